@@ -158,6 +158,7 @@ import (
 	clclient "github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/client"
 	cwpoolclient "github.com/osmosis-labs/osmosis/v29/x/cosmwasmpool/client"
 	gammclient "github.com/osmosis-labs/osmosis/v29/x/gamm/client"
+	ibcratelimitclient "github.com/osmosis-labs/osmosis/v29/x/ibc-rate-limit/client"
 	incentivesclient "github.com/osmosis-labs/osmosis/v29/x/incentives/client"
 	poolincentivesclient "github.com/osmosis-labs/osmosis/v29/x/pool-incentives/client"
 	poolmanagerclient "github.com/osmosis-labs/osmosis/v29/x/poolmanager/client"
@@ -526,11 +527,15 @@ func NewOsmosisApp(
 					gammclient.CreateCLPoolAndLinkToCFMMProposalHandler,
 					gammclient.SetScalingFactorControllerProposalHandler,
 					clclient.TickSpacingDecreaseProposalHandler,
+					clclient.SetDynamicSpreadFactorProposalHandler,
 					cwpoolclient.UploadCodeIdAndWhitelistProposalHandler,
 					cwpoolclient.MigratePoolContractsProposalHandler,
 					txfeesclient.SubmitUpdateFeeTokenProposalHandler,
 					poolmanagerclient.DenomPairTakerFeeProposalHandler,
 					incentivesclient.HandleCreateGroupsProposal,
+					ibcratelimitclient.AddRateLimitProposalHandler,
+					ibcratelimitclient.RemoveRateLimitProposalHandler,
+					ibcratelimitclient.SetDenomRestrictionsProposalHandler,
 				},
 			),
 		},
@@ -0,0 +1,33 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/poolmanager"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v29/x/poolmanager/types"
+	ibchookstypes "github.com/osmosis-labs/osmosis/x/ibc-hooks/types"
+)
+
+// IBCHooksSwapper exposes the poolmanager router to the native swaps of ibc-hooks.
+// ibc-hooks is a separate go module, so it declares its own route type instead of importing poolmanager's.
+type IBCHooksSwapper struct {
+	poolManagerKeeper *poolmanager.Keeper
+}
+
+var _ ibchookstypes.PoolManagerKeeper = IBCHooksSwapper{}
+
+func NewIBCHooksSwapper(poolManagerKeeper *poolmanager.Keeper) IBCHooksSwapper {
+	return IBCHooksSwapper{poolManagerKeeper: poolManagerKeeper}
+}
+
+func (s IBCHooksSwapper) RouteExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, routes []ibchookstypes.SwapAmountInRoute, tokenIn sdk.Coin, tokenOutMinAmount osmomath.Int) (osmomath.Int, error) {
+	poolManagerRoutes := make([]poolmanagertypes.SwapAmountInRoute, len(routes))
+	for i, route := range routes {
+		poolManagerRoutes[i] = poolmanagertypes.SwapAmountInRoute{
+			PoolId:        route.PoolId,
+			TokenOutDenom: route.TokenOutDenom,
+		}
+	}
+	return s.poolManagerKeeper.RouteExactAmountIn(ctx, sender, poolManagerRoutes, tokenIn, tokenOutMinAmount)
+}
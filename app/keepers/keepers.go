@@ -241,6 +241,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		authenticator.NewAnyOf(appKeepers.AuthenticatorManager),
 		authenticator.NewPartitionedAnyOf(appKeepers.AuthenticatorManager),
 		authenticator.NewPartitionedAllOf(appKeepers.AuthenticatorManager),
+		authenticator.NewSession(appKeepers.AuthenticatorManager, appKeepers.keys[smartaccounttypes.StoreKey]),
 	})
 	govModuleAddr := appKeepers.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
 
@@ -250,6 +251,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		govModuleAddr,
 		appKeepers.GetSubspace(smartaccounttypes.ModuleName),
 		appKeepers.AuthenticatorManager,
+		appKeepers.AccountKeeper,
+		encodingConfig.TxConfig,
 	)
 	appKeepers.SmartAccountKeeper = &smartAccountKeeper
 
@@ -448,9 +451,11 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.WasmKeeper,
 	)
 	appKeepers.PoolManagerKeeper.SetStakingKeeper(appKeepers.StakingKeeper)
+	appKeepers.PoolManagerKeeper.SetLockupKeeper(appKeepers.LockupKeeper)
 	appKeepers.GAMMKeeper.SetPoolManager(appKeepers.PoolManagerKeeper)
 	appKeepers.ConcentratedLiquidityKeeper.SetPoolManagerKeeper(appKeepers.PoolManagerKeeper)
 	appKeepers.CosmwasmPoolKeeper.SetPoolManagerKeeper(appKeepers.PoolManagerKeeper)
+	appKeepers.Ics20WasmHooks.PoolManagerKeeper = NewIBCHooksSwapper(appKeepers.PoolManagerKeeper)
 
 	appKeepers.TwapKeeper = twap.NewKeeper(
 		appKeepers.keys[twaptypes.StoreKey],
@@ -458,6 +463,10 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.GetSubspace(twaptypes.ModuleName),
 		appKeepers.PoolManagerKeeper)
 
+	// register the spend limit authenticator, which values spending through twap
+	appKeepers.AuthenticatorManager.RegisterAuthenticator(
+		authenticator.NewSpendLimit(appKeepers.keys[smartaccounttypes.StoreKey], appKeepers.BankKeeper, appKeepers.TwapKeeper))
+
 	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(appKeepers.keys[epochstypes.StoreKey])
 
 	protorevKeeper := protorevkeeper.NewKeeper(
@@ -512,6 +521,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.BankKeeper,
 		appKeepers.DistrKeeper,
 		appKeepers.EpochsKeeper,
+		appKeepers.StakingKeeper,
 		authtypes.FeeCollectorName,
 	)
 	appKeepers.MintKeeper = &mintKeeper
@@ -637,7 +647,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		AddRoute(concentratedliquiditytypes.RouterKey, concentratedliquidity.NewConcentratedLiquidityProposalHandler(*appKeepers.ConcentratedLiquidityKeeper)).
 		AddRoute(cosmwasmpooltypes.RouterKey, cosmwasmpool.NewCosmWasmPoolProposalHandler(*appKeepers.CosmwasmPoolKeeper)).
 		AddRoute(poolmanagertypes.RouterKey, poolmanager.NewPoolManagerProposalHandler(*appKeepers.PoolManagerKeeper)).
-		AddRoute(incentivestypes.RouterKey, incentiveskeeper.NewIncentivesProposalHandler(*appKeepers.IncentivesKeeper))
+		AddRoute(incentivestypes.RouterKey, incentiveskeeper.NewIncentivesProposalHandler(*appKeepers.IncentivesKeeper)).
+		AddRoute(ibcratelimittypes.RouterKey, ibcratelimit.NewRateLimitProposalHandler(appKeepers.RateLimitingICS4Wrapper))
 
 	govConfig := govtypes.DefaultConfig()
 	// Set the maximum metadata length for government-related configurations to 10,200, deviating from the default value of 256.
@@ -685,6 +696,7 @@ func (appKeepers *AppKeepers) WireICS20PreWasmKeeper(
 		nil,
 		appKeepers.BankKeeper,
 		appKeepers.GetSubspace(ibcratelimittypes.ModuleName),
+		appKeepers.keys[ibcratelimittypes.StoreKey],
 	)
 	appKeepers.RateLimitingICS4Wrapper = &rateLimitingICS4Wrapper
 
@@ -705,6 +717,12 @@ func (appKeepers *AppKeepers) WireICS20PreWasmKeeper(
 	appKeepers.TransferKeeper = &transferKeeper
 	appKeepers.RawIcs20TransferAppModule = transfer.NewAppModule(*appKeepers.TransferKeeper)
 
+	// Native swaps forward their output through the transfer keeper, and recover it through a native callback if the
+	// forward fails. The poolmanager keeper needs to be set later
+	appKeepers.Ics20WasmHooks.TransferKeeper = appKeepers.TransferKeeper
+	appKeepers.Ics20WasmHooks.BankKeeper = appKeepers.BankKeeper
+	hooksKeeper.RegisterNativeCallbackHandler(ibchookstypes.NativeSwapCallback, ibchooks.NewSwapRecoveryHandler(hooksKeeper, appKeepers.BankKeeper))
+
 	// Packet Forward Middleware
 	// Initialize packet forward middleware router
 	appKeepers.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
@@ -839,6 +857,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.DistrKeeper.Hooks(),
 			appKeepers.SlashingKeeper.Hooks(),
 			appKeepers.SuperfluidKeeper.Hooks(),
+			appKeepers.PoolManagerKeeper.StakingHooks(),
 		),
 	)
 
@@ -863,6 +882,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 		lockuptypes.NewMultiLockupHooks(
 			// insert lockup hooks receivers here
 			appKeepers.SuperfluidKeeper.Hooks(),
+			appKeepers.PoolManagerKeeper.LockupHooks(),
 		),
 	)
 
@@ -888,6 +908,8 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.IncentivesKeeper.Hooks(),
 			appKeepers.MintKeeper.Hooks(),
 			appKeepers.ProtoRevKeeper.EpochHooks(),
+			appKeepers.PoolManagerKeeper.EpochHooks(),
+			appKeepers.ValidatorSetPreferenceKeeper.EpochHooks(),
 		),
 	)
 
@@ -941,5 +963,6 @@ func KVStoreKeys() []string {
 		cosmwasmpooltypes.StoreKey,
 		auctiontypes.StoreKey,
 		smartaccounttypes.StoreKey,
+		ibcratelimittypes.StoreKey,
 	}
 }
@@ -47,6 +47,7 @@ import (
 	downtimemodule "github.com/osmosis-labs/osmosis/v29/x/downtime-detector/module"
 	"github.com/osmosis-labs/osmosis/v29/x/gamm"
 	gammclient "github.com/osmosis-labs/osmosis/v29/x/gamm/client"
+	ibcratelimitclient "github.com/osmosis-labs/osmosis/v29/x/ibc-rate-limit/client"
 	"github.com/osmosis-labs/osmosis/v29/x/ibc-rate-limit/ibcratelimitmodule"
 	"github.com/osmosis-labs/osmosis/v29/x/incentives"
 	incentivesclient "github.com/osmosis-labs/osmosis/v29/x/incentives/client"
@@ -91,11 +92,15 @@ var AppModuleBasics = module.NewBasicManager(
 			gammclient.CreateCLPoolAndLinkToCFMMProposalHandler,
 			gammclient.SetScalingFactorControllerProposalHandler,
 			clclient.TickSpacingDecreaseProposalHandler,
+			clclient.SetDynamicSpreadFactorProposalHandler,
 			cwpoolclient.UploadCodeIdAndWhitelistProposalHandler,
 			cwpoolclient.MigratePoolContractsProposalHandler,
 			txfeesclient.SubmitUpdateFeeTokenProposalHandler,
 			poolmanagerclient.DenomPairTakerFeeProposalHandler,
 			incentivesclient.HandleCreateGroupsProposal,
+			ibcratelimitclient.AddRateLimitProposalHandler,
+			ibcratelimitclient.RemoveRateLimitProposalHandler,
+			ibcratelimitclient.SetDenomRestrictionsProposalHandler,
 		},
 	),
 	params.AppModuleBasic{},
@@ -59,17 +59,18 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 	twapStoreKey := s.App.GetKey(twaptypes.ModuleName)
 	store := s.Ctx.KVStore(twapStoreKey)
 	twapRecord1 := twaptypes.TwapRecord{
-		PoolId:                      1,
-		Asset0Denom:                 "foo",
-		Asset1Denom:                 "bar",
-		Height:                      1,
-		Time:                        time.Date(2023, 0o2, 1, 0, 0, 0, 0, time.UTC),
-		P0LastSpotPrice:             osmomath.OneDec(),
-		P1LastSpotPrice:             osmomath.OneDec(),
-		P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
-		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
-		GeometricTwapAccumulator:    osmomath.ZeroDec(),
-		LastErrorTime:               time.Time{}, // no previous error
+		PoolId:                          1,
+		Asset0Denom:                     "foo",
+		Asset1Denom:                     "bar",
+		Height:                          1,
+		Time:                            time.Date(2023, 0o2, 1, 0, 0, 0, 0, time.UTC),
+		P0LastSpotPrice:                 osmomath.OneDec(),
+		P1LastSpotPrice:                 osmomath.OneDec(),
+		P0ArithmeticTwapAccumulator:     osmomath.ZeroDec(),
+		P1ArithmeticTwapAccumulator:     osmomath.ZeroDec(),
+		GeometricTwapAccumulator:        osmomath.ZeroDec(),
+		GeometricSquaredTwapAccumulator: osmomath.ZeroDec(),
+		LastErrorTime:                   time.Time{}, // no previous error
 	}
 	twapRecord2 := twapRecord1
 	twapRecord2.Time = time.Date(2023, 0o2, 2, 0, 0, 0, 0, time.UTC)
@@ -2,6 +2,7 @@ package v29
 
 import (
 	"github.com/osmosis-labs/osmosis/v29/app/upgrades"
+	ibcratelimittypes "github.com/osmosis-labs/osmosis/v29/x/ibc-rate-limit/types"
 
 	store "cosmossdk.io/store/types"
 )
@@ -13,7 +14,7 @@ var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateUpgradeHandler,
 	StoreUpgrades: store.StoreUpgrades{
-		Added:   []string{},
+		Added:   []string{ibcratelimittypes.StoreKey},
 		Deleted: []string{},
 	},
 }
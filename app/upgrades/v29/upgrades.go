@@ -4,10 +4,14 @@ import (
 	"context"
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
 	"github.com/osmosis-labs/osmosis/v29/app/keepers"
 	"github.com/osmosis-labs/osmosis/v29/app/upgrades"
+	minttypes "github.com/osmosis-labs/osmosis/v29/x/mint/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v29/x/poolmanager/types"
+	protorevtypes "github.com/osmosis-labs/osmosis/v29/x/protorev/types"
 )
 
 func CreateUpgradeHandler(
@@ -24,6 +28,33 @@ func CreateUpgradeHandler(
 			return nil, err
 		}
 
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+		// Initialize new param in the poolmanager module with the taker fee discount tiers.
+		keepers.PoolManagerKeeper.SetParam(sdkCtx, poolmanagertypes.KeyTakerFeeDiscountTiers, []poolmanagertypes.TakerFeeDiscountTier{})
+
+		// Initialize new param in the protorev module with the cyclic route search disabled.
+		keepers.ProtoRevKeeper.SetParam(sdkCtx, protorevtypes.ParamStoreKeyCyclicRouteSearchEnabled, protorevtypes.DefaultCyclicRouteSearchEnabled)
+
+		// Initialize new param in the mint module with no distribution streams.
+		keepers.MintKeeper.SetParam(sdkCtx, minttypes.KeyDistributionStreams, []minttypes.DistributionStream{})
+
+		// Initialize new params in the mint module, keeping the geometric supply curve.
+		keepers.MintKeeper.SetParam(sdkCtx, minttypes.KeySupplyCurve, minttypes.Geometric)
+		keepers.MintKeeper.SetParam(sdkCtx, minttypes.KeyProvisionsSchedule, []minttypes.ProvisionsScheduleEntry{})
+		keepers.MintKeeper.SetParam(sdkCtx, minttypes.KeyStakingRatioCurve, minttypes.StakingRatioCurve{})
+
+		// Move the rate limits of the rate limiting contract to the native rate limits of the ibc-rate-limit module.
+		if err := keepers.RateLimitingICS4Wrapper.ImportContractState(sdkCtx, keepers.WasmKeeper); err != nil {
+			return nil, err
+		}
+
+		// Replay the geometric squared accumulator, introduced for the twap volatility query, through the twap records
+		// written before it existed.
+		if err := keepers.TwapKeeper.BackfillGeometricSquaredAccumulators(sdkCtx); err != nil {
+			return nil, err
+		}
+
 		return migrations, nil
 	}
 }
@@ -0,0 +1,134 @@
+package v29_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/upgrade"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/osmosis-labs/osmosis/v29/app/apptesting"
+	v29 "github.com/osmosis-labs/osmosis/v29/app/upgrades/v29"
+	ibcratelimittypes "github.com/osmosis-labs/osmosis/v29/x/ibc-rate-limit/types"
+)
+
+const (
+	v29UpgradeHeight = int64(10)
+)
+
+type UpgradeTestSuite struct {
+	apptesting.KeeperTestHelper
+	preModule appmodule.HasPreBlocker
+}
+
+func TestUpgradeTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (s *UpgradeTestSuite) TestUpgrade() {
+	s.Setup()
+	s.preModule = upgrade.NewAppModule(s.App.UpgradeKeeper, addresscodec.NewBech32Codec("osmo"))
+
+	s.PrepareRateLimitContractStateTest()
+
+	// Run the upgrade
+	dummyUpgrade(s)
+	s.Require().NotPanics(func() {
+		_, err := s.preModule.PreBlock(s.Ctx)
+		s.Require().NoError(err)
+	})
+
+	s.ExecuteRateLimitContractStateTest()
+}
+
+func dummyUpgrade(s *UpgradeTestSuite) {
+	s.Ctx = s.Ctx.WithBlockHeight(v29UpgradeHeight - 1)
+	plan := upgradetypes.Plan{Name: v29.Upgrade.UpgradeName, Height: v29UpgradeHeight}
+	err := s.App.UpgradeKeeper.ScheduleUpgrade(s.Ctx, plan)
+	s.Require().NoError(err)
+	_, err = s.App.UpgradeKeeper.GetUpgradePlan(s.Ctx)
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithHeaderInfo(header.Info{Height: v29UpgradeHeight, Time: s.Ctx.BlockTime().Add(time.Second)}).WithBlockHeight(v29UpgradeHeight)
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+}
+
+// PrepareRateLimitContractStateTest instantiates the rate limiting contract with a valid path and a denom restriction,
+// and writes malformed paths to its storage.
+func (s *UpgradeTestSuite) PrepareRateLimitContractStateTest() {
+	govModule := s.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+	transferModule := s.App.AccountKeeper.GetModuleAddress(transfertypes.ModuleName)
+
+	code, err := os.ReadFile("../../../x/ibc-rate-limit/bytecode/rate_limiter.wasm")
+	s.Require().NoError(err)
+	contractKeeper := wasmkeeper.NewGovPermissionKeeper(s.App.WasmKeeper)
+	instantiateConfig := wasmtypes.AccessConfig{Permission: wasmtypes.AccessTypeAnyOfAddresses, Addresses: []string{govModule.String()}}
+	codeID, _, err := contractKeeper.Create(s.Ctx, govModule, code, &instantiateConfig)
+	s.Require().NoError(err)
+
+	initMsgBz := []byte(fmt.Sprintf(`{
+           "gov_module":  "%s",
+           "ibc_module":"%s",
+           "paths": [{"channel_id": "channel-0", "denom": "uosmo", "quotas": [{"name":"weekly", "duration": 604800, "send_recv":[5, 5]}] }]
+        }`,
+		govModule, transferModule))
+	contract, _, err := contractKeeper.Instantiate(s.Ctx, codeID, govModule, govModule, initMsgBz, "rate limiting contract", nil)
+	s.Require().NoError(err)
+	_, err = contractKeeper.Execute(s.Ctx, contract, govModule, []byte(`{"set_denom_restrictions": {"denom": "uosmo", "allowed_channels": ["channel-0"]}}`), nil)
+	s.Require().NoError(err)
+
+	params, err := ibcratelimittypes.NewParams(contract.String())
+	s.Require().NoError(err)
+	paramSpace, ok := s.App.ParamsKeeper.GetSubspace(ibcratelimittypes.ModuleName)
+	s.Require().True(ok)
+	paramSpace.SetParamSet(s.Ctx, &params)
+
+	// Malformed paths, which must be skipped without failing the upgrade
+	contractStore := s.Ctx.KVStore(s.App.AppKeepers.GetKey(wasmtypes.StoreKey))
+	contractStore.Set(append(wasmtypes.GetContractStorePrefix(contract), contractFlowKey("channel-1", "uosmo")...),
+		[]byte(`[{"quota":{"name":"weekly","max_percentage_send":5,"max_percentage_recv":5,"duration":604800,"channel_value":null},"flow":{"inflow":"not a number","outflow":"0","period_end":"0"}}]`))
+	contractStore.Set(append(wasmtypes.GetContractStorePrefix(contract), contractFlowKey("channel-2", "uosmo")...),
+		[]byte(fmt.Sprintf(`[{"quota":{"name":"weekly","max_percentage_send":5,"max_percentage_recv":5,"duration":%d,"channel_value":null},"flow":{"inflow":"0","outflow":"0","period_end":"0"}}]`, uint64(math.MaxUint64))))
+}
+
+// ExecuteRateLimitContractStateTest checks that the valid contract state is imported and the malformed paths are
+// skipped with an event.
+func (s *UpgradeTestSuite) ExecuteRateLimitContractStateTest() {
+	ics4Wrapper := s.App.RateLimitingICS4Wrapper
+	s.Require().Empty(ics4Wrapper.GetContractAddress(s.Ctx))
+
+	rateLimits := ics4Wrapper.GetRateLimits(s.Ctx, "channel-0", "uosmo")
+	s.Require().Len(rateLimits, 1)
+	s.Require().Equal(ibcratelimittypes.Quota{Name: "weekly", Duration: 7 * 24 * time.Hour, MaxPercentageSend: 5, MaxPercentageRecv: 5}, rateLimits[0].Quota)
+	s.Require().Empty(ics4Wrapper.GetRateLimits(s.Ctx, "channel-1", "uosmo"))
+	s.Require().Empty(ics4Wrapper.GetRateLimits(s.Ctx, "channel-2", "uosmo"))
+
+	restriction, found := ics4Wrapper.GetDenomRestriction(s.Ctx, "uosmo")
+	s.Require().True(found)
+	s.Require().Equal([]string{"channel-0"}, restriction.AllowedChannels)
+
+	s.AssertEventEmitted(s.Ctx, ibcratelimittypes.EventSkippedContractEntry, 2)
+}
+
+// contractFlowKey returns the key of the rate limits of a path in the contract's storage.
+func contractFlowKey(channelId, denom string) []byte {
+	key := binary.BigEndian.AppendUint16(nil, uint16(len("flow")))
+	key = append(key, "flow"...)
+	key = binary.BigEndian.AppendUint16(key, uint16(len(channelId)))
+	key = append(key, channelId...)
+	return append(key, denom...)
+}
@@ -492,12 +492,13 @@ func updateTWAPGenesis(appGenState map[string]json.RawMessage) func(twapGenState
 					Height:      1,
 					Time:        time.Date(2023, 0o2, 1, 0, 0, 0, 0, time.UTC), // some time in the past.
 					// Note: truncation is acceptable as x/twap is guaranteed to work only on pools with spot prices > 10^-18.
-					P0LastSpotPrice:             sp0.Dec(),
-					P1LastSpotPrice:             sp1.Dec(),
-					P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
-					P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
-					GeometricTwapAccumulator:    osmomath.ZeroDec(),
-					LastErrorTime:               time.Time{}, // no previous error
+					P0LastSpotPrice:                 sp0.Dec(),
+					P1LastSpotPrice:                 sp1.Dec(),
+					P0ArithmeticTwapAccumulator:     osmomath.ZeroDec(),
+					P1ArithmeticTwapAccumulator:     osmomath.ZeroDec(),
+					GeometricTwapAccumulator:        osmomath.ZeroDec(),
+					GeometricSquaredTwapAccumulator: osmomath.ZeroDec(),
+					LastErrorTime:                   time.Time{}, // no previous error
 				}
 				twapGenState.Twaps = append(twapGenState.Twaps, twapRecord)
 			}
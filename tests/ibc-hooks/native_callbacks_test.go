@@ -0,0 +1,141 @@
+package ibc_hooks_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/x/ibc-hooks/types"
+)
+
+// mockNativeCallbackHandler records the callbacks it receives and runs onCallback, if set, for each of them.
+type mockNativeCallbackHandler struct {
+	acks       []bool
+	timeouts   int
+	data       []transfertypes.FungibleTokenPacketData
+	onCallback func(ctx sdk.Context) error
+}
+
+var _ types.NativeCallbackHandler = &mockNativeCallbackHandler{}
+
+func (m *mockNativeCallbackHandler) OnIBCAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, ack []byte, success bool) error {
+	m.acks = append(m.acks, success)
+	m.data = append(m.data, data)
+	if m.onCallback != nil {
+		return m.onCallback(ctx)
+	}
+	return nil
+}
+
+func (m *mockNativeCallbackHandler) OnIBCTimeout(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+	m.timeouts++
+	m.data = append(m.data, data)
+	if m.onCallback != nil {
+		return m.onCallback(ctx)
+	}
+	return nil
+}
+
+func (suite *HooksTestSuite) TestNativeCallbackAcks() {
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	handler := &mockNativeCallbackHandler{}
+	osmosisApp.IBCHooksKeeper.RegisterNativeCallbackHandler("mock", handler)
+	sender := suite.chainA.SenderAccount.GetAddress()
+
+	// Successful ack. The callback key is stripped from the memo, keeping the rest of it.
+	transferMsg := NewMsgTransfer(sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1000)), sender.String(), sender.String(), "channel-0", `{"ibc_native_callback":"mock","other":"value"}`)
+	sendResult, _, ack, err := suite.FullSend(transferMsg, AtoB)
+	suite.Require().NoError(err)
+	suite.Require().Contains(ack, "result")
+	packet, err := ibctesting.ParsePacketFromEvents(sendResult.GetEvents())
+	suite.Require().NoError(err)
+	var packetData transfertypes.FungibleTokenPacketData
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData))
+	suite.Require().Equal(`{"other":"value"}`, packetData.Memo)
+
+	suite.Require().Equal([]bool{true}, handler.acks)
+	suite.Require().Equal(sender.String(), handler.data[0].Sender)
+	suite.Require().Empty(osmosisApp.IBCHooksKeeper.GetPacketNativeCallback(suite.chainA.GetContext(), "channel-0", packet.GetSequence()))
+
+	// Error ack, the receiver is not a valid address on chain B
+	transferMsg = NewMsgTransfer(sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1000)), sender.String(), "invalid", "channel-0", `{"ibc_native_callback":"mock"}`)
+	_, _, ack, err = suite.FullSend(transferMsg, AtoB)
+	suite.Require().NoError(err)
+	suite.Require().Contains(ack, "error")
+	suite.Require().Equal([]bool{true, false}, handler.acks)
+	suite.Require().Equal(0, handler.timeouts)
+}
+
+func (suite *HooksTestSuite) TestNativeCallbackTimeouts() {
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	handler := &mockNativeCallbackHandler{}
+	osmosisApp.IBCHooksKeeper.RegisterNativeCallbackHandler("mock", handler)
+	sender := suite.chainA.SenderAccount.GetAddress()
+
+	transferMsg := NewMsgTransfer(sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1000)), sender.String(), sender.String(), "channel-0", `{"ibc_native_callback":"mock"}`)
+	transferMsg.TimeoutTimestamp = uint64(suite.coordinator.CurrentTime.Add(time.Minute).UnixNano())
+	sendResult, err := suite.chainA.SendMsgsNoCheck(transferMsg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(sendResult.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal("mock", osmosisApp.IBCHooksKeeper.GetPacketNativeCallback(suite.chainA.GetContext(), "channel-0", packet.GetSequence()))
+
+	suite.chainB.NextBlock()
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	err = suite.pathAB.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = suite.pathAB.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(1, handler.timeouts)
+	suite.Require().Empty(handler.acks)
+	suite.Require().Empty(osmosisApp.IBCHooksKeeper.GetPacketNativeCallback(suite.chainA.GetContext(), "channel-0", packet.GetSequence()))
+}
+
+func (suite *HooksTestSuite) TestNativeCallbackErrors() {
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	sender := suite.chainA.SenderAccount.GetAddress()
+	recipient := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	callbackCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1)))
+
+	// The handler moves funds and then fails, so its state changes must be discarded.
+	handler := &mockNativeCallbackHandler{
+		onCallback: func(ctx sdk.Context) error {
+			err := osmosisApp.BankKeeper.SendCoins(ctx, sender, recipient, callbackCoins)
+			suite.Require().NoError(err)
+			return errors.New("callback failed")
+		},
+	}
+	osmosisApp.IBCHooksKeeper.RegisterNativeCallbackHandler("mock", handler)
+
+	// Registering a handler twice panics
+	suite.Require().Panics(func() {
+		osmosisApp.IBCHooksKeeper.RegisterNativeCallbackHandler("mock", handler)
+	})
+
+	// Sending with an unregistered handler fails
+	transferMsg := NewMsgTransfer(sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1000)), sender.String(), sender.String(), "channel-0", `{"ibc_native_callback":"unknown"}`)
+	_, err := suite.chainA.SendMsgsNoCheck(transferMsg)
+	suite.Require().ErrorContains(err, types.ErrUnknownNativeCallback.Error())
+
+	balanceBefore := osmosisApp.BankKeeper.GetBalance(suite.chainA.GetContext(), recipient, sdk.DefaultBondDenom)
+
+	// A failing handler doesn't fail the ack
+	transferMsg = NewMsgTransfer(sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1000)), sender.String(), sender.String(), "channel-0", `{"ibc_native_callback":"mock"}`)
+	sendResult, _, ack, err := suite.FullSend(transferMsg, AtoB)
+	suite.Require().NoError(err)
+	suite.Require().Contains(ack, "result")
+	suite.Require().Equal([]bool{true}, handler.acks)
+
+	packet, err := ibctesting.ParsePacketFromEvents(sendResult.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Empty(osmosisApp.IBCHooksKeeper.GetPacketNativeCallback(suite.chainA.GetContext(), "channel-0", packet.GetSequence()))
+	suite.Require().Equal(balanceBefore, osmosisApp.BankKeeper.GetBalance(suite.chainA.GetContext(), recipient, sdk.DefaultBondDenom))
+}
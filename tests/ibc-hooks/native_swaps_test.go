@@ -0,0 +1,156 @@
+package ibc_hooks_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	ibchooks "github.com/osmosis-labs/osmosis/x/ibc-hooks"
+	"github.com/osmosis-labs/osmosis/x/ibc-hooks/types"
+)
+
+// setupNativeSwaps creates a token0 (from chain B) / token1 pool on chain A and returns its id and the ibc denom of token0 on A
+func (suite *HooksTestSuite) setupNativeSwaps() (uint64, string) {
+	senderA := suite.chainA.SenderAccount.GetAddress()
+	senderB := suite.chainB.SenderAccount.GetAddress()
+	suite.fundAccount(suite.chainA, senderA)
+	suite.fundAccount(suite.chainB, senderB)
+
+	// Send some token0 from B to A to create the pool
+	transferMsg := NewMsgTransfer(sdk.NewCoin("token0", osmomath.NewInt(defaultPoolAmount)), senderB.String(), senderA.String(), "channel-0", "")
+	_, _, _, err := suite.FullSend(transferMsg, BtoA)
+	suite.Require().NoError(err)
+
+	token0IBC := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", "channel-0", "token0")).IBCDenom()
+	poolId := suite.CreateIBCPoolOnChain(ChainA, token0IBC, "token1", osmomath.NewInt(defaultPoolAmount))
+	return poolId, token0IBC
+}
+
+func (suite *HooksTestSuite) nativeSwapMemo(poolId uint64, minOut int64, recoveryAddress string, rest string) string {
+	return fmt.Sprintf(`{"swap":{"routes":[{"pool_id":%d,"token_out_denom":"token1"}],"token_out_min_amount":"%d","recovery_address":"%s"%s}}`,
+		poolId, minOut, recoveryAddress, rest)
+}
+
+func (suite *HooksTestSuite) TestNativeSwapToReceiver() {
+	poolId, _ := suite.setupNativeSwaps()
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	senderB := suite.chainB.SenderAccount.GetAddress()
+	recoveryAddr := suite.chainA.SenderAccounts[8].SenderAccount.GetAddress()
+	receiver := suite.chainA.SenderAccounts[9].SenderAccount.GetAddress()
+
+	// The min out is not met, the sender is refunded
+	balanceBefore := suite.chainB.GetOsmosisApp().BankKeeper.GetBalance(suite.chainB.GetContext(), senderB, "token0")
+	memo := suite.nativeSwapMemo(poolId, 1000, recoveryAddr.String(), fmt.Sprintf(`,"receiver":"%s"`, receiver))
+	transferMsg := NewMsgTransfer(sdk.NewCoin("token0", osmomath.NewInt(1000)), senderB.String(), recoveryAddr.String(), "channel-0", memo)
+	_, _, ack, err := suite.FullSend(transferMsg, BtoA)
+	suite.Require().NoError(err)
+	suite.Require().Contains(ack, "error")
+	suite.Require().Equal(balanceBefore, suite.chainB.GetOsmosisApp().BankKeeper.GetBalance(suite.chainB.GetContext(), senderB, "token0"))
+
+	// The output is sent to the receiver
+	recoveryBalance := osmosisApp.BankKeeper.GetAllBalances(suite.chainA.GetContext(), recoveryAddr)
+	receiverBalance := osmosisApp.BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, "token1")
+	memo = suite.nativeSwapMemo(poolId, 1, recoveryAddr.String(), fmt.Sprintf(`,"receiver":"%s"`, receiver))
+	transferMsg = NewMsgTransfer(sdk.NewCoin("token0", osmomath.NewInt(1000)), senderB.String(), recoveryAddr.String(), "channel-0", memo)
+	_, _, ack, err = suite.FullSend(transferMsg, BtoA)
+	suite.Require().NoError(err)
+	suite.Require().Contains(ack, "result")
+
+	var swapAck types.SwapAck
+	suite.Require().NoError(json.Unmarshal(suite.ackResult(ack), &swapAck))
+	suite.Require().Equal("token1", swapAck.TokenOut.Denom)
+	suite.Require().True(swapAck.TokenOut.Amount.IsPositive())
+	suite.Require().Equal(receiverBalance.Add(swapAck.TokenOut), osmosisApp.BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, "token1"))
+	suite.Require().Equal(recoveryBalance, osmosisApp.BankKeeper.GetAllBalances(suite.chainA.GetContext(), recoveryAddr))
+}
+
+func (suite *HooksTestSuite) TestNativeSwapForward() {
+	poolId, _ := suite.setupNativeSwaps()
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	senderB := suite.chainB.SenderAccount.GetAddress()
+	recoveryAddr := suite.chainA.SenderAccounts[8].SenderAccount.GetAddress()
+	receiverB := suite.chainB.SenderAccounts[9].SenderAccount.GetAddress()
+	token1IBC := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", "channel-0", "token1")).IBCDenom()
+
+	// The output is forwarded back to chain B
+	memo := suite.nativeSwapMemo(poolId, 1, recoveryAddr.String(), fmt.Sprintf(`,"forward":{"channel":"channel-0","receiver":"%s"}`, receiverB))
+	transferMsg := NewMsgTransfer(sdk.NewCoin("token0", osmomath.NewInt(1000)), senderB.String(), recoveryAddr.String(), "channel-0", memo)
+	_, receiveResult, ack, err := suite.FullSend(transferMsg, BtoA)
+	suite.Require().NoError(err)
+	suite.Require().Contains(ack, "result")
+	var swapAck types.SwapAck
+	suite.Require().NoError(json.Unmarshal(suite.ackResult(ack), &swapAck))
+
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(receiveResult.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(swapAck.ForwardSequence, forwardPacket.GetSequence())
+	_, forwardAck := suite.RelayPacket(forwardPacket, AtoB)
+	suite.Require().Contains(string(forwardAck), "result")
+	suite.Require().Equal(swapAck.TokenOut.Amount, suite.chainB.GetOsmosisApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiverB, token1IBC).Amount)
+	suite.Require().Empty(osmosisApp.IBCHooksKeeper.GetSwapRecoveryAddress(suite.chainA.GetContext(), "channel-0", forwardPacket.GetSequence()))
+
+	// The forward fails on chain B, the output is sent to the recovery address
+	recoveryBalance := osmosisApp.BankKeeper.GetBalance(suite.chainA.GetContext(), recoveryAddr, "token1")
+	memo = suite.nativeSwapMemo(poolId, 1, recoveryAddr.String(), `,"forward":{"channel":"channel-0","receiver":"invalid"}`)
+	transferMsg = NewMsgTransfer(sdk.NewCoin("token0", osmomath.NewInt(1000)), senderB.String(), recoveryAddr.String(), "channel-0", memo)
+	_, receiveResult, ack, err = suite.FullSend(transferMsg, BtoA)
+	suite.Require().NoError(err)
+	suite.Require().Contains(ack, "result")
+	suite.Require().NoError(json.Unmarshal(suite.ackResult(ack), &swapAck))
+
+	forwardPacket, err = ibctesting.ParsePacketFromEvents(receiveResult.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(recoveryAddr.String(), osmosisApp.IBCHooksKeeper.GetSwapRecoveryAddress(suite.chainA.GetContext(), "channel-0", forwardPacket.GetSequence()))
+	_, forwardAck = suite.RelayPacket(forwardPacket, AtoB)
+	suite.Require().True(osmoutils.IsAckError(forwardAck))
+	suite.Require().Equal(recoveryBalance.Add(swapAck.TokenOut), osmosisApp.BankKeeper.GetBalance(suite.chainA.GetContext(), recoveryAddr, "token1"))
+	suite.Require().Empty(osmosisApp.IBCHooksKeeper.GetSwapRecoveryAddress(suite.chainA.GetContext(), "channel-0", forwardPacket.GetSequence()))
+}
+
+func (suite *HooksTestSuite) TestBadNativeSwapMemos() {
+	recoveryAddr := suite.chainA.SenderAccounts[8].SenderAccount.GetAddress().String()
+	other := suite.chainA.SenderAccounts[9].SenderAccount.GetAddress().String()
+	routes := `"routes":[{"pool_id":1,"token_out_denom":"token1"}]`
+
+	for _, tc := range []struct {
+		memo     string
+		expected bool
+	}{
+		{fmt.Sprintf(`{"swap":{%s,"token_out_min_amount":"1","recovery_address":"%s"}}`, routes, recoveryAddr), true},
+		{fmt.Sprintf(`{"swap":{%s,"token_out_min_amount":"1","recovery_address":"%s","forward":{"channel":"channel-1","receiver":"cosmos1abc","memo":"{\"other\":1}"}}}`, routes, recoveryAddr), true},
+		{`{"swap":"invalid"}`, false},
+		{fmt.Sprintf(`{"swap":{"routes":[],"token_out_min_amount":"1","recovery_address":"%s"}}`, recoveryAddr), false},
+		{fmt.Sprintf(`{"swap":{%s,"token_out_min_amount":"0","recovery_address":"%s"}}`, routes, recoveryAddr), false},
+		{fmt.Sprintf(`{"swap":{%s,"recovery_address":"%s"}}`, routes, recoveryAddr), false},
+		{fmt.Sprintf(`{"swap":{%s,"token_out_min_amount":"1","recovery_address":"%s"}}`, routes, other), false},
+		{fmt.Sprintf(`{"swap":{%s,"token_out_min_amount":"1","recovery_address":"%s","receiver":"%s","forward":{"channel":"channel-1","receiver":"cosmos1abc"}}}`, routes, recoveryAddr, other), false},
+		{fmt.Sprintf(`{"swap":{%s,"token_out_min_amount":"1","recovery_address":"%s","forward":{"channel":"channel-1","receiver":"cosmos1abc","memo":"{\"ibc_callback\":\"%s\"}"}}}`, routes, recoveryAddr, other), false},
+		{fmt.Sprintf(`{"swap":{%s,"token_out_min_amount":"1","recovery_address":"%s"},"wasm":{}}`, routes, recoveryAddr), false},
+	} {
+		isSwapRouted, _, err := ibchooks.ValidateAndParseSwapMemo(tc.memo, recoveryAddr)
+		suite.Require().True(isSwapRouted, tc.memo)
+		if tc.expected {
+			suite.Require().NoError(err, tc.memo)
+		} else {
+			suite.Require().Error(err, tc.memo)
+		}
+	}
+
+	isSwapRouted, _, err := ibchooks.ValidateAndParseSwapMemo(`{"wasm":{}}`, recoveryAddr)
+	suite.Require().False(isSwapRouted)
+	suite.Require().NoError(err)
+}
+
+// ackResult returns the result of a result acknowledgement
+func (suite *HooksTestSuite) ackResult(ack string) []byte {
+	var res struct {
+		Result []byte `json:"result"`
+	}
+	suite.Require().NoError(json.Unmarshal([]byte(ack), &res))
+	return res.Result
+}
@@ -157,6 +157,10 @@ func init() {
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/Params", &poolmanagerqueryproto.ParamsResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee", &poolmanagerqueryproto.TradingPairTakerFeeResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateTradeBasedOnPriceImpact", &poolmanagerqueryproto.EstimateTradeBasedOnPriceImpactResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/BestSplitRouteExactAmountIn", &poolmanagerqueryproto.BestSplitRouteExactAmountInResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/PoolVolumeBuckets", &poolmanagerqueryproto.PoolVolumeBucketsResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/DenomPairVolumeBuckets", &poolmanagerqueryproto.DenomPairVolumeBucketsResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/TakerFeeDiscount", &poolmanagerqueryproto.TakerFeeDiscountResponse{})
 
 	// txfees
 	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/FeeTokens", &txfeestypes.QueryFeeTokensResponse{})
@@ -174,6 +178,10 @@ func init() {
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow", &twapquerytypes.ArithmeticTwapToNowResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwap", &twapquerytypes.GeometricTwapResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwapToNow", &twapquerytypes.GeometricTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/MedianTwap", &twapquerytypes.MedianTwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/MedianTwapToNow", &twapquerytypes.MedianTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Volatility", &twapquerytypes.VolatilityResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/VolatilityToNow", &twapquerytypes.VolatilityToNowResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Params", &twapquerytypes.ParamsResponse{})
 
 	// downtime-detector
@@ -190,6 +198,7 @@ func init() {
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords", &concentratedliquidityquery.IncentiveRecordsResponse{})
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers", &concentratedliquidityquery.TickAccumulatorTrackersResponse{})
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId", &concentratedliquidityquery.CFMMPoolIdLinkFromConcentratedPoolIdResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/DynamicSpreadFactor", &concentratedliquidityquery.DynamicSpreadFactorResponse{})
 }
 
 // IsWhitelistedQuery returns if the query is not whitelisted.
@@ -7,6 +7,8 @@ import (
 const (
 	FlagPoolId                     = "pool-id"
 	FlagPoolIdToTickSpacingRecords = "pool-tick-spacing-records"
+	FlagDynamicSpreadFactorConfigs = "dynamic-spread-factor-configs"
+	FlagDisabledPoolIds            = "disabled-pool-ids"
 )
 
 func FlagSetJustPoolId() *flag.FlagSet {
@@ -23,6 +23,8 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPoolAccumulatorRewards)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTickAccumulatorTrackers)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetLiquidityPerTickRange)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetClaimableLimitOrder)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetDynamicSpreadFactor)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -146,3 +148,21 @@ func GetTickAccumulatorTrackers() (*osmocli.QueryDescriptor, *queryproto.TickAcc
 {{.CommandPrefix}} tick-accumulator-trackers 1 "[-18000000]"`,
 	}, &queryproto.TickAccumulatorTrackersRequest{}
 }
+
+func GetClaimableLimitOrder() (*osmocli.QueryDescriptor, *queryproto.ClaimableLimitOrderRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "claimable-limit-order",
+		Short: "Query a limit order and the amount claimable by its owner",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} claimable-limit-order 53`,
+	}, &queryproto.ClaimableLimitOrderRequest{}
+}
+
+func GetDynamicSpreadFactor() (*osmocli.QueryDescriptor, *queryproto.DynamicSpreadFactorRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "dynamic-spread-factor",
+		Short: "Query the dynamic spread factor state of a pool and the spread factor it currently charges",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} dynamic-spread-factor 1`,
+	}, &queryproto.DynamicSpreadFactorRequest{}
+}
@@ -15,6 +15,8 @@ import (
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	clmodel "github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/types"
@@ -30,6 +32,9 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewCollectIncentivesCmd)
 	osmocli.AddTxCmd(txCmd, NewFungifyChargedPositionsCmd)
 	osmocli.AddTxCmd(txCmd, NewTransferPositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewPlaceLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewCancelLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewClaimLimitOrderCmd)
 	return txCmd
 }
 
@@ -102,6 +107,31 @@ func NewTransferPositionsCmd() (*osmocli.TxCliDesc, *types.MsgTransferPositions)
 	}, &types.MsgTransferPositions{}
 }
 
+func NewPlaceLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgPlaceLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "place-limit-order",
+		Short:   "place a limit order spanning one tick spacing starting at the given tick",
+		Long:    "the order sells the given token and is filled once the current tick of the pool fully crosses it. Token0 orders must be above the current tick, token1 orders below it.",
+		Example: "osmosisd tx concentratedliquidity place-limit-order 1 \"[-69100]\" 10000uosmo --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgPlaceLimitOrder{}
+}
+
+func NewCancelLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgCancelLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "cancel-limit-order",
+		Short:   "cancel a limit order that is not filled yet",
+		Example: "osmosisd tx concentratedliquidity cancel-limit-order 56 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgCancelLimitOrder{}
+}
+
+func NewClaimLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgClaimLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "claim-limit-order",
+		Short:   "claim the proceeds of a filled limit order",
+		Example: "osmosisd tx concentratedliquidity claim-limit-order 56 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgClaimLimitOrder{}
+}
+
 func NewTickSpacingDecreaseProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "tick-spacing-decrease-proposal [flags]",
@@ -205,3 +235,128 @@ func parsePoolIdToTickSpacingRecords(cmd *cobra.Command) ([]types.PoolIdToTickSp
 
 	return poolIdToTickSpacingRecords, nil
 }
+
+func NewSetDynamicSpreadFactorProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-dynamic-spread-factor-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a proposal to enable, update or disable the dynamic spread factor of concentrated pools",
+		Long: strings.TrimSpace(`Submit a proposal to enable, update or disable the dynamic spread factor of concentrated pools.
+
+Passing in FlagDynamicSpreadFactorConfigs separated by commas would be parsed automatically to groups of
+(poolId, minSpreadFactor, maxSpreadFactor, spreadFactorPerTick, volatilityDecay) configs.
+Ex) --dynamic-spread-factor-configs=1,0.0005,0.01,0.00001,0.9 -> [(poolId 1, min 0.0005, max 0.01, perTick 0.00001, decay 0.9)]
+Passing in FlagDisabledPoolIds separated by commas disables the dynamic spread factor of these pools.
+Ex) --disabled-pool-ids=2,3
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseSetDynamicSpreadFactorArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().String(FlagDynamicSpreadFactorConfigs, "", "The dynamic spread factor configs array")
+	cmd.Flags().String(FlagDisabledPoolIds, "", "The pool IDs to disable the dynamic spread factor of")
+
+	return cmd
+}
+
+func parseSetDynamicSpreadFactorArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	configs, err := parseDynamicSpreadFactorConfigs(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	disabledPoolIdsStr, err := cmd.Flags().GetString(FlagDisabledPoolIds)
+	if err != nil {
+		return nil, err
+	}
+	disabledPoolIds := []uint64{}
+	if disabledPoolIdsStr != "" {
+		disabledPoolIds, err = osmoutils.ParseUint64SliceFromString(disabledPoolIdsStr, ",")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	content := &types.SetDynamicSpreadFactorProposal{
+		Title:           title,
+		Description:     description,
+		Configs:         configs,
+		DisabledPoolIds: disabledPoolIds,
+	}
+	return content, nil
+}
+
+func parseDynamicSpreadFactorConfigs(cmd *cobra.Command) ([]types.DynamicSpreadFactorConfig, error) {
+	configsStr, err := cmd.Flags().GetString(FlagDynamicSpreadFactorConfigs)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := []types.DynamicSpreadFactorConfig{}
+	if configsStr == "" {
+		return configs, nil
+	}
+
+	values := strings.Split(configsStr, ",")
+	if len(values)%5 != 0 {
+		return nil, fmt.Errorf("dynamicSpreadFactorConfigs must be a list of groups of poolId, minSpreadFactor, maxSpreadFactor, spreadFactorPerTick and volatilityDecay")
+	}
+
+	for i := 0; i < len(values); i += 5 {
+		poolId, err := strconv.ParseUint(values[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		decs := make([]osmomath.Dec, 4)
+		for j := range decs {
+			decs[j], err = osmomath.NewDecFromStr(values[i+j+1])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		configs = append(configs, types.DynamicSpreadFactorConfig{
+			PoolId:              poolId,
+			MinSpreadFactor:     decs[0],
+			MaxSpreadFactor:     decs[1],
+			SpreadFactorPerTick: decs[2],
+			VolatilityDecay:     decs[3],
+		})
+	}
+
+	return configs, nil
+}
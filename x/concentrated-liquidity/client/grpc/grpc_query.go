@@ -151,6 +151,16 @@ func (q Querier) GetTotalLiquidity(grpcCtx context.Context,
 	return q.Q.GetTotalLiquidity(ctx, *req)
 }
 
+func (q Querier) DynamicSpreadFactor(grpcCtx context.Context,
+	req *queryproto.DynamicSpreadFactorRequest,
+) (*queryproto.DynamicSpreadFactorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.DynamicSpreadFactor(ctx, *req)
+}
+
 func (q Querier) ClaimableSpreadRewards(grpcCtx context.Context,
 	req *queryproto.ClaimableSpreadRewardsRequest,
 ) (*queryproto.ClaimableSpreadRewardsResponse, error) {
@@ -161,6 +171,16 @@ func (q Querier) ClaimableSpreadRewards(grpcCtx context.Context,
 	return q.Q.ClaimableSpreadRewards(ctx, *req)
 }
 
+func (q Querier) ClaimableLimitOrder(grpcCtx context.Context,
+	req *queryproto.ClaimableLimitOrderRequest,
+) (*queryproto.ClaimableLimitOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.ClaimableLimitOrder(ctx, *req)
+}
+
 func (q Querier) ClaimableIncentives(grpcCtx context.Context,
 	req *queryproto.ClaimableIncentivesRequest,
 ) (*queryproto.ClaimableIncentivesResponse, error) {
@@ -7,5 +7,6 @@ import (
 )
 
 var (
-	TickSpacingDecreaseProposalHandler = govclient.NewProposalHandler(cli.NewTickSpacingDecreaseProposal)
+	TickSpacingDecreaseProposalHandler    = govclient.NewProposalHandler(cli.NewTickSpacingDecreaseProposal)
+	SetDynamicSpreadFactorProposalHandler = govclient.NewProposalHandler(cli.NewSetDynamicSpreadFactorProposal)
 )
@@ -335,3 +335,43 @@ func (q Querier) NumPoolPositions(ctx sdk.Context, req clquery.NumPoolPositionsR
 		PositionCount: uint64(len(positionIDs)),
 	}, nil
 }
+
+// ClaimableLimitOrder returns the limit order with the given position id and the amount its owner can claim.
+func (q Querier) ClaimableLimitOrder(ctx sdk.Context, req clquery.ClaimableLimitOrderRequest) (*clquery.ClaimableLimitOrderResponse, error) {
+	limitOrder, err := q.Keeper.GetLimitOrder(ctx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claimable := sdk.NewCoins()
+	if limitOrder.Filled {
+		claimable = limitOrder.Proceeds
+	}
+
+	return &clquery.ClaimableLimitOrderResponse{
+		LimitOrder: limitOrder,
+		Claimable:  claimable,
+	}, nil
+}
+
+// DynamicSpreadFactor returns the dynamic spread factor state of a pool and the spread factor it currently charges.
+func (q Querier) DynamicSpreadFactor(ctx sdk.Context, req clquery.DynamicSpreadFactorRequest) (*clquery.DynamicSpreadFactorResponse, error) {
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id is zero")
+	}
+
+	dynamicSpreadFactor, err := q.Keeper.GetDynamicSpreadFactor(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	pool, err := q.Keeper.GetConcentratedPoolById(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clquery.DynamicSpreadFactorResponse{
+		DynamicSpreadFactor: dynamicSpreadFactor,
+		SpreadFactor:        pool.GetSpreadFactor(ctx),
+	}, nil
+}
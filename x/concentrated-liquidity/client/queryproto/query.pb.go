@@ -1717,6 +1717,195 @@ func (m *NumNextInitializedTicksResponse) GetCurrentTick() int64 {
 	return 0
 }
 
+// =============================== ClaimableLimitOrder
+type ClaimableLimitOrderRequest struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+}
+
+func (m *ClaimableLimitOrderRequest) Reset()         { *m = ClaimableLimitOrderRequest{} }
+func (m *ClaimableLimitOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*ClaimableLimitOrderRequest) ProtoMessage()    {}
+func (*ClaimableLimitOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{34}
+}
+func (m *ClaimableLimitOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimableLimitOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimableLimitOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimableLimitOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimableLimitOrderRequest.Merge(m, src)
+}
+func (m *ClaimableLimitOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimableLimitOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimableLimitOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimableLimitOrderRequest proto.InternalMessageInfo
+
+func (m *ClaimableLimitOrderRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+type ClaimableLimitOrderResponse struct {
+	LimitOrder model.LimitOrder `protobuf:"bytes,1,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order"`
+	// claimable is empty until the order is filled.
+	Claimable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=claimable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimable" yaml:"claimable"`
+}
+
+func (m *ClaimableLimitOrderResponse) Reset()         { *m = ClaimableLimitOrderResponse{} }
+func (m *ClaimableLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*ClaimableLimitOrderResponse) ProtoMessage()    {}
+func (*ClaimableLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{35}
+}
+func (m *ClaimableLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimableLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimableLimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimableLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimableLimitOrderResponse.Merge(m, src)
+}
+func (m *ClaimableLimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimableLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimableLimitOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimableLimitOrderResponse proto.InternalMessageInfo
+
+func (m *ClaimableLimitOrderResponse) GetLimitOrder() model.LimitOrder {
+	if m != nil {
+		return m.LimitOrder
+	}
+	return model.LimitOrder{}
+}
+
+func (m *ClaimableLimitOrderResponse) GetClaimable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimable
+	}
+	return nil
+}
+
+// =============================== DynamicSpreadFactor
+type DynamicSpreadFactorRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *DynamicSpreadFactorRequest) Reset()         { *m = DynamicSpreadFactorRequest{} }
+func (m *DynamicSpreadFactorRequest) String() string { return proto.CompactTextString(m) }
+func (*DynamicSpreadFactorRequest) ProtoMessage()    {}
+func (*DynamicSpreadFactorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{36}
+}
+func (m *DynamicSpreadFactorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSpreadFactorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSpreadFactorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSpreadFactorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSpreadFactorRequest.Merge(m, src)
+}
+func (m *DynamicSpreadFactorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSpreadFactorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSpreadFactorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSpreadFactorRequest proto.InternalMessageInfo
+
+func (m *DynamicSpreadFactorRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type DynamicSpreadFactorResponse struct {
+	DynamicSpreadFactor types1.DynamicSpreadFactor `protobuf:"bytes,1,opt,name=dynamic_spread_factor,json=dynamicSpreadFactor,proto3" json:"dynamic_spread_factor"`
+	// spread_factor is the current spread factor of the pool.
+	SpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=spread_factor,json=spreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spread_factor" yaml:"spread_factor"`
+}
+
+func (m *DynamicSpreadFactorResponse) Reset()         { *m = DynamicSpreadFactorResponse{} }
+func (m *DynamicSpreadFactorResponse) String() string { return proto.CompactTextString(m) }
+func (*DynamicSpreadFactorResponse) ProtoMessage()    {}
+func (*DynamicSpreadFactorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{37}
+}
+func (m *DynamicSpreadFactorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSpreadFactorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSpreadFactorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSpreadFactorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSpreadFactorResponse.Merge(m, src)
+}
+func (m *DynamicSpreadFactorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSpreadFactorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSpreadFactorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSpreadFactorResponse proto.InternalMessageInfo
+
+func (m *DynamicSpreadFactorResponse) GetDynamicSpreadFactor() types1.DynamicSpreadFactor {
+	if m != nil {
+		return m.DynamicSpreadFactor
+	}
+	return types1.DynamicSpreadFactor{}
+}
+
 func init() {
 	proto.RegisterType((*UserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsRequest")
 	proto.RegisterType((*UserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsResponse")
@@ -1752,6 +1941,10 @@ func init() {
 	proto.RegisterType((*GetTotalLiquidityResponse)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityResponse")
 	proto.RegisterType((*NumNextInitializedTicksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksRequest")
 	proto.RegisterType((*NumNextInitializedTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksResponse")
+	proto.RegisterType((*ClaimableLimitOrderRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableLimitOrderRequest")
+	proto.RegisterType((*ClaimableLimitOrderResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableLimitOrderResponse")
+	proto.RegisterType((*DynamicSpreadFactorRequest)(nil), "osmosis.concentratedliquidity.v1beta1.DynamicSpreadFactorRequest")
+	proto.RegisterType((*DynamicSpreadFactorResponse)(nil), "osmosis.concentratedliquidity.v1beta1.DynamicSpreadFactorResponse")
 }
 
 func init() {
@@ -1759,155 +1952,167 @@ func init() {
 }
 
 var fileDescriptor_5da291368ba4d8e3 = []byte{
-	// 2354 bytes of a gzipped FileDescriptorProto
+	// 2545 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x4e, 0x39, 0x3f, 0x9b, 0x79, 0x71, 0x9c, 0xa4, 0xec, 0xd8, 0xce, 0x24, 0x99, 0xc9, 0x16,
-	0x84, 0xb5, 0x48, 0x32, 0x43, 0xfe, 0x08, 0xf9, 0xdb, 0xc4, 0x63, 0xaf, 0xa3, 0xd1, 0x3a, 0x5e,
-	0xa7, 0x13, 0x03, 0x42, 0x88, 0xde, 0x9e, 0xee, 0xf2, 0xb8, 0x35, 0x3d, 0x5d, 0xe3, 0xee, 0xea,
-	0x24, 0x66, 0x89, 0xb4, 0xda, 0x3d, 0x22, 0xc1, 0x22, 0xae, 0x08, 0x09, 0x71, 0x41, 0x2b, 0x8e,
-	0x5c, 0xe0, 0x82, 0xe0, 0x80, 0x22, 0x0e, 0xab, 0x95, 0x10, 0x12, 0xda, 0xc3, 0x2c, 0x24, 0x1c,
-	0x90, 0x16, 0x90, 0x30, 0x17, 0x8e, 0xa8, 0xab, 0xab, 0x7f, 0x66, 0xa6, 0xc7, 0xee, 0x19, 0x9b,
-	0x13, 0x27, 0x4f, 0xf5, 0xab, 0xf7, 0xde, 0xf7, 0x7e, 0xea, 0x75, 0xbd, 0xd7, 0x86, 0x8b, 0xcc,
-	0x6d, 0x32, 0xd7, 0x74, 0xcb, 0x3a, 0xb3, 0x75, 0x6a, 0x73, 0x47, 0xe3, 0xd4, 0xb0, 0xcc, 0x75,
-	0xcf, 0x34, 0x4c, 0xbe, 0x51, 0x7e, 0x7c, 0xb1, 0x46, 0xb9, 0x76, 0xb1, 0xbc, 0xee, 0x51, 0x67,
-	0xa3, 0xd4, 0x72, 0x18, 0x67, 0xf8, 0xac, 0x64, 0x29, 0xa5, 0xb2, 0x94, 0x24, 0x4b, 0x7e, 0xa2,
-	0xce, 0xea, 0x4c, 0x70, 0x94, 0xfd, 0x5f, 0x01, 0x73, 0xfe, 0x8b, 0x5b, 0xeb, 0x6b, 0x69, 0x8e,
-	0xd6, 0x74, 0xe5, 0xde, 0xab, 0xd9, 0xb0, 0x71, 0x53, 0x6f, 0xa8, 0xa6, 0xbd, 0x1a, 0xaa, 0x28,
-	0xe8, 0x82, 0xaf, 0x5c, 0xd3, 0x5c, 0x1a, 0x6d, 0xd2, 0x99, 0x69, 0x87, 0x10, 0x92, 0x74, 0x61,
-	0x58, 0xb4, 0xab, 0xa5, 0xd5, 0x4d, 0x5b, 0xe3, 0x26, 0x0b, 0xf7, 0x9e, 0xaa, 0x33, 0x56, 0xb7,
-	0x68, 0x59, 0x6b, 0x99, 0x65, 0xcd, 0xb6, 0x19, 0x17, 0xc4, 0x10, 0xe0, 0x09, 0x49, 0x15, 0xab,
-	0x9a, 0xb7, 0x5a, 0xd6, 0xec, 0x8d, 0x90, 0x14, 0x28, 0x51, 0x03, 0x07, 0x04, 0x0b, 0x49, 0xba,
-	0x92, 0xcd, 0xac, 0x16, 0x73, 0xcd, 0x04, 0x92, 0x5b, 0xd9, 0xb8, 0x4c, 0x41, 0x34, 0x1f, 0x53,
-	0xd5, 0xa1, 0x3a, 0x73, 0x8c, 0x80, 0x9b, 0xfc, 0x12, 0xc1, 0xc4, 0x8a, 0x4b, 0x9d, 0x65, 0x29,
-	0xd4, 0x55, 0xe8, 0xba, 0x47, 0x5d, 0x8e, 0xcf, 0xc3, 0x2b, 0x9a, 0x61, 0x38, 0xd4, 0x75, 0xa7,
-	0xd1, 0x19, 0x34, 0x93, 0xab, 0xe0, 0xcd, 0x76, 0x71, 0x6c, 0x43, 0x6b, 0x5a, 0x37, 0x88, 0x24,
-	0x10, 0x25, 0xdc, 0x82, 0xcf, 0xc1, 0x2b, 0x2d, 0xc6, 0x2c, 0xd5, 0x34, 0xa6, 0x47, 0xce, 0xa0,
-	0x99, 0x7d, 0xc9, 0xdd, 0x92, 0x40, 0x94, 0x03, 0xfe, 0xaf, 0xaa, 0x81, 0x17, 0x00, 0x62, 0x7f,
-	0x4e, 0xef, 0x3d, 0x83, 0x66, 0x0e, 0x5d, 0xfa, 0x42, 0x49, 0xba, 0xc2, 0x77, 0x7e, 0x29, 0xc8,
-	0x2a, 0x09, 0xbd, 0xb4, 0xac, 0xd5, 0xa9, 0x84, 0xa5, 0x24, 0x38, 0xc9, 0x6f, 0x11, 0x1c, 0xef,
-	0xc2, 0xee, 0xb6, 0x98, 0xed, 0x52, 0xfc, 0x36, 0xe4, 0x42, 0x2f, 0xf9, 0xf0, 0xf7, 0xce, 0x1c,
-	0xba, 0x74, 0xab, 0x94, 0x29, 0x3b, 0x4b, 0x0b, 0x9e, 0x65, 0x85, 0x02, 0x2b, 0x0e, 0xd5, 0x1a,
-	0x06, 0x7b, 0x62, 0x57, 0xf6, 0x3d, 0x6f, 0x17, 0xf7, 0x28, 0xb1, 0x50, 0x7c, 0xaf, 0xc3, 0x86,
-	0x11, 0x61, 0xc3, 0x6b, 0xdb, 0xda, 0x10, 0xc0, 0xeb, 0x30, 0x62, 0x09, 0xc6, 0x23, 0x75, 0x1b,
-	0x55, 0x23, 0x74, 0xff, 0x35, 0x38, 0x14, 0x2a, 0xf3, 0x9d, 0x8a, 0x84, 0x53, 0x27, 0x37, 0xdb,
-	0x45, 0x1c, 0x3a, 0x35, 0x22, 0x12, 0x05, 0xc2, 0x55, 0xd5, 0x20, 0x8f, 0x61, 0xa2, 0x53, 0x9e,
-	0x74, 0xc9, 0xb7, 0xe0, 0x60, 0xb8, 0x4b, 0x48, 0xdb, 0x1d, 0x8f, 0x44, 0x32, 0xc9, 0x02, 0x4c,
-	0x2d, 0x79, 0xcd, 0x65, 0xc6, 0xac, 0x9e, 0x54, 0x4a, 0x24, 0x07, 0xda, 0x2e, 0x39, 0xc8, 0x37,
-	0x61, 0xba, 0x57, 0x8e, 0xb4, 0xe1, 0x2e, 0x8c, 0x45, 0x76, 0xeb, 0xcc, 0xb3, 0xb9, 0x94, 0x77,
-	0x62, 0xb3, 0x5d, 0x3c, 0xde, 0xe5, 0x17, 0x41, 0x27, 0xca, 0xe1, 0xf0, 0xc1, 0x9c, 0x58, 0x7f,
-	0x15, 0x46, 0x7d, 0xd1, 0x11, 0xb4, 0x85, 0x94, 0x30, 0x0e, 0x93, 0x8a, 0xdf, 0x47, 0x70, 0x58,
-	0x0a, 0x96, 0x58, 0xaf, 0xc2, 0x7e, 0xdf, 0xa2, 0x30, 0xfd, 0x26, 0x4a, 0x41, 0x49, 0x28, 0x85,
-	0x25, 0xa1, 0x34, 0x6b, 0x6f, 0x54, 0x72, 0xbf, 0xff, 0xc5, 0x85, 0xfd, 0x3e, 0x5f, 0x55, 0x09,
-	0x76, 0xef, 0x5e, 0x5e, 0x1d, 0x81, 0xc3, 0xcb, 0xa2, 0x66, 0x4a, 0xb8, 0x64, 0x05, 0xc6, 0xc2,
-	0x07, 0x12, 0xe2, 0x1c, 0x1c, 0x08, 0xca, 0xaa, 0x4c, 0x88, 0xb3, 0xdb, 0x24, 0x44, 0xc0, 0x2e,
-	0x23, 0x2f, 0x59, 0xc9, 0x87, 0x08, 0x8e, 0x3e, 0x32, 0xf5, 0xc6, 0x62, 0xb8, 0x6d, 0x89, 0x72,
-	0xfc, 0x36, 0x1c, 0x8e, 0xd8, 0x54, 0x9b, 0x72, 0x59, 0x42, 0x6e, 0xfa, 0x9c, 0x9f, 0xb4, 0x8b,
-	0x27, 0x03, 0x7b, 0x5c, 0xa3, 0x51, 0x32, 0x59, 0xb9, 0xa9, 0xf1, 0xb5, 0xd2, 0x22, 0xad, 0x6b,
-	0xfa, 0xc6, 0x3c, 0xd5, 0x37, 0xdb, 0xc5, 0x89, 0x20, 0x94, 0x1d, 0x12, 0x88, 0x32, 0x6a, 0x25,
-	0x35, 0x5c, 0x01, 0x90, 0xe5, 0xdd, 0xa0, 0x4f, 0x85, 0x9f, 0xf6, 0x56, 0x8e, 0x6f, 0xb6, 0x8b,
-	0xc7, 0x02, 0xde, 0x98, 0x46, 0x94, 0x9c, 0xbf, 0xa8, 0x8a, 0xdf, 0xff, 0x40, 0x30, 0x15, 0x01,
-	0x9d, 0xa7, 0x2d, 0xbe, 0xf6, 0x35, 0x93, 0xaf, 0x29, 0x9a, 0x5d, 0xa7, 0x78, 0x15, 0x8e, 0xc6,
-	0x1a, 0xb5, 0x66, 0x94, 0x5e, 0x3b, 0x84, 0x7d, 0x24, 0x5a, 0xcf, 0x0a, 0x99, 0x3e, 0x72, 0x8b,
-	0x3d, 0xa1, 0x8e, 0xea, 0xc3, 0xea, 0x45, 0x1e, 0xd3, 0x88, 0x92, 0x13, 0x0b, 0xdf, 0xbb, 0x3e,
-	0x97, 0xd7, 0x6a, 0x85, 0x5c, 0x7b, 0xbb, 0xb9, 0x62, 0x1a, 0x51, 0x72, 0x62, 0xe1, 0x73, 0x91,
-	0x4f, 0x47, 0xa0, 0x90, 0x0c, 0x4c, 0xd5, 0x9e, 0x37, 0x1d, 0xaa, 0xfb, 0x09, 0x32, 0xcc, 0xe1,
-	0xc4, 0x25, 0x38, 0xc8, 0x59, 0x83, 0xda, 0xaa, 0x19, 0xe4, 0x66, 0xae, 0x32, 0xbe, 0xd9, 0x2e,
-	0x1e, 0x91, 0x3e, 0x97, 0x14, 0xa2, 0xbc, 0x22, 0x7e, 0x56, 0x6d, 0x1f, 0xb5, 0xcb, 0x35, 0x87,
-	0xf7, 0x41, 0x1d, 0xd3, 0x88, 0x92, 0x13, 0x0b, 0x61, 0xeb, 0x75, 0x18, 0xf5, 0x5c, 0xaa, 0xea,
-	0x9e, 0xb4, 0x76, 0xdf, 0x19, 0x34, 0x73, 0xb0, 0x32, 0xb5, 0xd9, 0x2e, 0x8e, 0x4b, 0x6b, 0x13,
-	0x54, 0xa2, 0x80, 0xe7, 0xd2, 0x39, 0x2f, 0x72, 0x53, 0x8d, 0x79, 0xb6, 0x11, 0x30, 0xee, 0xef,
-	0x56, 0x18, 0xd3, 0x88, 0x92, 0x13, 0x8b, 0xa4, 0x42, 0x9b, 0xa9, 0xe2, 0xd9, 0xf4, 0x81, 0x34,
-	0x85, 0x21, 0x35, 0x50, 0xb8, 0xc4, 0x2a, 0x62, 0xf1, 0x93, 0xbd, 0x50, 0xec, 0xeb, 0x61, 0x79,
-	0xce, 0xd6, 0x92, 0x99, 0x65, 0xf8, 0x59, 0x17, 0x56, 0x85, 0x6b, 0x19, 0x4b, 0x70, 0xf7, 0x01,
-	0x93, 0x67, 0x30, 0xce, 0x2d, 0x91, 0xcb, 0x2e, 0x7e, 0x15, 0x46, 0x75, 0xcf, 0x71, 0xa8, 0xcd,
-	0x13, 0xd9, 0xa5, 0x1c, 0x92, 0xcf, 0x84, 0xad, 0x16, 0x1c, 0x0b, 0xb7, 0x44, 0xdc, 0x22, 0x32,
-	0xb9, 0xca, 0x9d, 0x6c, 0x79, 0x3e, 0x1d, 0xf8, 0xa4, 0x47, 0x0a, 0x51, 0x8e, 0xca, 0x67, 0x11,
-	0x54, 0xfc, 0x1e, 0x02, 0x1c, 0x6e, 0x74, 0xd7, 0x1d, 0xae, 0xb6, 0x1c, 0x53, 0xa7, 0x22, 0xa2,
-	0xb9, 0xca, 0x23, 0xa9, 0xaf, 0x5c, 0x37, 0xf9, 0x9a, 0x57, 0x2b, 0xe9, 0xac, 0x59, 0x96, 0xfe,
-	0xb8, 0x60, 0x69, 0x35, 0x37, 0x5c, 0x88, 0xbf, 0x02, 0x46, 0xc5, 0xac, 0x07, 0x18, 0x4e, 0x74,
-	0x62, 0x88, 0x45, 0xc7, 0x20, 0x1e, 0xae, 0x3b, 0x7c, 0x59, 0x3c, 0x7a, 0x13, 0x4e, 0x45, 0x88,
-	0x96, 0x83, 0x93, 0x21, 0x8e, 0xfc, 0x50, 0xef, 0xa7, 0x5f, 0x23, 0x38, 0xdd, 0x47, 0x9a, 0x0c,
-	0x77, 0x0d, 0x72, 0xb1, 0x67, 0x83, 0x38, 0xbf, 0x9e, 0x31, 0xce, 0x7d, 0x6a, 0x53, 0x78, 0xfd,
-	0x88, 0x18, 0xf0, 0x0d, 0x18, 0xad, 0x79, 0x7a, 0x83, 0xf2, 0x8e, 0x02, 0x98, 0xc8, 0xd8, 0x24,
-	0x95, 0x28, 0x87, 0x82, 0x65, 0x50, 0x04, 0xbf, 0x0e, 0xa7, 0xe7, 0x2c, 0xcd, 0x6c, 0x6a, 0x35,
-	0x8b, 0x3e, 0x6c, 0x39, 0x54, 0x33, 0x14, 0xfa, 0x44, 0x73, 0x0c, 0x77, 0xc7, 0x77, 0x8f, 0x1f,
-	0x23, 0x28, 0xf4, 0x13, 0x2d, 0x9d, 0xf3, 0x1d, 0x98, 0xd6, 0xc3, 0x1d, 0xaa, 0x2b, 0xb6, 0xa8,
-	0x4e, 0xb0, 0x47, 0xfa, 0xea, 0x44, 0xc7, 0xdb, 0x2e, 0xf4, 0xcc, 0x1c, 0x33, 0xed, 0xca, 0x6b,
-	0xbe, 0x1b, 0x36, 0xdb, 0xc5, 0xa2, 0x8c, 0x7e, 0x1f, 0x41, 0x44, 0x99, 0xd4, 0x53, 0x51, 0x90,
-	0x15, 0xc8, 0x47, 0xf8, 0xaa, 0xe1, 0x85, 0x78, 0xe7, 0x76, 0xbf, 0x3f, 0x02, 0x27, 0x53, 0xe5,
-	0x4a, 0xa3, 0xd7, 0x61, 0x22, 0xc6, 0x1a, 0x5d, 0xc4, 0x33, 0x18, 0xfc, 0x39, 0x69, 0xf0, 0xc9,
-	0x6e, 0x83, 0x63, 0x21, 0x44, 0x19, 0xd7, 0x7b, 0x55, 0xfb, 0x2a, 0x57, 0x99, 0xb3, 0x4a, 0x4d,
-	0x4e, 0x8d, 0xa4, 0xca, 0x91, 0x01, 0x55, 0xa6, 0x09, 0x21, 0xca, 0x78, 0xf4, 0x38, 0x56, 0x49,
-	0x16, 0xe1, 0xb4, 0x7f, 0x95, 0x99, 0xd5, 0x75, 0xaf, 0xe9, 0x59, 0x1a, 0x67, 0x4e, 0x57, 0x5e,
-	0x0d, 0x74, 0xce, 0x7e, 0x33, 0x02, 0x85, 0x7e, 0xe2, 0xa4, 0x5b, 0x3f, 0x40, 0x70, 0xb2, 0x23,
-	0xf2, 0x6a, 0xdd, 0x61, 0x4f, 0xf8, 0x9a, 0x5a, 0xb7, 0x58, 0x4d, 0xb3, 0xa4, 0x7b, 0x4f, 0xa5,
-	0xda, 0x3a, 0x4f, 0x75, 0x61, 0xee, 0x65, 0xdf, 0xdc, 0x0f, 0x3f, 0x2d, 0x9e, 0x4b, 0xd4, 0x20,
-	0xd9, 0x06, 0x06, 0x7f, 0x2e, 0xb8, 0x46, 0xa3, 0xcc, 0x37, 0x5a, 0xd4, 0x0d, 0x79, 0x5c, 0x65,
-	0xda, 0x4d, 0x64, 0xd5, 0x3d, 0xa1, 0xf3, 0x9e, 0x50, 0x89, 0xbf, 0x8b, 0x60, 0xc2, 0x6b, 0x71,
-	0xb3, 0x49, 0xbb, 0xb0, 0x04, 0x7e, 0xbf, 0x92, 0xb1, 0x0e, 0xac, 0x08, 0x11, 0x8f, 0x1c, 0x4d,
-	0x6f, 0x50, 0xa7, 0x3b, 0x24, 0x69, 0xf2, 0x89, 0x82, 0x83, 0xc7, 0x49, 0x34, 0xe4, 0x7d, 0x04,
-	0x05, 0xbf, 0x3e, 0x25, 0x7c, 0x28, 0x65, 0x0e, 0x15, 0x93, 0x21, 0x2f, 0x5d, 0x9f, 0x8d, 0x40,
-	0xb1, 0x2f, 0x0a, 0x19, 0xca, 0xe7, 0x08, 0xae, 0xa7, 0x86, 0x92, 0xb5, 0xc4, 0x39, 0xa3, 0xaa,
-	0x11, 0xbe, 0x56, 0x55, 0xb6, 0xaa, 0x5a, 0x9a, 0xcb, 0x55, 0xee, 0x68, 0x8f, 0xa9, 0xe3, 0xfe,
-	0x2f, 0x03, 0x7d, 0xa9, 0x37, 0xd0, 0x6f, 0x49, 0x40, 0xd1, 0x6b, 0xfe, 0xad, 0xd5, 0x45, 0xcd,
-	0xe5, 0x8f, 0x42, 0x30, 0xf8, 0x19, 0x1c, 0x91, 0x11, 0xe2, 0xd2, 0xca, 0x1d, 0x05, 0xbf, 0x20,
-	0x83, 0x3f, 0xd9, 0x11, 0xfc, 0x50, 0x34, 0x51, 0xc6, 0xbc, 0xe4, 0x76, 0x97, 0x7c, 0x0f, 0xc1,
-	0x54, 0x74, 0x28, 0x15, 0xd1, 0xea, 0x0f, 0x17, 0xec, 0xdd, 0x6a, 0x8d, 0x3e, 0x42, 0x30, 0xdd,
-	0x0b, 0x48, 0xc6, 0xdd, 0x84, 0x63, 0xdd, 0x83, 0x89, 0xb0, 0x2c, 0x7e, 0x39, 0xa3, 0xbb, 0xba,
-	0x64, 0xcb, 0x77, 0xe5, 0x51, 0xb3, 0x4b, 0xe5, 0xee, 0x75, 0x56, 0xef, 0x22, 0x38, 0x37, 0xb7,
-	0x70, 0xff, 0xbe, 0xe8, 0xdb, 0x8c, 0x45, 0xd3, 0x6e, 0x2c, 0x38, 0xac, 0x39, 0x97, 0x00, 0x19,
-	0x50, 0x42, 0xaf, 0x3f, 0x80, 0x89, 0xa4, 0x05, 0x6a, 0x67, 0x08, 0x8a, 0x89, 0xf2, 0x9e, 0xb2,
-	0x8b, 0x28, 0x58, 0xef, 0x91, 0x4c, 0x4c, 0x38, 0x9f, 0x0d, 0x81, 0x74, 0xf3, 0x75, 0x18, 0xd5,
-	0x57, 0x9b, 0xcd, 0x2e, 0xd5, 0x89, 0xeb, 0x42, 0x92, 0x4a, 0x14, 0xf0, 0x97, 0x52, 0xd5, 0x7d,
-	0x38, 0xbd, 0xe2, 0x52, 0x67, 0xc5, 0xae, 0x31, 0xdb, 0x30, 0xed, 0xfa, 0xce, 0x06, 0x45, 0xe4,
-	0xa7, 0x08, 0x0a, 0xfd, 0xe4, 0x49, 0xb0, 0xef, 0x22, 0xc8, 0x47, 0x83, 0x16, 0xf5, 0x89, 0xc9,
-	0xd7, 0xd4, 0x16, 0x75, 0x4c, 0x66, 0xa8, 0x16, 0xd3, 0x1b, 0x32, 0x3b, 0x6e, 0x67, 0xcc, 0x8e,
-	0x50, 0xbc, 0x7f, 0x97, 0x5a, 0x16, 0x52, 0x16, 0x99, 0xde, 0x90, 0x49, 0x32, 0x15, 0xa9, 0xe9,
-	0x24, 0x93, 0x3c, 0x4c, 0xdf, 0xa3, 0xfc, 0x11, 0xe3, 0x9a, 0x15, 0x5d, 0xc9, 0xc2, 0x3e, 0xfa,
-	0x07, 0x08, 0x4e, 0xa4, 0x10, 0x25, 0x78, 0x0e, 0x47, 0xb8, 0x4f, 0x51, 0xbb, 0xaf, 0x80, 0x5b,
-	0xbc, 0x72, 0xbf, 0x24, 0x4b, 0xd3, 0x4c, 0x86, 0xd2, 0x14, 0xd4, 0xa5, 0x31, 0xde, 0xa1, 0x9d,
-	0x6c, 0x22, 0x28, 0x2c, 0x79, 0xcd, 0x25, 0xfa, 0x94, 0x57, 0x6d, 0x93, 0x9b, 0x9a, 0x65, 0x7e,
-	0x9b, 0x8a, 0xde, 0x66, 0xb8, 0xb3, 0x7f, 0x07, 0xc6, 0xc2, 0x6e, 0x4e, 0x35, 0xa8, 0xcd, 0x9a,
-	0xb2, 0xdb, 0x4b, 0x0c, 0x5a, 0x3a, 0xe9, 0x44, 0x19, 0x95, 0x3d, 0xdf, 0xbc, 0xbf, 0xc4, 0x35,
-	0xc8, 0xdb, 0x5e, 0x53, 0xb5, 0xe9, 0x53, 0xff, 0x0e, 0x1a, 0x21, 0x12, 0x5d, 0x89, 0x2b, 0xda,
-	0x8d, 0x7d, 0x95, 0xb3, 0x9b, 0xed, 0xe2, 0xab, 0x81, 0xb0, 0xfe, 0x7b, 0x89, 0x32, 0x65, 0xa7,
-	0x1b, 0x46, 0x7e, 0x34, 0x02, 0xc5, 0xbe, 0x46, 0xff, 0xdf, 0xb7, 0x5e, 0x97, 0xfe, 0x95, 0x87,
-	0xfd, 0x0f, 0xfc, 0x8a, 0x86, 0x7f, 0x86, 0x40, 0x0c, 0x99, 0x5c, 0x7c, 0x39, 0xf3, 0xa9, 0x89,
-	0x67, 0x64, 0xf9, 0x2b, 0x83, 0x31, 0x05, 0x9e, 0x27, 0x57, 0xde, 0xfb, 0xc3, 0x5f, 0x7f, 0x38,
-	0x52, 0xc2, 0xe7, 0xcb, 0x59, 0xa7, 0xda, 0x3e, 0xc0, 0x9f, 0x23, 0x38, 0x10, 0x8c, 0x99, 0x70,
-	0x66, 0xb5, 0xc9, 0x29, 0x57, 0xfe, 0xea, 0x80, 0x5c, 0x12, 0xed, 0x55, 0x81, 0xb6, 0x8c, 0x2f,
-	0x64, 0x45, 0x1b, 0x60, 0xfc, 0x08, 0xc1, 0xe1, 0x8e, 0x09, 0x34, 0xbe, 0x99, 0xf5, 0x25, 0x9f,
-	0x32, 0x73, 0xcf, 0xdf, 0x1a, 0x8e, 0x59, 0xda, 0x50, 0x11, 0x36, 0xdc, 0xc2, 0x37, 0xca, 0x83,
-	0x7d, 0x47, 0x70, 0xcb, 0xef, 0xc8, 0xea, 0xfc, 0x0c, 0x7f, 0x86, 0xe0, 0x78, 0x6a, 0x77, 0x8b,
-	0xe7, 0x06, 0x6d, 0x61, 0x53, 0x3a, 0xed, 0xfc, 0xfc, 0xce, 0x84, 0x48, 0x43, 0xef, 0x09, 0x43,
-	0x67, 0xf1, 0x9d, 0x8c, 0x86, 0xc6, 0x15, 0x20, 0x1c, 0x92, 0xa9, 0x8e, 0xb0, 0xe9, 0xdf, 0xc9,
-	0x71, 0x60, 0xe7, 0xf0, 0x06, 0xbf, 0x31, 0x28, 0xd4, 0xd4, 0xf1, 0x5a, 0x7e, 0x61, 0xa7, 0x62,
-	0xa4, 0xcd, 0x55, 0x61, 0xf3, 0x1c, 0x9e, 0x1d, 0xd8, 0x66, 0x5b, 0x8c, 0x01, 0xe2, 0xfb, 0x33,
-	0xfe, 0x27, 0x82, 0xc9, 0xf4, 0x2e, 0x1d, 0x67, 0x8d, 0xcf, 0x96, 0xf3, 0x83, 0xfc, 0x1b, 0x3b,
-	0x94, 0x32, 0x64, 0x98, 0xfb, 0x8d, 0x03, 0xf0, 0x5f, 0x10, 0x8c, 0xa7, 0xb4, 0xe7, 0x78, 0x76,
-	0x50, 0x9c, 0x3d, 0x23, 0x83, 0x7c, 0x65, 0x27, 0x22, 0xa4, 0x9d, 0x73, 0xc2, 0xce, 0xdb, 0xf8,
-	0xe6, 0xc0, 0x76, 0xc6, 0x2d, 0x39, 0xfe, 0x1d, 0x82, 0xd1, 0xe4, 0x77, 0x1f, 0x7c, 0x63, 0xc0,
-	0x0b, 0x52, 0xe2, 0xe3, 0x53, 0xfe, 0xe6, 0x50, 0xbc, 0xd2, 0x9c, 0xdb, 0xc2, 0x9c, 0x6b, 0xf8,
-	0xea, 0x80, 0x65, 0x48, 0xad, 0x6d, 0xa8, 0xa6, 0x81, 0xff, 0x86, 0x60, 0x32, 0xbd, 0xef, 0xcf,
-	0x9c, 0x9d, 0x5b, 0x4e, 0x21, 0x32, 0x67, 0xe7, 0xd6, 0xc3, 0x07, 0x32, 0x2b, 0xcc, 0xbc, 0x89,
-	0xaf, 0x0f, 0xf0, 0x7e, 0x53, 0x35, 0x5f, 0x5e, 0x94, 0x97, 0x7f, 0x44, 0x70, 0xb4, 0xbb, 0x33,
-	0xc2, 0xaf, 0x0f, 0xd7, 0xf6, 0x44, 0xe6, 0xdd, 0x19, 0x9a, 0x5f, 0x1a, 0x76, 0x57, 0x18, 0x76,
-	0x03, 0x7f, 0xa5, 0x3c, 0xdc, 0x87, 0x65, 0x17, 0xff, 0x1d, 0xc1, 0x54, 0x9f, 0x86, 0x3f, 0x73,
-	0x59, 0xdd, 0x7a, 0x6c, 0x91, 0xb9, 0xac, 0x6e, 0x33, 0x77, 0x18, 0xf8, 0x9d, 0x29, 0x5e, 0x1e,
-	0x41, 0x14, 0xc3, 0x16, 0x1c, 0xff, 0x6a, 0x04, 0x3e, 0x9f, 0xa5, 0x1b, 0xc3, 0x4a, 0xd6, 0x62,
-	0x91, 0xbd, 0xb9, 0xcc, 0x3f, 0xdc, 0x55, 0x99, 0xd2, 0x2b, 0xa6, 0xf0, 0x8a, 0x8e, 0xb5, 0xac,
-	0x15, 0x29, 0xd1, 0x3d, 0xaa, 0x96, 0x69, 0x37, 0xd4, 0x55, 0x87, 0x35, 0xd5, 0x24, 0x53, 0xf9,
-	0x9d, 0xb4, 0xee, 0xf6, 0x19, 0xfe, 0x0f, 0x82, 0xc9, 0xf4, 0x7e, 0x30, 0xf3, 0x71, 0xdf, 0xb2,
-	0x3d, 0xcd, 0x7c, 0xdc, 0xb7, 0x6e, 0x4a, 0xc9, 0x03, 0xe1, 0x92, 0x37, 0x71, 0x35, 0xa3, 0x4b,
-	0x3c, 0x97, 0x3a, 0xaa, 0x17, 0xca, 0x53, 0xd3, 0xee, 0x5a, 0x9f, 0x20, 0x38, 0xd6, 0xd3, 0x48,
-	0xe2, 0xac, 0xe7, 0xb7, 0x5f, 0x7f, 0x9a, 0xbf, 0x3b, 0xbc, 0x80, 0x21, 0x0f, 0x45, 0x9d, 0x72,
-	0xb5, 0xab, 0xe9, 0x15, 0x57, 0xab, 0x3e, 0xcd, 0x59, 0xe6, 0x1a, 0xb0, 0x75, 0x47, 0x9b, 0xb9,
-	0x06, 0x6c, 0xd3, 0x23, 0x0e, 0x7c, 0xb5, 0xea, 0xdf, 0xac, 0x56, 0xd6, 0x9e, 0xbf, 0x28, 0xa0,
-	0x8f, 0x5f, 0x14, 0xd0, 0x9f, 0x5f, 0x14, 0xd0, 0x07, 0x2f, 0x0b, 0x7b, 0x3e, 0x7e, 0x59, 0xd8,
-	0xf3, 0xa7, 0x97, 0x85, 0x3d, 0xdf, 0x58, 0xda, 0xee, 0x1b, 0xd7, 0xe3, 0x4b, 0xd7, 0xcb, 0x4f,
-	0x3b, 0x34, 0x5f, 0x88, 0x55, 0xeb, 0x96, 0x49, 0x6d, 0x1e, 0xfc, 0x4f, 0x52, 0xf0, 0x0f, 0x04,
-	0x07, 0xc4, 0x9f, 0xcb, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x94, 0xed, 0x0f, 0xa7, 0x25,
-	0x00, 0x00,
+	0xf5, 0x4f, 0x4f, 0x3e, 0x36, 0xf3, 0xec, 0xd8, 0x4e, 0xd9, 0xb1, 0x9d, 0x76, 0x32, 0x93, 0xad,
+	0xff, 0x3f, 0xac, 0x45, 0x92, 0x19, 0xf2, 0x45, 0x88, 0xf3, 0xe9, 0xb1, 0xe3, 0x68, 0xb4, 0x8e,
+	0xe3, 0x74, 0x12, 0x58, 0x21, 0x44, 0x6f, 0x4f, 0x77, 0x79, 0xdc, 0x9a, 0x9e, 0xae, 0x71, 0x77,
+	0x75, 0x12, 0xb3, 0x44, 0x5a, 0xed, 0x1e, 0x91, 0x60, 0x11, 0x57, 0x84, 0x84, 0xb8, 0xa0, 0x15,
+	0x47, 0x2e, 0xc0, 0x01, 0xc1, 0x01, 0x45, 0x1c, 0x56, 0x2b, 0x21, 0x24, 0xb4, 0x87, 0x59, 0x48,
+	0x38, 0x20, 0x2d, 0x70, 0x30, 0x02, 0x71, 0x44, 0x5d, 0x5d, 0xdd, 0xd3, 0x33, 0xd3, 0xe3, 0xf4,
+	0xcc, 0x84, 0x13, 0x27, 0x4f, 0xf5, 0xab, 0xf7, 0xf1, 0x7b, 0xef, 0xd5, 0xeb, 0x57, 0xaf, 0x0d,
+	0x67, 0xa9, 0x5b, 0xa7, 0xae, 0xe9, 0x16, 0x75, 0x6a, 0xeb, 0xc4, 0x66, 0x8e, 0xc6, 0x88, 0x61,
+	0x99, 0x5b, 0x9e, 0x69, 0x98, 0x6c, 0xbb, 0xf8, 0xe8, 0x6c, 0x85, 0x30, 0xed, 0x6c, 0x71, 0xcb,
+	0x23, 0xce, 0x76, 0xa1, 0xe1, 0x50, 0x46, 0xd1, 0x49, 0xc1, 0x52, 0x48, 0x64, 0x29, 0x08, 0x16,
+	0x79, 0xaa, 0x4a, 0xab, 0x94, 0x73, 0x14, 0xfd, 0x5f, 0x01, 0xb3, 0xfc, 0xf9, 0xdd, 0xf5, 0x35,
+	0x34, 0x47, 0xab, 0xbb, 0x62, 0xef, 0xc5, 0x74, 0xb6, 0x31, 0x53, 0xaf, 0xa9, 0xa6, 0xbd, 0x11,
+	0xaa, 0xc8, 0xe9, 0x9c, 0xaf, 0x58, 0xd1, 0x5c, 0x12, 0x6d, 0xd2, 0xa9, 0x69, 0x87, 0x26, 0xc4,
+	0xe9, 0x1c, 0x58, 0xb4, 0xab, 0xa1, 0x55, 0x4d, 0x5b, 0x63, 0x26, 0x0d, 0xf7, 0x1e, 0xab, 0x52,
+	0x5a, 0xb5, 0x48, 0x51, 0x6b, 0x98, 0x45, 0xcd, 0xb6, 0x29, 0xe3, 0xc4, 0xd0, 0xc0, 0xa3, 0x82,
+	0xca, 0x57, 0x15, 0x6f, 0xa3, 0xa8, 0xd9, 0xdb, 0x21, 0x29, 0x50, 0xa2, 0x06, 0x0e, 0x08, 0x16,
+	0x82, 0x74, 0x21, 0x1d, 0xac, 0x06, 0x75, 0xcd, 0x98, 0x25, 0x57, 0xd3, 0x71, 0x99, 0x9c, 0x68,
+	0x3e, 0x22, 0xaa, 0x43, 0x74, 0xea, 0x18, 0x82, 0x7b, 0x31, 0x1d, 0xb7, 0xb1, 0x6d, 0x6b, 0x75,
+	0x53, 0x57, 0xdd, 0x86, 0x43, 0x34, 0x43, 0xdd, 0xd0, 0x74, 0x46, 0x9d, 0x40, 0x04, 0xfe, 0x99,
+	0x04, 0x53, 0x0f, 0x5d, 0xe2, 0xac, 0x0b, 0xbb, 0x5c, 0x85, 0x6c, 0x79, 0xc4, 0x65, 0xe8, 0x34,
+	0xbc, 0xa6, 0x19, 0x86, 0x43, 0x5c, 0x77, 0x56, 0x3a, 0x21, 0xcd, 0x67, 0x4b, 0x68, 0xa7, 0x99,
+	0x1f, 0xdb, 0xd6, 0xea, 0xd6, 0x02, 0x16, 0x04, 0xac, 0x84, 0x5b, 0xd0, 0x29, 0x78, 0xad, 0x41,
+	0xa9, 0xa5, 0x9a, 0xc6, 0x6c, 0xe6, 0x84, 0x34, 0xbf, 0x2f, 0xbe, 0x5b, 0x10, 0xb0, 0x72, 0xc0,
+	0xff, 0x55, 0x36, 0xd0, 0x0a, 0x40, 0x2b, 0x24, 0xb3, 0x7b, 0x4f, 0x48, 0xf3, 0x23, 0xe7, 0x3e,
+	0x57, 0x10, 0xde, 0xf4, 0xe3, 0x57, 0x08, 0x12, 0x53, 0xd8, 0x5f, 0x58, 0xd7, 0xaa, 0x44, 0x98,
+	0xa5, 0xc4, 0x38, 0xf1, 0xaf, 0x25, 0x38, 0xd2, 0x61, 0xbb, 0xdb, 0xa0, 0xb6, 0x4b, 0xd0, 0xdb,
+	0x90, 0x0d, 0x1d, 0xed, 0x9b, 0xbf, 0x77, 0x7e, 0xe4, 0xdc, 0xd5, 0x42, 0xaa, 0x04, 0x2f, 0xac,
+	0x78, 0x96, 0x15, 0x0a, 0x2c, 0x39, 0x44, 0xab, 0x19, 0xf4, 0xb1, 0x5d, 0xda, 0xf7, 0xac, 0x99,
+	0xdf, 0xa3, 0xb4, 0x84, 0xa2, 0xdb, 0x6d, 0x18, 0x32, 0x1c, 0xc3, 0x1b, 0x2f, 0xc5, 0x10, 0x98,
+	0xd7, 0x06, 0x62, 0x0d, 0x26, 0x23, 0x75, 0xdb, 0x65, 0x23, 0x74, 0xff, 0x25, 0x18, 0x09, 0x95,
+	0xf9, 0x4e, 0x95, 0xb8, 0x53, 0xa7, 0x77, 0x9a, 0x79, 0x14, 0x3a, 0x35, 0x22, 0x62, 0x05, 0xc2,
+	0x55, 0xd9, 0xc0, 0x8f, 0x60, 0xaa, 0x5d, 0x9e, 0x70, 0xc9, 0xd7, 0xe1, 0x60, 0xb8, 0x8b, 0x4b,
+	0x7b, 0x35, 0x1e, 0x89, 0x64, 0xe2, 0x15, 0x98, 0x59, 0xf3, 0xea, 0xeb, 0x94, 0x5a, 0x5d, 0xa9,
+	0x14, 0x4b, 0x0e, 0xe9, 0x65, 0xc9, 0x81, 0xbf, 0x06, 0xb3, 0xdd, 0x72, 0x04, 0x86, 0x9b, 0x30,
+	0x16, 0xe1, 0xd6, 0xa9, 0x67, 0x33, 0x21, 0xef, 0xe8, 0x4e, 0x33, 0x7f, 0xa4, 0xc3, 0x2f, 0x9c,
+	0x8e, 0x95, 0x43, 0xe1, 0x83, 0x25, 0xbe, 0xfe, 0x32, 0x8c, 0xfa, 0xa2, 0x23, 0xd3, 0x56, 0x12,
+	0xc2, 0x38, 0x48, 0x2a, 0x7e, 0x47, 0x82, 0x43, 0x42, 0xb0, 0xb0, 0xf5, 0x22, 0xec, 0xf7, 0x11,
+	0x85, 0xe9, 0x37, 0x55, 0x08, 0xaa, 0x4a, 0x21, 0xac, 0x2a, 0x85, 0x45, 0x7b, 0xbb, 0x94, 0xfd,
+	0xed, 0x4f, 0xcf, 0xec, 0xf7, 0xf9, 0xca, 0x4a, 0xb0, 0xfb, 0xd5, 0xe5, 0xd5, 0x38, 0x1c, 0x5a,
+	0xe7, 0x65, 0x57, 0x98, 0x8b, 0x1f, 0xc2, 0x58, 0xf8, 0x40, 0x98, 0xb8, 0x04, 0x07, 0x82, 0xca,
+	0x2c, 0x12, 0xe2, 0xe4, 0x4b, 0x12, 0x22, 0x60, 0x17, 0x91, 0x17, 0xac, 0xf8, 0x43, 0x09, 0x26,
+	0x1e, 0x98, 0x7a, 0x6d, 0x35, 0xdc, 0xb6, 0x46, 0x18, 0x7a, 0x1b, 0x0e, 0x45, 0x6c, 0xaa, 0x4d,
+	0x98, 0x28, 0x21, 0x57, 0x7c, 0xce, 0x4f, 0x9a, 0xf9, 0xb9, 0x00, 0x8f, 0x6b, 0xd4, 0x0a, 0x26,
+	0x2d, 0xd6, 0x35, 0xb6, 0x59, 0x58, 0x25, 0x55, 0x4d, 0xdf, 0x5e, 0x26, 0xfa, 0x4e, 0x33, 0x3f,
+	0x15, 0x84, 0xb2, 0x4d, 0x02, 0x56, 0x46, 0xad, 0xb8, 0x86, 0x0b, 0x00, 0xe2, 0x0d, 0x61, 0x90,
+	0x27, 0xdc, 0x4f, 0x7b, 0x4b, 0x47, 0x76, 0x9a, 0xf9, 0xc3, 0x01, 0x6f, 0x8b, 0x86, 0x95, 0xac,
+	0xbf, 0x28, 0xf3, 0xdf, 0x7f, 0x93, 0x60, 0x26, 0x32, 0x74, 0x99, 0x34, 0xd8, 0xe6, 0x57, 0x4c,
+	0xb6, 0xa9, 0x68, 0x76, 0x95, 0xa0, 0x0d, 0x98, 0x68, 0x69, 0xd4, 0xea, 0x51, 0x7a, 0x0d, 0x69,
+	0xf6, 0x78, 0xb4, 0x5e, 0xe4, 0x32, 0x7d, 0xcb, 0x2d, 0xfa, 0x98, 0x38, 0xaa, 0x6f, 0x56, 0xb7,
+	0xe5, 0x2d, 0x1a, 0x56, 0xb2, 0x7c, 0xe1, 0x7b, 0xd7, 0xe7, 0xf2, 0x1a, 0x8d, 0x90, 0x6b, 0x6f,
+	0x27, 0x57, 0x8b, 0x86, 0x95, 0x2c, 0x5f, 0xf8, 0x5c, 0xf8, 0xd3, 0x0c, 0xe4, 0xe2, 0x81, 0x29,
+	0xdb, 0xcb, 0xa6, 0x43, 0x74, 0x3f, 0x41, 0x06, 0x39, 0x9c, 0xa8, 0x00, 0x07, 0x19, 0xad, 0x11,
+	0x5b, 0x35, 0x83, 0xdc, 0xcc, 0x96, 0x26, 0x77, 0x9a, 0xf9, 0x71, 0xe1, 0x73, 0x41, 0xc1, 0xca,
+	0x6b, 0xfc, 0x67, 0xd9, 0xf6, 0xad, 0x76, 0x99, 0xe6, 0xb0, 0x1e, 0x56, 0xb7, 0x68, 0x58, 0xc9,
+	0xf2, 0x05, 0xc7, 0x7a, 0x19, 0x46, 0x3d, 0x97, 0xa8, 0xba, 0x27, 0xd0, 0xee, 0x3b, 0x21, 0xcd,
+	0x1f, 0x2c, 0xcd, 0xec, 0x34, 0xf3, 0x93, 0x02, 0x6d, 0x8c, 0x8a, 0x15, 0xf0, 0x5c, 0xb2, 0xe4,
+	0x45, 0x6e, 0xaa, 0x50, 0xcf, 0x36, 0x02, 0xc6, 0xfd, 0x9d, 0x0a, 0x5b, 0x34, 0xac, 0x64, 0xf9,
+	0x22, 0xae, 0xd0, 0xa6, 0x2a, 0x7f, 0x36, 0x7b, 0x20, 0x49, 0x61, 0x48, 0x0d, 0x14, 0xae, 0xd1,
+	0x12, 0x5f, 0xfc, 0x70, 0x2f, 0xe4, 0x7b, 0x7a, 0x58, 0x9c, 0xb3, 0xcd, 0x78, 0x66, 0x19, 0x7e,
+	0xd6, 0x85, 0x55, 0xe1, 0x52, 0xca, 0x12, 0xdc, 0x79, 0xc0, 0xc4, 0x19, 0x1c, 0xb7, 0xda, 0x72,
+	0xd9, 0x45, 0xaf, 0xc3, 0xa8, 0xee, 0x39, 0x0e, 0xb1, 0x59, 0x2c, 0xbb, 0x94, 0x11, 0xf1, 0x8c,
+	0x63, 0xb5, 0xe0, 0x70, 0xb8, 0x25, 0xe2, 0xe6, 0x91, 0xc9, 0x96, 0x6e, 0xa4, 0xcb, 0xf3, 0xd9,
+	0xc0, 0x27, 0x5d, 0x52, 0xb0, 0x32, 0x21, 0x9e, 0x45, 0xa6, 0xa2, 0xf7, 0x24, 0x40, 0xe1, 0x46,
+	0x77, 0xcb, 0x61, 0x6a, 0xc3, 0x31, 0x75, 0xc2, 0x23, 0x9a, 0x2d, 0x3d, 0x10, 0xfa, 0x8a, 0x55,
+	0x93, 0x6d, 0x7a, 0x95, 0x82, 0x4e, 0xeb, 0x45, 0xe1, 0x8f, 0x33, 0x96, 0x56, 0x71, 0xc3, 0x05,
+	0xff, 0xcb, 0xcd, 0x28, 0x99, 0xd5, 0xc0, 0x86, 0xa3, 0xed, 0x36, 0xb4, 0x44, 0xb7, 0x8c, 0xb8,
+	0xbf, 0xe5, 0xb0, 0x75, 0xfe, 0xe8, 0x4d, 0x38, 0x16, 0x59, 0xb4, 0x1e, 0x9c, 0x0c, 0x7e, 0xe4,
+	0x07, 0x7a, 0x3f, 0xfd, 0x52, 0x82, 0xe3, 0x3d, 0xa4, 0x89, 0x70, 0x57, 0x20, 0xdb, 0xf2, 0x6c,
+	0x10, 0xe7, 0xeb, 0x29, 0xe3, 0xdc, 0xa3, 0x36, 0x85, 0xed, 0x47, 0xc4, 0x80, 0x16, 0x60, 0xb4,
+	0xe2, 0xe9, 0x35, 0xc2, 0xda, 0x0a, 0x60, 0x2c, 0x63, 0xe3, 0x54, 0xac, 0x8c, 0x04, 0xcb, 0xa0,
+	0x08, 0xbe, 0x05, 0xc7, 0x97, 0x2c, 0xcd, 0xac, 0x6b, 0x15, 0x8b, 0xdc, 0xe7, 0x2d, 0xa1, 0x42,
+	0x1e, 0x6b, 0x8e, 0xe1, 0x0e, 0xdd, 0x7b, 0xfc, 0x40, 0x82, 0x5c, 0x2f, 0xd1, 0xc2, 0x39, 0xdf,
+	0x84, 0x59, 0x3d, 0xdc, 0x11, 0x36, 0xa4, 0x4e, 0xb0, 0x47, 0xf8, 0xea, 0x68, 0xdb, 0xdb, 0x2e,
+	0xf4, 0xcc, 0x12, 0x35, 0xed, 0xd2, 0x1b, 0xbe, 0x1b, 0x76, 0x9a, 0xf9, 0xbc, 0x88, 0x7e, 0x0f,
+	0x41, 0x58, 0x99, 0xd6, 0x13, 0xad, 0xc0, 0x0f, 0x41, 0x8e, 0xec, 0x2b, 0x87, 0x3d, 0xf5, 0xf0,
+	0xb8, 0xdf, 0xcf, 0xc0, 0x5c, 0xa2, 0x5c, 0x01, 0x7a, 0x0b, 0xa6, 0x5a, 0xb6, 0x46, 0xbd, 0x7c,
+	0x0a, 0xc0, 0xff, 0x27, 0x00, 0xcf, 0x75, 0x02, 0x6e, 0x09, 0xc1, 0xca, 0xa4, 0xde, 0xad, 0xda,
+	0x57, 0xb9, 0x41, 0x9d, 0x0d, 0x62, 0x32, 0x62, 0xc4, 0x55, 0x66, 0xfa, 0x54, 0x99, 0x24, 0x04,
+	0x2b, 0x93, 0xd1, 0xe3, 0x96, 0x4a, 0xbc, 0x0a, 0xc7, 0xfd, 0x56, 0x66, 0x51, 0xd7, 0xbd, 0xba,
+	0x67, 0x69, 0x8c, 0x3a, 0x1d, 0x79, 0xd5, 0xd7, 0x39, 0xfb, 0x55, 0x06, 0x72, 0xbd, 0xc4, 0x09,
+	0xb7, 0x7e, 0x20, 0xc1, 0x5c, 0x5b, 0xe4, 0xd5, 0xaa, 0x43, 0x1f, 0xb3, 0x4d, 0xb5, 0x6a, 0xd1,
+	0x8a, 0x66, 0x09, 0xf7, 0x1e, 0x4b, 0xc4, 0xba, 0x4c, 0x74, 0x0e, 0xf7, 0xbc, 0x0f, 0xf7, 0xc3,
+	0x4f, 0xf3, 0xa7, 0x62, 0x35, 0x28, 0xd8, 0x2f, 0xfe, 0x9c, 0x71, 0x8d, 0x5a, 0x91, 0x6d, 0x37,
+	0x88, 0x1b, 0xf2, 0xb8, 0xca, 0xac, 0x1b, 0xcb, 0xaa, 0xdb, 0x5c, 0xe7, 0x6d, 0xae, 0x12, 0x7d,
+	0x4b, 0x82, 0x29, 0xaf, 0xc1, 0xcc, 0x3a, 0xe9, 0xb0, 0x25, 0xf0, 0xfb, 0x85, 0x94, 0x75, 0xe0,
+	0x21, 0x17, 0xf1, 0xc0, 0xd1, 0xf4, 0x1a, 0x71, 0x3a, 0x43, 0x92, 0x24, 0x1f, 0x2b, 0x28, 0x78,
+	0x1c, 0xb7, 0x06, 0xbf, 0x2f, 0x41, 0xce, 0xaf, 0x4f, 0x31, 0x1f, 0x0a, 0x99, 0x03, 0xc5, 0x64,
+	0xc0, 0xa6, 0xeb, 0xb3, 0x0c, 0xe4, 0x7b, 0x5a, 0x21, 0x42, 0xf9, 0x4c, 0x82, 0xcb, 0x89, 0xa1,
+	0xa4, 0x0d, 0x7e, 0xce, 0x88, 0x6a, 0x84, 0xaf, 0x55, 0x95, 0x6e, 0xa8, 0x96, 0xe6, 0x32, 0x95,
+	0x39, 0xda, 0x23, 0xe2, 0xb8, 0xff, 0xcd, 0x40, 0x9f, 0xeb, 0x0e, 0xf4, 0x5d, 0x61, 0x50, 0xf4,
+	0x9a, 0xbf, 0xbb, 0xb1, 0xaa, 0xb9, 0xec, 0x41, 0x68, 0x0c, 0x7a, 0x0a, 0xe3, 0x22, 0x42, 0x4c,
+	0xa0, 0x1c, 0x2a, 0xf8, 0x39, 0x11, 0xfc, 0xe9, 0xb6, 0xe0, 0x87, 0xa2, 0xb1, 0x32, 0xe6, 0xc5,
+	0xb7, 0xbb, 0xf8, 0xdb, 0x12, 0xcc, 0x44, 0x87, 0x52, 0xe1, 0xd3, 0x82, 0xc1, 0x82, 0xfd, 0xaa,
+	0xae, 0x46, 0x1f, 0x49, 0x30, 0xdb, 0x6d, 0x90, 0x88, 0xbb, 0x09, 0x87, 0x3b, 0x67, 0x1b, 0x61,
+	0x59, 0xfc, 0x62, 0x4a, 0x77, 0x75, 0xc8, 0x16, 0xef, 0xca, 0x09, 0xb3, 0x43, 0xe5, 0xab, 0xbb,
+	0x59, 0xbd, 0x2b, 0xc1, 0xa9, 0xa5, 0x95, 0x3b, 0x77, 0xf8, 0xbd, 0xcd, 0x58, 0x35, 0xed, 0xda,
+	0x8a, 0x43, 0xeb, 0x4b, 0x31, 0x23, 0x03, 0x4a, 0xe8, 0xf5, 0x7b, 0x30, 0x15, 0x47, 0xa0, 0xb6,
+	0x87, 0x20, 0x1f, 0x2b, 0xef, 0x09, 0xbb, 0xb0, 0x82, 0xf4, 0x2e, 0xc9, 0xd8, 0x84, 0xd3, 0xe9,
+	0x2c, 0x10, 0x6e, 0xbe, 0x0c, 0xa3, 0xfa, 0x46, 0xbd, 0xde, 0xa1, 0x3a, 0xd6, 0x2e, 0xc4, 0xa9,
+	0x58, 0x01, 0x7f, 0x29, 0x54, 0xdd, 0x81, 0xe3, 0xfe, 0x8c, 0xe5, 0xa1, 0x5d, 0xa1, 0xb6, 0x61,
+	0xda, 0xd5, 0xe1, 0x06, 0x45, 0xf8, 0x47, 0x12, 0xe4, 0x7a, 0xc9, 0x13, 0xc6, 0xbe, 0x2b, 0x81,
+	0x1c, 0x0d, 0x5a, 0xd4, 0xc7, 0x26, 0xdb, 0x54, 0x1b, 0xc4, 0x31, 0xa9, 0xa1, 0x5a, 0x54, 0xaf,
+	0x89, 0xec, 0xb8, 0x96, 0x32, 0x3b, 0x42, 0xf1, 0x7e, 0x2f, 0xb5, 0xce, 0xa5, 0xac, 0x52, 0xbd,
+	0x26, 0x92, 0x64, 0x26, 0x52, 0xd3, 0x4e, 0xc6, 0x32, 0xcc, 0xde, 0x26, 0xec, 0x01, 0x65, 0x9a,
+	0x15, 0xb5, 0x64, 0xe1, 0x3d, 0xfa, 0xbb, 0x12, 0x1c, 0x4d, 0x20, 0x0a, 0xe3, 0x19, 0x8c, 0x33,
+	0x9f, 0xa2, 0x76, 0xb6, 0x80, 0xbb, 0xbc, 0x72, 0xbf, 0x20, 0x4a, 0xd3, 0x7c, 0x8a, 0xd2, 0x14,
+	0xd4, 0xa5, 0x31, 0xd6, 0xa6, 0x1d, 0xef, 0x48, 0x90, 0x5b, 0xf3, 0xea, 0x6b, 0xe4, 0x09, 0x2b,
+	0xdb, 0x26, 0x33, 0x35, 0xcb, 0xfc, 0x06, 0xe1, 0x77, 0x9b, 0xc1, 0xce, 0xfe, 0x0d, 0x18, 0x0b,
+	0x6f, 0x73, 0xaa, 0x41, 0x6c, 0x5a, 0x17, 0xb7, 0xbd, 0xd8, 0xa0, 0xa5, 0x9d, 0x8e, 0x95, 0x51,
+	0x71, 0xe7, 0x5b, 0xf6, 0x97, 0xa8, 0x02, 0xb2, 0xed, 0xd5, 0x55, 0x9b, 0x3c, 0xf1, 0x7b, 0xd0,
+	0xc8, 0x22, 0x7e, 0x2b, 0x71, 0xf9, 0x75, 0x63, 0x5f, 0xe9, 0xe4, 0x4e, 0x33, 0xff, 0x7a, 0x20,
+	0xac, 0xf7, 0x5e, 0xac, 0xcc, 0xd8, 0xc9, 0xc0, 0xf0, 0xf7, 0x33, 0x90, 0xef, 0x09, 0xfa, 0x7f,
+	0xfe, 0xea, 0xd5, 0xd6, 0xeb, 0xae, 0x9a, 0x75, 0x93, 0xdd, 0x75, 0x0c, 0xe2, 0x0c, 0xdd, 0xeb,
+	0xfe, 0x4b, 0x82, 0xb9, 0x44, 0xb9, 0xc2, 0xe3, 0x6f, 0xc1, 0x88, 0xe5, 0x3f, 0x55, 0xa9, 0xff,
+	0x58, 0x4c, 0x96, 0xce, 0xa6, 0xbe, 0xff, 0x84, 0xf2, 0x84, 0x9b, 0xc1, 0x8a, 0x9e, 0xa0, 0xa7,
+	0x90, 0x8d, 0x3a, 0xdd, 0x97, 0xf7, 0xb1, 0xcb, 0xe2, 0xbd, 0x39, 0xd1, 0xd1, 0x3a, 0xe3, 0xbe,
+	0x0e, 0x5a, 0x4b, 0x23, 0x2e, 0x83, 0xbc, 0x1c, 0x0c, 0xd2, 0x83, 0x3b, 0xc5, 0x0a, 0x1f, 0xa3,
+	0x0f, 0xd4, 0xdb, 0xfe, 0x53, 0x82, 0xb9, 0x44, 0x59, 0x51, 0x11, 0x39, 0x92, 0x38, 0xb3, 0x17,
+	0xde, 0x5c, 0x48, 0xe9, 0xcd, 0x04, 0x15, 0xc2, 0xad, 0x93, 0x46, 0x37, 0xc9, 0x1f, 0xda, 0xb5,
+	0x6b, 0xcb, 0x0c, 0x30, 0xfd, 0x6a, 0x93, 0x80, 0x95, 0x51, 0x37, 0xa6, 0xe1, 0xdc, 0x2f, 0x72,
+	0xb0, 0xff, 0x9e, 0xff, 0x92, 0x45, 0x3f, 0x96, 0x80, 0xcf, 0x3d, 0x5d, 0x74, 0x3e, 0x75, 0x21,
+	0x6f, 0x8d, 0x6d, 0xe5, 0x0b, 0xfd, 0x31, 0x05, 0x6e, 0xc5, 0x17, 0xde, 0xfb, 0xdd, 0x9f, 0xbf,
+	0x97, 0x29, 0xa0, 0xd3, 0xc5, 0xb4, 0xdf, 0x6a, 0x7c, 0x03, 0x7f, 0x22, 0xc1, 0x81, 0x60, 0xf2,
+	0x89, 0x52, 0xab, 0x8d, 0x0f, 0x5e, 0xe5, 0x8b, 0x7d, 0x72, 0x09, 0x6b, 0x2f, 0x72, 0x6b, 0x8b,
+	0xe8, 0x4c, 0x5a, 0x6b, 0x03, 0x1b, 0x3f, 0x92, 0xe0, 0x50, 0xdb, 0x47, 0x11, 0x74, 0x25, 0x6d,
+	0xdf, 0x99, 0xf0, 0x19, 0x48, 0xbe, 0x3a, 0x18, 0xb3, 0xc0, 0x50, 0xe2, 0x18, 0xae, 0xa2, 0x85,
+	0x62, 0x7f, 0x5f, 0xc7, 0xdc, 0xe2, 0x3b, 0xa2, 0x61, 0x78, 0x8a, 0x3e, 0x93, 0xe0, 0x48, 0xe2,
+	0xc0, 0x05, 0x2d, 0xf5, 0x3b, 0x55, 0x49, 0x18, 0xfe, 0xc8, 0xcb, 0xc3, 0x09, 0x11, 0x40, 0x6f,
+	0x73, 0xa0, 0x8b, 0xe8, 0x46, 0x4a, 0xa0, 0xd1, 0x13, 0x35, 0x9c, 0xdb, 0xaa, 0x0e, 0xc7, 0xf4,
+	0x8f, 0xf8, 0x84, 0xba, 0x7d, 0x9e, 0x88, 0x6e, 0xf5, 0x6b, 0x6a, 0xe2, 0xc4, 0x57, 0x5e, 0x19,
+	0x56, 0x8c, 0xc0, 0x5c, 0xe6, 0x98, 0x97, 0xd0, 0x62, 0xdf, 0x98, 0x6d, 0x3e, 0x99, 0x6a, 0x5d,
+	0xe9, 0xd0, 0xdf, 0x25, 0x98, 0x4e, 0x1e, 0x1c, 0xa1, 0xb4, 0xf1, 0xd9, 0x75, 0xa4, 0x25, 0xdf,
+	0x1a, 0x52, 0xca, 0x80, 0x61, 0xee, 0x35, 0xa1, 0x42, 0x7f, 0x92, 0x60, 0x32, 0x61, 0x62, 0x84,
+	0x16, 0xfb, 0xb5, 0xb3, 0x6b, 0x8a, 0x25, 0x97, 0x86, 0x11, 0x21, 0x70, 0x2e, 0x71, 0x9c, 0xd7,
+	0xd0, 0x95, 0xbe, 0x71, 0xb6, 0xa6, 0x44, 0xe8, 0x37, 0x92, 0xff, 0xb1, 0xad, 0xf5, 0x29, 0x12,
+	0x2d, 0xf4, 0xd9, 0xb3, 0xc7, 0xbe, 0x87, 0xca, 0x57, 0x06, 0xe2, 0x15, 0x70, 0xae, 0x71, 0x38,
+	0x97, 0xd0, 0xc5, 0x3e, 0xcb, 0x90, 0x5a, 0xd9, 0x56, 0x4d, 0x03, 0xfd, 0x45, 0x82, 0xe9, 0xe4,
+	0x51, 0x54, 0xea, 0xec, 0xdc, 0x75, 0x30, 0x26, 0xdf, 0x1a, 0x52, 0x8a, 0x80, 0xb9, 0xc8, 0x61,
+	0x5e, 0x41, 0x97, 0xfb, 0x78, 0xbf, 0xa9, 0x9a, 0x2f, 0x2f, 0xca, 0xcb, 0xdf, 0x4b, 0x30, 0xd1,
+	0x79, 0x59, 0x47, 0xd7, 0x07, 0xbb, 0x89, 0x47, 0xf0, 0x6e, 0x0c, 0xcc, 0x2f, 0x80, 0xdd, 0xe4,
+	0xc0, 0x16, 0xd0, 0x97, 0x8a, 0x83, 0xfd, 0xbb, 0x84, 0x8b, 0xfe, 0x2a, 0xc1, 0x4c, 0x8f, 0x19,
+	0x54, 0xea, 0xb2, 0xba, 0xfb, 0x24, 0x4d, 0x5e, 0x19, 0x56, 0xcc, 0x80, 0xef, 0x4c, 0xfe, 0xf2,
+	0x08, 0xa2, 0x18, 0x4e, 0x85, 0xd0, 0xcf, 0x33, 0xf0, 0xff, 0x69, 0x06, 0x04, 0x48, 0x49, 0x5b,
+	0x2c, 0xd2, 0xcf, 0x3b, 0xe4, 0xfb, 0xaf, 0x54, 0xa6, 0xf0, 0x8a, 0xc9, 0xbd, 0xa2, 0x23, 0x2d,
+	0x6d, 0x45, 0x8a, 0x0d, 0x34, 0x54, 0xcb, 0xb4, 0x6b, 0xea, 0x86, 0x43, 0xeb, 0x6a, 0x9c, 0xa9,
+	0xf8, 0x4e, 0xd2, 0xc0, 0xe5, 0x29, 0xfa, 0xb7, 0x04, 0xd3, 0xc9, 0x23, 0x8a, 0xd4, 0xc7, 0x7d,
+	0xd7, 0x89, 0x89, 0x7c, 0x6b, 0x48, 0x29, 0xc2, 0x25, 0xf7, 0xb8, 0x4b, 0xde, 0x44, 0xe5, 0x94,
+	0x2e, 0xf1, 0x5c, 0xe2, 0xa8, 0x5e, 0x28, 0x4f, 0x4d, 0xea, 0xb5, 0x3e, 0x91, 0xe0, 0x70, 0xd7,
+	0x6c, 0x03, 0xa5, 0x3d, 0xbf, 0xbd, 0x46, 0x26, 0xf2, 0xcd, 0xc1, 0x05, 0x0c, 0x78, 0x28, 0xaa,
+	0x84, 0xa9, 0x1d, 0x73, 0x18, 0xde, 0x5a, 0xf5, 0x98, 0x17, 0xa4, 0xae, 0x01, 0xbb, 0x0f, 0x59,
+	0xe4, 0x95, 0x61, 0xc5, 0x0c, 0xd8, 0x5a, 0xf5, 0x9e, 0x9f, 0xa0, 0xe7, 0xf1, 0x4e, 0xa3, 0x75,
+	0xbf, 0xee, 0xbf, 0xd3, 0xe8, 0x9a, 0x21, 0xc8, 0xa5, 0x61, 0x44, 0x08, 0xa4, 0xcb, 0x1c, 0xe9,
+	0x75, 0x74, 0xb5, 0xef, 0x4e, 0x23, 0x36, 0x65, 0xe0, 0x20, 0x13, 0x6e, 0xbb, 0xa9, 0x41, 0xf6,
+	0xbe, 0xd8, 0xcb, 0xa5, 0x61, 0x44, 0x0c, 0x08, 0x32, 0xf1, 0xf2, 0x5f, 0xda, 0x7c, 0xf6, 0x3c,
+	0x27, 0x7d, 0xfc, 0x3c, 0x27, 0xfd, 0xf1, 0x79, 0x4e, 0xfa, 0xe0, 0x45, 0x6e, 0xcf, 0xc7, 0x2f,
+	0x72, 0x7b, 0xfe, 0xf0, 0x22, 0xb7, 0xe7, 0xab, 0x6b, 0x2f, 0xfb, 0x80, 0xfe, 0xe8, 0xdc, 0xe5,
+	0xe2, 0x93, 0x36, 0xa5, 0x67, 0x5a, 0x5a, 0x75, 0xcb, 0x24, 0x36, 0x0b, 0xfe, 0x67, 0x32, 0xf8,
+	0xef, 0xa4, 0x03, 0xfc, 0xcf, 0xf9, 0xff, 0x0c, 0x00, 0x16, 0xd7, 0x07, 0x58, 0x47, 0x2a, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1962,6 +2167,12 @@ type QueryClient interface {
 	// NumNextInitializedTicks returns the provided number of next initialized
 	// ticks in the direction of swapping the token in denom.
 	NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error)
+	// ClaimableLimitOrder returns the limit order with the given position id
+	// along with the amount that can currently be claimed by its owner.
+	ClaimableLimitOrder(ctx context.Context, in *ClaimableLimitOrderRequest, opts ...grpc.CallOption) (*ClaimableLimitOrderResponse, error)
+	// DynamicSpreadFactor returns the dynamic spread factor state of the given
+	// pool, if it is enabled.
+	DynamicSpreadFactor(ctx context.Context, in *DynamicSpreadFactorRequest, opts ...grpc.CallOption) (*DynamicSpreadFactorResponse, error)
 }
 
 type queryClient struct {
@@ -2107,6 +2318,24 @@ func (c *queryClient) NumNextInitializedTicks(ctx context.Context, in *NumNextIn
 	return out, nil
 }
 
+func (c *queryClient) ClaimableLimitOrder(ctx context.Context, in *ClaimableLimitOrderRequest, opts ...grpc.CallOption) (*ClaimableLimitOrderResponse, error) {
+	out := new(ClaimableLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DynamicSpreadFactor(ctx context.Context, in *DynamicSpreadFactorRequest, opts ...grpc.CallOption) (*DynamicSpreadFactorResponse, error) {
+	out := new(DynamicSpreadFactorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/DynamicSpreadFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Pools returns all concentrated liquidity pools
@@ -2149,6 +2378,12 @@ type QueryServer interface {
 	// NumNextInitializedTicks returns the provided number of next initialized
 	// ticks in the direction of swapping the token in denom.
 	NumNextInitializedTicks(context.Context, *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error)
+	// ClaimableLimitOrder returns the limit order with the given position id
+	// along with the amount that can currently be claimed by its owner.
+	ClaimableLimitOrder(context.Context, *ClaimableLimitOrderRequest) (*ClaimableLimitOrderResponse, error)
+	// DynamicSpreadFactor returns the dynamic spread factor state of the given
+	// pool, if it is enabled.
+	DynamicSpreadFactor(context.Context, *DynamicSpreadFactorRequest) (*DynamicSpreadFactorResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2200,6 +2435,12 @@ func (*UnimplementedQueryServer) GetTotalLiquidity(ctx context.Context, req *Get
 func (*UnimplementedQueryServer) NumNextInitializedTicks(ctx context.Context, req *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NumNextInitializedTicks not implemented")
 }
+func (*UnimplementedQueryServer) ClaimableLimitOrder(ctx context.Context, req *ClaimableLimitOrderRequest) (*ClaimableLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableLimitOrder not implemented")
+}
+func (*UnimplementedQueryServer) DynamicSpreadFactor(ctx context.Context, req *DynamicSpreadFactorRequest) (*DynamicSpreadFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DynamicSpreadFactor not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2475,6 +2716,42 @@ func _Query_NumNextInitializedTicks_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClaimableLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimableLimitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableLimitOrder(ctx, req.(*ClaimableLimitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DynamicSpreadFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DynamicSpreadFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DynamicSpreadFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/DynamicSpreadFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DynamicSpreadFactor(ctx, req.(*DynamicSpreadFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2539,6 +2816,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NumNextInitializedTicks",
 			Handler:    _Query_NumNextInitializedTicks_Handler,
 		},
+		{
+			MethodName: "ClaimableLimitOrder",
+			Handler:    _Query_ClaimableLimitOrder_Handler,
+		},
+		{
+			MethodName: "DynamicSpreadFactor",
+			Handler:    _Query_DynamicSpreadFactor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/concentratedliquidity/v1beta1/query.proto",
@@ -3846,101 +4131,247 @@ func (m *NumNextInitializedTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *UserPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ClaimableLimitOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UserPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *ClaimableLimitOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PositionByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ClaimableLimitOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PositionByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClaimableLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Position.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NumPoolPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
+func (m *ClaimableLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NumPoolPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ClaimableLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PositionCount != 0 {
-		n += 1 + sovQuery(uint64(m.PositionCount))
-	}
-	return n
+	if len(m.Claimable) > 0 {
+		for iNdEx := len(m.Claimable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.LimitOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicSpreadFactorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSpreadFactorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSpreadFactorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicSpreadFactorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSpreadFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSpreadFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpreadFactor.Size()
+		i -= size
+		if _, err := m.SpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.DynamicSpreadFactor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UserPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *PositionByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *NumPoolPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *NumPoolPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionCount != 0 {
+		n += 1 + sovQuery(uint64(m.PositionCount))
+	}
+	return n
 }
 
 func (m *PoolsRequest) Size() (n int) {
@@ -4385,6 +4816,60 @@ func (m *NumNextInitializedTicksResponse) Size() (n int) {
 	return n
 }
 
+func (m *ClaimableLimitOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *ClaimableLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LimitOrder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Claimable) > 0 {
+		for _, e := range m.Claimable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DynamicSpreadFactorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *DynamicSpreadFactorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DynamicSpreadFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SpreadFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7677,6 +8162,378 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClaimableLimitOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimableLimitOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimableLimitOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimableLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimableLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimableLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimable = append(m.Claimable, types2.Coin{})
+			if err := m.Claimable[len(m.Claimable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicSpreadFactorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSpreadFactorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSpreadFactorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicSpreadFactorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSpreadFactorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSpreadFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSpreadFactor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v29/simulation/simtypes"
 	"github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/client/cli"
 	"github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/client/queryproto"
@@ -36,6 +37,7 @@ var (
 	_ module.HasConsensusVersion = AppModule{}
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasServices         = AppModule{}
+	_ appmodule.HasBeginBlocker  = AppModule{}
 )
 
 type AppModuleBasic struct {
@@ -130,6 +132,13 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// BeginBlock updates the spread factor of the pools with a dynamic spread factor.
+func (am AppModule) BeginBlock(context context.Context) error {
+	ctx := sdk.UnwrapSDKContext(context)
+	_ = osmoutils.ApplyFuncIfNoError(ctx, am.keeper.UpdateDynamicSpreadFactors)
+	return nil
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
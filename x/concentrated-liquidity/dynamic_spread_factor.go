@@ -0,0 +1,172 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/types"
+)
+
+// Pools opt in to a dynamic spread factor through governance. At the beginning of each block, the volatility of
+// every such pool is updated with the number of ticks it moved since the previous block:
+//
+//	volatility = volatility * volatility_decay + |current_tick - last_tick|
+//
+// and its spread factor is set to min(min_spread_factor + spread_factor_per_tick * volatility, max_spread_factor),
+// rounded down to an authorized spread factor. The min and max spread factors of the config must be authorized,
+// so the pool always charges an authorized spread factor within the bounds of its config. The spread factor is written to the pool itself, so that swaps and spread rewards charge the dynamic value.
+
+// SetDynamicSpreadFactors enables or updates the dynamic spread factor of the pools of the given configs,
+// and disables the dynamic spread factor of the given pools.
+func (k Keeper) SetDynamicSpreadFactors(ctx sdk.Context, configs []types.DynamicSpreadFactorConfig, disabledPoolIds []uint64) error {
+	for _, config := range configs {
+		if err := k.enableDynamicSpreadFactor(ctx, config); err != nil {
+			return err
+		}
+	}
+	for _, poolId := range disabledPoolIds {
+		if err := k.disableDynamicSpreadFactor(ctx, poolId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enableDynamicSpreadFactor enables the dynamic spread factor of the pool with the given config.
+// If it is already enabled, its config is replaced and its volatility is kept.
+func (k Keeper) enableDynamicSpreadFactor(ctx sdk.Context, config types.DynamicSpreadFactorConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	params := k.GetParams(ctx)
+	if !k.validateSpreadFactor(params, config.MinSpreadFactor) || !k.validateSpreadFactor(params, config.MaxSpreadFactor) {
+		return types.InvalidDynamicSpreadFactorConfigError{
+			PoolId: config.PoolId,
+			Reason: fmt.Sprintf("min and max spread factors must be authorized spread factors %s", params.AuthorizedSpreadFactors),
+		}
+	}
+	pool, err := k.getPoolById(ctx, config.PoolId)
+	if err != nil {
+		return err
+	}
+
+	dynamicSpreadFactor, err := k.GetDynamicSpreadFactor(ctx, config.PoolId)
+	if err != nil {
+		dynamicSpreadFactor = types.DynamicSpreadFactor{
+			StaticSpreadFactor: pool.GetSpreadFactor(ctx),
+			LastTick:           pool.GetCurrentTick(),
+			Volatility:         osmomath.ZeroDec(),
+		}
+	}
+	dynamicSpreadFactor.Config = config
+
+	pool.SetSpreadFactor(computeDynamicSpreadFactor(config, dynamicSpreadFactor.Volatility, params.AuthorizedSpreadFactors))
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
+	}
+	k.setDynamicSpreadFactor(ctx, dynamicSpreadFactor)
+	return nil
+}
+
+// disableDynamicSpreadFactor disables the dynamic spread factor of the given pool, restoring the spread factor
+// the pool had before it was enabled.
+func (k Keeper) disableDynamicSpreadFactor(ctx sdk.Context, poolId uint64) error {
+	dynamicSpreadFactor, err := k.GetDynamicSpreadFactor(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	pool.SetSpreadFactor(dynamicSpreadFactor.StaticSpreadFactor)
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Delete(types.KeyDynamicSpreadFactor(poolId))
+	return nil
+}
+
+// UpdateDynamicSpreadFactors updates the volatility and the spread factor of all pools with a dynamic spread factor
+// from the tick movement of the pool since the last update. It is called at the beginning of each block.
+func (k Keeper) UpdateDynamicSpreadFactors(ctx sdk.Context) error {
+	dynamicSpreadFactors, err := k.GetAllDynamicSpreadFactors(ctx)
+	if err != nil {
+		return err
+	}
+	authorizedSpreadFactors := k.GetParams(ctx).AuthorizedSpreadFactors
+
+	for _, dynamicSpreadFactor := range dynamicSpreadFactors {
+		pool, err := k.getPoolById(ctx, dynamicSpreadFactor.Config.PoolId)
+		if err != nil {
+			return err
+		}
+
+		currentTick := pool.GetCurrentTick()
+		tickMovement := currentTick - dynamicSpreadFactor.LastTick
+		if tickMovement < 0 {
+			tickMovement = -tickMovement
+		}
+		dynamicSpreadFactor.Volatility = dynamicSpreadFactor.Volatility.Mul(dynamicSpreadFactor.Config.VolatilityDecay).Add(osmomath.NewDec(tickMovement))
+		dynamicSpreadFactor.LastTick = currentTick
+
+		spreadFactor := computeDynamicSpreadFactor(dynamicSpreadFactor.Config, dynamicSpreadFactor.Volatility, authorizedSpreadFactors)
+		if !spreadFactor.Equal(pool.GetSpreadFactor(ctx)) {
+			pool.SetSpreadFactor(spreadFactor)
+			if err := k.setPool(ctx, pool); err != nil {
+				return err
+			}
+		}
+		k.setDynamicSpreadFactor(ctx, dynamicSpreadFactor)
+	}
+	return nil
+}
+
+// computeDynamicSpreadFactor returns the spread factor of a pool with the given config and volatility, rounded down
+// to the largest authorized spread factor within the bounds of the config. Falls back to the min spread factor
+// of the config if no authorized spread factor lies between it and the computed value.
+func computeDynamicSpreadFactor(config types.DynamicSpreadFactorConfig, volatility osmomath.Dec, authorizedSpreadFactors []osmomath.Dec) osmomath.Dec {
+	spreadFactor := config.MinSpreadFactor.Add(config.SpreadFactorPerTick.Mul(volatility))
+	if spreadFactor.GT(config.MaxSpreadFactor) {
+		spreadFactor = config.MaxSpreadFactor
+	}
+
+	roundedSpreadFactor := config.MinSpreadFactor
+	for _, authorizedSpreadFactor := range authorizedSpreadFactors {
+		if authorizedSpreadFactor.GT(roundedSpreadFactor) && authorizedSpreadFactor.LTE(spreadFactor) {
+			roundedSpreadFactor = authorizedSpreadFactor
+		}
+	}
+	return roundedSpreadFactor
+}
+
+// GetDynamicSpreadFactor returns the dynamic spread factor state of the given pool.
+// Returns error if the dynamic spread factor of the pool is not enabled.
+func (k Keeper) GetDynamicSpreadFactor(ctx sdk.Context, poolId uint64) (types.DynamicSpreadFactor, error) {
+	dynamicSpreadFactor := types.DynamicSpreadFactor{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyDynamicSpreadFactor(poolId), &dynamicSpreadFactor)
+	if err != nil {
+		return types.DynamicSpreadFactor{}, err
+	}
+	if !found {
+		return types.DynamicSpreadFactor{}, types.DynamicSpreadFactorNotFoundError{PoolId: poolId}
+	}
+	return dynamicSpreadFactor, nil
+}
+
+// GetAllDynamicSpreadFactors returns the dynamic spread factor state of all pools that enabled it.
+func (k Keeper) GetAllDynamicSpreadFactors(ctx sdk.Context) ([]types.DynamicSpreadFactor, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.DynamicSpreadFactorPrefix, func(value []byte) (types.DynamicSpreadFactor, error) {
+		dynamicSpreadFactor := types.DynamicSpreadFactor{}
+		err := k.cdc.Unmarshal(value, &dynamicSpreadFactor)
+		return dynamicSpreadFactor, err
+	})
+}
+
+func (k Keeper) setDynamicSpreadFactor(ctx sdk.Context, dynamicSpreadFactor types.DynamicSpreadFactor) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyDynamicSpreadFactor(dynamicSpreadFactor.Config.PoolId), &dynamicSpreadFactor)
+}
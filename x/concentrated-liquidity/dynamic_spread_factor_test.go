@@ -0,0 +1,174 @@
+package concentrated_liquidity_test
+
+import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/types"
+)
+
+var defaultStaticSpreadFactor = osmomath.MustNewDecFromStr("0.003")
+
+func defaultDynamicSpreadFactorConfig(poolId uint64) types.DynamicSpreadFactorConfig {
+	return types.DynamicSpreadFactorConfig{
+		PoolId:              poolId,
+		MinSpreadFactor:     osmomath.MustNewDecFromStr("0.001"),
+		MaxSpreadFactor:     osmomath.MustNewDecFromStr("0.005"),
+		SpreadFactorPerTick: osmomath.MustNewDecFromStr("0.000001"),
+		VolatilityDecay:     osmomath.MustNewDecFromStr("0.5"),
+	}
+}
+
+func (s *KeeperTestSuite) TestSetDynamicSpreadFactors() {
+	s.SetupTest()
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, defaultStaticSpreadFactor)
+	poolId := pool.GetId()
+	config := defaultDynamicSpreadFactorConfig(poolId)
+
+	// Disabling a pool that did not enable the dynamic spread factor fails.
+	err := s.App.ConcentratedLiquidityKeeper.SetDynamicSpreadFactors(s.Ctx, nil, []uint64{poolId})
+	s.Require().ErrorIs(err, types.DynamicSpreadFactorNotFoundError{PoolId: poolId})
+
+	// Enabling it for a pool that does not exist fails.
+	err = s.App.ConcentratedLiquidityKeeper.SetDynamicSpreadFactors(s.Ctx, []types.DynamicSpreadFactorConfig{defaultDynamicSpreadFactorConfig(poolId + 1)}, nil)
+	s.Require().Error(err)
+
+	// Enabling it with an invalid config fails.
+	invalidConfig := defaultDynamicSpreadFactorConfig(poolId)
+	invalidConfig.MinSpreadFactor = osmomath.MustNewDecFromStr("0.02")
+	err = s.App.ConcentratedLiquidityKeeper.SetDynamicSpreadFactors(s.Ctx, []types.DynamicSpreadFactorConfig{invalidConfig}, nil)
+	s.Require().Error(err)
+
+	// Enabling it with unauthorized min or max spread factors fails.
+	unauthorizedConfig := defaultDynamicSpreadFactorConfig(poolId)
+	unauthorizedConfig.MinSpreadFactor = osmomath.MustNewDecFromStr("0.0015")
+	err = s.App.ConcentratedLiquidityKeeper.SetDynamicSpreadFactors(s.Ctx, []types.DynamicSpreadFactorConfig{unauthorizedConfig}, nil)
+	s.Require().ErrorAs(err, &types.InvalidDynamicSpreadFactorConfigError{})
+	unauthorizedConfig = defaultDynamicSpreadFactorConfig(poolId)
+	unauthorizedConfig.MaxSpreadFactor = osmomath.MustNewDecFromStr("0.01")
+	err = s.App.ConcentratedLiquidityKeeper.SetDynamicSpreadFactors(s.Ctx, []types.DynamicSpreadFactorConfig{unauthorizedConfig}, nil)
+	s.Require().ErrorAs(err, &types.InvalidDynamicSpreadFactorConfigError{})
+
+	// Enabling it charges the minimum spread factor while the pool has not moved.
+	err = s.App.ConcentratedLiquidityKeeper.SetDynamicSpreadFactors(s.Ctx, []types.DynamicSpreadFactorConfig{config}, nil)
+	s.Require().NoError(err)
+
+	dynamicSpreadFactor, err := s.App.ConcentratedLiquidityKeeper.GetDynamicSpreadFactor(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DynamicSpreadFactor{
+		Config:             config,
+		StaticSpreadFactor: defaultStaticSpreadFactor,
+		LastTick:           pool.GetCurrentTick(),
+		Volatility:         osmomath.ZeroDec(),
+	}, dynamicSpreadFactor)
+	s.requirePoolSpreadFactor(poolId, config.MinSpreadFactor)
+
+	// Updating the config keeps the static spread factor and the volatility.
+	dynamicSpreadFactor.Volatility = osmomath.NewDec(1_000)
+	s.App.ConcentratedLiquidityKeeper.SetDynamicSpreadFactor(s.Ctx, dynamicSpreadFactor)
+	config.MinSpreadFactor = osmomath.MustNewDecFromStr("0.002")
+	err = s.App.ConcentratedLiquidityKeeper.SetDynamicSpreadFactors(s.Ctx, []types.DynamicSpreadFactorConfig{config}, nil)
+	s.Require().NoError(err)
+
+	dynamicSpreadFactor, err = s.App.ConcentratedLiquidityKeeper.GetDynamicSpreadFactor(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(config, dynamicSpreadFactor.Config)
+	s.Require().Equal(defaultStaticSpreadFactor, dynamicSpreadFactor.StaticSpreadFactor)
+	s.Require().Equal(osmomath.NewDec(1_000), dynamicSpreadFactor.Volatility)
+	// 0.002 + 0.000001 * 1000
+	s.requirePoolSpreadFactor(poolId, osmomath.MustNewDecFromStr("0.003"))
+
+	// Disabling it restores the static spread factor.
+	err = s.App.ConcentratedLiquidityKeeper.SetDynamicSpreadFactors(s.Ctx, nil, []uint64{poolId})
+	s.Require().NoError(err)
+	_, err = s.App.ConcentratedLiquidityKeeper.GetDynamicSpreadFactor(s.Ctx, poolId)
+	s.Require().ErrorIs(err, types.DynamicSpreadFactorNotFoundError{PoolId: poolId})
+	s.requirePoolSpreadFactor(poolId, defaultStaticSpreadFactor)
+}
+
+func (s *KeeperTestSuite) TestUpdateDynamicSpreadFactors() {
+	s.SetupTest()
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, defaultStaticSpreadFactor)
+	poolId := pool.GetId()
+	s.SetupDefaultPosition(poolId)
+	staticPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, defaultStaticSpreadFactor)
+
+	config := defaultDynamicSpreadFactorConfig(poolId)
+	err := s.App.ConcentratedLiquidityKeeper.SetDynamicSpreadFactors(s.Ctx, []types.DynamicSpreadFactorConfig{config}, nil)
+	s.Require().NoError(err)
+
+	// A block without any tick movement keeps the minimum spread factor.
+	err = s.App.ConcentratedLiquidityKeeper.UpdateDynamicSpreadFactors(s.Ctx)
+	s.Require().NoError(err)
+	s.requirePoolSpreadFactor(poolId, config.MinSpreadFactor)
+
+	// Moving the current tick increases the volatility by the number of ticks crossed.
+	tickBefore := s.getPoolCurrentTick(poolId)
+	s.swapForLimitOrderTest(poolId, limitOrderFillSwapUSDC, ETH)
+	tickMovement := s.getPoolCurrentTick(poolId) - tickBefore
+	s.Require().Positive(tickMovement)
+
+	err = s.App.ConcentratedLiquidityKeeper.UpdateDynamicSpreadFactors(s.Ctx)
+	s.Require().NoError(err)
+
+	volatility := osmomath.NewDec(tickMovement)
+	dynamicSpreadFactor, err := s.App.ConcentratedLiquidityKeeper.GetDynamicSpreadFactor(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(volatility, dynamicSpreadFactor.Volatility)
+	s.Require().Equal(s.getPoolCurrentTick(poolId), dynamicSpreadFactor.LastTick)
+	s.requirePoolSpreadFactor(poolId, s.expectedDynamicSpreadFactor(config, volatility))
+
+	// Without further movement the volatility decays.
+	err = s.App.ConcentratedLiquidityKeeper.UpdateDynamicSpreadFactors(s.Ctx)
+	s.Require().NoError(err)
+
+	volatility = volatility.Mul(config.VolatilityDecay)
+	dynamicSpreadFactor, err = s.App.ConcentratedLiquidityKeeper.GetDynamicSpreadFactor(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(volatility, dynamicSpreadFactor.Volatility)
+	s.requirePoolSpreadFactor(poolId, s.expectedDynamicSpreadFactor(config, volatility))
+
+	// The spread factor is rounded down to an authorized spread factor.
+	// 0.001 + 0.000001 * 1500 = 0.0025, rounded down to 0.002.
+	dynamicSpreadFactor.Volatility = osmomath.NewDec(1_500).Quo(config.VolatilityDecay)
+	dynamicSpreadFactor.LastTick = s.getPoolCurrentTick(poolId)
+	s.App.ConcentratedLiquidityKeeper.SetDynamicSpreadFactor(s.Ctx, dynamicSpreadFactor)
+	err = s.App.ConcentratedLiquidityKeeper.UpdateDynamicSpreadFactors(s.Ctx)
+	s.Require().NoError(err)
+	s.requirePoolSpreadFactor(poolId, osmomath.MustNewDecFromStr("0.002"))
+
+	// The spread factor is capped at the maximum.
+	dynamicSpreadFactor.Volatility = osmomath.NewDec(1_000_000)
+	s.App.ConcentratedLiquidityKeeper.SetDynamicSpreadFactor(s.Ctx, dynamicSpreadFactor)
+	err = s.App.ConcentratedLiquidityKeeper.UpdateDynamicSpreadFactors(s.Ctx)
+	s.Require().NoError(err)
+	s.requirePoolSpreadFactor(poolId, config.MaxSpreadFactor)
+
+	// Pools without a dynamic spread factor are untouched.
+	s.requirePoolSpreadFactor(staticPool.GetId(), defaultStaticSpreadFactor)
+}
+
+func (s *KeeperTestSuite) expectedDynamicSpreadFactor(config types.DynamicSpreadFactorConfig, volatility osmomath.Dec) osmomath.Dec {
+	spreadFactor := config.MinSpreadFactor.Add(config.SpreadFactorPerTick.Mul(volatility))
+	if spreadFactor.GT(config.MaxSpreadFactor) {
+		spreadFactor = config.MaxSpreadFactor
+	}
+
+	roundedSpreadFactor := config.MinSpreadFactor
+	for _, authorizedSpreadFactor := range s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx).AuthorizedSpreadFactors {
+		if authorizedSpreadFactor.GT(roundedSpreadFactor) && authorizedSpreadFactor.LTE(spreadFactor) {
+			roundedSpreadFactor = authorizedSpreadFactor
+		}
+	}
+	return roundedSpreadFactor
+}
+
+func (s *KeeperTestSuite) getPoolCurrentTick(poolId uint64) int64 {
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	return pool.GetCurrentTick()
+}
+
+func (s *KeeperTestSuite) requirePoolSpreadFactor(poolId uint64, expectedSpreadFactor osmomath.Dec) {
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedSpreadFactor, pool.GetSpreadFactor(s.Ctx))
+}
@@ -365,3 +365,7 @@ func ScaleDownSpreadRewardAmount(incentiveAmount osmomath.Int, scalingFactor osm
 func (k Keeper) RedepositForfeitedIncentives(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, scaledForfeitedIncentivesByUptime []sdk.Coins, totalForefeitedIncentives sdk.Coins) error {
 	return k.redepositForfeitedIncentives(ctx, poolId, owner, scaledForfeitedIncentivesByUptime, totalForefeitedIncentives)
 }
+
+func (k Keeper) SetDynamicSpreadFactor(ctx sdk.Context, dynamicSpreadFactor types.DynamicSpreadFactor) {
+	k.setDynamicSpreadFactor(ctx, dynamicSpreadFactor)
+}
@@ -101,6 +101,33 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 		}
 	}
 
+	// set limit orders, indexing the pending ones by their fill tick
+	for _, limitOrder := range genState.LimitOrders {
+		if _, ok := seenPoolIds[limitOrder.PoolId]; !ok {
+			panic(fmt.Sprintf("found limit order with pool id (%d) but there is no pool with such id that exists", limitOrder.PoolId))
+		}
+
+		k.setLimitOrder(ctx, limitOrder)
+		if !limitOrder.Filled {
+			pool, err := k.getPoolById(ctx, limitOrder.PoolId)
+			if err != nil {
+				panic(err)
+			}
+			k.setPendingLimitOrderTick(ctx, limitOrder, limitOrder.TokenInDenom == pool.GetToken0())
+		}
+	}
+
+	// set dynamic spread factors
+	for _, dynamicSpreadFactor := range genState.DynamicSpreadFactors {
+		if _, ok := seenPoolIds[dynamicSpreadFactor.Config.PoolId]; !ok {
+			panic(fmt.Sprintf("found dynamic spread factor with pool id (%d) but there is no pool with such id that exists", dynamicSpreadFactor.Config.PoolId))
+		}
+		if err := dynamicSpreadFactor.Config.Validate(); err != nil {
+			panic(err)
+		}
+		k.setDynamicSpreadFactor(ctx, dynamicSpreadFactor)
+	}
+
 	// set total liquidity
 	k.setTotalLiquidity(ctx, totalLiquidity)
 
@@ -245,6 +272,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		panic(err)
 	}
 
+	limitOrders, err := k.GetAllLimitOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	dynamicSpreadFactors, err := k.GetAllDynamicSpreadFactors(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &genesis.GenesisState{
 		Params:                k.GetParams(ctx),
 		PoolData:              poolData,
@@ -253,6 +290,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		NextIncentiveRecordId: k.GetNextIncentiveRecordId(ctx),
 		IncentivesAccumulatorPoolIdMigrationThreshold: incentivesAccumulatorPoolIDMigrationThreshold,
 		SpreadFactorPoolIdMigrationThreshold:          spreadFactorPoolIdMigrationThreshold,
+		LimitOrders:                                   limitOrders,
+		DynamicSpreadFactors:                          dynamicSpreadFactors,
 	}
 }
 
@@ -0,0 +1,344 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/types"
+)
+
+// PlaceLimitOrder creates a limit order position in the given pool.
+// A limit order is a regular position spanning exactly one tick spacing, starting at tickIndex,
+// that is funded with a single pool asset. Selling token0 requires the order to be fully above
+// the current tick while selling token1 requires the order to be fully below the current tick.
+// Once a swap moves the current tick across the whole range of the order, the order is filled:
+// its liquidity is withdrawn from the pool so that it stops providing liquidity and the
+// converted tokens are moved to the pool's limit order escrow until claimed.
+// Returns error if:
+// - the pool does not exist
+// - the token in is not one of the pool tokens
+// - the order would be in range or immediately fillable at the current tick
+// - creating the underlying position fails
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, tickIndex int64, tokenIn sdk.Coin) (CreatePositionData, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	if tokenIn.Denom != pool.GetToken0() && tokenIn.Denom != pool.GetToken1() {
+		return CreatePositionData{}, types.TokenInDenomNotInPoolError{TokenInDenom: tokenIn.Denom}
+	}
+
+	isToken0 := tokenIn.Denom == pool.GetToken0()
+	lowerTick := tickIndex
+	upperTick := tickIndex + int64(pool.GetTickSpacing())
+
+	// A position is single-sided in token0 only if the current tick is below its lower tick
+	// and single-sided in token1 only if the current tick is at or above its upper tick.
+	currentTick := pool.GetCurrentTick()
+	if (isToken0 && lowerTick <= currentTick) || (!isToken0 && upperTick > currentTick) {
+		return CreatePositionData{}, types.LimitOrderInRangeError{TickIndex: tickIndex, CurrentTick: currentTick, TokenIn: tokenIn.Denom}
+	}
+
+	positionData, err := k.CreatePosition(ctx, poolId, owner, sdk.NewCoins(tokenIn), osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	limitOrder := model.LimitOrder{
+		PositionId:   positionData.ID,
+		Owner:        owner.String(),
+		PoolId:       poolId,
+		LowerTick:    positionData.LowerTick,
+		UpperTick:    positionData.UpperTick,
+		TokenInDenom: tokenIn.Denom,
+	}
+	k.setLimitOrder(ctx, limitOrder)
+	k.setPendingLimitOrderTick(ctx, limitOrder, isToken0)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPlaceLimitOrder,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionData.ID, 10)),
+			sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(positionData.LowerTick, 10)),
+			sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(positionData.UpperTick, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, tokenIn.String()),
+		),
+	})
+
+	return positionData, nil
+}
+
+// CancelLimitOrder withdraws all liquidity of a limit order that has not been filled yet
+// and sends the underlying amounts to the owner. Note that the order might have been partially
+// converted if the current tick is within its range.
+// Returns error if the order does not exist, the sender is not its owner or it is already filled.
+func (k Keeper) CancelLimitOrder(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (amount0, amount1 osmomath.Int, err error) {
+	limitOrder, err := k.GetLimitOrder(ctx, positionId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	if limitOrder.Owner != owner.String() {
+		return osmomath.Int{}, osmomath.Int{}, types.NotLimitOrderOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	if limitOrder.Filled {
+		return osmomath.Int{}, osmomath.Int{}, types.LimitOrderAlreadyFilledError{PositionId: positionId}
+	}
+
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	pool, err := k.getPoolById(ctx, limitOrder.PoolId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	amount0, amount1, err = k.withdrawPosition(ctx, owner, positionId, position.Liquidity, owner)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	k.deletePendingLimitOrderTick(ctx, limitOrder, limitOrder.TokenInDenom == pool.GetToken0())
+	k.deleteLimitOrder(ctx, positionId)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCancelLimitOrder,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(limitOrder.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeAmount0, amount0.String()),
+			sdk.NewAttribute(types.AttributeAmount1, amount1.String()),
+		),
+	})
+
+	return amount0, amount1, nil
+}
+
+// ClaimLimitOrder sends the proceeds of a filled limit order from the pool's limit order escrow
+// to the owner and removes the order from state.
+// An order that was fully crossed but left pending because of the swap fill bounds is filled first.
+// Returns error if the order does not exist, the sender is not its owner or it is not filled yet.
+func (k Keeper) ClaimLimitOrder(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (sdk.Coins, error) {
+	limitOrder, err := k.GetLimitOrder(ctx, positionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if limitOrder.Owner != owner.String() {
+		return nil, types.NotLimitOrderOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	if !limitOrder.Filled {
+		crossed, err := k.isLimitOrderCrossed(ctx, limitOrder)
+		if err != nil {
+			return nil, err
+		}
+		if !crossed {
+			return nil, types.LimitOrderNotFilledError{PositionId: positionId}
+		}
+
+		if err := k.fillLimitOrder(ctx, positionId); err != nil {
+			return nil, err
+		}
+		if limitOrder, err = k.GetLimitOrder(ctx, positionId); err != nil {
+			return nil, err
+		}
+	}
+
+	if !limitOrder.Proceeds.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, types.GetLimitOrderEscrowAddress(limitOrder.PoolId), owner, limitOrder.Proceeds); err != nil {
+			return nil, err
+		}
+	}
+
+	k.deleteLimitOrder(ctx, positionId)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtClaimLimitOrder,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(limitOrder.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, limitOrder.Proceeds.String()),
+		),
+	})
+
+	return limitOrder.Proceeds, nil
+}
+
+// fillCrossedLimitOrders fills the pending limit orders of the given pool that were fully crossed
+// by a swap moving the current tick from oldTick to newTick.
+// When the tick increases, token0 orders with an upper tick at or below the new tick are filled.
+// When the tick decreases, token1 orders with a lower tick above the new tick are filled.
+// Orders are filled closest to the old tick first. At most types.MaxLimitOrdersFilledPerSwap orders
+// are considered and no new fill is started once the fills consumed types.MaxLimitOrderFillGasPerSwap
+// gas, so that placing many orders cannot inflate the swapper's gas cost.
+// Crossed orders left pending are filled by subsequent swaps or when claimed.
+// Failing to fill an order does not fail the swap, the order is left pending instead.
+func (k Keeper) fillCrossedLimitOrders(ctx sdk.Context, poolId uint64, oldTick, newTick int64) {
+	if oldTick == newTick {
+		return
+	}
+
+	isToken0 := newTick > oldTick
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyLimitOrderTickPrefix(poolId, isToken0))
+
+	var iter storetypes.Iterator
+	if isToken0 {
+		iter = prefixStore.Iterator(nil, types.TickIndexToBytes(newTick+1))
+	} else {
+		iter = prefixStore.ReverseIterator(types.TickIndexToBytes(newTick+1), nil)
+	}
+
+	positionIds := []uint64{}
+	for ; iter.Valid() && len(positionIds) < types.MaxLimitOrdersFilledPerSwap; iter.Next() {
+		positionIds = append(positionIds, sdk.BigEndianToUint64(iter.Value()))
+	}
+	iter.Close()
+
+	startGas := ctx.GasMeter().GasConsumed()
+	for _, positionId := range positionIds {
+		if ctx.GasMeter().GasConsumed()-startGas >= types.MaxLimitOrderFillGasPerSwap {
+			break
+		}
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.fillLimitOrder(ctx, positionId)
+		})
+	}
+}
+
+// fillLimitOrder withdraws the full liquidity of the given limit order into the pool's
+// limit order escrow and marks the order as filled.
+func (k Keeper) fillLimitOrder(ctx sdk.Context, positionId uint64) error {
+	limitOrder, err := k.GetLimitOrder(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	pool, err := k.getPoolById(ctx, limitOrder.PoolId)
+	if err != nil {
+		return err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(limitOrder.Owner)
+	if err != nil {
+		return err
+	}
+
+	amount0, amount1, err := k.withdrawPosition(ctx, owner, positionId, position.Liquidity, types.GetLimitOrderEscrowAddress(limitOrder.PoolId))
+	if err != nil {
+		return err
+	}
+
+	k.deletePendingLimitOrderTick(ctx, limitOrder, limitOrder.TokenInDenom == pool.GetToken0())
+	limitOrder.Filled = true
+	limitOrder.Proceeds = sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1))
+	k.setLimitOrder(ctx, limitOrder)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtFillLimitOrder,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(limitOrder.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, limitOrder.Proceeds.String()),
+		),
+	})
+
+	return nil
+}
+
+// isLimitOrderCrossed returns true if the current tick of the order's pool is past its fill tick.
+func (k Keeper) isLimitOrderCrossed(ctx sdk.Context, limitOrder model.LimitOrder) (bool, error) {
+	pool, err := k.getPoolById(ctx, limitOrder.PoolId)
+	if err != nil {
+		return false, err
+	}
+
+	if limitOrder.TokenInDenom == pool.GetToken0() {
+		return pool.GetCurrentTick() >= limitOrder.UpperTick, nil
+	}
+	return pool.GetCurrentTick() < limitOrder.LowerTick, nil
+}
+
+// GetLimitOrder returns the limit order backed by the given position id.
+func (k Keeper) GetLimitOrder(ctx sdk.Context, positionId uint64) (model.LimitOrder, error) {
+	store := ctx.KVStore(k.storeKey)
+	limitOrder := model.LimitOrder{}
+	found, err := osmoutils.Get(store, types.KeyLimitOrder(positionId), &limitOrder)
+	if err != nil {
+		return model.LimitOrder{}, err
+	}
+	if !found {
+		return model.LimitOrder{}, types.LimitOrderNotFoundError{PositionId: positionId}
+	}
+	return limitOrder, nil
+}
+
+// GetAllLimitOrders returns all pending and unclaimed limit orders.
+func (k Keeper) GetAllLimitOrders(ctx sdk.Context) ([]model.LimitOrder, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.LimitOrderPrefix, func(value []byte) (model.LimitOrder, error) {
+		limitOrder := model.LimitOrder{}
+		err := k.cdc.Unmarshal(value, &limitOrder)
+		return limitOrder, err
+	})
+}
+
+// isLimitOrder returns true if the given position backs a limit order.
+func (k Keeper) isLimitOrder(ctx sdk.Context, positionId uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KeyLimitOrder(positionId))
+}
+
+// setLimitOrder stores the given limit order.
+func (k Keeper) setLimitOrder(ctx sdk.Context, limitOrder model.LimitOrder) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyLimitOrder(limitOrder.PositionId), &limitOrder)
+}
+
+// deleteLimitOrder removes the given limit order from state.
+func (k Keeper) deleteLimitOrder(ctx sdk.Context, positionId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyLimitOrder(positionId))
+}
+
+// setPendingLimitOrderTick indexes the given pending limit order by its fill tick so that
+// it can be found when a swap crosses it.
+func (k Keeper) setPendingLimitOrderTick(ctx sdk.Context, limitOrder model.LimitOrder, isToken0 bool) {
+	ctx.KVStore(k.storeKey).Set(limitOrderTickKey(limitOrder, isToken0), sdk.Uint64ToBigEndian(limitOrder.PositionId))
+}
+
+// deletePendingLimitOrderTick removes the fill tick index entry of the given limit order.
+func (k Keeper) deletePendingLimitOrderTick(ctx sdk.Context, limitOrder model.LimitOrder, isToken0 bool) {
+	ctx.KVStore(k.storeKey).Delete(limitOrderTickKey(limitOrder, isToken0))
+}
+
+// limitOrderTickKey returns the fill tick index key of the given limit order.
+// Token0 orders are filled once the current tick reaches their upper tick
+// while token1 orders are filled once the current tick drops below their lower tick.
+func limitOrderTickKey(limitOrder model.LimitOrder, isToken0 bool) []byte {
+	fillTick := limitOrder.LowerTick
+	if isToken0 {
+		fillTick = limitOrder.UpperTick
+	}
+	return types.KeyLimitOrderTick(limitOrder.PoolId, isToken0, fillTick, limitOrder.PositionId)
+}
@@ -0,0 +1,232 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/types"
+)
+
+var (
+	defaultLimitOrderETH  = sdk.NewCoin(ETH, osmomath.NewInt(1_000))
+	defaultLimitOrderUSDC = sdk.NewCoin(USDC, osmomath.NewInt(1_000_000))
+	// large enough to cross a default limit order and move the current tick of the default position
+	// by more than two tick spacings.
+	limitOrderFillSwapUSDC = sdk.NewCoin(USDC, osmomath.NewInt(20_000_000))
+	limitOrderFillSwapETH  = sdk.NewCoin(ETH, osmomath.NewInt(10_000))
+)
+
+func (s *KeeperTestSuite) swapForLimitOrderTest(poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) {
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(tokenIn))
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	_, err = s.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool, tokenIn, tokenOutDenom, osmomath.OneInt(), pool.GetSpreadFactor(s.Ctx))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestPlaceLimitOrder() {
+	tests := map[string]struct {
+		tickIndex     int64
+		tokenIn       sdk.Coin
+		expectedError error
+	}{
+		"token0 above current tick": {
+			tickIndex: DefaultCurrTick + int64(DefaultTickSpacing),
+			tokenIn:   defaultLimitOrderETH,
+		},
+		"token1 below current tick": {
+			tickIndex: DefaultCurrTick - 2*int64(DefaultTickSpacing),
+			tokenIn:   defaultLimitOrderUSDC,
+		},
+		"error: token0 at current tick": {
+			tickIndex:     DefaultCurrTick,
+			tokenIn:       defaultLimitOrderETH,
+			expectedError: types.LimitOrderInRangeError{TickIndex: DefaultCurrTick, CurrentTick: DefaultCurrTick, TokenIn: ETH},
+		},
+		"error: token1 range containing current tick": {
+			tickIndex:     DefaultCurrTick - int64(DefaultTickSpacing)/2,
+			tokenIn:       defaultLimitOrderUSDC,
+			expectedError: types.LimitOrderInRangeError{TickIndex: DefaultCurrTick - int64(DefaultTickSpacing)/2, CurrentTick: DefaultCurrTick, TokenIn: USDC},
+		},
+		"error: token not in pool": {
+			tickIndex:     DefaultCurrTick + int64(DefaultTickSpacing),
+			tokenIn:       sdk.NewCoin(FOO, defaultLimitOrderUSDC.Amount),
+			expectedError: types.TokenInDenomNotInPoolError{TokenInDenom: FOO},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+
+			owner := s.TestAccs[1]
+			s.FundAcc(owner, sdk.NewCoins(tc.tokenIn))
+
+			positionData, err := s.App.ConcentratedLiquidityKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, tc.tickIndex, tc.tokenIn)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.tickIndex, positionData.LowerTick)
+			s.Require().Equal(tc.tickIndex+int64(DefaultTickSpacing), positionData.UpperTick)
+
+			limitOrder, err := s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, positionData.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(owner.String(), limitOrder.Owner)
+			s.Require().Equal(tc.tokenIn.Denom, limitOrder.TokenInDenom)
+			s.Require().False(limitOrder.Filled)
+
+			// The underlying position can only be managed through the limit order methods.
+			_, _, err = s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, owner, positionData.ID, positionData.Liquidity)
+			s.Require().ErrorIs(err, types.LimitOrderPositionError{PositionId: positionData.ID})
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestFillAndClaimLimitOrder() {
+	tests := map[string]struct {
+		tickIndex     int64
+		tokenIn       sdk.Coin
+		swapTokenIn   sdk.Coin
+		expectedDenom string
+	}{
+		"token0 order filled by swapping token1 in": {
+			tickIndex:     DefaultCurrTick + int64(DefaultTickSpacing),
+			tokenIn:       defaultLimitOrderETH,
+			swapTokenIn:   limitOrderFillSwapUSDC,
+			expectedDenom: USDC,
+		},
+		"token1 order filled by swapping token0 in": {
+			tickIndex:     DefaultCurrTick - 2*int64(DefaultTickSpacing),
+			tokenIn:       defaultLimitOrderUSDC,
+			swapTokenIn:   limitOrderFillSwapETH,
+			expectedDenom: ETH,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+
+			owner := s.TestAccs[1]
+			s.FundAcc(owner, sdk.NewCoins(tc.tokenIn))
+			positionData, err := s.App.ConcentratedLiquidityKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, tc.tickIndex, tc.tokenIn)
+			s.Require().NoError(err)
+
+			// Claiming before the order is filled fails.
+			_, err = s.App.ConcentratedLiquidityKeeper.ClaimLimitOrder(s.Ctx, owner, positionData.ID)
+			s.Require().ErrorIs(err, types.LimitOrderNotFilledError{PositionId: positionData.ID})
+
+			tokenOutDenom := ETH
+			if tc.swapTokenIn.Denom == ETH {
+				tokenOutDenom = USDC
+			}
+			s.swapForLimitOrderTest(pool.GetId(), tc.swapTokenIn, tokenOutDenom)
+
+			limitOrder, err := s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, positionData.ID)
+			s.Require().NoError(err)
+			s.Require().True(limitOrder.Filled)
+			s.Require().True(limitOrder.Proceeds.AmountOf(tc.tokenIn.Denom).IsZero())
+			s.Require().True(limitOrder.Proceeds.AmountOf(tc.expectedDenom).IsPositive())
+
+			// The filled position no longer exists and the proceeds are escrowed.
+			_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionData.ID)
+			s.Require().Error(err)
+			escrowBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, types.GetLimitOrderEscrowAddress(pool.GetId()))
+			s.Require().Equal(limitOrder.Proceeds, escrowBalance)
+
+			// Filled orders can no longer be canceled.
+			_, _, err = s.App.ConcentratedLiquidityKeeper.CancelLimitOrder(s.Ctx, owner, positionData.ID)
+			s.Require().ErrorIs(err, types.LimitOrderAlreadyFilledError{PositionId: positionData.ID})
+
+			// Only the owner can claim.
+			_, err = s.App.ConcentratedLiquidityKeeper.ClaimLimitOrder(s.Ctx, s.TestAccs[0], positionData.ID)
+			s.Require().ErrorIs(err, types.NotLimitOrderOwnerError{PositionId: positionData.ID, Address: s.TestAccs[0].String()})
+
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			claimed, err := s.App.ConcentratedLiquidityKeeper.ClaimLimitOrder(s.Ctx, owner, positionData.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(limitOrder.Proceeds, claimed)
+			s.Require().Equal(ownerBalanceBefore.Add(claimed...), s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+
+			_, err = s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, positionData.ID)
+			s.Require().ErrorIs(err, types.LimitOrderNotFoundError{PositionId: positionData.ID})
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelLimitOrder() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	owner := s.TestAccs[1]
+	tokenIn := defaultLimitOrderETH
+	s.FundAcc(owner, sdk.NewCoins(tokenIn))
+	positionData, err := s.App.ConcentratedLiquidityKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, DefaultCurrTick+int64(DefaultTickSpacing), tokenIn)
+	s.Require().NoError(err)
+
+	// Only the owner can cancel.
+	_, _, err = s.App.ConcentratedLiquidityKeeper.CancelLimitOrder(s.Ctx, s.TestAccs[0], positionData.ID)
+	s.Require().ErrorIs(err, types.NotLimitOrderOwnerError{PositionId: positionData.ID, Address: s.TestAccs[0].String()})
+
+	amount0, amount1, err := s.App.ConcentratedLiquidityKeeper.CancelLimitOrder(s.Ctx, owner, positionData.ID)
+	s.Require().NoError(err)
+	s.Require().True(amount1.IsZero())
+	// Rounding in favor of the pool may leave up to one unit behind.
+	s.Require().True(tokenIn.Amount.Sub(amount0).LTE(osmomath.OneInt()))
+	s.Require().Equal(amount0, s.App.BankKeeper.GetBalance(s.Ctx, owner, ETH).Amount)
+
+	_, err = s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, positionData.ID)
+	s.Require().ErrorIs(err, types.LimitOrderNotFoundError{PositionId: positionData.ID})
+
+	// A swap crossing the canceled order's range does not touch it anymore.
+	s.swapForLimitOrderTest(pool.GetId(), limitOrderFillSwapUSDC, ETH)
+	limitOrders, err := s.App.ConcentratedLiquidityKeeper.GetAllLimitOrders(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(limitOrders)
+}
+
+// Tests that a swap stops filling crossed limit orders once the fill gas bound is reached
+// and that the orders left pending are filled when claimed.
+func (s *KeeperTestSuite) TestFillCrossedLimitOrdersGasBound() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	owner := s.TestAccs[1]
+	tokenIn := sdk.NewCoin(ETH, osmomath.NewInt(10))
+	positionIds := make([]uint64, types.MaxLimitOrdersFilledPerSwap)
+	for i := range positionIds {
+		s.FundAcc(owner, sdk.NewCoins(tokenIn))
+		positionData, err := s.App.ConcentratedLiquidityKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, DefaultCurrTick+int64(DefaultTickSpacing), tokenIn)
+		s.Require().NoError(err)
+		positionIds[i] = positionData.ID
+	}
+
+	s.swapForLimitOrderTest(pool.GetId(), limitOrderFillSwapUSDC, ETH)
+
+	pendingIds := []uint64{}
+	for _, positionId := range positionIds {
+		limitOrder, err := s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, positionId)
+		s.Require().NoError(err)
+		if !limitOrder.Filled {
+			pendingIds = append(pendingIds, positionId)
+		}
+	}
+	s.Require().NotEmpty(pendingIds)
+	s.Require().Less(len(pendingIds), len(positionIds))
+
+	// A crossed order left pending is filled at claim time.
+	claimed, err := s.App.ConcentratedLiquidityKeeper.ClaimLimitOrder(s.Ctx, owner, pendingIds[0])
+	s.Require().NoError(err)
+	s.Require().True(claimed.AmountOf(ETH).IsZero())
+	s.Require().True(claimed.AmountOf(USDC).IsPositive())
+	_, err = s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, pendingIds[0])
+	s.Require().ErrorIs(err, types.LimitOrderNotFoundError{PositionId: pendingIds[0]})
+}
@@ -218,10 +218,21 @@ func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 // - if the position's underlying lock is not mature
 // - if tick ranges are invalid
 // - if attempts to withdraw an amount higher than originally provided in createPosition for a given range.
+// - if the position backs a limit order, which must be managed through the limit order methods instead.
 //
 // BeforeWithdrawPosition hook is triggered after validation logic but before any state changes are made.
 // AfterWithdrawPosition hook is triggered after state changes are complete if no errors have occurred.
 func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, requestedLiquidityAmountToWithdraw osmomath.Dec) (amtDenom0, amtDenom1 osmomath.Int, err error) {
+	if k.isLimitOrder(ctx, positionId) {
+		return osmomath.Int{}, osmomath.Int{}, types.LimitOrderPositionError{PositionId: positionId}
+	}
+
+	return k.withdrawPosition(ctx, owner, positionId, requestedLiquidityAmountToWithdraw, owner)
+}
+
+// withdrawPosition implements WithdrawPosition, sending the withdrawn liquidity to the given recipient.
+// Spread rewards and incentives are always collected by the owner of the position.
+func (k Keeper) withdrawPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, requestedLiquidityAmountToWithdraw osmomath.Dec, recipient sdk.AccAddress) (amtDenom0, amtDenom1 osmomath.Int, err error) {
 	position, err := k.GetPosition(ctx, positionId)
 	if err != nil {
 		return osmomath.Int{}, osmomath.Int{}, err
@@ -283,8 +294,8 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 		return osmomath.Int{}, osmomath.Int{}, err
 	}
 
-	// Transfer the actual amounts of tokens 0 and 1 from the pool to the position owner.
-	err = k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), updateData.Amount0.Abs(), updateData.Amount1.Abs(), pool.GetAddress(), owner)
+	// Transfer the actual amounts of tokens 0 and 1 from the pool to the recipient.
+	err = k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), updateData.Amount0.Abs(), updateData.Amount1.Abs(), pool.GetAddress(), recipient)
 	if err != nil {
 		return osmomath.Int{}, osmomath.Int{}, err
 	}
@@ -192,6 +192,11 @@ func (p *Pool) SetTickSpacing(tickSpacing uint64) {
 	p.TickSpacing = tickSpacing
 }
 
+// SetSpreadFactor updates the spread factor of the pool.
+func (p *Pool) SetSpreadFactor(spreadFactor osmomath.Dec) {
+	p.SpreadFactor = spreadFactor
+}
+
 // SetLastLiquidityUpdate updates the pool's LastLiquidityUpdate to newTime.
 func (p *Pool) SetLastLiquidityUpdate(newTime time.Time) {
 	p.LastLiquidityUpdate = newTime
@@ -11,6 +11,7 @@ package model
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -265,10 +266,119 @@ func (m *PositionWithPeriodLock) GetLocks() types1.PeriodLock {
 	return types1.PeriodLock{}
 }
 
+// LimitOrder tracks a position that was placed as a limit order. A limit order
+// is a single-sided position spanning exactly one tick spacing. Once the
+// pool's current tick fully crosses the order's range, its liquidity is
+// withdrawn from the pool and the converted tokens are held in the pool's
+// limit order escrow until the owner claims them.
+type LimitOrder struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	Owner      string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	PoolId     uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	LowerTick  int64  `protobuf:"varint,4,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick  int64  `protobuf:"varint,5,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	// token_in_denom is the denom that was deposited when placing the order.
+	TokenInDenom string `protobuf:"bytes,6,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	// filled is set once the current tick of the pool has crossed the order.
+	Filled bool `protobuf:"varint,7,opt,name=filled,proto3" json:"filled,omitempty" yaml:"filled"`
+	// proceeds are the tokens withdrawn from the order's position upon filling.
+	Proceeds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=proceeds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"proceeds" yaml:"proceeds"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1363e25aa5179fb1, []int{3}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetFilled() bool {
+	if m != nil {
+		return m.Filled
+	}
+	return false
+}
+
+func (m *LimitOrder) GetProceeds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Proceeds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Position)(nil), "osmosis.concentratedliquidity.v1beta1.Position")
 	proto.RegisterType((*FullPositionBreakdown)(nil), "osmosis.concentratedliquidity.v1beta1.FullPositionBreakdown")
 	proto.RegisterType((*PositionWithPeriodLock)(nil), "osmosis.concentratedliquidity.v1beta1.PositionWithPeriodLock")
+	proto.RegisterType((*LimitOrder)(nil), "osmosis.concentratedliquidity.v1beta1.LimitOrder")
 }
 
 func init() {
@@ -276,51 +386,61 @@ func init() {
 }
 
 var fileDescriptor_1363e25aa5179fb1 = []byte{
-	// 697 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xbf, 0xfc, 0xb4, 0x9d, 0x48, 0x9f, 0x90, 0x5b, 0x2a, 0x37, 0x85, 0x38, 0x32, 0x42,
-	0x8d, 0x04, 0xf5, 0x90, 0x80, 0xa8, 0x60, 0x19, 0x10, 0x52, 0xa5, 0x2e, 0x8a, 0x29, 0x42, 0x42,
-	0x48, 0xd1, 0xd8, 0x33, 0x4d, 0x87, 0xd8, 0x19, 0xd7, 0x33, 0x69, 0x89, 0xc4, 0x13, 0xb0, 0xea,
-	0x8a, 0x17, 0x60, 0xc7, 0x93, 0x74, 0xd9, 0x25, 0x62, 0x91, 0xa2, 0xf6, 0x0d, 0xfa, 0x04, 0x68,
-	0xc6, 0x63, 0x3b, 0xa0, 0xf2, 0xb7, 0xe8, 0xca, 0x33, 0xf7, 0xcc, 0x39, 0xe7, 0xfa, 0xde, 0xab,
-	0x0b, 0x1e, 0x30, 0x1e, 0x31, 0x4e, 0x39, 0x0c, 0xd8, 0x28, 0x20, 0x23, 0x91, 0x20, 0x41, 0x70,
-	0x48, 0xf7, 0xc7, 0x14, 0x53, 0x31, 0x81, 0x07, 0x1d, 0x9f, 0x08, 0xd4, 0x81, 0x31, 0xe3, 0x54,
-	0x50, 0x36, 0x72, 0xe3, 0x84, 0x09, 0x66, 0xde, 0xd6, 0x2c, 0xf7, 0x52, 0x96, 0xab, 0x59, 0x0d,
-	0x7b, 0xc0, 0xd8, 0x20, 0x24, 0x50, 0x91, 0xfc, 0xf1, 0x2e, 0x14, 0x34, 0x22, 0x5c, 0xa0, 0x28,
-	0x4e, 0x75, 0x1a, 0x4b, 0x03, 0x36, 0x60, 0xea, 0x08, 0xe5, 0x49, 0x47, 0x9b, 0x81, 0x92, 0x87,
-	0x3e, 0xe2, 0x24, 0xcf, 0x20, 0x60, 0x54, 0xbb, 0x37, 0x56, 0xb2, 0x9c, 0x43, 0x16, 0x0c, 0xc7,
-	0xb1, 0xfa, 0xa4, 0x90, 0xf3, 0xa1, 0x0c, 0xe6, 0xb7, 0x75, 0xae, 0xe6, 0x06, 0xa8, 0x67, 0x79,
-	0xf7, 0x29, 0xb6, 0x8c, 0x96, 0xd1, 0xae, 0xf4, 0x96, 0x2f, 0xa6, 0xb6, 0x39, 0x41, 0x51, 0xf8,
-	0xd8, 0x99, 0x01, 0x1d, 0x0f, 0x64, 0xb7, 0x4d, 0x6c, 0xde, 0x05, 0x73, 0x08, 0xe3, 0x84, 0x70,
-	0x6e, 0xfd, 0xd7, 0x32, 0xda, 0x0b, 0x3d, 0xf3, 0x62, 0x6a, 0xff, 0x9f, 0x92, 0x34, 0xe0, 0x78,
-	0xd9, 0x13, 0xf3, 0x0e, 0x98, 0x8b, 0x19, 0x0b, 0xa5, 0x45, 0x59, 0x59, 0xcc, 0xbc, 0xd6, 0x80,
-	0xe3, 0xd5, 0xe4, 0x69, 0x13, 0x9b, 0x37, 0x01, 0x08, 0xd9, 0x21, 0x49, 0xfa, 0x82, 0x06, 0x43,
-	0xab, 0xd2, 0x32, 0xda, 0x65, 0x6f, 0x41, 0x45, 0x76, 0x68, 0x30, 0x94, 0xf0, 0x38, 0x8e, 0x33,
-	0xb8, 0x9a, 0xc2, 0x2a, 0xa2, 0xe0, 0x97, 0x60, 0xe1, 0x2d, 0xa3, 0xa3, 0xbe, 0xac, 0xa3, 0x55,
-	0x6b, 0x19, 0xed, 0x7a, 0xb7, 0xe1, 0xa6, 0x45, 0x76, 0xb3, 0x22, 0xbb, 0x3b, 0x59, 0x91, 0x7b,
-	0x37, 0x8e, 0xa7, 0x76, 0xe9, 0x62, 0x6a, 0x5f, 0x4b, 0x93, 0xc9, 0xa9, 0xce, 0xd1, 0xa9, 0x6d,
-	0x78, 0xf3, 0xf2, 0x2e, 0x1f, 0x4b, 0xd9, 0xbc, 0x79, 0xd6, 0x9c, 0xfa, 0xe3, 0x0d, 0x49, 0xfd,
-	0x3a, 0xb5, 0x57, 0xd3, 0x5e, 0x70, 0x3c, 0x74, 0x29, 0x83, 0x11, 0x12, 0x7b, 0xee, 0x16, 0x19,
-	0xa0, 0x60, 0xf2, 0x94, 0x04, 0x85, 0x72, 0xce, 0x76, 0xbc, 0x42, 0xc9, 0xf9, 0x58, 0x05, 0xd7,
-	0x9f, 0x8d, 0xc3, 0x30, 0x6b, 0x48, 0x2f, 0x21, 0x68, 0x88, 0xd9, 0xe1, 0xc8, 0x7c, 0x0e, 0xe6,
-	0xb3, 0x72, 0xab, 0xb6, 0xd4, 0xbb, 0xd0, 0xfd, 0xab, 0x91, 0x72, 0x73, 0xad, 0x8a, 0x4c, 0xd0,
-	0xcb, 0x65, 0x4c, 0x1f, 0xd4, 0x10, 0xe7, 0x44, 0xdc, 0x53, 0x2d, 0xab, 0x77, 0x57, 0xdc, 0x34,
-	0x73, 0x57, 0x4e, 0x51, 0x4e, 0x7f, 0xc2, 0xe8, 0xa8, 0x07, 0x25, 0xf5, 0xf3, 0xa9, 0xbd, 0x36,
-	0xa0, 0x62, 0x6f, 0xec, 0xbb, 0x01, 0x8b, 0xa0, 0x1e, 0xb9, 0xf4, 0xb3, 0xce, 0xf1, 0x10, 0x8a,
-	0x49, 0x4c, 0xb8, 0x22, 0x78, 0x5a, 0x39, 0xf7, 0xe8, 0xa8, 0x46, 0x5f, 0x85, 0x47, 0xc7, 0x7c,
-	0x0f, 0xac, 0x20, 0x44, 0x34, 0x42, 0x7e, 0x48, 0xfa, 0x3c, 0x4e, 0x08, 0xc2, 0xfd, 0x84, 0x1c,
-	0xa2, 0x04, 0x73, 0xab, 0xd2, 0x2a, 0xff, 0xde, 0x75, 0x4d, 0x37, 0xdc, 0x4e, 0xdb, 0xf2, 0x2b,
-	0x21, 0xc7, 0x5b, 0xce, 0xa1, 0x17, 0x0a, 0xf1, 0x52, 0xc0, 0xdc, 0x07, 0x4b, 0x05, 0x89, 0xaa,
-	0x46, 0xd0, 0x03, 0xc2, 0xad, 0xea, 0x9f, 0x9c, 0x6f, 0x69, 0xe7, 0xd5, 0x9f, 0x9d, 0x0b, 0x11,
-	0xc7, 0x5b, 0xcc, 0xc3, 0x9b, 0x79, 0x54, 0x5a, 0xee, 0xb2, 0x64, 0x97, 0x50, 0x41, 0xf0, 0xac,
-	0x65, 0xed, 0x1f, 0x2d, 0x2f, 0x13, 0x71, 0xbc, 0xc5, 0x3c, 0x5c, 0x58, 0x3a, 0x9f, 0x0c, 0xb0,
-	0x9c, 0x0d, 0xd2, 0x2b, 0x2a, 0xf6, 0xb6, 0x49, 0x42, 0x19, 0xde, 0x62, 0xc1, 0xf0, 0x2a, 0x26,
-	0xf3, 0x21, 0xa8, 0xca, 0x0d, 0xc5, 0xf5, 0x60, 0x36, 0x72, 0xbd, 0x74, 0x7d, 0xb9, 0x85, 0xbb,
-	0xa6, 0xa6, 0xcf, 0x7b, 0x6f, 0x8e, 0xcf, 0x9a, 0xc6, 0xc9, 0x59, 0xd3, 0xf8, 0x76, 0xd6, 0x34,
-	0x8e, 0xce, 0x9b, 0xa5, 0x93, 0xf3, 0x66, 0xe9, 0xcb, 0x79, 0xb3, 0xf4, 0xba, 0x37, 0x33, 0x54,
-	0x5a, 0x6c, 0x3d, 0x44, 0x3e, 0xcf, 0x2e, 0xf0, 0xa0, 0xfb, 0x08, 0xbe, 0xfb, 0x61, 0xa5, 0xaf,
-	0x17, 0x3b, 0x3d, 0x62, 0x98, 0x84, 0x7e, 0x4d, 0xed, 0x8b, 0xfb, 0xdf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xed, 0x97, 0x33, 0x8c, 0x01, 0x06, 0x00, 0x00,
+	// 856 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x5f, 0x93, 0x4d, 0x36, 0x3b, 0x5b, 0x4a, 0xeb, 0x76, 0x57, 0xde, 0x14, 0xe2, 0x68, 0x10,
+	0x34, 0x08, 0xd6, 0x66, 0xc3, 0x8a, 0x0a, 0x2e, 0x48, 0x6e, 0x85, 0x14, 0x69, 0x25, 0xca, 0x50,
+	0x84, 0x84, 0x90, 0x22, 0xdb, 0x33, 0xc9, 0x0e, 0xfe, 0x33, 0xae, 0x67, 0xb2, 0x21, 0x88, 0x4f,
+	0xc0, 0xa9, 0x27, 0xbe, 0x00, 0xb7, 0x7e, 0x92, 0x1e, 0x7b, 0x44, 0x1c, 0xbc, 0x68, 0xf7, 0xce,
+	0x21, 0x9f, 0x00, 0x79, 0x66, 0x6c, 0xa7, 0xb4, 0xd0, 0x3f, 0x52, 0x4f, 0xf6, 0xbc, 0xdf, 0xfc,
+	0x7e, 0xbf, 0x97, 0xf7, 0x5e, 0x9e, 0xc1, 0x11, 0xe3, 0x09, 0xe3, 0x94, 0xbb, 0x21, 0x4b, 0x43,
+	0x92, 0x8a, 0xdc, 0x17, 0x04, 0xc7, 0xf4, 0xfe, 0x9c, 0x62, 0x2a, 0x96, 0xee, 0xe9, 0x61, 0x40,
+	0x84, 0x7f, 0xe8, 0x66, 0x8c, 0x53, 0x41, 0x59, 0xea, 0x64, 0x39, 0x13, 0xcc, 0x7c, 0x4f, 0xb3,
+	0x9c, 0x67, 0xb2, 0x1c, 0xcd, 0xea, 0xd9, 0x33, 0xc6, 0x66, 0x31, 0x71, 0x25, 0x29, 0x98, 0x4f,
+	0x5d, 0x41, 0x13, 0xc2, 0x85, 0x9f, 0x64, 0x4a, 0xa7, 0x77, 0x7d, 0xc6, 0x66, 0x4c, 0xbe, 0xba,
+	0xe5, 0x9b, 0x8e, 0xf6, 0x43, 0x29, 0xef, 0x06, 0x3e, 0x27, 0x75, 0x06, 0x21, 0xa3, 0xda, 0xbd,
+	0xb7, 0x5f, 0xe5, 0x1c, 0xb3, 0x30, 0x9a, 0x67, 0xf2, 0xa1, 0x20, 0xf8, 0x6b, 0x0b, 0x74, 0xef,
+	0xea, 0x5c, 0xcd, 0x5b, 0x60, 0xa7, 0xca, 0x7b, 0x42, 0xb1, 0x65, 0x0c, 0x8c, 0xe1, 0xa6, 0xb7,
+	0xb7, 0x2a, 0x6c, 0x73, 0xe9, 0x27, 0xf1, 0xe7, 0x70, 0x0d, 0x84, 0x08, 0x54, 0xa7, 0x31, 0x36,
+	0x3f, 0x02, 0x5b, 0x3e, 0xc6, 0x39, 0xe1, 0xdc, 0x7a, 0x63, 0x60, 0x0c, 0xb7, 0x3d, 0x73, 0x55,
+	0xd8, 0x97, 0x15, 0x49, 0x03, 0x10, 0x55, 0x57, 0xcc, 0x0f, 0xc1, 0x56, 0xc6, 0x58, 0x5c, 0x5a,
+	0xb4, 0xa4, 0xc5, 0xda, 0x6d, 0x0d, 0x40, 0xd4, 0x29, 0xdf, 0xc6, 0xd8, 0x7c, 0x07, 0x80, 0x98,
+	0x2d, 0x48, 0x3e, 0x11, 0x34, 0x8c, 0xac, 0xcd, 0x81, 0x31, 0x6c, 0xa1, 0x6d, 0x19, 0xb9, 0x47,
+	0xc3, 0xa8, 0x84, 0xe7, 0x59, 0x56, 0xc1, 0x6d, 0x05, 0xcb, 0x88, 0x84, 0xbf, 0x05, 0xdb, 0x3f,
+	0x32, 0x9a, 0x4e, 0xca, 0x3a, 0x5a, 0x9d, 0x81, 0x31, 0xdc, 0x19, 0xf5, 0x1c, 0x55, 0x64, 0xa7,
+	0x2a, 0xb2, 0x73, 0xaf, 0x2a, 0xb2, 0xf7, 0xf6, 0xa3, 0xc2, 0xde, 0x58, 0x15, 0xf6, 0x15, 0x95,
+	0x4c, 0x4d, 0x85, 0x0f, 0xce, 0x6c, 0x03, 0x75, 0xcb, 0x73, 0x79, 0xb9, 0x94, 0xad, 0x9b, 0x67,
+	0x6d, 0xc9, 0x5f, 0x7c, 0xab, 0xa4, 0xfe, 0x59, 0xd8, 0x37, 0x54, 0x2f, 0x38, 0x8e, 0x1c, 0xca,
+	0xdc, 0xc4, 0x17, 0x27, 0xce, 0x31, 0x99, 0xf9, 0xe1, 0xf2, 0x0e, 0x09, 0x1b, 0xe5, 0x9a, 0x0d,
+	0x51, 0xa3, 0x04, 0x7f, 0x6b, 0x83, 0xdd, 0x2f, 0xe7, 0x71, 0x5c, 0x35, 0xc4, 0xcb, 0x89, 0x1f,
+	0x61, 0xb6, 0x48, 0xcd, 0xaf, 0x41, 0xb7, 0x2a, 0xb7, 0x6c, 0xcb, 0xce, 0xc8, 0x75, 0x5e, 0x68,
+	0xa4, 0x9c, 0x5a, 0x6b, 0xb3, 0x4c, 0x10, 0xd5, 0x32, 0x66, 0x00, 0x3a, 0x3e, 0xe7, 0x44, 0x7c,
+	0x2c, 0x5b, 0xb6, 0x33, 0xda, 0x77, 0x54, 0xe6, 0x4e, 0x39, 0x45, 0x35, 0xfd, 0x36, 0xa3, 0xa9,
+	0xe7, 0x96, 0xd4, 0x87, 0x67, 0xf6, 0xcd, 0x19, 0x15, 0x27, 0xf3, 0xc0, 0x09, 0x59, 0xe2, 0xea,
+	0x91, 0x53, 0x8f, 0x03, 0x8e, 0x23, 0x57, 0x2c, 0x33, 0xc2, 0x25, 0x01, 0x69, 0xe5, 0xda, 0xe3,
+	0xd0, 0x6a, 0xbd, 0x26, 0x8f, 0x43, 0xf3, 0x17, 0x60, 0x85, 0xb1, 0x4f, 0x13, 0x3f, 0x88, 0xc9,
+	0x84, 0x67, 0x39, 0xf1, 0xf1, 0x24, 0x27, 0x0b, 0x3f, 0xc7, 0xdc, 0xda, 0x1c, 0xb4, 0xfe, 0xdf,
+	0xf5, 0xa6, 0x6e, 0xb8, 0xad, 0xda, 0xf2, 0x5f, 0x42, 0x10, 0xed, 0xd5, 0xd0, 0x37, 0x12, 0x41,
+	0x0a, 0x30, 0xef, 0x83, 0xeb, 0x0d, 0x89, 0xca, 0x46, 0xd0, 0x53, 0xc2, 0xad, 0xf6, 0xf3, 0x9c,
+	0xdf, 0xd5, 0xce, 0x37, 0xfe, 0xed, 0xdc, 0x88, 0x40, 0x74, 0xad, 0x0e, 0x8f, 0xeb, 0x68, 0x69,
+	0x39, 0x65, 0xf9, 0x94, 0x50, 0x41, 0xf0, 0xba, 0x65, 0xe7, 0x25, 0x2d, 0x9f, 0x25, 0x02, 0xd1,
+	0xb5, 0x3a, 0xdc, 0x58, 0xc2, 0xdf, 0x0d, 0xb0, 0x57, 0x0d, 0xd2, 0x77, 0x54, 0x9c, 0xdc, 0x25,
+	0x39, 0x65, 0xf8, 0x98, 0x85, 0xd1, 0xeb, 0x98, 0xcc, 0x4f, 0x41, 0xbb, 0xdc, 0x50, 0x5c, 0x0f,
+	0x66, 0xaf, 0xd6, 0x53, 0xeb, 0xcb, 0x69, 0xdc, 0x35, 0x55, 0x5d, 0x87, 0x7f, 0xb7, 0x00, 0x38,
+	0xa6, 0x09, 0x15, 0x5f, 0xe5, 0x98, 0xe4, 0xaf, 0xbe, 0xcd, 0xde, 0x07, 0x6d, 0xb6, 0x48, 0x49,
+	0xae, 0x77, 0xd9, 0x95, 0x55, 0x61, 0x5f, 0x52, 0x14, 0x19, 0x86, 0x48, 0xc1, 0x2f, 0xb7, 0xc7,
+	0x8e, 0x9e, 0xde, 0x63, 0xde, 0xee, 0xaa, 0xb0, 0xaf, 0xaa, 0xfb, 0x0d, 0x06, 0xd7, 0xd7, 0xdb,
+	0xd1, 0xd3, 0xeb, 0x6d, 0x9d, 0xd5, 0x60, 0x70, 0x7d, 0xeb, 0x7d, 0x01, 0x2e, 0x0b, 0x16, 0x91,
+	0x74, 0x42, 0xd3, 0x09, 0x26, 0x29, 0x4b, 0xe4, 0xea, 0xdb, 0xf6, 0xf6, 0x57, 0x85, 0xbd, 0xab,
+	0x98, 0x4f, 0xe2, 0x10, 0x5d, 0x92, 0x81, 0x71, 0x7a, 0xa7, 0x3c, 0x9a, 0x1f, 0x80, 0xce, 0x94,
+	0xc6, 0x31, 0xc1, 0x72, 0xb9, 0x75, 0xbd, 0xab, 0xab, 0xc2, 0x7e, 0x53, 0x4f, 0x8d, 0x8c, 0x43,
+	0xa4, 0x2f, 0x98, 0x3f, 0x83, 0x6e, 0x96, 0xb3, 0x90, 0x10, 0xcc, 0xad, 0xee, 0xf3, 0x26, 0xf0,
+	0xb6, 0x9e, 0xc0, 0xb7, 0x74, 0x91, 0x34, 0x11, 0x3e, 0x3c, 0xb3, 0x87, 0x2f, 0xf8, 0xbf, 0xe7,
+	0xa8, 0xf6, 0xf3, 0x7e, 0x78, 0x74, 0xde, 0x37, 0x1e, 0x9f, 0xf7, 0x8d, 0xbf, 0xce, 0xfb, 0xc6,
+	0x83, 0x8b, 0xfe, 0xc6, 0xe3, 0x8b, 0xfe, 0xc6, 0x1f, 0x17, 0xfd, 0x8d, 0xef, 0xbd, 0x35, 0x35,
+	0x3d, 0x3d, 0x07, 0xb1, 0x1f, 0xf0, 0xea, 0xe0, 0x9e, 0x8e, 0x3e, 0x73, 0x7f, 0x7a, 0xe2, 0x1b,
+	0x7e, 0xd0, 0x7c, 0xc4, 0x13, 0x86, 0x49, 0x1c, 0x74, 0xe4, 0x07, 0xe2, 0x93, 0x7f, 0x06, 0x00,
+	0x74, 0x6f, 0x23, 0x6c, 0xf2, 0x07, 0x00, 0x00,
 }
 
 func (m *Position) Marshal() (dAtA []byte, err error) {
@@ -529,6 +649,87 @@ func (m *PositionWithPeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proceeds) > 0 {
+		for iNdEx := len(m.Proceeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPosition(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Filled {
+		i--
+		if m.Filled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintPosition(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UpperTick != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintPosition(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPosition(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPosition(v)
 	base := offset
@@ -615,6 +816,44 @@ func (m *PositionWithPeriodLock) Size() (n int) {
 	return n
 }
 
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovPosition(uint64(m.PositionId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovPosition(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovPosition(uint64(m.PoolId))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovPosition(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovPosition(uint64(m.UpperTick))
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovPosition(uint64(l))
+	}
+	if m.Filled {
+		n += 2
+	}
+	if len(m.Proceeds) > 0 {
+		for _, e := range m.Proceeds {
+			l = e.Size()
+			n += 1 + l + sovPosition(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovPosition(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1213,6 +1452,250 @@ func (m *PositionWithPeriodLock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPosition
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Filled = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proceeds = append(m.Proceeds, types.Coin{})
+			if err := m.Proceeds[len(m.Proceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPosition(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPosition(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
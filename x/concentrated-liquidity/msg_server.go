@@ -198,3 +198,56 @@ func (server msgServer) TransferPositions(goCtx context.Context, msg *types.MsgT
 
 	return &types.MsgTransferPositionsResponse{}, nil
 }
+
+// PlaceLimitOrder creates a limit order position that is filled once the current tick of the pool crosses it.
+func (server msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	positionData, err := server.keeper.PlaceLimitOrder(ctx, msg.PoolId, sender, msg.TickIndex, msg.TokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: place limit order event is emitted in keeper.PlaceLimitOrder(...)
+
+	return &types.MsgPlaceLimitOrderResponse{PositionId: positionData.ID, LowerTick: positionData.LowerTick, UpperTick: positionData.UpperTick, LiquidityCreated: positionData.Liquidity}, nil
+}
+
+// CancelLimitOrder withdraws a pending limit order and returns the underlying amounts to the sender.
+func (server msgServer) CancelLimitOrder(goCtx context.Context, msg *types.MsgCancelLimitOrder) (*types.MsgCancelLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	amount0, amount1, err := server.keeper.CancelLimitOrder(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelLimitOrderResponse{Amount0: amount0, Amount1: amount1}, nil
+}
+
+// ClaimLimitOrder sends the proceeds of a filled limit order to the sender.
+func (server msgServer) ClaimLimitOrder(goCtx context.Context, msg *types.MsgClaimLimitOrder) (*types.MsgClaimLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := server.keeper.ClaimLimitOrder(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimLimitOrderResponse{Claimed: claimed}, nil
+}
@@ -470,6 +470,26 @@ func (k Keeper) CreateFullRangePositionUnlocking(ctx sdk.Context, clPoolId uint6
 	return positionData, concentratedLockID, nil
 }
 
+// CreatePositionLocked creates a concentrated liquidity position in the given tick range for the given pool ID, owner, and coins.
+// CL shares are minted which represent the underlying liquidity and are locked for the given duration.
+// State entries are also created to map the position ID to the underlying lock ID.
+// Unlike CreateFullRangePositionLocked, the position is not required to be full range.
+func (k Keeper) CreatePositionLocked(ctx sdk.Context, clPoolId uint64, owner sdk.AccAddress, coins sdk.Coins, lowerTick, upperTick int64, remainingLockDuration time.Duration) (positionData CreatePositionData, concentratedLockID uint64, err error) {
+	positionData, err = k.CreatePosition(ctx, clPoolId, owner, coins, osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+	if err != nil {
+		return CreatePositionData{}, 0, err
+	}
+
+	// Mint CL shares for the position and lock them for the remaining lock duration.
+	// Also sets the position ID to underlying lock ID mapping.
+	concentratedLockId, _, err := k.mintPositionSharesAndLock(ctx, clPoolId, positionData.ID, positionData.Liquidity, owner, remainingLockDuration)
+	if err != nil {
+		return CreatePositionData{}, 0, err
+	}
+
+	return positionData, concentratedLockId, nil
+}
+
 // mintSharesAndLock mints the shares for the full range concentrated liquidity position and locks them for the given duration. It also updates the position ID to underlying lock ID mapping.
 // In the context of concentrated liquidity, shares need to be minted in order for a lock in its current form to be utilized (we cannot lock non-coin objects).
 // In turn, the locks are a prerequisite for superfluid to be enabled.
@@ -484,8 +504,15 @@ func (k Keeper) mintSharesAndLock(ctx sdk.Context, concentratedPoolId, positionI
 		return 0, sdk.Coins{}, types.PositionNotFullRangeError{PositionId: positionId, LowerTick: position.LowerTick, UpperTick: position.UpperTick}
 	}
 
+	return k.mintPositionSharesAndLock(ctx, concentratedPoolId, positionId, position.Liquidity, owner, remainingLockDuration)
+}
+
+// mintPositionSharesAndLock mints shares for the given position liquidity and locks them for the given duration,
+// without any restriction on the tick range of the position. It also updates the position ID to underlying lock ID mapping.
+// Note that the shares of full range and bounded range positions share the same denom, and are valued differently by superfluid.
+func (k Keeper) mintPositionSharesAndLock(ctx sdk.Context, concentratedPoolId, positionId uint64, liquidity osmomath.Dec, owner sdk.AccAddress, remainingLockDuration time.Duration) (concentratedLockID uint64, underlyingLiquidityTokenized sdk.Coins, err error) {
 	// Create a coin object to represent the underlying liquidity for the cl position.
-	underlyingLiquidityTokenized = sdk.NewCoins(sdk.NewCoin(types.GetConcentratedLockupDenomFromPoolId(concentratedPoolId), position.Liquidity.TruncateInt()))
+	underlyingLiquidityTokenized = sdk.NewCoins(sdk.NewCoin(types.GetConcentratedLockupDenomFromPoolId(concentratedPoolId), liquidity.TruncateInt()))
 
 	// Mint the underlying liquidity as a token
 	err = k.bankKeeper.MintCoins(ctx, lockuptypes.ModuleName, underlyingLiquidityTokenized)
@@ -694,6 +721,11 @@ func (k Keeper) transferPositions(ctx sdk.Context, positionIds []uint64, sender
 			return err
 		}
 
+		// Limit orders are tied to their owner until they are cancelled or claimed.
+		if k.isLimitOrder(ctx, positionId) {
+			return types.LimitOrderPositionError{PositionId: positionId}
+		}
+
 		// If the sender is not the governance module, verify that the sender is the owner of the position.
 		if !isGovModuleSender && position.Address != sender.String() {
 			return types.PositionOwnerMismatchError{PositionOwner: position.Address, Sender: sender.String()}
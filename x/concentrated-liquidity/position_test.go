@@ -1783,6 +1783,72 @@ func (s *KeeperTestSuite) TestCreateFullRangePositionLocked() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCreatePositionLocked() {
+	stakingParams, err := s.App.StakingKeeper.GetParams(s.Ctx)
+	s.Require().NoError(err)
+	defaultRemainingLockDuration := stakingParams.UnbondingTime
+
+	tests := []struct {
+		name      string
+		lowerTick int64
+		upperTick int64
+		expectErr bool
+	}{
+		{
+			name:      "bounded range",
+			lowerTick: DefaultLowerTick,
+			upperTick: DefaultUpperTick,
+		},
+		{
+			name:      "full range",
+			lowerTick: types.MinInitializedTick,
+			upperTick: types.MaxTick,
+		},
+		{
+			name:      "invalid tick range",
+			lowerTick: DefaultUpperTick,
+			upperTick: DefaultLowerTick,
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		s.Run(test.name, func() {
+			s.SetupTest()
+			clPool := s.PrepareConcentratedPool()
+
+			defaultAddress := s.TestAccs[0]
+			s.FundAcc(defaultAddress, DefaultCoins)
+
+			// System under test
+			positionData, concentratedLockId, err := s.App.ConcentratedLiquidityKeeper.CreatePositionLocked(s.Ctx, clPool.GetId(), defaultAddress, DefaultCoins, test.lowerTick, test.upperTick, defaultRemainingLockDuration)
+			if test.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Check position
+			position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionData.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(test.lowerTick, position.LowerTick)
+			s.Require().Equal(test.upperTick, position.UpperTick)
+			s.Require().Equal(positionData.Liquidity, position.Liquidity)
+
+			// Check locked
+			lockId, err := s.App.ConcentratedLiquidityKeeper.GetLockIdFromPositionId(s.Ctx, positionData.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(concentratedLockId, lockId)
+			concentratedLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, concentratedLockId)
+			s.Require().NoError(err)
+			s.Require().Equal(positionData.Liquidity.TruncateInt().String(), concentratedLock.Coins[0].Amount.String())
+			s.Require().Equal(defaultRemainingLockDuration, concentratedLock.Duration)
+			s.Require().False(concentratedLock.IsUnlocking())
+		})
+	}
+}
+
 // TestTickRoundingEdgeCase tests an edge case where incorrect tick rounding would cause LP funds to be drained.
 func (s *KeeperTestSuite) TestTickRoundingEdgeCase() {
 	s.SetupTest()
@@ -766,6 +766,8 @@ func (k Keeper) swapCrossTickLogic(ctx sdk.Context,
 // Calls AfterConcentratedPoolSwap listener. Currently, it notifies twap module about
 // a spot price update.
 //
+// Lastly, fills the limit orders that were fully crossed by the swap.
+//
 // If any error occurs during the swap operation, the method returns an error value indicating the cause of the error.
 func (k Keeper) updatePoolForSwap(
 	ctx sdk.Context,
@@ -814,6 +816,7 @@ func (k Keeper) updatePoolForSwap(
 		return types.InsufficientPoolBalanceError{Err: err}
 	}
 
+	oldTick := pool.GetCurrentTick()
 	err = pool.ApplySwap(poolUpdates.NewLiquidity, poolUpdates.NewCurrentTick, poolUpdates.NewSqrtPrice)
 	if err != nil {
 		return fmt.Errorf("error applying swap: %w", err)
@@ -831,6 +834,9 @@ func (k Keeper) updatePoolForSwap(
 	// Each new pool module will have to emit this event separately
 	events.EmitSwapEvent(ctx, swapDetails.Sender, pool.GetId(), sdk.Coins{swapDetails.TokenIn}, sdk.Coins{swapDetails.TokenOut})
 
+	// Fill the limit orders that were fully crossed by the swap so that they stop providing liquidity.
+	k.fillCrossedLimitOrders(ctx, poolId, oldTick, poolUpdates.NewCurrentTick)
+
 	return err
 }
 
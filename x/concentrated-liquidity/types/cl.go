@@ -3,14 +3,25 @@ package types
 import (
 	fmt "fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 )
 
+const limitOrderEscrowAddressPrefix = "limitOrderEscrow"
+
 // GetConcentratedLockupDenomFromPoolId returns the concentrated lockup denom for a given pool id.
 func GetConcentratedLockupDenomFromPoolId(poolId uint64) string {
 	return fmt.Sprintf("%s/%d", ConcentratedLiquidityTokenPrefix, poolId)
 }
 
+// GetLimitOrderEscrowAddress returns the address holding the proceeds of the filled limit orders
+// of the given pool until they are claimed by their owners.
+func GetLimitOrderEscrowAddress(poolId uint64) sdk.AccAddress {
+	return osmoutils.NewModuleAddressWithPrefix(ModuleName, limitOrderEscrowAddressPrefix, sdk.Uint64ToBigEndian(poolId))
+}
+
 // CreateFullRangePositionData represents the return data from any method
 // that creates a full range position. We have multiple variants to
 // account for varying locking scenarios.
@@ -28,6 +28,14 @@ const (
 	BaseGasFeeForNewIncentive           = 10_000
 	BaseGasFeeForInitializingTick       = 10_000
 	BaseGasFeeForTransferPosition       = 10_000
+	// MaxLimitOrdersFilledPerSwap bounds the number of limit orders that a single swap
+	// fills so that the swapper's gas cost cannot be inflated by placing many tiny orders.
+	// Crossed orders exceeding the bound are filled by subsequent swaps in the same direction
+	// or when claimed.
+	MaxLimitOrdersFilledPerSwap = 25
+	// MaxLimitOrderFillGasPerSwap is the gas after which a single swap stops filling crossed
+	// limit orders. The fill in progress when the bound is reached is completed.
+	MaxLimitOrderFillGasPerSwap uint64 = 1_000_000
 )
 
 var (
@@ -0,0 +1,869 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentratedliquidity/v1beta1/dynamic_spread_factor.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DynamicSpreadFactorConfig defines the governance-set bounds and sensitivity
+// of the dynamic spread factor of a pool. At the beginning of each block, the
+// volatility of the pool is updated with the number of ticks the pool moved
+// since the previous block, and its spread factor is set to
+// min(min_spread_factor + spread_factor_per_tick * volatility,
+// max_spread_factor).
+type DynamicSpreadFactorConfig struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// min_spread_factor is the spread factor of the pool when its volatility is
+	// zero.
+	MinSpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_spread_factor,json=minSpreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_spread_factor" yaml:"min_spread_factor"`
+	// max_spread_factor is the upper bound of the spread factor of the pool.
+	MaxSpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_spread_factor,json=maxSpreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_spread_factor" yaml:"max_spread_factor"`
+	// spread_factor_per_tick is the increase of the spread factor per tick of
+	// volatility.
+	SpreadFactorPerTick cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=spread_factor_per_tick,json=spreadFactorPerTick,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spread_factor_per_tick" yaml:"spread_factor_per_tick"`
+	// volatility_decay is the fraction of the volatility carried over from one
+	// block to the next, in [0, 1). The closer it is to 1, the longer past tick
+	// movements keep the spread factor high.
+	VolatilityDecay cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=volatility_decay,json=volatilityDecay,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volatility_decay" yaml:"volatility_decay"`
+}
+
+func (m *DynamicSpreadFactorConfig) Reset()         { *m = DynamicSpreadFactorConfig{} }
+func (m *DynamicSpreadFactorConfig) String() string { return proto.CompactTextString(m) }
+func (*DynamicSpreadFactorConfig) ProtoMessage()    {}
+func (*DynamicSpreadFactorConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81bebf9355d0ef5b, []int{0}
+}
+func (m *DynamicSpreadFactorConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSpreadFactorConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSpreadFactorConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSpreadFactorConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSpreadFactorConfig.Merge(m, src)
+}
+func (m *DynamicSpreadFactorConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSpreadFactorConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSpreadFactorConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSpreadFactorConfig proto.InternalMessageInfo
+
+func (m *DynamicSpreadFactorConfig) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// DynamicSpreadFactor is the dynamic spread factor state of a pool.
+type DynamicSpreadFactor struct {
+	Config DynamicSpreadFactorConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+	// static_spread_factor is the spread factor of the pool before its dynamic
+	// spread factor was enabled. It is restored when the dynamic spread factor is
+	// disabled.
+	StaticSpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=static_spread_factor,json=staticSpreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"static_spread_factor" yaml:"static_spread_factor"`
+	// last_tick is the current tick of the pool at the last update.
+	LastTick int64 `protobuf:"varint,3,opt,name=last_tick,json=lastTick,proto3" json:"last_tick,omitempty" yaml:"last_tick"`
+	// volatility is the decayed sum of the tick movements of the pool, in ticks.
+	Volatility cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=volatility,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volatility" yaml:"volatility"`
+}
+
+func (m *DynamicSpreadFactor) Reset()         { *m = DynamicSpreadFactor{} }
+func (m *DynamicSpreadFactor) String() string { return proto.CompactTextString(m) }
+func (*DynamicSpreadFactor) ProtoMessage()    {}
+func (*DynamicSpreadFactor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81bebf9355d0ef5b, []int{1}
+}
+func (m *DynamicSpreadFactor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSpreadFactor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSpreadFactor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSpreadFactor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSpreadFactor.Merge(m, src)
+}
+func (m *DynamicSpreadFactor) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSpreadFactor) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSpreadFactor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSpreadFactor proto.InternalMessageInfo
+
+func (m *DynamicSpreadFactor) GetConfig() DynamicSpreadFactorConfig {
+	if m != nil {
+		return m.Config
+	}
+	return DynamicSpreadFactorConfig{}
+}
+
+func (m *DynamicSpreadFactor) GetLastTick() int64 {
+	if m != nil {
+		return m.LastTick
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DynamicSpreadFactorConfig)(nil), "osmosis.concentratedliquidity.v1beta1.DynamicSpreadFactorConfig")
+	proto.RegisterType((*DynamicSpreadFactor)(nil), "osmosis.concentratedliquidity.v1beta1.DynamicSpreadFactor")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/dynamic_spread_factor.proto", fileDescriptor_81bebf9355d0ef5b)
+}
+
+var fileDescriptor_81bebf9355d0ef5b = []byte{
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0x87, 0x9b, 0x2d, 0x14, 0x66, 0x24, 0xb6, 0x65, 0x15, 0x04, 0x26, 0x92, 0xc9, 0x12, 0xd2,
+	0x24, 0xb4, 0x44, 0x1d, 0x17, 0xd8, 0x81, 0x3f, 0xa1, 0x42, 0x42, 0xe2, 0x80, 0x02, 0x07, 0x84,
+	0x10, 0x91, 0xeb, 0x78, 0x99, 0xd5, 0x24, 0x0e, 0xb1, 0x57, 0x35, 0x5f, 0x02, 0xf1, 0x11, 0xf8,
+	0x38, 0x3b, 0xee, 0x88, 0x38, 0x44, 0xa8, 0xbd, 0x70, 0xee, 0x89, 0x23, 0x8a, 0x13, 0x68, 0xd3,
+	0x06, 0x29, 0xe2, 0xe6, 0xda, 0xf5, 0xf3, 0xbc, 0xf6, 0xfb, 0x8b, 0xc1, 0x33, 0xc6, 0x23, 0xc6,
+	0x29, 0xb7, 0x31, 0x8b, 0x31, 0x89, 0x45, 0x8a, 0x04, 0xf1, 0x43, 0xfa, 0xe9, 0x9c, 0xfa, 0x54,
+	0x64, 0xf6, 0xb8, 0x3f, 0x24, 0x02, 0xf5, 0x6d, 0x3f, 0x8b, 0x51, 0x44, 0xb1, 0xc7, 0x93, 0x94,
+	0x20, 0xdf, 0x3b, 0x45, 0x58, 0xb0, 0xd4, 0x4a, 0x52, 0x26, 0x98, 0x76, 0xaf, 0x42, 0x58, 0x8d,
+	0x08, 0xab, 0x42, 0xdc, 0xe9, 0x05, 0x2c, 0x60, 0x72, 0x87, 0x5d, 0x8c, 0xca, 0xcd, 0xf0, 0xb3,
+	0x0a, 0x6e, 0x0f, 0x4a, 0xf8, 0x1b, 0xc9, 0x7e, 0x21, 0xd1, 0xcf, 0x59, 0x7c, 0x4a, 0x03, 0xed,
+	0x3e, 0xb8, 0x9a, 0x30, 0x16, 0x7a, 0xd4, 0xd7, 0x95, 0x03, 0xe5, 0x50, 0x75, 0xb4, 0x79, 0x6e,
+	0xde, 0xc8, 0x50, 0x14, 0x9e, 0xc0, 0x6a, 0x01, 0xba, 0xdd, 0x62, 0xf4, 0xd2, 0xd7, 0x46, 0x60,
+	0x37, 0xa2, 0x71, 0xbd, 0x44, 0x7d, 0xe3, 0x40, 0x39, 0xdc, 0x72, 0x9e, 0x5c, 0xe4, 0x66, 0xe7,
+	0x7b, 0x6e, 0xee, 0x63, 0x59, 0x2b, 0xf7, 0x47, 0x16, 0x65, 0x76, 0x84, 0xc4, 0x99, 0xf5, 0x8a,
+	0x04, 0x08, 0x67, 0x03, 0x82, 0xe7, 0xb9, 0xa9, 0x97, 0xe4, 0x35, 0x0a, 0x74, 0xb7, 0x23, 0x1a,
+	0x2f, 0xd7, 0x27, 0x65, 0x68, 0xb2, 0x22, 0xdb, 0xfc, 0x1f, 0x19, 0x9a, 0xac, 0xcb, 0xd0, 0xa4,
+	0x26, 0xcb, 0xc0, 0xcd, 0xda, 0x5f, 0xbc, 0x84, 0xa4, 0x9e, 0xa0, 0x78, 0xa4, 0xab, 0xd2, 0x38,
+	0x68, 0x67, 0xbc, 0x5b, 0x1a, 0x9b, 0x51, 0xd0, 0xdd, 0xe3, 0x4b, 0xce, 0xd7, 0x24, 0x7d, 0x4b,
+	0xf1, 0x48, 0xa3, 0x60, 0x67, 0xcc, 0x42, 0x24, 0x68, 0x48, 0x45, 0xe6, 0xf9, 0x04, 0xa3, 0x4c,
+	0xbf, 0x22, 0xa5, 0x8f, 0xdb, 0x49, 0x6f, 0x95, 0xd2, 0x55, 0x08, 0x74, 0xb7, 0x17, 0x53, 0x83,
+	0x62, 0xe6, 0x44, 0xfd, 0xf9, 0xd5, 0x54, 0xe0, 0xaf, 0x0d, 0xb0, 0xd7, 0x10, 0x08, 0xed, 0x23,
+	0xe8, 0x62, 0x19, 0x0a, 0x99, 0x84, 0xeb, 0xc7, 0x4f, 0xad, 0x56, 0xb1, 0xb3, 0xfe, 0x19, 0x2e,
+	0x47, 0x2d, 0x0e, 0xe0, 0x56, 0x54, 0x4d, 0x80, 0x1e, 0x17, 0x48, 0x50, 0xdc, 0x18, 0x20, 0xa7,
+	0xdd, 0x61, 0xf7, 0xab, 0x1b, 0x6e, 0x00, 0x41, 0x57, 0x2b, 0xa7, 0x6b, 0xa7, 0xea, 0x83, 0xad,
+	0x10, 0x71, 0x51, 0x36, 0xb3, 0x88, 0xcf, 0xa6, 0xd3, 0x9b, 0xe7, 0xe6, 0x4e, 0xc9, 0xf9, 0xbb,
+	0x04, 0xdd, 0x6b, 0xc5, 0x58, 0x76, 0xe4, 0x1d, 0x00, 0x8b, 0x9b, 0xab, 0x02, 0xf0, 0xb0, 0x5d,
+	0x79, 0xbb, 0xab, 0xbd, 0x80, 0xee, 0x12, 0xcb, 0xf9, 0x70, 0x31, 0x35, 0x94, 0xcb, 0xa9, 0xa1,
+	0xfc, 0x98, 0x1a, 0xca, 0x97, 0x99, 0xd1, 0xb9, 0x9c, 0x19, 0x9d, 0x6f, 0x33, 0xa3, 0xf3, 0xde,
+	0x09, 0xa8, 0x38, 0x3b, 0x1f, 0x5a, 0x98, 0x45, 0x76, 0x75, 0xed, 0x47, 0x21, 0x1a, 0xf2, 0x3f,
+	0x3f, 0xec, 0xf1, 0xf1, 0x23, 0x7b, 0x52, 0x7b, 0x43, 0x8e, 0x16, 0x8f, 0x88, 0xc8, 0x12, 0xc2,
+	0x87, 0x5d, 0xf9, 0xc1, 0x3f, 0xf8, 0x3d, 0x00, 0xaf, 0x69, 0x9d, 0xc3, 0x72, 0x04, 0x00, 0x00,
+}
+
+func (this *DynamicSpreadFactorConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicSpreadFactorConfig)
+	if !ok {
+		that2, ok := that.(DynamicSpreadFactorConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.MinSpreadFactor.Equal(that1.MinSpreadFactor) {
+		return false
+	}
+	if !this.MaxSpreadFactor.Equal(that1.MaxSpreadFactor) {
+		return false
+	}
+	if !this.SpreadFactorPerTick.Equal(that1.SpreadFactorPerTick) {
+		return false
+	}
+	if !this.VolatilityDecay.Equal(that1.VolatilityDecay) {
+		return false
+	}
+	return true
+}
+func (m *DynamicSpreadFactorConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSpreadFactorConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSpreadFactorConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VolatilityDecay.Size()
+		i -= size
+		if _, err := m.VolatilityDecay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SpreadFactorPerTick.Size()
+		i -= size
+		if _, err := m.SpreadFactorPerTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxSpreadFactor.Size()
+		i -= size
+		if _, err := m.MaxSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinSpreadFactor.Size()
+		i -= size
+		if _, err := m.MinSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicSpreadFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSpreadFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSpreadFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volatility.Size()
+		i -= size
+		if _, err := m.Volatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.LastTick != 0 {
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(m.LastTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.StaticSpreadFactor.Size()
+		i -= size
+		if _, err := m.StaticSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDynamicSpreadFactor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDynamicSpreadFactor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DynamicSpreadFactorConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovDynamicSpreadFactor(uint64(m.PoolId))
+	}
+	l = m.MinSpreadFactor.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = m.MaxSpreadFactor.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = m.SpreadFactorPerTick.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = m.VolatilityDecay.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	return n
+}
+
+func (m *DynamicSpreadFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = m.StaticSpreadFactor.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	if m.LastTick != 0 {
+		n += 1 + sovDynamicSpreadFactor(uint64(m.LastTick))
+	}
+	l = m.Volatility.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	return n
+}
+
+func sovDynamicSpreadFactor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDynamicSpreadFactor(x uint64) (n int) {
+	return sovDynamicSpreadFactor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DynamicSpreadFactorConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicSpreadFactor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSpreadFactorConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSpreadFactorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadFactorPerTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpreadFactorPerTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityDecay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolatilityDecay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicSpreadFactor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicSpreadFactor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicSpreadFactor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSpreadFactor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSpreadFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaticSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StaticSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTick", wireType)
+			}
+			m.LastTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicSpreadFactor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDynamicSpreadFactor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDynamicSpreadFactor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDynamicSpreadFactor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDynamicSpreadFactor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDynamicSpreadFactor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDynamicSpreadFactor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDynamicSpreadFactor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDynamicSpreadFactor = fmt.Errorf("proto: unexpected end of group")
+)
@@ -956,3 +956,71 @@ type InvalidForfeitedIncentivesLengthError struct {
 func (e InvalidForfeitedIncentivesLengthError) Error() string {
 	return fmt.Sprintf("attempted to redeposit incorrectly constructed forfeited incentives slice. forfeited incentives must have an entry for each supported uptime. forfeit entries: %d, expected: %d", e.ForfeitedIncentivesLength, e.ExpectedLength)
 }
+
+type LimitOrderNotFoundError struct {
+	PositionId uint64
+}
+
+func (e LimitOrderNotFoundError) Error() string {
+	return fmt.Sprintf("limit order with position id (%d) not found", e.PositionId)
+}
+
+type LimitOrderPositionError struct {
+	PositionId uint64
+}
+
+func (e LimitOrderPositionError) Error() string {
+	return fmt.Sprintf("position id (%d) is a limit order, use the limit order messages to manage it", e.PositionId)
+}
+
+type NotLimitOrderOwnerError struct {
+	PositionId uint64
+	Address    string
+}
+
+func (e NotLimitOrderOwnerError) Error() string {
+	return fmt.Sprintf("address (%s) is not the owner of limit order with position id (%d)", e.Address, e.PositionId)
+}
+
+type LimitOrderAlreadyFilledError struct {
+	PositionId uint64
+}
+
+func (e LimitOrderAlreadyFilledError) Error() string {
+	return fmt.Sprintf("limit order with position id (%d) is already filled, claim it instead", e.PositionId)
+}
+
+type LimitOrderNotFilledError struct {
+	PositionId uint64
+}
+
+func (e LimitOrderNotFilledError) Error() string {
+	return fmt.Sprintf("limit order with position id (%d) is not filled yet", e.PositionId)
+}
+
+type LimitOrderInRangeError struct {
+	TickIndex   int64
+	CurrentTick int64
+	TokenIn     string
+}
+
+func (e LimitOrderInRangeError) Error() string {
+	return fmt.Sprintf("limit order selling (%s) at tick (%d) would be immediately fillable or in range at current tick (%d)", e.TokenIn, e.TickIndex, e.CurrentTick)
+}
+
+type DynamicSpreadFactorNotFoundError struct {
+	PoolId uint64
+}
+
+func (e DynamicSpreadFactorNotFoundError) Error() string {
+	return fmt.Sprintf("dynamic spread factor is not enabled for pool id (%d)", e.PoolId)
+}
+
+type InvalidDynamicSpreadFactorConfigError struct {
+	PoolId uint64
+	Reason string
+}
+
+func (e InvalidDynamicSpreadFactorConfigError) Error() string {
+	return fmt.Sprintf("invalid dynamic spread factor config for pool id (%d): %s", e.PoolId, e.Reason)
+}
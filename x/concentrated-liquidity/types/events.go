@@ -15,6 +15,10 @@ const (
 	TypeEvtTransferPositions         = "transfer_positions"
 	TypeEvtInitTick                  = "init_tick"
 	TypeEvtRemoveTick                = "remove_tick"
+	TypeEvtPlaceLimitOrder           = "place_limit_order"
+	TypeEvtFillLimitOrder            = "fill_limit_order"
+	TypeEvtCancelLimitOrder          = "cancel_limit_order"
+	TypeEvtClaimLimitOrder           = "claim_limit_order"
 
 	AttributeValueCategory                                         = ModuleName
 	AttributeKeyPositionId                                         = "position_id"
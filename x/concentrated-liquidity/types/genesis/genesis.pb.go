@@ -253,6 +253,10 @@ type GenesisState struct {
 	NextIncentiveRecordId                         uint64         `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
 	IncentivesAccumulatorPoolIdMigrationThreshold uint64         `protobuf:"varint,6,opt,name=incentives_accumulator_pool_id_migration_threshold,json=incentivesAccumulatorPoolIdMigrationThreshold,proto3" json:"incentives_accumulator_pool_id_migration_threshold,omitempty" yaml:"incentives_accumulator_pool_id_migration_threshold"`
 	SpreadFactorPoolIdMigrationThreshold          uint64         `protobuf:"varint,7,opt,name=spread_factor_pool_id_migration_threshold,json=spreadFactorPoolIdMigrationThreshold,proto3" json:"spread_factor_pool_id_migration_threshold,omitempty" yaml:"spread_factor_pool_id_migration_threshold"`
+	// limit orders, both pending and filled but not yet claimed.
+	LimitOrders []model.LimitOrder `protobuf:"bytes,8,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders" yaml:"limit_orders"`
+	// dynamic spread factor state of the pools that enabled it.
+	DynamicSpreadFactors []types1.DynamicSpreadFactor `protobuf:"bytes,9,rep,name=dynamic_spread_factors,json=dynamicSpreadFactors,proto3" json:"dynamic_spread_factors" yaml:"dynamic_spread_factors"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -337,6 +341,20 @@ func (m *GenesisState) GetSpreadFactorPoolIdMigrationThreshold() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetLimitOrders() []model.LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDynamicSpreadFactors() []types1.DynamicSpreadFactor {
+	if m != nil {
+		return m.DynamicSpreadFactors
+	}
+	return nil
+}
+
 type AccumObject struct {
 	// Accumulator's name (pulled from AccumulatorContent)
 	Name         string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
@@ -403,67 +421,72 @@ func init() {
 }
 
 var fileDescriptor_4cdf50d18c43a7c5 = []byte{
-	// 948 bytes of a gzipped FileDescriptorProto
+	// 1034 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0x26, 0xb6, 0x9b, 0x8c, 0xdd, 0x92, 0x8e, 0x52, 0xb2, 0x0d, 0xaa, 0xd7, 0x4c, 0x89,
-	0x94, 0x82, 0xe2, 0xa5, 0x4e, 0x40, 0x2a, 0x82, 0x43, 0x5c, 0x28, 0x32, 0x08, 0x88, 0x86, 0x70,
-	0xe1, 0xcb, 0x8c, 0x77, 0xc7, 0xce, 0xd0, 0xf5, 0x8e, 0xd9, 0x19, 0x87, 0xf8, 0xca, 0x1d, 0x09,
-	0x71, 0xe2, 0x27, 0xf0, 0x03, 0x90, 0x38, 0x73, 0xab, 0x10, 0x87, 0x1c, 0x39, 0xad, 0x50, 0x72,
-	0xe0, 0xee, 0x5f, 0x80, 0x76, 0x3e, 0xfc, 0x11, 0x9c, 0xc4, 0xe9, 0x6d, 0xc6, 0xef, 0xfb, 0x3c,
-	0xef, 0x33, 0xef, 0x97, 0x17, 0xec, 0x70, 0xd1, 0xe5, 0x82, 0x09, 0x3f, 0xe0, 0x71, 0x40, 0x63,
-	0x99, 0x10, 0x49, 0xc3, 0x88, 0x7d, 0xd7, 0x67, 0x21, 0x93, 0x03, 0xff, 0xe8, 0x61, 0x8b, 0x4a,
-	0xf2, 0xd0, 0xef, 0xd0, 0x98, 0x0a, 0x26, 0xaa, 0xbd, 0x84, 0x4b, 0x0e, 0x37, 0x0d, 0xa8, 0x3a,
-	0x13, 0x54, 0x35, 0xa0, 0x8d, 0xb5, 0x0e, 0xef, 0x70, 0x85, 0xf0, 0xb3, 0x93, 0x06, 0x6f, 0xdc,
-	0x0d, 0x14, 0xba, 0xa9, 0x0d, 0xfa, 0x62, 0x4d, 0x1d, 0xce, 0x3b, 0x11, 0xf5, 0xd5, 0xad, 0xd5,
-	0x6f, 0xfb, 0x24, 0x1e, 0x18, 0xd3, 0xcb, 0x56, 0x27, 0x09, 0x82, 0x7e, 0x77, 0xa4, 0x4b, 0xdd,
-	0x8c, 0xcb, 0xab, 0x97, 0x3f, 0xa5, 0x47, 0x12, 0xd2, 0xb5, 0x91, 0x76, 0xe7, 0x7b, 0x76, 0x8f,
-	0x0b, 0x26, 0x19, 0x8f, 0x0d, 0xea, 0x8d, 0xf9, 0x50, 0x92, 0x05, 0x4f, 0x9b, 0x2c, 0x6e, 0xdb,
-	0x17, 0xbf, 0x3d, 0x1f, 0x8c, 0x29, 0x23, 0x3b, 0xa2, 0xcd, 0x84, 0x06, 0x3c, 0x09, 0x35, 0x1a,
-	0xfd, 0xe5, 0x80, 0xe5, 0x27, 0xfd, 0x28, 0x3a, 0x60, 0xc1, 0x53, 0xf8, 0x1a, 0xb8, 0xd1, 0xe3,
-	0x3c, 0x6a, 0xb2, 0xd0, 0x75, 0x2a, 0xce, 0x56, 0xae, 0x0e, 0x87, 0xa9, 0x77, 0x6b, 0x40, 0xba,
-	0xd1, 0x5b, 0xc8, 0x18, 0x10, 0x2e, 0x64, 0xa7, 0x46, 0x08, 0x77, 0x01, 0x30, 0x52, 0x42, 0x7a,
-	0xec, 0x2e, 0x56, 0x9c, 0xad, 0xa5, 0xfa, 0x9d, 0x61, 0xea, 0xdd, 0xd6, 0xfe, 0x63, 0x1b, 0xc2,
-	0x2b, 0xd9, 0xa5, 0x91, 0x9d, 0xe1, 0x57, 0x20, 0x97, 0x69, 0x77, 0x97, 0x2a, 0xce, 0x56, 0xb1,
-	0xe6, 0x57, 0xe7, 0xaa, 0x75, 0xf5, 0x40, 0xe1, 0xdb, 0xbc, 0xee, 0x3e, 0x4b, 0xbd, 0x85, 0x61,
-	0xea, 0xad, 0x4e, 0x05, 0x69, 0x73, 0x84, 0x15, 0x2d, 0xfa, 0x3d, 0x07, 0x96, 0xf7, 0x39, 0x8f,
-	0xde, 0x25, 0x92, 0xc0, 0x1d, 0x90, 0xcb, 0xb4, 0xaa, 0xb7, 0x14, 0x6b, 0x6b, 0x55, 0x5d, 0xff,
-	0xaa, 0xad, 0x7f, 0x75, 0x2f, 0x1e, 0xd4, 0x57, 0xfe, 0xfc, 0x6d, 0x3b, 0x9f, 0x21, 0x1a, 0x58,
-	0x39, 0xc3, 0x2f, 0x40, 0x3e, 0x63, 0x15, 0xee, 0x62, 0x65, 0xe9, 0x1a, 0x0a, 0x6d, 0x0e, 0xeb,
-	0x6b, 0x46, 0x61, 0x69, 0xac, 0x50, 0x20, 0xac, 0x39, 0xe1, 0x2f, 0x0e, 0xb8, 0x2b, 0x7a, 0x09,
-	0x25, 0x61, 0x33, 0xa1, 0xdf, 0x93, 0x24, 0x6c, 0xaa, 0x16, 0xeb, 0x47, 0x44, 0xf2, 0xc4, 0xe4,
-	0xa4, 0x36, 0x67, 0xc4, 0xbd, 0x0c, 0xf9, 0x49, 0xeb, 0x5b, 0x1a, 0xc8, 0xfa, 0x96, 0x09, 0x5a,
-	0xd1, 0x41, 0x2f, 0x0c, 0x81, 0xf0, 0xba, 0xb6, 0x61, 0x65, 0xda, 0x1b, 0x5b, 0xe0, 0xcf, 0x0e,
-	0x58, 0x1f, 0xf5, 0x88, 0x98, 0x04, 0x09, 0x37, 0xa7, 0x52, 0xf1, 0x3c, 0xc2, 0x36, 0x8d, 0xb0,
-	0x7b, 0x5a, 0xd8, 0xec, 0x00, 0x08, 0xbf, 0x38, 0x36, 0x4c, 0x68, 0x12, 0x90, 0x81, 0xdb, 0xe7,
-	0xfb, 0x56, 0xb8, 0x79, 0xa5, 0xe6, 0xcd, 0x39, 0xd5, 0x34, 0x2c, 0x1e, 0x2b, 0x78, 0x3d, 0x97,
-	0x29, 0xc2, 0xab, 0x6c, 0xfa, 0x67, 0x81, 0xfe, 0x58, 0x04, 0xa5, 0x7d, 0x33, 0x90, 0xaa, 0x7b,
-	0x3e, 0x04, 0xcb, 0x76, 0x40, 0x4d, 0x07, 0xcd, 0xdb, 0x0b, 0x96, 0x06, 0x8f, 0x08, 0xb2, 0xc9,
-	0x8a, 0x78, 0xd6, 0xab, 0xa1, 0x9a, 0x94, 0xa9, 0xc9, 0x32, 0x06, 0x84, 0x0b, 0xd9, 0xa9, 0x11,
-	0xc2, 0x6f, 0xc0, 0xc6, 0x8c, 0x0a, 0x9a, 0xf7, 0x9b, 0x2e, 0xb9, 0x37, 0xd2, 0xa2, 0x97, 0x94,
-	0x8d, 0x3d, 0xf5, 0xca, 0xff, 0x17, 0x5b, 0x9b, 0xe1, 0x67, 0x60, 0xad, 0xdf, 0x93, 0xac, 0x4b,
-	0xa7, 0xa8, 0x6d, 0xa1, 0xe7, 0xe2, 0x86, 0x9a, 0x60, 0x82, 0x55, 0xa0, 0x7f, 0xf3, 0xa0, 0xf4,
-	0xbe, 0xde, 0xe5, 0x9f, 0x4a, 0x22, 0x29, 0x7c, 0x0c, 0x0a, 0x7a, 0x31, 0x9a, 0x0c, 0x6e, 0x5e,
-	0x91, 0xc1, 0x7d, 0xe5, 0x6c, 0x22, 0x18, 0x28, 0xc4, 0x60, 0x45, 0x2d, 0x9f, 0x90, 0x48, 0x72,
-	0xcd, 0xa9, 0xb4, 0xab, 0xc0, 0x30, 0x2e, 0xf7, 0xec, 0x6a, 0xf8, 0x1a, 0xdc, 0xb4, 0xb5, 0xd1,
-	0xbc, 0x4b, 0x8a, 0x77, 0xe7, 0x9a, 0x15, 0x9e, 0xe0, 0x2e, 0xf5, 0x26, 0x9b, 0xe7, 0x3d, 0xb0,
-	0x1a, 0xd3, 0x63, 0xd9, 0x1c, 0x05, 0x61, 0xa1, 0x9b, 0x53, 0x85, 0x7f, 0x69, 0x98, 0x7a, 0xeb,
-	0xba, 0xf0, 0xe7, 0x3d, 0x10, 0xbe, 0x95, 0xfd, 0x64, 0xc9, 0x1b, 0x21, 0xfc, 0x12, 0xb8, 0xca,
-	0xe9, 0xfc, 0x10, 0x64, 0x74, 0x79, 0x45, 0x77, 0x7f, 0x98, 0x7a, 0xde, 0x04, 0xdd, 0x0c, 0x4f,
-	0x84, 0xef, 0x64, 0xa6, 0x73, 0x83, 0xd0, 0x08, 0xe1, 0xaf, 0x0e, 0xa8, 0xcd, 0x9e, 0xc8, 0xa6,
-	0xd9, 0xf6, 0xcd, 0x2e, 0xeb, 0x24, 0x44, 0xc9, 0x93, 0x87, 0x09, 0x15, 0x87, 0x3c, 0x0a, 0xdd,
-	0x82, 0x0a, 0xfc, 0xce, 0x30, 0xf5, 0x1e, 0x5d, 0x36, 0xd5, 0x97, 0x71, 0x20, 0xbc, 0x3d, 0x73,
-	0xe2, 0xd5, 0x22, 0x0e, 0x3f, 0xb2, 0x80, 0x03, 0xeb, 0x0f, 0x7f, 0x74, 0xc0, 0x03, 0x33, 0x13,
-	0x6d, 0x12, 0x5c, 0xa5, 0xf0, 0x86, 0x52, 0xb8, 0x3b, 0x4c, 0xbd, 0xd7, 0xa7, 0x16, 0xe2, 0xd5,
-	0x50, 0x84, 0x5f, 0xd1, 0xbe, 0x4f, 0x94, 0xeb, 0x45, 0x7a, 0xd0, 0x0f, 0x0e, 0x28, 0x4e, 0xec,
-	0x39, 0x78, 0x1f, 0xe4, 0x62, 0xd2, 0xa5, 0xaa, 0xcd, 0x57, 0xea, 0x2f, 0x0c, 0x53, 0xaf, 0x68,
-	0x8a, 0x42, 0xba, 0x14, 0x61, 0x65, 0x84, 0x1f, 0x83, 0x9b, 0x7a, 0xdc, 0x02, 0x1e, 0x4b, 0x1a,
-	0x4b, 0xb5, 0x0a, 0x8a, 0xb5, 0x07, 0x17, 0x8c, 0xdb, 0x44, 0x5e, 0x1e, 0x6b, 0x00, 0x2e, 0x29,
-	0x0f, 0x73, 0xab, 0x87, 0xcf, 0x4e, 0xcb, 0xce, 0xc9, 0x69, 0xd9, 0xf9, 0xe7, 0xb4, 0xec, 0xfc,
-	0x74, 0x56, 0x5e, 0x38, 0x39, 0x2b, 0x2f, 0xfc, 0x7d, 0x56, 0x5e, 0xf8, 0xfc, 0x83, 0x0e, 0x93,
-	0x87, 0xfd, 0x56, 0x35, 0xe0, 0x5d, 0xdf, 0x90, 0x6f, 0x47, 0xa4, 0x25, 0xec, 0xc5, 0x3f, 0xaa,
-	0x3d, 0xf2, 0x8f, 0xa7, 0xbe, 0x18, 0xb6, 0xc7, 0x9f, 0x0c, 0x72, 0xd0, 0xa3, 0xc2, 0x7e, 0x94,
-	0xb5, 0x0a, 0xea, 0xff, 0x72, 0xe7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x77, 0xe0, 0x6e, 0xcc,
-	0xcc, 0x09, 0x00, 0x00,
+	0x18, 0xce, 0x26, 0xce, 0xd7, 0xd8, 0x2d, 0xe9, 0x90, 0x36, 0xdb, 0x54, 0xb5, 0xcd, 0x94, 0x48,
+	0x29, 0x28, 0x36, 0x71, 0x02, 0x52, 0x2b, 0x38, 0xc4, 0x2d, 0x45, 0xe6, 0xab, 0xd1, 0x34, 0x5c,
+	0xf8, 0x5a, 0xc6, 0x3b, 0x13, 0x67, 0xe8, 0xee, 0x8e, 0xbb, 0x33, 0x0e, 0xf1, 0x95, 0x3b, 0x12,
+	0xe2, 0x02, 0x3f, 0x81, 0x1f, 0x80, 0xc4, 0x99, 0x5b, 0x85, 0x38, 0xf4, 0xc8, 0xc9, 0x42, 0xc9,
+	0x3f, 0xb0, 0xf8, 0x01, 0x68, 0x67, 0x66, 0x1d, 0xdb, 0x75, 0xdd, 0x0d, 0xb7, 0x9d, 0x7d, 0xdf,
+	0xe7, 0x79, 0x9f, 0x77, 0xdf, 0x8f, 0x1d, 0xb0, 0x23, 0x64, 0x28, 0x24, 0x97, 0x55, 0x5f, 0x44,
+	0x3e, 0x8b, 0x54, 0x4c, 0x14, 0xa3, 0x01, 0x7f, 0xd2, 0xe1, 0x94, 0xab, 0x6e, 0xf5, 0x78, 0xbb,
+	0xc9, 0x14, 0xd9, 0xae, 0xb6, 0x58, 0xc4, 0x24, 0x97, 0x95, 0x76, 0x2c, 0x94, 0x80, 0x1b, 0x16,
+	0x54, 0x99, 0x08, 0xaa, 0x58, 0xd0, 0xfa, 0x6a, 0x4b, 0xb4, 0x84, 0x46, 0x54, 0x93, 0x27, 0x03,
+	0x5e, 0xbf, 0xee, 0x6b, 0xb4, 0x67, 0x0c, 0xe6, 0x90, 0x9a, 0x5a, 0x42, 0xb4, 0x02, 0x56, 0xd5,
+	0xa7, 0x66, 0xe7, 0xb0, 0x4a, 0xa2, 0xae, 0x35, 0xbd, 0x96, 0xea, 0x24, 0xbe, 0xdf, 0x09, 0x07,
+	0xba, 0xf4, 0xc9, 0xba, 0xbc, 0x31, 0x3d, 0x95, 0x36, 0x89, 0x49, 0x98, 0x46, 0xda, 0xcd, 0x96,
+	0x76, 0x5b, 0x48, 0xae, 0xb8, 0x88, 0x2c, 0xea, 0xed, 0x6c, 0x28, 0xc5, 0xfd, 0xc7, 0x1e, 0x8f,
+	0x0e, 0xd3, 0x8c, 0xdf, 0xcd, 0x06, 0xe3, 0xda, 0xc8, 0x8f, 0x99, 0x17, 0x33, 0x5f, 0xc4, 0xd4,
+	0xa2, 0xf7, 0xb2, 0xa1, 0x69, 0x37, 0x22, 0x21, 0xf7, 0x3d, 0xd9, 0x8e, 0x19, 0xa1, 0xde, 0x21,
+	0xf1, 0x95, 0x88, 0x0d, 0x05, 0xfa, 0xcb, 0x01, 0x4b, 0x0f, 0x3a, 0x41, 0x70, 0xc0, 0xfd, 0xc7,
+	0xf0, 0x4d, 0xb0, 0xd8, 0x16, 0x22, 0xf0, 0x38, 0x75, 0x9d, 0xb2, 0xb3, 0x99, 0xab, 0xc3, 0x7e,
+	0xaf, 0x74, 0xb9, 0x4b, 0xc2, 0xe0, 0x2e, 0xb2, 0x06, 0x84, 0x17, 0x92, 0xa7, 0x06, 0x85, 0xbb,
+	0x00, 0xd8, 0x6c, 0x28, 0x3b, 0x71, 0x67, 0xcb, 0xce, 0xe6, 0x5c, 0xfd, 0x6a, 0xbf, 0x57, 0xba,
+	0x62, 0xfc, 0xcf, 0x6d, 0x08, 0x2f, 0x27, 0x87, 0x46, 0xf2, 0x0c, 0xbf, 0x02, 0xb9, 0x24, 0x7d,
+	0x77, 0xae, 0xec, 0x6c, 0xe6, 0x6b, 0xd5, 0x4a, 0xa6, 0x76, 0xa9, 0x1c, 0x68, 0xfc, 0xa1, 0xa8,
+	0xbb, 0x4f, 0x7b, 0xa5, 0x99, 0x7e, 0xaf, 0xb4, 0x32, 0x12, 0xe4, 0x50, 0x20, 0xac, 0x69, 0xd1,
+	0xef, 0x39, 0xb0, 0xb4, 0x2f, 0x44, 0x70, 0x9f, 0x28, 0x02, 0x77, 0x40, 0x2e, 0xd1, 0xaa, 0x73,
+	0xc9, 0xd7, 0x56, 0x2b, 0xa6, 0x85, 0x2a, 0x69, 0x0b, 0x55, 0xf6, 0xa2, 0x6e, 0x7d, 0xf9, 0xcf,
+	0xdf, 0xb6, 0xe6, 0x13, 0x44, 0x03, 0x6b, 0x67, 0xf8, 0x05, 0x98, 0x4f, 0x58, 0xa5, 0x3b, 0x5b,
+	0x9e, 0xbb, 0x80, 0xc2, 0xf4, 0x1b, 0xd6, 0x57, 0xad, 0xc2, 0xc2, 0xb9, 0x42, 0x89, 0xb0, 0xe1,
+	0x84, 0xbf, 0x38, 0xe0, 0xba, 0xad, 0x42, 0xcc, 0xbe, 0x23, 0x31, 0xf5, 0x74, 0x97, 0x76, 0x02,
+	0xa2, 0x44, 0x6c, 0xbf, 0x49, 0x2d, 0x63, 0xc4, 0xbd, 0x04, 0xf9, 0xb0, 0xf9, 0x2d, 0xf3, 0x55,
+	0x7d, 0xd3, 0x06, 0x2d, 0x9b, 0xa0, 0x2f, 0x0c, 0x81, 0xf0, 0x9a, 0xb1, 0x61, 0x6d, 0xda, 0x3b,
+	0xb7, 0xc0, 0x9f, 0x1c, 0xb0, 0x36, 0x68, 0x33, 0x39, 0x0c, 0x92, 0x6e, 0xae, 0x3c, 0xf7, 0x3f,
+	0x85, 0x6d, 0x58, 0x61, 0x37, 0x8d, 0xb0, 0xc9, 0x01, 0x10, 0xbe, 0x76, 0x6e, 0x18, 0xd2, 0x24,
+	0x21, 0x07, 0x57, 0xc6, 0x5b, 0x5f, 0xba, 0xf3, 0x5a, 0xcd, 0x3b, 0x19, 0xd5, 0x34, 0x52, 0x3c,
+	0xd6, 0xf0, 0x7a, 0x2e, 0x51, 0x84, 0x57, 0xf8, 0xe8, 0x6b, 0x89, 0xfe, 0x98, 0x05, 0x85, 0x7d,
+	0x3b, 0xd3, 0xba, 0x7b, 0x3e, 0x02, 0x4b, 0xe9, 0x8c, 0xdb, 0x0e, 0xca, 0xda, 0x0b, 0x29, 0x0d,
+	0x1e, 0x10, 0x24, 0x93, 0x15, 0x88, 0xa4, 0x57, 0xa9, 0x3b, 0x3b, 0x3e, 0x59, 0xd6, 0x80, 0xf0,
+	0x42, 0xf2, 0xd4, 0xa0, 0xf0, 0x1b, 0xb0, 0x3e, 0xa1, 0x82, 0x36, 0x7f, 0xdb, 0x25, 0x37, 0x07,
+	0x5a, 0xb4, 0x71, 0x10, 0x7b, 0x24, 0xcb, 0xe7, 0x8b, 0x6d, 0xcc, 0xf0, 0x33, 0xb0, 0xda, 0x69,
+	0x2b, 0x1e, 0xb2, 0x11, 0xea, 0xb4, 0xd0, 0x99, 0xb8, 0xa1, 0x21, 0x18, 0x62, 0x95, 0xe8, 0xdf,
+	0x45, 0x50, 0xf8, 0xc0, 0xfc, 0x0e, 0x1e, 0x29, 0xa2, 0x18, 0xbc, 0x07, 0x16, 0xcc, 0x6e, 0xb5,
+	0x5f, 0x70, 0xe3, 0x25, 0x5f, 0x70, 0x5f, 0x3b, 0xdb, 0x08, 0x16, 0x0a, 0x31, 0x58, 0xd6, 0xcb,
+	0x87, 0x12, 0x45, 0x2e, 0x38, 0x95, 0xe9, 0x2a, 0xb0, 0x8c, 0x4b, 0xed, 0x74, 0x35, 0x7c, 0x0d,
+	0x2e, 0xa5, 0xb5, 0x31, 0xbc, 0x73, 0x9a, 0x77, 0xe7, 0x82, 0x15, 0x1e, 0xe2, 0x2e, 0xb4, 0x87,
+	0x9b, 0xe7, 0x7d, 0xb0, 0x12, 0xb1, 0x13, 0xe5, 0x0d, 0x82, 0x70, 0xea, 0xe6, 0x74, 0xe1, 0x6f,
+	0xf4, 0x7b, 0xa5, 0x35, 0x53, 0xf8, 0x71, 0x0f, 0x84, 0x2f, 0x27, 0xaf, 0x52, 0xf2, 0x06, 0x85,
+	0x5f, 0x02, 0x57, 0x3b, 0x8d, 0x0f, 0x41, 0x42, 0x37, 0xaf, 0xe9, 0x6e, 0xf5, 0x7b, 0xa5, 0xd2,
+	0x10, 0xdd, 0x04, 0x4f, 0x84, 0xaf, 0x26, 0xa6, 0xb1, 0x41, 0x68, 0x50, 0xf8, 0xab, 0x03, 0x6a,
+	0x93, 0x27, 0xd2, 0xb3, 0xdb, 0xde, 0x0b, 0x79, 0x2b, 0x26, 0x5a, 0x9e, 0x3a, 0x8a, 0x99, 0x3c,
+	0x12, 0x01, 0x75, 0x17, 0x74, 0xe0, 0xf7, 0xfa, 0xbd, 0xd2, 0x9d, 0x69, 0x53, 0x3d, 0x8d, 0x03,
+	0xe1, 0xad, 0x89, 0x13, 0xaf, 0x17, 0x31, 0xfd, 0x24, 0x05, 0x1c, 0xa4, 0xfe, 0xf0, 0x07, 0x07,
+	0xdc, 0x1e, 0xf9, 0x7d, 0x4d, 0x55, 0xb8, 0xa8, 0x15, 0xee, 0xf6, 0x7b, 0xa5, 0xb7, 0x46, 0x16,
+	0xe2, 0xcb, 0xa1, 0x08, 0xbf, 0x6e, 0x7c, 0x1f, 0x10, 0x7f, 0x9a, 0x9e, 0x27, 0xa0, 0x10, 0xf0,
+	0x90, 0x2b, 0x4f, 0xc4, 0x94, 0xc5, 0xd2, 0x5d, 0xd2, 0xed, 0xb3, 0x9d, 0xb1, 0x7d, 0x3e, 0x4e,
+	0xa0, 0x0f, 0x13, 0x64, 0xfd, 0x86, 0x5d, 0x90, 0xaf, 0xda, 0x5d, 0x30, 0x44, 0x8a, 0x70, 0x3e,
+	0x18, 0x38, 0x4a, 0xf8, 0xb3, 0x03, 0xae, 0x4d, 0xfc, 0x93, 0x4b, 0x77, 0x59, 0x47, 0xbf, 0x9b,
+	0x31, 0xfa, 0x7d, 0x43, 0xf2, 0x68, 0x28, 0xcf, 0xf1, 0x3d, 0x3d, 0x39, 0x0e, 0xc2, 0xab, 0xf4,
+	0x79, 0xac, 0x44, 0xdf, 0x3b, 0x20, 0x3f, 0xb4, 0xf4, 0xe1, 0x2d, 0x90, 0x8b, 0x48, 0xc8, 0xf4,
+	0xcc, 0x2f, 0xd7, 0x5f, 0xe9, 0xf7, 0x4a, 0x79, 0xdb, 0xa1, 0x24, 0x64, 0x08, 0x6b, 0x23, 0xfc,
+	0x14, 0x5c, 0x32, 0xbb, 0xc7, 0x17, 0x91, 0x62, 0x91, 0xd2, 0x7b, 0x31, 0x5f, 0xbb, 0xfd, 0x82,
+	0xdd, 0x33, 0xd4, 0x24, 0xf7, 0x0c, 0x00, 0x17, 0xb4, 0x87, 0x3d, 0xd5, 0xe9, 0xd3, 0xd3, 0xa2,
+	0xf3, 0xec, 0xb4, 0xe8, 0xfc, 0x73, 0x5a, 0x74, 0x7e, 0x3c, 0x2b, 0xce, 0x3c, 0x3b, 0x2b, 0xce,
+	0xfc, 0x7d, 0x56, 0x9c, 0xf9, 0xfc, 0xc3, 0x16, 0x57, 0x47, 0x9d, 0x66, 0xc5, 0x17, 0x61, 0xd5,
+	0x92, 0x6f, 0x05, 0xa4, 0x29, 0xd3, 0x43, 0xf5, 0xb8, 0x76, 0xa7, 0x7a, 0x32, 0x72, 0x87, 0xda,
+	0x3a, 0xbf, 0x44, 0xa9, 0x6e, 0x9b, 0xc9, 0xf4, 0x92, 0xdb, 0x5c, 0xd0, 0x97, 0x87, 0x9d, 0xff,
+	0x06, 0x00, 0x03, 0xd1, 0x6e, 0x96, 0x1c, 0x0b, 0x00, 0x00,
 }
 
 func (m *FullTick) Marshal() (dAtA []byte, err error) {
@@ -680,6 +703,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DynamicSpreadFactors) > 0 {
+		for iNdEx := len(m.DynamicSpreadFactors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DynamicSpreadFactors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.SpreadFactorPoolIdMigrationThreshold != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.SpreadFactorPoolIdMigrationThreshold))
 		i--
@@ -900,6 +951,18 @@ func (m *GenesisState) Size() (n int) {
 	if m.SpreadFactorPoolIdMigrationThreshold != 0 {
 		n += 1 + sovGenesis(uint64(m.SpreadFactorPoolIdMigrationThreshold))
 	}
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DynamicSpreadFactors) > 0 {
+		for _, e := range m.DynamicSpreadFactors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1646,6 +1709,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, model.LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSpreadFactors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DynamicSpreadFactors = append(m.DynamicSpreadFactors, types1.DynamicSpreadFactor{})
+			if err := m.DynamicSpreadFactors[len(m.DynamicSpreadFactors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,18 +5,23 @@ import (
 	"strings"
 
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 const (
-	ProposalTypeTickSpacingDecrease = "TickSpacingDecrease"
+	ProposalTypeTickSpacingDecrease    = "TickSpacingDecrease"
+	ProposalTypeSetDynamicSpreadFactor = "SetDynamicSpreadFactor"
 )
 
 func init() {
 	govtypesv1.RegisterProposalType(ProposalTypeTickSpacingDecrease)
+	govtypesv1.RegisterProposalType(ProposalTypeSetDynamicSpreadFactor)
 }
 
 var (
 	_ govtypesv1.Content = &TickSpacingDecreaseProposal{}
+	_ govtypesv1.Content = &SetDynamicSpreadFactorProposal{}
 )
 
 // String returns a string containing the pool incentives proposal.
@@ -94,3 +99,100 @@ Records:     %s
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+func NewSetDynamicSpreadFactorProposal(title, description string, configs []DynamicSpreadFactorConfig, disabledPoolIds []uint64) govtypesv1.Content {
+	return &SetDynamicSpreadFactorProposal{
+		Title:           title,
+		Description:     description,
+		Configs:         configs,
+		DisabledPoolIds: disabledPoolIds,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *SetDynamicSpreadFactorProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetDynamicSpreadFactorProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetDynamicSpreadFactorProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetDynamicSpreadFactorProposal) ProposalType() string {
+	return ProposalTypeSetDynamicSpreadFactor
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+func (p *SetDynamicSpreadFactorProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.Configs) == 0 && len(p.DisabledPoolIds) == 0 {
+		return fmt.Errorf("empty proposal records")
+	}
+
+	seenPoolIds := map[uint64]bool{}
+	for _, config := range p.Configs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+		if seenPoolIds[config.PoolId] {
+			return fmt.Errorf("duplicate pool id (%d) in proposal", config.PoolId)
+		}
+		seenPoolIds[config.PoolId] = true
+	}
+	for _, poolId := range p.DisabledPoolIds {
+		if poolId == 0 {
+			return fmt.Errorf("pool id must be positive")
+		}
+		if seenPoolIds[poolId] {
+			return fmt.Errorf("duplicate pool id (%d) in proposal", poolId)
+		}
+		seenPoolIds[poolId] = true
+	}
+	return nil
+}
+
+// String returns a string containing the set dynamic spread factor proposal.
+func (p SetDynamicSpreadFactorProposal) String() string {
+	recordsStr := ""
+	for _, config := range p.Configs {
+		recordsStr = recordsStr + fmt.Sprintf("(PoolID: %d, MinSpreadFactor: %s, MaxSpreadFactor: %s, SpreadFactorPerTick: %s, VolatilityDecay: %s) ",
+			config.PoolId, config.MinSpreadFactor, config.MaxSpreadFactor, config.SpreadFactorPerTick, config.VolatilityDecay)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Dynamic Spread Factor Proposal:
+Title:             %s
+Description:       %s
+Records:           %s
+Disabled Pool IDs: %v
+`, p.Title, p.Description, recordsStr, p.DisabledPoolIds))
+	return b.String()
+}
+
+// Validate checks that the spread factor bounds are in [0, 1) and ordered, that the spread factor per tick
+// is not negative, and that the volatility decay is in [0, 1).
+func (c DynamicSpreadFactorConfig) Validate() error {
+	if c.PoolId == 0 {
+		return InvalidDynamicSpreadFactorConfigError{PoolId: c.PoolId, Reason: "pool id must be positive"}
+	}
+	if c.MinSpreadFactor.IsNil() || c.MaxSpreadFactor.IsNil() || c.SpreadFactorPerTick.IsNil() || c.VolatilityDecay.IsNil() {
+		return InvalidDynamicSpreadFactorConfigError{PoolId: c.PoolId, Reason: "all fields must be set"}
+	}
+	if c.MinSpreadFactor.IsNegative() || c.MaxSpreadFactor.GTE(osmomath.OneDec()) {
+		return InvalidDynamicSpreadFactorConfigError{PoolId: c.PoolId, Reason: "spread factor bounds must be in [0, 1)"}
+	}
+	if c.MinSpreadFactor.GT(c.MaxSpreadFactor) {
+		return InvalidDynamicSpreadFactorConfigError{PoolId: c.PoolId, Reason: "min spread factor must not exceed max spread factor"}
+	}
+	if c.SpreadFactorPerTick.IsNegative() {
+		return InvalidDynamicSpreadFactorConfigError{PoolId: c.PoolId, Reason: "spread factor per tick must not be negative"}
+	}
+	if c.VolatilityDecay.IsNegative() || c.VolatilityDecay.GTE(osmomath.OneDec()) {
+		return InvalidDynamicSpreadFactorConfigError{PoolId: c.PoolId, Reason: "volatility decay must be in [0, 1)"}
+	}
+	return nil
+}
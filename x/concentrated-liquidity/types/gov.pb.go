@@ -163,6 +163,53 @@ func (m *PoolIdToTickSpacingRecord) GetNewTickSpacing() uint64 {
 	return 0
 }
 
+// SetDynamicSpreadFactorProposal is a gov Content type for enabling, updating
+// and disabling the dynamic spread factor of pools. The proposal will fail if
+// one of the pools does not exist, or if one of the pools to disable does not
+// have its dynamic spread factor enabled.
+type SetDynamicSpreadFactorProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// configs enables the dynamic spread factor of the given pools, or updates
+	// it if it is already enabled.
+	Configs []DynamicSpreadFactorConfig `protobuf:"bytes,3,rep,name=configs,proto3" json:"configs"`
+	// disabled_pool_ids disables the dynamic spread factor of the given pools,
+	// restoring the spread factor they had before it was enabled.
+	DisabledPoolIds []uint64 `protobuf:"varint,4,rep,packed,name=disabled_pool_ids,json=disabledPoolIds,proto3" json:"disabled_pool_ids,omitempty"`
+}
+
+func (m *SetDynamicSpreadFactorProposal) Reset()      { *m = SetDynamicSpreadFactorProposal{} }
+func (*SetDynamicSpreadFactorProposal) ProtoMessage() {}
+func (*SetDynamicSpreadFactorProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a96adc35f4989ef7, []int{3}
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDynamicSpreadFactorProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDynamicSpreadFactorProposal.Merge(m, src)
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDynamicSpreadFactorProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDynamicSpreadFactorProposal proto.InternalMessageInfo
+
 type PoolRecord struct {
 	Denom0       string                      `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0"`
 	Denom1       string                      `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1"`
@@ -174,7 +221,7 @@ func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
 func (m *PoolRecord) String() string { return proto.CompactTextString(m) }
 func (*PoolRecord) ProtoMessage()    {}
 func (*PoolRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a96adc35f4989ef7, []int{3}
+	return fileDescriptor_a96adc35f4989ef7, []int{4}
 }
 func (m *PoolRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,6 +275,7 @@ func init() {
 	proto.RegisterType((*CreateConcentratedLiquidityPoolsProposal)(nil), "osmosis.concentratedliquidity.v1beta1.CreateConcentratedLiquidityPoolsProposal")
 	proto.RegisterType((*TickSpacingDecreaseProposal)(nil), "osmosis.concentratedliquidity.v1beta1.TickSpacingDecreaseProposal")
 	proto.RegisterType((*PoolIdToTickSpacingRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIdToTickSpacingRecord")
+	proto.RegisterType((*SetDynamicSpreadFactorProposal)(nil), "osmosis.concentratedliquidity.v1beta1.SetDynamicSpreadFactorProposal")
 	proto.RegisterType((*PoolRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolRecord")
 }
 
@@ -236,42 +284,46 @@ func init() {
 }
 
 var fileDescriptor_a96adc35f4989ef7 = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xb5, 0x5b, 0x27, 0xdf, 0xc7, 0x24, 0x45, 0xc5, 0x44, 0x6a, 0x68, 0x24, 0x3b, 0xb2, 0x84,
-	0x14, 0x16, 0xb5, 0x71, 0x59, 0x11, 0x36, 0x28, 0xad, 0x90, 0x40, 0x5d, 0x54, 0xa6, 0x2b, 0x84,
-	0x14, 0x26, 0xe3, 0xc1, 0x1d, 0xc5, 0xf1, 0x75, 0x3d, 0xd3, 0x94, 0xbc, 0x01, 0x12, 0x2c, 0x58,
-	0xb2, 0xcc, 0xe3, 0x74, 0xd9, 0x25, 0x62, 0x11, 0xa1, 0x64, 0xc3, 0x96, 0x3c, 0x01, 0xca, 0x4c,
-	0x7e, 0x9c, 0x42, 0x25, 0x10, 0x3b, 0xcf, 0xcc, 0x39, 0xe7, 0x9e, 0x73, 0xaf, 0x2f, 0xf2, 0x80,
-	0xf7, 0x80, 0x33, 0xee, 0x11, 0x48, 0x08, 0x4d, 0x44, 0x86, 0x05, 0x0d, 0x63, 0x76, 0x76, 0xce,
-	0x42, 0x26, 0x06, 0x5e, 0xdf, 0xef, 0x50, 0x81, 0x7d, 0x2f, 0x82, 0xbe, 0x9b, 0x66, 0x20, 0xc0,
-	0xbc, 0x3f, 0x27, 0xb8, 0xbf, 0x25, 0xb8, 0x73, 0xc2, 0x6e, 0x25, 0x82, 0x08, 0x24, 0xc3, 0x9b,
-	0x7d, 0x29, 0xb2, 0x33, 0xd1, 0x51, 0xe3, 0x20, 0xa3, 0x58, 0xd0, 0x83, 0x1c, 0xfb, 0x68, 0xc1,
-	0x3e, 0x06, 0x88, 0xf9, 0x71, 0x06, 0x29, 0x70, 0x1c, 0x9b, 0x15, 0x54, 0x10, 0x4c, 0xc4, 0xb4,
-	0xaa, 0xd7, 0xf5, 0xc6, 0xad, 0x40, 0x1d, 0xcc, 0x3a, 0x2a, 0x85, 0x94, 0x93, 0x8c, 0xa5, 0x82,
-	0x41, 0x52, 0xdd, 0x90, 0x6f, 0xf9, 0x2b, 0xf3, 0x0c, 0x95, 0x53, 0x80, 0xb8, 0x9d, 0x51, 0x02,
-	0x59, 0xc8, 0xab, 0x9b, 0xf5, 0xcd, 0x46, 0x69, 0xdf, 0x77, 0xff, 0xc8, 0xb8, 0x3b, 0xf3, 0x10,
-	0x48, 0x66, 0xab, 0x76, 0x39, 0xb2, 0xb5, 0xe9, 0xc8, 0xbe, 0x3b, 0xc0, 0xbd, 0xb8, 0xe9, 0xe4,
-	0x45, 0x9d, 0xa0, 0x94, 0x2e, 0x81, 0xbc, 0x59, 0x7e, 0x3f, 0xb4, 0xb5, 0xcf, 0x43, 0x5b, 0xfb,
-	0x3e, 0xb4, 0x75, 0xe7, 0x87, 0x8e, 0x6a, 0x27, 0x8c, 0x74, 0x5f, 0xa6, 0x98, 0xb0, 0x24, 0x3a,
-	0xa4, 0x24, 0xa3, 0x98, 0xd3, 0x7f, 0x0e, 0xf6, 0x41, 0x47, 0xb6, 0x34, 0xc1, 0xc2, 0xb6, 0x80,
-	0xb6, 0x60, 0xa4, 0xdb, 0xe6, 0xaa, 0xc6, 0xb5, 0xb0, 0x4f, 0xff, 0x22, 0xec, 0xf3, 0xf0, 0x04,
-	0x72, 0x6e, 0xe7, 0xd9, 0x8d, 0x59, 0xf6, 0x60, 0x37, 0xbd, 0x09, 0x70, 0x3d, 0x73, 0x88, 0xee,
-	0xdd, 0x28, 0x66, 0xee, 0xa0, 0xff, 0xe6, 0xbe, 0x65, 0x64, 0x23, 0x28, 0x2a, 0x5d, 0xb3, 0x81,
-	0xb6, 0x13, 0x7a, 0xb1, 0x96, 0x44, 0x06, 0x37, 0x82, 0xdb, 0x09, 0xbd, 0xc8, 0x09, 0x35, 0x0d,
-	0x59, 0xe5, 0xe3, 0x06, 0x42, 0xab, 0x01, 0x99, 0x0f, 0x50, 0x31, 0xa4, 0x09, 0xf4, 0x1e, 0xaa,
-	0x4e, 0xb6, 0xee, 0x4c, 0x47, 0xf6, 0x96, 0x1a, 0x96, 0xba, 0x77, 0x82, 0x39, 0x60, 0x09, 0xf5,
-	0x55, 0x63, 0x7f, 0x81, 0xfa, 0x0b, 0xa8, 0x6f, 0x36, 0x51, 0x79, 0xcd, 0xd0, 0xe6, 0xcc, 0x50,
-	0x6b, 0x67, 0xf5, 0x23, 0xe4, 0x5f, 0x9d, 0xa0, 0x24, 0x56, 0x36, 0xcd, 0x37, 0x68, 0x8b, 0xa7,
-	0x19, 0xc5, 0x61, 0xfb, 0x2d, 0x26, 0x02, 0xb2, 0x6a, 0x41, 0x56, 0x7b, 0x32, 0xeb, 0xe6, 0xd7,
-	0x91, 0x5d, 0x23, 0x72, 0x2e, 0x3c, 0xec, 0xba, 0x0c, 0xbc, 0x1e, 0x16, 0xa7, 0xee, 0x11, 0x8d,
-	0x30, 0x19, 0x1c, 0x52, 0x32, 0x1d, 0xd9, 0x15, 0xa5, 0xbf, 0xa6, 0xe0, 0x04, 0x65, 0x75, 0x7e,
-	0x26, 0x8f, 0xaa, 0x11, 0x2f, 0x8c, 0xff, 0x8d, 0xed, 0x42, 0xeb, 0xf5, 0xe5, 0xd8, 0xd2, 0xaf,
-	0xc6, 0x96, 0xfe, 0x6d, 0x6c, 0xe9, 0x9f, 0x26, 0x96, 0x76, 0x35, 0xb1, 0xb4, 0x2f, 0x13, 0x4b,
-	0x7b, 0xd5, 0x8a, 0x98, 0x38, 0x3d, 0xef, 0xb8, 0x04, 0x7a, 0x8b, 0x0d, 0xdf, 0x8b, 0x71, 0x87,
-	0x2f, 0xd7, 0xbd, 0xbf, 0xff, 0xd8, 0x7b, 0xb7, 0xb6, 0xf4, 0x7b, 0xab, 0xad, 0x17, 0x83, 0x94,
-	0xf2, 0x4e, 0x51, 0xee, 0xec, 0xa3, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd6, 0xdf, 0x27, 0x12,
-	0x23, 0x04, 0x00, 0x00,
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x41, 0x4f, 0xdb, 0x4c,
+	0x10, 0x8d, 0x89, 0x81, 0xef, 0xdb, 0x84, 0x16, 0x5c, 0x24, 0x52, 0x90, 0xec, 0xc8, 0x52, 0xa5,
+	0xb4, 0x12, 0x76, 0x4d, 0x4f, 0x4d, 0x2f, 0x6d, 0x40, 0x95, 0x5a, 0x71, 0x40, 0x86, 0x53, 0x55,
+	0xc9, 0x6c, 0x76, 0x17, 0xb3, 0xc2, 0xf6, 0x1a, 0xef, 0x02, 0xcd, 0x3f, 0xa8, 0xd4, 0x1e, 0x7a,
+	0xec, 0x91, 0x9f, 0xc3, 0x91, 0x63, 0xd5, 0x43, 0x54, 0x91, 0x4b, 0xaf, 0x45, 0xfd, 0x01, 0x55,
+	0x76, 0x6d, 0xe2, 0xa0, 0x20, 0x81, 0xb8, 0x79, 0x77, 0x67, 0xde, 0xcc, 0x7b, 0xf3, 0x3c, 0xc0,
+	0x65, 0x3c, 0x66, 0x9c, 0x72, 0x17, 0xb1, 0x04, 0x91, 0x44, 0x64, 0x50, 0x10, 0x1c, 0xd1, 0xc3,
+	0x23, 0x8a, 0xa9, 0xe8, 0xb9, 0xc7, 0x5e, 0x97, 0x08, 0xe8, 0xb9, 0x21, 0x3b, 0x76, 0xd2, 0x8c,
+	0x09, 0x66, 0x3c, 0xc9, 0x13, 0x9c, 0x89, 0x09, 0x4e, 0x9e, 0xb0, 0xbc, 0x18, 0xb2, 0x90, 0xc9,
+	0x0c, 0x77, 0xf8, 0xa5, 0x92, 0x97, 0xdf, 0xdc, 0xae, 0x1a, 0xee, 0x25, 0x30, 0xa6, 0x28, 0xe0,
+	0x69, 0x46, 0x20, 0x0e, 0xf6, 0x20, 0x12, 0x2c, 0x53, 0x10, 0xf6, 0x40, 0x03, 0xad, 0xf5, 0x8c,
+	0x40, 0x41, 0xd6, 0x4b, 0x18, 0x9b, 0x05, 0xc6, 0x16, 0x63, 0x11, 0xdf, 0xca, 0x58, 0xca, 0x38,
+	0x8c, 0x8c, 0x45, 0x30, 0x2d, 0xa8, 0x88, 0x48, 0x43, 0x6b, 0x6a, 0xad, 0xff, 0x7d, 0x75, 0x30,
+	0x9a, 0xa0, 0x86, 0x09, 0x47, 0x19, 0x4d, 0x05, 0x65, 0x49, 0x63, 0x4a, 0xbe, 0x95, 0xaf, 0x8c,
+	0x43, 0x50, 0x4f, 0x19, 0x8b, 0x82, 0x8c, 0x20, 0x96, 0x61, 0xde, 0xa8, 0x36, 0xab, 0xad, 0xda,
+	0x9a, 0xe7, 0xdc, 0x8a, 0xbb, 0x33, 0xec, 0xc1, 0x97, 0x99, 0x9d, 0x95, 0xb3, 0xbe, 0x55, 0xb9,
+	0xec, 0x5b, 0x8f, 0x7a, 0x30, 0x8e, 0xda, 0x76, 0x19, 0xd4, 0xf6, 0x6b, 0xe9, 0x55, 0x20, 0x6f,
+	0xd7, 0x3f, 0x9f, 0x5a, 0x95, 0xef, 0xa7, 0x56, 0xe5, 0xf7, 0xa9, 0xa5, 0xd9, 0x7f, 0x34, 0xb0,
+	0xb2, 0x43, 0xd1, 0xc1, 0x76, 0x0a, 0x11, 0x4d, 0xc2, 0x0d, 0x82, 0x32, 0x02, 0x39, 0xb9, 0x37,
+	0xb1, 0x2f, 0x1a, 0xb0, 0x64, 0x13, 0x14, 0x07, 0x82, 0x05, 0x82, 0xa2, 0x83, 0x80, 0xab, 0x1a,
+	0xd7, 0xc8, 0xbe, 0xbe, 0x03, 0xd9, 0x77, 0x78, 0x87, 0x95, 0xba, 0xcd, 0xb9, 0xeb, 0x43, 0xee,
+	0xfe, 0x72, 0x7a, 0x53, 0xc0, 0x75, 0xce, 0x18, 0x3c, 0xbe, 0x11, 0xcc, 0x58, 0x02, 0xb3, 0x79,
+	0xdf, 0x92, 0xb2, 0xee, 0xcf, 0x28, 0x5c, 0xa3, 0x05, 0xe6, 0x13, 0x72, 0x32, 0xc6, 0x44, 0x12,
+	0xd7, 0xfd, 0x07, 0x09, 0x39, 0x29, 0x01, 0xb5, 0x75, 0x59, 0xe5, 0xaf, 0x06, 0xcc, 0x6d, 0x22,
+	0x36, 0x94, 0xc5, 0xb6, 0xa5, 0xc3, 0xde, 0x4a, 0x83, 0xdd, 0x5b, 0xdc, 0x5d, 0x30, 0x8b, 0x58,
+	0xb2, 0x47, 0xc3, 0xbb, 0x6a, 0x38, 0xa1, 0x99, 0x75, 0x09, 0x94, 0x6b, 0x58, 0xc0, 0x1a, 0xcf,
+	0xc0, 0x02, 0xa6, 0x1c, 0x76, 0x23, 0x82, 0x83, 0x5c, 0x0e, 0xde, 0xd0, 0x9b, 0xd5, 0x96, 0xee,
+	0x3f, 0x2c, 0x1e, 0x94, 0x86, 0xd7, 0xc5, 0xfd, 0x3a, 0x05, 0xc0, 0xc8, 0x97, 0xc6, 0x53, 0x30,
+	0x83, 0x49, 0xc2, 0xe2, 0xe7, 0x8a, 0x63, 0x67, 0xe1, 0xb2, 0x6f, 0xcd, 0x29, 0x8f, 0xaa, 0x7b,
+	0xdb, 0xcf, 0x03, 0xae, 0x42, 0xbd, 0xc6, 0xd4, 0xc4, 0x50, 0xaf, 0x08, 0xf5, 0x8c, 0x36, 0xa8,
+	0x8f, 0xcd, 0xa1, 0x3a, 0x9c, 0x43, 0x67, 0x69, 0xe4, 0xff, 0xf2, 0xab, 0xed, 0xd7, 0xc4, 0x68,
+	0x3a, 0xc6, 0x2e, 0x98, 0x1b, 0xfb, 0xdd, 0x1b, 0xd3, 0xb2, 0xda, 0xab, 0xa1, 0x00, 0x3f, 0xfb,
+	0xd6, 0x0a, 0x92, 0x52, 0x72, 0x7c, 0xe0, 0x50, 0xe6, 0xc6, 0x50, 0xec, 0x3b, 0x9b, 0x24, 0x84,
+	0xa8, 0xb7, 0x41, 0xd0, 0x65, 0xdf, 0x5a, 0x54, 0xf8, 0x63, 0x08, 0xb6, 0x5f, 0xe7, 0x25, 0x45,
+	0xd5, 0xfc, 0xdf, 0xeb, 0xff, 0xe9, 0xf3, 0xd3, 0x9d, 0x8f, 0x67, 0x17, 0xa6, 0x76, 0x7e, 0x61,
+	0x6a, 0xbf, 0x2e, 0x4c, 0xed, 0xdb, 0xc0, 0xac, 0x9c, 0x0f, 0xcc, 0xca, 0x8f, 0x81, 0x59, 0xf9,
+	0xd0, 0x09, 0xa9, 0xd8, 0x3f, 0xea, 0x3a, 0x88, 0xc5, 0xc5, 0x6e, 0x5c, 0x8d, 0x60, 0x97, 0x17,
+	0x07, 0xf7, 0x78, 0xed, 0xa5, 0xfb, 0x69, 0x6c, 0x81, 0xad, 0x8e, 0x36, 0x98, 0xe8, 0xa5, 0x84,
+	0x77, 0x67, 0xe4, 0xaa, 0x7a, 0xf1, 0x6f, 0x00, 0x26, 0xda, 0x4d, 0xf4, 0x5d, 0x05, 0x00, 0x00,
 }
 
 func (this *CreateConcentratedLiquidityPoolsProposal) Equal(that interface{}) bool {
@@ -371,6 +423,49 @@ func (this *PoolIdToTickSpacingRecord) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SetDynamicSpreadFactorProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetDynamicSpreadFactorProposal)
+	if !ok {
+		that2, ok := that.(SetDynamicSpreadFactorProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Configs) != len(that1.Configs) {
+		return false
+	}
+	for i := range this.Configs {
+		if !this.Configs[i].Equal(&that1.Configs[i]) {
+			return false
+		}
+	}
+	if len(this.DisabledPoolIds) != len(that1.DisabledPoolIds) {
+		return false
+	}
+	for i := range this.DisabledPoolIds {
+		if this.DisabledPoolIds[i] != that1.DisabledPoolIds[i] {
+			return false
+		}
+	}
+	return true
+}
 func (this *PoolRecord) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -539,6 +634,75 @@ func (m *PoolIdToTickSpacingRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *SetDynamicSpreadFactorProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDynamicSpreadFactorProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDynamicSpreadFactorProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisabledPoolIds) > 0 {
+		dAtA2 := make([]byte, len(m.DisabledPoolIds)*10)
+		var j1 int
+		for _, num := range m.DisabledPoolIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGov(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Configs) > 0 {
+		for iNdEx := len(m.Configs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Configs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -663,6 +827,36 @@ func (m *PoolIdToTickSpacingRecord) Size() (n int) {
 	return n
 }
 
+func (m *SetDynamicSpreadFactorProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Configs) > 0 {
+		for _, e := range m.Configs {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.DisabledPoolIds) > 0 {
+		l = 0
+		for _, e := range m.DisabledPoolIds {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *PoolRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1075,6 +1269,230 @@ func (m *PoolIdToTickSpacingRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetDynamicSpreadFactorProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDynamicSpreadFactorProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDynamicSpreadFactorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Configs = append(m.Configs, DynamicSpreadFactorConfig{})
+			if err := m.Configs[len(m.Configs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DisabledPoolIds = append(m.DisabledPoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DisabledPoolIds) == 0 {
+					m.DisabledPoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DisabledPoolIds = append(m.DisabledPoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledPoolIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
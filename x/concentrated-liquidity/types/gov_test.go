@@ -6,6 +6,7 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/types"
 )
 
@@ -48,3 +49,103 @@ func TestTickSpacingDecreaseProposalMarshalUnmarshal(t *testing.T) {
 		require.Equal(t, *test.proposal, decoded)
 	}
 }
+
+func validDynamicSpreadFactorConfig(poolId uint64) types.DynamicSpreadFactorConfig {
+	return types.DynamicSpreadFactorConfig{
+		PoolId:              poolId,
+		MinSpreadFactor:     osmomath.MustNewDecFromStr("0.001"),
+		MaxSpreadFactor:     osmomath.MustNewDecFromStr("0.01"),
+		SpreadFactorPerTick: osmomath.MustNewDecFromStr("0.000001"),
+		VolatilityDecay:     osmomath.MustNewDecFromStr("0.9"),
+	}
+}
+
+func TestSetDynamicSpreadFactorProposalValidateBasic(t *testing.T) {
+	withConfig := func(modify func(*types.DynamicSpreadFactorConfig)) []types.DynamicSpreadFactorConfig {
+		config := validDynamicSpreadFactorConfig(1)
+		modify(&config)
+		return []types.DynamicSpreadFactorConfig{config}
+	}
+
+	tests := map[string]struct {
+		configs         []types.DynamicSpreadFactorConfig
+		disabledPoolIds []uint64
+		expectErr       bool
+	}{
+		"valid configs and disabled pools": {
+			configs:         []types.DynamicSpreadFactorConfig{validDynamicSpreadFactorConfig(1), validDynamicSpreadFactorConfig(2)},
+			disabledPoolIds: []uint64{3},
+		},
+		"valid: min equals max": {
+			configs: withConfig(func(c *types.DynamicSpreadFactorConfig) { c.MinSpreadFactor = c.MaxSpreadFactor }),
+		},
+		"error: empty proposal": {
+			expectErr: true,
+		},
+		"error: zero pool id": {
+			configs:   []types.DynamicSpreadFactorConfig{validDynamicSpreadFactorConfig(0)},
+			expectErr: true,
+		},
+		"error: zero disabled pool id": {
+			disabledPoolIds: []uint64{0},
+			expectErr:       true,
+		},
+		"error: duplicate pool id": {
+			configs:         []types.DynamicSpreadFactorConfig{validDynamicSpreadFactorConfig(1)},
+			disabledPoolIds: []uint64{1},
+			expectErr:       true,
+		},
+		"error: nil field": {
+			configs:   withConfig(func(c *types.DynamicSpreadFactorConfig) { c.SpreadFactorPerTick = osmomath.Dec{} }),
+			expectErr: true,
+		},
+		"error: negative min spread factor": {
+			configs:   withConfig(func(c *types.DynamicSpreadFactorConfig) { c.MinSpreadFactor = osmomath.NewDec(-1) }),
+			expectErr: true,
+		},
+		"error: max spread factor of one": {
+			configs:   withConfig(func(c *types.DynamicSpreadFactorConfig) { c.MaxSpreadFactor = osmomath.OneDec() }),
+			expectErr: true,
+		},
+		"error: min exceeds max": {
+			configs:   withConfig(func(c *types.DynamicSpreadFactorConfig) { c.MinSpreadFactor = osmomath.MustNewDecFromStr("0.02") }),
+			expectErr: true,
+		},
+		"error: negative spread factor per tick": {
+			configs:   withConfig(func(c *types.DynamicSpreadFactorConfig) { c.SpreadFactorPerTick = osmomath.NewDec(-1) }),
+			expectErr: true,
+		},
+		"error: volatility decay of one": {
+			configs:   withConfig(func(c *types.DynamicSpreadFactorConfig) { c.VolatilityDecay = osmomath.OneDec() }),
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			proposal := types.NewSetDynamicSpreadFactorProposal("title", "description", tc.configs, tc.disabledPoolIds)
+			err := proposal.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSetDynamicSpreadFactorProposalMarshalUnmarshal(t *testing.T) {
+	proposal := &types.SetDynamicSpreadFactorProposal{
+		Title:           "title",
+		Description:     "proposal to set dynamic spread factors",
+		Configs:         []types.DynamicSpreadFactorConfig{validDynamicSpreadFactorConfig(1)},
+		DisabledPoolIds: []uint64{2},
+	}
+
+	bz, err := proto.Marshal(proposal)
+	require.NoError(t, err)
+	decoded := types.SetDynamicSpreadFactorProposal{}
+	err = proto.Unmarshal(bz, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, *proposal, decoded)
+}
@@ -53,6 +53,11 @@ var (
 	KeyIncentiveAccumulatorMigrationThreshold    = []byte{0x15}
 	KeySpreadRewardAccumulatorMigrationThreshold = []byte{0x16}
 
+	LimitOrderPrefix     = []byte{0x17}
+	LimitOrderTickPrefix = []byte{0x18}
+
+	DynamicSpreadFactorPrefix = []byte{0x19}
+
 	// TickPrefix + pool id
 	KeyTickPrefixByPoolIdLengthBytes = len(TickPrefix) + Uint64ByteSize
 	// TickPrefix + pool id + sign byte(negative / positive prefix) + tick index: 18bytes in total
@@ -224,6 +229,39 @@ func KeyPoolPosition(poolId uint64) []byte {
 	return key
 }
 
+// Limit Order Prefix Keys
+
+// KeyLimitOrder returns the key consisted of (LimitOrderPrefix | position Id) and is used to store limit order info.
+func KeyLimitOrder(positionId uint64) []byte {
+	return append(LimitOrderPrefix, sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// KeyLimitOrderTickPrefix returns the prefix used to index pending limit orders of a pool that sell the given side
+// of the pool. Orders are ordered by their fill tick so that the orders crossed by a swap can be range iterated.
+func KeyLimitOrderTickPrefix(poolId uint64, isToken0 bool) []byte {
+	key := make([]byte, 0, len(LimitOrderTickPrefix)+Uint64ByteSize+1)
+	key = append(key, LimitOrderTickPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	if isToken0 {
+		return append(key, 0x00)
+	}
+	return append(key, 0x01)
+}
+
+// KeyLimitOrderTick returns the full key of a pending limit order in the fill tick index, which is consisted of
+// (KeyLimitOrderTickPrefix | fill tick | position Id).
+func KeyLimitOrderTick(poolId uint64, isToken0 bool, fillTick int64, positionId uint64) []byte {
+	key := KeyLimitOrderTickPrefix(poolId, isToken0)
+	key = append(key, TickIndexToBytes(fillTick)...)
+	return append(key, sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// KeyDynamicSpreadFactor returns the key consisted of (DynamicSpreadFactorPrefix | pool Id) and is used to store
+// the dynamic spread factor state of a pool.
+func KeyDynamicSpreadFactor(poolId uint64) []byte {
+	return append(DynamicSpreadFactorPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
 // Pool Prefix Keys
 // KeyPool is used to map a pool id to a pool struct
 func KeyPool(poolId uint64) []byte {
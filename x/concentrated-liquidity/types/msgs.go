@@ -19,6 +19,9 @@ const (
 	TypeMsgCollectIncentives       = "collect-incentives"
 	TypeMsgFungifyChargedPositions = "fungify-charged-positions"
 	TypeMsgTransferPositions       = "transfer-positions"
+	TypeMsgPlaceLimitOrder         = "place-limit-order"
+	TypeMsgCancelLimitOrder        = "cancel-limit-order"
+	TypeMsgClaimLimitOrder         = "claim-limit-order"
 )
 
 var _ sdk.Msg = &MsgCreatePosition{}
@@ -239,3 +242,82 @@ func (msg MsgTransferPositions) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgPlaceLimitOrder{}
+
+func (msg MsgPlaceLimitOrder) Route() string { return RouterKey }
+func (msg MsgPlaceLimitOrder) Type() string  { return TypeMsgPlaceLimitOrder }
+func (msg MsgPlaceLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenIn.IsValid() {
+		return fmt.Errorf("Invalid coin (%s)", msg.TokenIn.String())
+	}
+
+	if !msg.TokenIn.IsPositive() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenIn.Amount.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelLimitOrder{}
+
+func (msg MsgCancelLimitOrder) Route() string { return RouterKey }
+func (msg MsgCancelLimitOrder) Type() string  { return TypeMsgCancelLimitOrder }
+func (msg MsgCancelLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return ErrZeroPositionId
+	}
+
+	return nil
+}
+
+func (msg MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClaimLimitOrder{}
+
+func (msg MsgClaimLimitOrder) Route() string { return RouterKey }
+func (msg MsgClaimLimitOrder) Type() string  { return TypeMsgClaimLimitOrder }
+func (msg MsgClaimLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return ErrZeroPositionId
+	}
+
+	return nil
+}
+
+func (msg MsgClaimLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -30,6 +30,8 @@ type (
 
 		channelKeeper  types.ChannelKeeper
 		ContractKeeper *wasmkeeper.PermissionedKeeper
+
+		nativeCallbacks map[string]types.NativeCallbackHandler
 	}
 )
 
@@ -44,10 +46,11 @@ func NewKeeper(
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 	return &Keeper{
-		storeKey:       storeKey,
-		paramSpace:     paramSpace,
-		channelKeeper:  channelKeeper,
-		ContractKeeper: contractKeeper,
+		storeKey:        storeKey,
+		paramSpace:      paramSpace,
+		channelKeeper:   channelKeeper,
+		ContractKeeper:  contractKeeper,
+		nativeCallbacks: make(map[string]types.NativeCallbackHandler),
 	}
 }
 
@@ -86,6 +89,14 @@ func GetPacketCallbackKey(channel string, packetSequence uint64) []byte {
 	return []byte(fmt.Sprintf("%s::%d", channel, packetSequence))
 }
 
+func GetPacketNativeCallbackKey(channel string, packetSequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s::%d::native", channel, packetSequence))
+}
+
+func GetSwapRecoveryKey(channel string, packetSequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s::%d::recovery", channel, packetSequence))
+}
+
 func GetPacketAckKey(channel string, packetSequence uint64) []byte {
 	return []byte(fmt.Sprintf("%s::%d::ack", channel, packetSequence))
 }
@@ -133,6 +144,64 @@ func (k Keeper) DeletePacketCallback(ctx sdk.Context, channel string, packetSequ
 	store.Delete(GetPacketCallbackKey(channel, packetSequence))
 }
 
+// RegisterNativeCallbackHandler registers a Go module handler for packet acks and timeouts under the given name.
+// It is meant to be called during app wiring and panics if the name is empty or already registered.
+func (k Keeper) RegisterNativeCallbackHandler(name string, handler types.NativeCallbackHandler) {
+	if name == "" {
+		panic("native callback handler name cannot be empty")
+	}
+	if _, ok := k.nativeCallbacks[name]; ok {
+		panic(fmt.Sprintf("native callback handler %s already registered", name))
+	}
+	k.nativeCallbacks[name] = handler
+}
+
+// GetNativeCallbackHandler returns the native callback handler registered under the given name
+func (k Keeper) GetNativeCallbackHandler(name string) (types.NativeCallbackHandler, bool) {
+	handler, ok := k.nativeCallbacks[name]
+	return handler, ok
+}
+
+// StorePacketNativeCallback stores which native handler will be listening for the ack or timeout of a packet
+func (k Keeper) StorePacketNativeCallback(ctx sdk.Context, channel string, packetSequence uint64, name string) error {
+	if _, ok := k.nativeCallbacks[name]; !ok {
+		return errorsmod.Wrap(types.ErrUnknownNativeCallback, name)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetPacketNativeCallbackKey(channel, packetSequence), []byte(name))
+	return nil
+}
+
+// GetPacketNativeCallback returns the name of the native handler that is expecting a callback from a packet
+func (k Keeper) GetPacketNativeCallback(ctx sdk.Context, channel string, packetSequence uint64) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(GetPacketNativeCallbackKey(channel, packetSequence)))
+}
+
+// DeletePacketNativeCallback deletes the native callback from storage once it has been processed
+func (k Keeper) DeletePacketNativeCallback(ctx sdk.Context, channel string, packetSequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetPacketNativeCallbackKey(channel, packetSequence))
+}
+
+// StoreSwapRecoveryAddress stores the address that receives the funds of a swap forward packet if it fails
+func (k Keeper) StoreSwapRecoveryAddress(ctx sdk.Context, channel string, packetSequence uint64, recoveryAddress string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetSwapRecoveryKey(channel, packetSequence), []byte(recoveryAddress))
+}
+
+// GetSwapRecoveryAddress returns the recovery address of a swap forward packet, or an empty string if the packet isn't one
+func (k Keeper) GetSwapRecoveryAddress(ctx sdk.Context, channel string, packetSequence uint64) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(GetSwapRecoveryKey(channel, packetSequence)))
+}
+
+// DeleteSwapRecoveryAddress deletes the recovery address of a swap forward packet once it has been acked or timed out
+func (k Keeper) DeleteSwapRecoveryAddress(ctx sdk.Context, channel string, packetSequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetSwapRecoveryKey(channel, packetSequence))
+}
+
 // StorePacketAckActor stores which contract is allowed to send an ack for the packet
 func (k Keeper) StorePacketAckActor(ctx sdk.Context, packet channeltypes.Packet, contract string) {
 	store := ctx.KVStore(k.storeKey)
@@ -0,0 +1,228 @@
+package ibc_hooks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/x/ibc-hooks/keeper"
+	"github.com/osmosis-labs/osmosis/x/ibc-hooks/types"
+)
+
+// NativeSwapsConfigured returns true if the keepers needed to execute native swaps have been set
+func (h WasmHooks) NativeSwapsConfigured() bool {
+	return h.ibcHooksKeeper != nil && h.PoolManagerKeeper != nil && h.TransferKeeper != nil && h.BankKeeper != nil
+}
+
+// ValidateAndParseSwapMemo parses the value of the swap key of the memo, if present, and validates it
+// against the receiver of the packet.
+func ValidateAndParseSwapMemo(memo string, receiver string) (isSwapRouted bool, swapMemo types.SwapMemo, err error) {
+	isSwapRouted, metadata := jsonStringHasKey(memo, types.IBCSwapKey)
+	if !isSwapRouted {
+		return isSwapRouted, types.SwapMemo{}, nil
+	}
+
+	if _, ok := metadata["wasm"]; ok {
+		return isSwapRouted, types.SwapMemo{},
+			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, "swap and wasm cannot be used in the same memo")
+	}
+
+	// Make sure the swap key is a map. If it isn't, return an error
+	swap, ok := metadata[types.IBCSwapKey].(map[string]interface{})
+	if !ok {
+		return isSwapRouted, types.SwapMemo{},
+			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, "swap metadata is not a valid JSON map object")
+	}
+
+	bz, err := json.Marshal(swap)
+	if err != nil {
+		return isSwapRouted, types.SwapMemo{}, fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, err.Error())
+	}
+	if err := json.Unmarshal(bz, &swapMemo); err != nil {
+		return isSwapRouted, types.SwapMemo{}, fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, err.Error())
+	}
+
+	if err := swapMemo.Validate(receiver); err != nil {
+		return isSwapRouted, types.SwapMemo{}, fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, err.Error())
+	}
+
+	// The callback of the forward packet is used to recover its funds, so the forward memo cannot request another one
+	if swapMemo.Forward != nil {
+		for _, key := range []string{types.IBCCallbackKey, types.IBCNativeCallbackKey} {
+			if found, _ := jsonStringHasKey(swapMemo.Forward.Memo, key); found {
+				return isSwapRouted, types.SwapMemo{},
+					fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, fmt.Sprintf("forward memo cannot contain %s", key))
+			}
+		}
+	}
+
+	return isSwapRouted, swapMemo, nil
+}
+
+// execNativeSwap receives the packet's funds into the intermediary account of its sender, swaps them and sends the
+// output to the memo's receiver or forwards it over IBC. Any error results in an error ack, which reverts the receive
+// and refunds the sender on the source chain.
+func (h WasmHooks) execNativeSwap(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, swapMemo types.SwapMemo, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	// Calculate the intermediary account based on the packet's channel and sender, as done for wasm hooks
+	channel := packet.GetDestChannel()
+	sender := data.GetSender()
+	senderBech32, err := keeper.DeriveIntermediateSender(channel, sender, h.bech32PrefixAccAddr)
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrBadSender, fmt.Sprintf("cannot convert sender address %s/%s to bech32: %s", channel, sender, err.Error()))
+	}
+	intermediary, err := sdk.AccAddressFromBech32(senderBech32)
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrBadSender, err.Error())
+	}
+
+	// Send the funds to the intermediary account instead of the receiver
+	data.Receiver = senderBech32
+	bz, err := json.Marshal(data)
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrMarshaling, err.Error())
+	}
+	packet.Data = bz
+
+	ack := im.App.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	amount, ok := osmomath.NewIntFromString(data.GetAmount())
+	if !ok {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrInvalidPacket, "Amount is not an int")
+	}
+	tokenIn := sdk.NewCoin(osmoutils.MustExtractDenomFromPacketOnRecv(packet), amount)
+
+	tokenOutAmount, err := h.PoolManagerKeeper.RouteExactAmountIn(ctx, intermediary, swapMemo.Routes, tokenIn, swapMemo.TokenOutMinAmount)
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrNativeSwap, err.Error())
+	}
+	tokenOut := sdk.NewCoin(swapMemo.Routes[len(swapMemo.Routes)-1].TokenOutDenom, tokenOutAmount)
+	swapAck := types.SwapAck{TokenOut: tokenOut, IbcAck: ack.Acknowledgement()}
+
+	if swapMemo.Forward == nil {
+		receiver := swapMemo.Receiver
+		if receiver == "" {
+			receiver = swapMemo.RecoveryAddress
+		}
+		receiverAddr, err := sdk.AccAddressFromBech32(receiver)
+		if err != nil {
+			return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrNativeSwap, err.Error())
+		}
+		if err := h.BankKeeper.SendCoins(ctx, intermediary, receiverAddr, sdk.NewCoins(tokenOut)); err != nil {
+			return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrNativeSwap, err.Error())
+		}
+	} else {
+		seq, err := h.forwardSwapOutput(ctx, senderBech32, tokenOut, *swapMemo.Forward, swapMemo.RecoveryAddress)
+		if err != nil {
+			return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrNativeSwap, err.Error())
+		}
+		swapAck.ForwardSequence = seq
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"ibc-native-swap",
+			sdk.NewAttribute(types.AttributeSender, senderBech32),
+			sdk.NewAttribute("token_in", tokenIn.String()),
+			sdk.NewAttribute("token_out", tokenOut.String()),
+		),
+	})
+
+	bz, err = json.Marshal(swapAck)
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrBadResponse, err.Error())
+	}
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
+// forwardSwapOutput sends the output of a swap over IBC and registers the swap callback, so that the output is sent
+// to the recovery address if the forward packet fails.
+func (h WasmHooks) forwardSwapOutput(ctx sdk.Context, sender string, tokenOut sdk.Coin, forward types.SwapForward, recoveryAddress string) (uint64, error) {
+	msg := transfertypes.NewMsgTransfer(
+		transfertypes.PortID,
+		forward.Channel,
+		tokenOut,
+		sender,
+		forward.Receiver,
+		clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().UnixNano())+types.DefaultSwapForwardTimeoutNanos,
+		forward.Memo,
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return 0, err
+	}
+	res, err := h.TransferKeeper.Transfer(ctx, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	h.ibcHooksKeeper.StoreSwapRecoveryAddress(ctx, forward.Channel, res.Sequence, recoveryAddress)
+	if err := h.ibcHooksKeeper.StorePacketNativeCallback(ctx, forward.Channel, res.Sequence, types.NativeSwapCallback); err != nil {
+		return 0, err
+	}
+	return res.Sequence, nil
+}
+
+// SwapRecoveryHandler is the native callback handler for swap forward packets. If a forward packet fails, the
+// refunded output of the swap is sent from the intermediary account to the recovery address.
+type SwapRecoveryHandler struct {
+	ibcHooksKeeper *keeper.Keeper
+	bankKeeper     types.BankKeeper
+}
+
+var _ types.NativeCallbackHandler = SwapRecoveryHandler{}
+
+func NewSwapRecoveryHandler(ibcHooksKeeper *keeper.Keeper, bankKeeper types.BankKeeper) SwapRecoveryHandler {
+	return SwapRecoveryHandler{
+		ibcHooksKeeper: ibcHooksKeeper,
+		bankKeeper:     bankKeeper,
+	}
+}
+
+func (h SwapRecoveryHandler) OnIBCAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, ack []byte, success bool) error {
+	if success {
+		h.ibcHooksKeeper.DeleteSwapRecoveryAddress(ctx, packet.GetSourceChannel(), packet.GetSequence())
+		return nil
+	}
+	return h.recoverFunds(ctx, packet, data)
+}
+
+func (h SwapRecoveryHandler) OnIBCTimeout(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+	return h.recoverFunds(ctx, packet, data)
+}
+
+func (h SwapRecoveryHandler) recoverFunds(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+	recoveryAddress := h.ibcHooksKeeper.GetSwapRecoveryAddress(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if recoveryAddress == "" {
+		// Anyone can request this callback in a memo. Only packets sent by execNativeSwap have a recovery address.
+		return nil
+	}
+	h.ibcHooksKeeper.DeleteSwapRecoveryAddress(ctx, packet.GetSourceChannel(), packet.GetSequence())
+
+	recoveryAddr, err := sdk.AccAddressFromBech32(recoveryAddress)
+	if err != nil {
+		return err
+	}
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+	amount, ok := osmomath.NewIntFromString(data.Amount)
+	if !ok {
+		return errorsmod.Wrap(types.ErrInvalidPacket, "Amount is not an int")
+	}
+
+	// The transfer module has refunded the packet's tokens to the sender, under their local denom
+	denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	return h.bankKeeper.SendCoins(ctx, sender, recoveryAddr, sdk.NewCoins(sdk.NewCoin(denom, amount)))
+}
@@ -5,8 +5,13 @@ const (
 	RouterKey  = ModuleName
 	StoreKey   = "hooks-for-ibc" // not using the module name because of collisions with key "ibc"
 
-	IBCCallbackKey = "ibc_callback"
-	IBCAsyncAckKey = "ibc_async_ack"
+	IBCCallbackKey       = "ibc_callback"
+	IBCNativeCallbackKey = "ibc_native_callback"
+	IBCAsyncAckKey       = "ibc_async_ack"
+	IBCSwapKey           = "swap"
+
+	// NativeSwapCallback is the name of the native callback handler that recovers the output of failed swap forwards
+	NativeSwapCallback = "swap"
 
 	MsgEmitAckKey           = "emit_ack"
 	AttributeSender         = "sender"
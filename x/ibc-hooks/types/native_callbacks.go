@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// NativeCallbackHandler is implemented by Go modules that want to be notified when an ICS20 packet
+// they track is acknowledged or times out.
+//
+// A packet is routed to a handler either by setting the IBCNativeCallbackKey memo key to the name the
+// handler was registered with, or by calling Keeper.StorePacketNativeCallback after sending the packet.
+// Since anyone can set the memo key, handlers must check the packet data (e.g. its sender) before
+// acting on a callback.
+type NativeCallbackHandler interface {
+	// OnIBCAcknowledgement is called when the packet is acknowledged. success is false for error acks.
+	OnIBCAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, ack []byte, success bool) error
+	// OnIBCTimeout is called when the packet times out.
+	OnIBCTimeout(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error
+}
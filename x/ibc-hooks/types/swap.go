@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// DefaultSwapForwardTimeoutNanos is the relative timeout used when forwarding the output of a native swap over IBC
+const DefaultSwapForwardTimeoutNanos = uint64(10 * 60 * 1_000_000_000) // 10 minutes
+
+// SwapAmountInRoute is one hop of a native swap. It mirrors poolmanager's SwapAmountInRoute.
+type SwapAmountInRoute struct {
+	PoolId        uint64 `json:"pool_id"`
+	TokenOutDenom string `json:"token_out_denom"`
+}
+
+// SwapForward describes where to send the output of a native swap over IBC
+type SwapForward struct {
+	Channel  string `json:"channel"`
+	Receiver string `json:"receiver"`
+	Memo     string `json:"memo,omitempty"`
+}
+
+// SwapMemo is the value of the IBCSwapKey memo key. The received tokens are swapped through Routes
+// and the output is either sent to Receiver or forwarded over IBC as described by Forward.
+//
+// The packet's receiver must be RecoveryAddress, so that chains that don't support the swap key still deliver the
+// tokens to it. If the forward packet fails, the output of the swap is sent to RecoveryAddress.
+type SwapMemo struct {
+	Routes            []SwapAmountInRoute `json:"routes"`
+	TokenOutMinAmount osmomath.Int        `json:"token_out_min_amount"`
+	RecoveryAddress   string              `json:"recovery_address"`
+	Receiver          string              `json:"receiver,omitempty"`
+	Forward           *SwapForward        `json:"forward,omitempty"`
+}
+
+// Validate checks the swap memo against the receiver of the packet
+func (m SwapMemo) Validate(packetReceiver string) error {
+	if len(m.Routes) == 0 {
+		return fmt.Errorf("routes cannot be empty")
+	}
+	for _, route := range m.Routes {
+		if err := sdk.ValidateDenom(route.TokenOutDenom); err != nil {
+			return fmt.Errorf("invalid token out denom in route: %w", err)
+		}
+	}
+	if m.TokenOutMinAmount.IsNil() || !m.TokenOutMinAmount.IsPositive() {
+		return fmt.Errorf("token_out_min_amount must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.RecoveryAddress); err != nil {
+		return fmt.Errorf("recovery_address is not a valid bech32 address")
+	}
+	if m.RecoveryAddress != packetReceiver {
+		return fmt.Errorf("recovery_address should be the same as the receiver of the packet")
+	}
+	if m.Receiver != "" && m.Forward != nil {
+		return fmt.Errorf("receiver and forward cannot both be set")
+	}
+	if m.Receiver != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+			return fmt.Errorf("receiver is not a valid bech32 address")
+		}
+	}
+	if m.Forward != nil {
+		if err := m.Forward.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks that the forward is well formed
+func (f SwapForward) Validate() error {
+	if err := host.ChannelIdentifierValidator(f.Channel); err != nil {
+		return fmt.Errorf("invalid forward channel: %w", err)
+	}
+	if f.Receiver == "" {
+		return fmt.Errorf("forward receiver cannot be empty")
+	}
+	return nil
+}
+
+// SwapAck is the result acknowledgement of a packet that executed a native swap
+type SwapAck struct {
+	TokenOut        sdk.Coin `json:"token_out"`
+	ForwardSequence uint64   `json:"forward_sequence,omitempty"`
+	IbcAck          []byte   `json:"ibc_ack"`
+}
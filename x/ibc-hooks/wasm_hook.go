@@ -3,6 +3,7 @@ package ibc_hooks
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
@@ -28,6 +29,11 @@ type WasmHooks struct {
 	ContractKeeper      *wasmkeeper.Keeper
 	ibcHooksKeeper      *keeper.Keeper
 	bech32PrefixAccAddr string
+
+	// The keepers used by native swaps need to be set after creation, as they depend on the transfer stack
+	PoolManagerKeeper types.PoolManagerKeeper
+	TransferKeeper    types.TransferKeeper
+	BankKeeper        types.BankKeeper
 }
 
 func NewWasmHooks(ibcHooksKeeper *keeper.Keeper, contractKeeper *wasmkeeper.Keeper, bech32PrefixAccAddr string) WasmHooks {
@@ -43,15 +49,27 @@ func (h WasmHooks) ProperlyConfigured() bool {
 }
 
 func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
-	if !h.ProperlyConfigured() {
-		// Not configured
-		return im.App.OnRecvPacket(ctx, packet, relayer)
-	}
 	isIcs20, data := isIcs20Packet(packet.GetData())
 	if !isIcs20 {
 		return im.App.OnRecvPacket(ctx, packet, relayer)
 	}
 
+	// Native swaps don't need the contract keeper
+	if h.NativeSwapsConfigured() {
+		isSwapRouted, swapMemo, err := ValidateAndParseSwapMemo(data.GetMemo(), data.Receiver)
+		if isSwapRouted {
+			if err != nil {
+				return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation, err.Error())
+			}
+			return h.execNativeSwap(im, ctx, packet, data, swapMemo, relayer)
+		}
+	}
+
+	if !h.ProperlyConfigured() {
+		// Not configured
+		return im.App.OnRecvPacket(ctx, packet, relayer)
+	}
+
 	// Validate the memo
 	isWasmRouted, contractAddr, msgBytes, err := ValidateAndParseMemo(data.GetMemo(), data.Receiver)
 	if !isWasmRouted {
@@ -248,10 +266,25 @@ func (h WasmHooks) SendPacketOverride(i ICS4Middleware, ctx sdk.Context, chanCap
 	}
 
 	isCallbackRouted, metadata := jsonStringHasKey(ics20data.GetMemo(), types.IBCCallbackKey)
-	if !isCallbackRouted {
+	isNativeCallbackRouted, _ := jsonStringHasKey(ics20data.GetMemo(), types.IBCNativeCallbackKey)
+	if !isCallbackRouted && !isNativeCallbackRouted {
 		return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data) // continue
 	}
 
+	// The native callback must name a registered handler. Unlike contract callbacks, which are ignored if invalid,
+	// we error out before sending so that the sender doesn't rely on a callback that will never happen.
+	var nativeCallback string
+	if isNativeCallbackRouted {
+		var ok bool
+		nativeCallback, ok = metadata[types.IBCNativeCallbackKey].(string)
+		if !ok {
+			return 0, errorsmod.Wrap(types.ErrUnknownNativeCallback, "native callback must be a string")
+		}
+		if _, ok := h.ibcHooksKeeper.GetNativeCallbackHandler(nativeCallback); !ok {
+			return 0, errorsmod.Wrap(types.ErrUnknownNativeCallback, nativeCallback)
+		}
+	}
+
 	// We remove the callback metadata from the memo as it has already been processed.
 
 	// If the only available key in the memo is the callback, we should remove the memo
@@ -259,6 +292,7 @@ func (h WasmHooks) SendPacketOverride(i ICS4Middleware, ctx sdk.Context, chanCap
 	// This way receiver chains that are on old versions of IBC will be able to process the packet
 	callbackRaw := metadata[types.IBCCallbackKey] // This will be used later.
 	delete(metadata, types.IBCCallbackKey)
+	delete(metadata, types.IBCNativeCallbackKey)
 	bzMetadata, err := json.Marshal(metadata)
 	if err != nil {
 		return 0, errorsmod.Wrap(err, "Send packet with callback error")
@@ -279,6 +313,17 @@ func (h WasmHooks) SendPacketOverride(i ICS4Middleware, ctx sdk.Context, chanCap
 		return 0, err
 	}
 
+	if isNativeCallbackRouted {
+		err = h.ibcHooksKeeper.StorePacketNativeCallback(ctx, sourceChannel, seq, nativeCallback)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if !isCallbackRouted {
+		return seq, nil
+	}
+
 	// Make sure the callback contract is a string and a valid bech32 addr. If it isn't, ignore this packet
 	contract, ok := callbackRaw.(string)
 	if !ok {
@@ -293,6 +338,50 @@ func (h WasmHooks) SendPacketOverride(i ICS4Middleware, ctx sdk.Context, chanCap
 	return seq, nil
 }
 
+// execNativeCallback calls the native handler registered for the packet, if any, and deletes the callback.
+// The handler runs in a cached context. Since retrying won't help, an error or a panic in the handler doesn't fail
+// the ack or the timeout: the handler's state changes are discarded and an event is emitted instead.
+func (h WasmHooks) execNativeCallback(ctx sdk.Context, packet channeltypes.Packet, callback func(ctx sdk.Context, handler types.NativeCallbackHandler, data transfertypes.FungibleTokenPacketData) error) {
+	if h.ibcHooksKeeper == nil {
+		return
+	}
+
+	name := h.ibcHooksKeeper.GetPacketNativeCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if name == "" {
+		// No native callback configured
+		return
+	}
+	h.ibcHooksKeeper.DeletePacketNativeCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+
+	handler, ok := h.ibcHooksKeeper.GetNativeCallbackHandler(name)
+	if !ok {
+		// The handler may have been removed in an upgrade after the packet was sent.
+		h.emitNativeCallbackError(ctx, name, packet, types.ErrUnknownNativeCallback)
+		return
+	}
+
+	// Only ICS20 packets can have a callback stored
+	_, data := isIcs20Packet(packet.GetData())
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		return callback(cacheCtx, handler, data)
+	})
+	if err != nil {
+		h.emitNativeCallbackError(ctx, name, packet, err)
+	}
+}
+
+func (h WasmHooks) emitNativeCallbackError(ctx sdk.Context, name string, packet channeltypes.Packet, err error) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"ibc-native-callback-error",
+			sdk.NewAttribute("handler", name),
+			sdk.NewAttribute(types.AttributeChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributePacketSequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute("error", err.Error()),
+		),
+	})
+}
+
 func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
 	err := im.App.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 	if err != nil {
@@ -302,6 +391,10 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Con
 		return nil
 	}
 
+	h.execNativeCallback(ctx, packet, func(ctx sdk.Context, handler types.NativeCallbackHandler, data transfertypes.FungibleTokenPacketData) error {
+		return handler.OnIBCAcknowledgement(ctx, packet, data, acknowledgement, !osmoutils.IsAckError(acknowledgement))
+	})
+
 	if !h.ProperlyConfigured() {
 		// Not configured. Return from the underlying implementation
 		return nil
@@ -349,6 +442,10 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, pa
 		return err
 	}
 
+	h.execNativeCallback(ctx, packet, func(ctx sdk.Context, handler types.NativeCallbackHandler, data transfertypes.FungibleTokenPacketData) error {
+		return handler.OnIBCTimeout(ctx, packet, data)
+	})
+
 	if !h.ProperlyConfigured() {
 		// Not configured. Return from the underlying implementation
 		return nil
@@ -12,6 +12,8 @@ import (
 func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdRateLimits)
+
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -19,3 +21,12 @@ func GetQueryCmd() *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdRateLimits() (*osmocli.QueryDescriptor, *queryproto.RateLimitsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "rate-limits [channel-id] [denom]",
+		Short: "Query the native rate limits of a channel and denom",
+		Long: `{{.Short}}{{.ExampleHeader}}
+		{{.CommandPrefix}} channel-0 uosmo`,
+	}, &queryproto.RateLimitsRequest{}
+}
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v29/x/ibc-rate-limit/types"
+)
+
+// NewCmdHandleAddRateLimitProposal implements a command handler for add rate limit proposal
+func NewCmdHandleAddRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-rate-limit-proposal [channel-id] [denom] [quotas] [flags]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to set the quotas of a channel and denom",
+		Long: strings.TrimSpace(`Submit a proposal to set the quotas of a channel and denom.
+
+Quotas are passed as name:duration:max-percentage-send:max-percentage-recv, separated by commas. The existing quotas of
+the channel and denom are replaced. The channel "any" applies the quotas to transfers of the denom over every channel.
+Ex) add-rate-limit-proposal channel-0 uosmo weekly:168h:10:10,daily:24h:5:5
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			quotas, err := ParseQuotas(args[2])
+			if err != nil {
+				return err
+			}
+			return submitLegacyProposal(cmd, func(title, description string) govtypesv1beta1.Content {
+				return types.NewAddRateLimitProposal(title, description, args[0], args[1], quotas)
+			})
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdHandleRemoveRateLimitProposal implements a command handler for remove rate limit proposal
+func NewCmdHandleRemoveRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-rate-limit-proposal [channel-id] [denom] [flags]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a proposal to remove the quotas of a channel and denom",
+		Example: "remove-rate-limit-proposal channel-0 uosmo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitLegacyProposal(cmd, func(title, description string) govtypesv1beta1.Content {
+				return types.NewRemoveRateLimitProposal(title, description, args[0], args[1])
+			})
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdHandleSetDenomRestrictionsProposal implements a command handler for set denom restrictions proposal
+func NewCmdHandleSetDenomRestrictionsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-restrictions-proposal [denom] [allowed-channels] [flags]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Submit a proposal to restrict the channels a denom can be sent over",
+		Long: strings.TrimSpace(`Submit a proposal to restrict the channels a denom can be sent over.
+
+Allowed channels are separated by commas. Omitting them removes the restriction of the denom.
+Ex) set-denom-restrictions-proposal uosmo channel-0,channel-1
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			allowedChannels := []string{}
+			if len(args) == 2 && args[1] != "" {
+				allowedChannels = strings.Split(args[1], ",")
+			}
+			return submitLegacyProposal(cmd, func(title, description string) govtypesv1beta1.Content {
+				return types.NewSetDenomRestrictionsProposal(title, description, args[0], allowedChannels)
+			})
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+// ParseQuotas parses a comma separated list of quotas formatted as name:duration:max-percentage-send:max-percentage-recv.
+func ParseQuotas(arg string) ([]types.Quota, error) {
+	quotas := []types.Quota{}
+	for _, quotaStr := range strings.Split(arg, ",") {
+		fields := strings.Split(quotaStr, ":")
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("quota %s must be formatted as name:duration:max-percentage-send:max-percentage-recv", quotaStr)
+		}
+		duration, err := time.ParseDuration(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		maxPercentageSend, err := strconv.ParseUint(fields[2], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		maxPercentageRecv, err := strconv.ParseUint(fields[3], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		quotas = append(quotas, types.Quota{
+			Name:              fields[0],
+			Duration:          duration,
+			MaxPercentageSend: uint32(maxPercentageSend),
+			MaxPercentageRecv: uint32(maxPercentageRecv),
+		})
+	}
+	return quotas, nil
+}
+
+func submitLegacyProposal(cmd *cobra.Command, newContent func(title, description string) govtypesv1beta1.Content) error {
+	clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return err
+	}
+	content := newContent(title, description)
+
+	contentMsg, err := v1.NewLegacyContent(content, authority.String())
+	if err != nil {
+		return err
+	}
+
+	msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+	proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+}
@@ -0,0 +1,13 @@
+package client
+
+import (
+	"github.com/osmosis-labs/osmosis/v29/x/ibc-rate-limit/client/cli"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	AddRateLimitProposalHandler         = govclient.NewProposalHandler(cli.NewCmdHandleAddRateLimitProposal)
+	RemoveRateLimitProposalHandler      = govclient.NewProposalHandler(cli.NewCmdHandleRemoveRateLimitProposal)
+	SetDenomRestrictionsProposalHandler = govclient.NewProposalHandler(cli.NewCmdHandleSetDenomRestrictionsProposal)
+)
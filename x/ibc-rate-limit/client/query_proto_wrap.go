@@ -19,3 +19,10 @@ func (q Querier) Params(ctx sdk.Context,
 	params := q.K.GetParams(ctx)
 	return &queryproto.ParamsResponse{Params: params}, nil
 }
+
+func (q Querier) RateLimits(ctx sdk.Context,
+	req queryproto.RateLimitsRequest,
+) (*queryproto.RateLimitsResponse, error) {
+	rateLimits := q.K.GetRateLimits(ctx, req.ChannelId, req.Denom)
+	return &queryproto.RateLimitsResponse{RateLimits: rateLimits}, nil
+}
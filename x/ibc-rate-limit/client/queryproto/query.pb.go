@@ -114,9 +114,109 @@ func (m *ParamsResponse) GetParams() types.Params {
 	return types.Params{}
 }
 
+// RateLimitsRequest is the request type for the Query/RateLimits RPC method.
+type RateLimitsRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *RateLimitsRequest) Reset()         { *m = RateLimitsRequest{} }
+func (m *RateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*RateLimitsRequest) ProtoMessage()    {}
+func (*RateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6904fea69f32464e, []int{2}
+}
+func (m *RateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitsRequest.Merge(m, src)
+}
+func (m *RateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitsRequest proto.InternalMessageInfo
+
+func (m *RateLimitsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RateLimitsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// RateLimitsResponse is the response type for the Query/RateLimits RPC method.
+type RateLimitsResponse struct {
+	RateLimits []types.RateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
+}
+
+func (m *RateLimitsResponse) Reset()         { *m = RateLimitsResponse{} }
+func (m *RateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*RateLimitsResponse) ProtoMessage()    {}
+func (*RateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6904fea69f32464e, []int{3}
+}
+func (m *RateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitsResponse.Merge(m, src)
+}
+func (m *RateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitsResponse proto.InternalMessageInfo
+
+func (m *RateLimitsResponse) GetRateLimits() []types.RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "osmosis.ibcratelimit.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "osmosis.ibcratelimit.v1beta1.ParamsResponse")
+	proto.RegisterType((*RateLimitsRequest)(nil), "osmosis.ibcratelimit.v1beta1.RateLimitsRequest")
+	proto.RegisterType((*RateLimitsResponse)(nil), "osmosis.ibcratelimit.v1beta1.RateLimitsResponse")
 }
 
 func init() {
@@ -124,28 +224,37 @@ func init() {
 }
 
 var fileDescriptor_6904fea69f32464e = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3f, 0x4b, 0x3b, 0x31,
-	0x1c, 0xc6, 0x2f, 0x3f, 0x7e, 0x76, 0x38, 0x51, 0xe1, 0x70, 0x90, 0x52, 0xa2, 0x14, 0x91, 0xfa,
-	0xa7, 0x09, 0xad, 0x93, 0x6b, 0x07, 0x67, 0x2d, 0x4e, 0x4e, 0x26, 0x47, 0x88, 0x81, 0xbb, 0x7c,
-	0xaf, 0x97, 0xb4, 0xe8, 0xea, 0x2b, 0x10, 0x3a, 0xfb, 0x7e, 0x3a, 0x16, 0x5c, 0x9c, 0x44, 0x5a,
-	0x5f, 0x88, 0x5c, 0x92, 0x96, 0x22, 0x52, 0x3a, 0xdd, 0x25, 0xf9, 0x3c, 0xcf, 0xf7, 0xc9, 0x93,
-	0xb8, 0x05, 0x26, 0x07, 0xa3, 0x0c, 0x55, 0x3c, 0x2d, 0x99, 0x15, 0x99, 0xca, 0x95, 0xa5, 0xa3,
-	0x0e, 0x17, 0x96, 0x75, 0xe8, 0x60, 0x28, 0xca, 0x67, 0x52, 0x94, 0x60, 0x21, 0x69, 0x04, 0x92,
-	0xac, 0x92, 0x24, 0x90, 0xf5, 0x7d, 0x09, 0x12, 0x1c, 0x48, 0xab, 0x3f, 0xaf, 0xa9, 0x37, 0x24,
-	0x80, 0xcc, 0x04, 0x65, 0x85, 0xa2, 0x4c, 0x6b, 0xb0, 0xcc, 0x2a, 0xd0, 0x26, 0x9c, 0x9e, 0xa5,
-	0xce, 0x92, 0x72, 0x66, 0x84, 0x1f, 0xb5, 0x1c, 0x5c, 0x30, 0xa9, 0xb4, 0x83, 0x03, 0x7b, 0xba,
-	0x36, 0x67, 0xc1, 0x4a, 0x96, 0x07, 0xdb, 0xe6, 0x5e, 0xbc, 0x73, 0xe3, 0xd6, 0x7d, 0x31, 0x18,
-	0x0a, 0x63, 0x9b, 0x77, 0xf1, 0xee, 0x62, 0xc3, 0x14, 0xa0, 0x8d, 0x48, 0x7a, 0x71, 0xcd, 0x4b,
-	0x0e, 0xd0, 0x11, 0x6a, 0x6d, 0x77, 0x8f, 0xc9, 0xba, 0xcb, 0x11, 0xaf, 0xee, 0xfd, 0x9f, 0x7c,
-	0x1e, 0x46, 0xfd, 0xa0, 0xec, 0xbe, 0xa1, 0x78, 0xeb, 0xb6, 0x0a, 0x9d, 0x8c, 0x51, 0x5c, 0xf3,
-	0x48, 0x72, 0xbe, 0x89, 0x51, 0xc8, 0x55, 0xbf, 0xd8, 0x0c, 0xf6, 0x99, 0x9b, 0xe4, 0xe5, 0xfd,
-	0x7b, 0xfc, 0xaf, 0x95, 0x9c, 0xd0, 0x95, 0x2a, 0xda, 0x95, 0xac, 0xfd, 0x57, 0x19, 0xbd, 0x87,
-	0xc9, 0x0c, 0xa3, 0xe9, 0x0c, 0xa3, 0xaf, 0x19, 0x46, 0xaf, 0x73, 0x1c, 0x4d, 0xe7, 0x38, 0xfa,
-	0x98, 0xe3, 0xe8, 0xfe, 0x5a, 0x2a, 0xfb, 0x38, 0xe4, 0x24, 0x85, 0x7c, 0xe1, 0xd5, 0xce, 0x18,
-	0x37, 0x4b, 0xe3, 0x51, 0xf7, 0x8a, 0x3e, 0xfd, 0xb6, 0x4f, 0x33, 0x25, 0xb4, 0xf5, 0xef, 0xe4,
-	0x8a, 0xe6, 0x35, 0xf7, 0xb9, 0xfc, 0x09, 0x00, 0x00, 0xff, 0xff, 0x40, 0x75, 0x84, 0x4f, 0x44,
-	0x02, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0x6e, 0x0a, 0xab, 0x34, 0x97, 0x7f, 0xb3, 0x40, 0x9a, 0xaa, 0x29, 0x45, 0x16, 0x1a, 0x85,
+	0x91, 0x98, 0x75, 0x5c, 0xe0, 0x98, 0x03, 0x12, 0x12, 0x07, 0x88, 0x38, 0x71, 0x19, 0x4e, 0x6a,
+	0x65, 0x96, 0x12, 0x3b, 0x8d, 0xdd, 0x89, 0x71, 0xe4, 0x09, 0x90, 0xf6, 0x0a, 0x3c, 0x0a, 0x87,
+	0x1d, 0x2b, 0x71, 0xe1, 0x54, 0xa1, 0x96, 0x27, 0xe8, 0x13, 0xa0, 0xd8, 0x6e, 0x1a, 0x21, 0x94,
+	0xe5, 0x94, 0xc4, 0xfe, 0xfe, 0xfc, 0xbe, 0xcf, 0x0e, 0x18, 0x09, 0x99, 0x09, 0xc9, 0x24, 0x66,
+	0x51, 0x5c, 0x10, 0x45, 0x53, 0x96, 0x31, 0x85, 0xcf, 0x8f, 0x23, 0xaa, 0xc8, 0x31, 0x9e, 0xce,
+	0x68, 0x71, 0xe1, 0xe7, 0x85, 0x50, 0x02, 0x1e, 0x58, 0xa4, 0x5f, 0x47, 0xfa, 0x16, 0x39, 0xb8,
+	0x9f, 0x88, 0x44, 0x68, 0x20, 0x2e, 0xdf, 0x0c, 0x67, 0x70, 0x90, 0x08, 0x91, 0xa4, 0x14, 0x93,
+	0x9c, 0x61, 0xc2, 0xb9, 0x50, 0x44, 0x31, 0xc1, 0xa5, 0xdd, 0x7d, 0x1a, 0x6b, 0x49, 0x1c, 0x11,
+	0x49, 0x8d, 0x55, 0x65, 0x9c, 0x93, 0x84, 0x71, 0x0d, 0xb6, 0xd8, 0x27, 0x8d, 0x73, 0xe6, 0xa4,
+	0x20, 0xd9, 0x46, 0xd6, 0x6b, 0x84, 0x96, 0x2b, 0xa7, 0x66, 0x76, 0x0d, 0x47, 0x77, 0xc1, 0xed,
+	0x77, 0x9a, 0x1e, 0xd2, 0xe9, 0x8c, 0x4a, 0x85, 0x3e, 0x80, 0x3b, 0x9b, 0x05, 0x99, 0x0b, 0x2e,
+	0x29, 0x0c, 0x40, 0xcf, 0x38, 0xec, 0x3b, 0x0f, 0x9d, 0x51, 0x7f, 0xfc, 0xc8, 0x6f, 0xea, 0xc2,
+	0x37, 0xec, 0xe0, 0xe6, 0xd5, 0x62, 0xd8, 0x09, 0x2d, 0x13, 0x4d, 0xc1, 0x5e, 0x48, 0x14, 0x7d,
+	0x5b, 0x22, 0x37, 0x56, 0xf0, 0x05, 0x00, 0xf1, 0x19, 0xe1, 0x9c, 0xa6, 0xa7, 0x6c, 0xa2, 0xc5,
+	0x77, 0x83, 0x07, 0xeb, 0xc5, 0x70, 0xef, 0x82, 0x64, 0xe9, 0x2b, 0xb4, 0xdd, 0x43, 0xe1, 0xae,
+	0xfd, 0x78, 0x33, 0x81, 0x87, 0x60, 0x67, 0x42, 0xb9, 0xc8, 0xf6, 0xbb, 0x9a, 0x70, 0x6f, 0xbd,
+	0x18, 0xde, 0x32, 0x04, 0xbd, 0x8c, 0x42, 0xb3, 0x8d, 0xbe, 0x00, 0x58, 0xb7, 0xb4, 0x61, 0x26,
+	0xa0, 0xbf, 0xed, 0xa0, 0x4c, 0x74, 0x63, 0xd4, 0x1f, 0x3f, 0x6e, 0x4e, 0x54, 0xc9, 0x04, 0x83,
+	0x32, 0xd4, 0x7a, 0x31, 0x84, 0xc6, 0xb0, 0xa6, 0x84, 0x42, 0x50, 0x54, 0x6e, 0xe3, 0x1f, 0x5d,
+	0xb0, 0xf3, 0xbe, 0x3c, 0x52, 0x78, 0xe9, 0x80, 0x9e, 0x69, 0x04, 0x1e, 0xb5, 0xe9, 0xcd, 0x76,
+	0x33, 0x78, 0xd6, 0x0e, 0x6c, 0x52, 0x21, 0xff, 0xeb, 0xcf, 0x3f, 0x97, 0xdd, 0x11, 0x3c, 0xc4,
+	0xb5, 0xd3, 0xf7, 0x4a, 0x9a, 0xf7, 0xbf, 0xab, 0x02, 0xbf, 0x3b, 0x00, 0x6c, 0xcb, 0x81, 0xb8,
+	0x65, 0xfe, 0x6a, 0xba, 0xe7, 0xed, 0x09, 0x76, 0xc2, 0x13, 0x3d, 0xa1, 0x07, 0x8f, 0xae, 0x9b,
+	0xb0, 0xd6, 0x69, 0xf0, 0xe9, 0x6a, 0xe9, 0x3a, 0xf3, 0xa5, 0xeb, 0xfc, 0x5e, 0xba, 0xce, 0xb7,
+	0x95, 0xdb, 0x99, 0xaf, 0xdc, 0xce, 0xaf, 0x95, 0xdb, 0xf9, 0xf8, 0x3a, 0x61, 0xea, 0x6c, 0x16,
+	0xf9, 0xb1, 0xc8, 0x36, 0x82, 0x5e, 0x4a, 0x22, 0x59, 0xa9, 0x9f, 0x8f, 0x5f, 0xe2, 0xcf, 0xff,
+	0x7a, 0xc4, 0x29, 0xa3, 0x5c, 0x99, 0x9f, 0x4d, 0x5f, 0xff, 0xa8, 0xa7, 0x1f, 0x27, 0x7f, 0x07,
+	0x00, 0xbd, 0x63, 0x66, 0xa2, 0x09, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -163,6 +272,8 @@ type QueryClient interface {
 	// Params defines a gRPC query method that returns the ibc-rate-limit module's
 	// parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// RateLimits returns the native rate limits of a path.
+	RateLimits(ctx context.Context, in *RateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error)
 }
 
 type queryClient struct {
@@ -182,11 +293,22 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) RateLimits(ctx context.Context, in *RateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error) {
+	out := new(RateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.ibcratelimit.v1beta1.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the ibc-rate-limit module's
 	// parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// RateLimits returns the native rate limits of a path.
+	RateLimits(context.Context, *RateLimitsRequest) (*RateLimitsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -196,6 +318,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *RateLimitsRequest) (*RateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -219,6 +344,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.ibcratelimit.v1beta1.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*RateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.ibcratelimit.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -227,6 +370,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/ibcratelimit/v1beta1/query.proto",
@@ -288,6 +435,80 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -319,6 +540,38 @@ func (m *ParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *RateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -458,6 +711,204 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, types.RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
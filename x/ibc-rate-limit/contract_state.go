@@ -0,0 +1,216 @@
+package ibc_rate_limit
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/ibc-rate-limit/types"
+)
+
+// WasmViewKeeper is the subset of the wasm keeper used to read the state of the rate limiting contract.
+type WasmViewKeeper interface {
+	IterateContractState(ctx context.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool)
+}
+
+var (
+	// contractRateLimitsNamespace is the namespace of the contract's map of (channel, denom) to rate limits
+	contractRateLimitsNamespace = "flow"
+	// contractDenomRestrictionsNamespace is the namespace of the contract's map of denom to accepted channels
+	contractDenomRestrictionsNamespace = "acfd"
+)
+
+type contractQuota struct {
+	Name              string  `json:"name"`
+	MaxPercentageSend uint32  `json:"max_percentage_send"`
+	MaxPercentageRecv uint32  `json:"max_percentage_recv"`
+	Duration          uint64  `json:"duration"`
+	ChannelValue      *string `json:"channel_value"`
+}
+
+type contractFlow struct {
+	Inflow    string `json:"inflow"`
+	Outflow   string `json:"outflow"`
+	PeriodEnd string `json:"period_end"`
+}
+
+type contractRateLimit struct {
+	Quota contractQuota `json:"quota"`
+	Flow  contractFlow  `json:"flow"`
+}
+
+// ContractStateToGenesis reads the rate limits and denom restrictions of the rate limiting contract and returns them
+// as the native genesis state, keeping the current params. Entries that cannot be converted to a valid native state
+// are logged and skipped, emitting an EventSkippedContractEntry event, so that they don't prevent importing the rest.
+func (i *ICS4Wrapper) ContractStateToGenesis(ctx sdk.Context, wasmKeeper WasmViewKeeper, contract sdk.AccAddress) *types.GenesisState {
+	genState := &types.GenesisState{
+		Params:            i.GetParams(ctx),
+		PathRateLimits:    []types.PathRateLimits{},
+		DenomRestrictions: []types.DenomRestriction{},
+	}
+
+	rateLimitsPrefix := contractMapPrefix(contractRateLimitsNamespace)
+	restrictionsPrefix := contractMapPrefix(contractDenomRestrictionsNamespace)
+	wasmKeeper.IterateContractState(ctx, contract, func(key, value []byte) bool {
+		switch {
+		case bytes.HasPrefix(key, rateLimitsPrefix):
+			rateLimits, err := parseContractRateLimits(key[len(rateLimitsPrefix):], value)
+			if err != nil {
+				skipContractEntry(ctx, key, err)
+				return false
+			}
+			if len(rateLimits.RateLimits) > 0 {
+				genState.PathRateLimits = append(genState.PathRateLimits, rateLimits)
+			}
+		case bytes.HasPrefix(key, restrictionsPrefix):
+			restriction, err := parseContractDenomRestriction(string(key[len(restrictionsPrefix):]), value)
+			if err != nil {
+				skipContractEntry(ctx, key, err)
+				return false
+			}
+			if len(restriction.AllowedChannels) > 0 {
+				genState.DenomRestrictions = append(genState.DenomRestrictions, restriction)
+			}
+		}
+		return false
+	})
+	return genState
+}
+
+// ImportContractState moves the state of the configured rate limiting contract to the native rate limits and unsets
+// the contract address, so that the native rate limits are enforced from then on. It is a no-op if no contract is
+// configured.
+func (i *ICS4Wrapper) ImportContractState(ctx sdk.Context, wasmKeeper WasmViewKeeper) error {
+	contract := i.GetContractAddress(ctx)
+	if contract == "" {
+		return nil
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return err
+	}
+
+	genState := i.ContractStateToGenesis(ctx, wasmKeeper, contractAddr)
+	genState.Params = types.DefaultParams()
+	i.InitGenesis(ctx, *genState)
+	return nil
+}
+
+// skipContractEntry logs and emits an event for an entry of the contract's storage that couldn't be imported.
+func skipContractEntry(ctx sdk.Context, key []byte, err error) {
+	ctx.Logger().Error("skipping rate limiting contract entry", "key", fmt.Sprintf("%X", key), "error", err.Error())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventSkippedContractEntry,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContractKey, fmt.Sprintf("%X", key)),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	)
+}
+
+// contractMapPrefix returns the prefix of the keys of a map of the contract's storage, which cw-storage-plus encodes as
+// the length prefixed namespace.
+func contractMapPrefix(namespace string) []byte {
+	prefix := binary.BigEndian.AppendUint16(nil, uint16(len(namespace)))
+	return append(prefix, []byte(namespace)...)
+}
+
+// splitContractKey splits a composite key of a map of the contract's storage into its length prefixed first element
+// and the last element.
+func splitContractKey(key []byte) (string, []byte, error) {
+	if len(key) < 2 {
+		return "", nil, errorsmod.Wrapf(types.ErrContractError, "invalid contract storage key %X", key)
+	}
+	length := int(binary.BigEndian.Uint16(key[:2]))
+	if len(key) < 2+length {
+		return "", nil, errorsmod.Wrapf(types.ErrContractError, "invalid contract storage key %X", key)
+	}
+	return string(key[2 : 2+length]), key[2+length:], nil
+}
+
+func parseContractRateLimits(key, value []byte) (types.PathRateLimits, error) {
+	channelId, denomBz, err := splitContractKey(key)
+	if err != nil {
+		return types.PathRateLimits{}, err
+	}
+	denom := string(denomBz)
+
+	var contractRateLimits []contractRateLimit
+	if err := json.Unmarshal(value, &contractRateLimits); err != nil {
+		return types.PathRateLimits{}, errorsmod.Wrapf(types.ErrContractError, "invalid rate limits of %s/%s: %s", channelId, denom, err)
+	}
+
+	rateLimits := types.PathRateLimits{ChannelId: channelId, Denom: denom}
+	for _, rateLimit := range contractRateLimits {
+		inflow, ok := osmomath.NewIntFromString(rateLimit.Flow.Inflow)
+		if !ok {
+			return types.PathRateLimits{}, errorsmod.Wrapf(types.ErrContractError, "invalid inflow %s", rateLimit.Flow.Inflow)
+		}
+		outflow, ok := osmomath.NewIntFromString(rateLimit.Flow.Outflow)
+		if !ok {
+			return types.PathRateLimits{}, errorsmod.Wrapf(types.ErrContractError, "invalid outflow %s", rateLimit.Flow.Outflow)
+		}
+		// Contract timestamps are nanoseconds since the unix epoch
+		periodEnd, err := strconv.ParseInt(rateLimit.Flow.PeriodEnd, 10, 64)
+		if err != nil {
+			return types.PathRateLimits{}, errorsmod.Wrapf(types.ErrContractError, "invalid period end %s", rateLimit.Flow.PeriodEnd)
+		}
+		channelValue := osmomath.ZeroInt()
+		if rateLimit.Quota.ChannelValue != nil {
+			channelValue, ok = osmomath.NewIntFromString(*rateLimit.Quota.ChannelValue)
+			if !ok {
+				return types.PathRateLimits{}, errorsmod.Wrapf(types.ErrContractError, "invalid channel value %s", *rateLimit.Quota.ChannelValue)
+			}
+		}
+		if rateLimit.Quota.Duration > uint64(math.MaxInt64)/uint64(time.Second) {
+			return types.PathRateLimits{}, errorsmod.Wrapf(types.ErrContractError, "invalid duration %d", rateLimit.Quota.Duration)
+		}
+
+		rateLimits.RateLimits = append(rateLimits.RateLimits, types.RateLimit{
+			Quota: types.Quota{
+				Name:              rateLimit.Quota.Name,
+				MaxPercentageSend: rateLimit.Quota.MaxPercentageSend,
+				MaxPercentageRecv: rateLimit.Quota.MaxPercentageRecv,
+				Duration:          time.Duration(rateLimit.Quota.Duration) * time.Second,
+			},
+			Flow: types.Flow{
+				Inflow:    inflow,
+				Outflow:   outflow,
+				PeriodEnd: time.Unix(0, periodEnd).UTC(),
+			},
+			ChannelValue: channelValue,
+		})
+	}
+	if len(rateLimits.RateLimits) == 0 {
+		return rateLimits, nil
+	}
+	if err := rateLimits.Validate(); err != nil {
+		return types.PathRateLimits{}, errorsmod.Wrapf(types.ErrContractError, "invalid rate limits of %s/%s: %s", channelId, denom, err)
+	}
+	return rateLimits, nil
+}
+
+func parseContractDenomRestriction(denom string, value []byte) (types.DenomRestriction, error) {
+	var channels []string
+	if err := json.Unmarshal(value, &channels); err != nil {
+		return types.DenomRestriction{}, errorsmod.Wrapf(types.ErrContractError, "invalid denom restriction of %s: %s", denom, err)
+	}
+	restriction := types.DenomRestriction{Denom: denom, AllowedChannels: channels}
+	if len(channels) == 0 {
+		return restriction, nil
+	}
+	if err := restriction.Validate(); err != nil {
+		return types.DenomRestriction{}, errorsmod.Wrapf(types.ErrContractError, "invalid denom restriction of %s: %s", denom, err)
+	}
+	return restriction, nil
+}
@@ -7,14 +7,22 @@ import (
 )
 
 // InitGenesis initializes the x/ibc-rate-limit module's state from a provided genesis
-// state, which includes the parameter for the contract address.
+// state, which includes the parameter for the contract address and the native rate limits.
 func (i *ICS4Wrapper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	i.SetParams(ctx, genState.Params)
+	for _, rateLimits := range genState.PathRateLimits {
+		i.SetPathRateLimits(ctx, rateLimits)
+	}
+	for _, restriction := range genState.DenomRestrictions {
+		i.SetDenomRestriction(ctx, restriction)
+	}
 }
 
 // ExportGenesis returns the x/ibc-rate-limit module's exported genesis.
 func (i *ICS4Wrapper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params: i.GetParams(ctx),
+		Params:            i.GetParams(ctx),
+		PathRateLimits:    i.GetAllPathRateLimits(ctx),
+		DenomRestrictions: i.GetAllDenomRestrictions(ctx),
 	}
 }
@@ -0,0 +1,40 @@
+package ibc_rate_limit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/osmosis-labs/osmosis/v29/x/ibc-rate-limit/types"
+)
+
+func (i *ICS4Wrapper) HandleAddRateLimitProposal(ctx sdk.Context, p *types.AddRateLimitProposal) error {
+	return i.AddRateLimit(ctx, p.ChannelId, p.Denom, p.Quotas)
+}
+
+func (i *ICS4Wrapper) HandleRemoveRateLimitProposal(ctx sdk.Context, p *types.RemoveRateLimitProposal) error {
+	return i.RemoveRateLimit(ctx, p.ChannelId, p.Denom)
+}
+
+func (i *ICS4Wrapper) HandleSetDenomRestrictionsProposal(ctx sdk.Context, p *types.SetDenomRestrictionsProposal) error {
+	i.SetDenomRestriction(ctx, types.DenomRestriction{Denom: p.Denom, AllowedChannels: p.AllowedChannels})
+	return nil
+}
+
+func NewRateLimitProposalHandler(i *ICS4Wrapper) govtypesv1.Handler {
+	return func(ctx sdk.Context, content govtypesv1.Content) error {
+		switch c := content.(type) {
+		case *types.AddRateLimitProposal:
+			return i.HandleAddRateLimitProposal(ctx, c)
+		case *types.RemoveRateLimitProposal:
+			return i.HandleRemoveRateLimitProposal(ctx, c)
+		case *types.SetDenomRestrictionsProposal:
+			return i.HandleSetDenomRestrictionsProposal(ctx, c)
+
+		default:
+			return fmt.Errorf("unrecognized ibc rate limit proposal content type: %T", c)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
@@ -137,7 +138,17 @@ func (im *IBCModule) OnRecvPacket(
 
 	contract := im.ics4Middleware.GetContractAddress(ctx)
 	if contract == "" {
-		// The contract has not been configured. Continue as usual
+		// The contract has not been configured. Check the native rate limits
+		var data transfertypes.FungibleTokenPacketData
+		if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+			// Not a transfer packet. Continue as usual
+			return im.app.OnRecvPacket(ctx, packet, relayer)
+		}
+		err := im.ics4Middleware.CheckAndUpdateNativeRateLimits(ctx, types.FlowIn, packet.GetSourceChannel(), packet.GetDestChannel(), data)
+		if err != nil {
+			return osmoutils.NewEmitErrorAcknowledgement(ctx, err)
+		}
+		// if this returns an Acknowledgement that isn't successful, all state changes are discarded
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
 
@@ -208,15 +219,19 @@ func (im *IBCModule) OnTimeoutPacket(
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
 
-// RevertSentPacket Notifies the contract that a sent packet wasn't properly received
+// RevertSentPacket Notifies the contract, or the native rate limits, that a sent packet wasn't properly received
 func (im *IBCModule) RevertSentPacket(
 	ctx sdk.Context,
 	packet exported.PacketI,
 ) error {
 	contract := im.ics4Middleware.GetContractAddress(ctx)
 	if contract == "" {
-		// The contract has not been configured. Continue as usual
-		return nil
+		// The contract has not been configured. Revert the native rate limits
+		var data transfertypes.FungibleTokenPacketData
+		if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+			return err
+		}
+		return im.ics4Middleware.UndoNativeSend(ctx, packet.GetSourceChannel(), data)
 	}
 
 	if err := UndoSendRateLimit(
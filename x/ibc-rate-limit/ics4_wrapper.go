@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +32,7 @@ type ICS4Wrapper struct {
 	bankKeeper     *bankkeeper.BaseKeeper
 	ContractKeeper *wasmkeeper.PermissionedKeeper
 	paramSpace     paramtypes.Subspace
+	storeKey       storetypes.StoreKey
 }
 
 func (i *ICS4Wrapper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
@@ -40,7 +42,7 @@ func (i *ICS4Wrapper) GetAppVersion(ctx sdk.Context, portID, channelID string) (
 func NewICS4Middleware(
 	channel porttypes.ICS4Wrapper,
 	accountKeeper *authkeeper.AccountKeeper, contractKeeper *wasmkeeper.PermissionedKeeper,
-	bankKeeper *bankkeeper.BaseKeeper, paramSpace paramtypes.Subspace,
+	bankKeeper *bankkeeper.BaseKeeper, paramSpace paramtypes.Subspace, storeKey storetypes.StoreKey,
 ) ICS4Wrapper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -51,14 +53,15 @@ func NewICS4Middleware(
 		ContractKeeper: contractKeeper,
 		bankKeeper:     bankKeeper,
 		paramSpace:     paramSpace,
+		storeKey:       storeKey,
 	}
 }
 
 // SendPacket implements the ICS4 interface and is called when sending packets.
 // This method retrieves the contract from the middleware's parameters and checks if the limits have been exceeded for
 // the current transfer, in which case it returns an error preventing the IBC send from taking place.
-// If the contract param is not configured, or the contract doesn't have a configuration for the (channel+denom) being
-// used, transfers are not prevented and handled by the wrapped IBC app
+// If the contract param is not configured, the native rate limits are checked instead. If there is no configuration
+// for the (channel+denom) being used, transfers are not prevented and handled by the wrapped IBC app
 func (i *ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, sourcePort, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
 	var packetdata transfertypes.FungibleTokenPacketData
 	if err := json.Unmarshal(data, &packetdata); err != nil {
@@ -69,7 +72,10 @@ func (i *ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capab
 	}
 	contract := i.GetContractAddress(ctx)
 	if contract == "" {
-		// The contract has not been configured. Continue as usual
+		// The contract has not been configured. Check the native rate limits
+		if err := i.CheckAndUpdateNativeRateLimits(ctx, types.FlowOut, sourceChannel, "", packetdata); err != nil {
+			return 0, errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
+		}
 		return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	}
 
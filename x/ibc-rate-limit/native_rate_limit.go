@@ -0,0 +1,243 @@
+package ibc_rate_limit
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v29/x/ibc-rate-limit/types"
+)
+
+// The native rate limits implement the same semantics as the rate limiting contract. They are used when the contract
+// address param is not set.
+
+// CheckAndUpdateNativeRateLimits checks the transfer against the denom restrictions and the rate limits of its path
+// and of the "any" channel path of its denom, and updates their flows. If any of the quotas is exceeded, an error is
+// returned and no flow is updated.
+func (i *ICS4Wrapper) CheckAndUpdateNativeRateLimits(ctx sdk.Context, direction types.FlowDirection, sourceChannel, destChannel string, data transfertypes.FungibleTokenPacketData) error {
+	if err := i.checkDenomRestriction(ctx, direction, sourceChannel, data.Denom); err != nil {
+		return err
+	}
+
+	channelId, denom := pathData(direction, sourceChannel, destChannel, data.Denom)
+	pathRateLimits, found := i.GetPathRateLimits(ctx, channelId, denom)
+	anyRateLimits, anyFound := i.GetPathRateLimits(ctx, types.AnyChannel, denom)
+	if !found && !anyFound {
+		// No quota configured for the path. Allowing the transfer.
+		return nil
+	}
+
+	amount, ok := osmomath.NewIntFromString(data.Amount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrBadMessage, "invalid packet amount %s", data.Amount)
+	}
+	// The contract uses the SupplyOf query, which includes the supply offset
+	channelValue := i.bankKeeper.GetSupplyWithOffset(ctx, denom).Amount
+
+	for _, rateLimits := range []*types.PathRateLimits{&pathRateLimits, &anyRateLimits} {
+		for j := range rateLimits.RateLimits {
+			if err := rateLimits.RateLimits[j].AllowTransfer(channelId, denom, direction, amount, channelValue, ctx.BlockTime()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if found {
+		i.SetPathRateLimits(ctx, pathRateLimits)
+	}
+	if anyFound {
+		i.SetPathRateLimits(ctx, anyRateLimits)
+	}
+	return nil
+}
+
+// UndoNativeSend removes the value of a sent packet that failed from the outflow of its path and of the "any"
+// channel path of its denom.
+func (i *ICS4Wrapper) UndoNativeSend(ctx sdk.Context, sourceChannel string, data transfertypes.FungibleTokenPacketData) error {
+	channelId, denom := pathData(types.FlowOut, sourceChannel, "", data.Denom)
+	amount, ok := osmomath.NewIntFromString(data.Amount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrBadMessage, "invalid packet amount %s", data.Amount)
+	}
+
+	for _, channel := range []string{channelId, types.AnyChannel} {
+		rateLimits, found := i.GetPathRateLimits(ctx, channel, denom)
+		if !found {
+			continue
+		}
+		for j := range rateLimits.RateLimits {
+			rateLimits.RateLimits[j].Flow.UndoOutflow(amount)
+		}
+		i.SetPathRateLimits(ctx, rateLimits)
+	}
+	return nil
+}
+
+// checkDenomRestriction returns an error if the denom of the packet is restricted to channels that don't include the
+// source channel. Only outflows are restricted.
+func (i *ICS4Wrapper) checkDenomRestriction(ctx sdk.Context, direction types.FlowDirection, sourceChannel, packetDenom string) error {
+	if direction == types.FlowIn {
+		return nil
+	}
+	restriction, found := i.GetDenomRestriction(ctx, packetDenom)
+	if !found || len(restriction.AllowedChannels) == 0 {
+		return nil
+	}
+	for _, channel := range restriction.AllowedChannels {
+		if channel == sourceChannel {
+			return nil
+		}
+	}
+	return errorsmod.Wrapf(types.ErrChannelBlocked, "denom %s cannot be sent over %s", packetDenom, sourceChannel)
+}
+
+// pathData returns the osmosis channel and the local denom of a transfer. The channel is the source channel for
+// sends and the destination channel for receives.
+func pathData(direction types.FlowDirection, sourceChannel, destChannel, packetDenom string) (channelId, denom string) {
+	if direction == types.FlowOut {
+		return sourceChannel, localDenomForSends(packetDenom)
+	}
+	return destChannel, localDenomForRecvs(sourceChannel, destChannel, packetDenom)
+}
+
+func localDenomForSends(packetDenom string) string {
+	if !strings.HasPrefix(packetDenom, "transfer/") {
+		// Native tokens are sent with their denom
+		return packetDenom
+	}
+	return hashDenom(packetDenom)
+}
+
+func localDenomForRecvs(sourceChannel, destChannel, packetDenom string) string {
+	if strings.HasPrefix(packetDenom, fmt.Sprintf("transfer/%s", sourceChannel)) {
+		// These are tokens that were sent to the counterparty and are returning
+		unprefixed, _ := strings.CutPrefix(packetDenom, fmt.Sprintf("transfer/%s/", sourceChannel))
+		first, _, hasSlash := strings.Cut(unprefixed, "/")
+		if !hasSlash || first == "factory" {
+			// Native and tokenfactory tokens are unprefixed
+			return unprefixed
+		}
+		// Non-native tokens that were sent to the counterparty are still prefixed
+		return hashDenom(unprefixed)
+	}
+	// Tokens that come from the counterparty are prefixed with the destination channel
+	return hashDenom(fmt.Sprintf("transfer/%s/%s", destChannel, packetDenom))
+}
+
+func hashDenom(denom string) string {
+	return fmt.Sprintf("ibc/%X", sha256.Sum256([]byte(denom)))
+}
+
+// GetRateLimits returns the native rate limits of a path.
+func (i *ICS4Wrapper) GetRateLimits(ctx sdk.Context, channelId, denom string) []types.RateLimit {
+	rateLimits, _ := i.GetPathRateLimits(ctx, channelId, denom)
+	return rateLimits.RateLimits
+}
+
+// GetPathRateLimits returns the native rate limits of a path and whether the path has any.
+func (i *ICS4Wrapper) GetPathRateLimits(ctx sdk.Context, channelId, denom string) (types.PathRateLimits, bool) {
+	store := ctx.KVStore(i.storeKey)
+	rateLimits := types.PathRateLimits{}
+	found, err := osmoutils.Get(store, types.GetPathRateLimitsKey(channelId, denom), &rateLimits)
+	if err != nil {
+		panic(err)
+	}
+	return rateLimits, found && len(rateLimits.RateLimits) > 0
+}
+
+// SetPathRateLimits stores the native rate limits of a path. A path without rate limits is deleted.
+func (i *ICS4Wrapper) SetPathRateLimits(ctx sdk.Context, rateLimits types.PathRateLimits) {
+	store := ctx.KVStore(i.storeKey)
+	key := types.GetPathRateLimitsKey(rateLimits.ChannelId, rateLimits.Denom)
+	if len(rateLimits.RateLimits) == 0 {
+		store.Delete(key)
+		return
+	}
+	osmoutils.MustSet(store, key, &rateLimits)
+}
+
+// GetAllPathRateLimits returns the native rate limits of every path.
+func (i *ICS4Wrapper) GetAllPathRateLimits(ctx sdk.Context) []types.PathRateLimits {
+	store := ctx.KVStore(i.storeKey)
+	rateLimits, err := osmoutils.GatherValuesFromStorePrefix(store, types.PathRateLimitsPrefix, parsePathRateLimits)
+	if err != nil {
+		panic(err)
+	}
+	return rateLimits
+}
+
+// AddRateLimit replaces the quotas of a path with the given ones, with flows starting at the current block time.
+func (i *ICS4Wrapper) AddRateLimit(ctx sdk.Context, channelId, denom string, quotas []types.Quota) error {
+	if err := types.ValidatePath(channelId, denom); err != nil {
+		return err
+	}
+	if err := types.ValidateQuotas(quotas); err != nil {
+		return err
+	}
+	rateLimits := types.PathRateLimits{ChannelId: channelId, Denom: denom}
+	for _, quota := range quotas {
+		rateLimits.RateLimits = append(rateLimits.RateLimits, types.NewRateLimit(quota, ctx.BlockTime()))
+	}
+	i.SetPathRateLimits(ctx, rateLimits)
+	return nil
+}
+
+// RemoveRateLimit removes all the quotas of a path.
+func (i *ICS4Wrapper) RemoveRateLimit(ctx sdk.Context, channelId, denom string) error {
+	if _, found := i.GetPathRateLimits(ctx, channelId, denom); !found {
+		return errorsmod.Wrapf(types.ErrInvalidRateLimit, "no rate limit for %s/%s", channelId, denom)
+	}
+	ctx.KVStore(i.storeKey).Delete(types.GetPathRateLimitsKey(channelId, denom))
+	return nil
+}
+
+// GetDenomRestriction returns the channel restriction of a denom and whether it exists.
+func (i *ICS4Wrapper) GetDenomRestriction(ctx sdk.Context, denom string) (types.DenomRestriction, bool) {
+	store := ctx.KVStore(i.storeKey)
+	restriction := types.DenomRestriction{}
+	found, err := osmoutils.Get(store, types.GetDenomRestrictionKey(denom), &restriction)
+	if err != nil {
+		panic(err)
+	}
+	return restriction, found
+}
+
+// SetDenomRestriction stores the channel restriction of a denom. A restriction without channels is deleted.
+func (i *ICS4Wrapper) SetDenomRestriction(ctx sdk.Context, restriction types.DenomRestriction) {
+	store := ctx.KVStore(i.storeKey)
+	key := types.GetDenomRestrictionKey(restriction.Denom)
+	if len(restriction.AllowedChannels) == 0 {
+		store.Delete(key)
+		return
+	}
+	osmoutils.MustSet(store, key, &restriction)
+}
+
+// GetAllDenomRestrictions returns the channel restrictions of every denom.
+func (i *ICS4Wrapper) GetAllDenomRestrictions(ctx sdk.Context) []types.DenomRestriction {
+	store := ctx.KVStore(i.storeKey)
+	restrictions, err := osmoutils.GatherValuesFromStorePrefix(store, types.DenomRestrictionPrefix, parseDenomRestriction)
+	if err != nil {
+		panic(err)
+	}
+	return restrictions
+}
+
+func parsePathRateLimits(bz []byte) (types.PathRateLimits, error) {
+	rateLimits := types.PathRateLimits{}
+	err := proto.Unmarshal(bz, &rateLimits)
+	return rateLimits, err
+}
+
+func parseDenomRestriction(bz []byte) (types.DenomRestriction, error) {
+	restriction := types.DenomRestriction{}
+	err := proto.Unmarshal(bz, &restriction)
+	return restriction, err
+}
@@ -0,0 +1,251 @@
+package ibc_rate_limit_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	ibcratelimit "github.com/osmosis-labs/osmosis/v29/x/ibc-rate-limit"
+	"github.com/osmosis-labs/osmosis/v29/x/ibc-rate-limit/types"
+)
+
+const weekly = 7 * 24 * time.Hour
+
+// executeProposal executes a rate limit proposal on chain A through the governance handler
+func (suite *MiddlewareTestSuite) executeProposal(content govtypesv1.Content) error {
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	handler := ibcratelimit.NewRateLimitProposalHandler(osmosisApp.RateLimitingICS4Wrapper)
+	return handler(suite.chainA.GetContext(), content)
+}
+
+func (suite *MiddlewareTestSuite) addNativeRateLimit(channel, denom string, percentage uint32) {
+	err := suite.executeProposal(types.NewAddRateLimitProposal("title", "description", channel, denom, []types.Quota{
+		{Name: "weekly", Duration: weekly, MaxPercentageSend: percentage, MaxPercentageRecv: percentage},
+	}))
+	suite.Require().NoError(err)
+}
+
+// Same as fullSendTest, with the quota configured natively instead of in the contract
+func (suite *MiddlewareTestSuite) fullNativeSendTest(native bool) {
+	quotaPercentage := 5
+	suite.initializeEscrow()
+	denom := sdk.DefaultBondDenom
+	if !native {
+		denom = transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", "channel-0", denom)).IBCDenom()
+	}
+
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	channelValue := CalculateChannelValue(suite.chainA.GetContext(), denom, osmosisApp.BankKeeper)
+
+	// The amount to be sent is send 2.5% (quota is 5%)
+	quota := channelValue.QuoRaw(int64(100 / quotaPercentage))
+	sendAmount := quota.QuoRaw(2)
+
+	suite.addNativeRateLimit("channel-0", denom, uint32(quotaPercentage))
+
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(denom, sendAmount))
+	suite.Require().NoError(err)
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(denom, sendAmount))
+	suite.Require().NoError(err)
+
+	rateLimits := osmosisApp.RateLimitingICS4Wrapper.GetRateLimits(suite.chainA.GetContext(), "channel-0", denom)
+	suite.Require().Len(rateLimits, 1)
+	suite.Require().Equal(sendAmount.MulRaw(2).String(), rateLimits[0].Flow.Outflow.String())
+
+	// Sending above the quota should fail. We use 2 instead of 1 here to avoid rounding issues
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(denom, osmomath.NewInt(2)))
+	suite.Require().Error(err)
+}
+
+func (suite *MiddlewareTestSuite) TestNativeSendTransferWithRateLimitingNative() {
+	suite.fullNativeSendTest(true)
+}
+
+func (suite *MiddlewareTestSuite) TestNativeSendTransferWithRateLimitingNonNative() {
+	suite.fullNativeSendTest(false)
+}
+
+func (suite *MiddlewareTestSuite) TestNativeSendTransferReset() {
+	suite.fullNativeSendTest(true)
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	rateLimits := osmosisApp.RateLimitingICS4Wrapper.GetRateLimits(suite.chainA.GetContext(), "channel-0", sdk.DefaultBondDenom)
+
+	suite.chainA.NextBlock()
+	suite.coordinator.IncrementTimeBy(rateLimits[0].Flow.PeriodEnd.Add(time.Second).Sub(suite.coordinator.CurrentTime))
+
+	// Sending should succeed again, with a new period
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, osmomath.NewInt(1)))
+	suite.Require().NoError(err)
+	rateLimits = osmosisApp.RateLimitingICS4Wrapper.GetRateLimits(suite.chainA.GetContext(), "channel-0", sdk.DefaultBondDenom)
+	suite.Require().Equal("1", rateLimits[0].Flow.Outflow.String())
+}
+
+// Same as fullRecvTest, with the quota configured natively instead of in the contract
+func (suite *MiddlewareTestSuite) fullNativeRecvTest(native bool) {
+	quotaPercentage := 4
+	suite.initializeEscrow()
+	sendDenom := sdk.DefaultBondDenom
+	localDenom := sdk.DefaultBondDenom
+	if native {
+		localDenom = transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", "channel-0", localDenom)).IBCDenom()
+	} else {
+		sendDenom = transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", "channel-0", sendDenom)).IBCDenom()
+	}
+
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	channelValue := CalculateChannelValue(suite.chainA.GetContext(), localDenom, osmosisApp.BankKeeper)
+
+	// The amount to be sent is 2% (quota is 4%)
+	quota := channelValue.QuoRaw(int64(100 / quotaPercentage))
+	sendAmount := quota.QuoRaw(2)
+
+	suite.addNativeRateLimit("channel-0", localDenom, uint32(quotaPercentage))
+
+	_, err := suite.AssertReceive(true, suite.MessageFromBToA(sendDenom, sendAmount))
+	suite.Require().NoError(err)
+	_, err = suite.AssertReceive(true, suite.MessageFromBToA(sendDenom, sendAmount))
+	suite.Require().NoError(err)
+
+	// Receiving above the quota should fail. We send 2 instead of 1 to account for rounding errors
+	_, err = suite.AssertReceive(false, suite.MessageFromBToA(sendDenom, osmomath.NewInt(2)))
+	suite.Require().NoError(err)
+
+	// The failed receive is not counted
+	rateLimits := osmosisApp.RateLimitingICS4Wrapper.GetRateLimits(suite.chainA.GetContext(), "channel-0", localDenom)
+	suite.Require().Equal(sendAmount.MulRaw(2).String(), rateLimits[0].Flow.Inflow.String())
+}
+
+func (suite *MiddlewareTestSuite) TestNativeRecvTransferWithRateLimitingNative() {
+	suite.fullNativeRecvTest(true)
+}
+
+func (suite *MiddlewareTestSuite) TestNativeRecvTransferWithRateLimitingNonNative() {
+	suite.fullNativeRecvTest(false)
+}
+
+// Test that the "any" channel quota applies to every channel
+func (suite *MiddlewareTestSuite) TestNativeAnyChannel() {
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	channelValue := CalculateChannelValue(suite.chainA.GetContext(), sdk.DefaultBondDenom, osmosisApp.BankKeeper)
+	quota := channelValue.QuoRaw(100)
+	suite.addNativeRateLimit(types.AnyChannel, sdk.DefaultBondDenom, 1)
+
+	_, _, err := suite.FullSendAToC(suite.MessageFromAToC(sdk.DefaultBondDenom, quota.QuoRaw(2)))
+	suite.Require().NoError(err)
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, quota.QuoRaw(2)))
+	suite.Require().NoError(err)
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(sdk.DefaultBondDenom, osmomath.NewInt(2)))
+	suite.Require().Error(err)
+
+	// Removing the quota allows the transfer
+	err = suite.executeProposal(types.NewRemoveRateLimitProposal("title", "description", types.AnyChannel, sdk.DefaultBondDenom))
+	suite.Require().NoError(err)
+	suite.Require().Empty(osmosisApp.RateLimitingICS4Wrapper.GetRateLimits(suite.chainA.GetContext(), types.AnyChannel, sdk.DefaultBondDenom))
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, osmomath.NewInt(2)))
+	suite.Require().NoError(err)
+
+	// Removing a path without quotas fails
+	err = suite.executeProposal(types.NewRemoveRateLimitProposal("title", "description", types.AnyChannel, sdk.DefaultBondDenom))
+	suite.Require().ErrorIs(err, types.ErrInvalidRateLimit)
+}
+
+// Test that funds cannot be sent via a restricted channel
+func (suite *MiddlewareTestSuite) TestNativeDenomRestrictions() {
+	err := suite.executeProposal(types.NewSetDenomRestrictionsProposal("title", "description", sdk.DefaultBondDenom, []string{"channel-1"}))
+	suite.Require().NoError(err)
+
+	// channel-0 is not allowed
+	_, _, err = suite.FullSendAToB(suite.MessageFromAToB(sdk.DefaultBondDenom, osmomath.NewInt(1)))
+	suite.Require().ErrorContains(err, types.ErrChannelBlocked.Error())
+
+	// channel-1 is allowed
+	_, _, err = suite.FullSendAToC(suite.MessageFromAToC(sdk.DefaultBondDenom, osmomath.NewInt(1)))
+	suite.Require().NoError(err)
+
+	// Receives are not restricted
+	_, err = suite.AssertReceive(true, suite.MessageFromBToA(sdk.DefaultBondDenom, osmomath.NewInt(1)))
+	suite.Require().NoError(err)
+
+	// Removing the restriction allows the transfer
+	err = suite.executeProposal(types.NewSetDenomRestrictionsProposal("title", "description", sdk.DefaultBondDenom, []string{}))
+	suite.Require().NoError(err)
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, osmomath.NewInt(1)))
+	suite.Require().NoError(err)
+}
+
+// Test native rate limits are reverted if a "send" fails
+func (suite *MiddlewareTestSuite) TestNativeFailedSendTransfer() {
+	suite.initializeEscrow()
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	suite.addNativeRateLimit("channel-0", sdk.DefaultBondDenom, 1)
+	quota := CalculateChannelValue(suite.chainA.GetContext(), sdk.DefaultBondDenom, osmosisApp.BankKeeper).QuoRaw(100)
+
+	// Use the whole quota with a packet that fails on chain B
+	coins := sdk.NewCoin(sdk.DefaultBondDenom, quota)
+	accountFrom := suite.chainA.SenderAccount.GetAddress().String()
+	msg := transfertypes.NewMsgTransfer("transfer", "channel-0", coins, accountFrom, "INVALID", clienttypes.NewHeight(10, 100), 0, "")
+	res, err := suite.chainA.SendMsgsNoCheck(msg)
+	suite.Require().NoError(err)
+
+	// Sending again fails as the quota is filled
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(sdk.DefaultBondDenom, quota))
+	suite.Require().Error(err)
+
+	suite.chainA.NextBlock()
+	suite.chainA.Coordinator.IncrementTime()
+	err = suite.path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+	err = suite.path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	// Relay the packet and the error acknowledgement
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	newRes, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(newRes.GetEvents())
+	suite.Require().NoError(err)
+	err = suite.path.EndpointA.AcknowledgePacket(packet, ack)
+	suite.Require().NoError(err)
+
+	// The flow of the failed packet has been reverted
+	rateLimits := osmosisApp.RateLimitingICS4Wrapper.GetRateLimits(suite.chainA.GetContext(), "channel-0", sdk.DefaultBondDenom)
+	suite.Require().True(rateLimits[0].Flow.Outflow.IsZero())
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, osmomath.NewInt(1)))
+	suite.Require().NoError(err)
+}
+
+// Test the state of the contract is imported into the native rate limits, which keep enforcing it
+func (suite *MiddlewareTestSuite) TestImportContractState() {
+	attrs := suite.fullSendTest(true)
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	ctx := suite.chainA.GetContext()
+
+	err := osmosisApp.RateLimitingICS4Wrapper.ImportContractState(ctx, osmosisApp.WasmKeeper)
+	suite.Require().NoError(err)
+	suite.Require().Empty(osmosisApp.RateLimitingICS4Wrapper.GetContractAddress(ctx))
+
+	rateLimits := osmosisApp.RateLimitingICS4Wrapper.GetRateLimits(ctx, "channel-0", sdk.DefaultBondDenom)
+	suite.Require().Len(rateLimits, 1)
+	suite.Require().Equal(types.Quota{Name: "weekly", Duration: weekly, MaxPercentageSend: 5, MaxPercentageRecv: 5}, rateLimits[0].Quota)
+	suite.Require().Equal(attrs["weekly_used_out"], rateLimits[0].Flow.Outflow.String())
+	suite.Require().True(rateLimits[0].Flow.Inflow.IsZero())
+	suite.Require().Equal(attrs["weekly_max_out"], rateLimits[0].ChannelValue.MulRaw(5).QuoRaw(100).String())
+
+	restriction, found := osmosisApp.RateLimitingICS4Wrapper.GetDenomRestriction(ctx, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal([]string{"channel-0"}, restriction.AllowedChannels)
+
+	// The quota is still filled
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(sdk.DefaultBondDenom, osmomath.NewInt(2)))
+	suite.Require().Error(err)
+
+	// Importing again is a no-op as the contract is no longer configured
+	err = osmosisApp.RateLimitingICS4Wrapper.ImportContractState(suite.chainA.GetContext(), osmosisApp.WasmKeeper)
+	suite.Require().NoError(err)
+}
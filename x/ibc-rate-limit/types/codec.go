@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&AddRateLimitProposal{}, "osmosis/AddRateLimitProposal", nil)
+	cdc.RegisterConcrete(&RemoveRateLimitProposal{}, "osmosis/RemoveRateLimitProposal", nil)
+	cdc.RegisterConcrete(&SetDenomRestrictionsProposal{}, "osmosis/SetDenomRestrictionsProposal", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypesv1.Content)(nil),
+		&AddRateLimitProposal{},
+		&RemoveRateLimitProposal{},
+		&SetDenomRestrictionsProposal{},
+	)
+}
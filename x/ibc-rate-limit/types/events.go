@@ -5,4 +5,8 @@ const (
 	AttributeKeyPacket      = "packet"
 	AttributeKeyAck         = "acknowledgement"
 	AttributeKeyFailureType = "failure_type"
+
+	EventSkippedContractEntry = "skipped_contract_entry"
+	AttributeKeyContractKey   = "contract_key"
+	AttributeKeyError         = "error"
 )
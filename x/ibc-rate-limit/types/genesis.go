@@ -1,9 +1,15 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
 // DefaultGenesis creates a default GenesisState object.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:            DefaultParams(),
+		PathRateLimits:    []PathRateLimits{},
+		DenomRestrictions: []DenomRestriction{},
 	}
 }
 
@@ -13,5 +19,28 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	paths := make(map[string]bool, len(gs.PathRateLimits))
+	for _, pathRateLimits := range gs.PathRateLimits {
+		if err := pathRateLimits.Validate(); err != nil {
+			return err
+		}
+		key := string(GetPathRateLimitsKey(pathRateLimits.ChannelId, pathRateLimits.Denom))
+		if paths[key] {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "duplicate path %s/%s", pathRateLimits.ChannelId, pathRateLimits.Denom)
+		}
+		paths[key] = true
+	}
+
+	denoms := make(map[string]bool, len(gs.DenomRestrictions))
+	for _, restriction := range gs.DenomRestrictions {
+		if err := restriction.Validate(); err != nil {
+			return err
+		}
+		if denoms[restriction.Denom] {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "duplicate denom restriction %s", restriction.Denom)
+		}
+		denoms[restriction.Denom] = true
+	}
 	return nil
 }
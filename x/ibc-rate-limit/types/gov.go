@@ -0,0 +1,164 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	ProposalTypeAddRateLimit         = "AddRateLimit"
+	ProposalTypeRemoveRateLimit      = "RemoveRateLimit"
+	ProposalTypeSetDenomRestrictions = "SetDenomRestrictions"
+)
+
+func init() {
+	govtypesv1.RegisterProposalType(ProposalTypeAddRateLimit)
+	govtypesv1.RegisterProposalType(ProposalTypeRemoveRateLimit)
+	govtypesv1.RegisterProposalType(ProposalTypeSetDenomRestrictions)
+}
+
+var (
+	_ govtypesv1.Content = &AddRateLimitProposal{}
+	_ govtypesv1.Content = &RemoveRateLimitProposal{}
+	_ govtypesv1.Content = &SetDenomRestrictionsProposal{}
+)
+
+// NewAddRateLimitProposal returns a new instance of an add rate limit proposal struct.
+func NewAddRateLimitProposal(title, description, channelId, denom string, quotas []Quota) govtypesv1.Content {
+	return &AddRateLimitProposal{
+		Title:       title,
+		Description: description,
+		ChannelId:   channelId,
+		Denom:       denom,
+		Quotas:      quotas,
+	}
+}
+
+func (p *AddRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *AddRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *AddRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *AddRateLimitProposal) ProposalType() string { return ProposalTypeAddRateLimit }
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *AddRateLimitProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if err := ValidatePath(p.ChannelId, p.Denom); err != nil {
+		return err
+	}
+	return ValidateQuotas(p.Quotas)
+}
+
+// String returns a string containing the add rate limit proposal.
+func (p AddRateLimitProposal) String() string {
+	quotasStr := ""
+	for _, quota := range p.Quotas {
+		quotasStr = quotasStr + fmt.Sprintf("(Name: %s, Duration: %s, MaxPercentageSend: %d, MaxPercentageRecv: %d) ", quota.Name, quota.Duration, quota.MaxPercentageSend, quota.MaxPercentageRecv)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Add Rate Limit Proposal:
+Title:       %s
+Description: %s
+Channel:     %s
+Denom:       %s
+Quotas:      %s
+`, p.Title, p.Description, p.ChannelId, p.Denom, quotasStr))
+	return b.String()
+}
+
+// NewRemoveRateLimitProposal returns a new instance of a remove rate limit proposal struct.
+func NewRemoveRateLimitProposal(title, description, channelId, denom string) govtypesv1.Content {
+	return &RemoveRateLimitProposal{
+		Title:       title,
+		Description: description,
+		ChannelId:   channelId,
+		Denom:       denom,
+	}
+}
+
+func (p *RemoveRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *RemoveRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *RemoveRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *RemoveRateLimitProposal) ProposalType() string { return ProposalTypeRemoveRateLimit }
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *RemoveRateLimitProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return ValidatePath(p.ChannelId, p.Denom)
+}
+
+// String returns a string containing the remove rate limit proposal.
+func (p RemoveRateLimitProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Remove Rate Limit Proposal:
+Title:       %s
+Description: %s
+Channel:     %s
+Denom:       %s
+`, p.Title, p.Description, p.ChannelId, p.Denom))
+	return b.String()
+}
+
+// NewSetDenomRestrictionsProposal returns a new instance of a set denom restrictions proposal struct.
+func NewSetDenomRestrictionsProposal(title, description, denom string, allowedChannels []string) govtypesv1.Content {
+	return &SetDenomRestrictionsProposal{
+		Title:           title,
+		Description:     description,
+		Denom:           denom,
+		AllowedChannels: allowedChannels,
+	}
+}
+
+func (p *SetDenomRestrictionsProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetDenomRestrictionsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetDenomRestrictionsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetDenomRestrictionsProposal) ProposalType() string {
+	return ProposalTypeSetDenomRestrictions
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *SetDenomRestrictionsProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return DenomRestriction{Denom: p.Denom, AllowedChannels: p.AllowedChannels}.Validate()
+}
+
+// String returns a string containing the set denom restrictions proposal.
+func (p SetDenomRestrictionsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Denom Restrictions Proposal:
+Title:            %s
+Description:      %s
+Denom:            %s
+Allowed Channels: %s
+`, p.Title, p.Description, p.Denom, strings.Join(p.AllowedChannels, ", ")))
+	return b.String()
+}
@@ -5,8 +5,35 @@ import "strings"
 const (
 	ModuleName = "rate-limited-ibc" // IBC at the end to avoid conflicts with the ibc prefix
 
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// AnyChannel is the channel of the paths whose rate limits apply to transfers over every channel.
+	AnyChannel = "any"
+)
+
+var (
+	// PathRateLimitsPrefix is the prefix of the rate limits of each path, keyed by channel and denom.
+	PathRateLimitsPrefix = []byte{0x01}
+
+	// DenomRestrictionPrefix is the prefix of the channel restrictions of each denom.
+	DenomRestrictionPrefix = []byte{0x02}
 )
 
 // RouterKey is the message route. Can only contain
 // alphanumeric characters.
 var RouterKey = strings.ReplaceAll(ModuleName, "-", "")
+
+// GetPathRateLimitsKey returns the store key of the rate limits of a path. The channel is length prefixed so that
+// paths can't collide.
+func GetPathRateLimitsKey(channelId, denom string) []byte {
+	key := append([]byte{}, PathRateLimitsPrefix...)
+	key = append(key, byte(len(channelId)))
+	key = append(key, []byte(channelId)...)
+	return append(key, []byte(denom)...)
+}
+
+// GetDenomRestrictionKey returns the store key of the channel restrictions of a denom.
+func GetDenomRestrictionKey(denom string) []byte {
+	return append(append([]byte{}, DenomRestrictionPrefix...), []byte(denom)...)
+}
@@ -0,0 +1,193 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// FlowDirection is the direction of a transfer, as seen from osmosis.
+type FlowDirection int
+
+const (
+	FlowIn FlowDirection = iota
+	FlowOut
+)
+
+// MaxPercentage is the maximum percentage of the channel value a quota can allow in each direction.
+const MaxPercentage = 100
+
+// NewRateLimit returns a rate limit for the quota with a flow starting at now.
+func NewRateLimit(quota Quota, now time.Time) RateLimit {
+	return RateLimit{
+		Quota: quota,
+		Flow: Flow{
+			Inflow:    osmomath.ZeroInt(),
+			Outflow:   osmomath.ZeroInt(),
+			PeriodEnd: now.Add(quota.Duration),
+		},
+		ChannelValue: osmomath.ZeroInt(),
+	}
+}
+
+// Validate performs a stateless validation of the quota.
+func (q Quota) Validate() error {
+	if q.Name == "" {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "quota name cannot be empty")
+	}
+	if q.MaxPercentageSend > MaxPercentage || q.MaxPercentageRecv > MaxPercentage {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "quota %s percentages cannot be greater than %d", q.Name, MaxPercentage)
+	}
+	if q.Duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "quota %s duration must be positive", q.Name)
+	}
+	return nil
+}
+
+// ValidateQuotas validates each quota and checks that their names are unique.
+func ValidateQuotas(quotas []Quota) error {
+	if len(quotas) == 0 {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "at least one quota is required")
+	}
+	names := make(map[string]bool, len(quotas))
+	for _, quota := range quotas {
+		if err := quota.Validate(); err != nil {
+			return err
+		}
+		if names[quota.Name] {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "duplicate quota %s", quota.Name)
+		}
+		names[quota.Name] = true
+	}
+	return nil
+}
+
+// ValidatePath checks that the path's channel is either a valid channel identifier or AnyChannel, and that the
+// denom is set.
+func ValidatePath(channelId, denom string) error {
+	if channelId != AnyChannel {
+		if err := host.ChannelIdentifierValidator(channelId); err != nil {
+			return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+		}
+	}
+	if strings.TrimSpace(denom) == "" {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "denom cannot be empty")
+	}
+	return nil
+}
+
+// Capacity returns the maximum value that can flow in each direction for the given channel value.
+func (q Quota) Capacity(channelValue osmomath.Int) (maxIn, maxOut osmomath.Int) {
+	maxIn = channelValue.MulRaw(int64(q.MaxPercentageRecv)).QuoRaw(MaxPercentage)
+	maxOut = channelValue.MulRaw(int64(q.MaxPercentageSend)).QuoRaw(MaxPercentage)
+	return maxIn, maxOut
+}
+
+// Balance returns the net value that has flowed in and out of the channel during the period.
+func (f Flow) Balance() (balanceIn, balanceOut osmomath.Int) {
+	balanceIn, balanceOut = osmomath.ZeroInt(), osmomath.ZeroInt()
+	if f.Inflow.GT(f.Outflow) {
+		balanceIn = f.Inflow.Sub(f.Outflow)
+	} else {
+		balanceOut = f.Outflow.Sub(f.Inflow)
+	}
+	return balanceIn, balanceOut
+}
+
+// IsExpired returns true if the period of the flow has ended.
+func (f Flow) IsExpired(now time.Time) bool {
+	return f.PeriodEnd.Before(now)
+}
+
+// UndoOutflow removes value from the outflow, without going below zero. It is used when a sent packet fails.
+func (f *Flow) UndoOutflow(amount osmomath.Int) {
+	if amount.GT(f.Outflow) {
+		f.Outflow = osmomath.ZeroInt()
+		return
+	}
+	f.Outflow = f.Outflow.Sub(amount)
+}
+
+// AllowTransfer applies a transfer to the rate limit, resetting the flow first if its period has ended, and returns
+// an error if the quota is exceeded. The channel value is cached on the first transfer of each period.
+func (r *RateLimit) AllowTransfer(channelId, denom string, direction FlowDirection, amount, channelValue osmomath.Int, now time.Time) error {
+	expired := false
+	if r.Flow.IsExpired(now) {
+		r.Flow.Inflow = osmomath.ZeroInt()
+		r.Flow.Outflow = osmomath.ZeroInt()
+		r.Flow.PeriodEnd = now.Add(r.Quota.Duration)
+		expired = true
+	}
+
+	if direction == FlowIn {
+		r.Flow.Inflow = r.Flow.Inflow.Add(amount)
+	} else {
+		r.Flow.Outflow = r.Flow.Outflow.Add(amount)
+	}
+
+	if r.ChannelValue.IsNil() || r.ChannelValue.IsZero() || expired {
+		r.ChannelValue = channelValue
+		// Non-native tokens are burned before the packet is sent, so the amount is added back to their supply
+		if direction == FlowOut && strings.HasPrefix(denom, "ibc") {
+			r.ChannelValue = r.ChannelValue.Add(amount)
+		}
+	}
+
+	maxIn, maxOut := r.Quota.Capacity(r.ChannelValue)
+	balanceIn, balanceOut := r.Flow.Balance()
+	used, max := balanceIn, maxIn
+	if direction == FlowOut {
+		used, max = balanceOut, maxOut
+	}
+	if used.GT(max) {
+		return errorsmod.Wrapf(ErrRateLimitExceeded, "%s quota of %s over %s exceeded: %s used of %s, resets at %s",
+			r.Quota.Name, denom, channelId, used, max, r.Flow.PeriodEnd.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of the rate limits of a path.
+func (p PathRateLimits) Validate() error {
+	if err := ValidatePath(p.ChannelId, p.Denom); err != nil {
+		return err
+	}
+	quotas := make([]Quota, len(p.RateLimits))
+	for i, rateLimit := range p.RateLimits {
+		if rateLimit.Flow.Inflow.IsNil() || rateLimit.Flow.Outflow.IsNil() ||
+			rateLimit.Flow.Inflow.IsNegative() || rateLimit.Flow.Outflow.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "flow of quota %s must not be negative", rateLimit.Quota.Name)
+		}
+		if !rateLimit.ChannelValue.IsNil() && rateLimit.ChannelValue.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "channel value of quota %s must not be negative", rateLimit.Quota.Name)
+		}
+		quotas[i] = rateLimit.Quota
+	}
+	return ValidateQuotas(quotas)
+}
+
+// Validate performs a stateless validation of the denom restriction.
+func (d DenomRestriction) Validate() error {
+	if strings.TrimSpace(d.Denom) == "" {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "denom cannot be empty")
+	}
+	return ValidateAllowedChannels(d.AllowedChannels)
+}
+
+// ValidateAllowedChannels checks that the channels are valid identifiers and are not repeated.
+func ValidateAllowedChannels(channels []string) error {
+	seen := make(map[string]bool, len(channels))
+	for _, channel := range channels {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+		}
+		if seen[channel] {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "duplicate channel %s", channel)
+		}
+		seen[channel] = true
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+func TestValidateQuotas(t *testing.T) {
+	valid := Quota{Name: "daily", MaxPercentageSend: 5, MaxPercentageRecv: 5, Duration: 24 * time.Hour}
+	testCases := map[string]struct {
+		quotas   []Quota
+		expected bool
+	}{
+		"valid": {
+			quotas:   []Quota{valid},
+			expected: true,
+		},
+		"no quotas": {
+			quotas:   []Quota{},
+			expected: false,
+		},
+		"duplicate name": {
+			quotas:   []Quota{valid, valid},
+			expected: false,
+		},
+		"empty name": {
+			quotas:   []Quota{{MaxPercentageSend: 5, MaxPercentageRecv: 5, Duration: time.Hour}},
+			expected: false,
+		},
+		"percentage too high": {
+			quotas:   []Quota{{Name: "daily", MaxPercentageSend: 101, MaxPercentageRecv: 5, Duration: time.Hour}},
+			expected: false,
+		},
+		"zero duration": {
+			quotas:   []Quota{{Name: "daily", MaxPercentageSend: 5, MaxPercentageRecv: 5}},
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateQuotas(tc.quotas)
+
+			// Assertions.
+			if !tc.expected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAllowTransfer(t *testing.T) {
+	now := time.Unix(1000, 0).UTC()
+	quota := Quota{Name: "daily", MaxPercentageSend: 10, MaxPercentageRecv: 5, Duration: 24 * time.Hour}
+	channelValue := osmomath.NewInt(1000)
+
+	rateLimit := NewRateLimit(quota, now)
+
+	// The send capacity is 100 and the receive capacity is 50
+	require.NoError(t, rateLimit.AllowTransfer("channel-0", "uosmo", FlowOut, osmomath.NewInt(100), channelValue, now))
+	exceeded := rateLimit
+	require.ErrorIs(t, exceeded.AllowTransfer("channel-0", "uosmo", FlowOut, osmomath.NewInt(1), channelValue, now), ErrRateLimitExceeded)
+
+	// Inflows are netted against outflows
+	require.NoError(t, rateLimit.AllowTransfer("channel-0", "uosmo", FlowIn, osmomath.NewInt(150), channelValue, now))
+	exceeded = rateLimit
+	require.ErrorIs(t, exceeded.AllowTransfer("channel-0", "uosmo", FlowIn, osmomath.NewInt(2), channelValue, now), ErrRateLimitExceeded)
+
+	// The channel value is cached for the period
+	require.Equal(t, channelValue, rateLimit.ChannelValue)
+	require.NoError(t, rateLimit.AllowTransfer("channel-0", "uosmo", FlowOut, osmomath.NewInt(1), osmomath.NewInt(10), now))
+	require.Equal(t, channelValue, rateLimit.ChannelValue)
+
+	// The flow and the channel value are reset once the period ends
+	later := now.Add(quota.Duration).Add(time.Second)
+	require.NoError(t, rateLimit.AllowTransfer("channel-0", "uosmo", FlowOut, osmomath.NewInt(1), osmomath.NewInt(10), later))
+	require.Equal(t, osmomath.NewInt(10), rateLimit.ChannelValue)
+	require.Equal(t, osmomath.ZeroInt(), rateLimit.Flow.Inflow)
+	require.Equal(t, osmomath.NewInt(1), rateLimit.Flow.Outflow)
+	require.Equal(t, later.Add(quota.Duration), rateLimit.Flow.PeriodEnd)
+
+	// Undoing a send never makes the outflow negative
+	rateLimit.Flow.UndoOutflow(osmomath.NewInt(5))
+	require.Equal(t, osmomath.ZeroInt(), rateLimit.Flow.Outflow)
+}
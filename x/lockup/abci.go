@@ -24,6 +24,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 		// delete synthetic locks matured before lockup deletion
 		k.DeleteAllMaturedSyntheticLocks(ctx)
 
+		// release matured tranches of scheduled partial unlocks.
+		k.ReleaseMaturedUnlockTranches(ctx, numLocksToDelete)
+
 		// withdraw and delete locks. Requires the corresponding synthetic locks to be deleted.
 		// This is guaranteed, as we delete _ALL_ synthetic locks before withdrawing a bounded number of native locks.
 		k.WithdrawMaturedLocks(ctx, numLocksToDelete)
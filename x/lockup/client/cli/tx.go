@@ -16,6 +16,8 @@ func GetTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(cmd, NewBeginUnlockByIDCmd)
 	osmocli.AddTxCmd(cmd, NewForceUnlockByIdCmd)
 	osmocli.AddTxCmd(cmd, NewSetRewardReceiverAddress)
+	osmocli.AddTxCmd(cmd, NewScheduleUnlockCmd)
+	osmocli.AddTxCmd(cmd, NewTransferLockCmd)
 
 	return cmd
 }
@@ -72,3 +74,21 @@ func NewSetRewardReceiverAddress() (*osmocli.TxCliDesc, *types.MsgSetRewardRecei
 		Long:  "sets reward receiver address for the designated lock id",
 	}, &types.MsgSetRewardReceiverAddress{}
 }
+
+// NewScheduleUnlockCmd schedules a partial release of an individual period lock by ID.
+func NewScheduleUnlockCmd() (*osmocli.TxCliDesc, *types.MsgScheduleUnlock) {
+	return &osmocli.TxCliDesc{
+		Use:   "schedule-unlock",
+		Short: "schedules a partial release of the designated lock id",
+		Long:  "schedules the given coins of the designated lock id to be released after the lock duration, without splitting the lock",
+	}, &types.MsgScheduleUnlock{}
+}
+
+// NewTransferLockCmd transfers the ownership of an individual period lock by ID.
+func NewTransferLockCmd() (*osmocli.TxCliDesc, *types.MsgTransferLock) {
+	return &osmocli.TxCliDesc{
+		Use:   "transfer-lock",
+		Short: "transfers the designated lock id to a new owner",
+		Long:  "transfers the designated lock id to a new owner. locks with synthetic lockups or concentrated liquidity shares can not be transferred",
+	}, &types.MsgTransferLock{}
+}
@@ -61,6 +61,7 @@ func (ak AdminKeeper) BreakLock(ctx sdk.Context, lockID uint64) error {
 	}
 
 	ak.deleteLock(ctx, lockID)
+	ak.deleteUnlockTrancheRefs(ctx, *lock)
 
 	refKeys, err := lockRefKeys(*lock)
 	if err != nil {
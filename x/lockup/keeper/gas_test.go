@@ -62,7 +62,8 @@ func (s *KeeperTestSuite) TestRepeatedLockTokensGas() {
 	}
 	avgGas, maxGas := s.measureAvgAndMaxLockGas(totalNumLocks-startAveragingAt, defaultAddr, coinsFn, durFn)
 	fmt.Printf("test deets: total locks created %d, begin average at %d\n", totalNumLocks, startAveragingAt)
-	s.Assert().LessOrEqual(int(avgGas), 60000, "average gas / lock")
+	// Creating a lock invalidates the taker fee discount cache of the owner in x/poolmanager, which costs 1000 gas.
+	s.Assert().LessOrEqual(int(avgGas), 61000, "average gas / lock")
 	s.Assert().LessOrEqual(int(maxGas), 100000, "max gas / lock")
 }
 
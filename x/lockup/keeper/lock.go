@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/sumtree"
 	cltypes "github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v29/x/lockup/types"
@@ -469,8 +470,34 @@ func (k Keeper) unlockMaturedLockInternalLogic(ctx sdk.Context, lock types.Perio
 		return err
 	}
 
-	// If the lock contains CL liquidity tokens, we do not send them back to the owner.
-	coins := lock.Coins
+	if err := k.sendUnlockedCoins(ctx, owner, lock.Coins); err != nil {
+		return err
+	}
+
+	k.deleteLock(ctx, lock.ID)
+
+	// a lock with an unlock schedule may mature before all of its tranches are released,
+	// in which case the remaining tranches are released along with the lock.
+	k.deleteUnlockTrancheRefs(ctx, lock)
+
+	// delete lock refs from the unlocking queue
+	err = k.deleteLockRefs(ctx, types.KeyPrefixUnlocking, lock)
+	if err != nil {
+		return err
+	}
+
+	// remove from accumulation store
+	for _, coin := range lock.Coins {
+		k.accumulationStore(ctx, coin.Denom).Decrease(accumulationKey(lock.Duration), coin.Amount)
+	}
+
+	k.hooks.OnTokenUnlocked(ctx, owner, lock.ID, lock.Coins, lock.Duration, lock.EndTime)
+	return nil
+}
+
+// sendUnlockedCoins sends the given unlocked coins from the module account back to the owner.
+// If the coins contain CL liquidity tokens, we do not send them back to the owner and burn them instead.
+func (k Keeper) sendUnlockedCoins(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins) error {
 	finalCoinsToSendBackToUser := sdk.NewCoins()
 	for _, coin := range coins {
 		if strings.HasPrefix(coin.Denom, cltypes.ConcentratedLiquidityTokenPrefix) {
@@ -486,27 +513,12 @@ func (k Keeper) unlockMaturedLockInternalLogic(ctx sdk.Context, lock types.Perio
 	}
 
 	// send coins back to owner
-	// if the lock was made completely of CL liquidity tokens, this will be a no-op
+	// if the coins were made completely of CL liquidity tokens, this will be a no-op
 	if !finalCoinsToSendBackToUser.Empty() {
 		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, finalCoinsToSendBackToUser); err != nil {
 			return err
 		}
 	}
-
-	k.deleteLock(ctx, lock.ID)
-
-	// delete lock refs from the unlocking queue
-	err = k.deleteLockRefs(ctx, types.KeyPrefixUnlocking, lock)
-	if err != nil {
-		return err
-	}
-
-	// remove from accumulation store
-	for _, coin := range lock.Coins {
-		k.accumulationStore(ctx, coin.Denom).Decrease(accumulationKey(lock.Duration), coin.Amount)
-	}
-
-	k.hooks.OnTokenUnlocked(ctx, owner, lock.ID, lock.Coins, lock.Duration, lock.EndTime)
 	return nil
 }
 
@@ -541,6 +553,65 @@ func (k Keeper) SetLockRewardReceiverAddress(ctx sdk.Context, lockID uint64, own
 	return nil
 }
 
+// TransferLock transfers the ownership of the given lock to the new owner.
+// Lock refs are re-keyed by the new owner, while the accumulation store is left untouched
+// as it is not indexed by owner. The reward receiver is reset to the new owner.
+// Locks with synthetic lockups and locks of concentrated liquidity positions can not be transferred,
+// as the superfluid and concentrated liquidity modules track the owner of these locks.
+func (k Keeper) TransferLock(ctx sdk.Context, lockID uint64, owner, newOwner sdk.AccAddress) error {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	// check if the lock owner is the method caller.
+	if lock.GetOwner() != owner.String() {
+		return types.ErrNotLockOwner
+	}
+
+	if owner.Equals(newOwner) {
+		return errorsmod.Wrapf(types.ErrLockNotTransferable, "lock %d is already owned by %s", lock.ID, newOwner)
+	}
+
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return errorsmod.Wrapf(types.ErrLockNotTransferable, "lock %d has synthetic lockup", lock.ID)
+	}
+
+	for _, coin := range lock.Coins {
+		if strings.HasPrefix(coin.Denom, cltypes.ConcentratedLiquidityTokenPrefix) {
+			return errorsmod.Wrapf(types.ErrLockNotTransferable, "lock %d holds concentrated liquidity shares %s", lock.ID, coin.Denom)
+		}
+	}
+
+	// remove lock refs keyed by the previous owner
+	err = k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), *lock)
+	if err != nil {
+		return err
+	}
+
+	lock.Owner = newOwner.String()
+	lock.RewardReceiverAddress = types.DefaultOwnerReceiverPlaceholder
+
+	err = k.setLockAndAddLockRefs(ctx, *lock)
+	if err != nil {
+		return err
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLockTransferred(ctx, lock.ID, owner, newOwner)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTransferLock,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, owner.String()),
+			sdk.NewAttribute(types.AttributePeriodLockNewOwner, lock.Owner),
+		),
+	})
+
+	return nil
+}
+
 // ExtendLockup changes the existing lock duration to the given lock duration.
 // Updating lock duration would fail on either of the following conditions.
 // 1. Only lock owner is able to change the duration of the lock.
@@ -626,6 +697,7 @@ func (k Keeper) InitializeAllLocks(ctx sdk.Context, locks []types.PeriodLock) er
 		if err != nil {
 			return err
 		}
+		k.addUnlockTrancheRefs(ctx, lock)
 
 		// Add to the accumulation store cache
 		for _, coin := range lock.Coins {
@@ -882,6 +954,11 @@ func (k Keeper) SplitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coin
 		return types.PeriodLock{}, fmt.Errorf("cannot split unlocking lock")
 	}
 
+	// coins scheduled for release must remain in the original lock.
+	if !coins.IsAllLTE(lock.UnscheduledCoins()) {
+		return types.PeriodLock{}, errorsmod.Wrapf(types.ErrScheduledUnlockExceedsLock, "cannot split %s from lock %d, unscheduled coins are %s", coins, lock.ID, lock.UnscheduledCoins())
+	}
+
 	lock.Coins = lock.Coins.Sub(coins...)
 	err := k.setLock(ctx, lock)
 	if err != nil {
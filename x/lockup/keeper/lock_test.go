@@ -620,6 +620,107 @@ func (s *KeeperTestSuite) TestSetLockRewardReceiverAddress() {
 
 }
 
+func (s *KeeperTestSuite) TestTransferLock() {
+	testCases := []struct {
+		name               string
+		isNotOwner         bool
+		isSameOwner        bool
+		isUnlocking        bool
+		hasSyntheticLockup bool
+		expectedErr        error
+	}{
+		{
+			name: "happy case",
+		},
+		{
+			name:        "happy case: unlocking lock",
+			isUnlocking: true,
+		},
+		{
+			name:        "error: caller of the function is not the owner",
+			isNotOwner:  true,
+			expectedErr: types.ErrNotLockOwner,
+		},
+		{
+			name:        "error: new owner is the current owner",
+			isSameOwner: true,
+			expectedErr: types.ErrLockNotTransferable,
+		},
+		{
+			name:               "error: lock has synthetic lockup",
+			hasSyntheticLockup: true,
+			expectedErr:        types.ErrLockNotTransferable,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			owner, newOwner := s.TestAccs[0], s.TestAccs[1]
+			coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+			s.FundAcc(owner, coins)
+
+			lock, err := s.App.LockupKeeper.CreateLock(s.Ctx, owner, coins, time.Second)
+			s.Require().NoError(err)
+
+			// reward receiver should be reset upon transfer
+			err = s.App.LockupKeeper.SetLockRewardReceiverAddress(s.Ctx, lock.ID, owner, s.TestAccs[2].String())
+			s.Require().NoError(err)
+
+			if tc.isUnlocking {
+				_, err = s.App.LockupKeeper.BeginUnlock(s.Ctx, lock.ID, nil)
+				s.Require().NoError(err)
+			}
+			if tc.hasSyntheticLockup {
+				err = s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, lock.ID, "synthstakestakedtovalidator", time.Second, false)
+				s.Require().NoError(err)
+			}
+
+			sender := owner
+			if tc.isNotOwner {
+				sender = newOwner
+			}
+			if tc.isSameOwner {
+				newOwner = owner
+			}
+
+			// System under test
+			err = s.App.LockupKeeper.TransferLock(s.Ctx, lock.ID, sender, newOwner)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the lock keeps its ID and coins under the new owner
+			transferredLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lock.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(newOwner.String(), transferredLock.Owner)
+			s.Require().Equal(types.DefaultOwnerReceiverPlaceholder, transferredLock.RewardReceiverAddress)
+			s.Require().Equal(coins, transferredLock.Coins)
+
+			// lock refs are moved to the new owner
+			s.Require().Len(s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, owner), 0)
+			s.Require().Len(s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, newOwner), 1)
+			if tc.isUnlocking {
+				s.Require().Equal(coins, s.App.LockupKeeper.GetAccountUnlockingCoins(s.Ctx, newOwner))
+				s.Require().True(s.App.LockupKeeper.GetAccountUnlockingCoins(s.Ctx, owner).Empty())
+			} else {
+				s.Require().Len(s.App.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(s.Ctx, newOwner, "stake", time.Second), 1)
+				s.Require().Len(s.App.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(s.Ctx, owner, "stake", time.Second), 0)
+			}
+
+			// accumulation store is not affected by the transfer
+			acc := s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, types.QueryCondition{
+				Denom:    "stake",
+				Duration: time.Second,
+			})
+			s.Require().Equal(int64(10), acc.Int64())
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestCreateLockNoSend() {
 	s.SetupTest()
 
@@ -134,6 +134,48 @@ func (server msgServer) BeginUnlockingAll(goCtx context.Context, msg *types.MsgB
 	return &types.MsgBeginUnlockingAllResponse{}, nil
 }
 
+// ScheduleUnlock schedules a partial release of the specified lock.
+// The coins are released after the lock's duration has passed, keeping the same lock ID for the remaining coins.
+// N.B. schedule unlock event is emitted downstream in the keeper method.
+func (server msgServer) ScheduleUnlock(goCtx context.Context, msg *types.MsgScheduleUnlock) (*types.MsgScheduleUnlockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime, err := server.keeper.ScheduleUnlock(ctx, msg.ID, owner, msg.Coins)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgScheduleUnlockResponse{EndTime: endTime}, nil
+}
+
+// TransferLock transfers the ownership of the specified lock to the new owner.
+// N.B. transfer lock event is emitted downstream in the keeper method.
+func (server msgServer) TransferLock(goCtx context.Context, msg *types.MsgTransferLock) (*types.MsgTransferLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.TransferLock(ctx, msg.ID, owner, newOwner)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgTransferLockResponse{}, nil
+}
+
 func createBeginUnlockEvent(lock *types.PeriodLock) sdk.Event {
 	return sdk.NewEvent(
 		types.TypeEvtBeginUnlock,
@@ -53,6 +53,11 @@ func syntheticLockTimeStoreKey(lockID uint64, synthDenom string, endTime time.Ti
 		[]byte(synthDenom))
 }
 
+// unlockTrancheTimeStoreKey returns the unlock tranche queue key from lock ID and tranche end time.
+func unlockTrancheTimeStoreKey(lockID uint64, endTime time.Time) []byte {
+	return combineKeys(types.KeyPrefixUnlockTrancheTimestamp, getTimeKey(endTime), sdk.Uint64ToBigEndian(lockID))
+}
+
 // getLockRefs get lock IDs specified on the prefix and timestamp key.
 // nolint: unused
 func (k Keeper) getLockRefs(ctx sdk.Context, key []byte) []uint64 {
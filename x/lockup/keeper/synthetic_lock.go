@@ -127,6 +127,11 @@ func (k Keeper) CreateSyntheticLockup(ctx sdk.Context, lockID uint64, synthDenom
 		return err
 	}
 
+	// scheduled tranches leave the lock over time, so the lock can't back a synthetic lockup.
+	if lock.HasUnlockSchedule() {
+		return types.ErrLockHasUnlockSchedule
+	}
+
 	endTime := time.Time{}
 	if isUnlocking { // end time is set automatically if it's unlocking lockup
 		if unlockDuration > lock.Duration {
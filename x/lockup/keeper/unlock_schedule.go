@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	cltypes "github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v29/x/lockup/types"
+)
+
+// ScheduleUnlock schedules the given coins of the lock to be released once the lock's duration
+// has passed, without splitting the lock into a new lock ID.
+// The coins are added to the lock's unlock schedule as a tranche ending at block time + duration,
+// merged into an existing tranche if one already ends at the same time.
+// Tranche coins remain part of the lock, and thus of the accumulation store, until they are released.
+// Returns the end time of the tranche.
+func (k Keeper) ScheduleUnlock(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, coins sdk.Coins) (time.Time, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if lock.GetOwner() != owner.String() {
+		return time.Time{}, errorsmod.Wrapf(types.ErrNotLockOwner, "msg sender (%s) and lock owner (%s) does not match", owner.String(), lock.GetOwner())
+	}
+
+	if lock.IsUnlocking() {
+		return time.Time{}, fmt.Errorf("cannot schedule unlock of a lock that is already unlocking")
+	}
+
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return time.Time{}, fmt.Errorf("cannot schedule unlock of a lock with synthetic lockup")
+	}
+
+	// releasing a tranche burns concentrated liquidity shares without withdrawing the position's liquidity,
+	// so these locks must be unlocked as a whole through the concentrated liquidity module.
+	for _, coin := range lock.Coins {
+		if strings.HasPrefix(coin.Denom, cltypes.ConcentratedLiquidityTokenPrefix) {
+			return time.Time{}, fmt.Errorf("cannot schedule unlock of lock %d holding concentrated liquidity shares %s", lock.ID, coin.Denom)
+		}
+	}
+
+	if !coins.IsAllLTE(lock.UnscheduledCoins()) {
+		return time.Time{}, errorsmod.Wrapf(types.ErrScheduledUnlockExceedsLock, "requested %s, unscheduled coins are %s", coins, lock.UnscheduledCoins())
+	}
+
+	endTime := ctx.BlockTime().Add(lock.Duration)
+	merged := false
+	for i, tranche := range lock.UnlockSchedule {
+		if tranche.EndTime.Equal(endTime) {
+			lock.UnlockSchedule[i].Coins = tranche.Coins.Add(coins...)
+			merged = true
+			break
+		}
+	}
+	if !merged {
+		lock.UnlockSchedule = append(lock.UnlockSchedule, types.UnlockTranche{Coins: coins, EndTime: endTime})
+	}
+
+	err = k.setLock(ctx, *lock)
+	if err != nil {
+		return time.Time{}, err
+	}
+	k.addUnlockTrancheRefs(ctx, *lock)
+
+	if k.hooks != nil {
+		k.hooks.OnStartUnlock(ctx, owner, lock.ID, coins, lock.Duration, endTime)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtScheduleUnlock,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, coins.String()),
+			sdk.NewAttribute(types.AttributePeriodLockDuration, lock.Duration.String()),
+			sdk.NewAttribute(types.AttributePeriodLockUnlockTime, endTime.String()),
+		),
+	})
+
+	return endTime, nil
+}
+
+// ReleaseMaturedUnlockTranches releases the unlock tranches of up to `numToRelease` locks
+// whose tranche end time has passed by the current block time.
+func (k Keeper) ReleaseMaturedUnlockTranches(ctx sdk.Context, numToRelease int) {
+	// collect the lock IDs first, as releasing modifies the queue being iterated.
+	lockIDs := []uint64{}
+	iterator := k.iteratorBeforeTime(ctx, types.KeyPrefixUnlockTrancheTimestamp, ctx.BlockTime())
+	for ; iterator.Valid() && len(lockIDs) < numToRelease; iterator.Next() {
+		lockID := sdk.BigEndianToUint64(iterator.Value())
+		if len(lockIDs) == 0 || lockIDs[len(lockIDs)-1] != lockID {
+			lockIDs = append(lockIDs, lockID)
+		}
+	}
+	iterator.Close()
+
+	for _, lockID := range lockIDs {
+		// a failed release is reverted and retried in a later block.
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.releaseMaturedUnlockTranches(ctx, lockID)
+		})
+	}
+}
+
+// releaseMaturedUnlockTranches removes all matured tranches from the lock's unlock schedule,
+// removes their coins from the lock and the accumulation store, and sends them to the owner.
+// The lock is deleted once all of its coins are released.
+func (k Keeper) releaseMaturedUnlockTranches(ctx sdk.Context, lockID uint64) error {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+
+	releasedCoins := sdk.NewCoins()
+	remainingSchedule := []types.UnlockTranche{}
+	for _, tranche := range lock.UnlockSchedule {
+		if tranche.EndTime.After(ctx.BlockTime()) {
+			remainingSchedule = append(remainingSchedule, tranche)
+			continue
+		}
+		releasedCoins = releasedCoins.Add(tranche.Coins...)
+		ctx.KVStore(k.storeKey).Delete(unlockTrancheTimeStoreKey(lock.ID, tranche.EndTime))
+	}
+	if releasedCoins.Empty() {
+		return nil
+	}
+
+	// lock refs are keyed by the lock's coins, so they are reset around the coin change.
+	err = k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), *lock)
+	if err != nil {
+		return err
+	}
+
+	lock.Coins = lock.Coins.Sub(releasedCoins...)
+	lock.UnlockSchedule = remainingSchedule
+	if lock.Coins.Empty() {
+		k.deleteLock(ctx, lock.ID)
+	} else {
+		err = k.setLockAndAddLockRefs(ctx, *lock)
+		if err != nil {
+			return err
+		}
+	}
+
+	owner := lock.OwnerAddress()
+	if err := k.sendUnlockedCoins(ctx, owner, releasedCoins); err != nil {
+		return err
+	}
+
+	// remove from accumulation store
+	for _, coin := range releasedCoins {
+		k.accumulationStore(ctx, coin.Denom).Decrease(accumulationKey(lock.Duration), coin.Amount)
+	}
+
+	k.hooks.OnTokenUnlocked(ctx, owner, lock.ID, releasedCoins, lock.Duration, ctx.BlockTime())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtUnlockTranche,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributeUnlockedCoins, releasedCoins.String()),
+		),
+	})
+	return nil
+}
+
+// addUnlockTrancheRefs adds the lock to the unlock tranche queue at each of its tranche end times.
+func (k Keeper) addUnlockTrancheRefs(ctx sdk.Context, lock types.PeriodLock) {
+	store := ctx.KVStore(k.storeKey)
+	for _, tranche := range lock.UnlockSchedule {
+		store.Set(unlockTrancheTimeStoreKey(lock.ID, tranche.EndTime), sdk.Uint64ToBigEndian(lock.ID))
+	}
+}
+
+// deleteUnlockTrancheRefs removes the lock from the unlock tranche queue.
+func (k Keeper) deleteUnlockTrancheRefs(ctx sdk.Context, lock types.PeriodLock) {
+	store := ctx.KVStore(k.storeKey)
+	for _, tranche := range lock.UnlockSchedule {
+		store.Delete(unlockTrancheTimeStoreKey(lock.ID, tranche.EndTime))
+	}
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cltypes "github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v29/x/lockup/types"
+)
+
+func (s *KeeperTestSuite) TestScheduleUnlock() {
+	defaultAddr := sdk.AccAddress([]byte("addr1---------------"))
+	otherAddr := sdk.AccAddress([]byte("addr2---------------"))
+	defaultCoins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	tests := map[string]struct {
+		owner              sdk.AccAddress
+		lockCoins          sdk.Coins
+		scheduledBefore    sdk.Coins
+		isUnlocking        bool
+		hasSyntheticLockup bool
+		coinsToSchedule    sdk.Coins
+		expectedScheduled  sdk.Coins
+		expectedErr        error
+		expectErr          bool
+	}{
+		"schedule part of the lock": {
+			owner:             defaultAddr,
+			coinsToSchedule:   sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+			expectedScheduled: sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+		},
+		"schedule all of the lock": {
+			owner:             defaultAddr,
+			coinsToSchedule:   defaultCoins,
+			expectedScheduled: defaultCoins,
+		},
+		"schedule with the same end time as an existing tranche is merged": {
+			owner:             defaultAddr,
+			scheduledBefore:   sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+			coinsToSchedule:   sdk.Coins{sdk.NewInt64Coin("stake", 3)},
+			expectedScheduled: sdk.Coins{sdk.NewInt64Coin("stake", 7)},
+		},
+		"error: schedule more than the unscheduled coins": {
+			owner:           defaultAddr,
+			scheduledBefore: sdk.Coins{sdk.NewInt64Coin("stake", 8)},
+			coinsToSchedule: sdk.Coins{sdk.NewInt64Coin("stake", 3)},
+			expectedErr:     types.ErrScheduledUnlockExceedsLock,
+		},
+		"error: schedule by someone other than the owner": {
+			owner:           otherAddr,
+			coinsToSchedule: sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+			expectedErr:     types.ErrNotLockOwner,
+		},
+		"error: lock is unlocking": {
+			owner:           defaultAddr,
+			isUnlocking:     true,
+			coinsToSchedule: sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+			expectErr:       true,
+		},
+		"error: lock has synthetic lockup": {
+			owner:              defaultAddr,
+			hasSyntheticLockup: true,
+			coinsToSchedule:    sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+			expectErr:          true,
+		},
+		"error: lock holds concentrated liquidity shares": {
+			owner:           defaultAddr,
+			lockCoins:       sdk.Coins{sdk.NewInt64Coin(cltypes.GetConcentratedLockupDenomFromPoolId(1), 10)},
+			coinsToSchedule: sdk.Coins{sdk.NewInt64Coin(cltypes.GetConcentratedLockupDenomFromPoolId(1), 4)},
+			expectErr:       true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			lockCoins := defaultCoins
+			if tc.lockCoins != nil {
+				lockCoins = tc.lockCoins
+			}
+			s.LockTokens(defaultAddr, lockCoins, time.Second)
+			lockID := uint64(1)
+
+			if !tc.scheduledBefore.Empty() {
+				_, err := s.App.LockupKeeper.ScheduleUnlock(s.Ctx, lockID, defaultAddr, tc.scheduledBefore)
+				s.Require().NoError(err)
+			}
+			if tc.isUnlocking {
+				_, err := s.App.LockupKeeper.BeginUnlock(s.Ctx, lockID, nil)
+				s.Require().NoError(err)
+			}
+			if tc.hasSyntheticLockup {
+				err := s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, lockID, "synthstakestakedtovalidator", time.Second, false)
+				s.Require().NoError(err)
+			}
+
+			endTime, err := s.App.LockupKeeper.ScheduleUnlock(s.Ctx, lockID, tc.owner, tc.coinsToSchedule)
+			if tc.expectedErr != nil || tc.expectErr {
+				s.Require().Error(err)
+				if tc.expectedErr != nil {
+					s.Require().ErrorIs(err, tc.expectedErr)
+				}
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(s.Ctx.BlockTime().Add(time.Second), endTime)
+
+			// the lock keeps its ID and coins, and no new lock is created.
+			locks, err := s.App.LockupKeeper.GetPeriodLocks(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Len(locks, 1)
+			s.Require().Equal(lockID, locks[0].ID)
+			s.Require().Equal(defaultCoins, locks[0].Coins)
+			s.Require().False(locks[0].IsUnlocking())
+			s.Require().Equal([]types.UnlockTranche{{Coins: tc.expectedScheduled, EndTime: endTime}}, locks[0].UnlockSchedule)
+
+			// scheduled coins stay in the accumulation store until released.
+			acc := s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, types.QueryCondition{
+				Denom:    "stake",
+				Duration: time.Second,
+			})
+			s.Require().Equal(int64(10), acc.Int64())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestReleaseMaturedUnlockTranches() {
+	s.SetupTest()
+
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	s.LockTokens(addr, coins, time.Second)
+	lockID := uint64(1)
+
+	// schedule two tranches with distinct end times.
+	_, err := s.App.LockupKeeper.ScheduleUnlock(s.Ctx, lockID, addr, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second / 2))
+	secondEndTime, err := s.App.LockupKeeper.ScheduleUnlock(s.Ctx, lockID, addr, sdk.Coins{sdk.NewInt64Coin("stake", 3)})
+	s.Require().NoError(err)
+
+	// nothing is released before the first tranche matures.
+	s.App.LockupKeeper.ReleaseMaturedUnlockTranches(s.Ctx, 100)
+	s.Require().Equal(int64(0), s.App.BankKeeper.GetBalance(s.Ctx, addr, "stake").Amount.Int64())
+
+	// release the first tranche.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second / 2))
+	s.App.LockupKeeper.ReleaseMaturedUnlockTranches(s.Ctx, 100)
+
+	lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lockID)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 6)}, lock.Coins)
+	s.Require().Equal([]types.UnlockTranche{{Coins: sdk.Coins{sdk.NewInt64Coin("stake", 3)}, EndTime: secondEndTime}}, lock.UnlockSchedule)
+	s.Require().Equal(int64(4), s.App.BankKeeper.GetBalance(s.Ctx, addr, "stake").Amount.Int64())
+	acc := s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, types.QueryCondition{
+		Denom:    "stake",
+		Duration: time.Second,
+	})
+	s.Require().Equal(int64(6), acc.Int64())
+	s.Require().Len(s.App.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(s.Ctx, addr, "stake", time.Second), 1)
+
+	// the remaining coins can't be split into a new lock beyond the unscheduled amount.
+	_, err = s.App.LockupKeeper.BeginUnlock(s.Ctx, lockID, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	s.Require().ErrorIs(err, types.ErrScheduledUnlockExceedsLock)
+
+	// schedule the rest of the lock and release everything.
+	_, err = s.App.LockupKeeper.ScheduleUnlock(s.Ctx, lockID, addr, sdk.Coins{sdk.NewInt64Coin("stake", 3)})
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+	s.App.LockupKeeper.ReleaseMaturedUnlockTranches(s.Ctx, 100)
+
+	// the lock is deleted once all of its coins are released.
+	_, err = s.App.LockupKeeper.GetLockByID(s.Ctx, lockID)
+	s.Require().Error(err)
+	s.Require().Equal(int64(10), s.App.BankKeeper.GetBalance(s.Ctx, addr, "stake").Amount.Int64())
+	acc = s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, types.QueryCondition{
+		Denom:    "stake",
+		Duration: time.Second,
+	})
+	s.Require().Equal(int64(0), acc.Int64())
+	s.Require().Len(s.App.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(s.Ctx, addr, "stake", time.Second), 0)
+}
+
+func (s *KeeperTestSuite) TestCreateSyntheticLockupWithUnlockSchedule() {
+	s.SetupTest()
+
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	s.LockTokens(addr, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+
+	_, err := s.App.LockupKeeper.ScheduleUnlock(s.Ctx, 1, addr, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	s.Require().NoError(err)
+
+	err = s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, 1, "synthstakestakedtovalidator", time.Second, false)
+	s.Require().ErrorIs(err, types.ErrLockHasUnlockSchedule)
+}
@@ -14,6 +14,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgExtendLockup{}, "osmosis/lockup/extend-lockup", nil)
 	cdc.RegisterConcrete(&MsgForceUnlock{}, "osmosis/lockup/force-unlock-tokens", nil)
 	cdc.RegisterConcrete(&MsgSetRewardReceiverAddress{}, "osmosis/lockup/set-reward-receiver-address", nil)
+	cdc.RegisterConcrete(&MsgScheduleUnlock{}, "osmosis/lockup/schedule-unlock", nil)
+	cdc.RegisterConcrete(&MsgTransferLock{}, "osmosis/lockup/transfer-lock", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -25,6 +27,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgExtendLockup{},
 		&MsgForceUnlock{},
 		&MsgSetRewardReceiverAddress{},
+		&MsgScheduleUnlock{},
+		&MsgTransferLock{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
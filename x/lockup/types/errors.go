@@ -13,4 +13,7 @@ var (
 	ErrSyntheticDurationLongerThanNative = errorsmod.Register(ModuleName, 3, "synthetic lockup duration should be shorter than native lockup duration")
 	ErrLockupNotFound                    = errorsmod.Register(ModuleName, 4, "lockup not found")
 	ErrRewardReceiverIsSame              = errorsmod.Register(ModuleName, 5, "reward receiver is the same")
+	ErrScheduledUnlockExceedsLock        = errorsmod.Register(ModuleName, 6, "scheduled unlock exceeds the coins of the lock that are not yet scheduled")
+	ErrLockHasUnlockSchedule             = errorsmod.Register(ModuleName, 7, "lock has a scheduled unlock")
+	ErrLockNotTransferable               = errorsmod.Register(ModuleName, 8, "lock can not be transferred")
 )
@@ -6,9 +6,13 @@ const (
 	TypeEvtAddTokensToLock = "add_tokens_to_lock"
 	TypeEvtBeginUnlockAll  = "begin_unlock_all"
 	TypeEvtBeginUnlock     = "begin_unlock"
+	TypeEvtScheduleUnlock  = "schedule_unlock"
+	TypeEvtUnlockTranche   = "unlock_tranche"
+	TypeEvtTransferLock    = "transfer_lock"
 
 	AttributePeriodLockID         = "period_lock_id"
 	AttributePeriodLockOwner      = "owner"
+	AttributePeriodLockNewOwner   = "new_owner"
 	AttributePeriodLockAmount     = "amount"
 	AttributePeriodLockDenom      = "denom"
 	AttributePeriodLockDuration   = "duration"
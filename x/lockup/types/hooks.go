@@ -13,6 +13,7 @@ type LockupHooks interface {
 	OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 	OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration time.Duration, newDuration time.Duration)
+	OnLockTransferred(ctx sdk.Context, lockID uint64, prevOwner, newOwner sdk.AccAddress)
 }
 
 var _ LockupHooks = MultiLockupHooks{}
@@ -59,3 +60,9 @@ func (h MultiLockupHooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDur
 		h[i].OnLockupExtend(ctx, lockID, prevDuration, newDuration)
 	}
 }
+
+func (h MultiLockupHooks) OnLockTransferred(ctx sdk.Context, lockID uint64, prevOwner, newOwner sdk.AccAddress) {
+	for i := range h {
+		h[i].OnLockTransferred(ctx, lockID, prevOwner, newOwner)
+	}
+}
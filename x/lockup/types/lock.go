@@ -59,6 +59,25 @@ func (p PeriodLock) OwnerAddress() sdk.AccAddress {
 	return addr
 }
 
+// ScheduledUnlockCoins returns the sum of the coins in the lock's unlock schedule.
+func (p PeriodLock) ScheduledUnlockCoins() sdk.Coins {
+	coins := sdk.NewCoins()
+	for _, tranche := range p.UnlockSchedule {
+		coins = coins.Add(tranche.Coins...)
+	}
+	return coins
+}
+
+// UnscheduledCoins returns the coins in the lock that have not been scheduled for release.
+func (p PeriodLock) UnscheduledCoins() sdk.Coins {
+	return p.Coins.Sub(p.ScheduledUnlockCoins()...)
+}
+
+// HasUnlockSchedule returns true if the lock has any tranche scheduled for release.
+func (p PeriodLock) HasUnlockSchedule() bool {
+	return len(p.UnlockSchedule) > 0
+}
+
 func (p PeriodLock) SingleCoin() (sdk.Coin, error) {
 	if len(p.Coins) != 1 {
 		return sdk.Coin{}, fmt.Errorf("PeriodLock %d has no single coin: %s", p.ID, p.Coins)
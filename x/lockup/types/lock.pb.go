@@ -89,6 +89,11 @@ type PeriodLock struct {
 	// the incentives for the lock. This is set to owner by default and can be
 	// changed via separate msg.
 	RewardReceiverAddress string `protobuf:"bytes,6,opt,name=reward_receiver_address,json=rewardReceiverAddress,proto3" json:"reward_receiver_address,omitempty" yaml:"reward_receiver_address"`
+	// UnlockSchedule is the set of tranches of the lock that have been scheduled
+	// for release without splitting the lock. Each tranche is released back to
+	// the owner once its end time has passed. Tranche coins stay part of the
+	// lock's coins until released.
+	UnlockSchedule []UnlockTranche `protobuf:"bytes,7,rep,name=unlock_schedule,json=unlockSchedule,proto3" json:"unlock_schedule" yaml:"unlock_schedule"`
 }
 
 func (m *PeriodLock) Reset()         { *m = PeriodLock{} }
@@ -166,6 +171,69 @@ func (m *PeriodLock) GetRewardReceiverAddress() string {
 	return ""
 }
 
+func (m *PeriodLock) GetUnlockSchedule() []UnlockTranche {
+	if m != nil {
+		return m.UnlockSchedule
+	}
+	return nil
+}
+
+// UnlockTranche is a portion of a lock's coins that has been scheduled for
+// release at a given end time.
+type UnlockTranche struct {
+	// Coins are the tokens that would be released from the lock at end time.
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// EndTime is the time at which the tranche is released from the lock.
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *UnlockTranche) Reset()         { *m = UnlockTranche{} }
+func (m *UnlockTranche) String() string { return proto.CompactTextString(m) }
+func (*UnlockTranche) ProtoMessage()    {}
+func (*UnlockTranche) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e9d7527a237b489, []int{1}
+}
+func (m *UnlockTranche) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlockTranche) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockTranche.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnlockTranche) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockTranche.Merge(m, src)
+}
+func (m *UnlockTranche) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlockTranche) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockTranche.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockTranche proto.InternalMessageInfo
+
+func (m *UnlockTranche) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *UnlockTranche) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 // QueryCondition is a struct used for querying locks upon different conditions.
 // Duration field and timestamp fields could be optional, depending on the
 // LockQueryType.
@@ -188,7 +256,7 @@ func (m *QueryCondition) Reset()         { *m = QueryCondition{} }
 func (m *QueryCondition) String() string { return proto.CompactTextString(m) }
 func (*QueryCondition) ProtoMessage()    {}
 func (*QueryCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e9d7527a237b489, []int{1}
+	return fileDescriptor_7e9d7527a237b489, []int{2}
 }
 func (m *QueryCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,7 +336,7 @@ func (m *SyntheticLock) Reset()         { *m = SyntheticLock{} }
 func (m *SyntheticLock) String() string { return proto.CompactTextString(m) }
 func (*SyntheticLock) ProtoMessage()    {}
 func (*SyntheticLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e9d7527a237b489, []int{2}
+	return fileDescriptor_7e9d7527a237b489, []int{3}
 }
 func (m *SyntheticLock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,6 +396,7 @@ func (m *SyntheticLock) GetDuration() time.Duration {
 func init() {
 	proto.RegisterEnum("osmosis.lockup.LockQueryType", LockQueryType_name, LockQueryType_value)
 	proto.RegisterType((*PeriodLock)(nil), "osmosis.lockup.PeriodLock")
+	proto.RegisterType((*UnlockTranche)(nil), "osmosis.lockup.UnlockTranche")
 	proto.RegisterType((*QueryCondition)(nil), "osmosis.lockup.QueryCondition")
 	proto.RegisterType((*SyntheticLock)(nil), "osmosis.lockup.SyntheticLock")
 }
@@ -335,49 +404,52 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/lock.proto", fileDescriptor_7e9d7527a237b489) }
 
 var fileDescriptor_7e9d7527a237b489 = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0xf3, 0xa7, 0x7f, 0xae, 0x34, 0xb5, 0x4e, 0x45, 0xa4, 0x01, 0xec, 0xc8, 0x03, 0x8a,
-	0x50, 0x6b, 0xd3, 0x30, 0xc1, 0x86, 0x1b, 0x84, 0x8a, 0x2a, 0x04, 0xa6, 0x62, 0xe8, 0x62, 0x39,
-	0xbe, 0x23, 0x3d, 0x35, 0xf6, 0x99, 0x3b, 0xbb, 0xc5, 0xdf, 0x80, 0xb1, 0x23, 0x48, 0x6c, 0x6c,
-	0x7c, 0x0b, 0xb6, 0x8e, 0x1d, 0x99, 0x52, 0xd4, 0x6e, 0x8c, 0xfd, 0x04, 0xe8, 0xee, 0xec, 0x34,
-	0x2d, 0xaa, 0xd4, 0x01, 0x26, 0xfb, 0xee, 0xfd, 0x7e, 0xef, 0x7e, 0x7e, 0xef, 0x9d, 0xc1, 0x0a,
-	0xe5, 0x11, 0xe5, 0x84, 0x3b, 0x23, 0x1a, 0xee, 0x65, 0x89, 0x7c, 0xd8, 0x09, 0xa3, 0x29, 0x85,
-	0xcd, 0x02, 0xb2, 0x15, 0xd4, 0x5e, 0x1e, 0xd2, 0x21, 0x95, 0x90, 0x23, 0xde, 0x54, 0x55, 0xdb,
-	0x18, 0x52, 0x3a, 0x1c, 0x61, 0x47, 0xae, 0x06, 0xd9, 0x7b, 0x07, 0x65, 0x2c, 0x48, 0x09, 0x8d,
-	0x0b, 0xdc, 0xbc, 0x8a, 0xa7, 0x24, 0xc2, 0x3c, 0x0d, 0xa2, 0xa4, 0x24, 0x08, 0xe5, 0x39, 0xce,
-	0x20, 0xe0, 0xd8, 0xd9, 0x5f, 0x1f, 0xe0, 0x34, 0x58, 0x77, 0x42, 0x4a, 0x0a, 0x02, 0xeb, 0x47,
-	0x0d, 0x80, 0xd7, 0x98, 0x11, 0x8a, 0xb6, 0x68, 0xb8, 0x07, 0x9b, 0xa0, 0xba, 0xd9, 0x6f, 0x69,
-	0x1d, 0xad, 0x5b, 0xf7, 0xaa, 0x9b, 0x7d, 0xf8, 0x00, 0x34, 0xe8, 0x41, 0x8c, 0x59, 0xab, 0xda,
-	0xd1, 0xba, 0xf3, 0xae, 0x7e, 0x3e, 0x36, 0x6f, 0xe5, 0x41, 0x34, 0x7a, 0x6a, 0xc9, 0x6d, 0xcb,
-	0x53, 0x30, 0xdc, 0x05, 0x73, 0xe5, 0x64, 0xad, 0x5a, 0x47, 0xeb, 0x2e, 0xf4, 0x56, 0x6c, 0x35,
-	0x9a, 0x5d, 0x8e, 0x66, 0xf7, 0x8b, 0x02, 0x77, 0xfd, 0x68, 0x6c, 0x56, 0x7e, 0x8f, 0x4d, 0x58,
-	0xb6, 0xac, 0xd2, 0x88, 0xa4, 0x38, 0x4a, 0xd2, 0xfc, 0x7c, 0x6c, 0x2e, 0x29, 0xfe, 0x12, 0xb3,
-	0x3e, 0x9f, 0x98, 0x9a, 0x37, 0x61, 0x87, 0x1e, 0x98, 0xc3, 0x31, 0xf2, 0xc5, 0x77, 0xb6, 0xea,
-	0xf2, 0xa4, 0xf6, 0x5f, 0x27, 0x6d, 0x97, 0x22, 0xb8, 0x77, 0xc5, 0x51, 0x17, 0xa4, 0x65, 0xa7,
-	0x75, 0x28, 0x48, 0x67, 0x71, 0x8c, 0x44, 0x29, 0x0c, 0x40, 0x43, 0x48, 0xc2, 0x5b, 0x8d, 0x4e,
-	0x4d, 0x8e, 0xae, 0x44, 0xb3, 0x85, 0x68, 0x76, 0x21, 0x9a, 0xbd, 0x41, 0x49, 0xec, 0x3e, 0x12,
-	0x7c, 0xdf, 0x4f, 0xcc, 0xee, 0x90, 0xa4, 0xbb, 0xd9, 0xc0, 0x0e, 0x69, 0xe4, 0x14, 0x0a, 0xab,
-	0xc7, 0x1a, 0x47, 0x7b, 0x4e, 0x9a, 0x27, 0x98, 0xcb, 0x06, 0xee, 0x29, 0x66, 0xb8, 0x03, 0xee,
-	0x30, 0x7c, 0x10, 0x30, 0xe4, 0x33, 0x1c, 0x62, 0xb2, 0x8f, 0x99, 0x1f, 0x20, 0xc4, 0x30, 0xe7,
-	0xad, 0x19, 0x29, 0xad, 0x75, 0x3e, 0x36, 0x0d, 0x35, 0xe5, 0x35, 0x85, 0x96, 0x77, 0x5b, 0x21,
-	0x5e, 0x01, 0x3c, 0x2b, 0xf6, 0xbf, 0x54, 0x41, 0xf3, 0x4d, 0x86, 0x59, 0xbe, 0x41, 0x63, 0x44,
-	0xa4, 0x4a, 0xcf, 0xc1, 0x92, 0xc8, 0x95, 0xff, 0x41, 0x6c, 0xfb, 0x62, 0x1e, 0x69, 0x6a, 0xb3,
-	0x77, 0xdf, 0xbe, 0x9c, 0x3b, 0x5b, 0xd8, 0x2e, 0x9b, 0xb7, 0xf3, 0x04, 0x7b, 0x8b, 0xa3, 0xe9,
-	0x25, 0x5c, 0x06, 0x0d, 0x84, 0x63, 0x1a, 0x29, 0xfb, 0x3d, 0xb5, 0x10, 0x16, 0xdc, 0xdc, 0xec,
-	0x2b, 0x0e, 0x5c, 0x67, 0xeb, 0x3b, 0x30, 0x3f, 0x89, 0xee, 0x0d, 0x7c, 0xbd, 0x57, 0xb0, 0xea,
-	0x8a, 0x75, 0xd2, 0xaa, 0x8c, 0xbd, 0xa0, 0xb2, 0xbe, 0x56, 0xc1, 0xe2, 0xdb, 0x3c, 0x4e, 0x77,
-	0x71, 0x4a, 0x42, 0x19, 0xf1, 0x55, 0x00, 0xb3, 0x18, 0x61, 0x36, 0xca, 0x49, 0x3c, 0xf4, 0xa5,
-	0x4a, 0x04, 0x15, 0x91, 0xd7, 0x2f, 0x10, 0x51, 0xbb, 0x89, 0xa0, 0x09, 0x16, 0xb8, 0x68, 0xf7,
-	0xa7, 0x75, 0x00, 0x72, 0xab, 0x5f, 0x8a, 0x31, 0xc9, 0x63, 0xed, 0x1f, 0xe5, 0x71, 0xfa, 0x36,
-	0xd5, 0xff, 0xe7, 0x6d, 0x7a, 0xf8, 0x12, 0x2c, 0x5e, 0x0a, 0x00, 0x6c, 0x02, 0xe0, 0xe6, 0x25,
-	0xb7, 0x5e, 0x81, 0x00, 0xcc, 0xb8, 0xb9, 0x18, 0x4a, 0xd7, 0xc4, 0xfb, 0x2b, 0x2a, 0xca, 0xf5,
-	0x2a, 0x5c, 0x00, 0xb3, 0x6e, 0xfe, 0x82, 0xd1, 0x2c, 0xd1, 0x6b, 0xed, 0xfa, 0xa7, 0x6f, 0x46,
-	0xc5, 0xdd, 0x3a, 0x3a, 0x35, 0xb4, 0xe3, 0x53, 0x43, 0xfb, 0x75, 0x6a, 0x68, 0x87, 0x67, 0x46,
-	0xe5, 0xf8, 0xcc, 0xa8, 0xfc, 0x3c, 0x33, 0x2a, 0x3b, 0xbd, 0xa9, 0xdb, 0x52, 0xc4, 0x6f, 0x6d,
-	0x14, 0x0c, 0x78, 0xb9, 0x70, 0xf6, 0x7b, 0x4f, 0x9c, 0x8f, 0xe5, 0x4f, 0x52, 0xde, 0x9e, 0xc1,
-	0x8c, 0xfc, 0xd2, 0xc7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x74, 0x5f, 0x5d, 0xe6, 0x43, 0x05,
-	0x00, 0x00,
+	// 718 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0xf3, 0xa3, 0x3f, 0xae, 0x24, 0x8d, 0x4e, 0x05, 0xdc, 0x00, 0x76, 0xe4, 0x01, 0x45,
+	0xa8, 0xb5, 0x69, 0x99, 0x60, 0xc3, 0x0d, 0x42, 0x45, 0x15, 0x02, 0xb7, 0x30, 0x74, 0xb1, 0x1c,
+	0xdf, 0x35, 0xb1, 0x6a, 0xfb, 0x8c, 0xcf, 0x6e, 0xf1, 0x7f, 0xc0, 0xd8, 0x11, 0x24, 0x36, 0x26,
+	0xf8, 0x43, 0x50, 0xc7, 0x8e, 0x4c, 0x29, 0x6a, 0x37, 0xc6, 0xfe, 0x05, 0xe8, 0xee, 0xec, 0x24,
+	0x6d, 0x55, 0xa9, 0x43, 0x3b, 0xc5, 0xf7, 0xbe, 0xf7, 0xbe, 0x7b, 0xef, 0xbb, 0xfb, 0x2e, 0x60,
+	0x91, 0xd0, 0x80, 0x50, 0x8f, 0x1a, 0x3e, 0x71, 0x77, 0xd3, 0x88, 0xff, 0xe8, 0x51, 0x4c, 0x12,
+	0x02, 0x1b, 0x39, 0xa4, 0x0b, 0xa8, 0xb5, 0xd0, 0x27, 0x7d, 0xc2, 0x21, 0x83, 0x7d, 0x89, 0xac,
+	0x96, 0xd2, 0x27, 0xa4, 0xef, 0x63, 0x83, 0xaf, 0x7a, 0xe9, 0x8e, 0x81, 0xd2, 0xd8, 0x49, 0x3c,
+	0x12, 0xe6, 0xb8, 0x7a, 0x11, 0x4f, 0xbc, 0x00, 0xd3, 0xc4, 0x09, 0xa2, 0x82, 0xc0, 0xe5, 0xfb,
+	0x18, 0x3d, 0x87, 0x62, 0x63, 0x6f, 0xa5, 0x87, 0x13, 0x67, 0xc5, 0x70, 0x89, 0x97, 0x13, 0x68,
+	0x3f, 0xab, 0x00, 0xbc, 0xc3, 0xb1, 0x47, 0xd0, 0x06, 0x71, 0x77, 0x61, 0x03, 0x94, 0xd7, 0xbb,
+	0xb2, 0xd4, 0x96, 0x3a, 0x55, 0xab, 0xbc, 0xde, 0x85, 0x8f, 0x41, 0x8d, 0xec, 0x87, 0x38, 0x96,
+	0xcb, 0x6d, 0xa9, 0x33, 0x6b, 0x36, 0xcf, 0x86, 0xea, 0x9d, 0xcc, 0x09, 0xfc, 0x17, 0x1a, 0x0f,
+	0x6b, 0x96, 0x80, 0xe1, 0x00, 0xcc, 0x14, 0x9d, 0xc9, 0x95, 0xb6, 0xd4, 0x99, 0x5b, 0x5d, 0xd4,
+	0x45, 0x6b, 0x7a, 0xd1, 0x9a, 0xde, 0xcd, 0x13, 0xcc, 0x95, 0xc3, 0xa1, 0x5a, 0xfa, 0x37, 0x54,
+	0x61, 0x51, 0xb2, 0x44, 0x02, 0x2f, 0xc1, 0x41, 0x94, 0x64, 0x67, 0x43, 0x75, 0x5e, 0xf0, 0x17,
+	0x98, 0xf6, 0xf5, 0x58, 0x95, 0xac, 0x11, 0x3b, 0xb4, 0xc0, 0x0c, 0x0e, 0x91, 0xcd, 0xe6, 0x94,
+	0xab, 0x7c, 0xa7, 0xd6, 0xa5, 0x9d, 0xb6, 0x0a, 0x11, 0xcc, 0x07, 0x6c, 0xab, 0x31, 0x69, 0x51,
+	0xa9, 0x1d, 0x30, 0xd2, 0x69, 0x1c, 0x22, 0x96, 0x0a, 0x1d, 0x50, 0x63, 0x92, 0x50, 0xb9, 0xd6,
+	0xae, 0xf0, 0xd6, 0x85, 0x68, 0x3a, 0x13, 0x4d, 0xcf, 0x45, 0xd3, 0xd7, 0x88, 0x17, 0x9a, 0x4f,
+	0x19, 0xdf, 0xaf, 0x63, 0xb5, 0xd3, 0xf7, 0x92, 0x41, 0xda, 0xd3, 0x5d, 0x12, 0x18, 0xb9, 0xc2,
+	0xe2, 0x67, 0x99, 0xa2, 0x5d, 0x23, 0xc9, 0x22, 0x4c, 0x79, 0x01, 0xb5, 0x04, 0x33, 0xdc, 0x06,
+	0xf7, 0x63, 0xbc, 0xef, 0xc4, 0xc8, 0x8e, 0xb1, 0x8b, 0xbd, 0x3d, 0x1c, 0xdb, 0x0e, 0x42, 0x31,
+	0xa6, 0x54, 0x9e, 0xe2, 0xd2, 0x6a, 0x67, 0x43, 0x55, 0x11, 0x5d, 0x5e, 0x91, 0xa8, 0x59, 0x77,
+	0x05, 0x62, 0xe5, 0xc0, 0x4b, 0x11, 0x87, 0x3b, 0x60, 0x3e, 0x0d, 0xd9, 0x35, 0xb2, 0xa9, 0x3b,
+	0xc0, 0x28, 0xf5, 0xb1, 0x3c, 0xcd, 0x07, 0x79, 0xa4, 0x9f, 0xbf, 0x64, 0xfa, 0x07, 0x9e, 0xb6,
+	0x15, 0x3b, 0xa1, 0x3b, 0xc0, 0xa6, 0x92, 0x8b, 0x73, 0x4f, 0x6c, 0x7b, 0x81, 0x43, 0xb3, 0x1a,
+	0x22, 0xb2, 0x59, 0x04, 0x7e, 0x4b, 0xa0, 0x7e, 0x8e, 0x61, 0x2c, 0x9c, 0x74, 0x6b, 0xc2, 0x4d,
+	0x9e, 0x77, 0xf9, 0x66, 0xce, 0x5b, 0xfb, 0x56, 0x06, 0x8d, 0xf7, 0x29, 0x8e, 0xb3, 0x35, 0x12,
+	0x22, 0x8f, 0x5f, 0xab, 0x57, 0x60, 0x9e, 0x4f, 0xff, 0x89, 0x85, 0x6d, 0xd6, 0x07, 0x77, 0x41,
+	0xe3, 0xb2, 0x86, 0xcc, 0x27, 0xbc, 0x78, 0x2b, 0x8b, 0xb0, 0x55, 0xf7, 0x27, 0x97, 0x70, 0x01,
+	0xd4, 0x10, 0x0e, 0x49, 0x20, 0xfc, 0x62, 0x89, 0x05, 0x9b, 0xe1, 0xfa, 0xee, 0xb8, 0x30, 0xc2,
+	0x55, 0x3e, 0xf8, 0x08, 0x66, 0x47, 0x5e, 0xbf, 0x86, 0x11, 0x1e, 0xe6, 0xac, 0x4d, 0xc1, 0x3a,
+	0x2a, 0x15, 0xca, 0x8c, 0xa9, 0xb4, 0xef, 0x65, 0x50, 0xdf, 0xcc, 0xc2, 0x64, 0x80, 0x13, 0xcf,
+	0xe5, 0x6f, 0xc2, 0x12, 0x80, 0x69, 0x88, 0x70, 0xec, 0x67, 0x5e, 0xd8, 0xb7, 0xb9, 0x4a, 0x1e,
+	0xca, 0xdf, 0x88, 0xe6, 0x18, 0x61, 0xb9, 0xeb, 0x08, 0xaa, 0x60, 0x8e, 0xb2, 0x72, 0x7b, 0x52,
+	0x07, 0xc0, 0x43, 0xdd, 0x42, 0x8c, 0xd1, 0x81, 0x56, 0x6e, 0xc8, 0xc0, 0x93, 0xcf, 0x4f, 0xf5,
+	0x36, 0x9f, 0x9f, 0x27, 0x6f, 0x40, 0xfd, 0xdc, 0x05, 0x80, 0x0d, 0x00, 0xcc, 0xac, 0xe0, 0x6e,
+	0x96, 0x20, 0x00, 0x53, 0x66, 0xc6, 0x9a, 0x6a, 0x4a, 0xec, 0xfb, 0x2d, 0x61, 0xe9, 0xcd, 0x32,
+	0x9c, 0x03, 0xd3, 0x66, 0xf6, 0x3a, 0x26, 0x69, 0xd4, 0xac, 0xb4, 0xaa, 0x5f, 0x7e, 0x28, 0x25,
+	0x73, 0xe3, 0xf0, 0x44, 0x91, 0x8e, 0x4e, 0x14, 0xe9, 0xef, 0x89, 0x22, 0x1d, 0x9c, 0x2a, 0xa5,
+	0xa3, 0x53, 0xa5, 0xf4, 0xe7, 0x54, 0x29, 0x6d, 0xaf, 0x4e, 0xb8, 0x24, 0xbf, 0x7e, 0xcb, 0xbe,
+	0xd3, 0xa3, 0xc5, 0xc2, 0xd8, 0x5b, 0x7d, 0x6e, 0x7c, 0x2e, 0xfe, 0x55, 0xb8, 0x6b, 0x7a, 0x53,
+	0x7c, 0xd2, 0x67, 0xff, 0x07, 0x00, 0x75, 0x9f, 0xe8, 0x09, 0x74, 0x06, 0x00, 0x00,
 }
 
 func (m *PeriodLock) Marshal() (dAtA []byte, err error) {
@@ -400,6 +472,20 @@ func (m *PeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnlockSchedule) > 0 {
+		for iNdEx := len(m.UnlockSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnlockSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.RewardReceiverAddress) > 0 {
 		i -= len(m.RewardReceiverAddress)
 		copy(dAtA[i:], m.RewardReceiverAddress)
@@ -452,7 +538,7 @@ func (m *PeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCondition) Marshal() (dAtA []byte, err error) {
+func (m *UnlockTranche) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -462,31 +548,76 @@ func (m *QueryCondition) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCondition) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnlockTranche) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnlockTranche) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintLock(dAtA, i, uint64(n3))
 	i--
-	dAtA[i] = 0x22
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	dAtA[i] = 0x12
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintLock(dAtA, i, uint64(n4))
 	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintLock(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0x1a
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
@@ -523,21 +654,21 @@ func (m *SyntheticLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintLock(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x22
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
 	if err6 != nil {
 		return 0, err6
 	}
 	i -= n6
 	i = encodeVarintLock(dAtA, i, uint64(n6))
 	i--
+	dAtA[i] = 0x22
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintLock(dAtA, i, uint64(n7))
+	i--
 	dAtA[i] = 0x1a
 	if len(m.SynthDenom) > 0 {
 		i -= len(m.SynthDenom)
@@ -592,6 +723,29 @@ func (m *PeriodLock) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLock(uint64(l))
 	}
+	if len(m.UnlockSchedule) > 0 {
+		for _, e := range m.UnlockSchedule {
+			l = e.Size()
+			n += 1 + l + sovLock(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnlockTranche) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovLock(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovLock(uint64(l))
 	return n
 }
 
@@ -853,6 +1007,157 @@ func (m *PeriodLock) Unmarshal(dAtA []byte) error {
 			}
 			m.RewardReceiverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockSchedule = append(m.UnlockSchedule, UnlockTranche{})
+			if err := m.UnlockSchedule[len(m.UnlockSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnlockTranche) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockTranche: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockTranche: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
@@ -17,6 +17,8 @@ const (
 	TypeMsgExtendLockup             = "edit_lockup"
 	TypeForceUnlock                 = "force_unlock"
 	TypeMsgSetRewardReceiverAddress = "set_reward_receiver_address"
+	TypeMsgScheduleUnlock           = "schedule_unlock"
+	TypeMsgTransferLock             = "transfer_lock"
 )
 
 var _ sdk.Msg = &MsgLockTokens{}
@@ -218,3 +220,82 @@ func (m MsgSetRewardReceiverAddress) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgScheduleUnlock{}
+
+// NewMsgScheduleUnlock creates a message to schedule a partial release of a lock.
+func NewMsgScheduleUnlock(owner sdk.AccAddress, id uint64, coins sdk.Coins) *MsgScheduleUnlock {
+	return &MsgScheduleUnlock{
+		Owner: owner.String(),
+		ID:    id,
+		Coins: coins,
+	}
+}
+
+func (m MsgScheduleUnlock) Route() string { return RouterKey }
+func (m MsgScheduleUnlock) Type() string  { return TypeMsgScheduleUnlock }
+func (m MsgScheduleUnlock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+
+	if m.ID == 0 {
+		return fmt.Errorf("invalid lockup ID, got %v", m.ID)
+	}
+
+	// only allow scheduling a single denom, matching the single denom restriction of locks
+	if m.Coins.Len() != 1 {
+		return fmt.Errorf("can only schedule unlock of one denom per lock ID, got %v", m.Coins)
+	}
+
+	if !m.Coins.IsAllPositive() {
+		return fmt.Errorf("cannot schedule unlock of a zero or negative amount")
+	}
+
+	return nil
+}
+
+func (m MsgScheduleUnlock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgTransferLock{}
+
+// NewMsgTransferLock creates a message to transfer the ownership of a lock.
+func NewMsgTransferLock(owner, newOwner sdk.AccAddress, id uint64) *MsgTransferLock {
+	return &MsgTransferLock{
+		Owner:    owner.String(),
+		ID:       id,
+		NewOwner: newOwner.String(),
+	}
+}
+
+func (m MsgTransferLock) Route() string { return RouterKey }
+func (m MsgTransferLock) Type() string  { return TypeMsgTransferLock }
+func (m MsgTransferLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(m.NewOwner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid new owner address (%s)", err)
+	}
+
+	if m.Owner == m.NewOwner {
+		return fmt.Errorf("new owner should be different from the current owner")
+	}
+
+	if m.ID == 0 {
+		return fmt.Errorf("invalid lockup ID, got %v", m.ID)
+	}
+
+	return nil
+}
+
+func (m MsgTransferLock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
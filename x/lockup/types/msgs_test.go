@@ -265,6 +265,143 @@ func TestMsgExtendLockup(t *testing.T) {
 }
 
 // // Test authz serialize and de-serializes for lockup msg.
+func TestMsgScheduleUnlock(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgScheduleUnlock
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgScheduleUnlock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewCoin("test", osmomath.NewInt(100))),
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgScheduleUnlock{
+				Owner: invalidAddr,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewCoin("test", osmomath.NewInt(100))),
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgScheduleUnlock{
+				Owner: addr1,
+				ID:    0,
+				Coins: sdk.NewCoins(sdk.NewCoin("test", osmomath.NewInt(100))),
+			},
+		},
+		{
+			name: "invalid coins length",
+			msg: types.MsgScheduleUnlock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(sdk.NewCoin("test1", osmomath.NewInt(100000)), sdk.NewCoin("test2", osmomath.NewInt(100000))),
+			},
+		},
+		{
+			name: "nil coins",
+			msg: types.MsgScheduleUnlock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "schedule_unlock")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgTransferLock(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+	addr2 := sdk.AccAddress([]byte("addr2---------------")).String()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgTransferLock
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgTransferLock{
+				Owner:    addr1,
+				ID:       1,
+				NewOwner: addr2,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgTransferLock{
+				Owner:    invalidAddr,
+				ID:       1,
+				NewOwner: addr2,
+			},
+		},
+		{
+			name: "invalid new owner",
+			msg: types.MsgTransferLock{
+				Owner:    addr1,
+				ID:       1,
+				NewOwner: invalidAddr,
+			},
+		},
+		{
+			name: "new owner is the owner",
+			msg: types.MsgTransferLock{
+				Owner:    addr1,
+				ID:       1,
+				NewOwner: addr1,
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgTransferLock{
+				Owner:    addr1,
+				ID:       0,
+				NewOwner: addr2,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "transfer_lock")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
 	addr1 := sdk.AccAddress(pk1.Address()).String()
@@ -296,6 +433,22 @@ func TestAuthzMsg(t *testing.T) {
 				Owner: addr1,
 			},
 		},
+		{
+			name: "MsgTransferLock",
+			msg: &types.MsgTransferLock{
+				Owner:    addr1,
+				ID:       1,
+				NewOwner: sdk.AccAddress([]byte("addr2---------------")).String(),
+			},
+		},
+		{
+			name: "MsgScheduleUnlock",
+			msg: &types.MsgScheduleUnlock{
+				Owner: addr1,
+				ID:    1,
+				Coins: sdk.NewCoins(coin),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -18,6 +18,7 @@ import (
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -660,6 +661,212 @@ func (m *MsgSetRewardReceiverAddressResponse) GetSuccess() bool {
 	return false
 }
 
+// MsgScheduleUnlock schedules the given coins of a lock to be released after
+// the lock's duration has passed. The lock keeps its ID, and the coins are
+// added to the lock's unlock schedule as a single tranche.
+type MsgScheduleUnlock struct {
+	Owner string                                   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ID    uint64                                   `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *MsgScheduleUnlock) Reset()         { *m = MsgScheduleUnlock{} }
+func (m *MsgScheduleUnlock) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleUnlock) ProtoMessage()    {}
+func (*MsgScheduleUnlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{12}
+}
+func (m *MsgScheduleUnlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleUnlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleUnlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleUnlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleUnlock.Merge(m, src)
+}
+func (m *MsgScheduleUnlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleUnlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleUnlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleUnlock proto.InternalMessageInfo
+
+func (m *MsgScheduleUnlock) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgScheduleUnlock) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *MsgScheduleUnlock) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+type MsgScheduleUnlockResponse struct {
+	EndTime time.Time `protobuf:"bytes,1,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *MsgScheduleUnlockResponse) Reset()         { *m = MsgScheduleUnlockResponse{} }
+func (m *MsgScheduleUnlockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleUnlockResponse) ProtoMessage()    {}
+func (*MsgScheduleUnlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{13}
+}
+func (m *MsgScheduleUnlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleUnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleUnlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleUnlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleUnlockResponse.Merge(m, src)
+}
+func (m *MsgScheduleUnlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleUnlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleUnlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleUnlockResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleUnlockResponse) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+// MsgTransferLock transfers the ownership of a lock to a new owner. Locks with
+// synthetic lockups and locks backing concentrated liquidity positions can not
+// be transferred. The reward receiver of the lock is reset to the new owner.
+type MsgTransferLock struct {
+	Owner    string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ID       uint64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty" yaml:"new_owner"`
+}
+
+func (m *MsgTransferLock) Reset()         { *m = MsgTransferLock{} }
+func (m *MsgTransferLock) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLock) ProtoMessage()    {}
+func (*MsgTransferLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{14}
+}
+func (m *MsgTransferLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLock.Merge(m, src)
+}
+func (m *MsgTransferLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferLock proto.InternalMessageInfo
+
+func (m *MsgTransferLock) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgTransferLock) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *MsgTransferLock) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+type MsgTransferLockResponse struct {
+}
+
+func (m *MsgTransferLockResponse) Reset()         { *m = MsgTransferLockResponse{} }
+func (m *MsgTransferLockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLockResponse) ProtoMessage()    {}
+func (*MsgTransferLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{15}
+}
+func (m *MsgTransferLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLockResponse.Merge(m, src)
+}
+func (m *MsgTransferLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferLockResponse proto.InternalMessageInfo
+
 // DEPRECATED
 // Following messages are deprecated but kept to support indexing.
 type MsgUnlockPeriodLock struct {
@@ -671,7 +878,7 @@ func (m *MsgUnlockPeriodLock) Reset()         { *m = MsgUnlockPeriodLock{} }
 func (m *MsgUnlockPeriodLock) String() string { return proto.CompactTextString(m) }
 func (*MsgUnlockPeriodLock) ProtoMessage()    {}
 func (*MsgUnlockPeriodLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcdad5af0d24735f, []int{12}
+	return fileDescriptor_bcdad5af0d24735f, []int{16}
 }
 func (m *MsgUnlockPeriodLock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -722,7 +929,7 @@ func (m *MsgUnlockTokens) Reset()         { *m = MsgUnlockTokens{} }
 func (m *MsgUnlockTokens) String() string { return proto.CompactTextString(m) }
 func (*MsgUnlockTokens) ProtoMessage()    {}
 func (*MsgUnlockTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcdad5af0d24735f, []int{13}
+	return fileDescriptor_bcdad5af0d24735f, []int{17}
 }
 func (m *MsgUnlockTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -771,6 +978,10 @@ func init() {
 	proto.RegisterType((*MsgForceUnlockResponse)(nil), "osmosis.lockup.MsgForceUnlockResponse")
 	proto.RegisterType((*MsgSetRewardReceiverAddress)(nil), "osmosis.lockup.MsgSetRewardReceiverAddress")
 	proto.RegisterType((*MsgSetRewardReceiverAddressResponse)(nil), "osmosis.lockup.MsgSetRewardReceiverAddressResponse")
+	proto.RegisterType((*MsgScheduleUnlock)(nil), "osmosis.lockup.MsgScheduleUnlock")
+	proto.RegisterType((*MsgScheduleUnlockResponse)(nil), "osmosis.lockup.MsgScheduleUnlockResponse")
+	proto.RegisterType((*MsgTransferLock)(nil), "osmosis.lockup.MsgTransferLock")
+	proto.RegisterType((*MsgTransferLockResponse)(nil), "osmosis.lockup.MsgTransferLockResponse")
 	proto.RegisterType((*MsgUnlockPeriodLock)(nil), "osmosis.lockup.MsgUnlockPeriodLock")
 	proto.RegisterType((*MsgUnlockTokens)(nil), "osmosis.lockup.MsgUnlockTokens")
 }
@@ -778,62 +989,72 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/tx.proto", fileDescriptor_bcdad5af0d24735f) }
 
 var fileDescriptor_bcdad5af0d24735f = []byte{
-	// 867 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0xc7, 0xe3, 0x64, 0x5f, 0x67, 0x97, 0x84, 0x9a, 0xa5, 0x4d, 0xcd, 0x62, 0x17, 0x03, 0xdb,
-	0x6c, 0x5b, 0xdb, 0x24, 0x45, 0x48, 0xcd, 0x05, 0x35, 0x2d, 0x48, 0x95, 0x6a, 0x09, 0x99, 0x56,
-	0x42, 0x1c, 0xa8, 0x1c, 0x67, 0x3a, 0xb5, 0x92, 0x78, 0x82, 0xc7, 0x4e, 0x5b, 0x89, 0x13, 0x47,
-	0x4e, 0x1c, 0xf9, 0x0c, 0x5c, 0xe8, 0xc7, 0xe8, 0xb1, 0xdc, 0xb8, 0x90, 0x56, 0xad, 0x44, 0x25,
-	0x8e, 0xfd, 0x04, 0xc8, 0x33, 0xb6, 0xb1, 0x9d, 0x34, 0x49, 0x2b, 0x81, 0xf6, 0x52, 0x7b, 0xfc,
-	0x3c, 0xcf, 0x7f, 0x9e, 0xff, 0xaf, 0xf3, 0x12, 0x30, 0x87, 0x49, 0x17, 0x13, 0x9b, 0x68, 0x1d,
-	0x6c, 0xb5, 0xfd, 0x9e, 0xe6, 0x1d, 0xa9, 0x3d, 0x17, 0x7b, 0x98, 0x2f, 0x86, 0x01, 0x95, 0x05,
-	0x84, 0x17, 0x08, 0x23, 0x4c, 0x43, 0x5a, 0xf0, 0xc6, 0xb2, 0x84, 0x19, 0xb3, 0x6b, 0x3b, 0x58,
-	0xa3, 0x7f, 0xc3, 0x4f, 0x22, 0xc2, 0x18, 0x75, 0xa0, 0x46, 0x47, 0x4d, 0x7f, 0x5f, 0x6b, 0xf9,
-	0xae, 0xe9, 0xd9, 0xd8, 0x89, 0xe2, 0x16, 0x55, 0xd6, 0x9a, 0x26, 0x81, 0x5a, 0xbf, 0xda, 0x84,
-	0x9e, 0x59, 0xd5, 0x2c, 0x6c, 0x47, 0xf1, 0xf9, 0x4c, 0x47, 0xc1, 0x23, 0x0c, 0xcd, 0x85, 0xa5,
-	0x5d, 0x82, 0xb4, 0x7e, 0x35, 0x78, 0xb0, 0x80, 0xfc, 0x5b, 0x1e, 0xbc, 0xa5, 0x13, 0xb4, 0x8d,
-	0xad, 0xf6, 0x0e, 0x6e, 0x43, 0x87, 0xf0, 0xaf, 0xc0, 0x43, 0x7c, 0xe8, 0x40, 0xb7, 0xcc, 0x2d,
-	0x70, 0x95, 0xa7, 0x8d, 0xb7, 0x6f, 0x06, 0xd2, 0xf3, 0x63, 0xb3, 0xdb, 0xa9, 0xcb, 0xf4, 0xb3,
-	0x6c, 0xb0, 0x30, 0x7f, 0x00, 0x9e, 0x44, 0xfd, 0x95, 0xf3, 0x0b, 0x5c, 0xe5, 0x59, 0x6d, 0x5e,
-	0x65, 0x06, 0xd4, 0xc8, 0x80, 0xba, 0x19, 0x26, 0x34, 0xaa, 0xa7, 0x03, 0x29, 0xf7, 0xf7, 0x40,
-	0xe2, 0xa3, 0x92, 0x15, 0xdc, 0xb5, 0x3d, 0xd8, 0xed, 0x79, 0xc7, 0x37, 0x03, 0xa9, 0xc4, 0xf4,
-	0xa3, 0x98, 0xfc, 0xcb, 0xb9, 0xc4, 0x19, 0xb1, 0x3a, 0x6f, 0x82, 0x87, 0x81, 0x4b, 0x52, 0x2e,
-	0x2c, 0x14, 0xe8, 0x34, 0xcc, 0x8c, 0x1a, 0x70, 0x50, 0x43, 0x0e, 0xea, 0x06, 0xb6, 0x9d, 0xc6,
-	0x27, 0xc1, 0x34, 0xbf, 0x9e, 0x4b, 0x15, 0x64, 0x7b, 0x07, 0x7e, 0x53, 0xb5, 0x70, 0x57, 0x0b,
-	0x9d, 0xb3, 0x87, 0x42, 0x5a, 0x6d, 0xcd, 0x3b, 0xee, 0x41, 0x42, 0x0b, 0x88, 0xc1, 0x94, 0xeb,
-	0xaf, 0x7f, 0xbc, 0x3e, 0x59, 0x62, 0xc6, 0x7e, 0xba, 0x3e, 0x59, 0x12, 0x46, 0x90, 0x54, 0x3c,
-	0xca, 0x47, 0x5e, 0x04, 0xef, 0xa6, 0x80, 0x19, 0x90, 0xf4, 0xb0, 0x43, 0x20, 0x5f, 0x04, 0xf9,
-	0xad, 0x4d, 0x4a, 0xed, 0x81, 0x91, 0xdf, 0xda, 0x94, 0xbf, 0x07, 0x2f, 0x74, 0x82, 0x1a, 0x10,
-	0xd9, 0xce, 0xae, 0x13, 0x28, 0xd8, 0x0e, 0x5a, 0xef, 0x74, 0xa6, 0x05, 0x5c, 0xaf, 0xa6, 0x7b,
-	0x92, 0x33, 0x3d, 0x35, 0x03, 0x61, 0xc5, 0x77, 0x92, 0xbd, 0xed, 0x80, 0x97, 0xa3, 0xa6, 0x8c,
-	0x5b, 0xfc, 0x14, 0x3c, 0x66, 0x05, 0xa4, 0xcc, 0x51, 0x96, 0x82, 0x9a, 0x5e, 0xac, 0xea, 0x57,
-	0xd0, 0xb5, 0x71, 0x2b, 0x70, 0x67, 0x44, 0xa9, 0xf2, 0x5f, 0x1c, 0x98, 0x19, 0x92, 0x9d, 0x7a,
-	0x9d, 0x30, 0x2c, 0xf9, 0x08, 0xcb, 0xff, 0xf1, 0xdf, 0xfc, 0x2c, 0x4d, 0x6e, 0x71, 0x1c, 0xb9,
-	0x1e, 0x35, 0xac, 0x04, 0xef, 0xf2, 0x1e, 0x98, 0x1f, 0xf2, 0x19, 0xb3, 0x2b, 0x83, 0xc7, 0xc4,
-	0xb7, 0x2c, 0x48, 0x08, 0x75, 0xfc, 0xc4, 0x88, 0x86, 0x7c, 0x05, 0x94, 0xfc, 0x28, 0x3d, 0x20,
-	0x17, 0xdb, 0xcd, 0x7e, 0x96, 0x2f, 0x38, 0x50, 0xd2, 0x09, 0xfa, 0xe2, 0xc8, 0x83, 0x0e, 0x85,
-	0xec, 0xf7, 0xee, 0xcd, 0x31, 0xb9, 0xff, 0x0a, 0xff, 0xe5, 0xfe, 0xab, 0x2f, 0xa7, 0x71, 0xbe,
-	0xcc, 0xe0, 0x84, 0xd4, 0x8d, 0xc2, 0x46, 0xf2, 0x2a, 0x98, 0xcb, 0x38, 0x9c, 0x4c, 0x50, 0x1e,
-	0x70, 0xa0, 0xa8, 0x13, 0xf4, 0x25, 0x76, 0x2d, 0xc8, 0xc8, 0xbf, 0xc9, 0xcb, 0x6b, 0xc2, 0xc6,
-	0xdc, 0x0f, 0x5c, 0x64, 0x36, 0x66, 0x0d, 0xcc, 0xa6, 0xfd, 0x4d, 0x01, 0xe5, 0x4f, 0x0e, 0xbc,
-	0xa7, 0x13, 0xf4, 0x35, 0xf4, 0x0c, 0x78, 0x68, 0xba, 0x2d, 0x03, 0x5a, 0xd0, 0xee, 0x43, 0x77,
-	0xbd, 0xd5, 0x72, 0x83, 0x65, 0x37, 0x2d, 0xa1, 0x59, 0xf0, 0xa8, 0x93, 0x5c, 0x95, 0xe1, 0x88,
-	0xdf, 0x00, 0x25, 0x97, 0x0a, 0xef, 0xb9, 0xa1, 0x32, 0x5d, 0x47, 0x4f, 0x1b, 0xc2, 0xcd, 0x40,
-	0x9a, 0x65, 0x4a, 0x99, 0x04, 0xd9, 0x28, 0xba, 0xa9, 0x5e, 0xea, 0x6b, 0x69, 0x16, 0x4b, 0x19,
-	0x16, 0x04, 0x7a, 0x0a, 0xab, 0x50, 0x22, 0x0d, 0xc5, 0x64, 0xfd, 0xcb, 0x9f, 0x83, 0x0f, 0xc7,
-	0xd8, 0x9b, 0x02, 0x90, 0x0e, 0xde, 0xd1, 0x09, 0x62, 0x3c, 0xff, 0x3d, 0xb7, 0xee, 0xbb, 0x72,
-	0xe4, 0x35, 0xba, 0x37, 0x99, 0xdc, 0xdd, 0xee, 0xc2, 0xda, 0xef, 0x0f, 0x40, 0x41, 0x27, 0x88,
-	0x37, 0x00, 0x48, 0xdc, 0xa4, 0xef, 0x67, 0x0f, 0xd7, 0xd4, 0xbd, 0x21, 0x7c, 0x3c, 0x36, 0x1c,
-	0xfb, 0x47, 0x60, 0x66, 0xf8, 0x0e, 0xf9, 0x68, 0x44, 0xed, 0x50, 0x96, 0xb0, 0x32, 0x4d, 0x56,
-	0x3c, 0xd1, 0x77, 0xa0, 0x98, 0x39, 0xe2, 0x3f, 0x98, 0x58, 0x2f, 0xbc, 0x9e, 0x98, 0x12, 0xeb,
-	0x7f, 0x03, 0x9e, 0xa7, 0x0e, 0x3e, 0x69, 0x44, 0x69, 0x32, 0x41, 0x58, 0x9c, 0x90, 0x10, 0x2b,
-	0xef, 0x82, 0x67, 0xc9, 0xa3, 0x43, 0x1c, 0x51, 0x97, 0x88, 0x0b, 0xaf, 0xc6, 0xc7, 0x63, 0xd9,
-	0x1f, 0x40, 0xf9, 0xd6, 0xcd, 0xb7, 0x3c, 0x42, 0xe3, 0xb6, 0x64, 0x61, 0xf5, 0x0e, 0xc9, 0xd1,
-	0xec, 0x8d, 0xed, 0xd3, 0x4b, 0x91, 0x3b, 0xbb, 0x14, 0xb9, 0x8b, 0x4b, 0x91, 0xfb, 0xf9, 0x4a,
-	0xcc, 0x9d, 0x5d, 0x89, 0xb9, 0x3f, 0xae, 0xc4, 0xdc, 0xb7, 0xb5, 0xc4, 0x81, 0x15, 0x0a, 0x2b,
-	0x1d, 0xb3, 0x49, 0xa2, 0x81, 0xd6, 0xaf, 0xad, 0x69, 0x47, 0xf1, 0xef, 0xd2, 0xe0, 0x00, 0x6b,
-	0x3e, 0xa2, 0x77, 0xc2, 0xea, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x30, 0x6e, 0x0a, 0x07, 0xb6,
-	0x0a, 0x00, 0x00,
+	// 1025 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0xe5, 0x37, 0xfe, 0x98, 0xe4, 0x95, 0x62, 0xd6, 0xb5, 0x25, 0x26, 0x15, 0xdd, 0x6d,
+	0x1b, 0x7f, 0x24, 0x24, 0x2b, 0xb9, 0x28, 0x60, 0x5d, 0x8a, 0x28, 0x6e, 0x81, 0x00, 0x16, 0x5a,
+	0x30, 0x0e, 0x50, 0xf4, 0x50, 0x83, 0xa2, 0xd6, 0x34, 0x61, 0x89, 0xab, 0x72, 0x49, 0x7f, 0x00,
+	0x3d, 0xf5, 0xd8, 0x5e, 0x72, 0xec, 0x5f, 0x68, 0x2f, 0xcd, 0xcf, 0xc8, 0x31, 0xc7, 0x5e, 0xaa,
+	0x04, 0x36, 0xd0, 0x00, 0x3d, 0xfa, 0x17, 0x14, 0xbb, 0x4b, 0x32, 0x24, 0xa5, 0x58, 0x4a, 0x8a,
+	0x16, 0xed, 0x85, 0xe4, 0x72, 0x66, 0x9e, 0x9d, 0xe7, 0xd9, 0xd9, 0xd9, 0x85, 0x65, 0x42, 0x7b,
+	0x84, 0xba, 0xd4, 0xe8, 0x12, 0xfb, 0x30, 0xec, 0x1b, 0xc1, 0x89, 0xde, 0xf7, 0x49, 0x40, 0xe4,
+	0x62, 0x64, 0xd0, 0x85, 0x41, 0x59, 0x74, 0x88, 0x43, 0xb8, 0xc9, 0x60, 0x5f, 0xc2, 0x4b, 0x59,
+	0xb0, 0x7a, 0xae, 0x47, 0x0c, 0xfe, 0x8c, 0x7e, 0x55, 0x1d, 0x42, 0x9c, 0x2e, 0x36, 0xf8, 0xa8,
+	0x1d, 0xee, 0x1b, 0x9d, 0xd0, 0xb7, 0x02, 0x97, 0x78, 0x91, 0x5d, 0xcd, 0xdb, 0x03, 0xb7, 0x87,
+	0x69, 0x60, 0xf5, 0xfa, 0x31, 0x80, 0xcd, 0xa7, 0x36, 0xda, 0x16, 0xc5, 0xc6, 0x51, 0xad, 0x8d,
+	0x03, 0xab, 0x66, 0xd8, 0xc4, 0x8d, 0x01, 0x2a, 0xb9, 0x94, 0xd9, 0x2b, 0x32, 0x2d, 0x47, 0xa1,
+	0x3d, 0xea, 0x18, 0x47, 0x35, 0xf6, 0x12, 0x06, 0xf4, 0x4b, 0x01, 0xfe, 0xdf, 0xa2, 0xce, 0x0e,
+	0xb1, 0x0f, 0x77, 0xc9, 0x21, 0xf6, 0xa8, 0x7c, 0x0b, 0xae, 0x90, 0x63, 0x0f, 0xfb, 0x65, 0x69,
+	0x45, 0x5a, 0x9b, 0x6f, 0x5e, 0xbf, 0x18, 0xa8, 0xd7, 0x4e, 0xad, 0x5e, 0xb7, 0x81, 0xf8, 0x6f,
+	0x64, 0x0a, 0xb3, 0x7c, 0x00, 0x73, 0x31, 0x81, 0x72, 0x61, 0x45, 0x5a, 0xbb, 0x5a, 0xaf, 0xe8,
+	0x82, 0x81, 0x1e, 0x33, 0xd0, 0xb7, 0x23, 0x87, 0x66, 0xed, 0xc9, 0x40, 0x9d, 0xfa, 0x63, 0xa0,
+	0xca, 0x71, 0xc8, 0x1d, 0xd2, 0x73, 0x03, 0xdc, 0xeb, 0x07, 0xa7, 0x17, 0x03, 0xb5, 0x24, 0xf0,
+	0x63, 0x1b, 0xfa, 0xf1, 0x99, 0x2a, 0x99, 0x09, 0xba, 0x6c, 0xc1, 0x15, 0xc6, 0x92, 0x96, 0xa7,
+	0x57, 0xa6, 0xf9, 0x34, 0x82, 0x8c, 0xce, 0x74, 0xd0, 0x23, 0x1d, 0xf4, 0x7b, 0xc4, 0xf5, 0x9a,
+	0x1f, 0xb2, 0x69, 0x7e, 0x7e, 0xa6, 0xae, 0x39, 0x6e, 0x70, 0x10, 0xb6, 0x75, 0x9b, 0xf4, 0x8c,
+	0x88, 0xb9, 0x78, 0x69, 0xb4, 0x73, 0x68, 0x04, 0xa7, 0x7d, 0x4c, 0x79, 0x00, 0x35, 0x05, 0x72,
+	0x63, 0xfd, 0xbb, 0x17, 0x8f, 0x37, 0x04, 0xb1, 0xef, 0x5f, 0x3c, 0xde, 0x50, 0x46, 0x28, 0xa9,
+	0x05, 0x5c, 0x1f, 0xb4, 0x0a, 0x6f, 0x67, 0x04, 0x33, 0x31, 0xed, 0x13, 0x8f, 0x62, 0xb9, 0x08,
+	0x85, 0xfb, 0xdb, 0x5c, 0xb5, 0xff, 0x99, 0x85, 0xfb, 0xdb, 0xe8, 0x1b, 0x58, 0x6c, 0x51, 0xa7,
+	0x89, 0x1d, 0xd7, 0x7b, 0xe8, 0x31, 0x04, 0xd7, 0x73, 0xee, 0x76, 0xbb, 0x93, 0x0a, 0xdc, 0xa8,
+	0x65, 0x73, 0x42, 0xb9, 0x9c, 0xda, 0x0c, 0x58, 0x0b, 0xbd, 0x74, 0x6e, 0xbb, 0x70, 0x73, 0xd4,
+	0x94, 0x49, 0x8a, 0x1f, 0xc1, 0xac, 0x08, 0xa0, 0x65, 0x89, 0x6b, 0xa9, 0xe8, 0xd9, 0x6a, 0xd6,
+	0xbf, 0xc0, 0xbe, 0x4b, 0x3a, 0x8c, 0x9d, 0x19, 0xbb, 0xa2, 0xdf, 0x25, 0x58, 0x18, 0x82, 0x9d,
+	0xb8, 0x4e, 0x84, 0x2c, 0x85, 0x58, 0x96, 0x7f, 0x62, 0x35, 0x3f, 0xce, 0x2a, 0xb7, 0x7a, 0x99,
+	0x72, 0x7d, 0x4e, 0x58, 0x63, 0xdf, 0x68, 0x0f, 0x2a, 0x43, 0x3c, 0x13, 0xed, 0xca, 0x30, 0x4b,
+	0x43, 0xdb, 0xc6, 0x94, 0x72, 0xc6, 0x73, 0x66, 0x3c, 0x94, 0xd7, 0xa0, 0x14, 0xc6, 0xee, 0x4c,
+	0xb9, 0x84, 0x6e, 0xfe, 0x37, 0x7a, 0x2e, 0x41, 0xa9, 0x45, 0x9d, 0x4f, 0x4f, 0x02, 0xec, 0x71,
+	0x91, 0xc3, 0xfe, 0x1b, 0xeb, 0x98, 0xde, 0x7f, 0xd3, 0x7f, 0xe7, 0xfe, 0x6b, 0xdc, 0xce, 0xca,
+	0x79, 0x33, 0x27, 0x27, 0xe6, 0x6c, 0x34, 0x31, 0x42, 0x9b, 0xb0, 0x9c, 0x63, 0x38, 0x5e, 0x41,
+	0x34, 0x90, 0xa0, 0xd8, 0xa2, 0xce, 0x67, 0xc4, 0xb7, 0xb1, 0x50, 0xfe, 0xdf, 0x5c, 0x5e, 0x63,
+	0x36, 0xe6, 0x3e, 0x63, 0x91, 0xdb, 0x98, 0x75, 0x58, 0xca, 0xf2, 0x9b, 0x40, 0x94, 0xdf, 0x24,
+	0xb8, 0xd1, 0xa2, 0xce, 0x03, 0x1c, 0x98, 0xf8, 0xd8, 0xf2, 0x3b, 0x26, 0xb6, 0xb1, 0x7b, 0x84,
+	0xfd, 0xbb, 0x9d, 0x8e, 0xcf, 0xca, 0x6e, 0x52, 0x85, 0x96, 0x60, 0xa6, 0x9b, 0xae, 0xca, 0x68,
+	0x24, 0xdf, 0x83, 0x92, 0xcf, 0x81, 0xf7, 0xfc, 0x08, 0x99, 0xd7, 0xd1, 0x7c, 0x53, 0xb9, 0x18,
+	0xa8, 0x4b, 0x02, 0x29, 0xe7, 0x80, 0xcc, 0xa2, 0x9f, 0xc9, 0xa5, 0xb1, 0x95, 0xd5, 0x62, 0x23,
+	0xa7, 0x05, 0xc5, 0x81, 0x26, 0x22, 0xb4, 0x18, 0x43, 0xb3, 0x44, 0xfe, 0xe8, 0x13, 0x78, 0xef,
+	0x12, 0x7a, 0x93, 0x09, 0xc4, 0xfa, 0xd2, 0x03, 0xfb, 0x00, 0x77, 0xc2, 0xee, 0x7f, 0xa0, 0x70,
+	0xb4, 0xac, 0x58, 0xd5, 0xbc, 0x58, 0x11, 0x91, 0xa8, 0x76, 0x10, 0x81, 0xca, 0x10, 0xbd, 0x44,
+	0x16, 0x13, 0xe6, 0xb0, 0xd7, 0xd9, 0x63, 0x77, 0x04, 0xce, 0x94, 0xf5, 0xf2, 0xfc, 0xf6, 0xdf,
+	0x8d, 0x2f, 0x10, 0xcd, 0x1b, 0x2c, 0xe5, 0x97, 0x3b, 0x3d, 0x8e, 0x44, 0x8f, 0xd8, 0x4e, 0x9f,
+	0xc5, 0x5e, 0x87, 0xb9, 0xa2, 0x9f, 0x44, 0x7b, 0xda, 0xf5, 0x2d, 0x8f, 0xee, 0x63, 0x7f, 0xe7,
+	0xaf, 0xc8, 0x59, 0x83, 0x79, 0x0f, 0x1f, 0xef, 0x89, 0x58, 0x51, 0x57, 0x8b, 0x17, 0x03, 0xf5,
+	0xba, 0x88, 0x4d, 0x4c, 0xc8, 0x9c, 0xf3, 0xf0, 0xf1, 0xe7, 0xec, 0x73, 0x5c, 0x9f, 0x09, 0xa2,
+	0xb4, 0x44, 0xaf, 0xae, 0xc0, 0x72, 0x2e, 0xd5, 0x58, 0x1a, 0xd4, 0x82, 0xb7, 0x5a, 0xd4, 0x11,
+	0x7a, 0xbd, 0x3c, 0xcf, 0xde, 0x94, 0x09, 0xda, 0x82, 0x52, 0x02, 0xf7, 0x7a, 0x77, 0xa4, 0xfa,
+	0x0f, 0x33, 0x30, 0xdd, 0xa2, 0x8e, 0x6c, 0x02, 0xa4, 0x6e, 0x58, 0xef, 0xe4, 0x0f, 0xdd, 0xcc,
+	0x7d, 0x42, 0xf9, 0xe0, 0x52, 0x73, 0x52, 0x00, 0x0e, 0x2c, 0x0c, 0xdf, 0x2d, 0xde, 0x1f, 0x11,
+	0x3b, 0xe4, 0xa5, 0xdc, 0x99, 0xc4, 0x2b, 0x99, 0xe8, 0x6b, 0x28, 0x66, 0x8d, 0xf2, 0xbb, 0x63,
+	0xe3, 0x95, 0xf5, 0xb1, 0x2e, 0x09, 0xfe, 0x97, 0x70, 0x2d, 0x73, 0x20, 0xaa, 0x23, 0x42, 0xd3,
+	0x0e, 0xca, 0xea, 0x18, 0x87, 0x04, 0xf9, 0x21, 0x5c, 0x4d, 0x1f, 0x29, 0xd5, 0x11, 0x71, 0x29,
+	0xbb, 0x72, 0xeb, 0x72, 0x7b, 0x02, 0xfb, 0x2d, 0x94, 0x5f, 0xd9, 0x94, 0x6f, 0x8f, 0xc0, 0x78,
+	0x95, 0xb3, 0xb2, 0xf9, 0x1a, 0xce, 0xe9, 0xe5, 0xc8, 0x75, 0xbc, 0x51, 0xcb, 0x91, 0x75, 0x51,
+	0xd6, 0xc7, 0xba, 0xa4, 0x97, 0x23, 0xd3, 0x00, 0x46, 0x2d, 0x47, 0xda, 0x41, 0x59, 0x1d, 0xe3,
+	0x10, 0x23, 0x37, 0x77, 0x9e, 0x9c, 0x55, 0xa5, 0xa7, 0x67, 0x55, 0xe9, 0xf9, 0x59, 0x55, 0x7a,
+	0x74, 0x5e, 0x9d, 0x7a, 0x7a, 0x5e, 0x9d, 0xfa, 0xf5, 0xbc, 0x3a, 0xf5, 0x55, 0x3d, 0xd5, 0x49,
+	0x23, 0x30, 0xad, 0x6b, 0xb5, 0x69, 0x3c, 0x30, 0x8e, 0xea, 0x5b, 0xc6, 0x49, 0xd2, 0x08, 0x58,
+	0x67, 0x6d, 0xcf, 0xf0, 0x36, 0xb7, 0xf9, 0xe7, 0x00, 0xab, 0xd9, 0x90, 0x81, 0xa9, 0x0d, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -859,6 +1080,11 @@ type MsgClient interface {
 	ForceUnlock(ctx context.Context, in *MsgForceUnlock, opts ...grpc.CallOption) (*MsgForceUnlockResponse, error)
 	// SetRewardReceiverAddress edits the reward receiver for the given lock ID
 	SetRewardReceiverAddress(ctx context.Context, in *MsgSetRewardReceiverAddress, opts ...grpc.CallOption) (*MsgSetRewardReceiverAddressResponse, error)
+	// ScheduleUnlock schedules a partial release of the given lock ID without
+	// splitting it into a new lock
+	ScheduleUnlock(ctx context.Context, in *MsgScheduleUnlock, opts ...grpc.CallOption) (*MsgScheduleUnlockResponse, error)
+	// TransferLock transfers the ownership of the given lock ID to a new owner
+	TransferLock(ctx context.Context, in *MsgTransferLock, opts ...grpc.CallOption) (*MsgTransferLockResponse, error)
 }
 
 type msgClient struct {
@@ -923,6 +1149,24 @@ func (c *msgClient) SetRewardReceiverAddress(ctx context.Context, in *MsgSetRewa
 	return out, nil
 }
 
+func (c *msgClient) ScheduleUnlock(ctx context.Context, in *MsgScheduleUnlock, opts ...grpc.CallOption) (*MsgScheduleUnlockResponse, error) {
+	out := new(MsgScheduleUnlockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/ScheduleUnlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferLock(ctx context.Context, in *MsgTransferLock, opts ...grpc.CallOption) (*MsgTransferLockResponse, error) {
+	out := new(MsgTransferLockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/TransferLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// LockTokens lock tokens
@@ -936,6 +1180,11 @@ type MsgServer interface {
 	ForceUnlock(context.Context, *MsgForceUnlock) (*MsgForceUnlockResponse, error)
 	// SetRewardReceiverAddress edits the reward receiver for the given lock ID
 	SetRewardReceiverAddress(context.Context, *MsgSetRewardReceiverAddress) (*MsgSetRewardReceiverAddressResponse, error)
+	// ScheduleUnlock schedules a partial release of the given lock ID without
+	// splitting it into a new lock
+	ScheduleUnlock(context.Context, *MsgScheduleUnlock) (*MsgScheduleUnlockResponse, error)
+	// TransferLock transfers the ownership of the given lock ID to a new owner
+	TransferLock(context.Context, *MsgTransferLock) (*MsgTransferLockResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -960,6 +1209,12 @@ func (*UnimplementedMsgServer) ForceUnlock(ctx context.Context, req *MsgForceUnl
 func (*UnimplementedMsgServer) SetRewardReceiverAddress(ctx context.Context, req *MsgSetRewardReceiverAddress) (*MsgSetRewardReceiverAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetRewardReceiverAddress not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleUnlock(ctx context.Context, req *MsgScheduleUnlock) (*MsgScheduleUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleUnlock not implemented")
+}
+func (*UnimplementedMsgServer) TransferLock(ctx context.Context, req *MsgTransferLock) (*MsgTransferLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLock not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1073,6 +1328,42 @@ func _Msg_SetRewardReceiverAddress_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleUnlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/ScheduleUnlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleUnlock(ctx, req.(*MsgScheduleUnlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/TransferLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferLock(ctx, req.(*MsgTransferLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.lockup.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1101,6 +1392,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetRewardReceiverAddress",
 			Handler:    _Msg_SetRewardReceiverAddress_Handler,
 		},
+		{
+			MethodName: "ScheduleUnlock",
+			Handler:    _Msg_ScheduleUnlock_Handler,
+		},
+		{
+			MethodName: "TransferLock",
+			Handler:    _Msg_TransferLock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/lockup/tx.proto",
@@ -1573,7 +1872,7 @@ func (m *MsgSetRewardReceiverAddressResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnlockPeriodLock) Marshal() (dAtA []byte, err error) {
+func (m *MsgScheduleUnlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1583,16 +1882,30 @@ func (m *MsgUnlockPeriodLock) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUnlockPeriodLock) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgScheduleUnlock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnlockPeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgScheduleUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.ID != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.ID))
 		i--
@@ -1608,7 +1921,7 @@ func (m *MsgUnlockPeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnlockTokens) Marshal() (dAtA []byte, err error) {
+func (m *MsgScheduleUnlockResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1618,16 +1931,59 @@ func (m *MsgUnlockTokens) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUnlockTokens) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgScheduleUnlockResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnlockTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgScheduleUnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -1638,31 +1994,119 @@ func (m *MsgUnlockTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgTransferLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgLockTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgTransferLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockPeriodLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockPeriodLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockPeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLockTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
 		}
@@ -1843,6 +2287,68 @@ func (m *MsgSetRewardReceiverAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleUnlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleUnlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUnlockPeriodLock) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1971,7 +2477,377 @@ func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
 			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocks = append(m.Unlocks, &PeriodLock{})
+			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlocking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlocking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
@@ -2027,7 +2903,7 @@ func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2050,17 +2926,17 @@ func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLockTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBeginUnlockingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLockTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBeginUnlockingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			m.ID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2070,7 +2946,27 @@ func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockingLockID", wireType)
+			}
+			m.UnlockingLockID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockingLockID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2096,7 +2992,7 @@ func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2119,10 +3015,10 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAll: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendLockup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAll: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendLockup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2157,6 +3053,58 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2178,7 +3126,7 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2201,17 +3149,17 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendLockupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2221,26 +3169,12 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Unlocks = append(m.Unlocks, &PeriodLock{})
-			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2262,7 +3196,7 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
+func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2285,10 +3219,10 @@ func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlocking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceUnlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlocking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2397,7 +3331,7 @@ func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2420,10 +3354,10 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceUnlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2446,25 +3380,6 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Success = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockingLockID", wireType)
-			}
-			m.UnlockingLockID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnlockingLockID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2486,7 +3401,7 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2509,10 +3424,10 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendLockup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendLockup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2549,9 +3464,9 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockID", wireType)
 			}
-			m.ID = 0
+			m.LockID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2561,16 +3476,16 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.LockID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardReceiver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2580,24 +3495,23 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RewardReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2620,7 +3534,7 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRewardReceiverAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2643,10 +3557,10 @@ func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendLockupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2690,7 +3604,7 @@ func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleUnlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2713,10 +3627,10 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceUnlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleUnlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2825,7 +3739,7 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleUnlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2848,17 +3762,17 @@ func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceUnlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleUnlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2868,12 +3782,25 @@ func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2895,7 +3822,7 @@ func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2918,10 +3845,10 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2958,9 +3885,9 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.LockID = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2970,14 +3897,14 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LockID |= uint64(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardReceiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3005,7 +3932,7 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardReceiver = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3028,7 +3955,7 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRewardReceiverAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferLockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3051,32 +3978,12 @@ func (m *MsgSetRewardReceiverAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferLockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -52,7 +52,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"mint_denom":"stake","genesis_epoch_provisions":"5000000.000000000000000000","epoch_identifier":"week","reduction_period_in_epochs":"156","reduction_factor":"0.500000000000000000","distribution_proportions":{"staking":"0.400000000000000000","pool_incentives":"0.300000000000000000","developer_rewards":"0.200000000000000000","community_pool":"0.100000000000000000"},"weighted_developer_rewards_receivers":[],"minting_rewards_distribution_start_epoch":"0"}`,
+			`{"mint_denom":"stake","genesis_epoch_provisions":"5000000.000000000000000000","epoch_identifier":"week","reduction_period_in_epochs":"156","reduction_factor":"0.500000000000000000","distribution_proportions":{"staking":"0.400000000000000000","pool_incentives":"0.300000000000000000","developer_rewards":"0.200000000000000000","community_pool":"0.100000000000000000"},"weighted_developer_rewards_receivers":[],"minting_rewards_distribution_start_epoch":"0","distribution_streams":[],"supply_curve":"Geometric","provisions_schedule":[],"staking_ratio_curve":{"goal_bonded":"0.000000000000000000","inflation_rate_change":"0.000000000000000000","inflation_min":"0.000000000000000000","inflation_max":"0.000000000000000000","epochs_per_year":"0"}}`,
 		},
 		{
 			"text output",
@@ -62,12 +62,21 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
   developer_rewards: "0.200000000000000000"
   pool_incentives: "0.300000000000000000"
   staking: "0.400000000000000000"
+distribution_streams: []
 epoch_identifier: week
 genesis_epoch_provisions: "5000000.000000000000000000"
 mint_denom: stake
 minting_rewards_distribution_start_epoch: "0"
+provisions_schedule: []
 reduction_factor: "0.500000000000000000"
 reduction_period_in_epochs: "156"
+staking_ratio_curve:
+  epochs_per_year: "0"
+  goal_bonded: "0.000000000000000000"
+  inflation_max: "0.000000000000000000"
+  inflation_min: "0.000000000000000000"
+  inflation_rate_change: "0.000000000000000000"
+supply_curve: Geometric
 weighted_developer_rewards_receivers: []`,
 		},
 	}
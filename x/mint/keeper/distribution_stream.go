@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v29/x/mint/types"
+)
+
+// GetDistributionStreamDistributed returns the amount distributed so far by the stream with the given name.
+func (k Keeper) GetDistributionStreamDistributed(ctx sdk.Context, name string) osmomath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DistributionStreamRecordKeyPrefix)
+	bz := store.Get([]byte(name))
+	if bz == nil {
+		return osmomath.ZeroInt()
+	}
+
+	var distributed osmomath.Int
+	if err := distributed.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return distributed
+}
+
+// setDistributionStreamDistributed sets the amount distributed so far by the stream with the given name.
+func (k Keeper) setDistributionStreamDistributed(ctx sdk.Context, name string, distributed osmomath.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DistributionStreamRecordKeyPrefix)
+	bz, err := distributed.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(name), bz)
+}
+
+// GetAllDistributionStreamRecords returns the distributed amounts of all streams, ordered by stream name.
+func (k Keeper) GetAllDistributionStreamRecords(ctx sdk.Context) []types.DistributionStreamRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DistributionStreamRecordKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var records []types.DistributionStreamRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var distributed osmomath.Int
+		if err := distributed.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		records = append(records, types.DistributionStreamRecord{Name: string(iterator.Key()), Distributed: distributed})
+	}
+	return records
+}
+
+// pruneDistributionStreamRecords deletes the records of streams that were removed from the params,
+// so that a stream re-added under the same name starts over.
+func (k Keeper) pruneDistributionStreamRecords(ctx sdk.Context, streams []types.DistributionStream) {
+	names := make(map[string]struct{}, len(streams))
+	for _, stream := range streams {
+		names[stream.Name] = struct{}{}
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DistributionStreamRecordKeyPrefix)
+	for _, record := range k.GetAllDistributionStreamRecords(ctx) {
+		if _, ok := names[record.Name]; !ok {
+			store.Delete([]byte(record.Name))
+		}
+	}
+}
+
+// distributeStreams distributes the amount vested by each distribution stream and not yet distributed,
+// funded from the given coin minted for the community pool.
+// Streams are funded in the order of the params until the given coin is exhausted. Any shortfall stays
+// vested and is distributed in a later epoch.
+// A stream that fails to distribute, e.g. due to an unknown or blocked recipient module, is skipped and its
+// share stays with the community pool.
+// Returns the total amount distributed by the streams.
+func (k Keeper) distributeStreams(ctx sdk.Context, streams []types.DistributionStream, communityPoolCoin sdk.Coin) osmomath.Int {
+	k.pruneDistributionStreamRecords(ctx, streams)
+
+	totalDistributed := osmomath.ZeroInt()
+	for _, stream := range streams {
+		available := communityPoolCoin.Amount.Sub(totalDistributed)
+		if !available.IsPositive() {
+			break
+		}
+
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			distributed, err := k.distributeStream(ctx, stream, sdk.NewCoin(communityPoolCoin.Denom, available))
+			if err != nil {
+				return err
+			}
+			totalDistributed = totalDistributed.Add(distributed)
+			return nil
+		})
+	}
+	return totalDistributed
+}
+
+// distributeStream sends the amount vested by the stream and not yet distributed to its recipient,
+// up to the available coin. Returns the amount distributed.
+func (k Keeper) distributeStream(ctx sdk.Context, stream types.DistributionStream, availableCoin sdk.Coin) (osmomath.Int, error) {
+	distributed := k.GetDistributionStreamDistributed(ctx, stream.Name)
+	due := stream.VestedAmount(ctx.BlockTime()).Sub(distributed)
+	if !due.IsPositive() {
+		return osmomath.ZeroInt(), nil
+	}
+
+	distributionCoins := sdk.NewCoins(sdk.NewCoin(availableCoin.Denom, osmomath.MinInt(due, availableCoin.Amount)))
+	if recipient, err := sdk.AccAddressFromBech32(stream.Recipient); err == nil {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, distributionCoins); err != nil {
+			return osmomath.Int{}, err
+		}
+	} else {
+		moduleAddress := k.accountKeeper.GetModuleAddress(stream.Recipient)
+		if moduleAddress == nil {
+			return osmomath.Int{}, errorsmod.Wrapf(types.ErrModuleDoesnotExist, "distribution stream %s recipient module %s", stream.Name, stream.Recipient)
+		}
+		// SendCoinsFromModuleToModule does not check blocked addresses, unlike SendCoinsFromModuleToAccount.
+		if k.bankKeeper.BlockedAddr(moduleAddress) {
+			return osmomath.Int{}, errorsmod.Wrapf(types.ErrBlockedRecipient, "distribution stream %s recipient module %s", stream.Name, stream.Recipient)
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, stream.Recipient, distributionCoins); err != nil {
+			return osmomath.Int{}, err
+		}
+	}
+
+	k.setDistributionStreamDistributed(ctx, stream.Name, distributed.Add(distributionCoins[0].Amount))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributionStream,
+			sdk.NewAttribute(types.AttributeKeyStreamName, stream.Name),
+			sdk.NewAttribute(types.AttributeKeyStreamRecipient, stream.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, distributionCoins.String()),
+		),
+	)
+	return distributionCoins[0].Amount, nil
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	incentivestypes "github.com/osmosis-labs/osmosis/v29/x/incentives/types"
+	"github.com/osmosis-labs/osmosis/v29/x/mint/types"
+	protorevtypes "github.com/osmosis-labs/osmosis/v29/x/protorev/types"
+)
+
+func (s *KeeperTestSuite) TestDistributeMintedCoinWithStreams() {
+	const (
+		mintAmount = 10000
+		// community pool proportion of the default params
+		communityPoolAmount = 1000
+	)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newStream := func(name, recipient string, amount int64, start, end, cliff time.Time) types.DistributionStream {
+		return types.DistributionStream{
+			Name:      name,
+			Recipient: recipient,
+			Amount:    osmomath.NewInt(amount),
+			StartTime: start,
+			EndTime:   end,
+			CliffTime: cliff,
+		}
+	}
+
+	tests := map[string]struct {
+		streams             []types.DistributionStream
+		expectedDistributed []int64
+	}{
+		"no streams": {
+			expectedDistributed: []int64{},
+		},
+		"stream not started yet": {
+			streams:             []types.DistributionStream{newStream("grant", testAddressTwo.String(), 1000, now.Add(time.Hour), now.Add(2*time.Hour), time.Time{})},
+			expectedDistributed: []int64{0},
+		},
+		"stream vested halfway": {
+			streams:             []types.DistributionStream{newStream("grant", testAddressTwo.String(), 1000, now.Add(-time.Hour), now.Add(time.Hour), time.Time{})},
+			expectedDistributed: []int64{500},
+		},
+		"stream before cliff": {
+			streams:             []types.DistributionStream{newStream("grant", testAddressTwo.String(), 1000, now.Add(-time.Hour), now.Add(time.Hour), now.Add(time.Minute))},
+			expectedDistributed: []int64{0},
+		},
+		"stream after cliff distributes everything vested since start": {
+			streams:             []types.DistributionStream{newStream("grant", testAddressTwo.String(), 400, now.Add(-2*time.Hour), now.Add(2*time.Hour), now.Add(-time.Hour))},
+			expectedDistributed: []int64{200},
+		},
+		"stream limited by the community pool allocation": {
+			streams:             []types.DistributionStream{newStream("grant", testAddressTwo.String(), 5000, now.Add(-2*time.Hour), now.Add(-time.Hour), time.Time{})},
+			expectedDistributed: []int64{communityPoolAmount},
+		},
+		"streams funded in order": {
+			streams: []types.DistributionStream{
+				newStream("first", testAddressTwo.String(), 800, now.Add(-2*time.Hour), now.Add(-time.Hour), time.Time{}),
+				newStream("second", testAddressThree.String(), 800, now.Add(-2*time.Hour), now.Add(-time.Hour), time.Time{}),
+			},
+			expectedDistributed: []int64{800, 200},
+		},
+		"module recipient": {
+			streams:             []types.DistributionStream{newStream("grant", protorevtypes.ModuleName, 1000, now.Add(-time.Hour), now.Add(time.Hour), time.Time{})},
+			expectedDistributed: []int64{500},
+		},
+		"blocked module recipient is skipped": {
+			streams: []types.DistributionStream{
+				newStream("blocked", incentivestypes.ModuleName, 1000, now.Add(-time.Hour), now.Add(time.Hour), time.Time{}),
+				newStream("grant", testAddressTwo.String(), 1000, now.Add(-time.Hour), now.Add(time.Hour), time.Time{}),
+			},
+			expectedDistributed: []int64{0, 500},
+		},
+		"blocked account recipient is skipped": {
+			streams: []types.DistributionStream{
+				newStream("blocked", authtypes.NewModuleAddress(incentivestypes.ModuleName).String(), 1000, now.Add(-time.Hour), now.Add(time.Hour), time.Time{}),
+				newStream("grant", testAddressTwo.String(), 1000, now.Add(-time.Hour), now.Add(time.Hour), time.Time{}),
+			},
+			expectedDistributed: []int64{0, 500},
+		},
+		"unknown module recipient is skipped": {
+			streams: []types.DistributionStream{
+				newStream("unknown", "unknown_module", 1000, now.Add(-time.Hour), now.Add(time.Hour), time.Time{}),
+				newStream("grant", testAddressTwo.String(), 1000, now.Add(-time.Hour), now.Add(time.Hour), time.Time{}),
+			},
+			expectedDistributed: []int64{0, 500},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+			s.Ctx = s.Ctx.WithBlockTime(now)
+			mintKeeper := s.App.MintKeeper
+			mintKeeper.SetMintHooksUnsafe(&mintHooksMock{})
+
+			params := types.DefaultParams()
+			params.WeightedDeveloperRewardsReceivers = []types.WeightedAddress{{Address: testAddressOne.String(), Weight: osmomath.OneDec()}}
+			params.DistributionStreams = tc.streams
+			mintKeeper.SetParams(s.Ctx, params)
+
+			mintCoin := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(mintAmount))
+			s.MintCoins(sdk.NewCoins(mintCoin))
+
+			recipientBalancesBefore := make([]osmomath.Int, len(tc.streams))
+			for i, stream := range tc.streams {
+				recipientBalancesBefore[i] = s.App.BankKeeper.GetBalance(s.Ctx, s.streamRecipientAddress(stream), sdk.DefaultBondDenom).Amount
+			}
+
+			// System under test.
+			err := mintKeeper.DistributeMintedCoin(s.Ctx, mintCoin)
+			s.Require().NoError(err)
+
+			totalDistributed := int64(0)
+			for i, stream := range tc.streams {
+				expected := osmomath.NewInt(tc.expectedDistributed[i])
+				s.Require().Equal(expected, mintKeeper.GetDistributionStreamDistributed(s.Ctx, stream.Name))
+				recipientBalance := s.App.BankKeeper.GetBalance(s.Ctx, s.streamRecipientAddress(stream), sdk.DefaultBondDenom).Amount
+				s.Require().Equal(expected, recipientBalance.Sub(recipientBalancesBefore[i]))
+				totalDistributed += tc.expectedDistributed[i]
+			}
+
+			// the community pool receives what was not distributed by the streams.
+			communityPoolBalance := s.App.BankKeeper.GetBalance(s.Ctx, s.App.AccountKeeper.GetModuleAddress(distributiontypes.ModuleName), sdk.DefaultBondDenom).Amount
+			s.Require().Equal(osmomath.NewInt(communityPoolAmount-totalDistributed), communityPoolBalance)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestDistributionStreamAcrossEpochs() {
+	s.Setup()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.Ctx = s.Ctx.WithBlockTime(now)
+	mintKeeper := s.App.MintKeeper
+	mintKeeper.SetMintHooksUnsafe(&mintHooksMock{})
+
+	stream := types.DistributionStream{
+		Name:      "grant",
+		Recipient: testAddressTwo.String(),
+		Amount:    osmomath.NewInt(1500),
+		StartTime: now.Add(-2 * time.Hour),
+		EndTime:   now.Add(-time.Hour),
+	}
+	params := types.DefaultParams()
+	params.DistributionStreams = []types.DistributionStream{stream}
+	mintKeeper.SetParams(s.Ctx, params)
+
+	mintCoin := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(10000))
+	distribute := func() {
+		s.MintCoins(sdk.NewCoins(mintCoin))
+		err := mintKeeper.DistributeMintedCoin(s.Ctx, mintCoin)
+		s.Require().NoError(err)
+	}
+
+	// the first epoch is limited by the community pool allocation, the shortfall is distributed in the next epoch.
+	distribute()
+	s.Require().Equal(osmomath.NewInt(1000), mintKeeper.GetDistributionStreamDistributed(s.Ctx, stream.Name))
+	distribute()
+	s.Require().Equal(osmomath.NewInt(1500), mintKeeper.GetDistributionStreamDistributed(s.Ctx, stream.Name))
+	distribute()
+	s.Require().Equal(osmomath.NewInt(1500), s.App.BankKeeper.GetBalance(s.Ctx, testAddressTwo, sdk.DefaultBondDenom).Amount)
+
+	// the record is exported in genesis.
+	genesis := mintKeeper.ExportGenesis(s.Ctx)
+	s.Require().Equal([]types.DistributionStreamRecord{{Name: stream.Name, Distributed: osmomath.NewInt(1500)}}, genesis.DistributionStreamRecords)
+
+	// removing the stream from the params prunes its record.
+	params.DistributionStreams = []types.DistributionStream{}
+	mintKeeper.SetParams(s.Ctx, params)
+	distribute()
+	s.Require().Empty(mintKeeper.GetAllDistributionStreamRecords(s.Ctx))
+}
+
+func (s *KeeperTestSuite) streamRecipientAddress(stream types.DistributionStream) sdk.AccAddress {
+	if addr, err := sdk.AccAddressFromBech32(stream.Recipient); err == nil {
+		return addr
+	}
+	if addr := s.App.AccountKeeper.GetModuleAddress(stream.Recipient); addr != nil {
+		return addr
+	}
+	return authtypes.NewModuleAddress(stream.Recipient)
+}
@@ -36,6 +36,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	}
 
 	k.setLastReductionEpochNum(ctx, data.ReductionStartedEpoch)
+
+	for _, record := range data.DistributionStreamRecords {
+		k.setDistributionStreamDistributed(ctx, record.Name, record.Distributed)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -47,6 +51,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		params.WeightedDeveloperRewardsReceivers = make([]types.WeightedAddress, 0)
 	}
 
+	if params.DistributionStreams == nil {
+		params.DistributionStreams = make([]types.DistributionStream, 0)
+	}
+
+	if params.ProvisionsSchedule == nil {
+		params.ProvisionsSchedule = make([]types.ProvisionsScheduleEntry, 0)
+	}
+
 	lastHalvenEpoch := k.getLastReductionEpochNum(ctx)
-	return types.NewGenesisState(minter, params, lastHalvenEpoch)
+	genesis := types.NewGenesisState(minter, params, lastHalvenEpoch)
+	genesis.DistributionStreamRecords = k.GetAllDistributionStreamRecords(ctx)
+	return genesis
 }
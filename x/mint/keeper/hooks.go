@@ -19,7 +19,7 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochN
 // AfterEpochEnd is a hook which is executed after the end of an epoch.
 // This hook should attempt to mint and distribute coins according to
 // the configuration set via parameters. In addition, it handles the logic
-// for reducing minted coins according to the parameters.
+// for updating minted coins according to the supply curve set via parameters.
 // For an attempt to mint to occur:
 // - given epochIdentifier must be equal to the mint epoch identifier set via parameters.
 // - given epochNumber must be greater than or equal to the mint start epoch set via parameters.
@@ -36,16 +36,10 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		// fetch stored minter & params
 		minter := k.GetMinter(ctx)
 
-		// Check if we have hit an epoch where we update the inflation parameter.
-		// We measure time between reductions in number of epochs.
-		// This avoids issues with measuring in block numbers, as epochs have fixed intervals, with very
-		// low variance at the relevant sizes. As a result, it is safe to store the epoch number
-		// of the last reduction to be later retrieved for comparison.
-		if epochNumber >= params.ReductionPeriodInEpochs+k.getLastReductionEpochNum(ctx) {
-			// Reduce the reward per reduction period
-			minter.EpochProvisions = minter.NextEpochProvisions(params)
-			k.SetMinter(ctx, minter)
-			k.setLastReductionEpochNum(ctx, epochNumber)
+		// Update the epoch provisions according to the supply curve.
+		minter, err := k.updateEpochProvisions(ctx, params, minter, epochNumber)
+		if err != nil {
+			return err
 		}
 
 		// mint coins, update supply
@@ -53,7 +47,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		mintedCoins := sdk.NewCoins(mintedCoin)
 
 		// We over-allocate by the developer vesting portion, and burn this later
-		err := k.mintCoins(ctx, mintedCoins)
+		err = k.mintCoins(ctx, mintedCoins)
 		if err != nil {
 			return err
 		}
@@ -26,6 +26,7 @@ type Keeper struct {
 	bankKeeper          types.BankKeeper
 	communityPoolKeeper types.CommunityPoolKeeper
 	epochKeeper         types.EpochKeeper
+	stakingKeeper       types.StakingKeeper
 	hooks               types.MintHooks
 	feeCollectorName    string
 }
@@ -53,7 +54,7 @@ const emptyWeightedAddressReceiver = ""
 func NewKeeper(
 	key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, ck types.CommunityPoolKeeper, epochKeeper types.EpochKeeper,
-	feeCollectorName string,
+	stakingKeeper types.StakingKeeper, feeCollectorName string,
 ) Keeper {
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -72,6 +73,7 @@ func NewKeeper(
 		bankKeeper:          bk,
 		communityPoolKeeper: ck,
 		epochKeeper:         epochKeeper,
+		stakingKeeper:       stakingKeeper,
 		feeCollectorName:    feeCollectorName,
 	}
 }
@@ -145,6 +147,10 @@ func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error
 
 	// subtract from original provision to ensure no coins left over after the allocations
 	communityPoolAmount := mintedCoin.Amount.Sub(stakingIncentivesAmount).Sub(poolIncentivesAmount).Sub(devRewardAmount)
+
+	// fund distribution streams from the community pool allocation, the rest goes to the community pool.
+	streamsAmount := k.distributeStreams(ctx, params.DistributionStreams, sdk.NewCoin(params.MintDenom, communityPoolAmount))
+	communityPoolAmount = communityPoolAmount.Sub(streamsAmount)
 	err = k.communityPoolKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, communityPoolAmount)), k.accountKeeper.GetModuleAddress(types.ModuleName))
 	if err != nil {
 		return err
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v29/x/mint/types"
+)
+
+// updateEpochProvisions updates the minter's epoch provisions for the given epoch
+// according to the supply curve selected in the params.
+func (k Keeper) updateEpochProvisions(ctx sdk.Context, params types.Params, minter types.Minter, epochNumber int64) (types.Minter, error) {
+	previousEpochProvisions := minter.EpochProvisions
+	switch params.SupplyCurve {
+	case types.Geometric:
+		// Check if we have hit an epoch where we update the inflation parameter.
+		// We measure time between reductions in number of epochs.
+		// This avoids issues with measuring in block numbers, as epochs have fixed intervals, with very
+		// low variance at the relevant sizes. As a result, it is safe to store the epoch number
+		// of the last reduction to be later retrieved for comparison.
+		if epochNumber >= params.ReductionPeriodInEpochs+k.getLastReductionEpochNum(ctx) {
+			// Reduce the reward per reduction period
+			minter.EpochProvisions = minter.NextEpochProvisions(params)
+			k.setLastReductionEpochNum(ctx, epochNumber)
+		}
+	case types.PiecewiseLinear:
+		// the schedule can be emptied by a param change after the curve was selected,
+		// in which case the epoch provisions are left unchanged.
+		if len(params.ProvisionsSchedule) > 0 {
+			minter.EpochProvisions = types.ScheduledEpochProvisions(params.ProvisionsSchedule, epochNumber)
+		}
+	case types.StakingRatio:
+		// likewise, the epoch provisions are left unchanged if the curve was unset.
+		if params.StakingRatioCurve.IsEmpty() {
+			break
+		}
+		bondedTokens, err := k.stakingKeeper.TotalBondedTokens(ctx)
+		if err != nil {
+			return types.Minter{}, err
+		}
+		supply := k.bankKeeper.GetSupplyWithOffset(ctx, params.MintDenom).Amount
+		minter.EpochProvisions = params.StakingRatioCurve.NextEpochProvisions(minter.EpochProvisions, bondedTokens, supply)
+	default:
+		return types.Minter{}, fmt.Errorf("unknown supply curve: %d", params.SupplyCurve)
+	}
+
+	// The reduction period of the geometric curve only runs while it is selected, so that switching back to it
+	// does not trigger the reductions that would have happened in the meantime.
+	if params.SupplyCurve != types.Geometric {
+		k.setLastReductionEpochNum(ctx, epochNumber)
+	}
+
+	if !minter.EpochProvisions.Equal(previousEpochProvisions) {
+		k.SetMinter(ctx, minter)
+	}
+	return minter, nil
+}
@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/mint/types"
+)
+
+func (s *KeeperTestSuite) TestAfterEpochEndSupplyCurves() {
+	const (
+		startEpoch       = 1
+		reductionPeriod  = 10
+		initialProvision = 1000
+	)
+
+	schedule := []types.ProvisionsScheduleEntry{
+		{Epoch: 10, EpochProvisions: osmomath.NewDec(2000)},
+		{Epoch: 20, EpochProvisions: osmomath.NewDec(1000)},
+	}
+
+	tests := map[string]struct {
+		supplyCurve        types.SupplyCurve
+		provisionsSchedule []types.ProvisionsScheduleEntry
+		stakingRatioCurve  types.StakingRatioCurve
+		epochNumber        int64
+
+		// expectedProvisions is computed from the bonded tokens and supply
+		// when expectStakingRatioCalc is set.
+		expectedProvisions     osmomath.Dec
+		expectedLastReduction  int64
+		expectStakingRatioCalc bool
+	}{
+		"geometric before the reduction period": {
+			supplyCurve:           types.Geometric,
+			epochNumber:           startEpoch + reductionPeriod - 1,
+			expectedProvisions:    osmomath.NewDec(initialProvision),
+			expectedLastReduction: startEpoch,
+		},
+		"geometric at the reduction period": {
+			supplyCurve:           types.Geometric,
+			epochNumber:           startEpoch + reductionPeriod,
+			expectedProvisions:    osmomath.NewDec(initialProvision / 2),
+			expectedLastReduction: startEpoch + reductionPeriod,
+		},
+		"piecewise linear before the schedule": {
+			supplyCurve:           types.PiecewiseLinear,
+			provisionsSchedule:    schedule,
+			epochNumber:           5,
+			expectedProvisions:    osmomath.NewDec(2000),
+			expectedLastReduction: 5,
+		},
+		"piecewise linear within the schedule": {
+			supplyCurve:           types.PiecewiseLinear,
+			provisionsSchedule:    schedule,
+			epochNumber:           15,
+			expectedProvisions:    osmomath.NewDec(1500),
+			expectedLastReduction: 15,
+		},
+		"piecewise linear with the schedule removed keeps the provisions": {
+			supplyCurve:           types.PiecewiseLinear,
+			epochNumber:           15,
+			expectedProvisions:    osmomath.NewDec(initialProvision),
+			expectedLastReduction: 15,
+		},
+		"staking ratio": {
+			supplyCurve:            types.StakingRatio,
+			stakingRatioCurve:      types.DefaultStakingRatioCurve(),
+			epochNumber:            startEpoch + reductionPeriod,
+			expectStakingRatioCalc: true,
+			expectedLastReduction:  startEpoch + reductionPeriod,
+		},
+		"staking ratio with the curve removed keeps the provisions": {
+			supplyCurve:           types.StakingRatio,
+			epochNumber:           startEpoch + reductionPeriod,
+			expectedProvisions:    osmomath.NewDec(initialProvision),
+			expectedLastReduction: startEpoch + reductionPeriod,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+			mintKeeper := s.App.MintKeeper
+			mintKeeper.SetMintHooksUnsafe(&mintHooksMock{})
+
+			params := types.DefaultParams()
+			params.MintingRewardsDistributionStartEpoch = startEpoch
+			params.ReductionPeriodInEpochs = reductionPeriod
+			params.SupplyCurve = tc.supplyCurve
+			params.ProvisionsSchedule = tc.provisionsSchedule
+			params.StakingRatioCurve = tc.stakingRatioCurve
+			mintKeeper.SetParams(s.Ctx, params)
+			mintKeeper.SetMinter(s.Ctx, types.NewMinter(osmomath.NewDec(initialProvision)))
+			mintKeeper.SetLastReductionEpochNum(s.Ctx, startEpoch)
+
+			expectedProvisions := tc.expectedProvisions
+			if tc.expectStakingRatioCalc {
+				bondedTokens, err := s.App.StakingKeeper.TotalBondedTokens(s.Ctx)
+				s.Require().NoError(err)
+				supply := s.App.BankKeeper.GetSupplyWithOffset(s.Ctx, params.MintDenom).Amount
+				expectedProvisions = tc.stakingRatioCurve.NextEpochProvisions(osmomath.NewDec(initialProvision), bondedTokens, supply)
+				s.Require().True(expectedProvisions.IsPositive())
+			}
+
+			err := mintKeeper.AfterEpochEnd(s.Ctx, params.EpochIdentifier, tc.epochNumber)
+			s.Require().NoError(err)
+
+			s.Require().Equal(expectedProvisions, mintKeeper.GetMinter(s.Ctx).EpochProvisions)
+			s.Require().Equal(tc.expectedLastReduction, mintKeeper.GetLastReductionEpochNum(s.Ctx))
+
+			// the minted amount follows the updated provisions.
+			feeCollectorBalance := s.App.BankKeeper.GetBalance(s.Ctx, s.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), sdk.DefaultBondDenom)
+			s.Require().Equal(expectedProvisions.Mul(params.DistributionProportions.Staking).TruncateInt(), feeCollectorBalance.Amount)
+		})
+	}
+}
+
+// TestSupplyCurveSwitch checks that switching back to the geometric curve restarts its reduction period,
+// instead of applying the reductions that were skipped while another curve was selected.
+func (s *KeeperTestSuite) TestSupplyCurveSwitch() {
+	const (
+		startEpoch       = 1
+		reductionPeriod  = 10
+		initialProvision = 1000
+	)
+
+	s.Setup()
+	mintKeeper := s.App.MintKeeper
+	mintKeeper.SetMintHooksUnsafe(&mintHooksMock{})
+
+	params := types.DefaultParams()
+	params.MintingRewardsDistributionStartEpoch = startEpoch
+	params.ReductionPeriodInEpochs = reductionPeriod
+	params.SupplyCurve = types.Geometric
+	mintKeeper.SetParams(s.Ctx, params)
+	mintKeeper.SetMinter(s.Ctx, types.NewMinter(osmomath.NewDec(initialProvision)))
+	mintKeeper.SetLastReductionEpochNum(s.Ctx, startEpoch)
+
+	// Switch to the piecewise linear curve for several reduction periods.
+	params.SupplyCurve = types.PiecewiseLinear
+	params.ProvisionsSchedule = []types.ProvisionsScheduleEntry{{Epoch: 1, EpochProvisions: osmomath.NewDec(initialProvision)}}
+	mintKeeper.SetParams(s.Ctx, params)
+	for epoch := int64(startEpoch + 1); epoch <= startEpoch+3*reductionPeriod; epoch++ {
+		s.Require().NoError(mintKeeper.AfterEpochEnd(s.Ctx, params.EpochIdentifier, epoch))
+	}
+	s.Require().Equal(osmomath.NewDec(initialProvision), mintKeeper.GetMinter(s.Ctx).EpochProvisions)
+
+	// Switching back does not reduce the provisions right away.
+	switchEpoch := int64(startEpoch + 3*reductionPeriod + 1)
+	params.SupplyCurve = types.Geometric
+	mintKeeper.SetParams(s.Ctx, params)
+	s.Require().NoError(mintKeeper.AfterEpochEnd(s.Ctx, params.EpochIdentifier, switchEpoch))
+	s.Require().Equal(osmomath.NewDec(initialProvision), mintKeeper.GetMinter(s.Ctx).EpochProvisions)
+	s.Require().Equal(switchEpoch-1, mintKeeper.GetLastReductionEpochNum(s.Ctx))
+
+	// The provisions are reduced a full reduction period after the last epoch of the other curve.
+	s.Require().NoError(mintKeeper.AfterEpochEnd(s.Ctx, params.EpochIdentifier, switchEpoch-1+reductionPeriod))
+	s.Require().Equal(osmomath.NewDec(initialProvision/2), mintKeeper.GetMinter(s.Ctx).EpochProvisions)
+	s.Require().Equal(switchEpoch-1+reductionPeriod, mintKeeper.GetLastReductionEpochNum(s.Ctx))
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// moduleNameRegex matches module account names, used as stream recipients
+// when the recipient is not a bech32 address.
+var moduleNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// Validate performs stateless validation of the distribution stream.
+func (s DistributionStream) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("distribution stream name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil && !moduleNameRegex.MatchString(s.Recipient) {
+		return fmt.Errorf("distribution stream %s recipient (%s) is neither an address nor a module name", s.Name, s.Recipient)
+	}
+	if s.Amount.IsNil() || !s.Amount.IsPositive() {
+		return fmt.Errorf("distribution stream %s amount must be positive", s.Name)
+	}
+	if !s.EndTime.After(s.StartTime) {
+		return fmt.Errorf("distribution stream %s end time (%s) must be after start time (%s)", s.Name, s.EndTime, s.StartTime)
+	}
+	if s.HasCliff() && (s.CliffTime.Before(s.StartTime) || s.CliffTime.After(s.EndTime)) {
+		return fmt.Errorf("distribution stream %s cliff time (%s) must be between start and end time", s.Name, s.CliffTime)
+	}
+	return nil
+}
+
+// HasCliff returns true if the stream has a cliff time set.
+func (s DistributionStream) HasCliff() bool {
+	return !s.CliffTime.IsZero()
+}
+
+// VestedAmount returns the total amount vested by the stream at the given time.
+// Vesting is linear between the start and end time, and nothing is vested before the cliff.
+func (s DistributionStream) VestedAmount(blockTime time.Time) osmomath.Int {
+	if blockTime.Before(s.StartTime) || (s.HasCliff() && blockTime.Before(s.CliffTime)) {
+		return osmomath.ZeroInt()
+	}
+	if !blockTime.Before(s.EndTime) {
+		return s.Amount
+	}
+
+	elapsed := osmomath.NewInt(blockTime.Sub(s.StartTime).Nanoseconds())
+	total := osmomath.NewInt(s.EndTime.Sub(s.StartTime).Nanoseconds())
+	return s.Amount.Mul(elapsed).Quo(total)
+}
+
+func validateDistributionStreams(i interface{}) error {
+	v, ok := i.([]DistributionStream)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	names := make(map[string]struct{}, len(v))
+	for _, stream := range v {
+		if err := stream.Validate(); err != nil {
+			return err
+		}
+		if _, ok := names[stream.Name]; ok {
+			return fmt.Errorf("duplicate distribution stream name %s", stream.Name)
+		}
+		names[stream.Name] = struct{}{}
+	}
+
+	return nil
+}
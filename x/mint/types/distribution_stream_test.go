@@ -0,0 +1,147 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/mint/types"
+)
+
+var (
+	streamStartTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	streamEndTime   = streamStartTime.Add(100 * time.Hour)
+)
+
+func defaultDistributionStream() types.DistributionStream {
+	return types.DistributionStream{
+		Name:      "grant",
+		Recipient: sdk.AccAddress([]byte("addr1---------------")).String(),
+		Amount:    osmomath.NewInt(1000),
+		StartTime: streamStartTime,
+		EndTime:   streamEndTime,
+	}
+}
+
+func TestDistributionStreamValidate(t *testing.T) {
+	tests := map[string]struct {
+		modify    func(*types.DistributionStream)
+		expectErr bool
+	}{
+		"valid address recipient": {
+			modify: func(*types.DistributionStream) {},
+		},
+		"valid module recipient": {
+			modify: func(s *types.DistributionStream) { s.Recipient = "incentives" },
+		},
+		"valid cliff": {
+			modify: func(s *types.DistributionStream) { s.CliffTime = streamStartTime.Add(time.Hour) },
+		},
+		"empty name": {
+			modify:    func(s *types.DistributionStream) { s.Name = "" },
+			expectErr: true,
+		},
+		"invalid recipient": {
+			modify:    func(s *types.DistributionStream) { s.Recipient = "Not A Module" },
+			expectErr: true,
+		},
+		"nil amount": {
+			modify:    func(s *types.DistributionStream) { s.Amount = osmomath.Int{} },
+			expectErr: true,
+		},
+		"zero amount": {
+			modify:    func(s *types.DistributionStream) { s.Amount = osmomath.ZeroInt() },
+			expectErr: true,
+		},
+		"end time equal to start time": {
+			modify:    func(s *types.DistributionStream) { s.EndTime = s.StartTime },
+			expectErr: true,
+		},
+		"cliff before start time": {
+			modify:    func(s *types.DistributionStream) { s.CliffTime = streamStartTime.Add(-time.Hour) },
+			expectErr: true,
+		},
+		"cliff after end time": {
+			modify:    func(s *types.DistributionStream) { s.CliffTime = streamEndTime.Add(time.Hour) },
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			stream := defaultDistributionStream()
+			tc.modify(&stream)
+
+			err := stream.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDistributionStreamsParamValidation(t *testing.T) {
+	params := types.DefaultParams()
+	params.DistributionStreams = []types.DistributionStream{defaultDistributionStream(), defaultDistributionStream()}
+	require.Error(t, params.Validate())
+
+	params.DistributionStreams[1].Name = "other"
+	require.NoError(t, params.Validate())
+}
+
+func TestDistributionStreamVestedAmount(t *testing.T) {
+	tests := map[string]struct {
+		cliffTime time.Time
+		blockTime time.Time
+		expected  osmomath.Int
+	}{
+		"before start": {
+			blockTime: streamStartTime.Add(-time.Hour),
+			expected:  osmomath.ZeroInt(),
+		},
+		"at start": {
+			blockTime: streamStartTime,
+			expected:  osmomath.ZeroInt(),
+		},
+		"linear vesting": {
+			blockTime: streamStartTime.Add(25 * time.Hour),
+			expected:  osmomath.NewInt(250),
+		},
+		"vested amount is truncated": {
+			blockTime: streamStartTime.Add(90 * time.Minute),
+			expected:  osmomath.NewInt(15),
+		},
+		"at end": {
+			blockTime: streamEndTime,
+			expected:  osmomath.NewInt(1000),
+		},
+		"after end": {
+			blockTime: streamEndTime.Add(time.Hour),
+			expected:  osmomath.NewInt(1000),
+		},
+		"before cliff": {
+			cliffTime: streamStartTime.Add(50 * time.Hour),
+			blockTime: streamStartTime.Add(49 * time.Hour),
+			expected:  osmomath.ZeroInt(),
+		},
+		"at cliff vests everything since start": {
+			cliffTime: streamStartTime.Add(50 * time.Hour),
+			blockTime: streamStartTime.Add(50 * time.Hour),
+			expected:  osmomath.NewInt(500),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			stream := defaultDistributionStream()
+			stream.CliffTime = tc.cliffTime
+
+			require.Equal(t, tc.expected, stream.VestedAmount(tc.blockTime))
+		})
+	}
+}
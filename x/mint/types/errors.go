@@ -8,4 +8,5 @@ var (
 	ErrAmountNilOrZero           = errorsmod.Register(ModuleName, 2, "amount cannot be nil or zero")
 	ErrModuleAccountAlreadyExist = errorsmod.Register(ModuleName, 3, "module account already exists")
 	ErrModuleDoesnotExist        = errorsmod.Register(ModuleName, 4, "module account does not exist")
+	ErrBlockedRecipient          = errorsmod.Register(ModuleName, 5, "recipient is not allowed to receive funds")
 )
@@ -8,4 +8,14 @@ const (
 	// AttributeEpochNumber is the string representation of the
 	// epoch number event attribute.
 	AttributeEpochNumber = "epoch_number"
+
+	// EventTypeDistributionStream is the event type emitted when a
+	// distribution stream distributes minted coins.
+	EventTypeDistributionStream = "distribution_stream"
+	// AttributeKeyStreamName is the string representation of the
+	// distribution stream name event attribute.
+	AttributeKeyStreamName = "name"
+	// AttributeKeyStreamRecipient is the string representation of the
+	// distribution stream recipient event attribute.
+	AttributeKeyStreamRecipient = "recipient"
 )
@@ -25,9 +25,11 @@ type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
 	MintCoins(ctx context.Context, name string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 	AddSupplyOffset(ctx context.Context, denom string, offsetAmount osmomath.Int)
+	GetSupplyWithOffset(ctx context.Context, denom string) sdk.Coin
 }
 
 // CommunityPoolKeeper defines the contract needed to be fulfilled for distribution keeper.
@@ -39,3 +41,8 @@ type CommunityPoolKeeper interface {
 type EpochKeeper interface {
 	GetEpochInfo(ctx sdk.Context, identifier string) epochstypes.EpochInfo
 }
+
+// StakingKeeper defines the contract needed to be fulfilled for staking keeper.
+type StakingKeeper interface {
+	TotalBondedTokens(ctx context.Context) (osmomath.Int, error)
+}
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // NewGenesisState creates a new GenesisState object.
 func NewGenesisState(minter Minter, params Params, reductionStartedEpoch int64) *GenesisState {
 	return &GenesisState{
@@ -25,5 +27,16 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	names := make(map[string]struct{}, len(data.DistributionStreamRecords))
+	for _, record := range data.DistributionStreamRecords {
+		if _, ok := names[record.Name]; ok {
+			return fmt.Errorf("duplicate distribution stream record %s", record.Name)
+		}
+		names[record.Name] = struct{}{}
+		if record.Distributed.IsNil() || record.Distributed.IsNegative() {
+			return fmt.Errorf("distribution stream record %s has invalid distributed amount", record.Name)
+		}
+	}
+
 	return data.Minter.Validate()
 }
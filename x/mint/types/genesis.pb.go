@@ -32,6 +32,9 @@ type GenesisState struct {
 	// reduction_started_epoch is the first epoch in which the reduction of mint
 	// begins.
 	ReductionStartedEpoch int64 `protobuf:"varint,3,opt,name=reduction_started_epoch,json=reductionStartedEpoch,proto3" json:"reduction_started_epoch,omitempty" yaml:"reduction_started_epoch"`
+	// distribution_stream_records are the amounts distributed so far by the
+	// distribution streams.
+	DistributionStreamRecords []DistributionStreamRecord `protobuf:"bytes,4,rep,name=distribution_stream_records,json=distributionStreamRecords,proto3" json:"distribution_stream_records" yaml:"distribution_stream_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +91,13 @@ func (m *GenesisState) GetReductionStartedEpoch() int64 {
 	return 0
 }
 
+func (m *GenesisState) GetDistributionStreamRecords() []DistributionStreamRecord {
+	if m != nil {
+		return m.DistributionStreamRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.mint.v1beta1.GenesisState")
 }
@@ -97,25 +107,29 @@ func init() {
 }
 
 var fileDescriptor_12e6a5511ad3feeb = []byte{
-	// 283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x81, 0xaa, 0xd1, 0x03, 0xa9, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0xe4, 0xb1, 0x9a, 0x07, 0xd6, 0x08, 0x56, 0xa0, 0xf4, 0x88,
-	0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x7c, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x15, 0x17, 0x1b, 0x48,
-	0x3a, 0xb5, 0x48, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x46, 0x0f, 0x9b, 0x75, 0x7a, 0xbe,
-	0x60, 0x35, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x80, 0xf4, 0x16, 0x24, 0x16,
-	0x25, 0xe6, 0x16, 0x4b, 0x30, 0xe1, 0xd3, 0x1b, 0x00, 0x56, 0x03, 0xd3, 0x0b, 0xd1, 0x21, 0x14,
-	0xc5, 0x25, 0x5e, 0x94, 0x9a, 0x52, 0x9a, 0x5c, 0x92, 0x99, 0x9f, 0x17, 0x5f, 0x5c, 0x92, 0x58,
-	0x54, 0x92, 0x9a, 0x12, 0x9f, 0x5a, 0x90, 0x9f, 0x9c, 0x21, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0xec,
-	0xa4, 0xf4, 0xe9, 0x9e, 0xbc, 0x5c, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x0e, 0x85, 0x4a, 0x41,
-	0xa2, 0x70, 0x99, 0x60, 0x88, 0x84, 0x2b, 0x48, 0xdc, 0xc9, 0xeb, 0xc4, 0x23, 0x39, 0xc6, 0x0b,
-	0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86,
-	0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x0c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73,
-	0xf5, 0xa1, 0x6e, 0xd5, 0xcd, 0x49, 0x4c, 0x2a, 0x86, 0x71, 0xf4, 0xcb, 0x8c, 0x2c, 0xf5, 0x2b,
-	0x20, 0xa1, 0x57, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x37, 0x63, 0x40, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xb1, 0x98, 0xed, 0x01, 0xaa, 0x01, 0x00, 0x00,
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x6a, 0xc2, 0x30,
+	0x18, 0xc7, 0x1b, 0x15, 0x0f, 0x75, 0xa7, 0xe2, 0x58, 0xe7, 0x46, 0x2a, 0x39, 0xc9, 0x60, 0xc9,
+	0x74, 0xa7, 0x79, 0x2c, 0x1b, 0x83, 0xc1, 0x60, 0xd4, 0x9b, 0x17, 0x49, 0xdb, 0x50, 0x03, 0xd6,
+	0x94, 0x24, 0xca, 0x7c, 0x8b, 0x9d, 0xf7, 0x44, 0x1e, 0x3d, 0xee, 0x24, 0x43, 0xdf, 0xc0, 0x17,
+	0xd8, 0x68, 0x5a, 0xc7, 0x0e, 0xea, 0xad, 0xcd, 0xf7, 0xfb, 0xfd, 0xbf, 0x3f, 0x7c, 0x36, 0x12,
+	0x2a, 0x15, 0x8a, 0x2b, 0x92, 0xf2, 0xa9, 0x26, 0xf3, 0x6e, 0xc8, 0x34, 0xed, 0x92, 0x84, 0x4d,
+	0x99, 0xe2, 0x0a, 0x67, 0x52, 0x68, 0xe1, 0x34, 0x4b, 0x06, 0xe7, 0x0c, 0x2e, 0x99, 0x56, 0x33,
+	0x11, 0x89, 0x30, 0x00, 0xc9, 0xbf, 0x0a, 0xb6, 0xe5, 0x1d, 0xcc, 0x33, 0xa2, 0x01, 0xd0, 0x4f,
+	0xc5, 0x3e, 0x7b, 0x2e, 0xe2, 0x07, 0x9a, 0x6a, 0xe6, 0xf4, 0xed, 0x7a, 0x3e, 0x66, 0xd2, 0x05,
+	0x6d, 0xd0, 0x69, 0xf4, 0xae, 0xf1, 0xa1, 0x75, 0xf8, 0xd5, 0x30, 0x7e, 0x6d, 0xb9, 0xf6, 0xac,
+	0xa0, 0x34, 0x72, 0x37, 0xa3, 0x92, 0xa6, 0xca, 0xad, 0x9c, 0x72, 0xdf, 0x0c, 0xb3, 0x77, 0x0b,
+	0xc3, 0x19, 0xda, 0x17, 0x92, 0xc5, 0xb3, 0x48, 0x73, 0x31, 0x1d, 0x29, 0x4d, 0xa5, 0x66, 0xf1,
+	0x88, 0x65, 0x22, 0x1a, 0xbb, 0xd5, 0x36, 0xe8, 0x54, 0x7d, 0xb4, 0x5b, 0x7b, 0x70, 0x41, 0xd3,
+	0x49, 0x1f, 0x1d, 0x01, 0x51, 0x70, 0xfe, 0x37, 0x19, 0x14, 0x83, 0xa7, 0xfc, 0xdd, 0xf9, 0x04,
+	0xf6, 0x55, 0xcc, 0x95, 0x96, 0x3c, 0x9c, 0x95, 0x9a, 0x64, 0x34, 0x1d, 0x49, 0x16, 0x09, 0x19,
+	0x2b, 0xb7, 0xd6, 0xae, 0x76, 0x1a, 0x3d, 0x7c, 0xb8, 0xed, 0xe3, 0x3f, 0x71, 0x60, 0xbc, 0xc0,
+	0x68, 0xfe, 0x4d, 0xde, 0x7f, 0xb7, 0xf6, 0x50, 0x51, 0xea, 0xc4, 0x02, 0x14, 0x5c, 0xc6, 0x47,
+	0x52, 0x94, 0xff, 0xb2, 0xdc, 0x40, 0xb0, 0xda, 0x40, 0xf0, 0xbd, 0x81, 0xe0, 0x63, 0x0b, 0xad,
+	0xd5, 0x16, 0x5a, 0x5f, 0x5b, 0x68, 0x0d, 0xef, 0x12, 0xae, 0xc7, 0xb3, 0x10, 0x47, 0x22, 0x25,
+	0x65, 0xb5, 0xdb, 0x09, 0x0d, 0xd5, 0xfe, 0x87, 0xcc, 0x7b, 0x0f, 0xe4, 0xbd, 0x38, 0xad, 0x5e,
+	0x64, 0x4c, 0x85, 0x75, 0x73, 0xd4, 0xfb, 0xdf, 0x01, 0x00, 0xf7, 0x4c, 0x7e, 0x09, 0x47, 0x02,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -138,6 +152,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DistributionStreamRecords) > 0 {
+		for iNdEx := len(m.DistributionStreamRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionStreamRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.ReductionStartedEpoch != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.ReductionStartedEpoch))
 		i--
@@ -190,6 +218,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.ReductionStartedEpoch != 0 {
 		n += 1 + sovGenesis(uint64(m.ReductionStartedEpoch))
 	}
+	if len(m.DistributionStreamRecords) > 0 {
+		for _, e := range m.DistributionStreamRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -313,6 +347,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionStreamRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionStreamRecords = append(m.DistributionStreamRecords, DistributionStreamRecord{})
+			if err := m.DistributionStreamRecords[len(m.DistributionStreamRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
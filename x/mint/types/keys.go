@@ -8,6 +8,10 @@ var MinterKey = []byte{0x00}
 // for storing the last epoch at which reduction occurred.
 var LastReductionEpochKey = []byte{0x03}
 
+// DistributionStreamRecordKeyPrefix is the key prefix to use for the keeper store
+// for storing the amount distributed by each distribution stream, keyed by stream name.
+var DistributionStreamRecordKeyPrefix = []byte{0x04}
+
 const (
 	// ModuleName is the module name.
 	ModuleName = "mint"
@@ -9,17 +9,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +30,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SupplyCurve defines how the epoch provisions change over time.
+type SupplyCurve int32
+
+const (
+	// Geometric multiplies the epoch provisions by reduction_factor every
+	// reduction_period_in_epochs epochs.
+	Geometric SupplyCurve = 0
+	// PiecewiseLinear interpolates the epoch provisions linearly between the
+	// entries of provisions_schedule.
+	PiecewiseLinear SupplyCurve = 1
+	// StakingRatio adjusts the inflation every epoch towards a bonded ratio
+	// goal, as in the Cosmos SDK mint module, as configured by
+	// staking_ratio_curve.
+	StakingRatio SupplyCurve = 2
+)
+
+var SupplyCurve_name = map[int32]string{
+	0: "Geometric",
+	1: "PiecewiseLinear",
+	2: "StakingRatio",
+}
+
+var SupplyCurve_value = map[string]int32{
+	"Geometric":       0,
+	"PiecewiseLinear": 1,
+	"StakingRatio":    2,
+}
+
+func (x SupplyCurve) String() string {
+	return proto.EnumName(SupplyCurve_name, int32(x))
+}
+
+func (SupplyCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ccb38f8335e0f45b, []int{0}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// epoch_provisions represent rewards for the current epoch.
@@ -114,6 +153,247 @@ func (m *WeightedAddress) GetAddress() string {
 	return ""
 }
 
+// DistributionStream streams a total amount of the minted denom to a recipient,
+// vesting linearly from start_time to end_time. Nothing is distributed before
+// the cliff_time, at which point everything vested so far is distributed.
+// Streams are funded from the community pool proportion of each mint epoch.
+type DistributionStream struct {
+	// name uniquely identifies the stream.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// recipient is either a bech32 account address or a module account name.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+	// amount is the total amount of the minted denom distributed by the stream.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+	// start_time is the time at which the stream starts vesting.
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// end_time is the time at which the stream is fully vested.
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// cliff_time is the time before which nothing is distributed. The zero
+	// value means the stream has no cliff.
+	CliffTime time.Time `protobuf:"bytes,6,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time" yaml:"cliff_time"`
+}
+
+func (m *DistributionStream) Reset()         { *m = DistributionStream{} }
+func (m *DistributionStream) String() string { return proto.CompactTextString(m) }
+func (*DistributionStream) ProtoMessage()    {}
+func (*DistributionStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccb38f8335e0f45b, []int{2}
+}
+func (m *DistributionStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionStream.Merge(m, src)
+}
+func (m *DistributionStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionStream proto.InternalMessageInfo
+
+func (m *DistributionStream) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DistributionStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *DistributionStream) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *DistributionStream) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *DistributionStream) GetCliffTime() time.Time {
+	if m != nil {
+		return m.CliffTime
+	}
+	return time.Time{}
+}
+
+// DistributionStreamRecord tracks the amount distributed by a stream so far.
+type DistributionStreamRecord struct {
+	// name is the name of the distribution stream.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// distributed is the amount of the minted denom distributed by the stream.
+	Distributed cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=distributed,proto3,customtype=cosmossdk.io/math.Int" json:"distributed" yaml:"distributed"`
+}
+
+func (m *DistributionStreamRecord) Reset()         { *m = DistributionStreamRecord{} }
+func (m *DistributionStreamRecord) String() string { return proto.CompactTextString(m) }
+func (*DistributionStreamRecord) ProtoMessage()    {}
+func (*DistributionStreamRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccb38f8335e0f45b, []int{3}
+}
+func (m *DistributionStreamRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionStreamRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionStreamRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionStreamRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionStreamRecord.Merge(m, src)
+}
+func (m *DistributionStreamRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionStreamRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionStreamRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionStreamRecord proto.InternalMessageInfo
+
+func (m *DistributionStreamRecord) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// ProvisionsScheduleEntry sets the epoch provisions at an epoch of the
+// piecewise linear supply curve.
+type ProvisionsScheduleEntry struct {
+	// epoch is the mint epoch number at which the entry applies.
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty" yaml:"epoch"`
+	// epoch_provisions are the epoch provisions at the epoch.
+	EpochProvisions cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=epoch_provisions,json=epochProvisions,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"epoch_provisions" yaml:"epoch_provisions"`
+}
+
+func (m *ProvisionsScheduleEntry) Reset()         { *m = ProvisionsScheduleEntry{} }
+func (m *ProvisionsScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*ProvisionsScheduleEntry) ProtoMessage()    {}
+func (*ProvisionsScheduleEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccb38f8335e0f45b, []int{4}
+}
+func (m *ProvisionsScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProvisionsScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvisionsScheduleEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProvisionsScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvisionsScheduleEntry.Merge(m, src)
+}
+func (m *ProvisionsScheduleEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProvisionsScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvisionsScheduleEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProvisionsScheduleEntry proto.InternalMessageInfo
+
+func (m *ProvisionsScheduleEntry) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// StakingRatioCurve configures the staking ratio supply curve. Every epoch,
+// the annual inflation rate moves towards inflation_max while the bonded
+// ratio is below goal_bonded, and towards inflation_min while it is above.
+type StakingRatioCurve struct {
+	// goal_bonded is the targeted ratio of bonded tokens to the supply.
+	GoalBonded cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=goal_bonded,json=goalBonded,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"goal_bonded" yaml:"goal_bonded"`
+	// inflation_rate_change is the maximum annual change of the inflation rate.
+	InflationRateChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation_rate_change,json=inflationRateChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rate_change" yaml:"inflation_rate_change"`
+	// inflation_min is the minimum annual inflation rate.
+	InflationMin cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=inflation_min,json=inflationMin,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_min" yaml:"inflation_min"`
+	// inflation_max is the maximum annual inflation rate.
+	InflationMax cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=inflation_max,json=inflationMax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_max" yaml:"inflation_max"`
+	// epochs_per_year is the number of mint epochs in a year.
+	EpochsPerYear int64 `protobuf:"varint,5,opt,name=epochs_per_year,json=epochsPerYear,proto3" json:"epochs_per_year,omitempty" yaml:"epochs_per_year"`
+}
+
+func (m *StakingRatioCurve) Reset()         { *m = StakingRatioCurve{} }
+func (m *StakingRatioCurve) String() string { return proto.CompactTextString(m) }
+func (*StakingRatioCurve) ProtoMessage()    {}
+func (*StakingRatioCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccb38f8335e0f45b, []int{5}
+}
+func (m *StakingRatioCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingRatioCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingRatioCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingRatioCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingRatioCurve.Merge(m, src)
+}
+func (m *StakingRatioCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingRatioCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingRatioCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingRatioCurve proto.InternalMessageInfo
+
+func (m *StakingRatioCurve) GetEpochsPerYear() int64 {
+	if m != nil {
+		return m.EpochsPerYear
+	}
+	return 0
+}
+
 // DistributionProportions defines the distribution proportions of the minted
 // denom. In other words, defines which stakeholders will receive the minted
 // denoms and how much.
@@ -136,7 +416,7 @@ func (m *DistributionProportions) Reset()         { *m = DistributionProportions
 func (m *DistributionProportions) String() string { return proto.CompactTextString(m) }
 func (*DistributionProportions) ProtoMessage()    {}
 func (*DistributionProportions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccb38f8335e0f45b, []int{2}
+	return fileDescriptor_ccb38f8335e0f45b, []int{6}
 }
 func (m *DistributionProportions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,12 +471,24 @@ type Params struct {
 	// minting_rewards_distribution_start_epoch start epoch to distribute minting
 	// rewards
 	MintingRewardsDistributionStartEpoch int64 `protobuf:"varint,8,opt,name=minting_rewards_distribution_start_epoch,json=mintingRewardsDistributionStartEpoch,proto3" json:"minting_rewards_distribution_start_epoch,omitempty" yaml:"minting_rewards_distribution_start_epoch"`
+	// distribution_streams are the governance managed streams funded from the
+	// community pool proportion of the minted denom.
+	DistributionStreams []DistributionStream `protobuf:"bytes,9,rep,name=distribution_streams,json=distributionStreams,proto3" json:"distribution_streams" yaml:"distribution_streams"`
+	// supply_curve selects how the epoch provisions change over time.
+	SupplyCurve SupplyCurve `protobuf:"varint,10,opt,name=supply_curve,json=supplyCurve,proto3,enum=osmosis.mint.v1beta1.SupplyCurve" json:"supply_curve,omitempty" yaml:"supply_curve"`
+	// provisions_schedule are the entries of the piecewise linear supply curve,
+	// ordered by epoch.
+	ProvisionsSchedule []ProvisionsScheduleEntry `protobuf:"bytes,11,rep,name=provisions_schedule,json=provisionsSchedule,proto3" json:"provisions_schedule" yaml:"provisions_schedule"`
+	// staking_ratio_curve configures the staking ratio supply curve. It must be
+	// set when the staking ratio supply curve is selected, and is left empty
+	// otherwise.
+	StakingRatioCurve StakingRatioCurve `protobuf:"bytes,12,opt,name=staking_ratio_curve,json=stakingRatioCurve,proto3" json:"staking_ratio_curve" yaml:"staking_ratio_curve"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccb38f8335e0f45b, []int{3}
+	return fileDescriptor_ccb38f8335e0f45b, []int{7}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -267,9 +559,42 @@ func (m *Params) GetMintingRewardsDistributionStartEpoch() int64 {
 	return 0
 }
 
+func (m *Params) GetDistributionStreams() []DistributionStream {
+	if m != nil {
+		return m.DistributionStreams
+	}
+	return nil
+}
+
+func (m *Params) GetSupplyCurve() SupplyCurve {
+	if m != nil {
+		return m.SupplyCurve
+	}
+	return Geometric
+}
+
+func (m *Params) GetProvisionsSchedule() []ProvisionsScheduleEntry {
+	if m != nil {
+		return m.ProvisionsSchedule
+	}
+	return nil
+}
+
+func (m *Params) GetStakingRatioCurve() StakingRatioCurve {
+	if m != nil {
+		return m.StakingRatioCurve
+	}
+	return StakingRatioCurve{}
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.mint.v1beta1.SupplyCurve", SupplyCurve_name, SupplyCurve_value)
 	proto.RegisterType((*Minter)(nil), "osmosis.mint.v1beta1.Minter")
 	proto.RegisterType((*WeightedAddress)(nil), "osmosis.mint.v1beta1.WeightedAddress")
+	proto.RegisterType((*DistributionStream)(nil), "osmosis.mint.v1beta1.DistributionStream")
+	proto.RegisterType((*DistributionStreamRecord)(nil), "osmosis.mint.v1beta1.DistributionStreamRecord")
+	proto.RegisterType((*ProvisionsScheduleEntry)(nil), "osmosis.mint.v1beta1.ProvisionsScheduleEntry")
+	proto.RegisterType((*StakingRatioCurve)(nil), "osmosis.mint.v1beta1.StakingRatioCurve")
 	proto.RegisterType((*DistributionProportions)(nil), "osmosis.mint.v1beta1.DistributionProportions")
 	proto.RegisterType((*Params)(nil), "osmosis.mint.v1beta1.Params")
 }
@@ -277,56 +602,92 @@ func init() {
 func init() { proto.RegisterFile("osmosis/mint/v1beta1/mint.proto", fileDescriptor_ccb38f8335e0f45b) }
 
 var fileDescriptor_ccb38f8335e0f45b = []byte{
-	// 774 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0x1b, 0x47,
-	0x18, 0xf5, 0xd6, 0xd4, 0x94, 0x41, 0x60, 0xba, 0xa2, 0x78, 0x0b, 0xaa, 0x17, 0x56, 0x20, 0xb9,
-	0x52, 0xf1, 0x16, 0x68, 0x0f, 0x45, 0xfd, 0xa1, 0x5a, 0x2e, 0x12, 0x15, 0x51, 0xac, 0xc9, 0x21,
-	0x52, 0x2e, 0xab, 0xf5, 0xee, 0x78, 0x3d, 0xc2, 0xbb, 0xb3, 0x9a, 0x19, 0x9b, 0xf8, 0x96, 0x7b,
-	0x14, 0x29, 0xc7, 0x1c, 0x93, 0x43, 0xfe, 0x17, 0x8e, 0x1c, 0xa3, 0x1c, 0xac, 0x08, 0x4e, 0xb9,
-	0xfa, 0x2f, 0x88, 0xe6, 0xc7, 0xda, 0xb0, 0x60, 0xc9, 0xca, 0x6d, 0xe7, 0x7d, 0x6f, 0xde, 0xfb,
-	0xfc, 0xcd, 0xbc, 0x31, 0xb0, 0x09, 0x8b, 0x09, 0xc3, 0xcc, 0x8d, 0x71, 0xc2, 0xdd, 0xc1, 0x41,
-	0x1b, 0x71, 0xff, 0x40, 0x2e, 0xea, 0x29, 0x25, 0x9c, 0x98, 0xeb, 0x9a, 0x50, 0x97, 0x98, 0x26,
-	0x6c, 0xae, 0x47, 0x24, 0x22, 0x92, 0xe0, 0x8a, 0x2f, 0xc5, 0xdd, 0xb4, 0x23, 0x42, 0xa2, 0x1e,
-	0x72, 0xe5, 0xaa, 0xdd, 0xef, 0xb8, 0x1c, 0xc7, 0x88, 0x71, 0x3f, 0x4e, 0x35, 0xe1, 0xc7, 0x3c,
-	0xc1, 0x4f, 0x86, 0xba, 0x54, 0xcd, 0x97, 0xc2, 0x3e, 0xf5, 0x39, 0x26, 0x89, 0xaa, 0x3b, 0x0c,
-	0x94, 0x1e, 0xe1, 0x84, 0x23, 0x6a, 0x62, 0xb0, 0x86, 0x52, 0x12, 0x74, 0xbd, 0x94, 0x92, 0x01,
-	0x66, 0x98, 0x24, 0xcc, 0x32, 0xb6, 0x8d, 0xda, 0x52, 0xe3, 0xef, 0xcb, 0x91, 0x5d, 0xf8, 0x38,
-	0xb2, 0xb7, 0x02, 0xd9, 0x34, 0x0b, 0xcf, 0xeb, 0x98, 0xb8, 0xb1, 0xcf, 0xbb, 0xf5, 0x33, 0x14,
-	0xf9, 0xc1, 0xb0, 0x89, 0x82, 0xf1, 0xc8, 0xae, 0x0c, 0xfd, 0xb8, 0x77, 0xec, 0xe4, 0x45, 0x1c,
-	0x58, 0x96, 0x50, 0x6b, 0x8a, 0xbc, 0x32, 0x40, 0xf9, 0x29, 0xc2, 0x51, 0x97, 0xa3, 0xf0, 0xdf,
-	0x30, 0xa4, 0x88, 0x31, 0xf3, 0x17, 0xb0, 0xe8, 0xab, 0x4f, 0xed, 0x6a, 0x8e, 0x47, 0xf6, 0xaa,
-	0x92, 0xd4, 0x05, 0x07, 0x66, 0x14, 0xf3, 0x0c, 0x94, 0x2e, 0xa4, 0x80, 0xf5, 0x8d, 0x24, 0xff,
-	0x36, 0x5f, 0x8b, 0x2b, 0x4a, 0x4f, 0x6d, 0x75, 0xa0, 0xd6, 0x70, 0xde, 0x17, 0x41, 0xa5, 0x89,
-	0x19, 0xa7, 0xb8, 0xdd, 0x17, 0xb3, 0x69, 0x51, 0x92, 0x12, 0x2a, 0xbe, 0x98, 0xf9, 0x18, 0x2c,
-	0x32, 0xee, 0x9f, 0xe3, 0x24, 0xd2, 0x7d, 0xfd, 0x3e, 0x9f, 0x95, 0x6e, 0x5d, 0xef, 0x75, 0x60,
-	0xa6, 0x62, 0x76, 0x40, 0x39, 0x25, 0xa4, 0xe7, 0xe1, 0x24, 0x40, 0x09, 0xc7, 0x03, 0xc4, 0xf4,
-	0x6f, 0xf8, 0x6b, 0x3e, 0xe1, 0x0d, 0x25, 0x9c, 0xd3, 0x70, 0xe0, 0xaa, 0x40, 0x4e, 0x27, 0x80,
-	0xd9, 0x03, 0xdf, 0x87, 0x68, 0x80, 0x7a, 0x24, 0x45, 0xd4, 0xa3, 0xe8, 0xc2, 0xa7, 0x21, 0xb3,
-	0x8a, 0xd2, 0xe9, 0x9f, 0xf9, 0x9c, 0x2c, 0xe5, 0x74, 0x4f, 0xc5, 0x81, 0x6b, 0x13, 0x0c, 0x2a,
-	0xc8, 0x0c, 0xc0, 0x6a, 0x40, 0xe2, 0xb8, 0x9f, 0x60, 0x3e, 0xf4, 0x44, 0x27, 0xd6, 0x82, 0xb4,
-	0xfa, 0x73, 0x3e, 0xab, 0x1f, 0x94, 0xd5, 0x5d, 0x09, 0x07, 0xae, 0x4c, 0x80, 0x96, 0x58, 0x7f,
-	0x2e, 0x81, 0x52, 0xcb, 0xa7, 0x7e, 0xcc, 0xcc, 0x9f, 0x00, 0x10, 0xc9, 0xf1, 0x42, 0x94, 0x90,
-	0x58, 0x9d, 0x0c, 0x5c, 0x12, 0x48, 0x53, 0x00, 0xe6, 0x0b, 0x03, 0x58, 0x11, 0x4a, 0x10, 0xc3,
-	0xcc, 0xbb, 0x77, 0xab, 0xd5, 0xb8, 0x4f, 0xe6, 0xeb, 0xcc, 0x56, 0x9d, 0xcd, 0x12, 0x73, 0xe0,
-	0x86, 0x2e, 0xfd, 0x77, 0xf7, 0x92, 0x9b, 0x27, 0x59, 0x9e, 0x70, 0x28, 0x8e, 0xa4, 0x83, 0x11,
-	0xd5, 0xe3, 0xdf, 0xca, 0x87, 0x65, 0xca, 0xc8, 0xc2, 0x72, 0x3a, 0x41, 0xcc, 0x36, 0xd8, 0xa4,
-	0x28, 0xec, 0x07, 0xe2, 0x3a, 0x7a, 0x29, 0xa2, 0x98, 0x84, 0x1e, 0x4e, 0x54, 0x23, 0x4c, 0x4e,
-	0xb9, 0xd8, 0xd8, 0x1b, 0x8f, 0xec, 0x1d, 0xa5, 0x38, 0x9b, 0xeb, 0xc0, 0xca, 0xa4, 0xd8, 0x92,
-	0xb5, 0xd3, 0x44, 0x36, 0xcd, 0x44, 0xf6, 0xa7, 0xfb, 0x3a, 0x7e, 0xc0, 0x09, 0xb5, 0xbe, 0xfd,
-	0x8a, 0xec, 0xe7, 0x45, 0x1c, 0x58, 0x9e, 0x40, 0x27, 0x12, 0x31, 0x13, 0x60, 0x85, 0xb7, 0xa2,
-	0x26, 0x46, 0x99, 0x65, 0xcd, 0x2a, 0x6d, 0x1b, 0xb5, 0xe5, 0xc3, 0xfd, 0xfa, 0x43, 0x6f, 0x63,
-	0x7d, 0x46, 0x40, 0x1b, 0x0b, 0xa2, 0x43, 0x58, 0x09, 0x67, 0xe4, 0xf7, 0x9d, 0x01, 0x76, 0x2f,
-	0xf4, 0x5b, 0xe3, 0xdd, 0xbb, 0xca, 0x1e, 0x45, 0x01, 0xc2, 0x03, 0x44, 0x99, 0xb5, 0xb8, 0x5d,
-	0xac, 0x2d, 0x1f, 0xee, 0x3d, 0x6c, 0x9e, 0x7b, 0xad, 0x1a, 0x3f, 0x0b, 0xd3, 0xe9, 0xd0, 0x67,
-	0xeb, 0x3a, 0x70, 0x27, 0x73, 0x6f, 0xe6, 0x32, 0x03, 0x33, 0x6b, 0xf3, 0xa5, 0x01, 0x6a, 0xc2,
-	0x0e, 0x27, 0xd1, 0x44, 0xe0, 0xce, 0x90, 0x18, 0xf7, 0x29, 0x57, 0xc7, 0x68, 0x7d, 0x27, 0x4f,
-	0xfc, 0x68, 0x3c, 0xb2, 0x5d, 0x65, 0x3e, 0xef, 0x4e, 0x07, 0xee, 0x6a, 0xaa, 0x6e, 0xe0, 0xf6,
-	0x44, 0x9f, 0x08, 0x9e, 0xbc, 0x0d, 0xc7, 0x0b, 0x6f, 0xde, 0xda, 0x85, 0xc6, 0xff, 0x97, 0xd7,
-	0x55, 0xe3, 0xea, 0xba, 0x6a, 0x7c, 0xba, 0xae, 0x1a, 0xaf, 0x6f, 0xaa, 0x85, 0xab, 0x9b, 0x6a,
-	0xe1, 0xc3, 0x4d, 0xb5, 0xf0, 0xec, 0xd7, 0x08, 0xf3, 0x6e, 0xbf, 0x5d, 0x0f, 0x48, 0xec, 0xea,
-	0x61, 0xed, 0xf7, 0xfc, 0x36, 0xcb, 0x16, 0xee, 0xe0, 0xf0, 0x0f, 0xf7, 0xb9, 0xfa, 0xe7, 0xe3,
-	0xc3, 0x14, 0xb1, 0x76, 0x49, 0xfe, 0xd7, 0x1c, 0x7d, 0x09, 0x00, 0x00, 0xff, 0xff, 0x11, 0xa3,
-	0x09, 0xe4, 0x16, 0x07, 0x00, 0x00,
+	// 1359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xd6, 0x69, 0xd2, 0x8c, 0xf3, 0xe1, 0x4c, 0xd2, 0xc6, 0x38, 0xd4, 0x9b, 0x4c, 0x5b,
+	0x08, 0x88, 0xda, 0x34, 0x85, 0x43, 0xcb, 0x97, 0x70, 0xd3, 0xa0, 0xa0, 0x56, 0x58, 0x13, 0x10,
+	0xa5, 0x12, 0x5a, 0xc6, 0xbb, 0x63, 0x67, 0x54, 0xef, 0xcc, 0x6a, 0x66, 0xed, 0xc4, 0xe2, 0x82,
+	0x38, 0x21, 0x21, 0xa4, 0x9e, 0x10, 0x47, 0x10, 0xe2, 0xc6, 0xbf, 0xc0, 0xbd, 0x17, 0xa4, 0x1e,
+	0x11, 0x07, 0x83, 0xda, 0x7f, 0x00, 0xf9, 0x2f, 0x40, 0x3b, 0x33, 0xfe, 0xc8, 0x26, 0x56, 0x5d,
+	0x21, 0x6e, 0x3b, 0xef, 0xe3, 0xf7, 0xde, 0xcc, 0x9b, 0xdf, 0x9b, 0xb7, 0xc0, 0x15, 0x2a, 0x14,
+	0x8a, 0xa9, 0x72, 0xc8, 0x78, 0x5c, 0x6e, 0x5f, 0xab, 0xd1, 0x98, 0x5c, 0xd3, 0x8b, 0x52, 0x24,
+	0x45, 0x2c, 0xe0, 0xaa, 0x35, 0x28, 0x69, 0x99, 0x35, 0x28, 0xac, 0x36, 0x44, 0x43, 0x68, 0x83,
+	0x72, 0xf2, 0x65, 0x6c, 0x0b, 0x6e, 0x43, 0x88, 0x46, 0x93, 0x96, 0xf5, 0xaa, 0xd6, 0xaa, 0x97,
+	0x63, 0x16, 0x52, 0x15, 0x93, 0x30, 0xb2, 0x06, 0x2f, 0xa4, 0x0d, 0x08, 0xef, 0x58, 0x55, 0x31,
+	0xad, 0x0a, 0x5a, 0x92, 0xc4, 0x4c, 0x70, 0xa3, 0x47, 0x0a, 0xcc, 0xdc, 0x65, 0x3c, 0xa6, 0x12,
+	0x32, 0x90, 0xa3, 0x91, 0xf0, 0x0f, 0xbc, 0x48, 0x8a, 0x36, 0x53, 0x4c, 0x70, 0x95, 0x77, 0x36,
+	0x9c, 0xad, 0xb9, 0xca, 0xbb, 0x8f, 0xba, 0xee, 0xd4, 0x9f, 0x5d, 0x77, 0xdd, 0xd7, 0x49, 0xab,
+	0xe0, 0x41, 0x89, 0x89, 0x72, 0x48, 0xe2, 0x83, 0xd2, 0x1d, 0xda, 0x20, 0x7e, 0x67, 0x87, 0xfa,
+	0xbd, 0xae, 0xbb, 0xd6, 0x21, 0x61, 0xf3, 0x26, 0x4a, 0x83, 0x20, 0xbc, 0xa4, 0x45, 0xd5, 0xa1,
+	0xe4, 0x3b, 0x07, 0x2c, 0x7d, 0x4a, 0x59, 0xe3, 0x20, 0xa6, 0xc1, 0xfb, 0x41, 0x20, 0xa9, 0x52,
+	0xf0, 0x35, 0x30, 0x4b, 0xcc, 0xa7, 0x8d, 0x0a, 0x7b, 0x5d, 0x77, 0xd1, 0x40, 0x5a, 0x05, 0xc2,
+	0x7d, 0x13, 0x78, 0x07, 0xcc, 0x1c, 0x6a, 0x80, 0xfc, 0x19, 0x6d, 0xfc, 0xc6, 0x64, 0x29, 0x2e,
+	0x18, 0x3c, 0xe3, 0x8a, 0xb0, 0xc5, 0x40, 0xbf, 0x65, 0x00, 0xdc, 0x61, 0x2a, 0x96, 0xac, 0xd6,
+	0x4a, 0xce, 0x66, 0x3f, 0x96, 0x94, 0x84, 0xf0, 0x12, 0x98, 0xe6, 0x24, 0xa4, 0x36, 0x9f, 0xa5,
+	0x5e, 0xd7, 0xcd, 0x1a, 0xff, 0x44, 0x8a, 0xb0, 0x56, 0xc2, 0x6d, 0x30, 0x27, 0xa9, 0xcf, 0x22,
+	0x46, 0x79, 0x3f, 0x99, 0xd5, 0x5e, 0xd7, 0xcd, 0x19, 0xcb, 0x81, 0x0a, 0xe1, 0xa1, 0x19, 0xdc,
+	0x05, 0x33, 0x24, 0x14, 0x2d, 0x1e, 0xe7, 0x33, 0xda, 0xa1, 0x64, 0xb3, 0x3f, 0x7f, 0x32, 0xfb,
+	0x3d, 0x1e, 0x0f, 0xf3, 0x36, 0x4e, 0x08, 0x5b, 0x6f, 0x78, 0x0f, 0x00, 0x15, 0x13, 0x19, 0x7b,
+	0xc9, 0x85, 0xc8, 0x4f, 0x6f, 0x38, 0x5b, 0xd9, 0xed, 0x42, 0xc9, 0x54, 0xbc, 0xd4, 0xaf, 0x78,
+	0xe9, 0xe3, 0xfe, 0x6d, 0xa9, 0x5c, 0x4c, 0xe2, 0xf4, 0xba, 0xee, 0xb2, 0x81, 0x1b, 0xfa, 0xa2,
+	0x87, 0x7f, 0xb9, 0x0e, 0x9e, 0xd3, 0x82, 0xc4, 0x1c, 0x62, 0x70, 0x8e, 0xf2, 0xc0, 0xe0, 0x9e,
+	0x7d, 0x26, 0xee, 0xba, 0xc5, 0x5d, 0xb2, 0x37, 0x80, 0x07, 0x23, 0xa8, 0xb3, 0x94, 0x07, 0x1a,
+	0xf3, 0x1e, 0x00, 0x7e, 0x93, 0xd5, 0xeb, 0x06, 0x75, 0xe6, 0x79, 0xb3, 0x1d, 0xfa, 0xda, 0x6c,
+	0xb5, 0x20, 0x31, 0x47, 0xdf, 0x3b, 0x20, 0x7f, 0xb2, 0x7e, 0x98, 0xfa, 0x42, 0x06, 0x93, 0x55,
+	0xf1, 0x13, 0x90, 0x0d, 0xfa, 0x00, 0x34, 0xb0, 0x75, 0xbc, 0xfe, 0xac, 0xb2, 0x40, 0x03, 0x34,
+	0xe2, 0x89, 0xf0, 0x28, 0x0e, 0xfa, 0xd5, 0x01, 0x6b, 0xc3, 0x7b, 0xbf, 0xef, 0x1f, 0xd0, 0xa0,
+	0xd5, 0xa4, 0xb7, 0x79, 0x2c, 0x3b, 0xf0, 0x25, 0x70, 0x56, 0xf3, 0x42, 0x27, 0x96, 0xa9, 0xe4,
+	0x7a, 0x5d, 0x77, 0x7e, 0x84, 0x41, 0x08, 0x1b, 0xf5, 0xa9, 0xbc, 0x3c, 0xf3, 0xff, 0xf0, 0xf2,
+	0x9f, 0x0c, 0x58, 0xde, 0x8f, 0xc9, 0x03, 0xc6, 0x1b, 0x38, 0x69, 0x12, 0xb7, 0x5a, 0xb2, 0x4d,
+	0xe1, 0x7d, 0x90, 0x6d, 0x08, 0xd2, 0xf4, 0x6a, 0x82, 0x07, 0x34, 0xb0, 0xe7, 0x78, 0x63, 0xb2,
+	0xd8, 0xf6, 0x84, 0x46, 0xfc, 0x11, 0x06, 0xc9, 0xaa, 0xa2, 0x17, 0xf0, 0x10, 0x9c, 0x67, 0xbc,
+	0xde, 0xd4, 0x1d, 0xc9, 0x93, 0x24, 0xa6, 0x9e, 0x7f, 0x40, 0x78, 0x83, 0xda, 0x1d, 0xde, 0x9a,
+	0x2c, 0xca, 0x8b, 0x26, 0xca, 0xa9, 0x48, 0x08, 0xaf, 0x0c, 0xe4, 0x98, 0xc4, 0xf4, 0x96, 0x96,
+	0xc2, 0x2f, 0xc0, 0xc2, 0xd0, 0x3c, 0x64, 0xdc, 0x32, 0xf1, 0xad, 0xc9, 0x02, 0xae, 0xa6, 0x03,
+	0x86, 0x8c, 0x23, 0x3c, 0x3f, 0x58, 0xdf, 0x65, 0x3c, 0x15, 0x81, 0x1c, 0xe5, 0xa7, 0xff, 0x5b,
+	0x04, 0x72, 0x74, 0x2c, 0x02, 0x39, 0x82, 0x15, 0x60, 0x2a, 0xa8, 0xbc, 0x88, 0x4a, 0xaf, 0x43,
+	0x89, 0xd4, 0x5c, 0xcd, 0x54, 0x0a, 0xbd, 0xae, 0x7b, 0x61, 0xa4, 0xea, 0x43, 0x03, 0x84, 0x17,
+	0x8c, 0xa4, 0x4a, 0xe5, 0x67, 0xc9, 0xfa, 0x97, 0x0c, 0x58, 0x1b, 0xa5, 0x4e, 0x55, 0x8a, 0x48,
+	0xc8, 0xe4, 0x4b, 0xc1, 0x8f, 0xc0, 0xac, 0x32, 0xb7, 0xc1, 0x16, 0xfd, 0xcd, 0xc9, 0x72, 0x5f,
+	0x1c, 0xb4, 0x97, 0xc4, 0x17, 0xe1, 0x3e, 0x0a, 0xac, 0x83, 0xa5, 0x48, 0x88, 0xa6, 0xc7, 0xb8,
+	0x4f, 0x79, 0xcc, 0xda, 0xb4, 0x7f, 0x93, 0xdf, 0x99, 0x0c, 0xd8, 0xee, 0x29, 0x85, 0x81, 0xf0,
+	0x62, 0x22, 0xd9, 0x1b, 0x08, 0x60, 0x13, 0x2c, 0x07, 0xb4, 0x4d, 0x9b, 0x22, 0xd9, 0xb6, 0xa4,
+	0x87, 0x44, 0x06, 0xca, 0x16, 0xf8, 0xbd, 0xc9, 0x22, 0xe5, 0x2d, 0xb3, 0xd3, 0x28, 0x08, 0xe7,
+	0x06, 0x32, 0x6c, 0x44, 0xd0, 0x07, 0x8b, 0xbe, 0x08, 0xc3, 0x16, 0x67, 0x71, 0xc7, 0x4b, 0x32,
+	0xb1, 0x95, 0x7e, 0x7b, 0xb2, 0x50, 0xe7, 0x6d, 0x7b, 0x3b, 0x06, 0x81, 0xf0, 0xc2, 0x40, 0x50,
+	0x4d, 0xd6, 0xbf, 0x03, 0x30, 0x53, 0x25, 0x92, 0x84, 0x0a, 0x5e, 0x04, 0x20, 0x19, 0x1a, 0xbc,
+	0x80, 0x72, 0x11, 0x9a, 0xca, 0xe0, 0xb9, 0x44, 0xb2, 0x93, 0x08, 0xe0, 0x57, 0x0e, 0xc8, 0x37,
+	0x28, 0xa7, 0x8a, 0x29, 0x6f, 0x4c, 0xe3, 0xd8, 0x9d, 0x2c, 0x33, 0xd7, 0x92, 0x77, 0x0c, 0x18,
+	0xc2, 0x17, 0xac, 0xea, 0xf6, 0xf1, 0x3e, 0x02, 0x77, 0xfb, 0x2d, 0x8b, 0x05, 0x49, 0x49, 0xea,
+	0x8c, 0x4a, 0x7b, 0xfc, 0xeb, 0xe9, 0x7e, 0x34, 0xb4, 0xe8, 0xf7, 0xa3, 0xbd, 0x81, 0x04, 0xd6,
+	0x40, 0x41, 0xd2, 0xa0, 0xe5, 0x6b, 0x02, 0x44, 0x54, 0x32, 0x11, 0x78, 0x8c, 0x9b, 0x44, 0x94,
+	0x3e, 0xe5, 0x4c, 0xe5, 0x4a, 0xaf, 0xeb, 0x6e, 0xf6, 0x1f, 0xdb, 0x71, 0xb6, 0x08, 0xaf, 0x0d,
+	0x94, 0x55, 0xad, 0xdb, 0xe3, 0x3a, 0x69, 0x95, 0xb4, 0xd7, 0xa1, 0x5f, 0x9d, 0xf8, 0xb1, 0x30,
+	0x2c, 0x7a, 0xde, 0xf6, 0x9a, 0x06, 0x41, 0x78, 0x69, 0x20, 0xda, 0xd5, 0x12, 0xc8, 0x41, 0x3e,
+	0x18, 0xa1, 0x9a, 0x17, 0x0d, 0xb9, 0x66, 0x9f, 0xc3, 0xab, 0xa5, 0xd3, 0xc6, 0xc2, 0xd2, 0x18,
+	0x82, 0x56, 0xa6, 0x93, 0x0c, 0xf1, 0x5a, 0x30, 0x86, 0xbf, 0x3f, 0x39, 0xe0, 0xf2, 0xa1, 0x1d,
+	0xb3, 0xbc, 0x13, 0x57, 0xd9, 0x93, 0xd4, 0xa7, 0xac, 0x4d, 0xa5, 0xca, 0xcf, 0x6e, 0x64, 0xb6,
+	0xb2, 0xdb, 0x57, 0x4e, 0x0f, 0x9e, 0x1a, 0xd4, 0x2a, 0xaf, 0xd8, 0x67, 0x79, 0x73, 0x0c, 0x45,
+	0x06, 0xb8, 0x08, 0x6f, 0xf6, 0xa3, 0xef, 0xa4, 0x38, 0x83, 0xfb, 0xa1, 0xe1, 0xb7, 0x0e, 0xd8,
+	0x4a, 0xc2, 0x31, 0xde, 0x18, 0x00, 0x1c, 0x3b, 0x24, 0x33, 0xa4, 0x98, 0x97, 0xf2, 0x9c, 0xae,
+	0xf8, 0xf5, 0x5e, 0xd7, 0x2d, 0x9b, 0xe0, 0x93, 0x7a, 0x22, 0x7c, 0xd9, 0x9a, 0xda, 0x04, 0x8e,
+	0x4f, 0x0b, 0x44, 0xc6, 0xfa, 0x36, 0x24, 0xdc, 0x59, 0x4d, 0x61, 0x24, 0x93, 0x84, 0xca, 0xcf,
+	0xe9, 0x13, 0xda, 0x7a, 0x76, 0x79, 0xcc, 0xe8, 0x51, 0xb9, 0x64, 0x0f, 0x69, 0x3d, 0x35, 0x21,
+	0x8c, 0x60, 0x22, 0xbc, 0x12, 0x9c, 0x70, 0x54, 0xf0, 0x73, 0x30, 0xaf, 0x5a, 0x51, 0xd4, 0xec,
+	0x78, 0x7e, 0xf2, 0xfa, 0xe6, 0xc1, 0x86, 0xb3, 0xb5, 0xb8, 0xbd, 0x79, 0x7a, 0xe4, 0x7d, 0x6d,
+	0xa9, 0x9f, 0xe9, 0xca, 0x5a, 0xaf, 0xeb, 0xae, 0x98, 0x70, 0xa3, 0x00, 0x08, 0x67, 0xd5, 0xd0,
+	0x0a, 0x7e, 0xed, 0x80, 0x95, 0x21, 0x85, 0x3d, 0x65, 0x47, 0x92, 0x7c, 0x76, 0x23, 0x33, 0xfe,
+	0xfe, 0x8d, 0x19, 0x61, 0x2a, 0xc8, 0xee, 0xb2, 0x60, 0xfb, 0xf2, 0x49, 0x5c, 0x84, 0x61, 0x74,
+	0xc2, 0x19, 0x7e, 0x09, 0x56, 0xec, 0x93, 0xe0, 0xe9, 0x9f, 0x11, 0xbb, 0xd5, 0x79, 0xcd, 0x81,
+	0x97, 0xc7, 0x6c, 0x35, 0x3d, 0x97, 0xa4, 0xa3, 0x9f, 0x82, 0x88, 0xf0, 0xb2, 0x4a, 0xbb, 0xdd,
+	0x9c, 0xfe, 0xe1, 0x47, 0x77, 0xea, 0xd5, 0x3d, 0x90, 0x1d, 0x39, 0x3c, 0xb8, 0x00, 0xe6, 0x3e,
+	0xa0, 0x22, 0xa4, 0xb1, 0x64, 0x7e, 0x6e, 0x0a, 0xae, 0x80, 0xa5, 0x2a, 0xa3, 0x3e, 0x3d, 0x64,
+	0x8a, 0xde, 0x61, 0x9c, 0x12, 0x99, 0x73, 0x60, 0x0e, 0xcc, 0x8f, 0x26, 0x91, 0x3b, 0x53, 0x98,
+	0xfe, 0xe6, 0xe7, 0xe2, 0x54, 0xe5, 0xc3, 0x47, 0x4f, 0x8a, 0xce, 0xe3, 0x27, 0x45, 0xe7, 0xef,
+	0x27, 0x45, 0xe7, 0xe1, 0xd3, 0xe2, 0xd4, 0xe3, 0xa7, 0xc5, 0xa9, 0x3f, 0x9e, 0x16, 0xa7, 0xee,
+	0xbf, 0xde, 0x60, 0xf1, 0x41, 0xab, 0x56, 0xf2, 0x45, 0x58, 0xb6, 0x9b, 0xba, 0xda, 0x24, 0x35,
+	0xd5, 0x5f, 0x94, 0xdb, 0xdb, 0x37, 0xca, 0x47, 0xe6, 0x1f, 0x31, 0xee, 0x44, 0x54, 0xd5, 0x66,
+	0xf4, 0x1c, 0x7c, 0xfd, 0xdf, 0x01, 0x00, 0x2b, 0x64, 0x0b, 0xd4, 0x40, 0x0e, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -402,7 +763,7 @@ func (m *WeightedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
+func (m *DistributionStream) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -412,60 +773,146 @@ func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
+func (m *DistributionStream) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DistributionStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.CommunityPool.Size()
-		i -= size
-		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CliffTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CliffTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
 	}
+	i -= n2
+	i = encodeVarintMint(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMint(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x22
 	{
-		size := m.DeveloperRewards.Size()
+		size := m.Amount.Size()
 		i -= size
-		if _, err := m.DeveloperRewards.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintMint(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionStreamRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionStreamRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionStreamRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.PoolIncentives.Size()
+		size := m.Distributed.Size()
 		i -= size
-		if _, err := m.PoolIncentives.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Distributed.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintMint(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProvisionsScheduleEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvisionsScheduleEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvisionsScheduleEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.Staking.Size()
+		size := m.EpochProvisions.Size()
 		i -= size
-		if _, err := m.Staking.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.EpochProvisions.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintMint(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *StakingRatioCurve) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -475,45 +922,219 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *StakingRatioCurve) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StakingRatioCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MintingRewardsDistributionStartEpoch != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.MintingRewardsDistributionStartEpoch))
+	if m.EpochsPerYear != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EpochsPerYear))
 		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.WeightedDeveloperRewardsReceivers) > 0 {
-		for iNdEx := len(m.WeightedDeveloperRewardsReceivers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WeightedDeveloperRewardsReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMint(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+		dAtA[i] = 0x28
 	}
 	{
-		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintMint(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
+	dAtA[i] = 0x22
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InflationRateChange.Size()
+		i -= size
+		if _, err := m.InflationRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DeveloperRewards.Size()
+		i -= size
+		if _, err := m.DeveloperRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PoolIncentives.Size()
+		i -= size
+		if _, err := m.PoolIncentives.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Staking.Size()
+		i -= size
+		if _, err := m.Staking.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StakingRatioCurve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.ProvisionsSchedule) > 0 {
+		for iNdEx := len(m.ProvisionsSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProvisionsSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.SupplyCurve != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.SupplyCurve))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.DistributionStreams) > 0 {
+		for iNdEx := len(m.DistributionStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MintingRewardsDistributionStartEpoch != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.MintingRewardsDistributionStartEpoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.WeightedDeveloperRewardsReceivers) > 0 {
+		for iNdEx := len(m.WeightedDeveloperRewardsReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedDeveloperRewardsReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.ReductionFactor.Size()
 		i -= size
@@ -593,6 +1214,80 @@ func (m *WeightedAddress) Size() (n int) {
 	return n
 }
 
+func (m *DistributionStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CliffTime)
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DistributionStreamRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Distributed.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *ProvisionsScheduleEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovMint(uint64(m.Epoch))
+	}
+	l = m.EpochProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *StakingRatioCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.EpochsPerYear != 0 {
+		n += 1 + sovMint(uint64(m.EpochsPerYear))
+	}
+	return n
+}
+
 func (m *DistributionProportions) Size() (n int) {
 	if m == nil {
 		return 0
@@ -639,19 +1334,599 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovMint(uint64(l))
 		}
 	}
-	if m.MintingRewardsDistributionStartEpoch != 0 {
-		n += 1 + sovMint(uint64(m.MintingRewardsDistributionStartEpoch))
-	}
-	return n
-}
+	if m.MintingRewardsDistributionStartEpoch != 0 {
+		n += 1 + sovMint(uint64(m.MintingRewardsDistributionStartEpoch))
+	}
+	if len(m.DistributionStreams) > 0 {
+		for _, e := range m.DistributionStreams {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.SupplyCurve != 0 {
+		n += 1 + sovMint(uint64(m.SupplyCurve))
+	}
+	if len(m.ProvisionsSchedule) > 0 {
+		for _, e := range m.ProvisionsSchedule {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.StakingRatioCurve.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionStreamRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionStreamRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionStreamRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *ProvisionsScheduleEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -674,13 +1949,32 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProvisionsScheduleEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProvisionsScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EpochProvisions", wireType)
 			}
@@ -735,7 +2029,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
+func (m *StakingRatioCurve) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -758,15 +2052,15 @@ func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WeightedAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: StakingRatioCurve: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StakingRatioCurve: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -794,11 +2088,13 @@ func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -826,10 +2122,97 @@ func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsPerYear", wireType)
+			}
+			m.EpochsPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsPerYear |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -1303,6 +2686,126 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionStreams = append(m.DistributionStreams, DistributionStream{})
+			if err := m.DistributionStreams[len(m.DistributionStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCurve", wireType)
+			}
+			m.SupplyCurve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupplyCurve |= SupplyCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionsSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProvisionsSchedule = append(m.ProvisionsSchedule, ProvisionsScheduleEntry{})
+			if err := m.ProvisionsSchedule[len(m.ProvisionsSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRatioCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingRatioCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -24,6 +24,10 @@ var (
 	KeyPoolAllocationRatio                  = []byte("PoolAllocationRatio")
 	KeyDeveloperRewardsReceiver             = []byte("DeveloperRewardsReceiver")
 	KeyMintingRewardsDistributionStartEpoch = []byte("MintingRewardsDistributionStartEpoch")
+	KeyDistributionStreams                  = []byte("DistributionStreams")
+	KeySupplyCurve                          = []byte("SupplyCurve")
+	KeyProvisionsSchedule                   = []byte("ProvisionsSchedule")
+	KeyStakingRatioCurve                    = []byte("StakingRatioCurve")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -48,6 +52,10 @@ func NewParams(
 		DistributionProportions:              distrProportions,
 		WeightedDeveloperRewardsReceivers:    weightedDevRewardsReceivers,
 		MintingRewardsDistributionStartEpoch: mintingRewardsDistributionStartEpoch,
+		DistributionStreams:                  []DistributionStream{},
+		SupplyCurve:                          Geometric,
+		ProvisionsSchedule:                   []ProvisionsScheduleEntry{},
+		StakingRatioCurve:                    emptyStakingRatioCurve(),
 	}
 }
 
@@ -67,6 +75,10 @@ func DefaultParams() Params {
 		},
 		WeightedDeveloperRewardsReceivers:    []WeightedAddress{},
 		MintingRewardsDistributionStartEpoch: 0,
+		DistributionStreams:                  []DistributionStream{},
+		SupplyCurve:                          Geometric,
+		ProvisionsSchedule:                   []ProvisionsScheduleEntry{},
+		StakingRatioCurve:                    emptyStakingRatioCurve(),
 	}
 }
 
@@ -97,6 +109,21 @@ func (p Params) Validate() error {
 	if err := validateMintingRewardsDistributionStartEpoch(p.MintingRewardsDistributionStartEpoch); err != nil {
 		return err
 	}
+	if err := validateDistributionStreams(p.DistributionStreams); err != nil {
+		return err
+	}
+	if err := validateSupplyCurve(p.SupplyCurve); err != nil {
+		return err
+	}
+	if err := validateProvisionsSchedule(p.ProvisionsSchedule); err != nil {
+		return err
+	}
+	if err := validateStakingRatioCurve(p.StakingRatioCurve); err != nil {
+		return err
+	}
+	if err := validateSupplyCurveConfig(p); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -118,6 +145,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyPoolAllocationRatio, &p.DistributionProportions, validateDistributionProportions),
 		paramtypes.NewParamSetPair(KeyDeveloperRewardsReceiver, &p.WeightedDeveloperRewardsReceivers, validateWeightedDeveloperRewardsReceivers),
 		paramtypes.NewParamSetPair(KeyMintingRewardsDistributionStartEpoch, &p.MintingRewardsDistributionStartEpoch, validateMintingRewardsDistributionStartEpoch),
+		paramtypes.NewParamSetPair(KeyDistributionStreams, &p.DistributionStreams, validateDistributionStreams),
+		paramtypes.NewParamSetPair(KeySupplyCurve, &p.SupplyCurve, validateSupplyCurve),
+		paramtypes.NewParamSetPair(KeyProvisionsSchedule, &p.ProvisionsSchedule, validateProvisionsSchedule),
+		paramtypes.NewParamSetPair(KeyStakingRatioCurve, &p.StakingRatioCurve, validateStakingRatioCurve),
 	}
 }
 
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// DefaultStakingRatioCurve returns a staking ratio curve with the defaults
+// of the Cosmos SDK mint module, for weekly epochs.
+func DefaultStakingRatioCurve() StakingRatioCurve {
+	return StakingRatioCurve{
+		GoalBonded:          osmomath.NewDecWithPrec(67, 2),
+		InflationRateChange: osmomath.NewDecWithPrec(13, 2),
+		InflationMin:        osmomath.NewDecWithPrec(7, 2),
+		InflationMax:        osmomath.NewDecWithPrec(20, 2),
+		EpochsPerYear:       52,
+	}
+}
+
+// emptyStakingRatioCurve returns the staking ratio curve of the params
+// when the staking ratio supply curve is not used.
+func emptyStakingRatioCurve() StakingRatioCurve {
+	return StakingRatioCurve{
+		GoalBonded:          osmomath.ZeroDec(),
+		InflationRateChange: osmomath.ZeroDec(),
+		InflationMin:        osmomath.ZeroDec(),
+		InflationMax:        osmomath.ZeroDec(),
+	}
+}
+
+// ScheduledEpochProvisions returns the epoch provisions of the piecewise linear schedule at the given epoch.
+// The provisions are interpolated linearly between the entries surrounding the epoch, and are equal to
+// the first (last) entry's provisions before (after) the schedule.
+// The schedule must be non-empty and ordered by epoch.
+func ScheduledEpochProvisions(schedule []ProvisionsScheduleEntry, epoch int64) osmomath.Dec {
+	if epoch <= schedule[0].Epoch {
+		return schedule[0].EpochProvisions
+	}
+
+	for i := 1; i < len(schedule); i++ {
+		prev, next := schedule[i-1], schedule[i]
+		if epoch >= next.Epoch {
+			continue
+		}
+
+		// prev.EpochProvisions + (next.EpochProvisions - prev.EpochProvisions) * (epoch - prev.Epoch) / (next.Epoch - prev.Epoch)
+		elapsed := osmomath.NewDec(epoch - prev.Epoch)
+		span := osmomath.NewDec(next.Epoch - prev.Epoch)
+		return prev.EpochProvisions.Add(next.EpochProvisions.Sub(prev.EpochProvisions).Mul(elapsed).Quo(span))
+	}
+
+	return schedule[len(schedule)-1].EpochProvisions
+}
+
+// NextEpochProvisions returns the epoch provisions following the given ones under the staking ratio curve.
+// The current annual inflation rate is derived from the current epoch provisions and the supply, and
+// is moved by at most inflation_rate_change / epochs_per_year towards inflation_max when the bonded ratio is
+// below goal_bonded, or towards inflation_min when it is above.
+// The returned provisions are the new annual inflation rate applied to the supply, spread over a year of epochs.
+func (c StakingRatioCurve) NextEpochProvisions(epochProvisions osmomath.Dec, bondedTokens, supply osmomath.Int) osmomath.Dec {
+	if !supply.IsPositive() {
+		return osmomath.ZeroDec()
+	}
+
+	epochsPerYear := osmomath.NewDec(c.EpochsPerYear)
+	supplyDec := supply.ToLegacyDec()
+	inflation := epochProvisions.Mul(epochsPerYear).Quo(supplyDec)
+	bondedRatio := bondedTokens.ToLegacyDec().Quo(supplyDec)
+
+	// (1 - bondedRatio / goalBonded) * inflationRateChange / epochsPerYear
+	inflationChange := osmomath.OneDec().Sub(bondedRatio.Quo(c.GoalBonded)).Mul(c.InflationRateChange).Quo(epochsPerYear)
+	inflation = inflation.Add(inflationChange)
+	if inflation.GT(c.InflationMax) {
+		inflation = c.InflationMax
+	}
+	if inflation.LT(c.InflationMin) {
+		inflation = c.InflationMin
+	}
+
+	return inflation.Mul(supplyDec).Quo(epochsPerYear)
+}
+
+// IsEmpty returns true if the staking ratio curve is not set.
+func (c StakingRatioCurve) IsEmpty() bool {
+	isNilOrZero := func(d osmomath.Dec) bool { return d.IsNil() || d.IsZero() }
+	return c.EpochsPerYear == 0 && isNilOrZero(c.GoalBonded) && isNilOrZero(c.InflationRateChange) &&
+		isNilOrZero(c.InflationMin) && isNilOrZero(c.InflationMax)
+}
+
+// Validate performs stateless validation of the staking ratio curve.
+func (c StakingRatioCurve) Validate() error {
+	if c.GoalBonded.IsNil() || !c.GoalBonded.IsPositive() || c.GoalBonded.GT(osmomath.OneDec()) {
+		return fmt.Errorf("goal bonded (%s) must be in (0, 1]", c.GoalBonded)
+	}
+	if c.InflationRateChange.IsNil() || c.InflationRateChange.IsNegative() {
+		return fmt.Errorf("inflation rate change (%s) must be non-negative", c.InflationRateChange)
+	}
+	if c.InflationMin.IsNil() || c.InflationMin.IsNegative() {
+		return fmt.Errorf("inflation min (%s) must be non-negative", c.InflationMin)
+	}
+	if c.InflationMax.IsNil() || c.InflationMax.LT(c.InflationMin) {
+		return fmt.Errorf("inflation max (%s) must be greater than or equal to inflation min (%s)", c.InflationMax, c.InflationMin)
+	}
+	if c.EpochsPerYear <= 0 {
+		return fmt.Errorf("epochs per year must be positive: %d", c.EpochsPerYear)
+	}
+	return nil
+}
+
+func validateSupplyCurve(i interface{}) error {
+	v, ok := i.(SupplyCurve)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := SupplyCurve_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown supply curve: %d", v)
+	}
+
+	return nil
+}
+
+func validateProvisionsSchedule(i interface{}) error {
+	v, ok := i.([]ProvisionsScheduleEntry)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, entry := range v {
+		if entry.Epoch < 0 {
+			return fmt.Errorf("negative epoch at %dth schedule entry", i)
+		}
+		if entry.EpochProvisions.IsNil() || entry.EpochProvisions.IsNegative() {
+			return fmt.Errorf("negative epoch provisions at %dth schedule entry", i)
+		}
+		if i > 0 && entry.Epoch <= v[i-1].Epoch {
+			return fmt.Errorf("schedule entries must be ordered by strictly increasing epoch, got %d after %d", entry.Epoch, v[i-1].Epoch)
+		}
+	}
+
+	return nil
+}
+
+func validateStakingRatioCurve(i interface{}) error {
+	v, ok := i.(StakingRatioCurve)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// the curve is only required when the staking ratio supply curve is selected.
+	if v.IsEmpty() {
+		return nil
+	}
+
+	return v.Validate()
+}
+
+// validateSupplyCurveConfig checks that the selected supply curve is configured.
+func validateSupplyCurveConfig(p Params) error {
+	if p.SupplyCurve == PiecewiseLinear && len(p.ProvisionsSchedule) == 0 {
+		return errors.New("piecewise linear supply curve requires a non-empty provisions schedule")
+	}
+	if p.SupplyCurve == StakingRatio && p.StakingRatioCurve.IsEmpty() {
+		return errors.New("staking ratio supply curve requires the staking ratio curve to be set")
+	}
+	return nil
+}
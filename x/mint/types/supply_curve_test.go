@@ -0,0 +1,200 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/mint/types"
+)
+
+func TestScheduledEpochProvisions(t *testing.T) {
+	schedule := []types.ProvisionsScheduleEntry{
+		{Epoch: 10, EpochProvisions: osmomath.NewDec(1000)},
+		{Epoch: 20, EpochProvisions: osmomath.NewDec(500)},
+		{Epoch: 30, EpochProvisions: osmomath.NewDec(600)},
+	}
+
+	tests := map[string]struct {
+		epoch    int64
+		expected osmomath.Dec
+	}{
+		"before the schedule": {
+			epoch:    5,
+			expected: osmomath.NewDec(1000),
+		},
+		"at the first entry": {
+			epoch:    10,
+			expected: osmomath.NewDec(1000),
+		},
+		"decreasing segment": {
+			epoch:    14,
+			expected: osmomath.NewDec(800),
+		},
+		"at an inner entry": {
+			epoch:    20,
+			expected: osmomath.NewDec(500),
+		},
+		"increasing segment": {
+			epoch:    25,
+			expected: osmomath.NewDec(550),
+		},
+		"after the schedule": {
+			epoch:    100,
+			expected: osmomath.NewDec(600),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.ScheduledEpochProvisions(schedule, tc.epoch))
+		})
+	}
+}
+
+func TestStakingRatioCurveNextEpochProvisions(t *testing.T) {
+	curve := types.StakingRatioCurve{
+		GoalBonded:          osmomath.NewDecWithPrec(5, 1),
+		InflationRateChange: osmomath.NewDecWithPrec(10, 2),
+		InflationMin:        osmomath.NewDecWithPrec(5, 2),
+		InflationMax:        osmomath.NewDecWithPrec(20, 2),
+		EpochsPerYear:       10,
+	}
+	supply := osmomath.NewInt(1_000_000)
+
+	tests := map[string]struct {
+		epochProvisions osmomath.Dec
+		bondedTokens    osmomath.Int
+		expected        osmomath.Dec
+	}{
+		"bonded ratio at the goal keeps the inflation": {
+			// 10% inflation
+			epochProvisions: osmomath.NewDec(10_000),
+			bondedTokens:    osmomath.NewInt(500_000),
+			expected:        osmomath.NewDec(10_000),
+		},
+		"bonded ratio below the goal raises the inflation": {
+			// 10% + (1 - 0.25 / 0.5) * 10% / 10 = 10.5% inflation
+			epochProvisions: osmomath.NewDec(10_000),
+			bondedTokens:    osmomath.NewInt(250_000),
+			expected:        osmomath.NewDec(10_500),
+		},
+		"bonded ratio above the goal lowers the inflation": {
+			// 10% + (1 - 1 / 0.5) * 10% / 10 = 9% inflation
+			epochProvisions: osmomath.NewDec(10_000),
+			bondedTokens:    osmomath.NewInt(1_000_000),
+			expected:        osmomath.NewDec(9_000),
+		},
+		"inflation capped at the max": {
+			epochProvisions: osmomath.NewDec(20_000),
+			bondedTokens:    osmomath.ZeroInt(),
+			expected:        osmomath.NewDec(20_000),
+		},
+		"inflation floored at the min": {
+			// starts from no inflation, e.g. when switching from another curve.
+			epochProvisions: osmomath.ZeroDec(),
+			bondedTokens:    osmomath.NewInt(1_000_000),
+			expected:        osmomath.NewDec(5_000),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, curve.NextEpochProvisions(tc.epochProvisions, tc.bondedTokens, supply))
+		})
+	}
+
+	require.Equal(t, osmomath.ZeroDec(), curve.NextEpochProvisions(osmomath.NewDec(10_000), osmomath.ZeroInt(), osmomath.ZeroInt()))
+}
+
+func TestSupplyCurveParamsValidation(t *testing.T) {
+	tests := map[string]struct {
+		modify    func(*types.Params)
+		expectErr bool
+	}{
+		"default geometric": {
+			modify: func(*types.Params) {},
+		},
+		"piecewise linear": {
+			modify: func(p *types.Params) {
+				p.SupplyCurve = types.PiecewiseLinear
+				p.ProvisionsSchedule = []types.ProvisionsScheduleEntry{{Epoch: 1, EpochProvisions: osmomath.NewDec(100)}}
+			},
+		},
+		"piecewise linear without schedule": {
+			modify: func(p *types.Params) {
+				p.SupplyCurve = types.PiecewiseLinear
+			},
+			expectErr: true,
+		},
+		"schedule not ordered by epoch": {
+			modify: func(p *types.Params) {
+				p.ProvisionsSchedule = []types.ProvisionsScheduleEntry{
+					{Epoch: 2, EpochProvisions: osmomath.NewDec(100)},
+					{Epoch: 2, EpochProvisions: osmomath.NewDec(50)},
+				}
+			},
+			expectErr: true,
+		},
+		"schedule with negative provisions": {
+			modify: func(p *types.Params) {
+				p.ProvisionsSchedule = []types.ProvisionsScheduleEntry{{Epoch: 1, EpochProvisions: osmomath.NewDec(-1)}}
+			},
+			expectErr: true,
+		},
+		"staking ratio": {
+			modify: func(p *types.Params) {
+				p.SupplyCurve = types.StakingRatio
+				p.StakingRatioCurve = types.DefaultStakingRatioCurve()
+			},
+		},
+		"staking ratio without curve": {
+			modify: func(p *types.Params) {
+				p.SupplyCurve = types.StakingRatio
+			},
+			expectErr: true,
+		},
+		"staking ratio curve with max below min": {
+			modify: func(p *types.Params) {
+				p.StakingRatioCurve = types.DefaultStakingRatioCurve()
+				p.StakingRatioCurve.InflationMax = osmomath.NewDecWithPrec(1, 2)
+			},
+			expectErr: true,
+		},
+		"staking ratio curve with goal above one": {
+			modify: func(p *types.Params) {
+				p.StakingRatioCurve = types.DefaultStakingRatioCurve()
+				p.StakingRatioCurve.GoalBonded = osmomath.NewDecWithPrec(11, 1)
+			},
+			expectErr: true,
+		},
+		"staking ratio curve without epochs per year": {
+			modify: func(p *types.Params) {
+				p.StakingRatioCurve = types.DefaultStakingRatioCurve()
+				p.StakingRatioCurve.EpochsPerYear = 0
+			},
+			expectErr: true,
+		},
+		"unknown supply curve": {
+			modify: func(p *types.Params) {
+				p.SupplyCurve = types.SupplyCurve(3)
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modify(&params)
+
+			err := params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package cli_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/suite"
@@ -172,6 +173,7 @@ func TestNewSwapExactAmountOutCmd(t *testing.T) {
 // }
 
 func TestNewSwapExactAmountInCmd(t *testing.T) {
+	swapDeadline := time.Unix(1_700_000_000, 0)
 	desc, _ := cli.NewSwapExactAmountInCmd()
 	tcs := map[string]osmocli.TxCliTestCase[*types.MsgSwapExactAmountIn]{
 		"swap exact amount in": {
@@ -183,10 +185,81 @@ func TestNewSwapExactAmountInCmd(t *testing.T) {
 				TokenOutMinAmount: osmomath.NewIntFromUint64(3),
 			},
 		},
+		"swap exact amount in with swap protection": {
+			Cmd: "10stake 3 --swap-route-pool-ids=1 --swap-route-denoms=node0token --deadline=1700000000 --max-price-impact=0.05 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSwapExactAmountIn{
+				Sender:            testAddresses[0].String(),
+				Routes:            []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "node0token"}},
+				TokenIn:           sdk.NewInt64Coin("stake", 10),
+				TokenOutMinAmount: osmomath.NewIntFromUint64(3),
+				Deadline:          &swapDeadline,
+				MaxPriceImpact:    osmomath.NewDecWithPrec(5, 2),
+			},
+		},
 	}
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
 
+func TestGetCmdBestSplitRouteExactAmountIn(t *testing.T) {
+	desc, _ := cli.GetCmdBestSplitRouteExactAmountIn()
+	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.BestSplitRouteExactAmountInRequest]{
+		"default limits": {
+			Cmd: "1000uosmo uion",
+			ExpectedQuery: &queryproto.BestSplitRouteExactAmountInRequest{
+				TokenIn:       sdk.NewInt64Coin("uosmo", 1000),
+				TokenOutDenom: "uion",
+			},
+		},
+		"custom limits": {
+			Cmd: "1000uosmo uion --max-hops=2 --max-splits=4",
+			ExpectedQuery: &queryproto.BestSplitRouteExactAmountInRequest{
+				TokenIn:       sdk.NewInt64Coin("uosmo", 1000),
+				TokenOutDenom: "uion",
+				MaxHops:       2,
+				MaxSplits:     4,
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdPoolVolumeBuckets(t *testing.T) {
+	desc, _ := cli.GetCmdPoolVolumeBuckets()
+	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.PoolVolumeBucketsRequest]{
+		"all buckets": {
+			Cmd:           "1",
+			ExpectedQuery: &queryproto.PoolVolumeBucketsRequest{PoolId: 1},
+		},
+		"num buckets": {
+			Cmd:           "1 --num-buckets=7",
+			ExpectedQuery: &queryproto.PoolVolumeBucketsRequest{PoolId: 1, NumBuckets: 7},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdDenomPairVolumeBuckets(t *testing.T) {
+	desc, _ := cli.GetCmdDenomPairVolumeBuckets()
+	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.DenomPairVolumeBucketsRequest]{
+		"num buckets": {
+			Cmd:           "uosmo uion --num-buckets=1",
+			ExpectedQuery: &queryproto.DenomPairVolumeBucketsRequest{Denom_0: "uosmo", Denom_1: "uion", NumBuckets: 1},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
+func TestGetCmdTakerFeeDiscount(t *testing.T) {
+	desc, _ := cli.GetCmdTakerFeeDiscount()
+	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.TakerFeeDiscountRequest]{
+		"basic test": {
+			Cmd:           "osmo1ugku28hwyexpljrrmtet05nd6kjlrvr9jz6z00",
+			ExpectedQuery: &queryproto.TakerFeeDiscountRequest{Address: "osmo1ugku28hwyexpljrrmtet05nd6kjlrvr9jz6z00"},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
 func TestGetCmdNumPools(t *testing.T) {
 	desc, _ := cli.GetCmdNumPools()
 	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.NumPoolsRequest]{
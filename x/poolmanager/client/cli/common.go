@@ -4,9 +4,12 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"time"
 
 	flag "github.com/spf13/pflag"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v29/x/poolmanager/types"
 )
 
@@ -71,3 +74,32 @@ func swapAmountOutRoutes(fs *flag.FlagSet) ([]types.SwapAmountOutRoute, error) {
 	}
 	return routes, nil
 }
+
+// swapDeadline parses the optional swap deadline, either a unix timestamp or a sortable time string.
+func swapDeadline(fs *flag.FlagSet) (*time.Time, error) {
+	deadlineStr, err := fs.GetString(FlagDeadline)
+	if err != nil {
+		return nil, err
+	}
+	if deadlineStr == "" {
+		return nil, nil
+	}
+
+	deadline, err := osmocli.ParseUnixTime(deadlineStr, "deadline")
+	if err != nil {
+		return nil, err
+	}
+	return &deadline, nil
+}
+
+// swapMaxPriceImpact parses the optional maximum price impact of a swap.
+func swapMaxPriceImpact(fs *flag.FlagSet) (osmomath.Dec, error) {
+	maxPriceImpactStr, err := fs.GetString(FlagMaxPriceImpact)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	if maxPriceImpactStr == "" {
+		return osmomath.Dec{}, nil
+	}
+	return osmocli.ParseSdkDec(maxPriceImpactStr, "max price impact")
+}
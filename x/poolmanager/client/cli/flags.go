@@ -27,6 +27,16 @@ const (
 	FlagSwapRouteDenoms = "swap-route-denoms"
 	// Will be parsed to string.
 	FlagRoutesFile = "routes-file"
+	// Will be parsed to *time.Time.
+	FlagDeadline = "deadline"
+	// Will be parsed to osmomath.Dec.
+	FlagMaxPriceImpact = "max-price-impact"
+	// Will be parsed to uint64.
+	FlagMaxHops = "max-hops"
+	// Will be parsed to uint64.
+	FlagMaxSplits = "max-splits"
+	// Will be parsed to uint64.
+	FlagNumBuckets = "num-buckets"
 )
 
 type createBalancerPoolInputs struct {
@@ -93,6 +103,29 @@ func FlagSetSwapAmountOutRoutes() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetSwapProtection() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagDeadline, "", "Optional deadline of the swap, as a unix timestamp or a sortable time string. The swap fails if executed after it")
+	fs.String(FlagMaxPriceImpact, "", "Optional maximum price impact of the swap relative to the route spot price, between 0 and 1")
+	return fs
+}
+
+func FlagSetBestSplitRoute() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint64(FlagMaxHops, 0, "Maximum number of pools in a route, 0 for the default")
+	fs.Uint64(FlagMaxSplits, 0, "Maximum number of routes the token in is split across, 0 for the default")
+	return fs
+}
+
+func FlagSetVolumeBuckets() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint64(FlagNumBuckets, 0, "Number of most recent volume buckets to query, 0 for all retained buckets")
+	return fs
+}
+
 func FlagSetCreatePool() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -40,6 +40,10 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetRegisteredAlloyedPoolFromDenom)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetRegisteredAlloyedPoolFromPoolId)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetAllRegisteredAlloyedPools)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdBestSplitRouteExactAmountIn)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPoolVolumeBuckets)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdDenomPairVolumeBuckets)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTakerFeeDiscount)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -123,6 +127,21 @@ func GetCmdListPoolsByDenom() (*osmocli.QueryDescriptor, *queryproto.ListPoolsBy
 	}, &queryproto.ListPoolsByDenomRequest{}
 }
 
+// GetCmdBestSplitRouteExactAmountIn returns the split route with the largest estimated amount out for a swap.
+func GetCmdBestSplitRouteExactAmountIn() (*osmocli.QueryDescriptor, *queryproto.BestSplitRouteExactAmountInRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "best-split-route-exact-amount-in",
+		Short: "Query the split route with the largest estimated amount out when swapping a token in for a token out denom",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} best-split-route-exact-amount-in 1000000uosmo uion --max-hops=3 --max-splits=3`,
+		CustomFlagOverrides: map[string]string{
+			"maxhops":   FlagMaxHops,
+			"maxsplits": FlagMaxSplits,
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetBestSplitRoute()}},
+	}, &queryproto.BestSplitRouteExactAmountInRequest{}
+}
+
 func EstimateSwapExactAmountInParseArgs(args []string, fs *flag.FlagSet) (proto.Message, error) {
 	poolID, err := strconv.Atoi(args[0])
 	if err != nil {
@@ -199,6 +218,44 @@ func GetCmdTotalVolumeForPool() (*osmocli.QueryDescriptor, *queryproto.TotalVolu
 	}, &queryproto.TotalVolumeForPoolRequest{}
 }
 
+// GetCmdPoolVolumeBuckets returns the volume of a pool in the most recent volume buckets.
+func GetCmdPoolVolumeBuckets() (*osmocli.QueryDescriptor, *queryproto.PoolVolumeBucketsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-volume-buckets",
+		Short: "Query the OSMO-denominated volume of a pool in the most recent daily volume buckets",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-volume-buckets 1 --num-buckets=7`,
+		CustomFlagOverrides: map[string]string{
+			"numbuckets": FlagNumBuckets,
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetVolumeBuckets()}},
+	}, &queryproto.PoolVolumeBucketsRequest{}
+}
+
+// GetCmdDenomPairVolumeBuckets returns the volume swapped between two denoms in the most recent volume buckets.
+func GetCmdDenomPairVolumeBuckets() (*osmocli.QueryDescriptor, *queryproto.DenomPairVolumeBucketsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "denom-pair-volume-buckets",
+		Short: "Query the OSMO-denominated volume swapped between two denoms in the most recent daily volume buckets",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} denom-pair-volume-buckets uosmo uatom --num-buckets=7`,
+		CustomFlagOverrides: map[string]string{
+			"numbuckets": FlagNumBuckets,
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetVolumeBuckets()}},
+	}, &queryproto.DenomPairVolumeBucketsRequest{}
+}
+
+// GetCmdTakerFeeDiscount returns the taker fee discount of an address.
+func GetCmdTakerFeeDiscount() (*osmocli.QueryDescriptor, *queryproto.TakerFeeDiscountRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "taker-fee-discount",
+		Short: "Query the taker fee discount an address gets from the OSMO it has staked or locked",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} taker-fee-discount osmo1ugku28hwyexpljrrmtet05nd6kjlrvr9jz6z00`,
+	}, &queryproto.TakerFeeDiscountRequest{}
+}
+
 func GetCmdTradingPairTakerFee() (*osmocli.QueryDescriptor, *queryproto.TradingPairTakerFeeRequest) {
 	return &osmocli.QueryDescriptor{
 		Use:   "trading-pair-taker-fee",
@@ -52,9 +52,14 @@ func NewSwapExactAmountInCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountIn)
 		Short:   "swap exact amount in",
 		Example: "osmosisd tx poolmanager swap-exact-amount-in 2000000uosmo 1 --swap-route-pool-ids 5 --swap-route-denoms uion --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
 		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
-			"Routes": osmocli.FlagOnlyParser(swapAmountInRoutes),
+			"Routes":         osmocli.FlagOnlyParser(swapAmountInRoutes),
+			"Deadline":       osmocli.FlagOnlyParser(swapDeadline),
+			"MaxPriceImpact": osmocli.FlagOnlyParser(swapMaxPriceImpact),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetSwapProtection()},
 		},
-		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
 	}, &types.MsgSwapExactAmountIn{}
 }
 
@@ -66,7 +71,10 @@ func NewSwapExactAmountOutCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountOu
 		Example:          "osmosisd tx poolmanager swap-exact-amount-out 100uion 1000000 --swap-route-pool-ids 1 --swap-route-denoms uosmo --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
 		NumArgs:          2,
 		ParseAndBuildMsg: NewBuildSwapExactAmountOutMsg,
-		Flags:            osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetSwapProtection()},
+		},
 	}, &types.MsgSwapExactAmountOut{}
 }
 
@@ -108,10 +116,13 @@ func NewSplitRouteSwapExactAmountIn() (*osmocli.TxCliDesc, *types.MsgSplitRouteS
 		}
 		`,
 		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
-			"Routes": osmocli.FlagOnlyParser(NewMsgNewSplitRouteSwapExactAmountIn),
+			"Routes":         osmocli.FlagOnlyParser(NewMsgNewSplitRouteSwapExactAmountIn),
+			"Deadline":       osmocli.FlagOnlyParser(swapDeadline),
+			"MaxPriceImpact": osmocli.FlagOnlyParser(swapMaxPriceImpact),
 		},
 		Flags: osmocli.FlagDesc{
 			RequiredFlags: []*flag.FlagSet{FlagSetCreateRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetSwapProtection()},
 		},
 	}, &types.MsgSplitRouteSwapExactAmountIn{}
 }
@@ -154,10 +165,13 @@ func NewSplitRouteSwapExactAmountOut() (*osmocli.TxCliDesc, *types.MsgSplitRoute
 			}
 		`,
 		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
-			"Routes": osmocli.FlagOnlyParser(NewMsgNewSplitRouteSwapExactAmountOut),
+			"Routes":         osmocli.FlagOnlyParser(NewMsgNewSplitRouteSwapExactAmountOut),
+			"Deadline":       osmocli.FlagOnlyParser(swapDeadline),
+			"MaxPriceImpact": osmocli.FlagOnlyParser(swapMaxPriceImpact),
 		},
 		Flags: osmocli.FlagDesc{
 			RequiredFlags: []*flag.FlagSet{FlagSetCreateRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetSwapProtection()},
 		},
 	}, &types.MsgSplitRouteSwapExactAmountOut{}
 }
@@ -236,11 +250,24 @@ func NewBuildSwapExactAmountOutMsg(clientCtx client.Context, args []string, fs *
 	if !ok {
 		return nil, errors.New("invalid token in max amount")
 	}
+
+	deadline, err := swapDeadline(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	maxPriceImpact, err := swapMaxPriceImpact(fs)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.MsgSwapExactAmountOut{
 		Sender:           clientCtx.GetFromAddress().String(),
 		Routes:           routes,
 		TokenInMaxAmount: tokenInMaxAmount,
 		TokenOut:         tokenOut,
+		Deadline:         deadline,
+		MaxPriceImpact:   maxPriceImpact,
 	}, nil
 }
 
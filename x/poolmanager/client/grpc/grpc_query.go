@@ -81,6 +81,16 @@ func (q Querier) TakerFeeShareAgreementFromDenom(grpcCtx context.Context,
 	return q.Q.TakerFeeShareAgreementFromDenom(ctx, *req)
 }
 
+func (q Querier) TakerFeeDiscount(grpcCtx context.Context,
+	req *queryproto.TakerFeeDiscountRequest,
+) (*queryproto.TakerFeeDiscountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TakerFeeDiscount(ctx, *req)
+}
+
 func (q Querier) SpotPrice(grpcCtx context.Context,
 	req *queryproto.SpotPriceRequest,
 ) (*queryproto.SpotPriceResponse, error) {
@@ -111,6 +121,16 @@ func (q Querier) RegisteredAlloyedPoolFromDenom(grpcCtx context.Context,
 	return q.Q.RegisteredAlloyedPoolFromDenom(ctx, *req)
 }
 
+func (q Querier) PoolVolumeBuckets(grpcCtx context.Context,
+	req *queryproto.PoolVolumeBucketsRequest,
+) (*queryproto.PoolVolumeBucketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PoolVolumeBuckets(ctx, *req)
+}
+
 func (q Querier) Pool(grpcCtx context.Context,
 	req *queryproto.PoolRequest,
 ) (*queryproto.PoolResponse, error) {
@@ -221,6 +241,26 @@ func (q Querier) EstimateSinglePoolSwapExactAmountIn(grpcCtx context.Context,
 	return q.Q.EstimateSinglePoolSwapExactAmountIn(ctx, *req)
 }
 
+func (q Querier) DenomPairVolumeBuckets(grpcCtx context.Context,
+	req *queryproto.DenomPairVolumeBucketsRequest,
+) (*queryproto.DenomPairVolumeBucketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.DenomPairVolumeBuckets(ctx, *req)
+}
+
+func (q Querier) BestSplitRouteExactAmountIn(grpcCtx context.Context,
+	req *queryproto.BestSplitRouteExactAmountInRequest,
+) (*queryproto.BestSplitRouteExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.BestSplitRouteExactAmountIn(ctx, *req)
+}
+
 func (q Querier) AllTakerFeeShareAgreements(grpcCtx context.Context,
 	req *queryproto.AllTakerFeeShareAgreementsRequest,
 ) (*queryproto.AllTakerFeeShareAgreementsResponse, error) {
@@ -52,7 +52,12 @@ func (q Querier) EstimateSwapExactAmountIn(ctx sdk.Context, req queryproto.Estim
 		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
 	}
 
-	tokenOutAmount, err := q.K.MultihopEstimateOutGivenExactAmountIn(ctx, req.Routes, tokenIn)
+	sender, err := parseOptionalSender(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, err := q.K.MultihopEstimateOutGivenExactAmountInForSender(ctx, sender, req.Routes, tokenIn)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -109,7 +114,12 @@ func (q Querier) EstimateSwapExactAmountOut(ctx sdk.Context, req queryproto.Esti
 		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
 	}
 
-	tokenInAmount, err := q.K.MultihopEstimateInGivenExactAmountOut(ctx, req.Routes, tokenOut)
+	sender, err := parseOptionalSender(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInAmount, err := q.K.MultihopEstimateInGivenExactAmountOutForSender(ctx, sender, req.Routes, tokenOut)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -405,6 +415,67 @@ func (q Querier) EstimateTradeBasedOnPriceImpact(
 	}
 }
 
+// BestSplitRouteExactAmountIn returns the split route with the largest estimated amount out when swapping token in for token out.
+func (q Querier) BestSplitRouteExactAmountIn(ctx sdk.Context, req queryproto.BestSplitRouteExactAmountInRequest) (*queryproto.BestSplitRouteExactAmountInResponse, error) {
+	if req.TokenIn.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token in denom")
+	}
+
+	if req.TokenOutDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token out denom")
+	}
+
+	routes, tokenOutAmount, err := q.K.BestSplitRouteExactAmountIn(ctx, req.TokenIn, req.TokenOutDenom, req.MaxHops, req.MaxSplits)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.BestSplitRouteExactAmountInResponse{
+		Routes:         routes,
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
+// PoolVolumeBuckets returns the volume of the given pool in the most recent volume buckets.
+func (q Querier) PoolVolumeBuckets(ctx sdk.Context, req queryproto.PoolVolumeBucketsRequest) (*queryproto.PoolVolumeBucketsResponse, error) {
+	buckets, totalVolume, err := q.K.GetPoolVolumeBuckets(ctx, req.PoolId, req.NumBuckets)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &queryproto.PoolVolumeBucketsResponse{
+		Buckets:     buckets,
+		TotalVolume: totalVolume,
+	}, nil
+}
+
+// DenomPairVolumeBuckets returns the volume swapped between the given denoms in the most recent volume buckets.
+func (q Querier) DenomPairVolumeBuckets(ctx sdk.Context, req queryproto.DenomPairVolumeBucketsRequest) (*queryproto.DenomPairVolumeBucketsResponse, error) {
+	buckets, totalVolume, err := q.K.GetDenomPairVolumeBuckets(ctx, req.Denom_0, req.Denom_1, req.NumBuckets)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &queryproto.DenomPairVolumeBucketsResponse{
+		Buckets:     buckets,
+		TotalVolume: totalVolume,
+	}, nil
+}
+
+// TakerFeeDiscount returns the taker fee discount of the given address along with
+// the amount of OSMO it has staked or locked.
+func (q Querier) TakerFeeDiscount(ctx sdk.Context, req queryproto.TakerFeeDiscountRequest) (*queryproto.TakerFeeDiscountResponse, error) {
+	stakedOrLockedAmount, discount, err := q.K.GetTakerFeeDiscount(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &queryproto.TakerFeeDiscountResponse{
+		StakedOrLockedAmount: stakedOrLockedAmount,
+		Discount:             discount,
+	}, nil
+}
+
 func (q Querier) AllTakerFeeShareAgreements(ctx sdk.Context, req queryproto.AllTakerFeeShareAgreementsRequest) (*queryproto.AllTakerFeeShareAgreementsResponse, error) {
 	takerFeeShareAgreements, err := q.K.GetAllTakerFeesShareAgreements(ctx)
 	if err != nil {
@@ -479,3 +550,17 @@ func (q Querier) AllRegisteredAlloyedPools(ctx sdk.Context, req queryproto.AllRe
 		ContractStates: contractStates,
 	}, nil
 }
+
+// parseOptionalSender parses the optional sender of a swap estimate, returning a nil address if it is not set.
+func parseOptionalSender(sender string) (sdk.AccAddress, error) {
+	if sender == "" {
+		return nil, nil
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender: %s", err.Error())
+	}
+
+	return senderAddr, nil
+}
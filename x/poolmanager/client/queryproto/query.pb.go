@@ -120,8 +120,9 @@ func (m *ParamsResponse) GetParams() types.Params {
 
 // =============================== EstimateSwapExactAmountIn
 type EstimateSwapExactAmountInRequest struct {
-	// DEPRECATED
-	Sender  string                    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender",deprecated:"true"`                 // Deprecated: Do not use.
+	// sender is optional. If set, the taker fee is estimated for the sender,
+	// applying its taker fee discount.
+	Sender  string                    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	PoolId  uint64                    `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id",deprecated:"true"` // Deprecated: Do not use.
 	TokenIn string                    `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
 	Routes  []types.SwapAmountInRoute `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes" yaml:"routes"`
@@ -160,7 +161,6 @@ func (m *EstimateSwapExactAmountInRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EstimateSwapExactAmountInRequest proto.InternalMessageInfo
 
-// Deprecated: Do not use.
 func (m *EstimateSwapExactAmountInRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
@@ -366,8 +366,9 @@ var xxx_messageInfo_EstimateSwapExactAmountInResponse proto.InternalMessageInfo
 
 // =============================== EstimateSwapExactAmountOut
 type EstimateSwapExactAmountOutRequest struct {
-	// DEPRECATED
-	Sender   string                     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender",deprecated:"true"`                 // Deprecated: Do not use.
+	// sender is optional. If set, the taker fee is estimated for the sender,
+	// applying its taker fee discount.
+	Sender   string                     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	PoolId   uint64                     `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id",deprecated:"true"` // Deprecated: Do not use.
 	Routes   []types.SwapAmountOutRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes" yaml:"routes"`
 	TokenOut string                     `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty" yaml:"token_out"`
@@ -406,7 +407,6 @@ func (m *EstimateSwapExactAmountOutRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EstimateSwapExactAmountOutRequest proto.InternalMessageInfo
 
-// Deprecated: Do not use.
 func (m *EstimateSwapExactAmountOutRequest) GetSender() string {
 	if m != nil {
 		return m.Sender
@@ -2158,6 +2158,440 @@ func (m *AllRegisteredAlloyedPoolsResponse) GetContractStates() []types.AlloyCon
 	return nil
 }
 
+type BestSplitRouteExactAmountInRequest struct {
+	// token_in is the coin to swap.
+	TokenIn types2.Coin `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in"`
+	// token_out_denom is the denom to swap token_in for.
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty"`
+	// max_hops is the maximum number of pools in a route. Zero uses the default
+	// of 3, and it can be at most 4.
+	MaxHops uint64 `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+	// max_splits is the maximum number of routes token_in is split across. Zero
+	// uses the default of 3, and it can be at most 5.
+	MaxSplits uint64 `protobuf:"varint,4,opt,name=max_splits,json=maxSplits,proto3" json:"max_splits,omitempty"`
+}
+
+func (m *BestSplitRouteExactAmountInRequest) Reset()         { *m = BestSplitRouteExactAmountInRequest{} }
+func (m *BestSplitRouteExactAmountInRequest) String() string { return proto.CompactTextString(m) }
+func (*BestSplitRouteExactAmountInRequest) ProtoMessage()    {}
+func (*BestSplitRouteExactAmountInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{44}
+}
+func (m *BestSplitRouteExactAmountInRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BestSplitRouteExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BestSplitRouteExactAmountInRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BestSplitRouteExactAmountInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BestSplitRouteExactAmountInRequest.Merge(m, src)
+}
+func (m *BestSplitRouteExactAmountInRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BestSplitRouteExactAmountInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BestSplitRouteExactAmountInRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BestSplitRouteExactAmountInRequest proto.InternalMessageInfo
+
+func (m *BestSplitRouteExactAmountInRequest) GetTokenIn() types2.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types2.Coin{}
+}
+
+func (m *BestSplitRouteExactAmountInRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *BestSplitRouteExactAmountInRequest) GetMaxHops() uint64 {
+	if m != nil {
+		return m.MaxHops
+	}
+	return 0
+}
+
+func (m *BestSplitRouteExactAmountInRequest) GetMaxSplits() uint64 {
+	if m != nil {
+		return m.MaxSplits
+	}
+	return 0
+}
+
+type BestSplitRouteExactAmountInResponse struct {
+	// routes are the routes to swap token_in through, along with the amount of
+	// token_in to swap through each of them.
+	Routes []types.SwapAmountInSplitRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+	// token_out_amount is the estimated amount of token_out_denom received when
+	// swapping through the routes, taker fees included.
+	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount"`
+}
+
+func (m *BestSplitRouteExactAmountInResponse) Reset()         { *m = BestSplitRouteExactAmountInResponse{} }
+func (m *BestSplitRouteExactAmountInResponse) String() string { return proto.CompactTextString(m) }
+func (*BestSplitRouteExactAmountInResponse) ProtoMessage()    {}
+func (*BestSplitRouteExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{45}
+}
+func (m *BestSplitRouteExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BestSplitRouteExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BestSplitRouteExactAmountInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BestSplitRouteExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BestSplitRouteExactAmountInResponse.Merge(m, src)
+}
+func (m *BestSplitRouteExactAmountInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BestSplitRouteExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BestSplitRouteExactAmountInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BestSplitRouteExactAmountInResponse proto.InternalMessageInfo
+
+func (m *BestSplitRouteExactAmountInResponse) GetRoutes() []types.SwapAmountInSplitRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+type PoolVolumeBucketsRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// num_buckets is the number of most recent buckets to return, the current
+	// bucket included. Zero returns all retained buckets.
+	NumBuckets uint64 `protobuf:"varint,2,opt,name=num_buckets,json=numBuckets,proto3" json:"num_buckets,omitempty" yaml:"num_buckets"`
+}
+
+func (m *PoolVolumeBucketsRequest) Reset()         { *m = PoolVolumeBucketsRequest{} }
+func (m *PoolVolumeBucketsRequest) String() string { return proto.CompactTextString(m) }
+func (*PoolVolumeBucketsRequest) ProtoMessage()    {}
+func (*PoolVolumeBucketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{46}
+}
+func (m *PoolVolumeBucketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolumeBucketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolumeBucketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolumeBucketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolumeBucketsRequest.Merge(m, src)
+}
+func (m *PoolVolumeBucketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolumeBucketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolumeBucketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolumeBucketsRequest proto.InternalMessageInfo
+
+func (m *PoolVolumeBucketsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolVolumeBucketsRequest) GetNumBuckets() uint64 {
+	if m != nil {
+		return m.NumBuckets
+	}
+	return 0
+}
+
+type PoolVolumeBucketsResponse struct {
+	// buckets are the volume buckets, from the current one to the oldest one.
+	// The current bucket only holds the volume since the last day epoch.
+	Buckets []types.VolumeBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets"`
+	// total_volume is the volume summed over the returned buckets.
+	TotalVolume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_volume,json=totalVolume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_volume" yaml:"total_volume"`
+}
+
+func (m *PoolVolumeBucketsResponse) Reset()         { *m = PoolVolumeBucketsResponse{} }
+func (m *PoolVolumeBucketsResponse) String() string { return proto.CompactTextString(m) }
+func (*PoolVolumeBucketsResponse) ProtoMessage()    {}
+func (*PoolVolumeBucketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{47}
+}
+func (m *PoolVolumeBucketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolumeBucketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolumeBucketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolumeBucketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolumeBucketsResponse.Merge(m, src)
+}
+func (m *PoolVolumeBucketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolumeBucketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolumeBucketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolumeBucketsResponse proto.InternalMessageInfo
+
+func (m *PoolVolumeBucketsResponse) GetBuckets() []types.VolumeBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *PoolVolumeBucketsResponse) GetTotalVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalVolume
+	}
+	return nil
+}
+
+type DenomPairVolumeBucketsRequest struct {
+	Denom_0 string `protobuf:"bytes,1,opt,name=denom_0,json=denom0,proto3" json:"denom_0,omitempty" yaml:"denom_0"`
+	Denom_1 string `protobuf:"bytes,2,opt,name=denom_1,json=denom1,proto3" json:"denom_1,omitempty" yaml:"denom_1"`
+	// num_buckets is the number of most recent buckets to return, the current
+	// bucket included. Zero returns all retained buckets.
+	NumBuckets uint64 `protobuf:"varint,3,opt,name=num_buckets,json=numBuckets,proto3" json:"num_buckets,omitempty" yaml:"num_buckets"`
+}
+
+func (m *DenomPairVolumeBucketsRequest) Reset()         { *m = DenomPairVolumeBucketsRequest{} }
+func (m *DenomPairVolumeBucketsRequest) String() string { return proto.CompactTextString(m) }
+func (*DenomPairVolumeBucketsRequest) ProtoMessage()    {}
+func (*DenomPairVolumeBucketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{48}
+}
+func (m *DenomPairVolumeBucketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPairVolumeBucketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPairVolumeBucketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPairVolumeBucketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPairVolumeBucketsRequest.Merge(m, src)
+}
+func (m *DenomPairVolumeBucketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPairVolumeBucketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPairVolumeBucketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPairVolumeBucketsRequest proto.InternalMessageInfo
+
+func (m *DenomPairVolumeBucketsRequest) GetDenom_0() string {
+	if m != nil {
+		return m.Denom_0
+	}
+	return ""
+}
+
+func (m *DenomPairVolumeBucketsRequest) GetDenom_1() string {
+	if m != nil {
+		return m.Denom_1
+	}
+	return ""
+}
+
+func (m *DenomPairVolumeBucketsRequest) GetNumBuckets() uint64 {
+	if m != nil {
+		return m.NumBuckets
+	}
+	return 0
+}
+
+type DenomPairVolumeBucketsResponse struct {
+	// buckets are the volume buckets, from the current one to the oldest one.
+	// The current bucket only holds the volume since the last day epoch.
+	Buckets []types.VolumeBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets"`
+	// total_volume is the volume summed over the returned buckets.
+	TotalVolume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_volume,json=totalVolume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_volume" yaml:"total_volume"`
+}
+
+func (m *DenomPairVolumeBucketsResponse) Reset()         { *m = DenomPairVolumeBucketsResponse{} }
+func (m *DenomPairVolumeBucketsResponse) String() string { return proto.CompactTextString(m) }
+func (*DenomPairVolumeBucketsResponse) ProtoMessage()    {}
+func (*DenomPairVolumeBucketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{49}
+}
+func (m *DenomPairVolumeBucketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPairVolumeBucketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPairVolumeBucketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPairVolumeBucketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPairVolumeBucketsResponse.Merge(m, src)
+}
+func (m *DenomPairVolumeBucketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPairVolumeBucketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPairVolumeBucketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPairVolumeBucketsResponse proto.InternalMessageInfo
+
+func (m *DenomPairVolumeBucketsResponse) GetBuckets() []types.VolumeBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *DenomPairVolumeBucketsResponse) GetTotalVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalVolume
+	}
+	return nil
+}
+
+type TakerFeeDiscountRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+}
+
+func (m *TakerFeeDiscountRequest) Reset()         { *m = TakerFeeDiscountRequest{} }
+func (m *TakerFeeDiscountRequest) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeDiscountRequest) ProtoMessage()    {}
+func (*TakerFeeDiscountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{50}
+}
+func (m *TakerFeeDiscountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeDiscountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeDiscountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeDiscountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeDiscountRequest.Merge(m, src)
+}
+func (m *TakerFeeDiscountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeDiscountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeDiscountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeDiscountRequest proto.InternalMessageInfo
+
+func (m *TakerFeeDiscountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type TakerFeeDiscountResponse struct {
+	// staked_or_locked_amount is the amount of OSMO the address has bonded to
+	// validators and locked in x/lockup combined.
+	StakedOrLockedAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=staked_or_locked_amount,json=stakedOrLockedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"staked_or_locked_amount" yaml:"staked_or_locked_amount"`
+	// discount is the fraction of the taker fee waived for the address.
+	Discount cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=discount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount"`
+}
+
+func (m *TakerFeeDiscountResponse) Reset()         { *m = TakerFeeDiscountResponse{} }
+func (m *TakerFeeDiscountResponse) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeDiscountResponse) ProtoMessage()    {}
+func (*TakerFeeDiscountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{51}
+}
+func (m *TakerFeeDiscountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeDiscountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeDiscountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeDiscountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeDiscountResponse.Merge(m, src)
+}
+func (m *TakerFeeDiscountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeDiscountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeDiscountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeDiscountResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "osmosis.poolmanager.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "osmosis.poolmanager.v1beta1.ParamsResponse")
@@ -2203,6 +2637,14 @@ func init() {
 	proto.RegisterType((*RegisteredAlloyedPoolFromPoolIdResponse)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromPoolIdResponse")
 	proto.RegisterType((*AllRegisteredAlloyedPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.AllRegisteredAlloyedPoolsRequest")
 	proto.RegisterType((*AllRegisteredAlloyedPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.AllRegisteredAlloyedPoolsResponse")
+	proto.RegisterType((*BestSplitRouteExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.BestSplitRouteExactAmountInRequest")
+	proto.RegisterType((*BestSplitRouteExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.BestSplitRouteExactAmountInResponse")
+	proto.RegisterType((*PoolVolumeBucketsRequest)(nil), "osmosis.poolmanager.v1beta1.PoolVolumeBucketsRequest")
+	proto.RegisterType((*PoolVolumeBucketsResponse)(nil), "osmosis.poolmanager.v1beta1.PoolVolumeBucketsResponse")
+	proto.RegisterType((*DenomPairVolumeBucketsRequest)(nil), "osmosis.poolmanager.v1beta1.DenomPairVolumeBucketsRequest")
+	proto.RegisterType((*DenomPairVolumeBucketsResponse)(nil), "osmosis.poolmanager.v1beta1.DenomPairVolumeBucketsResponse")
+	proto.RegisterType((*TakerFeeDiscountRequest)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDiscountRequest")
+	proto.RegisterType((*TakerFeeDiscountResponse)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDiscountResponse")
 }
 
 func init() {
@@ -2210,173 +2652,202 @@ func init() {
 }
 
 var fileDescriptor_6256a4106f701b7d = []byte{
-	// 2651 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x4e, 0x8f, 0x1d, 0xaf, 0xfd, 0x12, 0xff, 0xa4, 0x36, 0x8e, 0xed, 0x49, 0xf0, 0x38, 0xe5,
-	0xc4, 0x71, 0x36, 0xf1, 0xcc, 0xda, 0xce, 0xb2, 0xbb, 0xd9, 0x75, 0x92, 0x19, 0xff, 0x24, 0x66,
-	0x13, 0xe2, 0x8c, 0x4d, 0x16, 0x96, 0xcd, 0xb6, 0xda, 0x33, 0x95, 0x71, 0xcb, 0xd3, 0xdd, 0x93,
-	0xee, 0x6a, 0xc7, 0x23, 0x94, 0x03, 0x48, 0x08, 0x4e, 0x28, 0xb0, 0x48, 0x8b, 0x04, 0xd2, 0x6a,
-	0x0f, 0x5c, 0xe0, 0x80, 0x90, 0x10, 0x12, 0x17, 0xb8, 0x70, 0x88, 0x90, 0x40, 0x91, 0x38, 0x80,
-	0x90, 0x18, 0x50, 0xc2, 0x01, 0x01, 0xa7, 0x39, 0x72, 0x01, 0x75, 0x55, 0x75, 0x4f, 0xcf, 0x78,
-	0xa6, 0xbb, 0x67, 0x26, 0xa0, 0x3d, 0xc5, 0xae, 0x7a, 0xef, 0xd5, 0xfb, 0xbe, 0x7a, 0xaf, 0xaa,
-	0xfa, 0x8b, 0xe1, 0x9c, 0x61, 0x69, 0x86, 0xa5, 0x5a, 0xa9, 0x92, 0x61, 0x14, 0x35, 0x45, 0x57,
-	0x0a, 0xc4, 0x4c, 0xed, 0xcd, 0x6f, 0x13, 0xaa, 0xcc, 0xa7, 0x1e, 0xd8, 0xc4, 0x2c, 0x27, 0x4b,
-	0xa6, 0x41, 0x0d, 0x74, 0x52, 0x18, 0x26, 0x7d, 0x86, 0x49, 0x61, 0x18, 0x3f, 0x5e, 0x30, 0x0a,
-	0x06, 0xb3, 0x4b, 0x39, 0x3f, 0x71, 0x97, 0xf8, 0xf9, 0xa0, 0xd8, 0x05, 0xa2, 0x13, 0x16, 0x8e,
-	0x99, 0x9e, 0x09, 0x32, 0xa5, 0xfb, 0xc2, 0xea, 0x62, 0x90, 0x95, 0xf5, 0x50, 0x29, 0xc9, 0xa6,
-	0x61, 0x53, 0x22, 0xac, 0xe7, 0x03, 0x63, 0x2a, 0xbb, 0xc4, 0x94, 0xef, 0x13, 0x22, 0x5b, 0x3b,
-	0x8a, 0xe9, 0xba, 0x4c, 0xe6, 0x98, 0x4f, 0x6a, 0x5b, 0xb1, 0x88, 0x67, 0x9a, 0x33, 0x54, 0x5d,
-	0xcc, 0xbf, 0xe2, 0x9f, 0x67, 0xec, 0x78, 0x56, 0x25, 0xa5, 0xa0, 0xea, 0x0a, 0x55, 0x0d, 0xd7,
-	0xf6, 0x54, 0xc1, 0x30, 0x0a, 0x45, 0x92, 0x52, 0x4a, 0x6a, 0x4a, 0xd1, 0x75, 0x83, 0xb2, 0x49,
-	0x17, 0xf0, 0x84, 0x98, 0x65, 0xbf, 0x6d, 0xdb, 0xf7, 0x53, 0x8a, 0x5e, 0x76, 0xa7, 0xf8, 0x22,
-	0x32, 0xe7, 0x93, 0xff, 0x22, 0xa6, 0x12, 0x8d, 0x5e, 0x54, 0xd5, 0x88, 0x45, 0x15, 0xad, 0xc4,
-	0x0d, 0xf0, 0x30, 0x0c, 0x6e, 0x28, 0xa6, 0xa2, 0x59, 0x59, 0xf2, 0xc0, 0x26, 0x16, 0xc5, 0x9b,
-	0x30, 0xe4, 0x0e, 0x58, 0x25, 0x43, 0xb7, 0x08, 0x4a, 0x43, 0x5f, 0x89, 0x8d, 0x8c, 0x4b, 0x53,
-	0xd2, 0xec, 0x91, 0x85, 0xe9, 0x64, 0xc0, 0xce, 0x26, 0xb9, 0x73, 0xa6, 0xf7, 0x49, 0x25, 0x71,
-	0x28, 0x2b, 0x1c, 0xf1, 0x4f, 0x63, 0x30, 0xb5, 0x6a, 0x51, 0x55, 0x53, 0x28, 0xd9, 0x7c, 0xa8,
-	0x94, 0x56, 0xf7, 0x95, 0x1c, 0x4d, 0x6b, 0x86, 0xad, 0xd3, 0x75, 0x5d, 0xac, 0x8c, 0x96, 0xa0,
-	0xcf, 0x22, 0x7a, 0x9e, 0x98, 0x6c, 0x9d, 0x81, 0xcc, 0xd9, 0x6a, 0x25, 0x91, 0x28, 0x2b, 0x5a,
-	0xf1, 0x32, 0xe6, 0xe3, 0xf8, 0x62, 0x9e, 0x94, 0x4c, 0x92, 0x53, 0x28, 0xc9, 0x5f, 0xc6, 0xd4,
-	0xb4, 0x09, 0x1e, 0x97, 0xb2, 0xc2, 0x09, 0x5d, 0x85, 0x97, 0x9c, 0x7c, 0x64, 0x35, 0x3f, 0x1e,
-	0x9b, 0x92, 0x66, 0x7b, 0x33, 0x33, 0xd5, 0x4a, 0x62, 0x8a, 0xfb, 0x8b, 0x89, 0x16, 0x01, 0x9c,
-	0xd9, 0xf5, 0x3c, 0x4a, 0x42, 0x3f, 0x35, 0x76, 0x89, 0x2e, 0xab, 0xfa, 0x78, 0x0f, 0xcb, 0xe0,
-	0xe5, 0x6a, 0x25, 0x31, 0xcc, 0x23, 0xb8, 0x33, 0x38, 0xfb, 0x12, 0xfb, 0x71, 0x5d, 0x47, 0xf7,
-	0xa0, 0x8f, 0x55, 0x8f, 0x35, 0xde, 0x3b, 0xd5, 0x33, 0x7b, 0x64, 0x21, 0x19, 0xc8, 0x8b, 0x03,
-	0xdb, 0x43, 0xec, 0xb8, 0x65, 0x46, 0x1d, 0x8a, 0xaa, 0x95, 0xc4, 0x20, 0x5f, 0x81, 0xc7, 0xc2,
-	0x59, 0x11, 0x14, 0xff, 0x32, 0x06, 0x0b, 0x2d, 0x39, 0x7b, 0x57, 0xa5, 0x3b, 0x1b, 0xa6, 0xaa,
-	0xa9, 0x54, 0xdd, 0x23, 0x5b, 0xe5, 0x12, 0x71, 0xf7, 0xcf, 0x4f, 0x83, 0xd4, 0x35, 0x0d, 0xb1,
-	0x08, 0x34, 0x5c, 0x85, 0x21, 0x9e, 0xb1, 0xec, 0xae, 0xdb, 0x33, 0xd5, 0x33, 0xdb, 0x9b, 0x99,
-	0xa8, 0x56, 0x12, 0xa3, 0x7e, 0x68, 0xee, 0x3c, 0xce, 0x1e, 0xe5, 0x03, 0x1b, 0x7c, 0xc1, 0xbb,
-	0x70, 0x42, 0x18, 0xf0, 0xe8, 0x86, 0x4d, 0xe5, 0x3c, 0xd1, 0x0d, 0x8d, 0xf1, 0x3a, 0x90, 0x39,
-	0x5d, 0xad, 0x24, 0x3e, 0x53, 0x17, 0xa8, 0xc1, 0x0e, 0x67, 0x5f, 0xe6, 0x13, 0x5b, 0xce, 0xf8,
-	0x6d, 0x9b, 0xae, 0xb0, 0xd1, 0xdf, 0x4a, 0xf0, 0x8a, 0x47, 0xa0, 0xaa, 0x17, 0x8a, 0xc4, 0x59,
-	0xb0, 0x65, 0xf9, 0x5d, 0x68, 0x24, 0x0e, 0x55, 0x2b, 0x89, 0xa1, 0x7a, 0xe2, 0x3a, 0x26, 0x29,
-	0x03, 0xc3, 0x8d, 0xe0, 0x78, 0x89, 0xc5, 0xab, 0x95, 0xc4, 0x09, 0xbf, 0x9b, 0x0f, 0xd5, 0x20,
-	0xad, 0xc3, 0xf3, 0x0d, 0x09, 0x4e, 0x07, 0x34, 0x91, 0xe8, 0xd6, 0x6d, 0x18, 0xa9, 0x05, 0x52,
-	0xd8, 0xac, 0xe8, 0xa7, 0x37, 0x9c, 0x7a, 0xfb, 0x53, 0x25, 0x31, 0xca, 0x4f, 0x08, 0x2b, 0xbf,
-	0x9b, 0x54, 0x8d, 0x94, 0xa6, 0xd0, 0x9d, 0xe4, 0xba, 0x4e, 0xab, 0x95, 0xc4, 0x58, 0x63, 0x1e,
-	0xdc, 0x1d, 0x67, 0x87, 0xdc, 0x44, 0xf8, 0x6a, 0xf8, 0xe7, 0xb1, 0x96, 0x99, 0xdc, 0xb6, 0xe9,
-	0xa7, 0xa5, 0x9f, 0x3f, 0xf0, 0xfa, 0xb3, 0x87, 0xf5, 0x67, 0x2a, 0x62, 0x7f, 0x3a, 0x10, 0x22,
-	0x34, 0x28, 0x9a, 0x87, 0x01, 0x8f, 0xaa, 0xf1, 0x5e, 0x06, 0xf1, 0x78, 0xb5, 0x92, 0x18, 0x69,
-	0x60, 0x11, 0x67, 0xfb, 0x5d, 0xfa, 0xf0, 0xaf, 0x62, 0xb0, 0xd8, 0x9a, 0xb8, 0xff, 0x61, 0x53,
-	0x1f, 0x6c, 0xd2, 0x58, 0x7b, 0x4d, 0xba, 0x09, 0xa3, 0x75, 0xcd, 0xa7, 0xea, 0x5e, 0x19, 0x3b,
-	0x3d, 0x3a, 0x55, 0xad, 0x24, 0x4e, 0x35, 0xe9, 0x51, 0xd7, 0x0c, 0x67, 0x91, 0xaf, 0x45, 0xd7,
-	0x75, 0x56, 0xd1, 0x9d, 0x30, 0xf8, 0x3b, 0x09, 0x2e, 0x84, 0x36, 0xb5, 0xaf, 0x08, 0xdb, 0xea,
-	0xea, 0xab, 0x30, 0xd4, 0x80, 0x8e, 0xf7, 0xb6, 0x8f, 0xa5, 0x46, 0x58, 0x47, 0x69, 0x4b, 0x40,
-	0x3d, 0x91, 0x00, 0x7d, 0x5d, 0x02, 0x1c, 0xd4, 0x4b, 0xa2, 0xad, 0x65, 0xf7, 0x00, 0x51, 0xf5,
-	0xfa, 0xae, 0x7e, 0x3d, 0xac, 0xab, 0x4f, 0x34, 0x24, 0xee, 0x36, 0xf5, 0xa0, 0xc8, 0x5c, 0xf4,
-	0xf4, 0x31, 0x18, 0xfe, 0xbc, 0xad, 0x39, 0x64, 0x7a, 0x4f, 0x81, 0x55, 0x18, 0xa9, 0x0d, 0x89,
-	0x3c, 0xe6, 0x61, 0x40, 0xb7, 0x35, 0x56, 0x25, 0x96, 0x60, 0xd4, 0x87, 0xd0, 0x9b, 0xc2, 0xd9,
-	0x7e, 0x5d, 0xb8, 0xe2, 0xcb, 0x70, 0xc4, 0xf9, 0xa1, 0x93, 0x1d, 0xc1, 0xcb, 0x70, 0x94, 0xfb,
-	0x8a, 0xe5, 0x17, 0xa1, 0xd7, 0x99, 0x11, 0x2f, 0x91, 0xe3, 0x49, 0xfe, 0xbc, 0x49, 0xba, 0xcf,
-	0x9b, 0x64, 0x5a, 0x2f, 0x67, 0x06, 0x7e, 0xf3, 0xb3, 0xb9, 0xc3, 0xac, 0x6c, 0xb3, 0xcc, 0xd8,
-	0x81, 0x96, 0x2e, 0x16, 0xeb, 0xa0, 0xad, 0xc3, 0x48, 0x6d, 0x48, 0xc4, 0x7e, 0x0d, 0x0e, 0xbb,
-	0xb0, 0x7a, 0xa2, 0x04, 0xe7, 0xd6, 0x38, 0x0d, 0x63, 0x37, 0x55, 0x8b, 0xb2, 0x58, 0x99, 0x32,
-	0xab, 0x03, 0x17, 0xea, 0x0c, 0x1c, 0xe6, 0x65, 0xc4, 0xb7, 0x6a, 0xa4, 0x5a, 0x49, 0x1c, 0xe5,
-	0x40, 0x45, 0xf5, 0xf0, 0x69, 0x7c, 0x07, 0xc6, 0x0f, 0x86, 0xe8, 0x2e, 0xab, 0xa7, 0x12, 0x8c,
-	0x6c, 0x96, 0x0c, 0xba, 0x61, 0xaa, 0x39, 0xd2, 0x51, 0x33, 0xac, 0xc2, 0x88, 0xf3, 0x6a, 0x95,
-	0x15, 0xcb, 0x22, 0xb4, 0xae, 0x1d, 0x4e, 0xd6, 0xee, 0x8a, 0x46, 0x0b, 0x9c, 0x1d, 0x72, 0x86,
-	0xd2, 0xce, 0x08, 0x6f, 0x89, 0x1b, 0x70, 0xec, 0x81, 0x6d, 0xd0, 0xfa, 0x38, 0xbc, 0x35, 0x4e,
-	0x55, 0x2b, 0x89, 0x71, 0x1e, 0xe7, 0x80, 0x09, 0xce, 0x0e, 0xb3, 0xb1, 0x5a, 0x24, 0xbc, 0x0e,
-	0xc7, 0x7c, 0x88, 0x04, 0x3d, 0x97, 0x00, 0xac, 0x92, 0x41, 0xe5, 0x92, 0x33, 0x2a, 0x78, 0x1e,
-	0xad, 0x56, 0x12, 0xc7, 0xc4, 0x45, 0xe3, 0xcd, 0xe1, 0xec, 0x80, 0xe5, 0x7a, 0xe3, 0x1b, 0x30,
-	0xb1, 0x65, 0x50, 0x85, 0x15, 0xc0, 0x4d, 0xf5, 0x81, 0xad, 0xe6, 0x55, 0x5a, 0xee, 0xa8, 0x40,
-	0xbf, 0x2f, 0x41, 0xbc, 0x59, 0x28, 0x91, 0xde, 0x23, 0x18, 0x28, 0xba, 0x83, 0x62, 0x07, 0x27,
-	0x92, 0xe2, 0x85, 0xee, 0x10, 0xe5, 0x5d, 0x3f, 0xcb, 0x86, 0xaa, 0x67, 0x56, 0xc4, 0x85, 0x23,
-	0xba, 0xc9, 0xf3, 0xc4, 0x3f, 0xfa, 0x4b, 0x62, 0xb6, 0xa0, 0xd2, 0x1d, 0x7b, 0x3b, 0x99, 0x33,
-	0x34, 0xf1, 0xc4, 0x17, 0xff, 0xcc, 0x59, 0xf9, 0xdd, 0x14, 0x75, 0x6e, 0x0b, 0x16, 0xc4, 0xca,
-	0xd6, 0x56, 0xc4, 0x63, 0x30, 0xca, 0x92, 0x6b, 0xc4, 0x88, 0x3f, 0x92, 0xe0, 0x44, 0xe3, 0xcc,
-	0xa7, 0x23, 0x65, 0x77, 0x6b, 0xee, 0x1a, 0x45, 0x5b, 0x23, 0x6b, 0x86, 0xd9, 0xf1, 0xd9, 0xf1,
-	0x1d, 0x77, 0x6b, 0x1a, 0x42, 0x09, 0x9c, 0x14, 0xfa, 0xf6, 0xd8, 0x44, 0x38, 0xc8, 0x74, 0xfd,
-	0x43, 0x80, 0xbb, 0xb5, 0x87, 0x50, 0xac, 0x85, 0xf7, 0x20, 0xbe, 0x65, 0x2a, 0x79, 0x55, 0x2f,
-	0x6c, 0x28, 0xaa, 0xb9, 0xe5, 0x7c, 0x54, 0xae, 0x11, 0x7f, 0x83, 0xb2, 0xea, 0x97, 0x5f, 0x15,
-	0xa5, 0xec, 0xc3, 0x27, 0x26, 0x70, 0xb6, 0x8f, 0xfd, 0xf4, 0x6a, 0xcd, 0x78, 0x5e, 0xf4, 0xe5,
-	0x01, 0xe3, 0x79, 0xd7, 0x78, 0x1e, 0xcb, 0x70, 0xb2, 0xe9, 0xba, 0x82, 0x8c, 0x6b, 0x30, 0xe0,
-	0x7d, 0xe0, 0x8a, 0xa5, 0xa7, 0xc5, 0xc5, 0x72, 0xf2, 0xe0, 0xc5, 0x72, 0x93, 0x14, 0x94, 0x5c,
-	0x79, 0x85, 0xe4, 0xb2, 0xfd, 0x54, 0x44, 0x72, 0x3e, 0x57, 0x66, 0xdc, 0x7b, 0xcc, 0x59, 0x89,
-	0x64, 0x14, 0x8b, 0xe4, 0x6f, 0xeb, 0xac, 0xe1, 0xd6, 0xb5, 0x92, 0x92, 0xf3, 0xee, 0xe4, 0xb7,
-	0x61, 0xe0, 0xbe, 0x69, 0x68, 0xb2, 0xf3, 0x9d, 0x2c, 0x4e, 0xf2, 0x00, 0xf2, 0xf9, 0x97, 0x64,
-	0xbf, 0xe3, 0xe1, 0xfc, 0x8e, 0x30, 0x0c, 0x52, 0x83, 0xf9, 0xfa, 0x0f, 0xa5, 0xec, 0x11, 0x6a,
-	0x38, 0xd3, 0xfc, 0xd0, 0x19, 0xab, 0xd5, 0x89, 0x73, 0xd4, 0xf4, 0x7a, 0x87, 0xda, 0x2d, 0x18,
-	0xd1, 0x94, 0x7d, 0x7e, 0x22, 0xc8, 0x2a, 0xcb, 0x4a, 0x3c, 0x3c, 0x22, 0xc1, 0x1d, 0xd2, 0x94,
-	0x7d, 0x1f, 0x20, 0xf4, 0x39, 0x18, 0x22, 0xfb, 0x94, 0x98, 0xba, 0x52, 0x14, 0x27, 0xd0, 0xe1,
-	0xe8, 0xc1, 0x06, 0x5d, 0x57, 0x7e, 0x26, 0xfd, 0x58, 0x82, 0x73, 0xa1, 0x04, 0x8a, 0xed, 0xba,
-	0x02, 0xa0, 0xea, 0x25, 0x9b, 0xb6, 0x45, 0xe1, 0x00, 0x73, 0x61, 0x1c, 0x5e, 0x83, 0x23, 0x86,
-	0x4d, 0xbd, 0x00, 0xb1, 0x68, 0x01, 0x80, 0xfb, 0x38, 0x23, 0x78, 0x1a, 0x4e, 0xa7, 0x8b, 0x45,
-	0xb7, 0x8e, 0x36, 0x77, 0x14, 0x93, 0xa4, 0x0b, 0x26, 0x21, 0x1a, 0xd1, 0xa9, 0x77, 0xcb, 0xfe,
-	0x40, 0x02, 0x1c, 0x64, 0x25, 0xd0, 0xec, 0x41, 0xbc, 0x41, 0x5d, 0x91, 0x15, 0xcf, 0x4a, 0x74,
-	0xe7, 0x62, 0xe0, 0xe3, 0xbd, 0xf9, 0x0a, 0x22, 0xed, 0x31, 0xda, 0x7c, 0x7d, 0x7c, 0x05, 0x66,
-	0x9a, 0x3b, 0xae, 0x99, 0x86, 0x56, 0x77, 0x91, 0x1f, 0xaf, 0xbb, 0xc8, 0xdd, 0x6b, 0xfb, 0x63,
-	0x09, 0xce, 0x85, 0x06, 0xf0, 0x4e, 0x9b, 0x89, 0x96, 0x18, 0xc5, 0x06, 0x76, 0x01, 0xf1, 0x44,
-	0x73, 0x88, 0xf8, 0x3e, 0xcc, 0xd6, 0xf9, 0xb1, 0x9c, 0xac, 0x2d, 0x23, 0x9d, 0xcb, 0x99, 0x36,
-	0xc9, 0xdf, 0x55, 0x8a, 0x36, 0x09, 0xc4, 0x88, 0xce, 0xc0, 0xa0, 0x1b, 0x7b, 0xc5, 0xd7, 0x6d,
-	0xf5, 0x83, 0xd8, 0x82, 0xf3, 0x11, 0xd6, 0x11, 0x54, 0xac, 0x41, 0x5f, 0xdd, 0x0b, 0x36, 0x19,
-	0xf6, 0x82, 0x15, 0xc7, 0xae, 0xfb, 0x70, 0x15, 0xde, 0xf8, 0x2c, 0x4c, 0x1f, 0x28, 0xae, 0x5c,
-	0xce, 0xd6, 0xec, 0xa2, 0x42, 0x0d, 0xd3, 0x2b, 0xc2, 0x4f, 0x24, 0x38, 0x13, 0x6c, 0x27, 0xf2,
-	0x2a, 0xc3, 0x49, 0xdf, 0x16, 0xed, 0xaa, 0x9a, 0xac, 0xf8, 0xcc, 0x44, 0x1d, 0x5e, 0x8a, 0xb6,
-	0x49, 0xbb, 0xaa, 0xe6, 0x5b, 0x43, 0xec, 0xd2, 0x38, 0x6d, 0x3e, 0x6d, 0xe1, 0x25, 0x38, 0x9b,
-	0x25, 0x05, 0xd5, 0xa2, 0xc4, 0x24, 0xf9, 0x74, 0xb1, 0x68, 0x94, 0x49, 0xde, 0xb9, 0xac, 0x22,
-	0x16, 0xe2, 0x87, 0x12, 0xcc, 0x84, 0xf9, 0x0b, 0x90, 0x2a, 0x0c, 0xe5, 0x0c, 0x9d, 0x9a, 0x4a,
-	0x8e, 0xca, 0x16, 0x55, 0x28, 0x11, 0xc5, 0xf7, 0x76, 0x20, 0x2e, 0x16, 0x72, 0x59, 0xf8, 0xd5,
-	0x31, 0xb9, 0xe9, 0xc4, 0x10, 0xf8, 0x06, 0xdd, 0xc8, 0x6c, 0x10, 0xa7, 0x03, 0x92, 0xe2, 0x5f,
-	0x95, 0x2e, 0xaa, 0xb1, 0x86, 0x6b, 0xdd, 0xbb, 0xc2, 0xbf, 0x2b, 0xc1, 0xb9, 0xd0, 0x18, 0xff,
-	0x7f, 0x64, 0x18, 0xa6, 0xd2, 0xc5, 0x62, 0xd3, 0xc4, 0xbc, 0xb2, 0x7b, 0x2c, 0xb1, 0x13, 0xb2,
-	0x95, 0x91, 0x48, 0x7a, 0x17, 0x86, 0xeb, 0x93, 0x76, 0xeb, 0xec, 0x45, 0x64, 0x3d, 0x54, 0x97,
-	0xb5, 0xb5, 0xf0, 0x87, 0xf3, 0x70, 0xf8, 0x8e, 0x4d, 0xcc, 0x32, 0xfa, 0x96, 0x04, 0x7d, 0x5c,
-	0xa8, 0x45, 0xaf, 0x44, 0x50, 0x73, 0x05, 0xa6, 0xf8, 0x85, 0x48, 0xb6, 0x1c, 0x1a, 0xbe, 0xf0,
-	0xb5, 0xdf, 0xff, 0xed, 0xc3, 0xd8, 0x59, 0x34, 0x9d, 0x0a, 0x92, 0xd5, 0x45, 0x16, 0x7f, 0x97,
-	0x60, 0xa2, 0xa5, 0xb6, 0x85, 0x96, 0x02, 0xd7, 0x0d, 0x13, 0x96, 0xe3, 0x57, 0x3a, 0x75, 0x17,
-	0x48, 0x6e, 0x32, 0x24, 0x6b, 0x68, 0x25, 0x10, 0xc9, 0x57, 0x44, 0x09, 0x3f, 0x4a, 0x11, 0x11,
-	0x91, 0xff, 0x0f, 0x03, 0x71, 0x62, 0x8a, 0xaf, 0x6e, 0x59, 0xd5, 0xd1, 0x27, 0x31, 0x9f, 0x82,
-	0x11, 0xae, 0xeb, 0xa2, 0xdb, 0x9d, 0x65, 0xdf, 0x52, 0x4c, 0xea, 0x9a, 0x0e, 0x85, 0xd1, 0xf1,
-	0x65, 0xf4, 0xa5, 0x17, 0x41, 0x87, 0xfc, 0x50, 0xa5, 0x3b, 0xce, 0xdb, 0x89, 0x27, 0x2a, 0xb3,
-	0x37, 0x33, 0xfa, 0x66, 0x0c, 0xa6, 0x23, 0x48, 0xb7, 0xe8, 0x7a, 0x34, 0x28, 0xa1, 0xe2, 0x6f,
-	0xd7, 0x9c, 0x7c, 0x91, 0x71, 0x92, 0x45, 0x1b, 0x6d, 0x73, 0xc2, 0x72, 0xe3, 0xaa, 0x5b, 0xd3,
-	0x72, 0xf9, 0x97, 0x04, 0xf1, 0xd6, 0xfa, 0x10, 0xea, 0x28, 0xf1, 0x9a, 0x3e, 0x16, 0xbf, 0xda,
-	0xb1, 0xbf, 0x40, 0x7e, 0x8b, 0x21, 0xbf, 0x8e, 0x56, 0xbb, 0xaf, 0x06, 0xc3, 0xa6, 0xe8, 0x87,
-	0x31, 0xb8, 0xd8, 0x8e, 0x42, 0x8a, 0x36, 0x3a, 0x04, 0xd0, 0xba, 0x3f, 0xba, 0xa6, 0x64, 0x9b,
-	0x51, 0xf2, 0x3e, 0x7a, 0xef, 0x85, 0x50, 0xd2, 0xbc, 0x43, 0x1e, 0xc7, 0xe0, 0x4c, 0x14, 0x1d,
-	0x14, 0xdd, 0xe8, 0xae, 0x45, 0x5e, 0x64, 0xa9, 0xdc, 0x63, 0xbc, 0xbc, 0x8b, 0xbe, 0xd0, 0x26,
-	0x2f, 0x0e, 0x0b, 0x21, 0x8d, 0xe2, 0x94, 0xce, 0x47, 0x12, 0xf4, 0xbb, 0x7a, 0x25, 0xba, 0x18,
-	0x98, 0x6c, 0x83, 0xd2, 0x19, 0x9f, 0x8b, 0x68, 0x2d, 0x80, 0x24, 0x19, 0x90, 0x59, 0x34, 0x13,
-	0x08, 0xc4, 0x13, 0x43, 0xd1, 0xb7, 0x25, 0xe8, 0x75, 0x22, 0xa0, 0xd9, 0xe0, 0x0b, 0xb4, 0xa6,
-	0x74, 0xc4, 0xcf, 0x47, 0xb0, 0x14, 0xd9, 0x5c, 0x62, 0xd9, 0x24, 0xd1, 0xc5, 0xc0, 0x6c, 0x58,
-	0x26, 0x35, 0x72, 0x19, 0x5b, 0xae, 0x04, 0x1a, 0xc2, 0x56, 0x83, 0x78, 0x1a, 0xc2, 0x56, 0xa3,
-	0xae, 0x1a, 0x91, 0x2d, 0xa5, 0x58, 0x9c, 0xe3, 0x6c, 0xfd, 0x42, 0x82, 0x91, 0x46, 0x39, 0x14,
-	0x05, 0xbf, 0xbb, 0x5b, 0x08, 0xb0, 0xf1, 0xd7, 0xda, 0xf4, 0x12, 0x19, 0xbf, 0xc1, 0x32, 0x5e,
-	0x40, 0xaf, 0x06, 0x66, 0x5c, 0x54, 0x2d, 0xca, 0x53, 0x9e, 0xdb, 0x2e, 0xcf, 0xf1, 0xcf, 0xa5,
-	0x8f, 0x25, 0x18, 0xf0, 0x44, 0x4a, 0x14, 0x4c, 0x54, 0xa3, 0x3c, 0x1b, 0x4f, 0x46, 0x35, 0x17,
-	0x69, 0x2e, 0xb2, 0x34, 0xe7, 0xd0, 0x85, 0xa6, 0x69, 0x36, 0x6c, 0x78, 0x8a, 0xe9, 0x13, 0x16,
-	0x7a, 0x2a, 0x01, 0x3a, 0x28, 0x58, 0xa2, 0xcf, 0x06, 0x7f, 0xd7, 0xb4, 0x12, 0x4b, 0xe3, 0xaf,
-	0xb7, 0xed, 0x27, 0x92, 0x5f, 0x67, 0xc9, 0x2f, 0xa3, 0x74, 0x3b, 0x55, 0x9b, 0xa2, 0x4e, 0x40,
-	0x7e, 0x08, 0x78, 0x92, 0x21, 0xfa, 0x89, 0x04, 0x43, 0xf5, 0x62, 0x26, 0x5a, 0x08, 0x4f, 0xeb,
-	0x00, 0x94, 0xc5, 0xb6, 0x7c, 0xda, 0x6a, 0x3e, 0x9e, 0x76, 0x2d, 0xe3, 0x27, 0xee, 0x26, 0xd4,
-	0x49, 0x93, 0x51, 0x36, 0xa1, 0x99, 0x2c, 0x1a, 0x65, 0x13, 0x9a, 0x6a, 0xa0, 0x38, 0xcd, 0xb2,
-	0x7f, 0x0b, 0xbd, 0xd9, 0xc1, 0x26, 0x70, 0x41, 0x13, 0xfd, 0x5a, 0x82, 0x97, 0x9b, 0x28, 0x8b,
-	0x28, 0x24, 0xa7, 0x96, 0x1a, 0x68, 0xfc, 0x8d, 0xf6, 0x1d, 0x05, 0x9a, 0xcb, 0x0c, 0xcd, 0x25,
-	0xb4, 0x10, 0xbc, 0x17, 0x3c, 0x82, 0x5c, 0x52, 0x54, 0x53, 0x66, 0x5f, 0xe4, 0xf7, 0x09, 0x41,
-	0xff, 0x94, 0x20, 0x11, 0xa2, 0xbe, 0xa1, 0xe5, 0x48, 0x17, 0x60, 0xb0, 0xf8, 0x19, 0x5f, 0xe9,
-	0x2e, 0x88, 0x80, 0xba, 0xc4, 0xa0, 0xbe, 0x8e, 0x5e, 0x6b, 0xf7, 0x2a, 0x75, 0xd0, 0x13, 0xf4,
-	0x4c, 0x82, 0x78, 0x6b, 0x61, 0x2e, 0xe4, 0x51, 0x19, 0xaa, 0xfb, 0x85, 0xbc, 0x14, 0xc2, 0x15,
-	0x41, 0xbc, 0xcc, 0xe0, 0x2d, 0xa1, 0xb7, 0xc2, 0xae, 0x0c, 0xb9, 0xb5, 0x70, 0x88, 0xfe, 0x23,
-	0x41, 0x22, 0x44, 0x9e, 0x0b, 0xd9, 0xd2, 0x68, 0xea, 0x60, 0xc8, 0x96, 0x46, 0x54, 0x08, 0xf1,
-	0x1d, 0x86, 0xf9, 0x1d, 0xb4, 0x1e, 0xbc, 0xa5, 0xec, 0x9e, 0x79, 0x94, 0x6a, 0x89, 0x5b, 0x66,
-	0xd2, 0x3a, 0xbf, 0x8d, 0xbe, 0x17, 0x83, 0xd3, 0xa1, 0xba, 0x1c, 0x5a, 0x8d, 0x9e, 0x7e, 0x80,
-	0x7e, 0x18, 0x5f, 0xeb, 0x36, 0x8c, 0xe0, 0x21, 0xcf, 0x78, 0xf8, 0x00, 0xbd, 0x1f, 0xcc, 0x43,
-	0x9d, 0x00, 0xf9, 0xa8, 0x25, 0x2f, 0x6c, 0xd8, 0x92, 0xa9, 0x21, 0x2b, 0x7c, 0x31, 0x79, 0x8f,
-	0x81, 0xfe, 0x87, 0x04, 0xa7, 0x82, 0x54, 0x41, 0x74, 0xad, 0xbd, 0x1a, 0x3e, 0x28, 0x3c, 0xc6,
-	0xd3, 0x5d, 0x44, 0x10, 0x5c, 0xac, 0x32, 0x2e, 0xae, 0xa2, 0xa5, 0xf6, 0xfb, 0xc0, 0x8f, 0xe5,
-	0xdf, 0x12, 0x4c, 0x06, 0xeb, 0x83, 0x28, 0x13, 0x98, 0x6c, 0x24, 0x71, 0x32, 0xbe, 0xdc, 0x55,
-	0x0c, 0x01, 0xf9, 0x36, 0x83, 0xbc, 0x8e, 0xae, 0x47, 0x6a, 0x03, 0xd3, 0x0b, 0x2a, 0x2b, 0x3c,
-	0x2a, 0x7f, 0x1c, 0xf8, 0x9a, 0xe0, 0xab, 0x31, 0x48, 0x84, 0x68, 0x88, 0xa8, 0xc3, 0xcc, 0xeb,
-	0x54, 0xcc, 0x90, 0x63, 0x20, 0xa2, 0x8c, 0x89, 0x37, 0x19, 0xfe, 0x5b, 0xe8, 0x9d, 0x88, 0x27,
-	0x7b, 0x20, 0x03, 0xc2, 0x0a, 0xfd, 0x59, 0x82, 0x89, 0x96, 0x62, 0x64, 0x88, 0xbc, 0x16, 0xa6,
-	0x74, 0xc6, 0xaf, 0x74, 0xea, 0xde, 0xd6, 0x23, 0xc4, 0x29, 0xf2, 0x16, 0x58, 0xad, 0xcc, 0xbd,
-	0x27, 0xcf, 0x26, 0xa5, 0xa7, 0xcf, 0x26, 0xa5, 0xbf, 0x3e, 0x9b, 0x94, 0x1e, 0x3f, 0x9f, 0x3c,
-	0xf4, 0xf4, 0xf9, 0xe4, 0xa1, 0x3f, 0x3e, 0x9f, 0x3c, 0xf4, 0xde, 0xb2, 0xef, 0x7f, 0x68, 0x45,
-	0xf8, 0xb9, 0xa2, 0xb2, 0x6d, 0x79, 0x6b, 0xed, 0x2d, 0xbc, 0x99, 0xda, 0xaf, 0x5b, 0x31, 0x57,
-	0x54, 0x89, 0x4e, 0xf9, 0x5f, 0xeb, 0xf2, 0xbf, 0xb2, 0xe8, 0x63, 0xff, 0x2c, 0xfe, 0x37, 0x00,
-	0x00, 0xff, 0xff, 0x4d, 0xde, 0x96, 0x83, 0xfc, 0x2c, 0x00, 0x00,
+	// 3118 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0x5d, 0x6c, 0x1c, 0x57,
+	0xf5, 0xcf, 0xac, 0x1d, 0xc7, 0x3e, 0x8e, 0x1d, 0xe7, 0x26, 0xb1, 0xd7, 0x93, 0xc4, 0xeb, 0x5c,
+	0xa7, 0x8e, 0xd3, 0xc4, 0xbb, 0xb1, 0x93, 0x34, 0x69, 0xda, 0xc4, 0xd9, 0xb5, 0x9d, 0xc4, 0xff,
+	0xa6, 0xff, 0x38, 0xeb, 0xd0, 0x42, 0x69, 0x3b, 0x1a, 0xef, 0xde, 0x38, 0x23, 0xef, 0xcc, 0x6c,
+	0x66, 0xee, 0xba, 0xb6, 0xaa, 0x48, 0x80, 0x54, 0xc1, 0x13, 0x2a, 0x14, 0xa9, 0x48, 0x80, 0xaa,
+	0x22, 0x78, 0x81, 0x07, 0x24, 0xc4, 0x0b, 0x2f, 0x20, 0x2a, 0x24, 0x2a, 0x24, 0x50, 0x50, 0x5f,
+	0x10, 0x12, 0x0b, 0x4a, 0x90, 0x40, 0x80, 0x78, 0x58, 0xde, 0x78, 0x01, 0xcd, 0xbd, 0x77, 0x66,
+	0xbf, 0xe7, 0x63, 0x37, 0x05, 0xc4, 0x53, 0xec, 0x7b, 0xcf, 0x39, 0xf7, 0xfc, 0xce, 0x3d, 0xe7,
+	0x9e, 0x3b, 0xf7, 0x17, 0xc3, 0x09, 0xd3, 0xd6, 0x4d, 0x5b, 0xb3, 0x53, 0x45, 0xd3, 0x2c, 0xe8,
+	0xaa, 0xa1, 0x6e, 0x10, 0x2b, 0xb5, 0x35, 0xb7, 0x4e, 0xa8, 0x3a, 0x97, 0xba, 0x5f, 0x22, 0xd6,
+	0x4e, 0xb2, 0x68, 0x99, 0xd4, 0x44, 0x87, 0x85, 0x60, 0xb2, 0x46, 0x30, 0x29, 0x04, 0xe5, 0x83,
+	0x1b, 0xe6, 0x86, 0xc9, 0xe4, 0x52, 0xce, 0x4f, 0x5c, 0x45, 0x3e, 0xe9, 0x67, 0x7b, 0x83, 0x18,
+	0x84, 0x99, 0x63, 0xa2, 0xc7, 0xfd, 0x44, 0xe9, 0xb6, 0x90, 0x3a, 0xed, 0x27, 0x65, 0xbf, 0xa1,
+	0x16, 0x15, 0xcb, 0x2c, 0x51, 0x22, 0xa4, 0xe7, 0x7c, 0x6d, 0xaa, 0x9b, 0xc4, 0x52, 0xee, 0x12,
+	0xa2, 0xd8, 0xf7, 0x54, 0xcb, 0x55, 0x39, 0xe3, 0xab, 0x62, 0xa9, 0xb9, 0x4d, 0x92, 0x57, 0xb6,
+	0xcc, 0x42, 0x49, 0x77, 0x35, 0x26, 0x72, 0x4c, 0x25, 0xb5, 0xae, 0xda, 0xc4, 0x93, 0xcc, 0x99,
+	0x9a, 0x21, 0xe6, 0x9f, 0xae, 0x9d, 0x67, 0xf1, 0xf4, 0xa4, 0x8a, 0xea, 0x86, 0x66, 0xa8, 0x54,
+	0x33, 0x5d, 0xd9, 0x23, 0x1b, 0xa6, 0xb9, 0x51, 0x20, 0x29, 0xb5, 0xa8, 0xa5, 0x54, 0xc3, 0x30,
+	0x29, 0x9b, 0x74, 0x43, 0x34, 0x2e, 0x66, 0xd9, 0x6f, 0xeb, 0xa5, 0xbb, 0x29, 0xd5, 0xd8, 0x71,
+	0xa7, 0xf8, 0x22, 0x0a, 0xdf, 0x01, 0xfe, 0x8b, 0x98, 0x4a, 0x34, 0x6a, 0x51, 0x4d, 0x27, 0x36,
+	0x55, 0xf5, 0x22, 0x17, 0xc0, 0xfb, 0x60, 0x68, 0x55, 0xb5, 0x54, 0xdd, 0xce, 0x92, 0xfb, 0x25,
+	0x62, 0x53, 0xbc, 0x06, 0xc3, 0xee, 0x80, 0x5d, 0x34, 0x0d, 0x9b, 0xa0, 0x34, 0xf4, 0x15, 0xd9,
+	0x48, 0x5c, 0x9a, 0x94, 0x66, 0x06, 0xe7, 0xa7, 0x92, 0x3e, 0xb9, 0x90, 0xe4, 0xca, 0x99, 0xde,
+	0x0f, 0xcb, 0x89, 0x5d, 0x59, 0xa1, 0x88, 0xbf, 0x11, 0x83, 0xc9, 0x65, 0x9b, 0x6a, 0xba, 0x4a,
+	0xc9, 0xda, 0x1b, 0x6a, 0x71, 0x79, 0x5b, 0xcd, 0xd1, 0xb4, 0x6e, 0x96, 0x0c, 0xba, 0x62, 0x88,
+	0x95, 0xd1, 0x49, 0xe8, 0xb3, 0x89, 0x91, 0x27, 0x16, 0x5b, 0x67, 0x20, 0xb3, 0xbf, 0x52, 0x4e,
+	0x0c, 0xed, 0xa8, 0x7a, 0xe1, 0x12, 0xe6, 0xe3, 0x38, 0x2b, 0x04, 0xd0, 0x02, 0xec, 0x71, 0xd6,
+	0x56, 0xb4, 0x7c, 0x3c, 0x36, 0x29, 0xcd, 0xf4, 0x66, 0xa6, 0x2b, 0xe5, 0xc4, 0x24, 0x97, 0x15,
+	0x13, 0xf8, 0x74, 0x9e, 0x14, 0x2d, 0x92, 0x53, 0x29, 0xc9, 0x5f, 0xc2, 0xd4, 0x2a, 0x11, 0x1c,
+	0x97, 0xb2, 0x7d, 0xce, 0xec, 0x4a, 0x1e, 0x25, 0xa1, 0x9f, 0x9a, 0x9b, 0xc4, 0x50, 0x34, 0x23,
+	0xde, 0xc3, 0x56, 0x3b, 0x50, 0x29, 0x27, 0xf6, 0x71, 0x0b, 0xee, 0x0c, 0xce, 0xee, 0x61, 0x3f,
+	0xae, 0x18, 0xe8, 0x35, 0xe8, 0x63, 0xb9, 0x65, 0xc7, 0x7b, 0x27, 0x7b, 0x66, 0x06, 0xe7, 0x93,
+	0xbe, 0x31, 0x70, 0x20, 0x7a, 0xe8, 0x1c, 0xb5, 0xcc, 0x21, 0x27, 0x1c, 0x55, 0x3c, 0xdc, 0x16,
+	0xce, 0x0a, 0xa3, 0xf8, 0x47, 0x31, 0x98, 0x6f, 0x1b, 0x9f, 0x97, 0x35, 0x7a, 0x6f, 0xd5, 0xd2,
+	0x74, 0x8d, 0x6a, 0x5b, 0xe4, 0xce, 0x4e, 0x91, 0xb8, 0x7b, 0x55, 0x1b, 0x06, 0xa9, 0xeb, 0x30,
+	0xc4, 0x42, 0x84, 0x61, 0x01, 0x86, 0xb9, 0xc7, 0x8a, 0xbb, 0x6e, 0xcf, 0x64, 0xcf, 0x4c, 0x6f,
+	0x66, 0xbc, 0x52, 0x4e, 0x1c, 0xaa, 0x85, 0xe6, 0xce, 0xe3, 0xec, 0x5e, 0x3e, 0xb0, 0xca, 0x17,
+	0x7c, 0x09, 0x46, 0x85, 0x00, 0xb7, 0x6e, 0x96, 0xa8, 0x92, 0x27, 0x86, 0xa9, 0xb3, 0xb8, 0x0e,
+	0x64, 0x8e, 0x55, 0xca, 0x89, 0xa3, 0x75, 0x86, 0x1a, 0xe4, 0x70, 0xf6, 0x00, 0x9f, 0xb8, 0xe3,
+	0x8c, 0xdf, 0x2a, 0xd1, 0x25, 0x36, 0xfa, 0x0b, 0x09, 0x9e, 0xf6, 0x02, 0xa8, 0x19, 0x1b, 0x05,
+	0xe2, 0x2c, 0xd8, 0x36, 0xd5, 0x4e, 0x35, 0x06, 0x0e, 0x55, 0xca, 0x89, 0xe1, 0xfa, 0xc0, 0x75,
+	0x1c, 0xa4, 0x0c, 0xec, 0x6b, 0x04, 0xc7, 0x53, 0x4c, 0xae, 0x94, 0x13, 0xa3, 0xb5, 0x6a, 0x35,
+	0xa8, 0x86, 0x68, 0x1d, 0x9e, 0xcf, 0x4b, 0x70, 0xcc, 0xa7, 0x60, 0x44, 0x65, 0xae, 0xc3, 0x48,
+	0xd5, 0x90, 0xca, 0x66, 0x45, 0xed, 0x5c, 0x74, 0xf2, 0xed, 0x37, 0xe5, 0xc4, 0x21, 0x7e, 0x1a,
+	0xd8, 0xf9, 0xcd, 0xa4, 0x66, 0xa6, 0x74, 0x95, 0xde, 0x4b, 0xae, 0x18, 0xb4, 0x52, 0x4e, 0x8c,
+	0x35, 0xfa, 0xc1, 0xd5, 0x71, 0x76, 0xd8, 0x75, 0x84, 0xaf, 0x86, 0xbf, 0x19, 0x6b, 0xeb, 0xc9,
+	0xad, 0x12, 0xfd, 0x4f, 0xd4, 0xee, 0xeb, 0x5e, 0x2d, 0xf6, 0xb0, 0x5a, 0x4c, 0x85, 0xac, 0x45,
+	0xc7, 0xdd, 0x10, 0xc5, 0x88, 0xe6, 0x60, 0xc0, 0x0b, 0x4b, 0xbc, 0x97, 0xc1, 0x39, 0x58, 0x29,
+	0x27, 0x46, 0x1a, 0x22, 0x86, 0xb3, 0xfd, 0x6e, 0xa8, 0xf0, 0x8f, 0x63, 0x70, 0xb6, 0x7d, 0x90,
+	0x3e, 0xc6, 0x02, 0x6e, 0x2e, 0xc8, 0x58, 0xb4, 0x82, 0x5c, 0x83, 0x43, 0x75, 0x85, 0xa6, 0x19,
+	0x5e, 0xca, 0x3a, 0xf5, 0x38, 0x59, 0x29, 0x27, 0x8e, 0xb4, 0xa8, 0x47, 0x57, 0x0c, 0x67, 0x51,
+	0x4d, 0x39, 0xae, 0x18, 0x2c, 0x7b, 0x3b, 0x89, 0xe0, 0x2f, 0x25, 0x38, 0x15, 0x58, 0xc0, 0x35,
+	0x09, 0x17, 0xa9, 0x82, 0x17, 0x60, 0xb8, 0x01, 0x1d, 0xaf, 0xe3, 0x9a, 0x28, 0x35, 0xc2, 0xda,
+	0x4b, 0xdb, 0x02, 0xea, 0x09, 0x05, 0xe8, 0x2d, 0x09, 0xb0, 0x5f, 0xdd, 0x88, 0x12, 0x56, 0xdc,
+	0xc3, 0x42, 0x33, 0xea, 0x2b, 0xf8, 0x42, 0x50, 0x05, 0x8f, 0x36, 0x38, 0xee, 0x16, 0xf0, 0x90,
+	0xf0, 0x5c, 0xd4, 0xef, 0x7e, 0xd8, 0xf7, 0xff, 0x25, 0xdd, 0x09, 0xa6, 0xd7, 0xe2, 0x97, 0x61,
+	0xa4, 0x3a, 0x24, 0xfc, 0x98, 0x83, 0x01, 0xa3, 0xa4, 0xb3, 0x2c, 0xb1, 0x45, 0x44, 0x6b, 0x10,
+	0x7a, 0x53, 0x38, 0xdb, 0x6f, 0x08, 0x55, 0x7c, 0x09, 0x06, 0x9d, 0x1f, 0x3a, 0xd9, 0x11, 0xbc,
+	0x08, 0x7b, 0xb9, 0xae, 0x58, 0xfe, 0x2c, 0xf4, 0x3a, 0x33, 0xe2, 0x86, 0x71, 0x30, 0xc9, 0xaf,
+	0x2d, 0x49, 0xf7, 0xda, 0x92, 0x4c, 0x1b, 0x3b, 0x99, 0x81, 0x9f, 0xff, 0x60, 0x76, 0x37, 0x4b,
+	0xdb, 0x2c, 0x13, 0x76, 0xa0, 0xa5, 0x0b, 0x85, 0x3a, 0x68, 0x2b, 0x30, 0x52, 0x1d, 0x12, 0xb6,
+	0xcf, 0xc3, 0x6e, 0x17, 0x56, 0x4f, 0x18, 0xe3, 0x5c, 0x1a, 0xa7, 0x61, 0xec, 0xa6, 0x66, 0x53,
+	0x66, 0x2b, 0xb3, 0xc3, 0xf2, 0xc0, 0x85, 0x3a, 0x0d, 0xbb, 0x79, 0x1a, 0xf1, 0xad, 0x1a, 0xa9,
+	0x94, 0x13, 0x7b, 0x39, 0x50, 0x91, 0x3d, 0x7c, 0x1a, 0xdf, 0x86, 0x78, 0xb3, 0x89, 0xee, 0xbc,
+	0x7a, 0x28, 0xc1, 0xc8, 0x5a, 0xd1, 0xa4, 0xab, 0x96, 0x96, 0x23, 0x1d, 0x15, 0xc3, 0x32, 0x8c,
+	0x38, 0xb7, 0x51, 0x45, 0xb5, 0x6d, 0x42, 0xeb, 0xca, 0xe1, 0x70, 0xb5, 0x2f, 0x34, 0x4a, 0xe0,
+	0xec, 0xb0, 0x33, 0x94, 0x76, 0x46, 0x78, 0x49, 0xdc, 0x80, 0xfd, 0xf7, 0x4b, 0x26, 0xad, 0xb7,
+	0xc3, 0x4b, 0xe3, 0x48, 0xa5, 0x9c, 0x88, 0x73, 0x3b, 0x4d, 0x22, 0x38, 0xbb, 0x8f, 0x8d, 0x55,
+	0x2d, 0xe1, 0x15, 0xd8, 0x5f, 0x83, 0x48, 0x84, 0xe7, 0x1c, 0x80, 0x5d, 0x34, 0xa9, 0x52, 0x74,
+	0x46, 0x45, 0x9c, 0x0f, 0x55, 0xca, 0x89, 0xfd, 0xdc, 0x6e, 0x75, 0x0e, 0x67, 0x07, 0x6c, 0x57,
+	0x1b, 0xdf, 0x80, 0xf1, 0x3b, 0x26, 0x55, 0x59, 0x02, 0xdc, 0xd4, 0xee, 0x97, 0xb4, 0xbc, 0x46,
+	0x77, 0x3a, 0x4a, 0xd0, 0xaf, 0x49, 0x20, 0xb7, 0x32, 0x25, 0xdc, 0x7b, 0x00, 0x03, 0x05, 0x77,
+	0x50, 0xec, 0xe0, 0x78, 0x52, 0xdc, 0xbc, 0x9d, 0x40, 0x79, 0xed, 0x67, 0xd1, 0xd4, 0x8c, 0xcc,
+	0x92, 0x68, 0x38, 0xa2, 0x9a, 0x3c, 0x4d, 0xfc, 0x9d, 0xdf, 0x25, 0x66, 0x36, 0x34, 0x7a, 0xaf,
+	0xb4, 0x9e, 0xcc, 0x99, 0xba, 0xb8, 0xba, 0x8b, 0x7f, 0x66, 0xed, 0xfc, 0x66, 0x8a, 0x3a, 0xdd,
+	0x82, 0x19, 0xb1, 0xb3, 0xd5, 0x15, 0xf1, 0x18, 0x1c, 0x62, 0xce, 0x35, 0x62, 0xc4, 0xef, 0x4a,
+	0x30, 0xda, 0x38, 0xf3, 0xdf, 0xe1, 0xb2, 0xbb, 0x35, 0x2f, 0xb1, 0xcf, 0xa7, 0x6b, 0xa6, 0xd5,
+	0xf1, 0xd9, 0xf1, 0x65, 0x77, 0x6b, 0x1a, 0x4c, 0x09, 0x9c, 0x14, 0xfa, 0xf8, 0x27, 0x5a, 0x30,
+	0xc8, 0x74, 0xfd, 0x45, 0x80, 0xab, 0x45, 0x43, 0x28, 0xd6, 0xc2, 0x5b, 0x20, 0xdf, 0xb1, 0xd4,
+	0xbc, 0x66, 0x6c, 0xac, 0xaa, 0x9a, 0x75, 0xc7, 0xf9, 0xbc, 0xbc, 0x46, 0x6a, 0x0b, 0x94, 0x65,
+	0xbf, 0x72, 0x46, 0xa4, 0x72, 0x0d, 0x3e, 0x31, 0x81, 0xb3, 0x7d, 0xec, 0xa7, 0x33, 0x55, 0xe1,
+	0xb9, 0x78, 0xac, 0xb5, 0xf0, 0x9c, 0x2b, 0x3c, 0x87, 0x15, 0x38, 0xdc, 0x72, 0x5d, 0x11, 0x8c,
+	0xab, 0x30, 0xe0, 0x7d, 0xea, 0x8a, 0xa5, 0xa7, 0x44, 0x63, 0x39, 0xdc, 0xdc, 0x58, 0x6e, 0x92,
+	0x0d, 0x35, 0xb7, 0xb3, 0x44, 0x72, 0xd9, 0x7e, 0x2a, 0x2c, 0x39, 0x9f, 0x26, 0xd3, 0x6e, 0x1f,
+	0x73, 0x56, 0x22, 0x19, 0xd5, 0x26, 0xf9, 0x5b, 0x06, 0x2b, 0xb8, 0x15, 0xbd, 0xa8, 0xe6, 0xbc,
+	0x9e, 0xfc, 0x3c, 0x0c, 0xdc, 0xb5, 0x4c, 0x5d, 0x71, 0xbe, 0x7f, 0xc5, 0x49, 0xee, 0x13, 0x7c,
+	0xfe, 0x85, 0xd8, 0xef, 0x68, 0x38, 0xbf, 0x23, 0x0c, 0x43, 0xd4, 0x64, 0xba, 0xb5, 0x87, 0x52,
+	0x76, 0x90, 0x9a, 0xce, 0x34, 0x3f, 0x74, 0xc6, 0xaa, 0x79, 0xe2, 0x1c, 0x35, 0xbd, 0xde, 0xa1,
+	0xf6, 0x22, 0x8c, 0xe8, 0xea, 0x36, 0x3f, 0x11, 0x14, 0x8d, 0x79, 0x15, 0xef, 0x0d, 0x0f, 0x77,
+	0x58, 0x57, 0xb7, 0x6b, 0x00, 0xa1, 0xff, 0x83, 0x61, 0xb2, 0x4d, 0x89, 0x65, 0xa8, 0x05, 0x71,
+	0x02, 0xed, 0x0e, 0x6f, 0x6c, 0xc8, 0x55, 0xe5, 0x67, 0xd2, 0x77, 0x25, 0x38, 0x11, 0x18, 0x40,
+	0xb1, 0x5d, 0x57, 0x00, 0x34, 0xa3, 0x58, 0xa2, 0x91, 0x42, 0x38, 0xc0, 0x54, 0x58, 0x0c, 0xaf,
+	0xc2, 0xa0, 0x59, 0xa2, 0x9e, 0x81, 0x58, 0x38, 0x03, 0xc0, 0x75, 0x9c, 0x11, 0x3c, 0x05, 0xc7,
+	0xd2, 0x85, 0x82, 0x9b, 0x47, 0x6b, 0xce, 0xe3, 0x48, 0x7a, 0xc3, 0x22, 0x44, 0x27, 0x06, 0xf5,
+	0xba, 0xec, 0xd7, 0x25, 0xc0, 0x7e, 0x52, 0x02, 0xcd, 0x16, 0xc8, 0x0d, 0xef, 0x2c, 0x8a, 0xea,
+	0x49, 0x89, 0xea, 0x3c, 0xeb, 0x7b, 0x79, 0x6f, 0xbd, 0x82, 0x70, 0x7b, 0x8c, 0xb6, 0x5e, 0x1f,
+	0x5f, 0x81, 0xe9, 0xd6, 0x8a, 0xd7, 0x2c, 0x53, 0xaf, 0x6b, 0xe4, 0x07, 0xeb, 0x1a, 0xb9, 0xdb,
+	0xb6, 0xdf, 0x93, 0xe0, 0x44, 0xa0, 0x01, 0xef, 0xb4, 0x19, 0x6f, 0x8b, 0x51, 0x6c, 0x60, 0x17,
+	0x10, 0x47, 0x5b, 0x43, 0xc4, 0x77, 0x61, 0xa6, 0x4e, 0x8f, 0xf9, 0x64, 0xdf, 0x31, 0xd3, 0xb9,
+	0x9c, 0x55, 0x22, 0xf9, 0x97, 0xd4, 0x42, 0x89, 0xf8, 0x62, 0x44, 0xc7, 0x61, 0xc8, 0xb5, 0xbd,
+	0x54, 0x53, 0x6d, 0xf5, 0x83, 0xd8, 0x86, 0x93, 0x21, 0xd6, 0x11, 0xa1, 0xb8, 0x06, 0x7d, 0x75,
+	0x37, 0xd8, 0x64, 0xd0, 0x0d, 0x56, 0x1c, 0xbb, 0xee, 0xc5, 0x55, 0x68, 0xe3, 0xa7, 0x60, 0xaa,
+	0x29, 0xb9, 0x72, 0xb9, 0x92, 0x5e, 0x2a, 0xa8, 0xd4, 0xb4, 0xbc, 0x24, 0x7c, 0x5f, 0x82, 0xe3,
+	0xfe, 0x72, 0xc2, 0xaf, 0x1d, 0x38, 0x5c, 0xb3, 0x45, 0x9b, 0x9a, 0xae, 0xa8, 0x35, 0x62, 0x22,
+	0x0f, 0xcf, 0x85, 0xdb, 0xa4, 0x4d, 0x4d, 0xaf, 0x59, 0x43, 0xec, 0x52, 0x9c, 0xb6, 0x9e, 0xb6,
+	0xf1, 0x65, 0x78, 0x2a, 0x4b, 0x36, 0x34, 0x9b, 0x12, 0x8b, 0xe4, 0xd3, 0x85, 0x82, 0xb9, 0x43,
+	0xf2, 0x4e, 0xb3, 0x0a, 0x99, 0x88, 0xef, 0x48, 0x30, 0x1d, 0xa4, 0x2f, 0x40, 0x6a, 0x30, 0x9c,
+	0x33, 0x0d, 0xe7, 0x8d, 0x92, 0x2a, 0x36, 0x55, 0x29, 0x11, 0xc9, 0xf7, 0xbc, 0x2f, 0x2e, 0x66,
+	0x72, 0x51, 0xe8, 0xd5, 0x45, 0x72, 0xcd, 0xb1, 0x21, 0xf0, 0x0d, 0xb9, 0x96, 0xd9, 0x20, 0x4e,
+	0xfb, 0x38, 0xc5, 0xbf, 0x2a, 0x5d, 0x54, 0x63, 0x0d, 0x6d, 0xdd, 0x6b, 0xe1, 0x5f, 0x91, 0xe0,
+	0x44, 0xa0, 0x8d, 0x7f, 0x3f, 0x32, 0x0c, 0x93, 0xe9, 0x42, 0xa1, 0xa5, 0x63, 0x5e, 0xda, 0xbd,
+	0x2d, 0xc1, 0x31, 0x1f, 0x21, 0xe1, 0xf4, 0x26, 0xec, 0xab, 0x77, 0xda, 0xcd, 0xb3, 0x27, 0xe1,
+	0xf5, 0x70, 0x9d, 0xd7, 0x36, 0xfe, 0x40, 0x02, 0x9c, 0x21, 0x36, 0x5d, 0x2b, 0x16, 0x34, 0xfe,
+	0xc4, 0xd1, 0xf2, 0xd1, 0xeb, 0x52, 0xcd, 0x3b, 0x56, 0xc8, 0xd6, 0xe2, 0xbd, 0x69, 0x4d, 0x37,
+	0xbf, 0x69, 0xb9, 0x07, 0x46, 0xed, 0xbb, 0x15, 0x1a, 0x87, 0x7e, 0xa7, 0x0f, 0xdf, 0x33, 0x8b,
+	0xb6, 0xe8, 0xd0, 0x7b, 0x74, 0x75, 0xfb, 0x86, 0x59, 0xb4, 0xd1, 0x51, 0x00, 0x67, 0xca, 0x76,
+	0x9c, 0xb4, 0x59, 0x73, 0xee, 0xcd, 0x0e, 0xe8, 0xea, 0x36, 0xf3, 0xda, 0xc6, 0x3f, 0x91, 0x60,
+	0xca, 0x17, 0x84, 0x88, 0xec, 0x6d, 0xef, 0xf5, 0x27, 0x4c, 0x03, 0xa9, 0x7d, 0x89, 0xad, 0x5a,
+	0x76, 0x5f, 0xa7, 0xb9, 0x21, 0x74, 0xbd, 0xc5, 0x33, 0x1a, 0xbf, 0x79, 0x1d, 0xf5, 0x3d, 0xc2,
+	0x9a, 0xde, 0xca, 0x3e, 0x23, 0x41, 0xdc, 0xc9, 0x03, 0x7e, 0x31, 0xcd, 0x94, 0x72, 0x9b, 0xc4,
+	0x6b, 0x9a, 0xd1, 0x3e, 0xd2, 0x2e, 0xc0, 0xa0, 0xf3, 0xcd, 0xbd, 0xce, 0x4d, 0x88, 0x87, 0xb2,
+	0xd1, 0x4a, 0x39, 0x81, 0xaa, 0x1f, 0xe4, 0x62, 0x12, 0x67, 0xc1, 0x28, 0xe9, 0x62, 0x31, 0xfc,
+	0x37, 0x09, 0xc6, 0x5b, 0xb8, 0x20, 0x82, 0xb7, 0x02, 0x7b, 0x5c, 0x93, 0x3c, 0x7a, 0x27, 0x7d,
+	0xa3, 0x57, 0x6b, 0xc4, 0xcd, 0x08, 0xa1, 0x8f, 0xde, 0x92, 0x60, 0x2f, 0x35, 0xa9, 0x5a, 0x10,
+	0x84, 0x08, 0x7b, 0x78, 0xf2, 0x4d, 0xa9, 0xeb, 0xe2, 0xb6, 0x7d, 0xc0, 0x7d, 0xb8, 0xa8, 0x2a,
+	0x47, 0xbb, 0x73, 0x0f, 0xd2, 0xea, 0xed, 0x1f, 0x7f, 0x5f, 0x82, 0xa3, 0x2c, 0xf7, 0x9c, 0xfb,
+	0x6f, 0xbb, 0xc0, 0x7f, 0x3c, 0x97, 0xef, 0xc6, 0x5d, 0xea, 0x09, 0xbd, 0x4b, 0x7f, 0x97, 0x60,
+	0xa2, 0x9d, 0xd3, 0xff, 0xbb, 0x5b, 0x75, 0x1d, 0xc6, 0xdc, 0x33, 0x6d, 0x49, 0xb3, 0x73, 0x4e,
+	0xc9, 0xb8, 0x7b, 0x74, 0x1a, 0xf6, 0xa8, 0xf9, 0xbc, 0x45, 0x6c, 0xbb, 0x79, 0x8f, 0xc4, 0x04,
+	0xce, 0xba, 0x22, 0xf8, 0x23, 0x09, 0xe2, 0xcd, 0x96, 0xbc, 0x5b, 0xe7, 0x98, 0xed, 0x34, 0xe4,
+	0xbc, 0x62, 0x5a, 0x4a, 0xc1, 0x64, 0x9c, 0x5d, 0xdd, 0xbd, 0x64, 0x21, 0xe8, 0x5e, 0x32, 0xc1,
+	0xd7, 0x6d, 0x63, 0x05, 0x67, 0x0f, 0xf2, 0x99, 0x5b, 0xd6, 0x4d, 0x36, 0xce, 0x8b, 0x1f, 0x2d,
+	0x40, 0x7f, 0x5e, 0xf8, 0x12, 0x8f, 0x85, 0xff, 0x5a, 0xf0, 0x94, 0xe6, 0xbf, 0x75, 0x06, 0x76,
+	0xdf, 0x76, 0x28, 0x42, 0xf4, 0x45, 0x09, 0xfa, 0x38, 0x8f, 0x86, 0x9e, 0x0e, 0x41, 0xb6, 0x89,
+	0x20, 0xca, 0xa7, 0x42, 0xc9, 0xf2, 0x30, 0xe1, 0x53, 0x9f, 0xfb, 0xe8, 0x0f, 0xef, 0xc4, 0x9e,
+	0x42, 0x53, 0x29, 0x3f, 0xd2, 0x53, 0x78, 0xf1, 0x27, 0x09, 0xc6, 0xdb, 0xd2, 0x11, 0xe8, 0xb2,
+	0xef, 0xba, 0x41, 0xbc, 0x9f, 0x7c, 0xa5, 0x53, 0x75, 0x81, 0xe4, 0x26, 0x43, 0x72, 0x0d, 0x2d,
+	0xf9, 0x22, 0x79, 0x53, 0x9c, 0xb1, 0x0f, 0x52, 0x44, 0x58, 0xe4, 0x94, 0x31, 0x71, 0x6c, 0x8a,
+	0xad, 0x55, 0x34, 0x03, 0xbd, 0x1f, 0x83, 0x53, 0x6d, 0xd7, 0x6c, 0x7e, 0xc9, 0x47, 0xb7, 0x3a,
+	0xf3, 0xbe, 0x2d, 0x27, 0xd0, 0x75, 0x38, 0x54, 0x16, 0x8e, 0x4f, 0xa3, 0x4f, 0x3d, 0x89, 0x70,
+	0x28, 0x6f, 0x68, 0xf4, 0x9e, 0x52, 0x74, 0x1d, 0x55, 0x58, 0x6d, 0xa3, 0x2f, 0xc4, 0x60, 0x2a,
+	0x04, 0xdb, 0x86, 0xae, 0x87, 0x83, 0x12, 0xc8, 0xd7, 0x75, 0x1d, 0x93, 0x4f, 0xb2, 0x98, 0x64,
+	0xd1, 0x6a, 0xe4, 0x98, 0x30, 0xdf, 0x38, 0x79, 0xd2, 0x32, 0x5d, 0xfe, 0x2a, 0x81, 0xdc, 0xfe,
+	0x99, 0x1f, 0x75, 0xe4, 0x78, 0x95, 0xe6, 0x90, 0x17, 0x3a, 0xd6, 0x17, 0xc8, 0x5f, 0x64, 0xc8,
+	0xaf, 0xa3, 0xe5, 0xee, 0xb3, 0xc1, 0x2c, 0x51, 0xf4, 0xed, 0x18, 0x9c, 0x8e, 0x42, 0x74, 0xa1,
+	0xd5, 0x0e, 0x01, 0xb4, 0xaf, 0x8f, 0xae, 0x43, 0xb2, 0xce, 0x42, 0xf2, 0x2a, 0x7a, 0xe5, 0x89,
+	0x84, 0xa4, 0x75, 0x85, 0xbc, 0x1d, 0x83, 0xe3, 0x61, 0xe8, 0x2c, 0x74, 0xa3, 0xbb, 0x12, 0x79,
+	0x92, 0xa9, 0xf2, 0x1a, 0x8b, 0xcb, 0xcb, 0xe8, 0x13, 0x11, 0xe3, 0xe2, 0x44, 0x21, 0xa0, 0x50,
+	0x9c, 0xd4, 0x79, 0x57, 0x82, 0x7e, 0x97, 0x76, 0x42, 0xa7, 0x7d, 0x9d, 0x6d, 0x20, 0xac, 0xe4,
+	0xd9, 0x90, 0xd2, 0x02, 0x48, 0x92, 0x01, 0x99, 0x41, 0xd3, 0xbe, 0x40, 0x3c, 0x4e, 0x0b, 0x7d,
+	0x49, 0x82, 0x5e, 0xc7, 0x02, 0x9a, 0xf1, 0x6f, 0xa0, 0xd5, 0x07, 0x6b, 0xf9, 0x64, 0x08, 0x49,
+	0xe1, 0xcd, 0x39, 0xe6, 0x4d, 0x12, 0x9d, 0xf6, 0xf5, 0x86, 0x79, 0x52, 0x0d, 0x2e, 0x8b, 0x96,
+	0xcb, 0x64, 0x05, 0x44, 0xab, 0x81, 0x03, 0x93, 0x67, 0x43, 0x4a, 0x47, 0x8a, 0x96, 0x5a, 0x28,
+	0xcc, 0xf2, 0x68, 0xfd, 0x50, 0x82, 0x91, 0x46, 0x56, 0x0b, 0xf9, 0x3f, 0x9f, 0xb4, 0xe1, 0xd1,
+	0xe4, 0xf3, 0x11, 0xb5, 0x84, 0xc7, 0x17, 0x99, 0xc7, 0xf3, 0xe8, 0x8c, 0xaf, 0xc7, 0x05, 0xcd,
+	0xa6, 0xdc, 0xe5, 0xd9, 0xf5, 0x9d, 0x59, 0xfe, 0xea, 0xf5, 0x9e, 0x04, 0x03, 0x1e, 0xd7, 0x84,
+	0xfc, 0x03, 0xd5, 0xc8, 0xb2, 0xc9, 0xc9, 0xb0, 0xe2, 0xc2, 0xcd, 0xb3, 0xcc, 0xcd, 0x59, 0x74,
+	0xaa, 0xa5, 0x9b, 0x0d, 0x1b, 0x9e, 0x62, 0xcf, 0xcc, 0x36, 0x7a, 0x28, 0x01, 0x6a, 0xe6, 0x9d,
+	0xd0, 0x33, 0xfe, 0xcf, 0x53, 0xed, 0x38, 0x2f, 0xf9, 0x42, 0x64, 0x3d, 0xe1, 0xfc, 0x0a, 0x73,
+	0x7e, 0x11, 0xa5, 0xa3, 0x64, 0x6d, 0x8a, 0x7f, 0x28, 0xb0, 0x5f, 0x3d, 0xe6, 0x07, 0x7d, 0x4f,
+	0x82, 0xe1, 0x7a, 0x4e, 0x0a, 0xcd, 0x07, 0xbb, 0xd5, 0x04, 0xe5, 0x6c, 0x24, 0x9d, 0x48, 0xc5,
+	0xc7, 0xdd, 0xae, 0x7a, 0xfc, 0xa1, 0xbb, 0x09, 0x75, 0x0c, 0x53, 0x98, 0x4d, 0x68, 0xc5, 0x6e,
+	0xc9, 0x17, 0x22, 0xeb, 0x09, 0xef, 0xd3, 0xcc, 0xfb, 0xe7, 0xd0, 0xb3, 0x1d, 0x6c, 0x02, 0xff,
+	0x5a, 0x43, 0x3f, 0x95, 0xe0, 0x40, 0x0b, 0x82, 0x08, 0x05, 0xf8, 0xd4, 0x96, 0xca, 0x92, 0x2f,
+	0x46, 0x57, 0x14, 0x68, 0x2e, 0x31, 0x34, 0xe7, 0xd0, 0x7c, 0x2a, 0xe0, 0xbf, 0x59, 0x3a, 0x16,
+	0x94, 0xa2, 0xaa, 0x59, 0x0a, 0x7b, 0x58, 0xbd, 0x4b, 0x08, 0xfa, 0x8b, 0x04, 0x89, 0x00, 0x12,
+	0x05, 0x2d, 0x86, 0x6a, 0x80, 0xfe, 0x1c, 0x96, 0xbc, 0xd4, 0x9d, 0x11, 0x01, 0xf5, 0x32, 0x83,
+	0x7a, 0x01, 0x9d, 0x8f, 0xda, 0x4a, 0x1d, 0xf4, 0x04, 0x3d, 0x92, 0x40, 0x6e, 0xcf, 0xaf, 0x04,
+	0x5c, 0x2a, 0x03, 0xe9, 0x1b, 0x79, 0xa1, 0x63, 0x7d, 0x01, 0x6f, 0x91, 0xc1, 0xbb, 0x8c, 0x9e,
+	0x0b, 0x6a, 0x19, 0x4a, 0x7b, 0xfe, 0x07, 0xfd, 0x53, 0x82, 0x44, 0x00, 0xcb, 0x12, 0xb0, 0xa5,
+	0xe1, 0x48, 0x1e, 0x79, 0xa9, 0x3b, 0x23, 0x02, 0xf3, 0x6d, 0x86, 0xf9, 0x05, 0xb4, 0xe2, 0xbf,
+	0xa5, 0xac, 0xcf, 0x3c, 0x48, 0xb5, 0xc5, 0xad, 0x30, 0x86, 0x94, 0x77, 0xa3, 0xaf, 0xc6, 0xe0,
+	0x58, 0x20, 0xbd, 0x82, 0x96, 0xc3, 0xbb, 0xef, 0x43, 0x03, 0xc9, 0xd7, 0xba, 0x35, 0x23, 0xe2,
+	0x90, 0x67, 0x71, 0x78, 0x1d, 0xbd, 0xea, 0x1f, 0x87, 0x3a, 0x1e, 0xe9, 0x41, 0xdb, 0xb8, 0xb0,
+	0x61, 0x5b, 0xa1, 0xa6, 0xa2, 0xf2, 0xc5, 0x94, 0x2d, 0x06, 0xfa, 0xcf, 0x12, 0x1c, 0xf1, 0x23,
+	0x77, 0xd0, 0xd5, 0x68, 0x39, 0xdc, 0xcc, 0x1f, 0xc9, 0xe9, 0x2e, 0x2c, 0x88, 0x58, 0x2c, 0xb3,
+	0x58, 0x2c, 0xa0, 0xcb, 0xd1, 0xeb, 0xa0, 0x16, 0xcb, 0x3f, 0x24, 0x98, 0xf0, 0xa7, 0x79, 0x50,
+	0xc6, 0xd7, 0xd9, 0x50, 0x1c, 0x93, 0xbc, 0xd8, 0x95, 0x0d, 0x01, 0xf9, 0x16, 0x83, 0xbc, 0x82,
+	0xae, 0x87, 0x2a, 0x03, 0xcb, 0x33, 0xaa, 0xa8, 0xdc, 0x2a, 0xbf, 0x1c, 0xd4, 0x14, 0xc1, 0x67,
+	0x63, 0x90, 0x08, 0xa0, 0x82, 0x50, 0x87, 0x9e, 0xd7, 0x91, 0x51, 0xf2, 0x52, 0x77, 0x46, 0x04,
+	0xfe, 0x35, 0x86, 0xff, 0x45, 0xf4, 0x42, 0xc8, 0x93, 0xdd, 0x37, 0x02, 0x42, 0x0a, 0xfd, 0x56,
+	0x82, 0xf1, 0xb6, 0x9c, 0x52, 0xc0, 0xf3, 0x5a, 0x10, 0x61, 0x25, 0x5f, 0xe9, 0x54, 0x3d, 0xd2,
+	0x25, 0xc4, 0x49, 0xf2, 0x36, 0x58, 0x6d, 0xf4, 0x47, 0x09, 0x0e, 0xfb, 0x70, 0x3b, 0xc8, 0xbf,
+	0x21, 0x05, 0x53, 0x5b, 0xf2, 0xd5, 0xce, 0x0d, 0x44, 0x2a, 0xe5, 0x75, 0x62, 0x53, 0xce, 0x60,
+	0xf1, 0x3f, 0x35, 0x69, 0x7a, 0x0e, 0xfa, 0x99, 0x04, 0xfb, 0x9b, 0xe8, 0x17, 0x74, 0x3e, 0xf0,
+	0x6b, 0xb1, 0x15, 0x71, 0x21, 0x3f, 0x13, 0x55, 0x2d, 0x52, 0x7b, 0x6e, 0xbc, 0x36, 0xf2, 0x0b,
+	0xa3, 0xcb, 0x57, 0xa0, 0x5f, 0x49, 0x30, 0xda, 0x9a, 0xa2, 0x40, 0x97, 0x7c, 0xfd, 0xf2, 0x25,
+	0x63, 0xe4, 0xe7, 0x3a, 0xd2, 0x15, 0xc0, 0xae, 0x30, 0x60, 0x17, 0xd1, 0x33, 0xbe, 0xc0, 0x38,
+	0x4d, 0xc3, 0xee, 0x8f, 0x0d, 0x98, 0x3e, 0x90, 0x60, 0xa4, 0x91, 0x37, 0x40, 0xe1, 0x98, 0xff,
+	0x06, 0xc2, 0x42, 0x3e, 0x1f, 0x51, 0x2b, 0x52, 0x31, 0x55, 0xbb, 0x85, 0x4b, 0x0e, 0xa4, 0xde,
+	0x14, 0xdc, 0xc7, 0x83, 0xcc, 0x6b, 0x1f, 0x3e, 0x9a, 0x90, 0x1e, 0x3e, 0x9a, 0x90, 0x7e, 0xff,
+	0x68, 0x42, 0x7a, 0xfb, 0xf1, 0xc4, 0xae, 0x87, 0x8f, 0x27, 0x76, 0xfd, 0xfa, 0xf1, 0xc4, 0xae,
+	0x57, 0x16, 0x6b, 0x68, 0x19, 0x61, 0x7e, 0xb6, 0xa0, 0xae, 0xdb, 0xde, 0x5a, 0x5b, 0xf3, 0xcf,
+	0xa6, 0xb6, 0xeb, 0x56, 0xcc, 0x15, 0x34, 0x62, 0x50, 0xfe, 0x97, 0x49, 0xfc, 0x7f, 0x9e, 0xf6,
+	0xb1, 0x7f, 0xce, 0xfe, 0x6b, 0x00, 0xab, 0x0c, 0x0b, 0xb4, 0x1a, 0x36, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2470,6 +2941,23 @@ type QueryClient interface {
 	// the current distribution composition of taker fee share denoms within the
 	// alloyed pool.
 	AllRegisteredAlloyedPools(ctx context.Context, in *AllRegisteredAlloyedPoolsRequest, opts ...grpc.CallOption) (*AllRegisteredAlloyedPoolsResponse, error)
+	// BestSplitRouteExactAmountIn returns the split route with the largest
+	// estimated amount out when swapping token_in for token_out_denom. Routes
+	// are searched among the pools containing each intermediary denom, up to
+	// max_hops pools per route, and token_in is split across up to max_splits
+	// routes that do not share pools. The returned routes can be used in
+	// MsgSplitRouteSwapExactAmountIn.
+	BestSplitRouteExactAmountIn(ctx context.Context, in *BestSplitRouteExactAmountInRequest, opts ...grpc.CallOption) (*BestSplitRouteExactAmountInResponse, error)
+	// PoolVolumeBuckets returns the OSMO-denominated volume of the given pool in
+	// the most recent volume buckets, each spanning one day epoch.
+	PoolVolumeBuckets(ctx context.Context, in *PoolVolumeBucketsRequest, opts ...grpc.CallOption) (*PoolVolumeBucketsResponse, error)
+	// DenomPairVolumeBuckets returns the OSMO-denominated volume swapped between
+	// the given denoms, in either direction, in the most recent volume buckets,
+	// each spanning one day epoch.
+	DenomPairVolumeBuckets(ctx context.Context, in *DenomPairVolumeBucketsRequest, opts ...grpc.CallOption) (*DenomPairVolumeBucketsResponse, error)
+	// TakerFeeDiscount returns the taker fee discount the given address gets
+	// from the amount of OSMO it has staked or locked.
+	TakerFeeDiscount(ctx context.Context, in *TakerFeeDiscountRequest, opts ...grpc.CallOption) (*TakerFeeDiscountResponse, error)
 }
 
 type queryClient struct {
@@ -2696,8 +3184,44 @@ func (c *queryClient) AllRegisteredAlloyedPools(ctx context.Context, in *AllRegi
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
+func (c *queryClient) BestSplitRouteExactAmountIn(ctx context.Context, in *BestSplitRouteExactAmountInRequest, opts ...grpc.CallOption) (*BestSplitRouteExactAmountInResponse, error) {
+	out := new(BestSplitRouteExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/BestSplitRouteExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolVolumeBuckets(ctx context.Context, in *PoolVolumeBucketsRequest, opts ...grpc.CallOption) (*PoolVolumeBucketsResponse, error) {
+	out := new(PoolVolumeBucketsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/PoolVolumeBuckets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomPairVolumeBuckets(ctx context.Context, in *DenomPairVolumeBucketsRequest, opts ...grpc.CallOption) (*DenomPairVolumeBucketsResponse, error) {
+	out := new(DenomPairVolumeBucketsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/DenomPairVolumeBuckets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TakerFeeDiscount(ctx context.Context, in *TakerFeeDiscountRequest, opts ...grpc.CallOption) (*TakerFeeDiscountResponse, error) {
+	out := new(TakerFeeDiscountResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TakerFeeDiscount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
 	// Estimates swap amount out given in.
 	EstimateSwapExactAmountIn(context.Context, *EstimateSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error)
@@ -2777,6 +3301,23 @@ type QueryServer interface {
 	// the current distribution composition of taker fee share denoms within the
 	// alloyed pool.
 	AllRegisteredAlloyedPools(context.Context, *AllRegisteredAlloyedPoolsRequest) (*AllRegisteredAlloyedPoolsResponse, error)
+	// BestSplitRouteExactAmountIn returns the split route with the largest
+	// estimated amount out when swapping token_in for token_out_denom. Routes
+	// are searched among the pools containing each intermediary denom, up to
+	// max_hops pools per route, and token_in is split across up to max_splits
+	// routes that do not share pools. The returned routes can be used in
+	// MsgSplitRouteSwapExactAmountIn.
+	BestSplitRouteExactAmountIn(context.Context, *BestSplitRouteExactAmountInRequest) (*BestSplitRouteExactAmountInResponse, error)
+	// PoolVolumeBuckets returns the OSMO-denominated volume of the given pool in
+	// the most recent volume buckets, each spanning one day epoch.
+	PoolVolumeBuckets(context.Context, *PoolVolumeBucketsRequest) (*PoolVolumeBucketsResponse, error)
+	// DenomPairVolumeBuckets returns the OSMO-denominated volume swapped between
+	// the given denoms, in either direction, in the most recent volume buckets,
+	// each spanning one day epoch.
+	DenomPairVolumeBuckets(context.Context, *DenomPairVolumeBucketsRequest) (*DenomPairVolumeBucketsResponse, error)
+	// TakerFeeDiscount returns the taker fee discount the given address gets
+	// from the amount of OSMO it has staked or locked.
+	TakerFeeDiscount(context.Context, *TakerFeeDiscountRequest) (*TakerFeeDiscountResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2855,6 +3396,18 @@ func (*UnimplementedQueryServer) RegisteredAlloyedPoolFromPoolId(ctx context.Con
 func (*UnimplementedQueryServer) AllRegisteredAlloyedPools(ctx context.Context, req *AllRegisteredAlloyedPoolsRequest) (*AllRegisteredAlloyedPoolsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllRegisteredAlloyedPools not implemented")
 }
+func (*UnimplementedQueryServer) BestSplitRouteExactAmountIn(ctx context.Context, req *BestSplitRouteExactAmountInRequest) (*BestSplitRouteExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BestSplitRouteExactAmountIn not implemented")
+}
+func (*UnimplementedQueryServer) PoolVolumeBuckets(ctx context.Context, req *PoolVolumeBucketsRequest) (*PoolVolumeBucketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolVolumeBuckets not implemented")
+}
+func (*UnimplementedQueryServer) DenomPairVolumeBuckets(ctx context.Context, req *DenomPairVolumeBucketsRequest) (*DenomPairVolumeBucketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomPairVolumeBuckets not implemented")
+}
+func (*UnimplementedQueryServer) TakerFeeDiscount(ctx context.Context, req *TakerFeeDiscountRequest) (*TakerFeeDiscountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakerFeeDiscount not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -3292,6 +3845,78 @@ func _Query_AllRegisteredAlloyedPools_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BestSplitRouteExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BestSplitRouteExactAmountInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BestSplitRouteExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/BestSplitRouteExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BestSplitRouteExactAmountIn(ctx, req.(*BestSplitRouteExactAmountInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolVolumeBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolVolumeBucketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolVolumeBuckets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/PoolVolumeBuckets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolVolumeBuckets(ctx, req.(*PoolVolumeBucketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomPairVolumeBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomPairVolumeBucketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomPairVolumeBuckets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/DenomPairVolumeBuckets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomPairVolumeBuckets(ctx, req.(*DenomPairVolumeBucketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TakerFeeDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakerFeeDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TakerFeeDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TakerFeeDiscount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TakerFeeDiscount(ctx, req.(*TakerFeeDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.poolmanager.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -3392,6 +4017,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllRegisteredAlloyedPools",
 			Handler:    _Query_AllRegisteredAlloyedPools_Handler,
 		},
+		{
+			MethodName: "BestSplitRouteExactAmountIn",
+			Handler:    _Query_BestSplitRouteExactAmountIn_Handler,
+		},
+		{
+			MethodName: "PoolVolumeBuckets",
+			Handler:    _Query_PoolVolumeBuckets_Handler,
+		},
+		{
+			MethodName: "DenomPairVolumeBuckets",
+			Handler:    _Query_DenomPairVolumeBuckets_Handler,
+		},
+		{
+			MethodName: "TakerFeeDiscount",
+			Handler:    _Query_TakerFeeDiscount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/poolmanager/v1beta1/query.proto",
@@ -4957,124 +5598,385 @@ func (m *AllRegisteredAlloyedPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BestSplitRouteExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *BestSplitRouteExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSwapExactAmountInRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BestSplitRouteExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MaxSplits != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxSplits))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if m.MaxHops != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BestSplitRouteExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BestSplitRouteExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BestSplitRouteExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.RoutesPoolId) > 0 {
-		l = 0
-		for _, e := range m.RoutesPoolId {
-			l += sovQuery(uint64(e))
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		n += 1 + sovQuery(uint64(l)) + l
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if len(m.RoutesTokenOutDenom) > 0 {
-		for _, s := range m.RoutesTokenOutDenom {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSinglePoolSwapExactAmountInRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolVolumeBucketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVolumeBucketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolumeBucketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if m.NumBuckets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumBuckets))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.TokenOutDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolVolumeBucketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EstimateSwapExactAmountInResponse) Size() (n int) {
+func (m *PoolVolumeBucketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolumeBucketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalVolume) > 0 {
+		for iNdEx := len(m.TotalVolume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalVolume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPairVolumeBucketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPairVolumeBucketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPairVolumeBucketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumBuckets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumBuckets))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom_1) > 0 {
+		i -= len(m.Denom_1)
+		copy(dAtA[i:], m.Denom_1)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom_0) > 0 {
+		i -= len(m.Denom_0)
+		copy(dAtA[i:], m.Denom_0)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_0)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPairVolumeBucketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPairVolumeBucketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPairVolumeBucketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalVolume) > 0 {
+		for iNdEx := len(m.TotalVolume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalVolume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TakerFeeDiscountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeDiscountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeDiscountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TakerFeeDiscountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeDiscountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeDiscountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.StakedOrLockedAmount.Size()
+		i -= size
+		if _, err := m.StakedOrLockedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.TokenOutAmount.Size()
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *EstimateSwapExactAmountOutRequest) Size() (n int) {
+func (m *EstimateSwapExactAmountInRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5087,20 +5989,20 @@ func (m *EstimateSwapExactAmountOutRequest) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovQuery(uint64(m.PoolId))
 	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	if len(m.Routes) > 0 {
 		for _, e := range m.Routes {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Size() (n int) {
+func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5109,6 +6011,10 @@ func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovQuery(uint64(m.PoolId))
 	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	if len(m.RoutesPoolId) > 0 {
 		l = 0
 		for _, e := range m.RoutesPoolId {
@@ -5116,20 +6022,16 @@ func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Size() (n int) {
 		}
 		n += 1 + sovQuery(uint64(l)) + l
 	}
-	if len(m.RoutesTokenInDenom) > 0 {
-		for _, s := range m.RoutesTokenInDenom {
+	if len(m.RoutesTokenOutDenom) > 0 {
+		for _, s := range m.RoutesTokenOutDenom {
 			l = len(s)
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *EstimateSinglePoolSwapExactAmountOutRequest) Size() (n int) {
+func (m *EstimateSinglePoolSwapExactAmountInRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5138,50 +6040,136 @@ func (m *EstimateSinglePoolSwapExactAmountOutRequest) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovQuery(uint64(m.PoolId))
 	}
-	l = len(m.TokenInDenom)
+	l = len(m.TokenIn)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.TokenOut)
+	l = len(m.TokenOutDenom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *EstimateSwapExactAmountOutResponse) Size() (n int) {
+func (m *EstimateSwapExactAmountInResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.TokenInAmount.Size()
+	l = m.TokenOutAmount.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *NumPoolsRequest) Size() (n int) {
+func (m *EstimateSwapExactAmountOutRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func (m *NumPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.NumPools != 0 {
-		n += 1 + sovQuery(uint64(m.NumPools))
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *PoolRequest) Size() (n int) {
+func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.RoutesPoolId) > 0 {
+		l = 0
+		for _, e := range m.RoutesPoolId {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.RoutesTokenInDenom) > 0 {
+		for _, s := range m.RoutesTokenInDenom {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateSinglePoolSwapExactAmountOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *NumPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *NumPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPools != 0 {
+		n += 1 + sovQuery(uint64(m.NumPools))
+	}
+	return n
+}
+
+func (m *PoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5603,6 +6591,147 @@ func (m *AllRegisteredAlloyedPoolsResponse) Size() (n int) {
 	return n
 }
 
+func (m *BestSplitRouteExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxHops != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHops))
+	}
+	if m.MaxSplits != 0 {
+		n += 1 + sovQuery(uint64(m.MaxSplits))
+	}
+	return n
+}
+
+func (m *BestSplitRouteExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PoolVolumeBucketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.NumBuckets != 0 {
+		n += 1 + sovQuery(uint64(m.NumBuckets))
+	}
+	return n
+}
+
+func (m *PoolVolumeBucketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalVolume) > 0 {
+		for _, e := range m.TotalVolume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomPairVolumeBucketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom_0)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom_1)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumBuckets != 0 {
+		n += 1 + sovQuery(uint64(m.NumBuckets))
+	}
+	return n
+}
+
+func (m *DenomPairVolumeBucketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalVolume) > 0 {
+		for _, e := range m.TotalVolume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TakerFeeDiscountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TakerFeeDiscountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakedOrLockedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Discount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5690,9 +6819,770 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInWithPrimitiveTypesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInWithPrimitiveTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RoutesPoolId = append(m.RoutesPoolId, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RoutesPoolId) == 0 {
+					m.RoutesPoolId = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RoutesPoolId = append(m.RoutesPoolId, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesPoolId", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesTokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutesTokenOutDenom = append(m.RoutesTokenOutDenom, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types.SwapAmountOutRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5702,24 +7592,23 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5742,7 +7631,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5765,17 +7654,17 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutWithPrimitiveTypesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutWithPrimitiveTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5785,46 +7674,90 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.RoutesPoolId = append(m.RoutesPoolId, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RoutesPoolId) == 0 {
+					m.RoutesPoolId = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RoutesPoolId = append(m.RoutesPoolId, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesPoolId", wireType)
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesTokenInDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5852,13 +7785,13 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			m.RoutesTokenInDenom = append(m.RoutesTokenInDenom, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5868,25 +7801,23 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, types.SwapAmountInRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5909,7 +7840,7 @@ func (m *EstimateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5932,10 +7863,10 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInWithPrimitiveTypesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountOutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInWithPrimitiveTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5959,7 +7890,7 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5987,87 +7918,93 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RoutesPoolId = append(m.RoutesPoolId, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RoutesPoolId) == 0 {
-					m.RoutesPoolId = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RoutesPoolId = append(m.RoutesPoolId, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutesPoolId", wireType)
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutesTokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6095,7 +8032,9 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoutesTokenOutDenom = append(m.RoutesTokenOutDenom, string(dAtA[iNdEx:postIndex]))
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6118,7 +8057,7 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 	}
 	return nil
 }
-func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+func (m *NumPoolsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6141,17 +8080,67 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NumPoolsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NumPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NumPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPools", wireType)
 			}
-			m.PoolId = 0
+			m.NumPools = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6161,48 +8150,66 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.NumPools |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6212,24 +8219,11 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6251,7 +8245,7 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6274,17 +8268,17 @@ func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6294,26 +8288,78 @@ func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &types1.Any{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6335,7 +8381,7 @@ func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+func (m *AllPoolsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6358,66 +8404,15 @@ func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllPoolsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6444,43 +8439,11 @@ func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, types.SwapAmountOutRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pools = append(m.Pools, &types1.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenOut = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6502,7 +8465,7 @@ func (m *EstimateSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []byte) error {
+func (m *ListPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6525,110 +8488,15 @@ func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []b
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutWithPrimitiveTypesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPoolsByDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutWithPrimitiveTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPoolsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RoutesPoolId = append(m.RoutesPoolId, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RoutesPoolId) == 0 {
-					m.RoutesPoolId = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RoutesPoolId = append(m.RoutesPoolId, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutesPoolId", wireType)
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutesTokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6653,16 +8521,66 @@ func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []b
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoutesTokenInDenom = append(m.RoutesTokenInDenom, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPoolsByDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPoolsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6672,23 +8590,25 @@ func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []b
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			m.Pools = append(m.Pools, &types1.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6711,7 +8631,7 @@ func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Unmarshal(dAtA []b
 	}
 	return nil
 }
-func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) error {
+func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6734,10 +8654,10 @@ func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountOutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpotPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6761,7 +8681,7 @@ func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) err
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6789,11 +8709,11 @@ func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6821,7 +8741,7 @@ func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6844,7 +8764,7 @@ func (m *EstimateSinglePoolSwapExactAmountOutRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6867,15 +8787,15 @@ func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpotPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6903,9 +8823,7 @@ func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SpotPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6928,7 +8846,7 @@ func (m *EstimateSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6951,12 +8869,31 @@ func (m *NumPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalPoolLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6978,7 +8915,7 @@ func (m *NumPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7001,17 +8938,17 @@ func (m *NumPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalPoolLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPools", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
-			m.NumPools = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7021,11 +8958,26 @@ func (m *NumPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumPools |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidity = append(m.Liquidity, types2.Coin{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7047,7 +8999,7 @@ func (m *NumPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolRequest) Unmarshal(dAtA []byte) error {
+func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7070,31 +9022,12 @@ func (m *PoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7116,7 +9049,7 @@ func (m *PoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolResponse) Unmarshal(dAtA []byte) error {
+func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7139,15 +9072,15 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7174,10 +9107,8 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &types1.Any{}
-			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Liquidity = append(m.Liquidity, types2.Coin{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7202,7 +9133,7 @@ func (m *PoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7225,12 +9156,31 @@ func (m *AllPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalVolumeForPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalVolumeForPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7252,7 +9202,7 @@ func (m *AllPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7275,15 +9225,15 @@ func (m *AllPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalVolumeForPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalVolumeForPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7310,8 +9260,8 @@ func (m *AllPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types1.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Volume = append(m.Volume, types2.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7336,7 +9286,7 @@ func (m *AllPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7359,15 +9309,15 @@ func (m *ListPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListPoolsByDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradingPairTakerFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListPoolsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradingPairTakerFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_0", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7395,7 +9345,39 @@ func (m *ListPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Denom_0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom_1 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7418,7 +9400,7 @@ func (m *ListPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7441,17 +9423,17 @@ func (m *ListPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListPoolsByDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradingPairTakerFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListPoolsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradingPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7461,23 +9443,23 @@ func (m *ListPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types1.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7502,7 +9484,7 @@ func (m *ListPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7525,17 +9507,17 @@ func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpotPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCoin", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7545,14 +9527,28 @@ func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7580,11 +9576,30 @@ func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
+			m.ToCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7612,61 +9627,13 @@ func (m *SpotPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SpotPriceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7694,7 +9661,9 @@ func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotPrice = string(dAtA[iNdEx:postIndex])
+			if err := m.ExternalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7717,7 +9686,7 @@ func (m *SpotPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7740,17 +9709,17 @@ func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalPoolLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputCoin", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7760,64 +9729,28 @@ func (m *TotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.InputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TotalPoolLiquidityResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7844,8 +9777,7 @@ func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, types2.Coin{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7870,7 +9802,7 @@ func (m *TotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *AllTakerFeeShareAgreementsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7893,10 +9825,10 @@ func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllTakerFeeShareAgreementsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllTakerFeeShareAgreementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7920,7 +9852,7 @@ func (m *TotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *AllTakerFeeShareAgreementsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7943,15 +9875,15 @@ func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllTakerFeeShareAgreementsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllTakerFeeShareAgreementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeShareAgreements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7978,8 +9910,8 @@ func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, types2.Coin{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TakerFeeShareAgreements = append(m.TakerFeeShareAgreements, types.TakerFeeShareAgreement{})
+			if err := m.TakerFeeShareAgreements[len(m.TakerFeeShareAgreements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8004,7 +9936,7 @@ func (m *TotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeShareAgreementFromDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8027,17 +9959,17 @@ func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalVolumeForPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalVolumeForPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8047,11 +9979,24 @@ func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8073,7 +10018,7 @@ func (m *TotalVolumeForPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeShareAgreementFromDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8096,15 +10041,15 @@ func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalVolumeForPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalVolumeForPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeShareAgreement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8131,8 +10076,7 @@ func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volume = append(m.Volume, types2.Coin{})
-			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TakerFeeShareAgreement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8157,7 +10101,7 @@ func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeShareDenomsToAccruedValueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8180,15 +10124,15 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradingPairTakerFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingPairTakerFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom_0", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8216,11 +10160,11 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom_0 = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom_1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8248,7 +10192,7 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom_1 = string(dAtA[iNdEx:postIndex])
+			m.TakerFeeDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8271,7 +10215,7 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeShareDenomsToAccruedValueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8294,15 +10238,15 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradingPairTakerFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8330,7 +10274,7 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8355,7 +10299,7 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
+func (m *AllTakerFeeShareAccumulatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8378,15 +10322,65 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllTakerFeeShareAccumulatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeSkimAccumulators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8413,98 +10407,64 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FromCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TakerFeeSkimAccumulators = append(m.TakerFeeSkimAccumulators, types.TakerFeeSkimAccumulator{})
+			if err := m.TakerFeeSkimAccumulators[len(m.TakerFeeSkimAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredAlloyedPoolFromDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8532,9 +10492,7 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExternalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8557,7 +10515,7 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
+func (m *RegisteredAlloyedPoolFromDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8580,48 +10538,15 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputCoin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8648,7 +10573,7 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContractState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8673,7 +10598,7 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllTakerFeeShareAgreementsRequest) Unmarshal(dAtA []byte) error {
+func (m *RegisteredAlloyedPoolFromPoolIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8696,12 +10621,31 @@ func (m *AllTakerFeeShareAgreementsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllTakerFeeShareAgreementsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllTakerFeeShareAgreementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8723,7 +10667,7 @@ func (m *AllTakerFeeShareAgreementsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllTakerFeeShareAgreementsResponse) Unmarshal(dAtA []byte) error {
+func (m *RegisteredAlloyedPoolFromPoolIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8746,15 +10690,15 @@ func (m *AllTakerFeeShareAgreementsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllTakerFeeShareAgreementsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllTakerFeeShareAgreementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeShareAgreements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8781,8 +10725,7 @@ func (m *AllTakerFeeShareAgreementsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TakerFeeShareAgreements = append(m.TakerFeeShareAgreements, types.TakerFeeShareAgreement{})
-			if err := m.TakerFeeShareAgreements[len(m.TakerFeeShareAgreements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContractState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8807,7 +10750,7 @@ func (m *AllTakerFeeShareAgreementsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TakerFeeShareAgreementFromDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *AllRegisteredAlloyedPoolsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8830,44 +10773,12 @@ func (m *TakerFeeShareAgreementFromDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8889,7 +10800,7 @@ func (m *TakerFeeShareAgreementFromDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TakerFeeShareAgreementFromDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *AllRegisteredAlloyedPoolsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8912,15 +10823,15 @@ func (m *TakerFeeShareAgreementFromDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakerFeeShareAgreementFromDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeShareAgreement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractStates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8947,7 +10858,8 @@ func (m *TakerFeeShareAgreementFromDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFeeShareAgreement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContractStates = append(m.ContractStates, types.AlloyContractTakerFeeShareState{})
+			if err := m.ContractStates[len(m.ContractStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8972,7 +10884,7 @@ func (m *TakerFeeShareAgreementFromDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TakerFeeShareDenomsToAccruedValueRequest) Unmarshal(dAtA []byte) error {
+func (m *BestSplitRouteExactAmountInRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8995,17 +10907,17 @@ func (m *TakerFeeShareDenomsToAccruedValueRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BestSplitRouteExactAmountInRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BestSplitRouteExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9015,27 +10927,28 @@ func (m *TakerFeeShareDenomsToAccruedValueRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9063,8 +10976,46 @@ func (m *TakerFeeShareDenomsToAccruedValueRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TakerFeeDenom = string(dAtA[iNdEx:postIndex])
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
+			}
+			m.MaxHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSplits", wireType)
+			}
+			m.MaxSplits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSplits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9086,7 +11037,7 @@ func (m *TakerFeeShareDenomsToAccruedValueRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *TakerFeeShareDenomsToAccruedValueResponse) Unmarshal(dAtA []byte) error {
+func (m *BestSplitRouteExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9109,17 +11060,17 @@ func (m *TakerFeeShareDenomsToAccruedValueResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BestSplitRouteExactAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakerFeeShareDenomsToAccruedValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BestSplitRouteExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9129,76 +11080,60 @@ func (m *TakerFeeShareDenomsToAccruedValueResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, types.SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllTakerFeeShareAccumulatorsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9220,7 +11155,7 @@ func (m *AllTakerFeeShareAccumulatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllTakerFeeShareAccumulatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolVolumeBucketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9243,17 +11178,17 @@ func (m *AllTakerFeeShareAccumulatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolVolumeBucketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllTakerFeeShareAccumulatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolVolumeBucketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeSkimAccumulators", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9263,26 +11198,30 @@ func (m *AllTakerFeeShareAccumulatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBuckets", wireType)
 			}
-			m.TakerFeeSkimAccumulators = append(m.TakerFeeSkimAccumulators, types.TakerFeeSkimAccumulator{})
-			if err := m.TakerFeeSkimAccumulators[len(m.TakerFeeSkimAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NumBuckets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBuckets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9304,7 +11243,7 @@ func (m *AllTakerFeeShareAccumulatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredAlloyedPoolFromDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolVolumeBucketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9327,17 +11266,17 @@ func (m *RegisteredAlloyedPoolFromDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolVolumeBucketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolVolumeBucketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9347,77 +11286,29 @@ func (m *RegisteredAlloyedPoolFromDenomRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Buckets = append(m.Buckets, types.VolumeBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RegisteredAlloyedPoolFromDenomResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVolume", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9444,7 +11335,8 @@ func (m *RegisteredAlloyedPoolFromDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContractState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalVolume = append(m.TotalVolume, types2.Coin{})
+			if err := m.TotalVolume[len(m.TotalVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9469,7 +11361,7 @@ func (m *RegisteredAlloyedPoolFromDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredAlloyedPoolFromPoolIdRequest) Unmarshal(dAtA []byte) error {
+func (m *DenomPairVolumeBucketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9492,17 +11384,81 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomPairVolumeBucketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomPairVolumeBucketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom_0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom_1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBuckets", wireType)
 			}
-			m.PoolId = 0
+			m.NumBuckets = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9512,7 +11468,7 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.NumBuckets |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9538,7 +11494,7 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredAlloyedPoolFromPoolIdResponse) Unmarshal(dAtA []byte) error {
+func (m *DenomPairVolumeBucketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9561,15 +11517,15 @@ func (m *RegisteredAlloyedPoolFromPoolIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomPairVolumeBucketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredAlloyedPoolFromPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomPairVolumeBucketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9596,7 +11552,42 @@ func (m *RegisteredAlloyedPoolFromPoolIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContractState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Buckets = append(m.Buckets, types.VolumeBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVolume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalVolume = append(m.TotalVolume, types2.Coin{})
+			if err := m.TotalVolume[len(m.TotalVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9621,7 +11612,7 @@ func (m *RegisteredAlloyedPoolFromPoolIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllRegisteredAlloyedPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeDiscountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9644,12 +11635,44 @@ func (m *AllRegisteredAlloyedPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeDiscountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeDiscountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9671,7 +11694,7 @@ func (m *AllRegisteredAlloyedPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllRegisteredAlloyedPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeDiscountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9694,17 +11717,17 @@ func (m *AllRegisteredAlloyedPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeDiscountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllRegisteredAlloyedPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeDiscountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedOrLockedAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9714,23 +11737,57 @@ func (m *AllRegisteredAlloyedPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractStates = append(m.ContractStates, types.AlloyContractTakerFeeShareState{})
-			if err := m.ContractStates[len(m.ContractStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakedOrLockedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,40 @@
+package poolmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v29/x/poolmanager/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+func (k Keeper) EpochHooks() epochstypes.EpochHooks {
+	return EpochHooks{k}
+}
+
+// GetModuleName implements types.EpochHooks.
+func (EpochHooks) GetModuleName() string {
+	return types.ModuleName
+}
+
+// BeforeEpochStart is the epoch start hook.
+func (h EpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// AfterEpochEnd is the epoch end hook.
+func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier == VolumeBucketEpochIdentifier {
+		h.k.rotateVolumeBuckets(ctx)
+	}
+	if epochIdentifier == TakerFeeDiscountEpochIdentifier {
+		h.k.SetTakerFeeDiscountEpoch(ctx, epochNumber)
+		h.k.clearTakerFeeDiscountCache(ctx)
+	}
+	return nil
+}
@@ -47,11 +47,15 @@ func (k Keeper) CreateMultihopExpectedSwapOuts(
 	route []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
 ) ([]osmomath.Int, error) {
-	return k.createMultihopExpectedSwapOuts(ctx, route, tokenOut)
+	return k.createMultihopExpectedSwapOuts(ctx, route, tokenOut, nil)
 }
 
-func (k Keeper) TrackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin) {
-	k.trackVolume(ctx, poolId, volumeGenerated)
+func (k Keeper) TrackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin, tokenOutDenom string) {
+	k.trackVolume(ctx, poolId, volumeGenerated, tokenOutDenom)
+}
+
+func (k Keeper) RotateVolumeBuckets(ctx sdk.Context) {
+	k.rotateVolumeBuckets(ctx)
 }
 
 func (k Keeper) ChargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, sdk.Coin, error) {
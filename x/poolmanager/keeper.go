@@ -26,6 +26,7 @@ type Keeper struct {
 	accountKeeper        types.AccountI
 	communityPoolKeeper  types.CommunityPoolI
 	stakingKeeper        types.StakingKeeper
+	lockupKeeper         types.LockupKeeper
 	protorevKeeper       types.ProtorevKeeper
 	wasmKeeper           types.WasmKeeper
 
@@ -149,6 +150,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, denomPairTakerFee := range genState.DenomPairTakerFeeStore {
 		k.SetDenomPairTakerFee(ctx, denomPairTakerFee.TokenInDenom, denomPairTakerFee.TokenOutDenom, denomPairTakerFee.TakerFee)
 	}
+
+	// Set the volume buckets KVStore.
+	k.SetVolumeBucketIndex(ctx, genState.VolumeBucketIndex)
+	for _, poolVolumeBucket := range genState.PoolVolumeBuckets {
+		k.SetPoolVolumeBucket(ctx, poolVolumeBucket)
+	}
+	for _, denomPairVolumeBucket := range genState.DenomPairVolumeBuckets {
+		k.SetDenomPairVolumeBucket(ctx, denomPairVolumeBucket)
+	}
 }
 
 // ExportGenesis returns the poolmanager module's exported genesis.
@@ -174,6 +184,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	poolVolumeBuckets, err := k.GetAllPoolVolumeBuckets(ctx)
+	if err != nil {
+		panic(err)
+	}
+	denomPairVolumeBuckets, err := k.GetAllDenomPairVolumeBuckets(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	// Export KVStore values to the genesis state so they can be imported in init genesis.
 	takerFeesTracker := types.TakerFeesTracker{
 		TakerFeesToStakers:         k.GetTakerFeeTrackerForStakers(ctx),
@@ -187,6 +206,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		TakerFeesTracker:       &takerFeesTracker,
 		PoolVolumes:            poolVolumes,
 		DenomPairTakerFeeStore: denomPairTakerFees,
+		VolumeBucketIndex:      k.GetVolumeBucketIndex(ctx),
+		PoolVolumeBuckets:      poolVolumeBuckets,
+		DenomPairVolumeBuckets: denomPairVolumeBuckets,
 	}
 }
 
@@ -214,6 +236,11 @@ func (k *Keeper) SetStakingKeeper(stakingKeeper types.StakingKeeper) {
 	k.stakingKeeper = stakingKeeper
 }
 
+// SetLockupKeeper sets lockup keeper
+func (k *Keeper) SetLockupKeeper(lockupKeeper types.LockupKeeper) {
+	k.lockupKeeper = lockupKeeper
+}
+
 // SetProtorevKeeper sets protorev keeper
 func (k *Keeper) SetProtorevKeeper(protorevKeeper types.ProtorevKeeper) {
 	k.protorevKeeper = protorevKeeper
@@ -67,6 +67,30 @@ var (
 		},
 	}
 
+	testVolumeBucketIndex = uint64(5)
+
+	testPoolVolumeBuckets = []types.PoolVolumeBucket{
+		{
+			Bucket: 4,
+			PoolId: 1,
+			Volume: sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(1000000))),
+		},
+		{
+			Bucket: 5,
+			PoolId: 2,
+			Volume: sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(2000000))),
+		},
+	}
+
+	testDenomPairVolumeBuckets = []types.DenomPairVolumeBucket{
+		{
+			Bucket:  5,
+			Denom_0: "uion",
+			Denom_1: appparams.BaseCoinUnit,
+			Volume:  sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, osmomath.NewInt(3000000))),
+		},
+	}
+
 	testDenomPairTakerFees = []types.DenomPairTakerFee{
 		{
 			TokenInDenom:  "uion",
@@ -143,6 +167,9 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 		TakerFeesTracker:       &testTakerFeesTracker,
 		PoolVolumes:            testPoolVolumes,
 		DenomPairTakerFeeStore: testDenomPairTakerFees,
+		VolumeBucketIndex:      testVolumeBucketIndex,
+		PoolVolumeBuckets:      testPoolVolumeBuckets,
+		DenomPairVolumeBuckets: testDenomPairVolumeBuckets,
 	})
 
 	params := s.App.PoolManagerKeeper.GetParams(s.Ctx)
@@ -167,6 +194,11 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	takerFee, err = s.App.PoolManagerKeeper.GetTradingPairTakerFee(s.Ctx, testDenomPairTakerFees[1].TokenInDenom, testDenomPairTakerFees[1].TokenOutDenom)
 	s.Require().NoError(err)
 	s.Require().Equal(testDenomPairTakerFees[1].TakerFee, takerFee)
+
+	s.Require().Equal(testVolumeBucketIndex, s.App.PoolManagerKeeper.GetVolumeBucketIndex(s.Ctx))
+	s.Require().Equal(testPoolVolumeBuckets[0].Volume, s.App.PoolManagerKeeper.GetPoolVolumeBucket(s.Ctx, testPoolVolumeBuckets[0].Bucket, testPoolVolumeBuckets[0].PoolId))
+	s.Require().Equal(testPoolVolumeBuckets[1].Volume, s.App.PoolManagerKeeper.GetPoolVolumeBucket(s.Ctx, testPoolVolumeBuckets[1].Bucket, testPoolVolumeBuckets[1].PoolId))
+	s.Require().Equal(testDenomPairVolumeBuckets[0].Volume, s.App.PoolManagerKeeper.GetDenomPairVolumeBucket(s.Ctx, testDenomPairVolumeBuckets[0].Bucket, appparams.BaseCoinUnit, "uion"))
 }
 
 func (s *KeeperTestSuite) TestExportGenesis() {
@@ -191,6 +223,9 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		TakerFeesTracker:       &testTakerFeesTracker,
 		PoolVolumes:            testPoolVolumes,
 		DenomPairTakerFeeStore: testDenomPairTakerFees,
+		VolumeBucketIndex:      testVolumeBucketIndex,
+		PoolVolumeBuckets:      testPoolVolumeBuckets,
+		DenomPairVolumeBuckets: testDenomPairVolumeBuckets,
 	})
 
 	genesis := s.App.PoolManagerKeeper.ExportGenesis(s.Ctx)
@@ -209,6 +244,9 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	s.Require().Equal(testPoolVolumes[0].PoolVolume, genesis.PoolVolumes[0].PoolVolume)
 	s.Require().Equal(testPoolVolumes[1].PoolVolume, genesis.PoolVolumes[1].PoolVolume)
 	s.Require().Equal(testDenomPairTakerFees, genesis.DenomPairTakerFeeStore)
+	s.Require().Equal(testVolumeBucketIndex, genesis.VolumeBucketIndex)
+	s.Require().ElementsMatch(testPoolVolumeBuckets, genesis.PoolVolumeBuckets)
+	s.Require().Equal(testDenomPairVolumeBuckets, genesis.DenomPairVolumeBuckets)
 }
 
 // TestBeginBlock tests that, if any one of the cache trackers is empty, all cache trackers are updated.
@@ -29,7 +29,7 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 		return nil, err
 	}
 
-	tokenOutAmount, err := server.keeper.RouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount)
+	tokenOutAmount, err := server.keeper.RouteExactAmountInWithSwapProtection(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount, msg.Deadline, msg.MaxPriceImpact)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +48,7 @@ func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 
-	tokenInAmount, err := server.keeper.RouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut)
+	tokenInAmount, err := server.keeper.RouteExactAmountOutWithSwapProtection(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut, msg.Deadline, msg.MaxPriceImpact)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +66,7 @@ func (server msgServer) SplitRouteSwapExactAmountIn(goCtx context.Context, msg *
 		return nil, err
 	}
 
-	tokenOutAmount, err := server.keeper.SplitRouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenInDenom, msg.TokenOutMinAmount)
+	tokenOutAmount, err := server.keeper.SplitRouteExactAmountInWithSwapProtection(ctx, sender, msg.Routes, msg.TokenInDenom, msg.TokenOutMinAmount, msg.Deadline, msg.MaxPriceImpact)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +84,7 @@ func (server msgServer) SplitRouteSwapExactAmountOut(goCtx context.Context, msg
 		return nil, err
 	}
 
-	tokenInAmount, err := server.keeper.SplitRouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenOutDenom, msg.TokenInMaxAmount)
+	tokenInAmount, err := server.keeper.SplitRouteExactAmountOutWithSwapProtection(ctx, sender, msg.Routes, msg.TokenOutDenom, msg.TokenInMaxAmount, msg.Deadline, msg.MaxPriceImpact)
 	if err != nil {
 		return nil, err
 	}
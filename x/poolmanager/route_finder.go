@@ -0,0 +1,319 @@
+package poolmanager
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/poolmanager/types"
+)
+
+const (
+	// DefaultRouteFinderMaxHops is the default maximum number of pools in a route found by BestSplitRouteExactAmountIn.
+	DefaultRouteFinderMaxHops = 3
+	// MaxRouteFinderMaxHops is the largest maximum number of pools in a route that can be requested.
+	MaxRouteFinderMaxHops = 4
+	// DefaultRouteFinderMaxSplits is the default maximum number of routes token in is split across.
+	DefaultRouteFinderMaxSplits = 3
+	// MaxRouteFinderMaxSplits is the largest maximum number of routes token in can be split across.
+	MaxRouteFinderMaxSplits = 5
+
+	// routeFinderMaxCandidates bounds the number of candidate routes that are estimated.
+	routeFinderMaxCandidates = 50
+	// routeFinderSplitSteps is the number of parts token in is divided into when splitting it across routes.
+	routeFinderSplitSteps = 10
+)
+
+// BestSplitRouteExactAmountIn returns the split route with the largest estimated amount out when swapping tokenIn
+// for tokenOutDenom, along with that amount out. Taker fees are included in the estimates.
+//
+// Candidate routes go through the active pools returned by ListPoolsByDenom, with at most maxHops pools and without
+// going through the same denom or pool twice. Shorter routes are searched first and at most routeFinderMaxCandidates
+// routes are considered. Token in is then split across up to maxSplits of the best candidates that do not share pools,
+// by greedily allocating each of routeFinderSplitSteps parts of token in to the route with the largest marginal
+// amount out. Zero maxHops and maxSplits use their default values.
+//
+// The returned routes can be used as is in SplitRouteExactAmountIn, as long as the state of the pools does not change.
+func (k Keeper) BestSplitRouteExactAmountIn(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops uint64,
+	maxSplits uint64,
+) ([]types.SwapAmountInSplitRoute, osmomath.Int, error) {
+	if err := tokenIn.Validate(); err != nil {
+		return nil, osmomath.Int{}, err
+	}
+	if !tokenIn.Amount.IsPositive() {
+		return nil, osmomath.Int{}, fmt.Errorf("token in amount must be positive, got %s", tokenIn.Amount)
+	}
+	if err := sdk.ValidateDenom(tokenOutDenom); err != nil {
+		return nil, osmomath.Int{}, err
+	}
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, osmomath.Int{}, fmt.Errorf("token in and token out denoms must be different, got %s", tokenOutDenom)
+	}
+
+	if maxHops == 0 {
+		maxHops = DefaultRouteFinderMaxHops
+	}
+	if maxHops > MaxRouteFinderMaxHops {
+		return nil, osmomath.Int{}, fmt.Errorf("max hops must be at most %d, got %d", MaxRouteFinderMaxHops, maxHops)
+	}
+	if maxSplits == 0 {
+		maxSplits = DefaultRouteFinderMaxSplits
+	}
+	if maxSplits > MaxRouteFinderMaxSplits {
+		return nil, osmomath.Int{}, fmt.Errorf("max splits must be at most %d, got %d", MaxRouteFinderMaxSplits, maxSplits)
+	}
+
+	finder := newRouteFinder(k, ctx, tokenIn.Denom, tokenOutDenom)
+	candidates, err := finder.findCandidateRoutes(int(maxHops))
+	if err != nil {
+		return nil, osmomath.Int{}, err
+	}
+
+	routes := k.selectBestRoutes(ctx, candidates, tokenIn, int(maxSplits))
+	if len(routes) == 0 {
+		return nil, osmomath.Int{}, types.NoRouteFoundError{TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom}
+	}
+
+	return k.splitTokenInAcrossRoutes(ctx, routes, tokenIn)
+}
+
+// estimatedRoute is a candidate route along with its estimated amount out for the whole token in.
+type estimatedRoute struct {
+	route          []types.SwapAmountInRoute
+	tokenOutAmount osmomath.Int
+}
+
+// selectBestRoutes estimates the amount out of each candidate route for the whole token in and returns up to maxSplits
+// routes with the largest amount out that do not share pools, ordered by decreasing amount out.
+// Routes sharing pools are excluded since their amounts out are not independent.
+func (k Keeper) selectBestRoutes(ctx sdk.Context, candidates [][]types.SwapAmountInRoute, tokenIn sdk.Coin, maxSplits int) []estimatedRoute {
+	estimatedRoutes := make([]estimatedRoute, 0, len(candidates))
+	for _, candidate := range candidates {
+		tokenOutAmount, err := k.estimateRouteExactAmountIn(ctx, candidate, tokenIn)
+		if err != nil {
+			continue
+		}
+		estimatedRoutes = append(estimatedRoutes, estimatedRoute{route: candidate, tokenOutAmount: tokenOutAmount})
+	}
+
+	// The sort is stable so that shorter routes, which are found first, are preferred on ties.
+	sort.SliceStable(estimatedRoutes, func(i, j int) bool {
+		return estimatedRoutes[i].tokenOutAmount.GT(estimatedRoutes[j].tokenOutAmount)
+	})
+
+	selected := []estimatedRoute{}
+	usedPools := map[uint64]bool{}
+	for _, estimated := range estimatedRoutes {
+		if len(selected) == maxSplits {
+			break
+		}
+		if slices.ContainsFunc(estimated.route, func(step types.SwapAmountInRoute) bool { return usedPools[step.PoolId] }) {
+			continue
+		}
+		for _, step := range estimated.route {
+			usedPools[step.PoolId] = true
+		}
+		selected = append(selected, estimated)
+	}
+	return selected
+}
+
+// splitTokenInAcrossRoutes splits token in across the given routes, ordered by decreasing amount out, and returns
+// the routes that are allocated some token in along with the total estimated amount out.
+func (k Keeper) splitTokenInAcrossRoutes(ctx sdk.Context, routes []estimatedRoute, tokenIn sdk.Coin) ([]types.SwapAmountInSplitRoute, osmomath.Int, error) {
+	bestSingleRoute := []types.SwapAmountInSplitRoute{{Pools: routes[0].route, TokenInAmount: tokenIn.Amount}}
+	if len(routes) == 1 {
+		return bestSingleRoute, routes[0].tokenOutAmount, nil
+	}
+
+	tokenInAmounts := make([]osmomath.Int, len(routes))
+	tokenOutAmounts := make([]osmomath.Int, len(routes))
+	for i := range routes {
+		tokenInAmounts[i] = osmomath.ZeroInt()
+		tokenOutAmounts[i] = osmomath.ZeroInt()
+	}
+
+	for step := int64(0); step < routeFinderSplitSteps; step++ {
+		// The parts are computed so that they sum up to the token in amount.
+		increment := tokenIn.Amount.MulRaw(step + 1).QuoRaw(routeFinderSplitSteps).Sub(tokenIn.Amount.MulRaw(step).QuoRaw(routeFinderSplitSteps))
+		if increment.IsZero() {
+			continue
+		}
+
+		bestIndex := -1
+		bestGain, bestTokenOutAmount := osmomath.Int{}, osmomath.Int{}
+		for i, route := range routes {
+			tokenOutAmount, err := k.estimateRouteExactAmountIn(ctx, route.route, sdk.Coin{Denom: tokenIn.Denom, Amount: tokenInAmounts[i].Add(increment)})
+			if err != nil {
+				continue
+			}
+			gain := tokenOutAmount.Sub(tokenOutAmounts[i])
+			if bestIndex == -1 || gain.GT(bestGain) {
+				bestIndex, bestGain, bestTokenOutAmount = i, gain, tokenOutAmount
+			}
+		}
+		// Each route can at least swap the whole token in, so this only happens if estimates are inconsistent.
+		if bestIndex == -1 {
+			return bestSingleRoute, routes[0].tokenOutAmount, nil
+		}
+
+		tokenInAmounts[bestIndex] = tokenInAmounts[bestIndex].Add(increment)
+		tokenOutAmounts[bestIndex] = bestTokenOutAmount
+	}
+
+	splitRoutes := []types.SwapAmountInSplitRoute{}
+	totalTokenOutAmount := osmomath.ZeroInt()
+	for i, route := range routes {
+		if tokenInAmounts[i].IsZero() {
+			continue
+		}
+		splitRoutes = append(splitRoutes, types.SwapAmountInSplitRoute{Pools: route.route, TokenInAmount: tokenInAmounts[i]})
+		totalTokenOutAmount = totalTokenOutAmount.Add(tokenOutAmounts[i])
+	}
+
+	// The greedy split is not guaranteed to beat the best route for pools with non-concave amounts out.
+	if totalTokenOutAmount.LTE(routes[0].tokenOutAmount) {
+		return bestSingleRoute, routes[0].tokenOutAmount, nil
+	}
+	return splitRoutes, totalTokenOutAmount, nil
+}
+
+// estimateRouteExactAmountIn estimates the amount out of swapping token in through the route, taker fees included.
+// The estimate runs in a cache context so that it never writes to state.
+func (k Keeper) estimateRouteExactAmountIn(ctx sdk.Context, route []types.SwapAmountInRoute, tokenIn sdk.Coin) (osmomath.Int, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	tokenOutAmount, err := k.MultihopEstimateOutGivenExactAmountIn(cacheCtx, route, tokenIn)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	if !tokenOutAmount.IsPositive() {
+		return osmomath.Int{}, types.FinalAmountIsNotPositiveError{IsAmountOut: true, Amount: tokenOutAmount}
+	}
+	return tokenOutAmount, nil
+}
+
+// routeFinder searches routes between two denoms, memoizing the pools of each denom and the denoms of each pool.
+type routeFinder struct {
+	k   Keeper
+	ctx sdk.Context
+
+	tokenInDenom  string
+	tokenOutDenom string
+
+	poolsByDenom map[string][]uint64
+	poolDenoms   map[uint64][]string
+	candidates   [][]types.SwapAmountInRoute
+}
+
+func newRouteFinder(k Keeper, ctx sdk.Context, tokenInDenom, tokenOutDenom string) *routeFinder {
+	return &routeFinder{
+		k:             k,
+		ctx:           ctx,
+		tokenInDenom:  tokenInDenom,
+		tokenOutDenom: tokenOutDenom,
+		poolsByDenom:  map[string][]uint64{},
+		poolDenoms:    map[uint64][]string{},
+	}
+}
+
+// findCandidateRoutes returns up to routeFinderMaxCandidates routes from token in to token out with at most maxHops
+// pools, ordered by number of hops and then by pool ids.
+func (f *routeFinder) findCandidateRoutes(maxHops int) ([][]types.SwapAmountInRoute, error) {
+	for hops := 1; hops <= maxHops; hops++ {
+		visitedDenoms := map[string]bool{f.tokenInDenom: true}
+		if err := f.search(nil, f.tokenInDenom, hops, map[uint64]bool{}, visitedDenoms); err != nil {
+			return nil, err
+		}
+		if len(f.candidates) >= routeFinderMaxCandidates {
+			break
+		}
+	}
+	return f.candidates, nil
+}
+
+// search appends to the candidates the routes from denom to token out with exactly hops pools, extending route.
+func (f *routeFinder) search(route []types.SwapAmountInRoute, denom string, hops int, usedPools map[uint64]bool, visitedDenoms map[string]bool) error {
+	if hops == 1 {
+		// The last pool must contain token out, so the pools of token out are searched instead of the pools of
+		// denom. This avoids listing the pools of every denom one hop away from token out.
+		tokenOutPools, err := f.poolsWithDenom(f.tokenOutDenom)
+		if err != nil {
+			return err
+		}
+		for _, poolId := range tokenOutPools {
+			if len(f.candidates) >= routeFinderMaxCandidates {
+				return nil
+			}
+			if usedPools[poolId] || !slices.Contains(f.poolDenoms[poolId], denom) {
+				continue
+			}
+			candidate := append(slices.Clone(route), types.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: f.tokenOutDenom})
+			f.candidates = append(f.candidates, candidate)
+		}
+		return nil
+	}
+
+	pools, err := f.poolsWithDenom(denom)
+	if err != nil {
+		return err
+	}
+	for _, poolId := range pools {
+		if usedPools[poolId] {
+			continue
+		}
+		for _, nextDenom := range f.poolDenoms[poolId] {
+			if len(f.candidates) >= routeFinderMaxCandidates {
+				return nil
+			}
+			if visitedDenoms[nextDenom] || nextDenom == f.tokenOutDenom {
+				continue
+			}
+
+			usedPools[poolId], visitedDenoms[nextDenom] = true, true
+			err := f.search(append(route, types.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: nextDenom}), nextDenom, hops-1, usedPools, visitedDenoms)
+			delete(usedPools, poolId)
+			delete(visitedDenoms, nextDenom)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// poolsWithDenom returns the ids of the active pools containing denom, sorted by id.
+func (f *routeFinder) poolsWithDenom(denom string) ([]uint64, error) {
+	if poolIds, ok := f.poolsByDenom[denom]; ok {
+		return poolIds, nil
+	}
+
+	pools, err := f.k.ListPoolsByDenom(f.ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIds := []uint64{}
+	for _, pool := range pools {
+		if !pool.IsActive(f.ctx) {
+			continue
+		}
+		poolId := pool.GetId()
+		if _, ok := f.poolDenoms[poolId]; !ok {
+			denoms, err := f.k.RouteGetPoolDenoms(f.ctx, poolId)
+			if err != nil {
+				continue
+			}
+			f.poolDenoms[poolId] = denoms
+		}
+		poolIds = append(poolIds, poolId)
+	}
+	f.poolsByDenom[denom] = poolIds
+	return poolIds, nil
+}
@@ -0,0 +1,125 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestBestSplitRouteExactAmountIn() {
+	tests := map[string]struct {
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		maxHops       uint64
+		maxSplits     uint64
+
+		expectedRoutes []types.SwapAmountInSplitRoute
+		expectSplit    bool
+		expectedErr    error
+		expectErr      bool
+	}{
+		"small swap goes through a single direct pool": {
+			tokenIn:       sdk.NewInt64Coin(FOO, 1_000),
+			tokenOutDenom: BAR,
+			maxSplits:     1,
+			expectedRoutes: []types.SwapAmountInSplitRoute{
+				{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: BAR}}, TokenInAmount: osmomath.NewInt(1_000)},
+			},
+		},
+		"large swap is split across disjoint routes": {
+			tokenIn:       sdk.NewInt64Coin(FOO, 300_000),
+			tokenOutDenom: BAR,
+			expectSplit:   true,
+		},
+		"multi hop route": {
+			tokenIn:       sdk.NewInt64Coin(FOO, 1_000),
+			tokenOutDenom: UOSMO,
+			maxSplits:     1,
+			expectedRoutes: []types.SwapAmountInSplitRoute{
+				{Pools: []types.SwapAmountInRoute{{PoolId: 3, TokenOutDenom: BAZ}, {PoolId: 5, TokenOutDenom: UOSMO}}, TokenInAmount: osmomath.NewInt(1_000)},
+			},
+		},
+		"error: no route within max hops": {
+			tokenIn:       sdk.NewInt64Coin(FOO, 1_000),
+			tokenOutDenom: UOSMO,
+			maxHops:       1,
+			expectedErr:   types.NoRouteFoundError{TokenInDenom: FOO, TokenOutDenom: UOSMO},
+		},
+		"error: same token in and token out denoms": {
+			tokenIn:       sdk.NewInt64Coin(FOO, 1_000),
+			tokenOutDenom: FOO,
+			expectErr:     true,
+		},
+		"error: zero token in": {
+			tokenIn:       sdk.NewInt64Coin(FOO, 0),
+			tokenOutDenom: BAR,
+			expectErr:     true,
+		},
+		"error: max hops too large": {
+			tokenIn:       sdk.NewInt64Coin(FOO, 1_000),
+			tokenOutDenom: BAR,
+			maxHops:       5,
+			expectErr:     true,
+		},
+		"error: max splits too large": {
+			tokenIn:       sdk.NewInt64Coin(FOO, 1_000),
+			tokenOutDenom: BAR,
+			maxSplits:     6,
+			expectErr:     true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.createBalancerPoolsFromCoins([]sdk.Coins{
+				sdk.NewCoins(sdk.NewInt64Coin(FOO, 1_000_000), sdk.NewInt64Coin(BAR, 1_000_000)),
+				sdk.NewCoins(sdk.NewInt64Coin(FOO, 1_000_000), sdk.NewInt64Coin(BAR, 1_000_000)),
+				sdk.NewCoins(sdk.NewInt64Coin(FOO, 1_000_000), sdk.NewInt64Coin(BAZ, 1_000_000)),
+				sdk.NewCoins(sdk.NewInt64Coin(BAZ, 1_000_000), sdk.NewInt64Coin(BAR, 1_000_000)),
+				sdk.NewCoins(sdk.NewInt64Coin(BAZ, 1_000_000), sdk.NewInt64Coin(UOSMO, 1_000_000)),
+			})
+
+			routes, tokenOutAmount, err := s.App.PoolManagerKeeper.BestSplitRouteExactAmountIn(s.Ctx, tc.tokenIn, tc.tokenOutDenom, tc.maxHops, tc.maxSplits)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			if tc.expectedRoutes != nil {
+				s.Require().Equal(tc.expectedRoutes, routes)
+			}
+
+			totalTokenInAmount := osmomath.ZeroInt()
+			usedPools := map[uint64]bool{}
+			for _, route := range routes {
+				totalTokenInAmount = totalTokenInAmount.Add(route.TokenInAmount)
+				for _, step := range route.Pools {
+					s.Require().False(usedPools[step.PoolId], "pool %d is used by several routes", step.PoolId)
+					usedPools[step.PoolId] = true
+				}
+			}
+			s.Require().Equal(tc.tokenIn.Amount, totalTokenInAmount)
+
+			if tc.expectSplit {
+				s.Require().Greater(len(routes), 1)
+				bestSingleRoute, bestSingleRouteAmountOut, err := s.App.PoolManagerKeeper.BestSplitRouteExactAmountIn(s.Ctx, tc.tokenIn, tc.tokenOutDenom, tc.maxHops, 1)
+				s.Require().NoError(err)
+				s.Require().Len(bestSingleRoute, 1)
+				s.Require().True(tokenOutAmount.GT(bestSingleRouteAmountOut))
+			}
+
+			// The routes can be swapped through and the estimated amount out is received.
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(tc.tokenIn))
+			actualTokenOutAmount, err := s.App.PoolManagerKeeper.SplitRouteExactAmountIn(s.Ctx, s.TestAccs[0], routes, tc.tokenIn.Denom, osmomath.OneInt())
+			s.Require().NoError(err)
+			s.Require().Equal(tokenOutAmount, actualTokenOutAmount)
+		})
+	}
+}
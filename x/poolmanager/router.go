@@ -204,7 +204,7 @@ func (k Keeper) SwapExactAmountIn(
 	}
 
 	// Track volume for volume-splitting incentives
-	k.trackVolume(ctx, pool.GetId(), tokenIn)
+	k.trackVolume(ctx, pool.GetId(), tokenIn, tokenOutDenom)
 
 	return tokenOutAmount, takerFeeCharged, nil
 }
@@ -239,7 +239,7 @@ func (k Keeper) SwapExactAmountInNoTakerFee(
 	}
 
 	// Track volume for volume-splitting incentives
-	k.trackVolume(ctx, pool.GetId(), tokenIn)
+	k.trackVolume(ctx, pool.GetId(), tokenIn, tokenOutDenom)
 
 	return tokenOutAmount, nil
 }
@@ -249,7 +249,7 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountInNoTakerFee(
 	route []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 ) (tokenOutAmount osmomath.Int, err error) {
-	return k.multihopEstimateOutGivenExactAmountInInternal(ctx, route, tokenIn, false)
+	return k.multihopEstimateOutGivenExactAmountInInternal(ctx, route, tokenIn, false, nil)
 }
 
 func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
@@ -257,7 +257,18 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 	route []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 ) (tokenOutAmount osmomath.Int, err error) {
-	return k.multihopEstimateOutGivenExactAmountInInternal(ctx, route, tokenIn, true)
+	return k.multihopEstimateOutGivenExactAmountInInternal(ctx, route, tokenIn, true, nil)
+}
+
+// MultihopEstimateOutGivenExactAmountInForSender is the same as MultihopEstimateOutGivenExactAmountIn,
+// but estimates the taker fee charged to the given sender, applying its taker fee discount.
+func (k Keeper) MultihopEstimateOutGivenExactAmountInForSender(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+) (tokenOutAmount osmomath.Int, err error) {
+	return k.multihopEstimateOutGivenExactAmountInInternal(ctx, route, tokenIn, true, sender)
 }
 
 func (k Keeper) multihopEstimateOutGivenExactAmountInInternal(
@@ -265,6 +276,7 @@ func (k Keeper) multihopEstimateOutGivenExactAmountInInternal(
 	route []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 	applyTakerFee bool,
+	sender sdk.AccAddress,
 ) (tokenOutAmount osmomath.Int, err error) {
 	// recover from panic
 	defer func() {
@@ -293,7 +305,7 @@ func (k Keeper) multihopEstimateOutGivenExactAmountInInternal(
 		actualTokenIn := tokenIn
 		// apply taker fee if applicable
 		if applyTakerFee {
-			takerFee, err := k.GetTradingPairTakerFee(ctx, tokenIn.Denom, routeStep.TokenOutDenom)
+			takerFee, err := k.getTakerFeeForSender(ctx, tokenIn.Denom, routeStep.TokenOutDenom, sender)
 			if err != nil {
 				return osmomath.Int{}, err
 			}
@@ -349,7 +361,7 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	}()
 
 	var insExpected []osmomath.Int
-	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, route, tokenOut)
+	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, route, tokenOut, sender)
 
 	if err != nil {
 		return osmomath.Int{}, err
@@ -403,7 +415,7 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		}
 
 		// Track volume for volume-splitting incentives
-		k.trackVolume(ctx, pool.GetId(), sdk.NewCoin(routeStep.TokenInDenom, tokenIn.Amount))
+		k.trackVolume(ctx, pool.GetId(), sdk.NewCoin(routeStep.TokenInDenom, tokenIn.Amount), _tokenOut.Denom)
 
 		// Sets the final amount of tokens that need to be input into the first pool. Even though this is the final return value for the
 		// whole method and will not change after the first iteration, we still iterate through the rest of the pools to execute their respective
@@ -539,6 +551,18 @@ func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 	ctx sdk.Context,
 	route []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
+) (tokenInAmount osmomath.Int, err error) {
+	return k.MultihopEstimateInGivenExactAmountOutForSender(ctx, nil, route, tokenOut)
+}
+
+// MultihopEstimateInGivenExactAmountOutForSender is the same as MultihopEstimateInGivenExactAmountOut,
+// but estimates the taker fee charged to the given sender, applying its taker fee discount.
+// If the sender is empty, the taker fee of each trading pair is applied.
+func (k Keeper) MultihopEstimateInGivenExactAmountOutForSender(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountOutRoute,
+	tokenOut sdk.Coin,
 ) (tokenInAmount osmomath.Int, err error) {
 	var insExpected []osmomath.Int
 
@@ -560,7 +584,7 @@ func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 	}
 
 	// Determine what the estimated input would be for each pool along the multi-hop route
-	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, route, tokenOut)
+	insExpected, err = k.createMultihopExpectedSwapOuts(ctx, route, tokenOut, sender)
 	if err != nil {
 		return osmomath.Int{}, err
 	}
@@ -638,10 +662,12 @@ func (k Keeper) ListPoolsByDenom(
 // amount for this last pool and then chains that input as the output of the previous pool in the routeStep, repeating
 // until the first pool is reached. It returns an array of inputs, each of which correspond to a pool ID in the
 // routeStep of pools for the original multihop transaction.
+// The taker fee is the one charged to the given sender, or the taker fee of each trading pair if the sender is empty.
 func (k Keeper) createMultihopExpectedSwapOuts(
 	ctx sdk.Context,
 	route []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
+	sender sdk.AccAddress,
 ) ([]osmomath.Int, error) {
 	insExpected := make([]osmomath.Int, len(route))
 	for i := len(route) - 1; i >= 0; i-- {
@@ -654,7 +680,7 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 
 		spreadFactor := poolI.GetSpreadFactor(ctx)
 
-		takerFee, err := k.GetTradingPairTakerFee(ctx, routeStep.TokenInDenom, tokenOut.Denom)
+		takerFee, err := k.getTakerFeeForSender(ctx, routeStep.TokenInDenom, tokenOut.Denom, sender)
 		if err != nil {
 			return nil, err
 		}
@@ -707,13 +733,14 @@ func (k Keeper) TotalLiquidity(ctx sdk.Context) (sdk.Coins, error) {
 }
 
 // nolint: unused
-// trackVolume converts the input token into OSMO units and adds it to the global tracked volume for the given pool ID.
+// trackVolume converts the input token into OSMO units and adds it to the global tracked volume for the given pool ID,
+// as well as to the current volume bucket of the pool and of the swapped denom pair.
 // Fails quietly if an OSMO paired pool cannot be found, although this should only happen in rare scenarios where OSMO is
 // removed as a base denom from the protorev module (which this function relies on).
 //
 // CONTRACT: `volumeGenerated` corresponds to one of the denoms in the pool
 // CONTRACT: pool with `poolId` exists
-func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin) {
+func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin, tokenOutDenom string) {
 	// If the denom is already denominated in uosmo, we can just use it directly
 	OSMO, err := k.stakingKeeper.BondDenom(ctx)
 	if err != nil {
@@ -721,6 +748,7 @@ func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.
 	}
 	if volumeGenerated.Denom == OSMO {
 		k.addVolume(ctx, poolId, volumeGenerated)
+		k.addVolumeToBuckets(ctx, poolId, volumeGenerated.Denom, tokenOutDenom, volumeGenerated)
 		return
 	}
 
@@ -757,6 +785,7 @@ func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.
 
 	// Add this new volume to the global tracked volume for the pool ID
 	k.addVolume(ctx, poolId, sdk.NewCoin(OSMO, volumeInOsmo))
+	k.addVolumeToBuckets(ctx, poolId, volumeGenerated.Denom, tokenOutDenom, sdk.NewCoin(OSMO, volumeInOsmo))
 }
 
 // addVolume adds the given volume to the global tracked volume for the given pool ID.
@@ -3346,9 +3346,9 @@ func (suite *KeeperTestSuite) TestCreateMultihopExpectedSwapOuts() {
 }
 
 // runMultipleTrackVolumes runs TrackVolume on the same pool multiple times
-func (s *KeeperTestSuite) runMultipleTrackVolumes(poolId uint64, volume sdk.Coin, times int64) {
+func (s *KeeperTestSuite) runMultipleTrackVolumes(poolId uint64, volume sdk.Coin, tokenOutDenom string, times int64) {
 	for i := 0; i < int(times); i++ {
-		s.App.PoolManagerKeeper.TrackVolume(s.Ctx, poolId, volume)
+		s.App.PoolManagerKeeper.TrackVolume(s.Ctx, poolId, volume, tokenOutDenom)
 	}
 }
 
@@ -3579,7 +3579,7 @@ func (s *KeeperTestSuite) TestTrackVolume() {
 			// --- System under test ---
 
 			// Run TrackVolume the specified number of times. Note that this function fails quietly in all error cases.
-			s.runMultipleTrackVolumes(targetPoolId, tc.generatedVolume, tc.timesRun)
+			s.runMultipleTrackVolumes(targetPoolId, tc.generatedVolume, BAR, tc.timesRun)
 
 			// --- Assertions ---
 
@@ -3590,6 +3590,12 @@ func (s *KeeperTestSuite) TestTrackVolume() {
 			// We wrap with sdk.NewCoins() to sanitize the outputs for comparison in case they are empty.
 			totalVolume := s.App.PoolManagerKeeper.GetTotalVolumeForPool(s.Ctx, targetPoolId)
 			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(UOSMO, tc.expectedVolume)), sdk.NewCoins(totalVolume...))
+
+			// Assert that the same volume was added to the current volume bucket of the pool and of the swapped denom pair
+			poolVolumeBucket := s.App.PoolManagerKeeper.GetPoolVolumeBucket(s.Ctx, 0, targetPoolId)
+			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(UOSMO, tc.expectedVolume)), sdk.NewCoins(poolVolumeBucket...))
+			denomPairVolumeBucket := s.App.PoolManagerKeeper.GetDenomPairVolumeBucket(s.Ctx, 0, BAR, tc.generatedVolume.Denom)
+			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(UOSMO, tc.expectedVolume)), sdk.NewCoins(denomPairVolumeBucket...))
 		})
 	}
 }
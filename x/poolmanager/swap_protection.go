@@ -0,0 +1,243 @@
+package poolmanager
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/poolmanager/types"
+)
+
+// The functions in this file wrap the routing functions with the optional protections of the swap messages:
+//   - deadline: the swap fails if the block time is after the deadline.
+//   - maxPriceImpact: the swap fails if the execution price is worse than the route spot price before the swap
+//     by more than maxPriceImpact, relative to the spot price. The price impact includes spread and taker fees.
+//
+// A nil deadline and a nil or zero maxPriceImpact disable the respective protection.
+
+// RouteExactAmountInWithSwapProtection executes RouteExactAmountIn, enforcing the given deadline and maximum price impact.
+func (k Keeper) RouteExactAmountInWithSwapProtection(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	tokenOutMinAmount osmomath.Int,
+	deadline *time.Time,
+	maxPriceImpact osmomath.Dec,
+) (osmomath.Int, error) {
+	if err := checkSwapDeadline(ctx, deadline); err != nil {
+		return osmomath.Int{}, err
+	}
+	if !isPriceImpactProtected(maxPriceImpact) {
+		return k.RouteExactAmountIn(ctx, sender, route, tokenIn, tokenOutMinAmount)
+	}
+
+	spotPrice, err := k.routeSpotPriceExactIn(ctx, route, tokenIn.Denom)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	tokenOutAmount, err := k.RouteExactAmountIn(ctx, sender, route, tokenIn, tokenOutMinAmount)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	expectedTokenOut := osmomath.BigDecFromSDKInt(tokenIn.Amount).MulMut(spotPrice)
+	if err := checkMaxPriceImpactExactIn(maxPriceImpact, expectedTokenOut, tokenOutAmount); err != nil {
+		return osmomath.Int{}, err
+	}
+	return tokenOutAmount, nil
+}
+
+// RouteExactAmountOutWithSwapProtection executes RouteExactAmountOut, enforcing the given deadline and maximum price impact.
+func (k Keeper) RouteExactAmountOutWithSwapProtection(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountOutRoute,
+	tokenInMaxAmount osmomath.Int,
+	tokenOut sdk.Coin,
+	deadline *time.Time,
+	maxPriceImpact osmomath.Dec,
+) (osmomath.Int, error) {
+	if err := checkSwapDeadline(ctx, deadline); err != nil {
+		return osmomath.Int{}, err
+	}
+	if !isPriceImpactProtected(maxPriceImpact) {
+		return k.RouteExactAmountOut(ctx, sender, route, tokenInMaxAmount, tokenOut)
+	}
+
+	spotPrice, err := k.routeSpotPriceExactOut(ctx, route, tokenOut.Denom)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	tokenInAmount, err := k.RouteExactAmountOut(ctx, sender, route, tokenInMaxAmount, tokenOut)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	expectedTokenIn := osmomath.BigDecFromSDKInt(tokenOut.Amount).QuoMut(spotPrice)
+	if err := checkMaxPriceImpactExactOut(maxPriceImpact, expectedTokenIn, tokenInAmount); err != nil {
+		return osmomath.Int{}, err
+	}
+	return tokenInAmount, nil
+}
+
+// SplitRouteExactAmountInWithSwapProtection executes SplitRouteExactAmountIn, enforcing the given deadline and maximum price impact.
+// The price impact is measured on the total amount out, against the sum of the amounts out expected from the spot price of each route.
+func (k Keeper) SplitRouteExactAmountInWithSwapProtection(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	tokenOutMinAmount osmomath.Int,
+	deadline *time.Time,
+	maxPriceImpact osmomath.Dec,
+) (osmomath.Int, error) {
+	if err := checkSwapDeadline(ctx, deadline); err != nil {
+		return osmomath.Int{}, err
+	}
+	if !isPriceImpactProtected(maxPriceImpact) {
+		return k.SplitRouteExactAmountIn(ctx, sender, routes, tokenInDenom, tokenOutMinAmount)
+	}
+
+	// Spot prices are all computed before swapping, since routes may share pools.
+	expectedTokenOut := osmomath.ZeroBigDec()
+	for _, route := range routes {
+		spotPrice, err := k.routeSpotPriceExactIn(ctx, route.Pools, tokenInDenom)
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+		expectedTokenOut.AddMut(osmomath.BigDecFromSDKInt(route.TokenInAmount).MulMut(spotPrice))
+	}
+
+	tokenOutAmount, err := k.SplitRouteExactAmountIn(ctx, sender, routes, tokenInDenom, tokenOutMinAmount)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	if err := checkMaxPriceImpactExactIn(maxPriceImpact, expectedTokenOut, tokenOutAmount); err != nil {
+		return osmomath.Int{}, err
+	}
+	return tokenOutAmount, nil
+}
+
+// SplitRouteExactAmountOutWithSwapProtection executes SplitRouteExactAmountOut, enforcing the given deadline and maximum price impact.
+// The price impact is measured on the total amount in, against the sum of the amounts in expected from the spot price of each route.
+func (k Keeper) SplitRouteExactAmountOutWithSwapProtection(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountOutSplitRoute,
+	tokenOutDenom string,
+	tokenInMaxAmount osmomath.Int,
+	deadline *time.Time,
+	maxPriceImpact osmomath.Dec,
+) (osmomath.Int, error) {
+	if err := checkSwapDeadline(ctx, deadline); err != nil {
+		return osmomath.Int{}, err
+	}
+	if !isPriceImpactProtected(maxPriceImpact) {
+		return k.SplitRouteExactAmountOut(ctx, sender, routes, tokenOutDenom, tokenInMaxAmount)
+	}
+
+	// Spot prices are all computed before swapping, since routes may share pools.
+	expectedTokenIn := osmomath.ZeroBigDec()
+	for _, route := range routes {
+		spotPrice, err := k.routeSpotPriceExactOut(ctx, route.Pools, tokenOutDenom)
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+		expectedTokenIn.AddMut(osmomath.BigDecFromSDKInt(route.TokenOutAmount).QuoMut(spotPrice))
+	}
+
+	tokenInAmount, err := k.SplitRouteExactAmountOut(ctx, sender, routes, tokenOutDenom, tokenInMaxAmount)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	if err := checkMaxPriceImpactExactOut(maxPriceImpact, expectedTokenIn, tokenInAmount); err != nil {
+		return osmomath.Int{}, err
+	}
+	return tokenInAmount, nil
+}
+
+// routeSpotPriceExactIn returns the spot price of the route, in units of the final token out per unit of token in.
+func (k Keeper) routeSpotPriceExactIn(ctx sdk.Context, route []types.SwapAmountInRoute, tokenInDenom string) (osmomath.BigDec, error) {
+	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	spotPrice := osmomath.OneBigDec()
+	for _, routeStep := range route {
+		stepSpotPrice, err := k.RouteCalculateSpotPrice(ctx, routeStep.PoolId, routeStep.TokenOutDenom, tokenInDenom)
+		if err != nil {
+			return osmomath.BigDec{}, err
+		}
+		spotPrice.MulMut(stepSpotPrice)
+		tokenInDenom = routeStep.TokenOutDenom
+	}
+	if !spotPrice.IsPositive() {
+		return osmomath.BigDec{}, errors.New("route spot price must be positive")
+	}
+	return spotPrice, nil
+}
+
+// routeSpotPriceExactOut returns the spot price of the route, in units of the token out per unit of the first token in.
+func (k Keeper) routeSpotPriceExactOut(ctx sdk.Context, route []types.SwapAmountOutRoute, tokenOutDenom string) (osmomath.BigDec, error) {
+	if err := types.SwapAmountOutRoutes(route).Validate(); err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	spotPrice := osmomath.OneBigDec()
+	for i, routeStep := range route {
+		stepTokenOutDenom := tokenOutDenom
+		if i != len(route)-1 {
+			stepTokenOutDenom = route[i+1].TokenInDenom
+		}
+		stepSpotPrice, err := k.RouteCalculateSpotPrice(ctx, routeStep.PoolId, stepTokenOutDenom, routeStep.TokenInDenom)
+		if err != nil {
+			return osmomath.BigDec{}, err
+		}
+		spotPrice.MulMut(stepSpotPrice)
+	}
+	if !spotPrice.IsPositive() {
+		return osmomath.BigDec{}, errors.New("route spot price must be positive")
+	}
+	return spotPrice, nil
+}
+
+// checkSwapDeadline returns an error if the block time is after the deadline.
+func checkSwapDeadline(ctx sdk.Context, deadline *time.Time) error {
+	if deadline != nil && ctx.BlockTime().After(*deadline) {
+		return types.SwapDeadlineExceededError{Deadline: *deadline, BlockTime: ctx.BlockTime()}
+	}
+	return nil
+}
+
+func isPriceImpactProtected(maxPriceImpact osmomath.Dec) bool {
+	return !maxPriceImpact.IsNil() && !maxPriceImpact.IsZero()
+}
+
+// checkMaxPriceImpactExactIn checks the price impact of receiving tokenOutAmount when expectedTokenOut was expected at spot price.
+func checkMaxPriceImpactExactIn(maxPriceImpact osmomath.Dec, expectedTokenOut osmomath.BigDec, tokenOutAmount osmomath.Int) error {
+	return checkMaxPriceImpact(maxPriceImpact, osmomath.BigDecFromSDKInt(tokenOutAmount).QuoMut(expectedTokenOut))
+}
+
+// checkMaxPriceImpactExactOut checks the price impact of paying tokenInAmount when expectedTokenIn was expected at spot price.
+func checkMaxPriceImpactExactOut(maxPriceImpact osmomath.Dec, expectedTokenIn osmomath.BigDec, tokenInAmount osmomath.Int) error {
+	if !tokenInAmount.IsPositive() {
+		return types.FinalAmountIsNotPositiveError{IsAmountOut: false, Amount: tokenInAmount}
+	}
+	return checkMaxPriceImpact(maxPriceImpact, expectedTokenIn.QuoMut(osmomath.BigDecFromSDKInt(tokenInAmount)))
+}
+
+// checkMaxPriceImpact checks that one minus the ratio of the execution price to the spot price is at most maxPriceImpact.
+func checkMaxPriceImpact(maxPriceImpact osmomath.Dec, executionToSpotPriceRatio osmomath.BigDec) error {
+	priceImpact := osmomath.OneBigDec().SubMut(executionToSpotPriceRatio)
+	if priceImpact.GT(osmomath.BigDecFromDec(maxPriceImpact)) {
+		return types.MaxPriceImpactExceededError{Actual: priceImpact.DecRoundUp(), Max: maxPriceImpact}
+	}
+	return nil
+}
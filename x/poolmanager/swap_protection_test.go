@@ -0,0 +1,168 @@
+package poolmanager_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/poolmanager/types"
+)
+
+var (
+	swapProtectionBlockTime = time.Unix(1_700_000_000, 0).UTC()
+	swapProtectionPast      = swapProtectionBlockTime.Add(-time.Second)
+	swapProtectionFuture    = swapProtectionBlockTime.Add(time.Second)
+)
+
+type swapProtectionTestCase struct {
+	amount         int64
+	deadline       *time.Time
+	maxPriceImpact osmomath.Dec
+
+	expectedErr error
+}
+
+// swapProtectionTestCases returns the test cases shared by the swap protection tests.
+// Pools hold 1_000_000 of each asset without spread factor, so swapping 1_000 has
+// a price impact below 1% on each hop, while swapping 200_000 has a price impact above 15%.
+func swapProtectionTestCases() map[string]swapProtectionTestCase {
+	return map[string]swapProtectionTestCase{
+		"no protection": {
+			amount: 200_000,
+		},
+		"deadline not reached": {
+			amount:   1_000,
+			deadline: &swapProtectionFuture,
+		},
+		"deadline equal to block time": {
+			amount:   1_000,
+			deadline: &swapProtectionBlockTime,
+		},
+		"error: deadline exceeded": {
+			amount:      1_000,
+			deadline:    &swapProtectionPast,
+			expectedErr: types.SwapDeadlineExceededError{},
+		},
+		"price impact within max": {
+			amount:         1_000,
+			maxPriceImpact: osmomath.NewDecWithPrec(2, 2),
+		},
+		"zero max price impact is not enforced": {
+			amount:         200_000,
+			maxPriceImpact: osmomath.ZeroDec(),
+		},
+		"error: price impact exceeds max": {
+			amount:         200_000,
+			maxPriceImpact: osmomath.NewDecWithPrec(15, 2),
+			expectedErr:    types.MaxPriceImpactExceededError{},
+		},
+	}
+}
+
+// setupSwapProtectionPools creates the given number of zero spread factor pools
+// between foo and bar, followed by a bar/baz pool, and disables taker fees.
+func (s *KeeperTestSuite) setupSwapProtectionPools(fooBarPools int) {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(swapProtectionBlockTime)
+
+	poolManagerParams := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+	poolManagerParams.TakerFeeParams.DefaultTakerFee = osmomath.ZeroDec()
+	s.App.PoolManagerKeeper.SetParams(s.Ctx, poolManagerParams)
+
+	poolCoins := []sdk.Coins{}
+	for i := 0; i < fooBarPools; i++ {
+		poolCoins = append(poolCoins, sdk.NewCoins(sdk.NewInt64Coin(FOO, 1_000_000), sdk.NewInt64Coin(BAR, 1_000_000)))
+	}
+	poolCoins = append(poolCoins, sdk.NewCoins(sdk.NewInt64Coin(BAR, 1_000_000), sdk.NewInt64Coin(BAZ, 1_000_000)))
+	s.createBalancerPoolsFromCoins(poolCoins)
+
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin(FOO, 10_000_000), sdk.NewInt64Coin(BAR, 10_000_000)))
+}
+
+func (s *KeeperTestSuite) requireSwapProtectionResult(tc swapProtectionTestCase, err error) {
+	switch tc.expectedErr.(type) {
+	case nil:
+		s.Require().NoError(err)
+	case types.SwapDeadlineExceededError:
+		s.Require().ErrorAs(err, &types.SwapDeadlineExceededError{})
+	case types.MaxPriceImpactExceededError:
+		s.Require().ErrorAs(err, &types.MaxPriceImpactExceededError{})
+	default:
+		s.FailNow("unexpected error type", tc.expectedErr)
+	}
+}
+
+func (s *KeeperTestSuite) TestRouteExactAmountInWithSwapProtection() {
+	for name, tc := range swapProtectionTestCases() {
+		s.Run(name, func() {
+			s.setupSwapProtectionPools(1)
+			route := []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: BAR}, {PoolId: 2, TokenOutDenom: BAZ}}
+
+			tokenOutAmount, err := s.App.PoolManagerKeeper.RouteExactAmountInWithSwapProtection(
+				s.Ctx, s.TestAccs[0], route, sdk.NewInt64Coin(FOO, tc.amount), osmomath.OneInt(), tc.deadline, tc.maxPriceImpact)
+
+			s.requireSwapProtectionResult(tc, err)
+			if tc.expectedErr == nil {
+				s.Require().True(tokenOutAmount.IsPositive())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRouteExactAmountOutWithSwapProtection() {
+	for name, tc := range swapProtectionTestCases() {
+		s.Run(name, func() {
+			s.setupSwapProtectionPools(1)
+			route := []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: FOO}, {PoolId: 2, TokenInDenom: BAR}}
+
+			tokenInAmount, err := s.App.PoolManagerKeeper.RouteExactAmountOutWithSwapProtection(
+				s.Ctx, s.TestAccs[0], route, osmomath.NewInt(10_000_000), sdk.NewInt64Coin(BAZ, tc.amount), tc.deadline, tc.maxPriceImpact)
+
+			s.requireSwapProtectionResult(tc, err)
+			if tc.expectedErr == nil {
+				s.Require().True(tokenInAmount.IsPositive())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSplitRouteExactAmountInWithSwapProtection() {
+	for name, tc := range swapProtectionTestCases() {
+		s.Run(name, func() {
+			s.setupSwapProtectionPools(2)
+			routes := []types.SwapAmountInSplitRoute{
+				{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: BAR}}, TokenInAmount: osmomath.NewInt(tc.amount)},
+				{Pools: []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: BAR}}, TokenInAmount: osmomath.NewInt(tc.amount)},
+			}
+
+			tokenOutAmount, err := s.App.PoolManagerKeeper.SplitRouteExactAmountInWithSwapProtection(
+				s.Ctx, s.TestAccs[0], routes, FOO, osmomath.OneInt(), tc.deadline, tc.maxPriceImpact)
+
+			s.requireSwapProtectionResult(tc, err)
+			if tc.expectedErr == nil {
+				s.Require().True(tokenOutAmount.IsPositive())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSplitRouteExactAmountOutWithSwapProtection() {
+	for name, tc := range swapProtectionTestCases() {
+		s.Run(name, func() {
+			s.setupSwapProtectionPools(2)
+			routes := []types.SwapAmountOutSplitRoute{
+				{Pools: []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: FOO}}, TokenOutAmount: osmomath.NewInt(tc.amount)},
+				{Pools: []types.SwapAmountOutRoute{{PoolId: 2, TokenInDenom: FOO}}, TokenOutAmount: osmomath.NewInt(tc.amount)},
+			}
+
+			tokenInAmount, err := s.App.PoolManagerKeeper.SplitRouteExactAmountOutWithSwapProtection(
+				s.Ctx, s.TestAccs[0], routes, BAR, osmomath.NewInt(10_000_000), tc.deadline, tc.maxPriceImpact)
+
+			s.requireSwapProtectionResult(tc, err)
+			if tc.expectedErr == nil {
+				s.Require().True(tokenInAmount.IsPositive())
+			}
+		})
+	}
+}
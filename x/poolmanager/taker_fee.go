@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 
@@ -18,6 +19,10 @@ import (
 
 var zero = osmomath.ZeroInt()
 
+// TakerFeeDiscountEpochIdentifier is the identifier of the epoch at the end of which the cached staked or locked
+// amounts used to compute taker fee discounts expire.
+const TakerFeeDiscountEpochIdentifier = "day"
+
 func (k *Keeper) GetDefaultTakerFee(ctx sdk.Context) osmomath.Dec {
 	defaultTakerFeeBz := k.paramSpace.GetRaw(ctx, types.KeyDefaultTakerFee)
 	if !bytes.Equal(defaultTakerFeeBz, k.defaultTakerFeeBz) {
@@ -126,16 +131,14 @@ func (k Keeper) GetAllTradingPairTakerFees(ctx sdk.Context) ([]types.DenomPairTa
 // chargeTakerFee extracts the taker fee from the given tokenIn and sends it to the appropriate
 // module account. It returns the tokenIn after the taker fee has been extracted.
 // If the sender is in the taker fee reduced whitelisted, it returns the tokenIn without extracting the taker fee.
+// Otherwise, the taker fee is reduced by the discount of the highest discount tier the sender qualifies for.
 // In the future, we might charge a lower taker fee as opposed to no fee at all.
 // TODO: Gas optimize this function, its expensive in both gas and CPU.
 func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, sdk.Coin, error) {
 	takerFeeModuleAccountName := txfeestypes.TakerFeeCollectorName
 
-	reducedFeeWhitelist := []string{}
-	k.paramSpace.Get(ctx, types.KeyReducedTakerFeeByWhitelist, &reducedFeeWhitelist)
-
 	// Determine if eligible to bypass taker fee.
-	if osmoutils.Contains(reducedFeeWhitelist, sender.String()) {
+	if k.isReducedTakerFeeWhitelisted(ctx, sender) {
 		return tokenIn, sdk.Coin{Denom: tokenIn.Denom, Amount: zero}, nil
 	}
 
@@ -144,6 +147,12 @@ func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	// Apply the discount of the tier the sender qualifies for, if any.
+	takerFee, err = k.applyTakerFeeDiscount(ctx, sender, takerFee)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
 	var tokenInAfterTakerFee sdk.Coin
 	var takerFeeCoin sdk.Coin
 	if exactIn {
@@ -159,6 +168,139 @@ func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom
 	return tokenInAfterTakerFee, takerFeeCoin, nil
 }
 
+// getTakerFeeForSender returns the taker fee the given sender is charged for swapping tokenInDenom for tokenOutDenom,
+// following the same rules as chargeTakerFee. If the sender is empty, the taker fee of the trading pair is returned.
+func (k Keeper) getTakerFeeForSender(ctx sdk.Context, tokenInDenom, tokenOutDenom string, sender sdk.AccAddress) (osmomath.Dec, error) {
+	if !sender.Empty() && k.isReducedTakerFeeWhitelisted(ctx, sender) {
+		return osmomath.ZeroDec(), nil
+	}
+
+	takerFee, err := k.GetTradingPairTakerFee(ctx, tokenInDenom, tokenOutDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	if sender.Empty() {
+		return takerFee, nil
+	}
+	return k.applyTakerFeeDiscount(ctx, sender, takerFee)
+}
+
+// isReducedTakerFeeWhitelisted returns true if the sender is in the reduced taker fee whitelist.
+func (k Keeper) isReducedTakerFeeWhitelisted(ctx sdk.Context, sender sdk.AccAddress) bool {
+	reducedFeeWhitelist := []string{}
+	k.paramSpace.Get(ctx, types.KeyReducedTakerFeeByWhitelist, &reducedFeeWhitelist)
+	return osmoutils.Contains(reducedFeeWhitelist, sender.String())
+}
+
+// applyTakerFeeDiscount returns the taker fee reduced by the discount of the highest discount tier the sender
+// qualifies for. The taker fee is returned unchanged if there are no discount tiers.
+func (k Keeper) applyTakerFeeDiscount(ctx sdk.Context, sender sdk.AccAddress, takerFee osmomath.Dec) (osmomath.Dec, error) {
+	discountTiers := []types.TakerFeeDiscountTier{}
+	k.paramSpace.Get(ctx, types.KeyTakerFeeDiscountTiers, &discountTiers)
+	if len(discountTiers) == 0 {
+		return takerFee, nil
+	}
+	_, discount, err := k.getTakerFeeDiscount(ctx, sender, discountTiers)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	return takerFee.Mul(osmomath.OneDec().Sub(discount)), nil
+}
+
+// GetTakerFeeDiscount returns the amount of OSMO the given address has bonded to validators and locked
+// in x/lockup combined, along with the taker fee discount of the highest discount tier it qualifies for.
+// The discount is zero if the address does not qualify for any tier.
+func (k Keeper) GetTakerFeeDiscount(ctx sdk.Context, address string) (osmomath.Int, osmomath.Dec, error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Dec{}, err
+	}
+
+	discountTiers := []types.TakerFeeDiscountTier{}
+	k.paramSpace.Get(ctx, types.KeyTakerFeeDiscountTiers, &discountTiers)
+	return k.getTakerFeeDiscount(ctx, addr, discountTiers)
+}
+
+// getTakerFeeDiscount returns the amount of OSMO the given address has staked or locked and the discount
+// of the highest of the given discount tiers that this amount reaches. The tiers are sorted by ascending
+// minimum amount, as enforced by the params validation.
+func (k Keeper) getTakerFeeDiscount(ctx sdk.Context, address sdk.AccAddress, discountTiers []types.TakerFeeDiscountTier) (osmomath.Int, osmomath.Dec, error) {
+	stakedOrLockedAmount, err := k.getStakedOrLockedAmount(ctx, address)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Dec{}, err
+	}
+
+	discount := osmomath.ZeroDec()
+	for _, tier := range discountTiers {
+		if stakedOrLockedAmount.LT(tier.MinStakedOrLockedAmount) {
+			break
+		}
+		discount = tier.Discount
+	}
+	return stakedOrLockedAmount, discount, nil
+}
+
+// getStakedOrLockedAmount returns the amount of OSMO the given address has bonded to validators and locked in
+// x/lockup combined. As computing it iterates over all the delegations and locks of the address, the amount is
+// cached until the end of the current taker fee discount epoch, or until the delegations or locks of the address
+// change. Changes that affect all delegators of a validator, such as slashing or unbonding, are only picked up at
+// the end of the epoch.
+func (k Keeper) getStakedOrLockedAmount(ctx sdk.Context, address sdk.AccAddress) (osmomath.Int, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyTakerFeeDiscountCache(address)
+	epoch := k.GetTakerFeeDiscountEpoch(ctx)
+
+	cache := types.TakerFeeDiscountCache{}
+	found, err := osmoutils.Get(store, key, &cache)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	if found && cache.Epoch == epoch {
+		return cache.StakedOrLockedAmount, nil
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	stakedAmount, err := k.stakingKeeper.GetDelegatorBonded(ctx, address)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	lockedAmount := k.lockupKeeper.GetAccountLockedCoins(ctx, address).AmountOf(bondDenom)
+	stakedOrLockedAmount := stakedAmount.Add(lockedAmount)
+
+	osmoutils.MustSet(store, key, &types.TakerFeeDiscountCache{StakedOrLockedAmount: stakedOrLockedAmount, Epoch: epoch})
+	return stakedOrLockedAmount, nil
+}
+
+// invalidateTakerFeeDiscountCache deletes the cached staked or locked amount of the given address,
+// so that it is recomputed on its next swap.
+func (k Keeper) invalidateTakerFeeDiscountCache(ctx sdk.Context, address sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyTakerFeeDiscountCache(address))
+}
+
+// GetTakerFeeDiscountEpoch returns the number of the current taker fee discount epoch.
+func (k Keeper) GetTakerFeeDiscountEpoch(ctx sdk.Context) int64 {
+	epoch := gogotypes.Int64Value{}
+	if _, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyTakerFeeDiscountEpoch, &epoch); err != nil {
+		panic(err)
+	}
+	return epoch.Value
+}
+
+// SetTakerFeeDiscountEpoch sets the number of the current taker fee discount epoch, expiring the cached
+// staked or locked amounts of the previous epochs.
+func (k Keeper) SetTakerFeeDiscountEpoch(ctx sdk.Context, epoch int64) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyTakerFeeDiscountEpoch, &gogotypes.Int64Value{Value: epoch})
+}
+
+// clearTakerFeeDiscountCache deletes the cached staked or locked amounts of all accounts. It is called at the end
+// of each taker fee discount epoch, so that the cache only holds the accounts that swapped during the current epoch.
+func (k Keeper) clearTakerFeeDiscountCache(ctx sdk.Context) {
+	osmoutils.DeleteAllKeysFromPrefix(ctx.KVStore(k.storeKey), types.KeyTakerFeeDiscountCachePrefix)
+}
+
 // Returns remaining amount in to swap, and takerFeeCoins.
 // returns (1 - takerFee) * tokenIn, takerFee * tokenIn
 func CalcTakerFeeExactIn(tokenIn sdk.Coin, takerFee osmomath.Dec) (sdk.Coin, sdk.Coin) {
@@ -0,0 +1,113 @@
+package poolmanager
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v29/x/lockup/types"
+)
+
+// The staking and lockup hooks invalidate the cached staked or locked amount of an account
+// when its delegations or locks change, so that its taker fee discount is recomputed on its next swap.
+
+type StakingHooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+func (k Keeper) StakingHooks() StakingHooks {
+	return StakingHooks{k}
+}
+
+func (h StakingHooks) AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorModified(ctx context.Context, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBeginUnbonding(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationCreated(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.invalidateTakerFeeDiscountCache(sdk.UnwrapSDKContext(ctx), delAddr)
+	return nil
+}
+
+func (h StakingHooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.invalidateTakerFeeDiscountCache(sdk.UnwrapSDKContext(ctx), delAddr)
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error {
+	return nil
+}
+
+func (h StakingHooks) AfterUnbondingInitiated(ctx context.Context, id uint64) error {
+	return nil
+}
+
+type LockupHooks struct {
+	k Keeper
+}
+
+var _ lockuptypes.LockupHooks = LockupHooks{}
+
+func (k Keeper) LockupHooks() LockupHooks {
+	return LockupHooks{k}
+}
+
+func (h LockupHooks) AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins) {
+	h.k.invalidateTakerFeeDiscountCache(ctx, address)
+}
+
+func (h LockupHooks) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.k.invalidateTakerFeeDiscountCache(ctx, address)
+}
+
+func (h LockupHooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.k.invalidateTakerFeeDiscountCache(ctx, address)
+}
+
+func (h LockupHooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.k.invalidateTakerFeeDiscountCache(ctx, address)
+}
+
+func (h LockupHooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins) {
+	lock, err := h.k.lockupKeeper.GetLockByID(ctx, lockID)
+	if err != nil {
+		return
+	}
+	h.k.invalidateTakerFeeDiscountCache(ctx, lock.OwnerAddress())
+}
+
+func (h LockupHooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration time.Duration, newDuration time.Duration) {
+}
+
+func (h LockupHooks) OnLockTransferred(ctx sdk.Context, lockID uint64, prevOwner, newOwner sdk.AccAddress) {
+	h.k.invalidateTakerFeeDiscountCache(ctx, prevOwner)
+	h.k.invalidateTakerFeeDiscountCache(ctx, newOwner)
+}
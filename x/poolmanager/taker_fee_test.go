@@ -2,11 +2,16 @@ package poolmanager_test
 
 import (
 	"fmt"
+	"time"
 
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v29/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v29/x/poolmanager"
 	"github.com/osmosis-labs/osmosis/v29/x/poolmanager/types"
 	txfeestypes "github.com/osmosis-labs/osmosis/v29/x/txfees/types"
 )
@@ -143,6 +148,252 @@ func (s *KeeperTestSuite) TestChargeTakerFee() {
 	}
 }
 
+// validates that the taker fee is discounted by the highest discount tier the sender qualifies for
+// with the OSMO it has staked and locked combined.
+func (s *KeeperTestSuite) TestChargeTakerFeeWithDiscount() {
+	var (
+		takerFee      = osmomath.MustNewDecFromStr("0.01")
+		tokenIn       = sdk.NewCoin(apptesting.ETH, osmomath.NewInt(10000000))
+		discountTiers = []types.TakerFeeDiscountTier{
+			{MinStakedOrLockedAmount: osmomath.NewInt(1_000), Discount: osmomath.MustNewDecFromStr("0.25")},
+			{MinStakedOrLockedAmount: osmomath.NewInt(10_000), Discount: osmomath.MustNewDecFromStr("0.5")},
+		}
+	)
+
+	tests := map[string]struct {
+		discountTiers []types.TakerFeeDiscountTier
+		stakedAmount  int64
+		lockedAmount  int64
+
+		expectedStakedOrLockedAmount osmomath.Int
+		expectedDiscount             osmomath.Dec
+	}{
+		"no stake or lock": {
+			discountTiers:                discountTiers,
+			expectedStakedOrLockedAmount: osmomath.ZeroInt(),
+			expectedDiscount:             osmomath.ZeroDec(),
+		},
+		"below the first tier": {
+			discountTiers:                discountTiers,
+			stakedAmount:                 999,
+			expectedStakedOrLockedAmount: osmomath.NewInt(999),
+			expectedDiscount:             osmomath.ZeroDec(),
+		},
+		"staked amount reaches the first tier": {
+			discountTiers:                discountTiers,
+			stakedAmount:                 1_000,
+			expectedStakedOrLockedAmount: osmomath.NewInt(1_000),
+			expectedDiscount:             osmomath.MustNewDecFromStr("0.25"),
+		},
+		"locked amount reaches the second tier": {
+			discountTiers:                discountTiers,
+			lockedAmount:                 20_000,
+			expectedStakedOrLockedAmount: osmomath.NewInt(20_000),
+			expectedDiscount:             osmomath.MustNewDecFromStr("0.5"),
+		},
+		"staked and locked amounts combined reach the second tier": {
+			discountTiers:                discountTiers,
+			stakedAmount:                 5_000,
+			lockedAmount:                 5_000,
+			expectedStakedOrLockedAmount: osmomath.NewInt(10_000),
+			expectedDiscount:             osmomath.MustNewDecFromStr("0.5"),
+		},
+		"no discount tiers": {
+			discountTiers:                []types.TakerFeeDiscountTier{},
+			stakedAmount:                 20_000,
+			expectedStakedOrLockedAmount: osmomath.NewInt(20_000),
+			expectedDiscount:             osmomath.ZeroDec(),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolManager := s.App.PoolManagerKeeper
+			sender := s.TestAccs[0]
+			bondDenom, err := s.App.StakingKeeper.BondDenom(s.Ctx)
+			s.Require().NoError(err)
+
+			poolManager.SetParam(s.Ctx, types.KeyTakerFeeDiscountTiers, tc.discountTiers)
+			poolManager.SetDenomPairTakerFee(s.Ctx, tokenIn.Denom, apptesting.USDC, takerFee)
+
+			if tc.stakedAmount > 0 {
+				validators, err := s.App.StakingKeeper.GetAllValidators(s.Ctx)
+				s.Require().NoError(err)
+				delegation := sdk.NewInt64Coin(bondDenom, tc.stakedAmount)
+				s.FundAcc(sender, sdk.NewCoins(delegation))
+				_, err = stakingkeeper.NewMsgServerImpl(s.App.StakingKeeper).Delegate(s.Ctx, stakingtypes.NewMsgDelegate(sender.String(), validators[0].GetOperator(), delegation))
+				s.Require().NoError(err)
+			}
+			if tc.lockedAmount > 0 {
+				s.LockTokens(sender, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, tc.lockedAmount)), time.Hour)
+			}
+
+			stakedOrLockedAmount, discount, err := poolManager.GetTakerFeeDiscount(s.Ctx, sender.String())
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedStakedOrLockedAmount, stakedOrLockedAmount)
+			s.Require().Equal(tc.expectedDiscount, discount)
+
+			s.FundAcc(sender, sdk.NewCoins(tokenIn))
+			tokenInAfterTakerFee, takerFeeCoin, err := poolManager.ChargeTakerFee(s.Ctx, tokenIn, apptesting.USDC, sender, true)
+			s.Require().NoError(err)
+
+			discountedTakerFee := takerFee.Mul(osmomath.OneDec().Sub(tc.expectedDiscount))
+			s.Require().Equal(sdk.NewCoin(tokenIn.Denom, tokenIn.Amount.ToLegacyDec().Mul(osmomath.OneDec().Sub(discountedTakerFee)).TruncateInt()), tokenInAfterTakerFee)
+			s.Require().Equal(tokenIn.Sub(tokenInAfterTakerFee), takerFeeCoin)
+		})
+	}
+
+	s.Run("invalid address", func() {
+		s.SetupTest()
+		_, _, err := s.App.PoolManagerKeeper.GetTakerFeeDiscount(s.Ctx, "invalid")
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestTakerFeeDiscountCache() {
+	s.SetupTest()
+	poolManager := s.App.PoolManagerKeeper
+	sender := s.TestAccs[0]
+	bondDenom, err := s.App.StakingKeeper.BondDenom(s.Ctx)
+	s.Require().NoError(err)
+	poolManager.SetParam(s.Ctx, types.KeyTakerFeeDiscountTiers, []types.TakerFeeDiscountTier{
+		{MinStakedOrLockedAmount: osmomath.NewInt(1_000), Discount: osmomath.MustNewDecFromStr("0.25")},
+		{MinStakedOrLockedAmount: osmomath.NewInt(10_000), Discount: osmomath.MustNewDecFromStr("0.5")},
+	})
+
+	// Delegating invalidates the cached amount.
+	_, discount, err := poolManager.GetTakerFeeDiscount(s.Ctx, sender.String())
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.ZeroDec(), discount)
+
+	validators, err := s.App.StakingKeeper.GetAllValidators(s.Ctx)
+	s.Require().NoError(err)
+	delegation := sdk.NewInt64Coin(bondDenom, 1_000)
+	s.FundAcc(sender, sdk.NewCoins(delegation))
+	_, err = stakingkeeper.NewMsgServerImpl(s.App.StakingKeeper).Delegate(s.Ctx, stakingtypes.NewMsgDelegate(sender.String(), validators[0].GetOperator(), delegation))
+	s.Require().NoError(err)
+
+	stakedOrLockedAmount, discount, err := poolManager.GetTakerFeeDiscount(s.Ctx, sender.String())
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(1_000), stakedOrLockedAmount)
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.25"), discount)
+
+	// Changes made without going through the hooks are only picked up at the end of the epoch.
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	s.Require().NoError(err)
+	storedDelegation, err := s.App.StakingKeeper.GetDelegation(s.Ctx, sender, valAddr)
+	s.Require().NoError(err)
+	storedDelegation.Shares = storedDelegation.Shares.MulInt64(20)
+	s.Require().NoError(s.App.StakingKeeper.SetDelegation(s.Ctx, storedDelegation))
+
+	stakedOrLockedAmount, discount, err = poolManager.GetTakerFeeDiscount(s.Ctx, sender.String())
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(1_000), stakedOrLockedAmount)
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.25"), discount)
+
+	s.Require().NoError(poolManager.EpochHooks().AfterEpochEnd(s.Ctx, poolmanager.TakerFeeDiscountEpochIdentifier, 1))
+	s.Require().Equal(int64(1), poolManager.GetTakerFeeDiscountEpoch(s.Ctx))
+
+	// The end of the epoch deletes the cached amounts of all accounts.
+	iterator := storetypes.KVStorePrefixIterator(s.Ctx.KVStore(s.App.AppKeepers.GetKey(types.StoreKey)), types.KeyTakerFeeDiscountCachePrefix)
+	s.Require().False(iterator.Valid())
+	s.Require().NoError(iterator.Close())
+
+	stakedOrLockedAmount, discount, err = poolManager.GetTakerFeeDiscount(s.Ctx, sender.String())
+	s.Require().NoError(err)
+	s.Require().True(stakedOrLockedAmount.GTE(osmomath.NewInt(10_000)))
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.5"), discount)
+
+	// Locking invalidates the cached amount.
+	s.LockTokens(sender, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000)), time.Hour)
+
+	lockedAmount, _, err := poolManager.GetTakerFeeDiscount(s.Ctx, sender.String())
+	s.Require().NoError(err)
+	s.Require().Equal(stakedOrLockedAmount.Add(osmomath.NewInt(1_000)), lockedAmount)
+}
+
+func (s *KeeperTestSuite) TestTakerFeeDiscountCacheLockTransfer() {
+	s.SetupTest()
+	poolManager := s.App.PoolManagerKeeper
+	owner, newOwner, lastOwner := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+	bondDenom, err := s.App.StakingKeeper.BondDenom(s.Ctx)
+	s.Require().NoError(err)
+	poolManager.SetParam(s.Ctx, types.KeyTakerFeeDiscountTiers, []types.TakerFeeDiscountTier{
+		{MinStakedOrLockedAmount: osmomath.NewInt(1_000), Discount: osmomath.MustNewDecFromStr("0.25")},
+	})
+
+	lockID := s.LockTokens(owner, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000)), time.Hour)
+
+	// Cache the amounts of every account before the lock is handed over.
+	for _, account := range []sdk.AccAddress{owner, newOwner, lastOwner} {
+		_, _, err := poolManager.GetTakerFeeDiscount(s.Ctx, account.String())
+		s.Require().NoError(err)
+	}
+
+	// Each transfer invalidates the cached amounts of both the previous and the new owner,
+	// so that the lock only ever grants a discount to its current owner.
+	previousOwner := owner
+	for _, nextOwner := range []sdk.AccAddress{newOwner, lastOwner} {
+		s.Require().NoError(s.App.LockupKeeper.TransferLock(s.Ctx, lockID, previousOwner, nextOwner))
+
+		stakedOrLockedAmount, discount, err := poolManager.GetTakerFeeDiscount(s.Ctx, previousOwner.String())
+		s.Require().NoError(err)
+		s.Require().Equal(osmomath.ZeroInt(), stakedOrLockedAmount)
+		s.Require().Equal(osmomath.ZeroDec(), discount)
+
+		stakedOrLockedAmount, discount, err = poolManager.GetTakerFeeDiscount(s.Ctx, nextOwner.String())
+		s.Require().NoError(err)
+		s.Require().Equal(osmomath.NewInt(1_000), stakedOrLockedAmount)
+		s.Require().Equal(osmomath.MustNewDecFromStr("0.25"), discount)
+
+		previousOwner = nextOwner
+	}
+}
+
+func (s *KeeperTestSuite) TestEstimateSwapWithTakerFeeDiscount() {
+	s.SetupTest()
+	poolManager := s.App.PoolManagerKeeper
+	sender := s.TestAccs[0]
+	bondDenom, err := s.App.StakingKeeper.BondDenom(s.Ctx)
+	s.Require().NoError(err)
+	poolManager.SetParam(s.Ctx, types.KeyTakerFeeDiscountTiers, []types.TakerFeeDiscountTier{
+		{MinStakedOrLockedAmount: osmomath.NewInt(1_000), Discount: osmomath.OneDec()},
+	})
+	s.LockTokens(sender, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000)), time.Hour)
+
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(apptesting.ETH, 1_000_000_000), sdk.NewInt64Coin(apptesting.USDC, 1_000_000_000))
+	poolManager.SetDenomPairTakerFee(s.Ctx, apptesting.ETH, apptesting.USDC, osmomath.MustNewDecFromStr("0.01"))
+
+	inRoute := []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: apptesting.USDC}}
+	tokenIn := sdk.NewInt64Coin(apptesting.ETH, 1_000_000)
+
+	// A full discount estimates the same amount as no taker fee.
+	noTakerFeeOut, err := poolManager.MultihopEstimateOutGivenExactAmountInNoTakerFee(s.Ctx, inRoute, tokenIn)
+	s.Require().NoError(err)
+	takerFeeOut, err := poolManager.MultihopEstimateOutGivenExactAmountIn(s.Ctx, inRoute, tokenIn)
+	s.Require().NoError(err)
+	discountedOut, err := poolManager.MultihopEstimateOutGivenExactAmountInForSender(s.Ctx, sender, inRoute, tokenIn)
+	s.Require().NoError(err)
+	s.Require().True(takerFeeOut.LT(noTakerFeeOut))
+	s.Require().Equal(noTakerFeeOut, discountedOut)
+
+	outRoute := []types.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: apptesting.ETH}}
+	tokenOut := sdk.NewInt64Coin(apptesting.USDC, 1_000_000)
+
+	takerFeeIn, err := poolManager.MultihopEstimateInGivenExactAmountOut(s.Ctx, outRoute, tokenOut)
+	s.Require().NoError(err)
+	discountedIn, err := poolManager.MultihopEstimateInGivenExactAmountOutForSender(s.Ctx, sender, outRoute, tokenOut)
+	s.Require().NoError(err)
+	s.Require().True(discountedIn.LT(takerFeeIn))
+
+	// The discounted estimate matches the amount charged by the swap.
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(apptesting.ETH, discountedIn)))
+	charged, err := poolManager.RouteExactAmountOut(s.Ctx, sender, outRoute, discountedIn, tokenOut)
+	s.Require().NoError(err)
+	s.Require().Equal(discountedIn, charged)
+}
+
 func (s *KeeperTestSuite) TestTakerFeeSkim() {
 	tests := map[string]struct {
 		alloyedPoolSetup                 func() []string
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 )
@@ -82,6 +83,41 @@ func (e PriceImpactProtectionExactOutError) Error() string {
 	return fmt.Sprintf("price impact protection: expected %s to be at most %s", e.Actual, e.MaxAmount)
 }
 
+type SwapDeadlineExceededError struct {
+	Deadline  time.Time
+	BlockTime time.Time
+}
+
+func (e SwapDeadlineExceededError) Error() string {
+	return fmt.Sprintf("swap deadline %s exceeded, block time is %s", e.Deadline, e.BlockTime)
+}
+
+type MaxPriceImpactExceededError struct {
+	Actual osmomath.Dec
+	Max    osmomath.Dec
+}
+
+func (e MaxPriceImpactExceededError) Error() string {
+	return fmt.Sprintf("price impact %s exceeds the maximum price impact %s", e.Actual, e.Max)
+}
+
+type InvalidMaxPriceImpactError struct {
+	MaxPriceImpact osmomath.Dec
+}
+
+func (e InvalidMaxPriceImpactError) Error() string {
+	return fmt.Sprintf("max price impact must be between 0 and 1, was (%s)", e.MaxPriceImpact)
+}
+
+type NoRouteFoundError struct {
+	TokenInDenom  string
+	TokenOutDenom string
+}
+
+func (e NoRouteFoundError) Error() string {
+	return fmt.Sprintf("no route found from (%s) to (%s)", e.TokenInDenom, e.TokenOutDenom)
+}
+
 type InvalidFinalTokenOutError struct {
 	TokenOutGivenA string
 	TokenOutGivenB string
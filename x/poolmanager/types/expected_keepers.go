@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	lockuptypes "github.com/osmosis-labs/osmosis/v29/x/lockup/types"
 
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -114,6 +115,12 @@ type MultihopRoute interface {
 
 type StakingKeeper interface {
 	BondDenom(ctx context.Context) (string, error)
+	GetDelegatorBonded(ctx context.Context, delegator sdk.AccAddress) (osmomath.Int, error)
+}
+
+type LockupKeeper interface {
+	GetAccountLockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetLockByID(ctx sdk.Context, lockID uint64) (*lockuptypes.PeriodLock, error)
 }
 
 type ProtorevKeeper interface {
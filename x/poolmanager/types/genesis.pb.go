@@ -111,6 +111,10 @@ type GenesisState struct {
 	TakerFeesTracker       *TakerFeesTracker   `protobuf:"bytes,4,opt,name=taker_fees_tracker,json=takerFeesTracker,proto3" json:"taker_fees_tracker,omitempty"`
 	PoolVolumes            []*PoolVolume       `protobuf:"bytes,5,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes,omitempty"`
 	DenomPairTakerFeeStore []DenomPairTakerFee `protobuf:"bytes,6,rep,name=denom_pair_taker_fee_store,json=denomPairTakerFeeStore,proto3" json:"denom_pair_taker_fee_store"`
+	// volume_bucket_index is the index of the current volume bucket.
+	VolumeBucketIndex      uint64                  `protobuf:"varint,7,opt,name=volume_bucket_index,json=volumeBucketIndex,proto3" json:"volume_bucket_index,omitempty"`
+	PoolVolumeBuckets      []PoolVolumeBucket      `protobuf:"bytes,8,rep,name=pool_volume_buckets,json=poolVolumeBuckets,proto3" json:"pool_volume_buckets"`
+	DenomPairVolumeBuckets []DenomPairVolumeBucket `protobuf:"bytes,9,rep,name=denom_pair_volume_buckets,json=denomPairVolumeBuckets,proto3" json:"denom_pair_volume_buckets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -188,6 +192,27 @@ func (m *GenesisState) GetDenomPairTakerFeeStore() []DenomPairTakerFee {
 	return nil
 }
 
+func (m *GenesisState) GetVolumeBucketIndex() uint64 {
+	if m != nil {
+		return m.VolumeBucketIndex
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPoolVolumeBuckets() []PoolVolumeBucket {
+	if m != nil {
+		return m.PoolVolumeBuckets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomPairVolumeBuckets() []DenomPairVolumeBucket {
+	if m != nil {
+		return m.DenomPairVolumeBuckets
+	}
+	return nil
+}
+
 // TakerFeeParams consolidates the taker fee parameters for the poolmanager.
 type TakerFeeParams struct {
 	// default_taker_fee is the fee used when creating a new pool that doesn't
@@ -227,6 +252,11 @@ type TakerFeeParams struct {
 	// Initially, the taker fee is allowed to be bypassed completely. However
 	// In the future, we will charge a reduced taker fee instead of no fee at all.
 	ReducedFeeWhitelist []string `protobuf:"bytes,6,rep,name=reduced_fee_whitelist,json=reducedFeeWhitelist,proto3" json:"reduced_fee_whitelist,omitempty" yaml:"reduced_fee_whitelist"`
+	// discount_tiers is a list of taker fee discounts granted to swappers based
+	// on the amount of OSMO they have staked or locked. Tiers must be sorted by
+	// ascending min_staked_or_locked_amount, and a swapper gets the discount of
+	// the highest tier they qualify for.
+	DiscountTiers []TakerFeeDiscountTier `protobuf:"bytes,7,rep,name=discount_tiers,json=discountTiers,proto3" json:"discount_tiers" yaml:"discount_tiers"`
 }
 
 func (m *TakerFeeParams) Reset()         { *m = TakerFeeParams{} }
@@ -297,6 +327,107 @@ func (m *TakerFeeParams) GetReducedFeeWhitelist() []string {
 	return nil
 }
 
+func (m *TakerFeeParams) GetDiscountTiers() []TakerFeeDiscountTier {
+	if m != nil {
+		return m.DiscountTiers
+	}
+	return nil
+}
+
+// TakerFeeDiscountTier defines the taker fee discount granted to swappers that
+// have at least min_staked_or_locked_amount of OSMO staked or locked.
+type TakerFeeDiscountTier struct {
+	// min_staked_or_locked_amount is the minimum amount of OSMO the swapper must
+	// have bonded to validators and locked in x/lockup combined.
+	MinStakedOrLockedAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=min_staked_or_locked_amount,json=minStakedOrLockedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_staked_or_locked_amount" yaml:"min_staked_or_locked_amount"`
+	// discount is the fraction of the taker fee that is waived, between 0 and 1.
+	Discount cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=discount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount" yaml:"discount"`
+}
+
+func (m *TakerFeeDiscountTier) Reset()         { *m = TakerFeeDiscountTier{} }
+func (m *TakerFeeDiscountTier) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeDiscountTier) ProtoMessage()    {}
+func (*TakerFeeDiscountTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{3}
+}
+func (m *TakerFeeDiscountTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeDiscountTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeDiscountTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeDiscountTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeDiscountTier.Merge(m, src)
+}
+func (m *TakerFeeDiscountTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeDiscountTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeDiscountTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeDiscountTier proto.InternalMessageInfo
+
+// TakerFeeDiscountCache stores the amount of OSMO an account had staked or
+// locked when its taker fee discount was last computed. It is the KVStore value
+// of the taker fee discount cache, and is used until the end of the epoch it was
+// computed in or until the delegations or locks of the account change.
+type TakerFeeDiscountCache struct {
+	StakedOrLockedAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=staked_or_locked_amount,json=stakedOrLockedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"staked_or_locked_amount" yaml:"staked_or_locked_amount"`
+	// epoch is the number of the taker fee discount epoch the amount was
+	// computed in.
+	Epoch int64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty" yaml:"epoch"`
+}
+
+func (m *TakerFeeDiscountCache) Reset()         { *m = TakerFeeDiscountCache{} }
+func (m *TakerFeeDiscountCache) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeDiscountCache) ProtoMessage()    {}
+func (*TakerFeeDiscountCache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{4}
+}
+func (m *TakerFeeDiscountCache) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeDiscountCache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeDiscountCache.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeDiscountCache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeDiscountCache.Merge(m, src)
+}
+func (m *TakerFeeDiscountCache) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeDiscountCache) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeDiscountCache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeDiscountCache proto.InternalMessageInfo
+
+func (m *TakerFeeDiscountCache) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
 // TakerFeeDistributionPercentage defines what percent of the taker fee category
 // gets distributed to the available categories.
 type TakerFeeDistributionPercentage struct {
@@ -308,7 +439,7 @@ func (m *TakerFeeDistributionPercentage) Reset()         { *m = TakerFeeDistribu
 func (m *TakerFeeDistributionPercentage) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeDistributionPercentage) ProtoMessage()    {}
 func (*TakerFeeDistributionPercentage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{3}
+	return fileDescriptor_aa099d9fbdf68b35, []int{5}
 }
 func (m *TakerFeeDistributionPercentage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +478,7 @@ func (m *TakerFeesTracker) Reset()         { *m = TakerFeesTracker{} }
 func (m *TakerFeesTracker) String() string { return proto.CompactTextString(m) }
 func (*TakerFeesTracker) ProtoMessage()    {}
 func (*TakerFeesTracker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{4}
+	return fileDescriptor_aa099d9fbdf68b35, []int{6}
 }
 func (m *TakerFeesTracker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,7 +541,7 @@ func (m *PoolVolume) Reset()         { *m = PoolVolume{} }
 func (m *PoolVolume) String() string { return proto.CompactTextString(m) }
 func (*PoolVolume) ProtoMessage()    {}
 func (*PoolVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{5}
+	return fileDescriptor_aa099d9fbdf68b35, []int{7}
 }
 func (m *PoolVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,13 +584,157 @@ func (m *PoolVolume) GetPoolVolume() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// PoolVolumeBucket stores the volume of a pool during one volume bucket. It is
+// the KVStore value of the pool volume buckets, and is also used in
+// export/import genesis.
+type PoolVolumeBucket struct {
+	// bucket is the index of the volume bucket.
+	Bucket uint64 `protobuf:"varint,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// pool_id is the id of the pool.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// volume is the OSMO-denominated volume of the pool during the bucket.
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume"`
+}
+
+func (m *PoolVolumeBucket) Reset()         { *m = PoolVolumeBucket{} }
+func (m *PoolVolumeBucket) String() string { return proto.CompactTextString(m) }
+func (*PoolVolumeBucket) ProtoMessage()    {}
+func (*PoolVolumeBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{8}
+}
+func (m *PoolVolumeBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolumeBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolumeBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolumeBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolumeBucket.Merge(m, src)
+}
+func (m *PoolVolumeBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolumeBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolumeBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolumeBucket proto.InternalMessageInfo
+
+func (m *PoolVolumeBucket) GetBucket() uint64 {
+	if m != nil {
+		return m.Bucket
+	}
+	return 0
+}
+
+func (m *PoolVolumeBucket) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolVolumeBucket) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+// DenomPairVolumeBucket stores the volume swapped between a pair of denoms, in
+// either direction, during one volume bucket. denom_0 is lexicographically
+// smaller than denom_1. It is the KVStore value of the denom pair volume
+// buckets, and is also used in export/import genesis.
+type DenomPairVolumeBucket struct {
+	// bucket is the index of the volume bucket.
+	Bucket  uint64 `protobuf:"varint,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Denom_0 string `protobuf:"bytes,2,opt,name=denom_0,json=denom0,proto3" json:"denom_0,omitempty"`
+	Denom_1 string `protobuf:"bytes,3,opt,name=denom_1,json=denom1,proto3" json:"denom_1,omitempty"`
+	// volume is the OSMO-denominated volume of the pair during the bucket.
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume"`
+}
+
+func (m *DenomPairVolumeBucket) Reset()         { *m = DenomPairVolumeBucket{} }
+func (m *DenomPairVolumeBucket) String() string { return proto.CompactTextString(m) }
+func (*DenomPairVolumeBucket) ProtoMessage()    {}
+func (*DenomPairVolumeBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{9}
+}
+func (m *DenomPairVolumeBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPairVolumeBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPairVolumeBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPairVolumeBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPairVolumeBucket.Merge(m, src)
+}
+func (m *DenomPairVolumeBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPairVolumeBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPairVolumeBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPairVolumeBucket proto.InternalMessageInfo
+
+func (m *DenomPairVolumeBucket) GetBucket() uint64 {
+	if m != nil {
+		return m.Bucket
+	}
+	return 0
+}
+
+func (m *DenomPairVolumeBucket) GetDenom_0() string {
+	if m != nil {
+		return m.Denom_0
+	}
+	return ""
+}
+
+func (m *DenomPairVolumeBucket) GetDenom_1() string {
+	if m != nil {
+		return m.Denom_1
+	}
+	return ""
+}
+
+func (m *DenomPairVolumeBucket) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.poolmanager.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.poolmanager.v1beta1.GenesisState")
 	proto.RegisterType((*TakerFeeParams)(nil), "osmosis.poolmanager.v1beta1.TakerFeeParams")
+	proto.RegisterType((*TakerFeeDiscountTier)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDiscountTier")
+	proto.RegisterType((*TakerFeeDiscountCache)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDiscountCache")
 	proto.RegisterType((*TakerFeeDistributionPercentage)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDistributionPercentage")
 	proto.RegisterType((*TakerFeesTracker)(nil), "osmosis.poolmanager.v1beta1.TakerFeesTracker")
 	proto.RegisterType((*PoolVolume)(nil), "osmosis.poolmanager.v1beta1.PoolVolume")
+	proto.RegisterType((*PoolVolumeBucket)(nil), "osmosis.poolmanager.v1beta1.PoolVolumeBucket")
+	proto.RegisterType((*DenomPairVolumeBucket)(nil), "osmosis.poolmanager.v1beta1.DenomPairVolumeBucket")
 }
 
 func init() {
@@ -467,75 +742,95 @@ func init() {
 }
 
 var fileDescriptor_aa099d9fbdf68b35 = []byte{
-	// 1083 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xdc, 0xc4,
-	0x1b, 0x8f, 0xb3, 0xe9, 0xfe, 0x95, 0x49, 0xfe, 0x49, 0x3a, 0x90, 0xc6, 0x4d, 0xca, 0x7a, 0xe5,
-	0x56, 0x62, 0x11, 0x8a, 0x97, 0x06, 0xa9, 0x88, 0x97, 0x1e, 0xb2, 0x89, 0x82, 0x40, 0xa5, 0x4d,
-	0x9d, 0x08, 0xa4, 0x72, 0x18, 0xcd, 0xda, 0x4f, 0xbc, 0xd6, 0xda, 0x9e, 0x65, 0x66, 0x9c, 0x17,
-	0xbe, 0x02, 0x17, 0xa4, 0x5e, 0x39, 0x73, 0xe0, 0x86, 0xc4, 0x87, 0xe8, 0xb1, 0x47, 0xc4, 0x61,
-	0x8b, 0x92, 0x33, 0x97, 0xfd, 0x04, 0xc8, 0x33, 0xb3, 0xaf, 0x4d, 0xb6, 0x01, 0x4e, 0xbb, 0x7e,
-	0x9e, 0xe7, 0xf7, 0xf3, 0xef, 0x79, 0x9b, 0x31, 0x7a, 0x8f, 0x89, 0x94, 0x89, 0x58, 0xd4, 0x3b,
-	0x8c, 0x25, 0x29, 0xcd, 0x68, 0x04, 0xbc, 0x7e, 0x7c, 0xbf, 0x09, 0x92, 0xde, 0xaf, 0x47, 0x90,
-	0x81, 0x88, 0x85, 0xd7, 0xe1, 0x4c, 0x32, 0xbc, 0x61, 0x42, 0xbd, 0x91, 0x50, 0xcf, 0x84, 0xae,
-	0xbf, 0x1d, 0xb1, 0x88, 0xa9, 0xb8, 0x7a, 0xf1, 0x4f, 0x43, 0xd6, 0x6f, 0x47, 0x8c, 0x45, 0x09,
-	0xd4, 0xd5, 0x53, 0x33, 0x3f, 0xaa, 0xd3, 0xec, 0xac, 0xef, 0x0a, 0x14, 0x1d, 0xd1, 0x18, 0xfd,
-	0x60, 0x5c, 0x95, 0x49, 0x54, 0x98, 0x73, 0x2a, 0x63, 0x96, 0xf5, 0xfd, 0x3a, 0xba, 0xde, 0xa4,
-	0x02, 0x06, 0x5a, 0x03, 0x16, 0xf7, 0xfd, 0xde, 0xb4, 0x9c, 0x52, 0x16, 0xe6, 0x09, 0x10, 0xce,
-	0x72, 0x09, 0x26, 0xfe, 0xde, 0xb4, 0x78, 0x79, 0xaa, 0xa3, 0xdc, 0xde, 0x2c, 0x2a, 0xef, 0x53,
-	0x4e, 0x53, 0x81, 0x9f, 0x5b, 0xe8, 0x66, 0x11, 0x4b, 0x02, 0x0e, 0x4a, 0x18, 0x39, 0x02, 0xb0,
-	0xad, 0x6a, 0xa9, 0xb6, 0xb0, 0x75, 0xdb, 0x33, 0xb9, 0x14, 0xea, 0xfa, 0xe5, 0xf1, 0x76, 0x58,
-	0x9c, 0x35, 0x1e, 0xbd, 0xe8, 0x3a, 0x33, 0xbd, 0xae, 0x63, 0x9f, 0xd1, 0x34, 0xf9, 0xc4, 0x7d,
-	0x8d, 0xc1, 0xfd, 0xe5, 0x95, 0x53, 0x8b, 0x62, 0xd9, 0xca, 0x9b, 0x5e, 0xc0, 0x52, 0x53, 0x14,
-	0xf3, 0xb3, 0x29, 0xc2, 0x76, 0x5d, 0x9e, 0x75, 0x40, 0x28, 0x32, 0xe1, 0x2f, 0x17, 0xf8, 0x1d,
-	0x03, 0xdf, 0x03, 0xc0, 0xc7, 0x68, 0x45, 0xd2, 0x36, 0xf0, 0x82, 0x8a, 0x74, 0x94, 0x52, 0x7b,
-	0xb6, 0x6a, 0xd5, 0x16, 0xb6, 0xde, 0xf7, 0xa6, 0xb4, 0xce, 0x3b, 0x2c, 0x40, 0x7b, 0x00, 0x3a,
-	0xb9, 0x86, 0x63, 0x54, 0xae, 0x69, 0x95, 0x93, 0x94, 0xae, 0xbf, 0x24, 0xc7, 0x00, 0xf8, 0x19,
-	0x5a, 0xa3, 0xb9, 0x6c, 0x31, 0x1e, 0x7f, 0x0f, 0x21, 0xf9, 0x2e, 0x67, 0x12, 0x48, 0x08, 0x19,
-	0x4b, 0x85, 0x5d, 0xaa, 0x96, 0x6a, 0xf3, 0x0d, 0xb7, 0xd7, 0x75, 0x2a, 0x9a, 0xed, 0x8a, 0x40,
-	0xd7, 0x5f, 0x1d, 0x7a, 0x9e, 0x16, 0x8e, 0x5d, 0x6d, 0x7f, 0x55, 0x42, 0x8b, 0x9f, 0xeb, 0x29,
-	0x3c, 0x90, 0x54, 0x02, 0xae, 0xa2, 0xc5, 0x0c, 0x4e, 0x25, 0x51, 0xc5, 0x8b, 0x43, 0xdb, 0xaa,
-	0x5a, 0xb5, 0x39, 0x1f, 0x15, 0xb6, 0x7d, 0xc6, 0x92, 0x2f, 0x42, 0xbc, 0x8d, 0xca, 0x63, 0xc9,
-	0xdf, 0x9d, 0x9a, 0xbc, 0x49, 0x7a, 0xae, 0x48, 0xda, 0x37, 0x40, 0xfc, 0x04, 0x2d, 0x28, 0x7e,
-	0x35, 0x24, 0x3a, 0x8b, 0x85, 0xad, 0xda, 0x54, 0x9e, 0xaf, 0xd4, 0x58, 0xf9, 0x05, 0xc0, 0x90,
-	0xa1, 0x22, 0x4c, 0x19, 0x04, 0xfe, 0x16, 0xe1, 0x41, 0x1d, 0x05, 0x91, 0x9c, 0x06, 0x6d, 0xe0,
-	0xf6, 0x9c, 0xd2, 0xb7, 0x79, 0xad, 0xe6, 0x88, 0x43, 0x0d, 0xf2, 0x57, 0xe4, 0x84, 0x05, 0x7f,
-	0x89, 0x16, 0x95, 0xda, 0x63, 0x96, 0xe4, 0x29, 0x08, 0xfb, 0x86, 0x92, 0xfb, 0xee, 0xf4, 0xb4,
-	0x19, 0x4b, 0xbe, 0x56, 0xf1, 0xbe, 0x4a, 0x55, 0xff, 0x17, 0xb8, 0x83, 0xd6, 0x55, 0x47, 0x48,
-	0x87, 0xc6, 0x9c, 0x0c, 0x7b, 0x2f, 0x24, 0xe3, 0x60, 0x97, 0x15, 0xb3, 0x37, 0x95, 0x59, 0x35,
-	0x6e, 0x9f, 0xc6, 0xbc, 0xaf, 0xdc, 0x94, 0xe3, 0x56, 0x38, 0xe9, 0x38, 0x28, 0x38, 0xdd, 0x1f,
-	0xca, 0x68, 0x69, 0x7c, 0x02, 0x71, 0x13, 0xdd, 0x0c, 0xe1, 0x88, 0xe6, 0x89, 0x1c, 0x2a, 0x50,
-	0x8d, 0x9e, 0x6f, 0x3c, 0x28, 0xb8, 0xfe, 0xe8, 0x3a, 0x1b, 0x7a, 0x29, 0x44, 0xd8, 0xf6, 0x62,
-	0x56, 0x4f, 0xa9, 0x6c, 0x79, 0x8f, 0x20, 0xa2, 0xc1, 0xd9, 0x2e, 0x04, 0xe7, 0x5d, 0x67, 0x79,
-	0x57, 0xe3, 0xfb, 0xc4, 0xfe, 0x72, 0x38, 0x6e, 0xc0, 0x3f, 0x59, 0x48, 0x9d, 0x67, 0x23, 0x39,
-	0x86, 0xb1, 0x90, 0x3c, 0x6e, 0xe6, 0xc5, 0x3e, 0x99, 0xd9, 0xf9, 0xf4, 0x5a, 0xbd, 0xd9, 0x1d,
-	0x01, 0xee, 0x03, 0x0f, 0x20, 0x93, 0x34, 0x82, 0x46, 0xb5, 0xd0, 0x7a, 0xde, 0x75, 0xec, 0x27,
-	0x22, 0x65, 0x97, 0xc5, 0xfa, 0x36, 0xbb, 0xc2, 0x83, 0x7f, 0xb6, 0x90, 0x93, 0xb1, 0x8c, 0x4c,
-	0x93, 0x58, 0xfa, 0xef, 0x12, 0xef, 0x1a, 0x89, 0x1b, 0x8f, 0x59, 0x76, 0xa5, 0xca, 0x8d, 0xec,
-	0x6a, 0x27, 0xde, 0x41, 0xcb, 0x34, 0x4c, 0xe3, 0x8c, 0xd0, 0x30, 0xe4, 0x20, 0x04, 0x08, 0x7b,
-	0x4e, 0x2d, 0xfd, 0x7a, 0xaf, 0xeb, 0xdc, 0x32, 0x4b, 0x3f, 0x1e, 0xe0, 0xfa, 0x4b, 0xca, 0xb2,
-	0xdd, 0x37, 0xe0, 0x5f, 0x2d, 0xf4, 0x20, 0x60, 0x69, 0x9a, 0x67, 0xb1, 0x3c, 0xd3, 0xab, 0xad,
-	0xa7, 0x50, 0x32, 0x22, 0x4e, 0x68, 0x87, 0x14, 0xa5, 0x38, 0x69, 0xc5, 0x12, 0x92, 0x58, 0x48,
-	0x08, 0x09, 0x15, 0x02, 0xa4, 0x20, 0x92, 0xd9, 0x37, 0xd4, 0x58, 0x6c, 0xf7, 0xba, 0xce, 0x43,
-	0xfd, 0xb2, 0x7f, 0xc7, 0xe3, 0xfa, 0xde, 0x00, 0x58, 0xec, 0x86, 0x9a, 0xe2, 0x43, 0x76, 0x70,
-	0x42, 0x3b, 0x8f, 0x59, 0xf6, 0xcd, 0x10, 0xb2, 0xad, 0x10, 0x87, 0x0c, 0x1f, 0xa2, 0x55, 0x0e,
-	0x61, 0x1e, 0x40, 0xa8, 0x3a, 0x33, 0x60, 0x55, 0x4b, 0x32, 0xdf, 0xa8, 0xf6, 0xba, 0xce, 0x1d,
-	0xad, 0xe8, 0xd2, 0x30, 0xd7, 0x7f, 0xcb, 0xd8, 0xf7, 0x00, 0x06, 0xfc, 0xee, 0x5f, 0x16, 0xaa,
-	0x4c, 0xef, 0x19, 0x3e, 0x42, 0xcb, 0x42, 0xd2, 0x76, 0x9c, 0x45, 0x84, 0xc3, 0x09, 0xe5, 0xa1,
-	0x30, 0xbb, 0xf1, 0xf0, 0x1a, 0xbb, 0x31, 0x6c, 0xca, 0x04, 0x87, 0xeb, 0x2f, 0x19, 0x8b, 0xaf,
-	0x0d, 0x38, 0x40, 0x4b, 0xe3, 0xb5, 0x54, 0x3b, 0x31, 0xdf, 0xf8, 0xec, 0x7a, 0xaf, 0x59, 0xbd,
-	0xac, 0x1d, 0xae, 0xff, 0xff, 0xb1, 0x32, 0xbb, 0xbf, 0xcd, 0xa2, 0x95, 0xc9, 0x23, 0x0e, 0xfb,
-	0x68, 0x75, 0xf4, 0xb4, 0x64, 0x44, 0xa8, 0x47, 0xf1, 0xe6, 0x1b, 0x56, 0x1f, 0x35, 0x78, 0x78,
-	0x44, 0xb2, 0x03, 0x0d, 0xc5, 0x04, 0xdd, 0x19, 0xe7, 0x7c, 0x2d, 0xb7, 0x6b, 0x51, 0xdb, 0x23,
-	0xd4, 0x3b, 0xa3, 0x99, 0xe0, 0x36, 0x7a, 0xa7, 0x05, 0x71, 0xd4, 0x92, 0x84, 0x06, 0x01, 0xcb,
-	0x33, 0x59, 0x14, 0x57, 0x48, 0xca, 0xa5, 0x20, 0x47, 0x9c, 0xa5, 0x6a, 0x5d, 0x4b, 0x8d, 0x5a,
-	0xaf, 0xeb, 0xdc, 0xd3, 0xa5, 0x99, 0x1a, 0xee, 0xfa, 0xeb, 0xda, 0xbf, 0x3d, 0x70, 0x1f, 0x28,
-	0xef, 0x5e, 0xe1, 0x7c, 0x6e, 0x21, 0x34, 0x3c, 0xc2, 0xf1, 0x1a, 0xfa, 0xdf, 0xf8, 0x7d, 0x58,
-	0xee, 0xe8, 0xbb, 0x30, 0x31, 0x17, 0x99, 0xbe, 0x1a, 0xde, 0x9c, 0xe4, 0x07, 0x45, 0x92, 0xff,
-	0xe8, 0x2b, 0x04, 0x0d, 0x6f, 0x8f, 0xc6, 0xd3, 0x17, 0xe7, 0x15, 0xeb, 0xe5, 0x79, 0xc5, 0xfa,
-	0xf3, 0xbc, 0x62, 0xfd, 0x78, 0x51, 0x99, 0x79, 0x79, 0x51, 0x99, 0xf9, 0xfd, 0xa2, 0x32, 0xf3,
-	0xec, 0xa3, 0x11, 0x3e, 0x73, 0x5c, 0x6d, 0x26, 0xb4, 0x29, 0xfa, 0x0f, 0xf5, 0xe3, 0xad, 0x8f,
-	0xeb, 0xa7, 0x63, 0xdf, 0x5f, 0xea, 0x25, 0xcd, 0xb2, 0xfa, 0xf6, 0xfa, 0xf0, 0xef, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x3d, 0x89, 0xbc, 0xc7, 0xa7, 0x0a, 0x00, 0x00,
+	// 1394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x16, 0x36, 0x2d, 0x5b, 0x8e, 0xc7, 0x8e, 0x1f, 0x93, 0xc8, 0x66, 0xec, 0x44, 0x14, 0x98, 0xe0,
+	0x5e, 0x5d, 0x5c, 0x84, 0x8a, 0x7d, 0x81, 0x5c, 0xf4, 0x11, 0x14, 0x96, 0x0d, 0x17, 0x29, 0xd2,
+	0xc4, 0xa1, 0x8d, 0x16, 0x48, 0x17, 0xc4, 0x88, 0x1c, 0x4b, 0x84, 0x45, 0x8e, 0x3a, 0x33, 0xf4,
+	0xa3, 0xbb, 0x02, 0xfd, 0x01, 0x05, 0xb2, 0xed, 0xba, 0x28, 0xba, 0x2b, 0x50, 0xa0, 0xff, 0xa0,
+	0xc8, 0x32, 0xcb, 0xa2, 0x0b, 0xb5, 0x70, 0x56, 0x5d, 0xb4, 0x0b, 0xfd, 0x82, 0x62, 0x1e, 0x92,
+	0x28, 0x45, 0xa6, 0xd5, 0xd7, 0xca, 0xe2, 0x9c, 0x73, 0xbe, 0xf9, 0xbe, 0x73, 0xe6, 0xcc, 0x1c,
+	0x83, 0xff, 0x10, 0x16, 0x11, 0x16, 0xb2, 0x4a, 0x8b, 0x90, 0x66, 0x84, 0x62, 0x54, 0xc7, 0xb4,
+	0x72, 0xbc, 0x51, 0xc3, 0x1c, 0x6d, 0x54, 0xea, 0x38, 0xc6, 0x2c, 0x64, 0x4e, 0x8b, 0x12, 0x4e,
+	0xe0, 0xba, 0x76, 0x75, 0x52, 0xae, 0x8e, 0x76, 0x5d, 0xbb, 0x5e, 0x27, 0x75, 0x22, 0xfd, 0x2a,
+	0xe2, 0x97, 0x0a, 0x59, 0xbb, 0x51, 0x27, 0xa4, 0xde, 0xc4, 0x15, 0xf9, 0x55, 0x4b, 0x0e, 0x2b,
+	0x28, 0x3e, 0xeb, 0x9a, 0x7c, 0x09, 0xe7, 0xa9, 0x18, 0xf5, 0xa1, 0x4d, 0xc5, 0xe1, 0xa8, 0x20,
+	0xa1, 0x88, 0x87, 0x24, 0xee, 0xda, 0x95, 0x77, 0xa5, 0x86, 0x18, 0xee, 0x71, 0xf5, 0x49, 0xd8,
+	0xb5, 0x3b, 0x59, 0x9a, 0x22, 0x12, 0x24, 0x4d, 0xec, 0x51, 0x92, 0x70, 0xac, 0xfd, 0xef, 0x64,
+	0xf9, 0xf3, 0x53, 0xe5, 0x65, 0x77, 0x26, 0x41, 0x7e, 0x0f, 0x51, 0x14, 0x31, 0xf8, 0xdc, 0x00,
+	0xcb, 0xc2, 0xd7, 0xf3, 0x29, 0x96, 0xc4, 0xbc, 0x43, 0x8c, 0x4d, 0xa3, 0x94, 0x2b, 0xcf, 0x6d,
+	0xde, 0x70, 0xb4, 0x16, 0xc1, 0xae, 0x9b, 0x1e, 0x67, 0x9b, 0x84, 0x71, 0xf5, 0xd1, 0x8b, 0xb6,
+	0x35, 0xd1, 0x69, 0x5b, 0xe6, 0x19, 0x8a, 0x9a, 0x6f, 0xda, 0xaf, 0x21, 0xd8, 0x5f, 0xff, 0x64,
+	0x95, 0xeb, 0x21, 0x6f, 0x24, 0x35, 0xc7, 0x27, 0x91, 0x4e, 0x8a, 0xfe, 0x73, 0x97, 0x05, 0x47,
+	0x15, 0x7e, 0xd6, 0xc2, 0x4c, 0x82, 0x31, 0x77, 0x51, 0xc4, 0x6f, 0xeb, 0xf0, 0x5d, 0x8c, 0xe1,
+	0x31, 0x58, 0xe2, 0xe8, 0x08, 0x53, 0x01, 0xe5, 0xb5, 0x24, 0x53, 0x73, 0xb2, 0x64, 0x94, 0xe7,
+	0x36, 0xff, 0xeb, 0x64, 0x94, 0xce, 0x39, 0x10, 0x41, 0xbb, 0x18, 0x2b, 0x71, 0x55, 0x4b, 0xb3,
+	0x5c, 0x55, 0x2c, 0x87, 0x21, 0x6d, 0x77, 0x81, 0x0f, 0x04, 0xc0, 0x67, 0x60, 0x15, 0x25, 0xbc,
+	0x41, 0x68, 0xf8, 0x09, 0x0e, 0xbc, 0x8f, 0x13, 0xc2, 0xb1, 0x17, 0xe0, 0x98, 0x44, 0xcc, 0xcc,
+	0x95, 0x72, 0xe5, 0xd9, 0xaa, 0xdd, 0x69, 0x5b, 0x45, 0x85, 0x76, 0x81, 0xa3, 0xed, 0x16, 0xfa,
+	0x96, 0xa7, 0xc2, 0xb0, 0xa3, 0xd6, 0x7f, 0x99, 0x06, 0xf3, 0xef, 0xaa, 0x53, 0xb8, 0xcf, 0x11,
+	0xc7, 0xb0, 0x04, 0xe6, 0x63, 0x7c, 0xca, 0x3d, 0x99, 0xbc, 0x30, 0x30, 0x8d, 0x92, 0x51, 0x9e,
+	0x72, 0x81, 0x58, 0xdb, 0x23, 0xa4, 0xf9, 0x30, 0x80, 0x5b, 0x20, 0x3f, 0x20, 0xfe, 0x76, 0xa6,
+	0x78, 0x2d, 0x7a, 0x4a, 0x88, 0x76, 0x75, 0x20, 0x7c, 0x02, 0xe6, 0x24, 0xbe, 0x3c, 0x24, 0x4a,
+	0xc5, 0xdc, 0x66, 0x39, 0x13, 0xe7, 0x7d, 0x79, 0xac, 0x5c, 0x11, 0xa0, 0xc1, 0x80, 0x70, 0x93,
+	0x0b, 0x0c, 0x7e, 0x04, 0x60, 0x2f, 0x8f, 0xcc, 0xe3, 0x14, 0xf9, 0x47, 0x98, 0x9a, 0x53, 0x92,
+	0xdf, 0xdd, 0xb1, 0x8a, 0xc3, 0x0e, 0x54, 0x90, 0xbb, 0xc4, 0x87, 0x56, 0xe0, 0x7b, 0x60, 0x5e,
+	0xb2, 0x3d, 0x26, 0xcd, 0x24, 0xc2, 0xcc, 0x9c, 0x96, 0x74, 0xff, 0x9d, 0x2d, 0x9b, 0x90, 0xe6,
+	0x07, 0xd2, 0xdf, 0x9d, 0x6b, 0xf5, 0x7e, 0x33, 0xd8, 0x02, 0x6b, 0xb2, 0x22, 0x5e, 0x0b, 0x85,
+	0xd4, 0xeb, 0xd7, 0x9e, 0x71, 0x42, 0xb1, 0x99, 0x97, 0xc8, 0x4e, 0x26, 0xb2, 0x2c, 0xdc, 0x1e,
+	0x0a, 0x69, 0x97, 0xb9, 0x4e, 0xc7, 0x4a, 0x30, 0x6c, 0xd8, 0x17, 0x98, 0xd0, 0x01, 0xd7, 0x14,
+	0x71, 0xaf, 0x96, 0xf8, 0x47, 0x98, 0x7b, 0x61, 0x1c, 0xe0, 0x53, 0x73, 0x46, 0xd6, 0x75, 0x59,
+	0x99, 0xaa, 0xd2, 0xf2, 0x50, 0x18, 0xa0, 0x0f, 0xae, 0xa5, 0xd4, 0xea, 0x20, 0x66, 0x5e, 0x29,
+	0xe5, 0x2e, 0xcd, 0x65, 0x5f, 0xb4, 0x02, 0xd4, 0xcc, 0x96, 0x5b, 0x43, 0xeb, 0x0c, 0x32, 0x70,
+	0x23, 0x95, 0x86, 0xa1, 0xad, 0x66, 0xe5, 0x56, 0x9b, 0xe3, 0x65, 0x61, 0xc4, 0x7e, 0x2b, 0xc1,
+	0x28, 0x23, 0xb3, 0x3f, 0x9b, 0x01, 0x0b, 0x83, 0xbd, 0x08, 0x6b, 0x60, 0x39, 0xc0, 0x87, 0x28,
+	0x69, 0xf2, 0x7e, 0x2d, 0xe4, 0x91, 0x9f, 0xad, 0xde, 0x17, 0x58, 0x3f, 0xb6, 0xad, 0x75, 0x75,
+	0x3d, 0xb0, 0xe0, 0xc8, 0x09, 0x49, 0x25, 0x42, 0xbc, 0xe1, 0x3c, 0xc2, 0x75, 0xe4, 0x9f, 0xed,
+	0x60, 0xff, 0xbc, 0x6d, 0x2d, 0xee, 0xa8, 0xf8, 0x2e, 0xb0, 0xbb, 0x18, 0x0c, 0x2e, 0xc0, 0x2f,
+	0x0c, 0x20, 0x6f, 0xf6, 0x54, 0xb5, 0x83, 0x90, 0x71, 0x1a, 0xd6, 0x12, 0x71, 0xb3, 0xe8, 0x2e,
+	0x7a, 0x6b, 0xac, 0x53, 0xba, 0x93, 0x0a, 0xdc, 0xc3, 0xd4, 0xc7, 0x31, 0x47, 0x75, 0x5c, 0x2d,
+	0x09, 0xae, 0xe7, 0x6d, 0xcb, 0x7c, 0xc2, 0x22, 0x32, 0xca, 0xd7, 0x35, 0xc9, 0x05, 0x16, 0xf8,
+	0xa5, 0x01, 0xac, 0x98, 0xc4, 0x5e, 0x16, 0xc5, 0xdc, 0x5f, 0xa7, 0x78, 0x5b, 0x53, 0x5c, 0x7f,
+	0x4c, 0xe2, 0x0b, 0x59, 0xae, 0xc7, 0x17, 0x1b, 0xe1, 0x36, 0x58, 0x44, 0x41, 0x14, 0xc6, 0x1e,
+	0x0a, 0x02, 0x8a, 0x19, 0xc3, 0xcc, 0x9c, 0x92, 0xd7, 0xdf, 0x5a, 0xa7, 0x6d, 0xad, 0xe8, 0xeb,
+	0x6f, 0xd0, 0xc1, 0x76, 0x17, 0xe4, 0xca, 0x56, 0x77, 0x01, 0x7e, 0x63, 0x80, 0xfb, 0x3e, 0x89,
+	0xa2, 0x24, 0x0e, 0xf9, 0x99, 0xba, 0xe4, 0xd4, 0x41, 0xe4, 0xc4, 0x63, 0x27, 0xa8, 0xe5, 0x89,
+	0x54, 0x9c, 0x34, 0x42, 0x8e, 0x9b, 0x21, 0xe3, 0x38, 0xf0, 0x10, 0x63, 0x98, 0x33, 0x8f, 0x13,
+	0x73, 0x5a, 0x1e, 0x8b, 0xad, 0x4e, 0xdb, 0x7a, 0xa0, 0x36, 0xfb, 0x73, 0x38, 0xb6, 0xeb, 0xf4,
+	0x02, 0x45, 0xc3, 0xc8, 0x93, 0x7c, 0x40, 0xf6, 0x4f, 0x50, 0xeb, 0x31, 0x89, 0x3f, 0xec, 0x87,
+	0x6c, 0xc9, 0x88, 0x03, 0x02, 0x0f, 0x40, 0x81, 0xe2, 0x20, 0xf1, 0x71, 0x20, 0x2b, 0xd3, 0x43,
+	0x95, 0xd7, 0xc5, 0x6c, 0xb5, 0xd4, 0x69, 0x5b, 0x37, 0x15, 0xa3, 0x91, 0x6e, 0xb6, 0x7b, 0x4d,
+	0xaf, 0xef, 0x62, 0xdc, 0xc3, 0x87, 0x27, 0x60, 0x21, 0x08, 0x99, 0x4f, 0x92, 0x98, 0x7b, 0x3c,
+	0xc4, 0x94, 0x99, 0x33, 0xb2, 0xef, 0x36, 0xc6, 0xad, 0xb2, 0x0c, 0x3d, 0x08, 0x31, 0xad, 0xde,
+	0xd2, 0x2f, 0x5a, 0x41, 0xb1, 0x18, 0x84, 0xb5, 0xdd, 0xab, 0x41, 0xca, 0x99, 0xd9, 0xbf, 0x19,
+	0xe0, 0xfa, 0x28, 0x18, 0xf8, 0xa9, 0x01, 0xd6, 0x45, 0xf9, 0x98, 0x38, 0x85, 0x81, 0x47, 0xa8,
+	0xd7, 0x24, 0xbe, 0xf8, 0x85, 0x22, 0xe1, 0xa3, 0xfb, 0x72, 0x5b, 0xf7, 0x65, 0xe1, 0xf5, 0xbe,
+	0x7c, 0x18, 0xf3, 0x4e, 0xdb, 0xb2, 0x15, 0x8b, 0x0c, 0x24, 0xdb, 0x5d, 0x8d, 0xc2, 0x78, 0x5f,
+	0x1a, 0x9f, 0xd0, 0x47, 0xd2, 0xb4, 0x25, 0x2d, 0xd0, 0x05, 0x57, 0xba, 0x6c, 0xcd, 0xc9, 0xb1,
+	0xef, 0x81, 0x4e, 0xdb, 0x5a, 0x1c, 0xd4, 0x6e, 0xbb, 0x3d, 0x1c, 0xfb, 0x3b, 0x03, 0x14, 0x86,
+	0x05, 0x6f, 0x23, 0xbf, 0x21, 0x26, 0x8a, 0xd5, 0x6c, 0xb1, 0xef, 0x5c, 0x26, 0x56, 0x3f, 0xfb,
+	0x17, 0x0a, 0xbd, 0xce, 0x46, 0xa9, 0xfc, 0x17, 0x98, 0xc6, 0x2d, 0xe2, 0x37, 0xa4, 0xc4, 0x5c,
+	0x75, 0xa9, 0xd3, 0xb6, 0xe6, 0x15, 0x90, 0x5c, 0xb6, 0x5d, 0x65, 0xb6, 0x7f, 0x35, 0x40, 0x31,
+	0xbb, 0xaf, 0xe1, 0x21, 0x58, 0x14, 0x5b, 0x84, 0x71, 0xdd, 0xa3, 0xf8, 0x04, 0xd1, 0x80, 0x69,
+	0xea, 0x0f, 0xc6, 0xcb, 0xdb, 0x4a, 0x5f, 0x40, 0x0a, 0xc3, 0x76, 0x17, 0xf4, 0x8a, 0xab, 0x16,
+	0xa0, 0x0f, 0x16, 0x06, 0xfb, 0x4d, 0x97, 0xe7, 0xed, 0xf1, 0xb6, 0x29, 0x8c, 0x6a, 0x59, 0xdb,
+	0xbd, 0x3a, 0xd0, 0x8a, 0xf6, 0xb7, 0x93, 0x60, 0x69, 0x78, 0x20, 0x80, 0x2e, 0x28, 0xa4, 0x67,
+	0x0b, 0xa2, 0x4e, 0x15, 0x65, 0x97, 0xcf, 0xa3, 0xea, 0x39, 0x82, 0xfd, 0x81, 0x82, 0xec, 0xab,
+	0x50, 0xe8, 0x81, 0x9b, 0x83, 0x98, 0xaf, 0x69, 0x1b, 0x0b, 0xda, 0x4c, 0x41, 0x6f, 0xa7, 0x95,
+	0xc0, 0x23, 0x70, 0xab, 0x81, 0xc3, 0x7a, 0x83, 0x7b, 0xc8, 0x97, 0x07, 0x4e, 0x24, 0x97, 0x71,
+	0x44, 0x39, 0xf3, 0x0e, 0x29, 0x89, 0xe4, 0x95, 0x9e, 0xab, 0x96, 0x3b, 0x6d, 0xeb, 0x8e, 0x4a,
+	0x4d, 0xa6, 0xbb, 0xed, 0xae, 0x29, 0xfb, 0x56, 0xcf, 0xbc, 0x2f, 0xad, 0xbb, 0xc2, 0xf8, 0xdc,
+	0x00, 0xa0, 0xff, 0xf6, 0xc3, 0x55, 0x30, 0x33, 0x38, 0x3d, 0xe6, 0x5b, 0x6a, 0x72, 0x6c, 0xea,
+	0xb1, 0x4f, 0xbd, 0xf7, 0x97, 0x8b, 0xbc, 0x27, 0x44, 0xfe, 0xa1, 0x99, 0x1d, 0xf4, 0x47, 0x0d,
+	0xfb, 0x2b, 0x03, 0x2c, 0x0d, 0x4f, 0x24, 0x70, 0x05, 0xe4, 0xd5, 0x98, 0xd1, 0xa5, 0xa6, 0xbe,
+	0xd2, 0x9c, 0x27, 0x07, 0x38, 0xfb, 0x20, 0xaf, 0xe9, 0xe6, 0xfe, 0x7e, 0xba, 0x1a, 0xda, 0xfe,
+	0xde, 0x00, 0x85, 0x91, 0x13, 0x4d, 0x16, 0x5f, 0xf5, 0xde, 0xdc, 0x53, 0x7d, 0xe0, 0xe6, 0xe5,
+	0xe7, 0xbd, 0xbe, 0x61, 0xc3, 0xcc, 0xa5, 0x0c, 0x1b, 0x29, 0x21, 0x53, 0xff, 0x98, 0x90, 0xea,
+	0xd3, 0x17, 0xe7, 0x45, 0xe3, 0xe5, 0x79, 0xd1, 0xf8, 0xf9, 0xbc, 0x68, 0x7c, 0xfe, 0xaa, 0x38,
+	0xf1, 0xf2, 0x55, 0x71, 0xe2, 0x87, 0x57, 0xc5, 0x89, 0x67, 0xff, 0x4f, 0x61, 0xe9, 0x07, 0xe6,
+	0x6e, 0x13, 0xd5, 0x58, 0xf7, 0xa3, 0x72, 0xbc, 0xf9, 0x46, 0xe5, 0x74, 0xe0, 0x3f, 0x44, 0xb9,
+	0x41, 0x2d, 0x2f, 0xff, 0x3b, 0xfc, 0xdf, 0xef, 0x03, 0x00, 0x13, 0xe2, 0xc6, 0x0c, 0x49, 0x0f,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -614,6 +909,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomPairVolumeBuckets) > 0 {
+		for iNdEx := len(m.DenomPairVolumeBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPairVolumeBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PoolVolumeBuckets) > 0 {
+		for iNdEx := len(m.PoolVolumeBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolVolumeBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.VolumeBucketIndex != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VolumeBucketIndex))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.DenomPairTakerFeeStore) > 0 {
 		for iNdEx := len(m.DenomPairTakerFeeStore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -706,6 +1034,20 @@ func (m *TakerFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DiscountTiers) > 0 {
+		for iNdEx := len(m.DiscountTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DiscountTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.ReducedFeeWhitelist) > 0 {
 		for iNdEx := len(m.ReducedFeeWhitelist) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ReducedFeeWhitelist[iNdEx])
@@ -764,6 +1106,87 @@ func (m *TakerFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TakerFeeDiscountTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeDiscountTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeDiscountTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinStakedOrLockedAmount.Size()
+		i -= size
+		if _, err := m.MinStakedOrLockedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TakerFeeDiscountCache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeDiscountCache) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeDiscountCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.StakedOrLockedAmount.Size()
+		i -= size
+		if _, err := m.StakedOrLockedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TakerFeeDistributionPercentage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -905,12 +1328,115 @@ func (m *PoolVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
+func (m *PoolVolumeBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVolumeBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolumeBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Bucket != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Bucket))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPairVolumeBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPairVolumeBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPairVolumeBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Denom_1) > 0 {
+		i -= len(m.Denom_1)
+		copy(dAtA[i:], m.Denom_1)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom_1)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom_0) > 0 {
+		i -= len(m.Denom_0)
+		copy(dAtA[i:], m.Denom_0)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom_0)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Bucket != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Bucket))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
@@ -972,6 +1498,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.VolumeBucketIndex != 0 {
+		n += 1 + sovGenesis(uint64(m.VolumeBucketIndex))
+	}
+	if len(m.PoolVolumeBuckets) > 0 {
+		for _, e := range m.PoolVolumeBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomPairVolumeBuckets) > 0 {
+		for _, e := range m.DenomPairVolumeBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1003,6 +1544,39 @@ func (m *TakerFeeParams) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DiscountTiers) > 0 {
+		for _, e := range m.DiscountTiers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TakerFeeDiscountTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinStakedOrLockedAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Discount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TakerFeeDiscountCache) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakedOrLockedAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
 	return n
 }
 
@@ -1061,6 +1635,53 @@ func (m *PoolVolume) Size() (n int) {
 	return n
 }
 
+func (m *PoolVolumeBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bucket != 0 {
+		n += 1 + sovGenesis(uint64(m.Bucket))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomPairVolumeBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bucket != 0 {
+		n += 1 + sovGenesis(uint64(m.Bucket))
+	}
+	l = len(m.Denom_0)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom_1)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1435,6 +2056,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeBucketIndex", wireType)
+			}
+			m.VolumeBucketIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VolumeBucketIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolumeBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolVolumeBuckets = append(m.PoolVolumeBuckets, PoolVolumeBucket{})
+			if err := m.PoolVolumeBuckets[len(m.PoolVolumeBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairVolumeBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPairVolumeBuckets = append(m.DenomPairVolumeBuckets, DenomPairVolumeBucket{})
+			if err := m.DenomPairVolumeBuckets[len(m.DenomPairVolumeBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1681,6 +2389,40 @@ func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
 			}
 			m.ReducedFeeWhitelist = append(m.ReducedFeeWhitelist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscountTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiscountTiers = append(m.DiscountTiers, TakerFeeDiscountTier{})
+			if err := m.DiscountTiers[len(m.DiscountTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1702,7 +2444,228 @@ func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TakerFeeDistributionPercentage) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeDiscountTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeDiscountTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeDiscountTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStakedOrLockedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStakedOrLockedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeDiscountCache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeDiscountCache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeDiscountCache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedOrLockedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakedOrLockedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeDistributionPercentage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2060,6 +3023,295 @@ func (m *PoolVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolVolumeBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolVolumeBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolVolumeBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			m.Bucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bucket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPairVolumeBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPairVolumeBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPairVolumeBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			m.Bucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bucket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom_0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom_1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
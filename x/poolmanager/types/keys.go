@@ -57,6 +57,21 @@ var (
 
 	// KeyRegisteredAlloyPool defines the key to store registered alloy pool data.
 	KeyRegisteredAlloyPool = []byte{0x0C}
+
+	// KeyVolumeBucketIndex defines key to store the index of the current volume bucket.
+	KeyVolumeBucketIndex = []byte{0x0D}
+
+	// KeyPoolVolumeBucketPrefix defines prefix to store pool volume per volume bucket.
+	KeyPoolVolumeBucketPrefix = []byte{0x0E}
+
+	// KeyDenomPairVolumeBucketPrefix defines prefix to store denom pair volume per volume bucket.
+	KeyDenomPairVolumeBucketPrefix = []byte{0x0F}
+
+	// KeyTakerFeeDiscountEpoch defines key to store the number of the current taker fee discount epoch.
+	KeyTakerFeeDiscountEpoch = []byte{0x10}
+
+	// KeyTakerFeeDiscountCachePrefix defines prefix to store the cached staked or locked amount of each account.
+	KeyTakerFeeDiscountCachePrefix = []byte{0x11}
 )
 
 // ModuleRouteToBytes serializes moduleRoute to bytes.
@@ -97,6 +112,41 @@ func KeyPoolVolume(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%s%d%s", KeyPoolVolumePrefix, KeySeparator, poolId, KeySeparator))
 }
 
+// KeyPoolVolumeBucketPrefixForBucket returns the prefix of the pool volumes of the given volume bucket.
+func KeyPoolVolumeBucketPrefixForBucket(bucket uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%d%s", KeyPoolVolumeBucketPrefix, KeySeparator, bucket, KeySeparator))
+}
+
+// KeyPoolVolumeBucket returns the key for the volume of the given pool in the given volume bucket.
+func KeyPoolVolumeBucket(bucket, poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", KeyPoolVolumeBucketPrefixForBucket(bucket), poolId))
+}
+
+// KeyDenomPairVolumeBucketPrefixForBucket returns the prefix of the denom pair volumes of the given volume bucket.
+func KeyDenomPairVolumeBucketPrefixForBucket(bucket uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%d%s", KeyDenomPairVolumeBucketPrefix, KeySeparator, bucket, KeySeparator))
+}
+
+// KeyDenomPairVolumeBucket returns the key for the volume of the given denom pair in the given volume bucket.
+// The denoms are ordered so that both directions of the pair map to the same key.
+func KeyDenomPairVolumeBucket(bucket uint64, denomA, denomB string) []byte {
+	denom0, denom1 := OrderDenomPair(denomA, denomB)
+	return []byte(fmt.Sprintf("%s%s%s%s", KeyDenomPairVolumeBucketPrefixForBucket(bucket), denom0, KeySeparator, denom1))
+}
+
+// KeyTakerFeeDiscountCache returns the key for the cached staked or locked amount of the given account.
+func KeyTakerFeeDiscountCache(address sdk.AccAddress) []byte {
+	return append(KeyTakerFeeDiscountCachePrefix, address.Bytes()...)
+}
+
+// OrderDenomPair returns the given denoms in lexicographical order.
+func OrderDenomPair(denomA, denomB string) (denom0, denom1 string) {
+	if denomA > denomB {
+		return denomB, denomA
+	}
+	return denomA, denomB
+}
+
 // ParseDenomTradePairKey parses the raw bytes of the DenomTradePairKey into a denom trade pair.
 func ParseDenomTradePairKey(key []byte) (tokenInDenom, tokenOutDenom string, err error) {
 	keyStr := string(key)
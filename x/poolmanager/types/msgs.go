@@ -6,6 +6,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 // constants.
@@ -43,6 +45,10 @@ func (msg MsgSwapExactAmountIn) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
 	}
 
+	if err := validateMaxPriceImpact(msg.MaxPriceImpact); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -77,6 +83,10 @@ func (msg MsgSwapExactAmountOut) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenInMaxAmount.String()}
 	}
 
+	if err := validateMaxPriceImpact(msg.MaxPriceImpact); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -111,6 +121,10 @@ func (msg MsgSplitRouteSwapExactAmountIn) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
 	}
 
+	if err := validateMaxPriceImpact(msg.MaxPriceImpact); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -145,6 +159,10 @@ func (msg MsgSplitRouteSwapExactAmountOut) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenInMaxAmount.String()}
 	}
 
+	if err := validateMaxPriceImpact(msg.MaxPriceImpact); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -242,3 +260,14 @@ func (msg MsgSetRegisteredAlloyedPool) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// validateMaxPriceImpact checks that the optional max price impact of a swap message is between 0 and 1.
+func validateMaxPriceImpact(maxPriceImpact osmomath.Dec) error {
+	if maxPriceImpact.IsNil() {
+		return nil
+	}
+	if maxPriceImpact.IsNegative() || maxPriceImpact.GT(osmomath.OneDec()) {
+		return InvalidMaxPriceImpactError{MaxPriceImpact: maxPriceImpact}
+	}
+	return nil
+}
@@ -159,6 +159,30 @@ func TestMsgSwapExactAmountIn(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "valid max price impact",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountIn) types.MsgSwapExactAmountIn {
+				msg.MaxPriceImpact = osmomath.NewDecWithPrec(5, 2)
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "negative max price impact",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountIn) types.MsgSwapExactAmountIn {
+				msg.MaxPriceImpact = osmomath.NewDecWithPrec(-5, 2)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "max price impact greater than one",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountIn) types.MsgSwapExactAmountIn {
+				msg.MaxPriceImpact = osmomath.NewDecWithPrec(101, 2)
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -286,6 +310,30 @@ func TestMsgSwapExactAmountOut(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "valid max price impact",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountOut) types.MsgSwapExactAmountOut {
+				msg.MaxPriceImpact = osmomath.NewDecWithPrec(5, 2)
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "negative max price impact",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountOut) types.MsgSwapExactAmountOut {
+				msg.MaxPriceImpact = osmomath.NewDecWithPrec(-5, 2)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "max price impact greater than one",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountOut) types.MsgSwapExactAmountOut {
+				msg.MaxPriceImpact = osmomath.NewDecWithPrec(101, 2)
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -441,6 +489,26 @@ func TestMsgSplitRouteSwapExactAmountIn(t *testing.T) {
 			}),
 			expectError: true,
 		},
+		"valid max price impact": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.MaxPriceImpact = osmomath.NewDecWithPrec(5, 2)
+				return msg
+			}),
+		},
+		"negative max price impact": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.MaxPriceImpact = osmomath.NewDecWithPrec(-5, 2)
+				return msg
+			}),
+			expectError: true,
+		},
+		"max price impact greater than one": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.MaxPriceImpact = osmomath.NewDecWithPrec(101, 2)
+				return msg
+			}),
+			expectError: true,
+		},
 	}
 
 	for name, tc := range tests {
@@ -555,6 +623,26 @@ func TestMsgSplitRouteSwapExactAmountOut(t *testing.T) {
 			}),
 			expectError: true,
 		},
+		"valid max price impact": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.MaxPriceImpact = osmomath.NewDecWithPrec(5, 2)
+				return msg
+			}),
+		},
+		"negative max price impact": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.MaxPriceImpact = osmomath.NewDecWithPrec(-5, 2)
+				return msg
+			}),
+			expectError: true,
+		},
+		"max price impact greater than one": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.MaxPriceImpact = osmomath.NewDecWithPrec(101, 2)
+				return msg
+			}),
+			expectError: true,
+		},
 	}
 
 	for name, tc := range tests {
@@ -21,6 +21,7 @@ var (
 	KeyCommunityPoolDenomToSwapNonWhitelistedAssetsTo = []byte("CommunityPoolDenomToSwapNonWhitelistedAssetsTo")
 	KeyAuthorizedQuoteDenoms                          = []byte("AuthorizedQuoteDenoms")
 	KeyReducedTakerFeeByWhitelist                     = []byte("ReducedTakerFeeByWhitelist")
+	KeyTakerFeeDiscountTiers                          = []byte("TakerFeeDiscountTiers")
 
 	ZeroDec = osmomath.ZeroDec()
 	OneDec  = osmomath.OneDec()
@@ -66,6 +67,7 @@ func DefaultParams() Params {
 			AdminAddresses: []string{},
 			CommunityPoolDenomToSwapNonWhitelistedAssetsTo: "ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858", // USDC
 			ReducedFeeWhitelist:                            []string{},
+			DiscountTiers:                                  []TakerFeeDiscountTier{},
 		},
 		AuthorizedQuoteDenoms: []string{
 			appparams.BaseCoinUnit,
@@ -99,6 +101,9 @@ func (p Params) Validate() error {
 	if err := osmoutils.ValidateAddressList(p.TakerFeeParams.ReducedFeeWhitelist); err != nil {
 		return err
 	}
+	if err := validateTakerFeeDiscountTiers(p.TakerFeeParams.DiscountTiers); err != nil {
+		return err
+	}
 	if err := validateAuthorizedQuoteDenoms(p.AuthorizedQuoteDenoms); err != nil {
 		return err
 	}
@@ -117,6 +122,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyCommunityPoolDenomToSwapNonWhitelistedAssetsTo, &p.TakerFeeParams.CommunityPoolDenomToSwapNonWhitelistedAssetsTo, validateCommunityPoolDenomToSwapNonWhitelistedAssetsTo),
 		paramtypes.NewParamSetPair(KeyAuthorizedQuoteDenoms, &p.AuthorizedQuoteDenoms, validateAuthorizedQuoteDenoms),
 		paramtypes.NewParamSetPair(KeyReducedTakerFeeByWhitelist, &p.TakerFeeParams.ReducedFeeWhitelist, osmoutils.ValidateAddressList),
+		paramtypes.NewParamSetPair(KeyTakerFeeDiscountTiers, &p.TakerFeeParams.DiscountTiers, validateTakerFeeDiscountTiers),
 	}
 }
 
@@ -227,6 +233,29 @@ func validateCommunityPoolDenomToSwapNonWhitelistedAssetsTo(i interface{}) error
 	return nil
 }
 
+// validateTakerFeeDiscountTiers validates that every tier has a non-negative minimum amount
+// and a discount between 0 and 1, and that the tiers are sorted by strictly ascending minimum amount.
+func validateTakerFeeDiscountTiers(i interface{}) error {
+	discountTiers, ok := i.([]TakerFeeDiscountTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for idx, tier := range discountTiers {
+		if tier.MinStakedOrLockedAmount.IsNil() || tier.MinStakedOrLockedAmount.IsNegative() {
+			return fmt.Errorf("invalid taker fee discount tier min staked or locked amount: %s", tier.MinStakedOrLockedAmount)
+		}
+		if tier.Discount.IsNil() || tier.Discount.IsNegative() || tier.Discount.GT(OneDec) {
+			return fmt.Errorf("invalid taker fee discount tier discount: %s", tier.Discount)
+		}
+		if idx > 0 && tier.MinStakedOrLockedAmount.LTE(discountTiers[idx-1].MinStakedOrLockedAmount) {
+			return fmt.Errorf("taker fee discount tiers must be sorted by strictly ascending min staked or locked amount")
+		}
+	}
+
+	return nil
+}
+
 func validateDenomPairTakerFees(pairs []DenomPairTakerFee) error {
 	if len(pairs) == 0 {
 		return fmt.Errorf("Empty denom pair taker fee")
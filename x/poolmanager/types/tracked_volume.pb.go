@@ -69,8 +69,64 @@ func (m *TrackedVolume) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// VolumeBucket is the OSMO-denominated volume tracked during one volume
+// bucket. A volume bucket spans one day epoch.
+type VolumeBucket struct {
+	// bucket is the index of the volume bucket.
+	Bucket uint64                                   `protobuf:"varint,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume"`
+}
+
+func (m *VolumeBucket) Reset()         { *m = VolumeBucket{} }
+func (m *VolumeBucket) String() string { return proto.CompactTextString(m) }
+func (*VolumeBucket) ProtoMessage()    {}
+func (*VolumeBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a2e3e91de3baf1a, []int{1}
+}
+func (m *VolumeBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolumeBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolumeBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VolumeBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeBucket.Merge(m, src)
+}
+func (m *VolumeBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolumeBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeBucket proto.InternalMessageInfo
+
+func (m *VolumeBucket) GetBucket() uint64 {
+	if m != nil {
+		return m.Bucket
+	}
+	return 0
+}
+
+func (m *VolumeBucket) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TrackedVolume)(nil), "osmosis.poolmanager.v1beta1.TrackedVolume")
+	proto.RegisterType((*VolumeBucket)(nil), "osmosis.poolmanager.v1beta1.VolumeBucket")
 }
 
 func init() {
@@ -78,7 +134,7 @@ func init() {
 }
 
 var fileDescriptor_0a2e3e91de3baf1a = []byte{
-	// 255 bytes of a gzipped FileDescriptorProto
+	// 292 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xc8, 0x2f, 0xce, 0xcd,
 	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc8, 0xcf, 0xcf, 0xc9, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f, 0x2d, 0xd2,
 	0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x29, 0x4a, 0x4c, 0xce, 0x4e, 0x4d, 0x89,
@@ -90,11 +146,14 @@ var fileDescriptor_0a2e3e91de3baf1a = []byte{
 	0x24, 0xa9, 0x07, 0x31, 0x41, 0x0f, 0x64, 0x02, 0xcc, 0x32, 0x3d, 0xe7, 0xfc, 0xcc, 0x3c, 0x27,
 	0x83, 0x13, 0xf7, 0xe4, 0x19, 0x56, 0xdd, 0x97, 0xd7, 0x48, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
 	0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x5a, 0x07, 0xa1, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x4b, 0x2a, 0x0b,
-	0x52, 0x8b, 0xc1, 0x1a, 0x8a, 0x83, 0xa0, 0x46, 0x3b, 0x05, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x39, 0x92, 0x59, 0x50, 0xdf, 0xea, 0xe6, 0x24, 0x26, 0x15, 0xc3,
-	0x38, 0xfa, 0x65, 0x46, 0x96, 0xfa, 0x15, 0x28, 0x41, 0x06, 0xb6, 0x20, 0x89, 0x0d, 0xec, 0x1f,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x70, 0xd0, 0x34, 0x56, 0x01, 0x00, 0x00,
+	0x52, 0x8b, 0xc1, 0x1a, 0x8a, 0x83, 0xa0, 0x46, 0x2b, 0x75, 0x33, 0x72, 0xf1, 0x40, 0xec, 0x73,
+	0x2a, 0x4d, 0xce, 0x4e, 0x2d, 0x11, 0x12, 0xe3, 0x62, 0x4b, 0x02, 0xb3, 0x24, 0x18, 0x15, 0x18,
+	0x35, 0x58, 0x82, 0xa0, 0x3c, 0x90, 0x6b, 0x20, 0x21, 0x20, 0xc1, 0x44, 0x03, 0xd7, 0x40, 0x8c,
+	0x76, 0x0a, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27,
+	0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x73, 0x24, 0xb3,
+	0xa0, 0x61, 0xaf, 0x9b, 0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97, 0x19, 0x59, 0xea, 0x57, 0xa0,
+	0x44, 0x20, 0xd8, 0x82, 0x24, 0x36, 0x70, 0xe8, 0x1a, 0x03, 0x06, 0x00, 0xc8, 0xa9, 0x31, 0x17,
+	0xe4, 0x01, 0x00, 0x00,
 }
 
 func (m *TrackedVolume) Marshal() (dAtA []byte, err error) {
@@ -134,6 +193,48 @@ func (m *TrackedVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VolumeBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrackedVolume(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Bucket != 0 {
+		i = encodeVarintTrackedVolume(dAtA, i, uint64(m.Bucket))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTrackedVolume(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTrackedVolume(v)
 	base := offset
@@ -160,6 +261,24 @@ func (m *TrackedVolume) Size() (n int) {
 	return n
 }
 
+func (m *VolumeBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bucket != 0 {
+		n += 1 + sovTrackedVolume(uint64(m.Bucket))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovTrackedVolume(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTrackedVolume(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -250,6 +369,109 @@ func (m *TrackedVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VolumeBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackedVolume
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolumeBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolumeBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			m.Bucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackedVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bucket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackedVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackedVolume(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTrackedVolume(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
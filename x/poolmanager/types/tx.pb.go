@@ -13,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,6 +42,11 @@ type MsgSwapExactAmountIn struct {
 	Routes            []SwapAmountInRoute   `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenIn           types.Coin            `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
 	TokenOutMinAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// deadline is the block time after which the swap fails. Unset if empty.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty" yaml:"deadline"`
+	// max_price_impact is the maximum relative difference between the route spot
+	// price before the swap and the execution price. Unset if zero.
+	MaxPriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact" yaml:"max_price_impact"`
 }
 
 func (m *MsgSwapExactAmountIn) Reset()         { *m = MsgSwapExactAmountIn{} }
@@ -94,6 +103,13 @@ func (m *MsgSwapExactAmountIn) GetTokenIn() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSwapExactAmountIn) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
 type MsgSwapExactAmountInResponse struct {
 	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
 }
@@ -137,6 +153,11 @@ type MsgSplitRouteSwapExactAmountIn struct {
 	Routes            []SwapAmountInSplitRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenInDenom      string                   `protobuf:"bytes,3,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
 	TokenOutMinAmount cosmossdk_io_math.Int    `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// deadline is the block time after which the swap fails. Unset if empty.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty" yaml:"deadline"`
+	// max_price_impact is the maximum relative difference between the route spot
+	// price before the swap and the execution price. Unset if zero.
+	MaxPriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact" yaml:"max_price_impact"`
 }
 
 func (m *MsgSplitRouteSwapExactAmountIn) Reset()         { *m = MsgSplitRouteSwapExactAmountIn{} }
@@ -193,6 +214,13 @@ func (m *MsgSplitRouteSwapExactAmountIn) GetTokenInDenom() string {
 	return ""
 }
 
+func (m *MsgSplitRouteSwapExactAmountIn) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
 type MsgSplitRouteSwapExactAmountInResponse struct {
 	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
 }
@@ -238,6 +266,11 @@ type MsgSwapExactAmountOut struct {
 	Routes           []SwapAmountOutRoute  `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenInMaxAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=token_in_max_amount,json=tokenInMaxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_max_amount" yaml:"token_in_max_amount"`
 	TokenOut         types.Coin            `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+	// deadline is the block time after which the swap fails. Unset if empty.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty" yaml:"deadline"`
+	// max_price_impact is the maximum relative difference between the route spot
+	// price before the swap and the execution price. Unset if zero.
+	MaxPriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact" yaml:"max_price_impact"`
 }
 
 func (m *MsgSwapExactAmountOut) Reset()         { *m = MsgSwapExactAmountOut{} }
@@ -294,6 +327,13 @@ func (m *MsgSwapExactAmountOut) GetTokenOut() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSwapExactAmountOut) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
 type MsgSwapExactAmountOutResponse struct {
 	TokenInAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
 }
@@ -337,6 +377,11 @@ type MsgSplitRouteSwapExactAmountOut struct {
 	Routes           []SwapAmountOutSplitRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenOutDenom    string                    `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
 	TokenInMaxAmount cosmossdk_io_math.Int     `protobuf:"bytes,4,opt,name=token_in_max_amount,json=tokenInMaxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_max_amount" yaml:"token_in_max_amount"`
+	// deadline is the block time after which the swap fails. Unset if empty.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty" yaml:"deadline"`
+	// max_price_impact is the maximum relative difference between the route spot
+	// price before the swap and the execution price. Unset if zero.
+	MaxPriceImpact cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact" yaml:"max_price_impact"`
 }
 
 func (m *MsgSplitRouteSwapExactAmountOut) Reset()         { *m = MsgSplitRouteSwapExactAmountOut{} }
@@ -393,6 +438,13 @@ func (m *MsgSplitRouteSwapExactAmountOut) GetTokenOutDenom() string {
 	return ""
 }
 
+func (m *MsgSplitRouteSwapExactAmountOut) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
 type MsgSplitRouteSwapExactAmountOutResponse struct {
 	TokenInAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
 }
@@ -829,89 +881,95 @@ func init() {
 }
 
 var fileDescriptor_acd130b4825d67dc = []byte{
-	// 1298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x53, 0xdb, 0x46,
-	0x18, 0x46, 0x98, 0xf0, 0xb1, 0x10, 0x82, 0x15, 0x28, 0x8e, 0x49, 0x2d, 0x2a, 0x48, 0x4a, 0x52,
-	0x24, 0xc7, 0xc0, 0x4c, 0xc0, 0xd0, 0x49, 0x70, 0x68, 0x66, 0x98, 0xc6, 0x03, 0x51, 0x72, 0xea,
-	0x4c, 0xc7, 0xb3, 0xb6, 0x36, 0x46, 0xc5, 0xd2, 0x7a, 0xa4, 0x35, 0x81, 0x5b, 0x9b, 0x66, 0xda,
-	0x29, 0xd3, 0x43, 0x4f, 0xbd, 0x76, 0xa6, 0xbf, 0x20, 0xb9, 0xf4, 0x37, 0xe4, 0x98, 0x63, 0xa7,
-	0x07, 0x4f, 0x0b, 0x87, 0xf4, 0xec, 0x5f, 0xd0, 0x59, 0xed, 0x4a, 0xb6, 0x85, 0xfc, 0x05, 0x53,
-	0x2e, 0x89, 0xa4, 0x7d, 0x9f, 0xf7, 0xe3, 0x79, 0x9f, 0x7d, 0x77, 0x0d, 0x98, 0xc7, 0x8e, 0x89,
-	0x1d, 0xc3, 0x49, 0x96, 0x31, 0x2e, 0x99, 0xd0, 0x82, 0x45, 0x64, 0x27, 0x0f, 0x52, 0x79, 0x44,
-	0x60, 0x2a, 0x49, 0x0e, 0xd5, 0xb2, 0x8d, 0x09, 0x16, 0x67, 0xb8, 0x95, 0xda, 0x60, 0xa5, 0x72,
-	0xab, 0xf8, 0x64, 0x11, 0x17, 0xb1, 0x6b, 0x97, 0xa4, 0x4f, 0x0c, 0x12, 0x8f, 0x42, 0xd3, 0xb0,
-	0x70, 0xd2, 0xfd, 0x97, 0x7f, 0x4a, 0x14, 0x5c, 0x37, 0xc9, 0x3c, 0x74, 0x90, 0x1f, 0xa3, 0x80,
-	0x0d, 0x8b, 0xaf, 0x2f, 0xb6, 0xcb, 0xc5, 0x79, 0x09, 0xcb, 0x39, 0x1b, 0x57, 0x08, 0xe2, 0xd6,
-	0xd3, 0xdc, 0x9b, 0xe9, 0x14, 0x93, 0x07, 0x29, 0xfa, 0x1f, 0x5b, 0x90, 0x7f, 0x8c, 0x80, 0xc9,
-	0xac, 0x53, 0x7c, 0xf6, 0x12, 0x96, 0xbf, 0x38, 0x84, 0x05, 0xb2, 0x69, 0xe2, 0x8a, 0x45, 0xb6,
-	0x2d, 0xf1, 0x0e, 0x18, 0x74, 0x90, 0xa5, 0x23, 0x3b, 0x26, 0xcc, 0x0a, 0x0b, 0x23, 0x99, 0x68,
-	0xad, 0x2a, 0x5d, 0x3d, 0x82, 0x66, 0x29, 0x2d, 0xb3, 0xef, 0xb2, 0xc6, 0x0d, 0xc4, 0x27, 0x60,
-	0xd0, 0x8d, 0xe5, 0xc4, 0xfa, 0x67, 0x23, 0x0b, 0xa3, 0x4b, 0xaa, 0xda, 0x86, 0x01, 0x95, 0x86,
-	0xf2, 0xa2, 0x68, 0x14, 0x96, 0x19, 0x78, 0x57, 0x95, 0xfa, 0x34, 0xee, 0x43, 0xcc, 0x82, 0x61,
-	0x82, 0xf7, 0x91, 0x95, 0x33, 0xac, 0x58, 0x64, 0x56, 0x58, 0x18, 0x5d, 0xba, 0xa1, 0xb2, 0xec,
-	0x55, 0xca, 0x85, 0xef, 0xe7, 0x11, 0x36, 0xac, 0xcc, 0x34, 0x85, 0xd6, 0xaa, 0xd2, 0x35, 0x96,
-	0x99, 0x07, 0x94, 0xb5, 0x21, 0xf7, 0x71, 0xdb, 0x12, 0x4d, 0x30, 0xc9, 0xbe, 0xe2, 0x0a, 0xc9,
-	0x99, 0x86, 0x95, 0x83, 0x6e, 0xec, 0xd8, 0x80, 0x5b, 0xd5, 0x06, 0xc5, 0xff, 0x55, 0x95, 0xa6,
-	0x58, 0x04, 0x47, 0xdf, 0x57, 0x0d, 0x9c, 0x34, 0x21, 0xd9, 0x53, 0xb7, 0x2d, 0x52, 0xab, 0x4a,
-	0x33, 0x8d, 0x8e, 0x9b, 0x5d, 0xc8, 0x5a, 0xd4, 0xfd, 0xbc, 0x53, 0x21, 0x59, 0xc3, 0x62, 0x25,
-	0xa5, 0x57, 0x5f, 0x7d, 0x78, 0x73, 0x97, 0x13, 0x73, 0xfc, 0xe1, 0xcd, 0xdd, 0x85, 0xb0, 0x36,
-	0xd1, 0xf6, 0x28, 0x88, 0xd2, 0xad, 0x30, 0x57, 0x8a, 0x61, 0xc9, 0xaf, 0x04, 0x70, 0x33, 0xac,
-	0x13, 0x1a, 0x72, 0xca, 0xd8, 0x72, 0x90, 0x98, 0x07, 0x13, 0xf5, 0x34, 0x78, 0x15, 0xac, 0x37,
-	0xab, 0x9d, 0xaa, 0x98, 0x0e, 0x56, 0xe1, 0x55, 0x30, 0xee, 0x55, 0xc0, 0xa2, 0xc9, 0xdf, 0x47,
-	0x40, 0x82, 0x26, 0x51, 0x2e, 0x19, 0xc4, 0x6d, 0xce, 0x85, 0x84, 0xf1, 0x34, 0x20, 0x8c, 0xe5,
-	0xae, 0x85, 0x51, 0x4f, 0x20, 0xa0, 0x8e, 0x07, 0x60, 0xdc, 0x6b, 0x72, 0x4e, 0x47, 0x16, 0x36,
-	0x5d, 0x8d, 0x8c, 0x64, 0x6e, 0xd4, 0xaa, 0xd2, 0x54, 0xb3, 0x08, 0xd8, 0xba, 0xac, 0x8d, 0x71,
-	0x29, 0x6c, 0xd1, 0xd7, 0xcb, 0xd6, 0xc3, 0x72, 0x40, 0x0f, 0x73, 0xa1, 0x7a, 0xa0, 0xd5, 0x36,
-	0x48, 0xe1, 0x67, 0x01, 0xdc, 0x6e, 0xdf, 0x85, 0x4b, 0x15, 0xc5, 0x71, 0x04, 0x4c, 0x9d, 0x55,
-	0xe6, 0x4e, 0x85, 0xf4, 0xa2, 0x85, 0x6c, 0x40, 0x0b, 0xc9, 0x2e, 0xb5, 0xb0, 0x53, 0x09, 0xd5,
-	0xc1, 0x37, 0xe0, 0xba, 0xdf, 0x67, 0x13, 0x1e, 0x7a, 0xa5, 0x33, 0x31, 0xac, 0x77, 0x2a, 0x3d,
-	0x1e, 0x50, 0x4a, 0xdd, 0x83, 0xac, 0x4d, 0x70, 0xb9, 0x64, 0xe1, 0x21, 0xcb, 0x40, 0xdc, 0x05,
-	0x23, 0x3e, 0x49, 0xae, 0x4e, 0xda, 0x8e, 0xa4, 0x18, 0x1f, 0x49, 0x13, 0x01, 0x7a, 0x65, 0x6d,
-	0xd8, 0xe3, 0x35, 0xbd, 0x16, 0x50, 0xc5, 0x9d, 0xee, 0xa6, 0x04, 0xf5, 0xf2, 0xad, 0x00, 0x3e,
-	0x0e, 0x6d, 0x86, 0x2f, 0x89, 0x1c, 0xb8, 0xe6, 0x17, 0xd6, 0xa4, 0x88, 0xfb, 0x9d, 0x68, 0xf9,
-	0x28, 0x40, 0x8b, 0x47, 0xc9, 0x55, 0x4e, 0x09, 0xd7, 0xc3, 0x0f, 0x11, 0x20, 0xb5, 0x93, 0x67,
-	0x8f, 0xca, 0xd0, 0x02, 0xca, 0x58, 0xe9, 0x5e, 0x19, 0x2d, 0xc7, 0x44, 0xc6, 0xe3, 0x80, 0xea,
-	0xba, 0x71, 0x4e, 0xc4, 0x83, 0x65, 0xfa, 0x06, 0x5e, 0x99, 0x3b, 0x15, 0xc2, 0x26, 0x45, 0x0b,
-	0x89, 0x0d, 0xfc, 0x0f, 0x12, 0x4b, 0xaf, 0x04, 0x04, 0x31, 0xdf, 0x71, 0x4c, 0x50, 0x2d, 0x1c,
-	0x0b, 0xe0, 0xd3, 0x0e, 0x8d, 0xb8, 0x3c, 0x55, 0xfc, 0xd4, 0x0f, 0xa6, 0x69, 0x32, 0x88, 0xd1,
-	0xb7, 0x0b, 0x0d, 0xfb, 0x39, 0xdc, 0x47, 0xf6, 0x63, 0x84, 0x7a, 0x51, 0xc3, 0x6b, 0x01, 0x4c,
-	0xba, 0xfd, 0xc8, 0x95, 0xa1, 0x61, 0xe7, 0x08, 0x75, 0x91, 0x7b, 0x81, 0x50, 0x57, 0x77, 0x8b,
-	0x33, 0x91, 0x33, 0x73, 0x7c, 0x37, 0xf2, 0xb9, 0x1d, 0xe6, 0x59, 0xd6, 0xa2, 0x7a, 0x10, 0x97,
-	0xde, 0x08, 0x34, 0x24, 0xf4, 0xba, 0xe5, 0x20, 0xa2, 0xb8, 0x50, 0x85, 0x7a, 0x54, 0x5c, 0x8f,
-	0x0a, 0xf5, 0xb8, 0xce, 0x36, 0x48, 0x08, 0x15, 0x7e, 0x3f, 0x62, 0x60, 0xc8, 0xa9, 0x14, 0x0a,
-	0xc8, 0x71, 0x5c, 0x4e, 0x86, 0x35, 0xef, 0x55, 0xfe, 0xa7, 0x1f, 0xcc, 0x33, 0xb4, 0x07, 0x7a,
-	0xb6, 0x07, 0x6d, 0xb4, 0x59, 0xb4, 0x11, 0x32, 0x91, 0x45, 0x1e, 0x63, 0x9b, 0x09, 0xb4, 0x07,
-	0x56, 0x6f, 0x83, 0x2b, 0x6c, 0x17, 0xf4, 0xbb, 0x96, 0x13, 0xb5, 0xaa, 0x34, 0xd6, 0xc0, 0x88,
-	0xac, 0xb1, 0x65, 0xf1, 0x6b, 0x30, 0xe6, 0xec, 0x1b, 0x66, 0xae, 0x8c, 0xec, 0x02, 0xf2, 0xe7,
-	0x69, 0x9a, 0x4b, 0x64, 0xe6, 0xac, 0x44, 0x9e, 0xa0, 0x22, 0x2c, 0x1c, 0x6d, 0xa1, 0x42, 0xad,
-	0x2a, 0x5d, 0xe7, 0xb1, 0x1b, 0x1c, 0xc8, 0xda, 0x28, 0x7d, 0xdd, 0x65, 0x6f, 0x62, 0x9a, 0xbb,
-	0x87, 0xba, 0x6e, 0xd3, 0xca, 0xd9, 0x5e, 0x9a, 0x0e, 0x60, 0xf9, 0x2a, 0xc7, 0x6e, 0xb2, 0xb7,
-	0xf4, 0x97, 0x81, 0x8e, 0xac, 0xb7, 0xea, 0x88, 0xdf, 0x06, 0xc5, 0xa1, 0xbc, 0x29, 0xd0, 0x23,
-	0x4e, 0x79, 0x81, 0x6d, 0xd6, 0x2f, 0x59, 0x05, 0x8b, 0xdd, 0x50, 0xec, 0x75, 0x4b, 0xfe, 0x43,
-	0x00, 0x33, 0x0c, 0xa0, 0xa1, 0xa2, 0xe1, 0x10, 0x64, 0x23, 0x7d, 0xb3, 0x54, 0xc2, 0x47, 0x48,
-	0xdf, 0xc5, 0xb8, 0xd4, 0x4b, 0x2b, 0x3e, 0x03, 0x43, 0x34, 0xe3, 0x9c, 0xa1, 0xbb, 0xcd, 0x18,
-	0xc8, 0x88, 0xb5, 0xaa, 0x34, 0xce, 0x6c, 0xf9, 0x82, 0xac, 0x0d, 0xd2, 0xa7, 0x6d, 0x3d, 0xfd,
-	0x20, 0x50, 0x74, 0xb2, 0x55, 0xd1, 0xb6, 0x9f, 0x96, 0x02, 0x59, 0x5e, 0x0a, 0x35, 0x91, 0x6f,
-	0x81, 0xb9, 0x36, 0x79, 0xfb, 0xf5, 0xfd, 0xdb, 0x0f, 0xa2, 0x67, 0xb7, 0xed, 0xe7, 0x60, 0xd0,
-	0xa5, 0xeb, 0x1e, 0xaf, 0xea, 0x56, 0xad, 0x2a, 0x49, 0x0d, 0xb2, 0xb9, 0x27, 0x2f, 0xea, 0xa8,
-	0x6c, 0xa3, 0x02, 0x24, 0x48, 0x4f, 0xcb, 0xc4, 0xae, 0x20, 0x39, 0x26, 0x68, 0x1c, 0xe4, 0xc3,
-	0x53, 0x5c, 0x75, 0x41, 0x78, 0xaa, 0x1d, 0x3c, 0x25, 0x3e, 0x07, 0x23, 0xf5, 0xdd, 0x1f, 0x69,
-	0x9a, 0x55, 0x1d, 0x84, 0xe8, 0x1d, 0xbd, 0xf5, 0x1d, 0x3e, 0x4c, 0xea, 0x35, 0x35, 0xdd, 0x07,
-	0xb9, 0x04, 0xbb, 0xbe, 0x3e, 0x3e, 0x04, 0xcd, 0xa7, 0x44, 0xec, 0x4a, 0x8f, 0xc7, 0xca, 0xd2,
-	0xdb, 0x61, 0x10, 0xc9, 0x3a, 0x45, 0xf1, 0x3b, 0x01, 0x44, 0xcf, 0xde, 0xae, 0x53, 0x6d, 0xe7,
-	0x5b, 0xd8, 0xef, 0x83, 0xf8, 0x5a, 0xcf, 0x10, 0x7f, 0x08, 0xbd, 0x16, 0x80, 0x18, 0x72, 0x78,
-	0x2f, 0xf5, 0xe8, 0x71, 0xa7, 0x42, 0xe2, 0xe9, 0xde, 0x31, 0x7e, 0x1a, 0xbf, 0x09, 0x60, 0xa6,
-	0xdd, 0x4f, 0x8e, 0xf5, 0x8e, 0xbe, 0x5b, 0x83, 0xe3, 0x8f, 0x2e, 0x00, 0xf6, 0x33, 0xfc, 0x5d,
-	0x00, 0x37, 0xdb, 0xde, 0x77, 0x36, 0xce, 0x1d, 0x85, 0x92, 0xb7, 0x75, 0x11, 0xb4, 0x9f, 0xe4,
-	0xb1, 0x00, 0x26, 0x43, 0x8f, 0xdf, 0x95, 0x8e, 0xee, 0x43, 0x50, 0xf1, 0x8d, 0xf3, 0xa0, 0xfc,
-	0x64, 0xde, 0x0a, 0xe0, 0x93, 0xce, 0x47, 0xd8, 0x66, 0x17, 0x31, 0xda, 0xbb, 0x88, 0x6f, 0x5f,
-	0xd8, 0x85, 0x9f, 0xf3, 0xaf, 0x02, 0x88, 0xb5, 0x1c, 0xf1, 0xab, 0x5d, 0xc4, 0x09, 0x45, 0xc6,
-	0x1f, 0x9e, 0x17, 0xe9, 0x25, 0x96, 0x79, 0xfa, 0xee, 0x24, 0x21, 0xbc, 0x3f, 0x49, 0x08, 0x7f,
-	0x9f, 0x24, 0x84, 0x5f, 0x4e, 0x13, 0x7d, 0xef, 0x4f, 0x13, 0x7d, 0x7f, 0x9e, 0x26, 0xfa, 0xbe,
-	0xba, 0x5f, 0x34, 0xc8, 0x5e, 0x25, 0xaf, 0x16, 0xb0, 0xe9, 0x9d, 0x0d, 0x4a, 0x09, 0xe6, 0x1d,
-	0xff, 0xa0, 0x38, 0x58, 0x5a, 0x4b, 0x1e, 0x36, 0x1d, 0x17, 0xe4, 0xa8, 0x8c, 0x9c, 0xfc, 0xa0,
-	0xfb, 0xf7, 0x9f, 0xe5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x7f, 0x8a, 0x8d, 0xd4, 0x12,
-	0x00, 0x00,
+	// 1404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x97, 0x4f, 0x6f, 0xd4, 0xc6,
+	0x1b, 0xc7, 0xe3, 0x64, 0x09, 0xc9, 0x00, 0x21, 0x31, 0xe1, 0xb7, 0x66, 0xc3, 0x6f, 0x4d, 0xcd,
+	0x9f, 0x06, 0x8a, 0x6d, 0x36, 0x20, 0x01, 0x9b, 0xb4, 0x90, 0x85, 0x22, 0x45, 0x25, 0x4a, 0x30,
+	0x9c, 0x2a, 0x55, 0xd6, 0xec, 0x7a, 0xd8, 0xb8, 0x59, 0x7b, 0x2c, 0x7b, 0x16, 0x92, 0x5b, 0x8b,
+	0xb8, 0x14, 0xf5, 0xc0, 0xa9, 0xd7, 0x4a, 0x7d, 0x05, 0x70, 0xe9, 0xb1, 0x52, 0x2b, 0x55, 0xe2,
+	0xc8, 0xb1, 0xea, 0x61, 0xdb, 0xc2, 0x81, 0x9e, 0xf7, 0x15, 0x54, 0xe3, 0x19, 0x7b, 0x77, 0xbd,
+	0xde, 0x7f, 0x44, 0x45, 0x42, 0xea, 0x25, 0x59, 0xdb, 0xf3, 0x7d, 0xe6, 0x79, 0x9e, 0xef, 0x67,
+	0x66, 0x6c, 0x70, 0x0a, 0x07, 0x0e, 0x0e, 0xec, 0x40, 0xf7, 0x30, 0xae, 0x39, 0xd0, 0x85, 0x55,
+	0xe4, 0xeb, 0x0f, 0x0a, 0x65, 0x44, 0x60, 0x41, 0x27, 0x3b, 0x9a, 0xe7, 0x63, 0x82, 0xc5, 0x05,
+	0x3e, 0x4a, 0x6b, 0x1b, 0xa5, 0xf1, 0x51, 0xb9, 0xf9, 0x2a, 0xae, 0xe2, 0x70, 0x9c, 0x4e, 0x7f,
+	0x31, 0x49, 0x6e, 0x0e, 0x3a, 0xb6, 0x8b, 0xf5, 0xf0, 0x2f, 0xbf, 0x95, 0xaf, 0x84, 0x61, 0xf4,
+	0x32, 0x0c, 0x50, 0x3c, 0x47, 0x05, 0xdb, 0x2e, 0x7f, 0x7e, 0xbe, 0x5f, 0x2e, 0xc1, 0x43, 0xe8,
+	0x99, 0x3e, 0xae, 0x13, 0xc4, 0x47, 0x67, 0x79, 0x34, 0x27, 0xa8, 0xea, 0x0f, 0x0a, 0xf4, 0x1f,
+	0x7f, 0x20, 0x57, 0x31, 0xae, 0xd6, 0x90, 0x1e, 0x5e, 0x95, 0xeb, 0xf7, 0x75, 0x62, 0x3b, 0x28,
+	0x20, 0xd0, 0xf1, 0xd8, 0x00, 0xe5, 0x97, 0x0c, 0x98, 0x5f, 0x0f, 0xaa, 0x77, 0x1f, 0x42, 0xef,
+	0xd3, 0x1d, 0x58, 0x21, 0xab, 0x0e, 0xae, 0xbb, 0x64, 0xcd, 0x15, 0xcf, 0x82, 0xc9, 0x00, 0xb9,
+	0x16, 0xf2, 0x25, 0xe1, 0x84, 0xb0, 0x38, 0x5d, 0x9a, 0x6b, 0x36, 0xe4, 0x43, 0xbb, 0xd0, 0xa9,
+	0x15, 0x15, 0x76, 0x5f, 0x31, 0xf8, 0x00, 0xf1, 0x36, 0x98, 0x0c, 0x93, 0x09, 0xa4, 0xf1, 0x13,
+	0x13, 0x8b, 0x07, 0x96, 0x34, 0xad, 0x4f, 0x8b, 0x34, 0x3a, 0x55, 0x34, 0x8b, 0x41, 0x65, 0xa5,
+	0xcc, 0x8b, 0x86, 0x3c, 0x66, 0xf0, 0x18, 0xe2, 0x3a, 0x98, 0x22, 0x78, 0x1b, 0xb9, 0xa6, 0xed,
+	0x4a, 0x13, 0x27, 0x84, 0xc5, 0x03, 0x4b, 0xc7, 0x34, 0x56, 0x9e, 0x46, 0x9b, 0x15, 0xc7, 0xb9,
+	0x81, 0x6d, 0xb7, 0x94, 0xa5, 0xd2, 0x66, 0x43, 0x3e, 0xcc, 0x32, 0x8b, 0x84, 0x8a, 0xb1, 0x3f,
+	0xfc, 0xb9, 0xe6, 0x8a, 0x0e, 0x98, 0x67, 0x77, 0x71, 0x9d, 0x98, 0x8e, 0xed, 0x9a, 0x30, 0x9c,
+	0x5b, 0xca, 0x84, 0x55, 0xad, 0x50, 0xfd, 0xef, 0x0d, 0xf9, 0x28, 0x9b, 0x21, 0xb0, 0xb6, 0x35,
+	0x1b, 0xeb, 0x0e, 0x24, 0x5b, 0xda, 0x9a, 0x4b, 0x9a, 0x0d, 0x79, 0xa1, 0x3d, 0x70, 0x67, 0x08,
+	0xc5, 0x98, 0x0b, 0x6f, 0x6f, 0xd4, 0xc9, 0xba, 0xed, 0xb2, 0x92, 0xc4, 0x0d, 0x30, 0x65, 0x21,
+	0x68, 0xd5, 0x6c, 0x17, 0x49, 0xfb, 0xc2, 0xec, 0x73, 0x1a, 0xf3, 0x40, 0x8b, 0x3c, 0xd0, 0xee,
+	0x45, 0x1e, 0x94, 0xb2, 0xad, 0xd4, 0x23, 0x95, 0xf2, 0xf4, 0x0f, 0x59, 0x30, 0xe2, 0x20, 0xe2,
+	0x16, 0x98, 0x75, 0xe0, 0x8e, 0xe9, 0xf9, 0x76, 0x05, 0x99, 0xb6, 0xe3, 0xc1, 0x0a, 0x91, 0x26,
+	0xc3, 0xdc, 0x3f, 0xe1, 0xb9, 0x2f, 0x74, 0xe7, 0x7e, 0x1b, 0x55, 0x61, 0x65, 0xf7, 0x26, 0xaa,
+	0x34, 0x1b, 0x72, 0x96, 0xc5, 0x4f, 0x06, 0x51, 0x8c, 0x19, 0x07, 0xee, 0x6c, 0xd2, 0x3b, 0x6b,
+	0xe1, 0x8d, 0xe2, 0x95, 0x47, 0x6f, 0x9e, 0x9d, 0xe3, 0x9e, 0x3e, 0x79, 0xf3, 0xec, 0xdc, 0x62,
+	0x1a, 0x82, 0x14, 0x3d, 0x15, 0x51, 0x52, 0x54, 0xd6, 0x05, 0xd5, 0x76, 0x95, 0x47, 0x02, 0x38,
+	0x9e, 0x06, 0x91, 0x81, 0x02, 0x0f, 0xbb, 0x01, 0x12, 0xcb, 0x60, 0xb6, 0xd5, 0x41, 0x6e, 0x00,
+	0xc3, 0xea, 0xca, 0x20, 0x03, 0xb2, 0x49, 0x03, 0xa2, 0xe6, 0xcf, 0x44, 0xcd, 0x67, 0xb3, 0x29,
+	0x3f, 0x65, 0x40, 0x9e, 0x26, 0xe1, 0xd5, 0x6c, 0x12, 0x72, 0xb5, 0x27, 0xa6, 0xef, 0x24, 0x98,
+	0xbe, 0x38, 0x34, 0xd3, 0xad, 0x04, 0x12, 0x60, 0x5f, 0x03, 0x33, 0x11, 0x9f, 0xa6, 0x85, 0x5c,
+	0xec, 0x84, 0x78, 0x4f, 0x97, 0x8e, 0x35, 0x1b, 0xf2, 0xd1, 0x4e, 0x7e, 0xd9, 0x73, 0xc5, 0x38,
+	0xc8, 0x29, 0xbe, 0x49, 0x2f, 0xff, 0x43, 0x79, 0x68, 0x94, 0x2f, 0x26, 0x50, 0x3e, 0x99, 0x8a,
+	0x32, 0x35, 0xaa, 0x8d, 0xe2, 0x6f, 0x05, 0x70, 0xa6, 0x3f, 0x40, 0xef, 0x94, 0xe7, 0x5f, 0x33,
+	0xe0, 0x68, 0xf7, 0xa2, 0xda, 0xa8, 0x93, 0x51, 0x30, 0x5e, 0x4f, 0x60, 0xac, 0x0f, 0x89, 0xf1,
+	0x46, 0x3d, 0x15, 0xe1, 0x2f, 0xc1, 0x91, 0x18, 0x51, 0xea, 0x02, 0x2f, 0x9d, 0x71, 0xbc, 0x3c,
+	0xa8, 0xf4, 0x5c, 0x02, 0xf2, 0x56, 0x04, 0xc5, 0x98, 0xe5, 0xa4, 0xaf, 0xc3, 0x1d, 0x8e, 0xdf,
+	0x26, 0x98, 0x8e, 0x9b, 0x24, 0x65, 0x06, 0x1d, 0x04, 0x12, 0x3f, 0x08, 0x66, 0x13, 0xed, 0x55,
+	0x8c, 0xa9, 0xa8, 0xaf, 0xef, 0x33, 0xd0, 0x57, 0x13, 0x40, 0x9f, 0x1d, 0x6e, 0x6f, 0xa6, 0x0d,
+	0xf8, 0x4a, 0x00, 0xff, 0x4f, 0xe5, 0x28, 0xa6, 0xd9, 0x04, 0x87, 0x63, 0x4f, 0x3a, 0x60, 0xbe,
+	0x3c, 0xc8, 0xd1, 0xff, 0x25, 0x1c, 0x8d, 0xdc, 0x3c, 0xc4, 0xdd, 0xe4, 0x28, 0xff, 0x9c, 0x01,
+	0x72, 0xbf, 0x95, 0x35, 0x22, 0xd4, 0x46, 0x02, 0xea, 0x4b, 0xc3, 0x43, 0xdd, 0x73, 0x73, 0x2e,
+	0x81, 0xc3, 0xad, 0x25, 0xd9, 0xbe, 0x3b, 0xe7, 0x92, 0x65, 0xc6, 0x03, 0xa2, 0x32, 0x37, 0xea,
+	0x84, 0xed, 0xcf, 0x3d, 0x56, 0x47, 0xe6, 0xdf, 0x58, 0x1d, 0xef, 0x31, 0xcb, 0x97, 0x12, 0x2c,
+	0x9f, 0x1a, 0xb8, 0x39, 0x53, 0x8c, 0x9f, 0x08, 0xe0, 0xc3, 0x01, 0x0c, 0xbd, 0x3b, 0xa0, 0xbf,
+	0x19, 0x07, 0x59, 0x9a, 0x0c, 0x62, 0xce, 0x6f, 0x42, 0xdb, 0xbf, 0x07, 0xb7, 0x91, 0x7f, 0x0b,
+	0xa1, 0x51, 0x40, 0x7e, 0x2c, 0x80, 0xf9, 0x10, 0x25, 0xd3, 0x83, 0xb6, 0x6f, 0x12, 0x1a, 0xc2,
+	0xbc, 0x8f, 0xd0, 0x50, 0xef, 0xd1, 0x5d, 0x33, 0x97, 0x4e, 0xf2, 0x3d, 0x70, 0x21, 0x72, 0xba,
+	0x3b, 0xb2, 0x62, 0xcc, 0x59, 0x49, 0x5d, 0x71, 0x25, 0x61, 0x48, 0xea, 0xb7, 0x47, 0x80, 0x88,
+	0x1a, 0x4a, 0x55, 0x1a, 0x51, 0x0d, 0x23, 0xaa, 0x34, 0xe2, 0x32, 0x90, 0x7b, 0xb4, 0x22, 0xf6,
+	0x43, 0x02, 0xfb, 0x83, 0x7a, 0xa5, 0x82, 0x82, 0x20, 0xec, 0xc9, 0x94, 0x11, 0x5d, 0x2a, 0x7f,
+	0x8d, 0x83, 0x53, 0x4c, 0x1d, 0x89, 0xee, 0x6e, 0x41, 0x1f, 0xad, 0x56, 0x7d, 0x84, 0x1c, 0xe4,
+	0x92, 0x5b, 0xd8, 0x67, 0x6b, 0x6b, 0x84, 0xae, 0x9e, 0x01, 0xfb, 0xd8, 0x02, 0x1e, 0x0f, 0x47,
+	0xce, 0x36, 0x1b, 0xf2, 0xc1, 0xb6, 0x8e, 0x28, 0x06, 0x7b, 0x2c, 0x7e, 0x01, 0x0e, 0x06, 0xdb,
+	0xb6, 0x63, 0x7a, 0xc8, 0xaf, 0xa0, 0xf8, 0x14, 0x2b, 0x0e, 0x47, 0xfb, 0x11, 0x3e, 0x77, 0x5b,
+	0x00, 0xc5, 0x38, 0x40, 0x2f, 0x37, 0xd9, 0x95, 0x58, 0xe4, 0xe1, 0xa1, 0x65, 0xf9, 0xb4, 0x72,
+	0xb6, 0x0d, 0x64, 0x13, 0x5a, 0xfe, 0x94, 0x6b, 0x57, 0xd9, 0x55, 0xf1, 0xb3, 0x84, 0x23, 0xcb,
+	0xbd, 0x1c, 0x89, 0x6d, 0x50, 0x03, 0xda, 0x37, 0x15, 0x46, 0x8d, 0x53, 0xef, 0x63, 0x9f, 0xf9,
+	0xa5, 0x68, 0xe0, 0xfc, 0x30, 0x2d, 0x8e, 0xdc, 0x52, 0x7e, 0x14, 0xc0, 0x02, 0x13, 0x18, 0xa8,
+	0x6a, 0x07, 0x04, 0xf9, 0xc8, 0x5a, 0xad, 0xd5, 0xf0, 0x2e, 0xb2, 0x36, 0x31, 0xae, 0x8d, 0x62,
+	0xc5, 0x47, 0x60, 0x3f, 0xcd, 0xd8, 0xb4, 0xad, 0xd0, 0x8c, 0x4c, 0x49, 0x6c, 0x36, 0xe4, 0x19,
+	0x36, 0x96, 0x3f, 0x50, 0x8c, 0x49, 0xfa, 0x6b, 0xcd, 0x2a, 0x5e, 0x4b, 0x14, 0xad, 0xf7, 0x2a,
+	0xda, 0x8f, 0xd3, 0x52, 0x21, 0xcb, 0x4b, 0xa5, 0x43, 0x94, 0xd3, 0xe0, 0x64, 0x9f, 0xbc, 0xe3,
+	0xfa, 0xfe, 0x1e, 0x07, 0x73, 0xdd, 0xcb, 0xf6, 0x63, 0x30, 0x19, 0xb6, 0xeb, 0x02, 0xaf, 0xea,
+	0x74, 0xb3, 0x21, 0xcb, 0x6d, 0xd8, 0x5c, 0x50, 0xce, 0x5b, 0xc8, 0xf3, 0x51, 0x05, 0x12, 0x64,
+	0x15, 0x15, 0xe2, 0xd7, 0x91, 0x22, 0x09, 0x06, 0x17, 0xc5, 0xf2, 0x82, 0x34, 0x9e, 0x2a, 0x2f,
+	0xf4, 0x93, 0x17, 0xc4, 0x7b, 0x60, 0xba, 0xb5, 0xfa, 0x27, 0x3a, 0xf6, 0xaa, 0x01, 0x20, 0x46,
+	0x2f, 0x3c, 0xad, 0x15, 0x3e, 0x45, 0x5a, 0x35, 0x75, 0x7c, 0x40, 0x48, 0x99, 0xd1, 0xbe, 0x37,
+	0xae, 0x83, 0xce, 0x03, 0x4e, 0xda, 0x37, 0xe2, 0x89, 0xb8, 0xf4, 0x7c, 0x0a, 0x4c, 0xac, 0x07,
+	0x55, 0xf1, 0x6b, 0x01, 0xcc, 0x75, 0x7f, 0x8e, 0x15, 0xfa, 0xee, 0x6f, 0x69, 0x1f, 0x94, 0xb9,
+	0xab, 0x23, 0x4b, 0xe2, 0x4d, 0xe8, 0xb1, 0x00, 0xc4, 0x94, 0xf7, 0x8e, 0xa5, 0x11, 0x23, 0x6e,
+	0xd4, 0x49, 0xae, 0x38, 0xba, 0x26, 0x4e, 0xe3, 0x7b, 0x01, 0x2c, 0xf4, 0xfb, 0x46, 0x5d, 0x1e,
+	0x18, 0xbb, 0xb7, 0x38, 0x77, 0x63, 0x0f, 0xe2, 0x38, 0xc3, 0x1f, 0x04, 0x70, 0xbc, 0xef, 0xab,
+	0xda, 0xca, 0x5b, 0xcf, 0x42, 0x9b, 0x77, 0x73, 0x2f, 0xea, 0x38, 0xc9, 0x27, 0x02, 0x98, 0x4f,
+	0x3d, 0x7e, 0x2f, 0x0d, 0x0c, 0x9f, 0xa2, 0xca, 0xad, 0xbc, 0x8d, 0x2a, 0x4e, 0xe6, 0xb9, 0x00,
+	0x3e, 0x18, 0x7c, 0x84, 0xad, 0x0e, 0x31, 0x47, 0xff, 0x10, 0xb9, 0xb5, 0x3d, 0x87, 0x88, 0x73,
+	0xfe, 0x4e, 0x00, 0x52, 0xcf, 0x2d, 0xfe, 0xca, 0x10, 0xf3, 0xa4, 0x2a, 0x73, 0xd7, 0xdf, 0x56,
+	0x19, 0x25, 0x56, 0xba, 0xf3, 0xe2, 0x55, 0x5e, 0x78, 0xf9, 0x2a, 0x2f, 0xfc, 0xf9, 0x2a, 0x2f,
+	0x3c, 0x7d, 0x9d, 0x1f, 0x7b, 0xf9, 0x3a, 0x3f, 0xf6, 0xdb, 0xeb, 0xfc, 0xd8, 0xe7, 0x97, 0xab,
+	0x36, 0xd9, 0xaa, 0x97, 0xb5, 0x0a, 0x76, 0xa2, 0xb3, 0x41, 0xad, 0xc1, 0x72, 0x10, 0x5d, 0xe8,
+	0x0f, 0x96, 0xae, 0xea, 0x3b, 0x1d, 0xc7, 0x05, 0xd9, 0xf5, 0x50, 0x50, 0x9e, 0x0c, 0x5f, 0x89,
+	0x2f, 0xfe, 0x33, 0x00, 0x0f, 0xc4, 0x3f, 0x04, 0xe1, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1230,6 +1288,26 @@ func (m *MsgSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxPriceImpact.Size()
+		i -= size
+		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Deadline != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.TokenOutMinAmount.Size()
 		i -= size
@@ -1327,6 +1405,26 @@ func (m *MsgSplitRouteSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxPriceImpact.Size()
+		i -= size
+		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Deadline != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.TokenOutMinAmount.Size()
 		i -= size
@@ -1421,6 +1519,26 @@ func (m *MsgSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxPriceImpact.Size()
+		i -= size
+		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Deadline != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1518,6 +1636,26 @@ func (m *MsgSplitRouteSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxPriceImpact.Size()
+		i -= size
+		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Deadline != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTx(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.TokenInMaxAmount.Size()
 		i -= size
@@ -1896,6 +2034,12 @@ func (m *MsgSwapExactAmountIn) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.TokenOutMinAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxPriceImpact.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1932,6 +2076,12 @@ func (m *MsgSplitRouteSwapExactAmountIn) Size() (n int) {
 	}
 	l = m.TokenOutMinAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxPriceImpact.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1966,6 +2116,12 @@ func (m *MsgSwapExactAmountOut) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.TokenOut.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxPriceImpact.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -2002,6 +2158,12 @@ func (m *MsgSplitRouteSwapExactAmountOut) Size() (n int) {
 	}
 	l = m.TokenInMaxAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxPriceImpact.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -2299,6 +2461,76 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2565,6 +2797,76 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2832,6 +3134,76 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3098,6 +3470,76 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
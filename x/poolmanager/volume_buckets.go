@@ -0,0 +1,190 @@
+package poolmanager
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v29/x/poolmanager/types"
+)
+
+const (
+	// VolumeBucketEpochIdentifier is the identifier of the epoch at the end of which a new volume bucket starts.
+	VolumeBucketEpochIdentifier = "day"
+	// VolumeBucketRetention is the number of volume buckets kept in state, the current bucket included.
+	// Older buckets are pruned when a new bucket starts.
+	VolumeBucketRetention = 30
+)
+
+// Volume buckets track the OSMO-denominated volume of each pool and of each denom pair over a rolling window.
+// Each bucket spans one VolumeBucketEpochIdentifier epoch. Volume is added to the current bucket as swaps
+// are executed, and a new bucket is started at the end of every epoch. Only the last VolumeBucketRetention
+// buckets are kept in state, so that, for example, the daily and weekly volumes of a pool can be read by summing
+// its most recent buckets.
+
+// GetVolumeBucketIndex returns the index of the current volume bucket.
+func (k Keeper) GetVolumeBucketIndex(ctx sdk.Context) uint64 {
+	bucketIndex := gogotypes.UInt64Value{}
+	if _, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyVolumeBucketIndex, &bucketIndex); err != nil {
+		panic(err)
+	}
+	return bucketIndex.Value
+}
+
+// SetVolumeBucketIndex sets the index of the current volume bucket.
+func (k Keeper) SetVolumeBucketIndex(ctx sdk.Context, bucket uint64) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyVolumeBucketIndex, &gogotypes.UInt64Value{Value: bucket})
+}
+
+// GetPoolVolumeBucket returns the volume of the given pool in the given volume bucket.
+func (k Keeper) GetPoolVolumeBucket(ctx sdk.Context, bucket, poolId uint64) sdk.Coins {
+	volumeBucket := types.PoolVolumeBucket{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPoolVolumeBucket(bucket, poolId), &volumeBucket)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return sdk.NewCoins()
+	}
+	return volumeBucket.Volume
+}
+
+// SetPoolVolumeBucket sets the volume of the given pool in the given volume bucket.
+func (k Keeper) SetPoolVolumeBucket(ctx sdk.Context, volumeBucket types.PoolVolumeBucket) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPoolVolumeBucket(volumeBucket.Bucket, volumeBucket.PoolId), &volumeBucket)
+}
+
+// GetDenomPairVolumeBucket returns the volume swapped between the given denoms, in either direction, in the given volume bucket.
+func (k Keeper) GetDenomPairVolumeBucket(ctx sdk.Context, bucket uint64, denomA, denomB string) sdk.Coins {
+	volumeBucket := types.DenomPairVolumeBucket{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyDenomPairVolumeBucket(bucket, denomA, denomB), &volumeBucket)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return sdk.NewCoins()
+	}
+	return volumeBucket.Volume
+}
+
+// SetDenomPairVolumeBucket sets the volume of the given denom pair in the given volume bucket.
+// The denoms of the pair are ordered before being stored.
+func (k Keeper) SetDenomPairVolumeBucket(ctx sdk.Context, volumeBucket types.DenomPairVolumeBucket) {
+	volumeBucket.Denom_0, volumeBucket.Denom_1 = types.OrderDenomPair(volumeBucket.Denom_0, volumeBucket.Denom_1)
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyDenomPairVolumeBucket(volumeBucket.Bucket, volumeBucket.Denom_0, volumeBucket.Denom_1), &volumeBucket)
+}
+
+// GetAllPoolVolumeBuckets returns the volume buckets of all pools.
+func (k Keeper) GetAllPoolVolumeBuckets(ctx sdk.Context) ([]types.PoolVolumeBucket, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPoolVolumeBucketPrefix, parsePoolVolumeBucket)
+}
+
+// GetAllDenomPairVolumeBuckets returns the volume buckets of all denom pairs.
+func (k Keeper) GetAllDenomPairVolumeBuckets(ctx sdk.Context) ([]types.DenomPairVolumeBucket, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyDenomPairVolumeBucketPrefix, parseDenomPairVolumeBucket)
+}
+
+// GetPoolVolumeBuckets returns the volume of the given pool in the numBuckets most recent volume buckets,
+// from the current bucket to the oldest one, along with the volume summed over these buckets.
+// If numBuckets is zero, all retained buckets are returned.
+func (k Keeper) GetPoolVolumeBuckets(ctx sdk.Context, poolId, numBuckets uint64) ([]types.VolumeBucket, sdk.Coins, error) {
+	if _, err := k.GetPool(ctx, poolId); err != nil {
+		return nil, nil, err
+	}
+	return k.getRecentVolumeBuckets(ctx, numBuckets, func(bucket uint64) sdk.Coins {
+		return k.GetPoolVolumeBucket(ctx, bucket, poolId)
+	})
+}
+
+// GetDenomPairVolumeBuckets returns the volume swapped between the given denoms, in either direction, in the numBuckets
+// most recent volume buckets, from the current bucket to the oldest one, along with the volume summed over these buckets.
+// If numBuckets is zero, all retained buckets are returned.
+func (k Keeper) GetDenomPairVolumeBuckets(ctx sdk.Context, denomA, denomB string, numBuckets uint64) ([]types.VolumeBucket, sdk.Coins, error) {
+	if err := sdk.ValidateDenom(denomA); err != nil {
+		return nil, nil, err
+	}
+	if err := sdk.ValidateDenom(denomB); err != nil {
+		return nil, nil, err
+	}
+	if denomA == denomB {
+		return nil, nil, fmt.Errorf("denoms of the pair must be different, got %s twice", denomA)
+	}
+	return k.getRecentVolumeBuckets(ctx, numBuckets, func(bucket uint64) sdk.Coins {
+		return k.GetDenomPairVolumeBucket(ctx, bucket, denomA, denomB)
+	})
+}
+
+// getRecentVolumeBuckets returns the volume read by getVolume in the numBuckets most recent volume buckets, along with their sum.
+// Buckets that were never reached, because fewer than numBuckets epochs elapsed, are not returned.
+func (k Keeper) getRecentVolumeBuckets(ctx sdk.Context, numBuckets uint64, getVolume func(bucket uint64) sdk.Coins) ([]types.VolumeBucket, sdk.Coins, error) {
+	if numBuckets > VolumeBucketRetention {
+		return nil, nil, fmt.Errorf("number of volume buckets must be at most %d, got %d", VolumeBucketRetention, numBuckets)
+	}
+	if numBuckets == 0 {
+		numBuckets = VolumeBucketRetention
+	}
+
+	currentBucket := k.GetVolumeBucketIndex(ctx)
+	numBuckets = min(numBuckets, currentBucket+1)
+
+	volumeBuckets := make([]types.VolumeBucket, 0, numBuckets)
+	totalVolume := sdk.NewCoins()
+	for i := uint64(0); i < numBuckets; i++ {
+		bucket := currentBucket - i
+		volume := getVolume(bucket)
+		volumeBuckets = append(volumeBuckets, types.VolumeBucket{Bucket: bucket, Volume: volume})
+		totalVolume = totalVolume.Add(volume...)
+	}
+	return volumeBuckets, totalVolume, nil
+}
+
+// addVolumeToBuckets adds the given OSMO-denominated volume to the current volume bucket of the given pool
+// and of the given denom pair.
+func (k Keeper) addVolumeToBuckets(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string, volumeGenerated sdk.Coin) {
+	bucket := k.GetVolumeBucketIndex(ctx)
+
+	k.SetPoolVolumeBucket(ctx, types.PoolVolumeBucket{
+		Bucket: bucket,
+		PoolId: poolId,
+		Volume: k.GetPoolVolumeBucket(ctx, bucket, poolId).Add(volumeGenerated),
+	})
+
+	// Volume of a swap between a denom and itself is not attributed to any pair.
+	if tokenInDenom == tokenOutDenom {
+		return
+	}
+	k.SetDenomPairVolumeBucket(ctx, types.DenomPairVolumeBucket{
+		Bucket:  bucket,
+		Denom_0: tokenInDenom,
+		Denom_1: tokenOutDenom,
+		Volume:  k.GetDenomPairVolumeBucket(ctx, bucket, tokenInDenom, tokenOutDenom).Add(volumeGenerated),
+	})
+}
+
+// rotateVolumeBuckets starts a new volume bucket and prunes the buckets that fall out of the retention window.
+func (k Keeper) rotateVolumeBuckets(ctx sdk.Context) {
+	newBucket := k.GetVolumeBucketIndex(ctx) + 1
+	k.SetVolumeBucketIndex(ctx, newBucket)
+
+	if newBucket < VolumeBucketRetention {
+		return
+	}
+	prunedBucket := newBucket - VolumeBucketRetention
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.DeleteAllKeysFromPrefix(store, types.KeyPoolVolumeBucketPrefixForBucket(prunedBucket))
+	osmoutils.DeleteAllKeysFromPrefix(store, types.KeyDenomPairVolumeBucketPrefixForBucket(prunedBucket))
+}
+
+func parsePoolVolumeBucket(bz []byte) (types.PoolVolumeBucket, error) {
+	volumeBucket := types.PoolVolumeBucket{}
+	err := volumeBucket.Unmarshal(bz)
+	return volumeBucket, err
+}
+
+func parseDenomPairVolumeBucket(bz []byte) (types.DenomPairVolumeBucket, error) {
+	volumeBucket := types.DenomPairVolumeBucket{}
+	err := volumeBucket.Unmarshal(bz)
+	return volumeBucket, err
+}
@@ -0,0 +1,171 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v29/x/poolmanager/types"
+)
+
+func osmoVolume(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(UOSMO, amount))
+}
+
+// TestVolumeBuckets tests that swaps add their volume to the current bucket of the pool and of the
+// swapped denom pair, and that the day epoch starts a new bucket and prunes the expired ones.
+func (s *KeeperTestSuite) TestVolumeBuckets() {
+	s.SetupTest()
+	s.createBalancerPoolsFromCoins([]sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin(UOSMO, 1_000_000), sdk.NewInt64Coin(FOO, 1_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin(UOSMO, 1_000_000), sdk.NewInt64Coin(BAR, 1_000_000)),
+	})
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin(UOSMO, 1_000_000)))
+	epochHooks := s.App.PoolManagerKeeper.EpochHooks()
+
+	swap := func(poolId uint64, tokenOutDenom string, amount int64) {
+		_, _, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[0], poolId, sdk.NewInt64Coin(UOSMO, amount), tokenOutDenom, osmomath.OneInt())
+		s.Require().NoError(err)
+	}
+
+	// Bucket 0
+	swap(1, FOO, 1_000)
+	swap(1, FOO, 2_000)
+	swap(2, BAR, 5_000)
+
+	s.Require().Equal(uint64(0), s.App.PoolManagerKeeper.GetVolumeBucketIndex(s.Ctx))
+	s.Require().Equal(osmoVolume(3_000), s.App.PoolManagerKeeper.GetPoolVolumeBucket(s.Ctx, 0, 1))
+	s.Require().Equal(osmoVolume(5_000), s.App.PoolManagerKeeper.GetPoolVolumeBucket(s.Ctx, 0, 2))
+	s.Require().Equal(osmoVolume(3_000), s.App.PoolManagerKeeper.GetDenomPairVolumeBucket(s.Ctx, 0, UOSMO, FOO))
+	s.Require().Equal(osmoVolume(3_000), s.App.PoolManagerKeeper.GetDenomPairVolumeBucket(s.Ctx, 0, FOO, UOSMO))
+	s.Require().Equal(osmoVolume(5_000), s.App.PoolManagerKeeper.GetDenomPairVolumeBucket(s.Ctx, 0, BAR, UOSMO))
+
+	// Only the day epoch starts a new bucket.
+	s.Require().NoError(epochHooks.AfterEpochEnd(s.Ctx, "week", 1))
+	s.Require().Equal(uint64(0), s.App.PoolManagerKeeper.GetVolumeBucketIndex(s.Ctx))
+	s.Require().NoError(epochHooks.AfterEpochEnd(s.Ctx, poolmanager.VolumeBucketEpochIdentifier, 1))
+	s.Require().Equal(uint64(1), s.App.PoolManagerKeeper.GetVolumeBucketIndex(s.Ctx))
+
+	// Bucket 1
+	swap(1, FOO, 4_000)
+
+	s.Require().Equal(osmoVolume(3_000), s.App.PoolManagerKeeper.GetPoolVolumeBucket(s.Ctx, 0, 1))
+	s.Require().Equal(osmoVolume(4_000), s.App.PoolManagerKeeper.GetPoolVolumeBucket(s.Ctx, 1, 1))
+	s.Require().Equal(sdk.NewCoins(), s.App.PoolManagerKeeper.GetPoolVolumeBucket(s.Ctx, 1, 2))
+
+	buckets, totalVolume, err := s.App.PoolManagerKeeper.GetPoolVolumeBuckets(s.Ctx, 1, 0)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.VolumeBucket{{Bucket: 1, Volume: osmoVolume(4_000)}, {Bucket: 0, Volume: osmoVolume(3_000)}}, buckets)
+	s.Require().Equal(osmoVolume(7_000), totalVolume)
+
+	// The cumulative volume is unaffected by the buckets.
+	s.Require().Equal(osmomath.NewInt(7_000), s.App.PoolManagerKeeper.GetOsmoVolumeForPool(s.Ctx, 1))
+
+	// Bucket 0 is pruned once it falls out of the retention window.
+	for i := 1; i < poolmanager.VolumeBucketRetention; i++ {
+		s.Require().NoError(epochHooks.AfterEpochEnd(s.Ctx, poolmanager.VolumeBucketEpochIdentifier, int64(i+1)))
+	}
+	s.Require().Equal(uint64(poolmanager.VolumeBucketRetention), s.App.PoolManagerKeeper.GetVolumeBucketIndex(s.Ctx))
+	s.Require().Equal(sdk.NewCoins(), s.App.PoolManagerKeeper.GetPoolVolumeBucket(s.Ctx, 0, 1))
+	s.Require().Equal(sdk.NewCoins(), s.App.PoolManagerKeeper.GetDenomPairVolumeBucket(s.Ctx, 0, UOSMO, FOO))
+	s.Require().Equal(osmoVolume(4_000), s.App.PoolManagerKeeper.GetPoolVolumeBucket(s.Ctx, 1, 1))
+	s.Require().Equal(osmoVolume(4_000), s.App.PoolManagerKeeper.GetDenomPairVolumeBucket(s.Ctx, 1, UOSMO, FOO))
+
+	poolVolumeBuckets, err := s.App.PoolManagerKeeper.GetAllPoolVolumeBuckets(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PoolVolumeBucket{{Bucket: 1, PoolId: 1, Volume: osmoVolume(4_000)}}, poolVolumeBuckets)
+	denomPairVolumeBuckets, err := s.App.PoolManagerKeeper.GetAllDenomPairVolumeBuckets(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.DenomPairVolumeBucket{{Bucket: 1, Denom_0: FOO, Denom_1: UOSMO, Volume: osmoVolume(4_000)}}, denomPairVolumeBuckets)
+}
+
+func (s *KeeperTestSuite) TestGetVolumeBuckets() {
+	tests := map[string]struct {
+		numBuckets uint64
+
+		expectedBuckets     []types.VolumeBucket
+		expectedTotalVolume sdk.Coins
+		expectErr           bool
+	}{
+		"all retained buckets": {
+			numBuckets: 0,
+			expectedBuckets: []types.VolumeBucket{
+				{Bucket: 2, Volume: osmoVolume(300)},
+				{Bucket: 1, Volume: sdk.NewCoins()},
+				{Bucket: 0, Volume: osmoVolume(100)},
+			},
+			expectedTotalVolume: osmoVolume(400),
+		},
+		"current bucket only": {
+			numBuckets: 1,
+			expectedBuckets: []types.VolumeBucket{
+				{Bucket: 2, Volume: osmoVolume(300)},
+			},
+			expectedTotalVolume: osmoVolume(300),
+		},
+		"two most recent buckets": {
+			numBuckets: 2,
+			expectedBuckets: []types.VolumeBucket{
+				{Bucket: 2, Volume: osmoVolume(300)},
+				{Bucket: 1, Volume: sdk.NewCoins()},
+			},
+			expectedTotalVolume: osmoVolume(300),
+		},
+		"more buckets than reached": {
+			numBuckets: 7,
+			expectedBuckets: []types.VolumeBucket{
+				{Bucket: 2, Volume: osmoVolume(300)},
+				{Bucket: 1, Volume: sdk.NewCoins()},
+				{Bucket: 0, Volume: osmoVolume(100)},
+			},
+			expectedTotalVolume: osmoVolume(400),
+		},
+		"error: more buckets than retained": {
+			numBuckets: poolmanager.VolumeBucketRetention + 1,
+			expectErr:  true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId := s.PrepareBalancerPool()
+			s.App.PoolManagerKeeper.SetVolumeBucketIndex(s.Ctx, 2)
+			for _, bucket := range []uint64{0, 2} {
+				volume := osmoVolume(100 * int64(bucket+1))
+				s.App.PoolManagerKeeper.SetPoolVolumeBucket(s.Ctx, types.PoolVolumeBucket{Bucket: bucket, PoolId: poolId, Volume: volume})
+				s.App.PoolManagerKeeper.SetDenomPairVolumeBucket(s.Ctx, types.DenomPairVolumeBucket{Bucket: bucket, Denom_0: UOSMO, Denom_1: FOO, Volume: volume})
+			}
+
+			buckets, totalVolume, err := s.App.PoolManagerKeeper.GetPoolVolumeBuckets(s.Ctx, poolId, tc.numBuckets)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedBuckets, buckets)
+				s.Require().Equal(tc.expectedTotalVolume, totalVolume)
+			}
+
+			buckets, totalVolume, err = s.App.PoolManagerKeeper.GetDenomPairVolumeBuckets(s.Ctx, FOO, UOSMO, tc.numBuckets)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedBuckets, buckets)
+				s.Require().Equal(tc.expectedTotalVolume, totalVolume)
+			}
+		})
+	}
+
+	s.Run("error: pool does not exist", func() {
+		s.SetupTest()
+		_, _, err := s.App.PoolManagerKeeper.GetPoolVolumeBuckets(s.Ctx, 1, 0)
+		s.Require().Error(err)
+	})
+
+	s.Run("error: same denoms", func() {
+		s.SetupTest()
+		_, _, err := s.App.PoolManagerKeeper.GetDenomPairVolumeBuckets(s.Ctx, UOSMO, UOSMO, 0)
+		s.Require().Error(err)
+	})
+}
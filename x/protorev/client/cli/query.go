@@ -21,6 +21,7 @@ func NewCmdQuery() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryAllProfitsCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryStatisticsByRouteCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryAllRouteStatisticsCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryStatisticsWindowsCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryTokenPairArbRoutesCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryAdminAccountCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryDeveloperAccountCmd)
@@ -86,6 +87,15 @@ func NewQueryAllRouteStatisticsCmd() (*osmocli.QueryDescriptor, *types.QueryGetP
 	}, &types.QueryGetProtoRevAllRouteStatisticsRequest{}
 }
 
+// NewQueryStatisticsWindowsCmd returns the command to query the route and pool statistics of protorev by day epoch
+func NewQueryStatisticsWindowsCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevStatisticsWindowsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "statistics-windows",
+		Short: "Query the ProtoRev route and pool statistics of the most recent day epochs",
+		Long:  `{{.Short}}{{.ExampleHeader}}{{.CommandPrefix}} statistics-windows 7`,
+	}, &types.QueryGetProtoRevStatisticsWindowsRequest{}
+}
+
 // NewQueryTokenPairArbRoutesCmd returns the command to query the token pair arb routes
 func NewQueryTokenPairArbRoutesCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevTokenPairArbRoutesRequest) {
 	return &osmocli.QueryDescriptor{
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -52,6 +54,9 @@ func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epoch
 				h.k.SetDaysSinceModuleGenesis(ctx, daysSinceGenesis+1)
 			}
 
+			// Delete the statistics of the windows that are no longer retained
+			h.k.PruneStatisticsWindows(ctx)
+
 			// Update the pools in the store
 			return h.k.UpdatePools(ctx)
 		}
@@ -129,6 +134,81 @@ func (k Keeper) UpdatePools(ctx sdk.Context) error {
 		}
 	}
 
+	// Update the denom pair graph used by the cyclic route search
+	return k.UpdateDenomPairGraph(ctx)
+}
+
+// UpdateDenomPairGraph first deletes the denom pair graph and then, if the cyclic route search is enabled, rebuilds it from
+// the highest liquidity pool of every denom pair. Each denom only keeps an edge to its MaxDenomPairGraphNeighbors neighbors
+// with the most liquidity of that denom, which bounds the number of routes searched per swap.
+func (k Keeper) UpdateDenomPairGraph(ctx sdk.Context) error {
+	k.DeleteDenomPairGraph(ctx)
+
+	if !k.GetCyclicRouteSearchEnabled(ctx) {
+		return nil
+	}
+
+	pools, err := k.poolmanagerKeeper.AllPools(ctx)
+	if err != nil {
+		return err
+	}
+
+	// denomPairPools maps each denom to the highest liquidity pool it is paired with for every other denom
+	// ex. {osmo -> {atom : {pool 1, 100}, weth : {pool 2, 200}}}
+	denomPairPools := make(map[string]map[string]LiquidityPoolStruct)
+	// denomReserves maps each pool id to the amount of each of its denoms, used to rank the neighbors of a denom
+	denomReserves := make(map[uint64]map[string]osmomath.Int)
+	for _, pool := range pools {
+		coins, err := k.poolmanagerKeeper.GetTotalPoolLiquidity(ctx, pool.GetId())
+		if err != nil {
+			return err
+		}
+
+		// Pool must be active and the number of coins must be 2
+		if pool.IsActive(ctx) && len(coins) == 2 {
+			tokenA := coins[0]
+			tokenB := coins[1]
+
+			newPool := LiquidityPoolStruct{
+				PoolId:    pool.GetId(),
+				Liquidity: tokenA.Amount.Mul(tokenB.Amount),
+			}
+			denomReserves[pool.GetId()] = map[string]osmomath.Int{tokenA.Denom: tokenA.Amount, tokenB.Denom: tokenB.Amount}
+
+			for _, pair := range [][2]string{{tokenA.Denom, tokenB.Denom}, {tokenB.Denom, tokenA.Denom}} {
+				if _, ok := denomPairPools[pair[0]]; !ok {
+					denomPairPools[pair[0]] = make(map[string]LiquidityPoolStruct)
+				}
+				k.compareAndStoreHighestLiquidityPool(pair[1], denomPairPools[pair[0]], newPool)
+			}
+		}
+	}
+
+	for denom, pairPools := range denomPairPools {
+		neighbors := make([]string, 0, len(pairPools))
+		for neighbor := range pairPools {
+			neighbors = append(neighbors, neighbor)
+		}
+
+		// Rank the neighbors by the amount of the denom held in the pool, breaking ties by denom to remain deterministic
+		sort.Slice(neighbors, func(i, j int) bool {
+			reserveI := denomReserves[pairPools[neighbors[i]].PoolId][denom]
+			reserveJ := denomReserves[pairPools[neighbors[j]].PoolId][denom]
+			if !reserveI.Equal(reserveJ) {
+				return reserveI.GT(reserveJ)
+			}
+			return neighbors[i] < neighbors[j]
+		})
+
+		if len(neighbors) > types.MaxDenomPairGraphNeighbors {
+			neighbors = neighbors[:types.MaxDenomPairGraphNeighbors]
+		}
+
+		for _, neighbor := range neighbors {
+			k.SetPoolForDenomPairGraphEdge(ctx, denom, neighbor, pairPools[neighbor].PoolId)
+		}
+	}
+
 	return nil
 }
 
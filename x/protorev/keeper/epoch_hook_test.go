@@ -187,6 +187,38 @@ func (s *KeeperTestSuite) TestUpdateHighestLiquidityPools() {
 	}
 }
 
+// TestUpdateDenomPairGraph tests the UpdateDenomPairGraph function
+func (s *KeeperTestSuite) TestUpdateDenomPairGraph() {
+	s.SetupPoolsTest()
+
+	// The denom pair graph is empty while the cyclic route search is disabled
+	err := s.App.ProtoRevKeeper.UpdateDenomPairGraph(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(s.App.ProtoRevKeeper.GetDenomPairGraphNeighbors(s.Ctx, "epochOne"))
+
+	s.App.ProtoRevKeeper.SetCyclicRouteSearchEnabled(s.Ctx, true)
+	err = s.App.ProtoRevKeeper.UpdateDenomPairGraph(s.Ctx)
+	s.Require().NoError(err)
+
+	// Pool 47 has the highest liquidity out of the epochOne/uosmo pools
+	s.Require().Equal([]types.Trade{{Pool: 47, TokenIn: "epochOne", TokenOut: types.OsmosisDenomination}}, s.App.ProtoRevKeeper.GetDenomPairGraphNeighbors(s.Ctx, "epochOne"))
+	poolId, err := s.App.ProtoRevKeeper.GetPoolForDenomPairGraphEdge(s.Ctx, "epochOne", types.OsmosisDenomination)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(47), poolId)
+
+	// Only the neighbors with the most liquidity of a denom are kept, so the uosmo -> epochOne edge is dropped
+	// since pool 47 holds little uosmo compared to the other uosmo pools
+	s.Require().Len(s.App.ProtoRevKeeper.GetDenomPairGraphNeighbors(s.Ctx, types.OsmosisDenomination), types.MaxDenomPairGraphNeighbors)
+	_, err = s.App.ProtoRevKeeper.GetPoolForDenomPairGraphEdge(s.Ctx, types.OsmosisDenomination, "epochOne")
+	s.Require().Error(err)
+
+	// Disabling the cyclic route search clears the denom pair graph on the next update
+	s.App.ProtoRevKeeper.SetCyclicRouteSearchEnabled(s.Ctx, false)
+	err = s.App.ProtoRevKeeper.UpdatePools(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(s.App.ProtoRevKeeper.GetDenomPairGraphNeighbors(s.Ctx, types.OsmosisDenomination))
+}
+
 func contains(baseDenoms []types.BaseDenom, denomToMatch string) bool {
 	for _, baseDenom := range baseDenoms {
 		if baseDenom.Denom == denomToMatch {
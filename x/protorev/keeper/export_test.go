@@ -0,0 +1,3 @@
+package keeper
+
+var FindMaxProfitInCFMMRange = findMaxProfitInCFMMRange
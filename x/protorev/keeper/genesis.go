@@ -100,6 +100,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	} else {
 		k.SetCyclicArbProfitTrackerStartHeight(ctx, ctx.BlockHeight())
 	}
+
+	// Set the route and pool statistics of the retained day epoch windows.
+	for _, window := range genState.StatisticsWindows {
+		k.SetStatisticsWindow(ctx, window)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis. ExportGenesis intentionally ignores a few of the errors thrown
@@ -176,5 +181,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 	genesis.CyclicArbTracker = &cyclicArbTracker
 
+	// Export the route and pool statistics of the retained day epoch windows.
+	statisticsWindows, err := k.GetStatisticsWindows(ctx, 0)
+	if err != nil {
+		panic(err)
+	}
+	genesis.StatisticsWindows = statisticsWindows
+
 	return genesis
 }
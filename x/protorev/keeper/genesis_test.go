@@ -1,6 +1,12 @@
 package keeper_test
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v29/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v29/x/protorev/types"
+)
 
 // TestInitGenesis tests the initialization and export of the module's genesis state.
 func (s *KeeperTestSuite) TestInitGenesis() {
@@ -69,3 +75,31 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	cyclicArbProfitAccountingHeight := s.App.ProtoRevKeeper.GetCyclicArbProfitTrackerStartHeight(s.Ctx)
 	s.Require().Equal(cyclicArbProfitAccountingHeight, exportedGenesis.CyclicArbTracker.HeightAccountingStartsFrom)
 }
+
+// TestStatisticsWindowsGenesis tests that the statistics windows are exported and imported.
+func (s *KeeperTestSuite) TestStatisticsWindowsGenesis() {
+	route := poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 1}, {TokenOutDenom: "", PoolId: 2}}
+	for _, window := range []uint64{3, 4} {
+		s.App.ProtoRevKeeper.SetDaysSinceModuleGenesis(s.Ctx, window)
+		err := s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx, route, types.OsmosisDenomination, osmomath.NewInt(1000))
+		s.Require().NoError(err)
+	}
+
+	windows, err := s.App.ProtoRevKeeper.GetStatisticsWindows(s.Ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Len(windows, 2)
+
+	exportedGenesis := s.App.ProtoRevKeeper.ExportGenesis(s.Ctx)
+	s.Require().Equal(windows, exportedGenesis.StatisticsWindows)
+
+	// Clear the windows and import them back
+	s.App.ProtoRevKeeper.DeleteStatisticsWindowsBefore(s.Ctx, 5)
+	windows, err = s.App.ProtoRevKeeper.GetStatisticsWindows(s.Ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Empty(windows)
+
+	s.App.ProtoRevKeeper.InitGenesis(s.Ctx, *exportedGenesis)
+	windows, err = s.App.ProtoRevKeeper.GetStatisticsWindows(s.Ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Equal(exportedGenesis.StatisticsWindows, windows)
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -130,6 +132,43 @@ func (q Querier) GetProtoRevAllRouteStatistics(c context.Context, req *types.Que
 	return &types.QueryGetProtoRevAllRouteStatisticsResponse{Statistics: statistics}, nil
 }
 
+// GetProtoRevStatisticsWindows queries the route and pool statistics of the trades executed during each of the most recent day epochs
+func (q Querier) GetProtoRevStatisticsWindows(c context.Context, req *types.QueryGetProtoRevStatisticsWindowsRequest) (*types.QueryGetProtoRevStatisticsWindowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	fromWindow := uint64(0)
+	if currentWindow := q.Keeper.GetCurrentStatisticsWindow(ctx); req.NumWindows != 0 && req.NumWindows <= currentWindow {
+		fromWindow = currentWindow - req.NumWindows + 1
+	}
+
+	windows := make([]types.StatisticsWindow, 0)
+	windowStore := prefix.NewStore(ctx.KVStore(q.Keeper.storeKey), types.KeyPrefixStatisticsWindows)
+	pageRes, err := query.FilteredPaginate(windowStore, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+		index := sdk.BigEndianToUint64(key)
+		if index < fromWindow {
+			return false, nil
+		}
+
+		if accumulate {
+			window, err := q.Keeper.GetStatisticsWindow(ctx, index)
+			if err != nil {
+				return false, err
+			}
+			windows = append(windows, window)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevStatisticsWindowsResponse{Windows: windows, Pagination: pageRes}, nil
+}
+
 // GetProtoRevTokenPairArbRoutes queries the hot routes that the module is utilizing for cyclic arbitrage route generation
 func (q Querier) GetProtoRevTokenPairArbRoutes(c context.Context, req *types.QueryGetProtoRevTokenPairArbRoutesRequest) (*types.QueryGetProtoRevTokenPairArbRoutesResponse, error) {
 	if req == nil {
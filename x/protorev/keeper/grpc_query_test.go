@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v29/app/apptesting"
@@ -250,6 +251,62 @@ func (s *KeeperTestSuite) TestGetProtoRevAllRouteStatistics() {
 	s.Require().Contains(res.Statistics[2].Profits, atomCoin)
 }
 
+// TestGetProtoRevStatisticsWindows tests the query to retrieve the statistics of the most recent day epochs
+func (s *KeeperTestSuite) TestGetProtoRevStatisticsWindows() {
+	route := poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 1}, {TokenOutDenom: "", PoolId: 2}, {TokenOutDenom: "", PoolId: 3}}
+	for _, window := range []uint64{5, 6, 7} {
+		s.App.AppKeepers.ProtoRevKeeper.SetDaysSinceModuleGenesis(s.Ctx, window)
+		err := s.App.AppKeepers.ProtoRevKeeper.UpdateStatistics(s.Ctx, route, types.OsmosisDenomination, osmomath.NewInt(10000))
+		s.Require().NoError(err)
+	}
+
+	// Query all of the windows
+	res, err := s.queryClient.GetProtoRevStatisticsWindows(s.Ctx, &types.QueryGetProtoRevStatisticsWindowsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(3, len(res.Windows))
+	for i, window := range res.Windows {
+		s.Require().Equal(uint64(5+i), window.Window)
+		s.Require().Equal([]uint64{1, 2, 3}, window.RouteStatistics[0].Route)
+		s.Require().Equal(osmomath.OneInt(), window.RouteStatistics[0].NumberOfTrades)
+		s.Require().Equal(3, len(window.PoolStatistics))
+	}
+
+	// Query the two most recent windows
+	res, err = s.queryClient.GetProtoRevStatisticsWindows(s.Ctx, &types.QueryGetProtoRevStatisticsWindowsRequest{NumWindows: 2})
+	s.Require().NoError(err)
+	s.Require().Equal(2, len(res.Windows))
+	s.Require().Equal(uint64(6), res.Windows[0].Window)
+	s.Require().Equal(uint64(7), res.Windows[1].Window)
+
+	// Query the windows one page at a time
+	res, err = s.queryClient.GetProtoRevStatisticsWindows(s.Ctx, &types.QueryGetProtoRevStatisticsWindowsRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(2, len(res.Windows))
+	s.Require().Equal(uint64(5), res.Windows[0].Window)
+	s.Require().Equal(uint64(6), res.Windows[1].Window)
+	s.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = s.queryClient.GetProtoRevStatisticsWindows(s.Ctx, &types.QueryGetProtoRevStatisticsWindowsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(res.Windows))
+	s.Require().Equal(uint64(7), res.Windows[0].Window)
+	s.Require().Nil(res.Pagination.NextKey)
+
+	// The number of windows is applied before the pagination
+	res, err = s.queryClient.GetProtoRevStatisticsWindows(s.Ctx, &types.QueryGetProtoRevStatisticsWindowsRequest{
+		NumWindows: 2,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(res.Windows))
+	s.Require().Equal(uint64(6), res.Windows[0].Window)
+	s.Require().Equal(uint64(2), res.Pagination.Total)
+}
+
 // TestGetProtoRevTokenPairArbRoutes tests the query to retrieve all token pair arb routes
 func (s *KeeperTestSuite) TestGetProtoRevTokenPairArbRoutes() {
 	s.SetupPoolsTest()
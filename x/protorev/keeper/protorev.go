@@ -198,6 +198,52 @@ func (k Keeper) DeleteAllPoolsForBaseDenom(ctx sdk.Context, baseDenom string) {
 	k.DeleteAllEntriesForKeyPrefix(ctx, key)
 }
 
+// GetPoolForDenomPairGraphEdge returns the id of the highest liquidity pool between the denom and its neighbor in the denom pair graph
+func (k Keeper) GetPoolForDenomPairGraphEdge(ctx sdk.Context, denom, neighborDenom string) (uint64, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomPairGraph)
+	key := types.GetKeyPrefixDenomPairGraph(denom, neighborDenom)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return 0, types.NoPoolForDenomPairError{BaseDenom: denom, MatchDenom: neighborDenom}
+	}
+
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+// GetDenomPairGraphNeighbors returns all of the edges of the denom pair graph that start at the given denom. Each edge is
+// returned as a trade from the denom to its neighbor on the highest liquidity pool between the two.
+func (k Keeper) GetDenomPairGraphNeighbors(ctx sdk.Context, denom string) []types.Trade {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomPairGraph)
+	keyPrefix := types.GetKeyPrefixDenomPairGraph(denom, "")
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+	neighbors := make([]types.Trade, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		neighbors = append(neighbors, types.Trade{
+			Pool:     sdk.BigEndianToUint64(iterator.Value()),
+			TokenIn:  denom,
+			TokenOut: string(iterator.Key()[len(keyPrefix):]),
+		})
+	}
+
+	return neighbors
+}
+
+// SetPoolForDenomPairGraphEdge sets the id of the highest liquidity pool between the denom and its neighbor in the denom pair graph
+func (k Keeper) SetPoolForDenomPairGraphEdge(ctx sdk.Context, denom, neighborDenom string, poolId uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomPairGraph)
+	key := types.GetKeyPrefixDenomPairGraph(denom, neighborDenom)
+
+	store.Set(key, sdk.Uint64ToBigEndian(poolId))
+}
+
+// DeleteDenomPairGraph deletes all of the edges of the denom pair graph
+func (k Keeper) DeleteDenomPairGraph(ctx sdk.Context) {
+	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixDenomPairGraph)
+}
+
 // SetSwapsToBackrun sets the swaps to backrun, updated via hooks
 func (k Keeper) SetSwapsToBackrun(ctx sdk.Context, swapsToBackrun types.Route) error {
 	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixSwapsToBackrun)
@@ -359,6 +405,19 @@ func (k Keeper) SetProtoRevEnabled(ctx sdk.Context, enabled bool) {
 	k.SetParams(ctx, params)
 }
 
+// GetCyclicRouteSearchEnabled returns whether routes are additionally discovered by searching the denom pair graph
+func (k Keeper) GetCyclicRouteSearchEnabled(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	return params.CyclicRouteSearchEnabled
+}
+
+// SetCyclicRouteSearchEnabled sets whether routes are additionally discovered by searching the denom pair graph
+func (k Keeper) SetCyclicRouteSearchEnabled(ctx sdk.Context, enabled bool) {
+	params := k.GetParams(ctx)
+	params.CyclicRouteSearchEnabled = enabled
+	k.SetParams(ctx, params)
+}
+
 // GetPointCountForBlock returns the number of pool points that have been consumed in the current block
 func (k Keeper) GetPointCountForBlock(ctx sdk.Context) (uint64, error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPointCountForBlock)
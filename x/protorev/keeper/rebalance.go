@@ -108,23 +108,15 @@ func (k Keeper) EstimateMultihopProfit(ctx sdk.Context, inputDenom string, amoun
 
 var oneInt, twoInt = osmomath.OneInt(), osmomath.NewInt(2)
 
-// FindMaxProfitRoute runs a binary search to find the max profit for a given route
+// FindMaxProfitForRoute finds the max profit for a given route
 func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) (sdk.Coin, osmomath.Int, error) {
-	// Track the tokenIn amount/denom and the profit
-	tokenIn := sdk.Coin{}
-	profit := osmomath.ZeroInt()
-
-	// Track the left and right bounds of the binary search
-	curLeft := osmomath.OneInt()
-	curRight := types.MaxInputAmount
-
 	// Input denom used for cyclic arbitrage
 	inputDenom := route.Route[route.Route.Length()-1].TokenOutDenom
 
 	// If a cyclic arb exists with an optimal amount in above our minimum amount in,
 	// then inputting the minimum amount in will result in a profit. So we check for that first.
 	// If there is no profit, then we can return early and not run the binary search.
-	_, minInProfit, err := k.EstimateMultihopProfit(ctx, inputDenom, curLeft.Mul(route.StepSize), route.Route)
+	_, minInProfit, err := k.EstimateMultihopProfit(ctx, inputDenom, osmomath.OneInt().Mul(route.StepSize), route.Route)
 	if err != nil {
 		return sdk.Coin{}, osmomath.ZeroInt(), err
 	} else if minInProfit.LTE(osmomath.ZeroInt()) {
@@ -140,8 +132,32 @@ func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, rema
 		return sdk.Coin{}, osmomath.ZeroInt(), err
 	}
 
+	// Routes made only of balancer and stableswap pools are solved in closed form instead of with the binary search.
+	// The closed form may simulate amounts that the binary search never reaches, so if any of those fails to
+	// simulate, or if the closed form doesn't converge, the binary search is run instead.
+	isCFMMRoute, err := k.IsCFMMRoute(ctx, route.Route)
+	if err != nil {
+		return sdk.Coin{}, osmomath.ZeroInt(), err
+	}
+	if isCFMMRoute {
+		tokenIn, profit, err := k.FindMaxProfitForCFMMRoute(ctx, route, inputDenom, minInProfit)
+		if err == nil {
+			return tokenIn, profit, nil
+		}
+	}
+
+	return k.FindMaxProfitWithBinarySearch(ctx, route, inputDenom)
+}
+
+// FindMaxProfitWithBinarySearch runs a binary search over the amounts in (normalized by the step size) of the route
+// to find its max profit.
+func (k Keeper) FindMaxProfitWithBinarySearch(ctx sdk.Context, route RouteMetaData, inputDenom string) (sdk.Coin, osmomath.Int, error) {
+	// Track the tokenIn amount/denom and the profit
+	tokenIn := sdk.Coin{}
+	profit := osmomath.ZeroInt()
+
 	// Update the search range if the max input amount is too small/large
-	curLeft, curRight, err = k.UpdateSearchRangeIfNeeded(ctx, route, inputDenom, curLeft, curRight)
+	curLeft, curRight, err := k.UpdateSearchRangeIfNeeded(ctx, route, inputDenom, osmomath.OneInt(), types.MaxInputAmount)
 	if err != nil {
 		return sdk.Coin{}, osmomath.ZeroInt(), err
 	}
@@ -178,6 +194,186 @@ func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, rema
 	return tokenIn, profit, nil
 }
 
+// IsCFMMRoute returns whether the route is made only of balancer and stableswap pools
+func (k Keeper) IsCFMMRoute(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes) (bool, error) {
+	for _, poolId := range route.PoolIds() {
+		poolType, err := k.poolmanagerKeeper.GetPoolType(ctx, poolId)
+		if err != nil {
+			return false, err
+		}
+
+		if poolType != poolmanagertypes.Balancer && poolType != poolmanagertypes.Stableswap {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// FindMaxProfitForCFMMRoute finds the max profit for a route made only of balancer and stableswap pools. A route of
+// constant product pools composes into amountOut = A * amountIn / (B + amountIn), which is the most profitable at
+// amountIn = sqrt(A * B) - B. A and B are fit through the last two simulated amounts and refit around every new estimate,
+// which converges in a few simulations for weighted and stableswap pools as well. The estimate is then moved to the
+// most profitable neighboring amount, within types.MaxCFMMHillClimbSteps steps, so the result matches the binary search.
+// If the estimate is further away than that, types.ErrCFMMHillClimbNotConverged is returned so that the binary search is
+// run instead. In any case at most types.MaxCFMMSimulations amounts are simulated, in addition to the ones simulated by
+// UpdateSearchRangeIfNeeded, whose range is the only one searched, like for the binary search.
+func (k Keeper) FindMaxProfitForCFMMRoute(ctx sdk.Context, route RouteMetaData, inputDenom string, minInProfit osmomath.Int) (sdk.Coin, osmomath.Int, error) {
+	// Search the same range as the binary search, so that only amounts the binary search may simulate are simulated
+	curLeft, curRight, err := k.UpdateSearchRangeIfNeeded(ctx, route, inputDenom, osmomath.OneInt(), types.MaxInputAmount)
+	if err != nil {
+		return sdk.Coin{}, osmomath.ZeroInt(), err
+	}
+
+	simulateProfit := func(amount int64) (osmomath.Int, error) {
+		_, profit, err := k.EstimateMultihopProfit(ctx, inputDenom, osmomath.NewInt(amount).Mul(route.StepSize), route.Route)
+		return profit, err
+	}
+	amount, profit, err := findMaxProfitInCFMMRange(curLeft.Int64(), curRight.Int64(), route.StepSize, minInProfit, simulateProfit)
+	if err != nil {
+		return sdk.Coin{}, osmomath.ZeroInt(), err
+	}
+
+	return sdk.NewCoin(inputDenom, osmomath.NewInt(amount).Mul(route.StepSize)), profit, nil
+}
+
+// findMaxProfitInCFMMRange finds the most profitable amount in (normalized by the step size) within [minAmount, maxAmount]
+// for FindMaxProfitForCFMMRoute, given the profit of the amount of 1 and a function simulating the profit of an amount.
+func findMaxProfitInCFMMRange(
+	minAmount, maxAmount int64,
+	stepSize, minInProfit osmomath.Int,
+	simulateProfit func(amount int64) (osmomath.Int, error),
+) (int64, osmomath.Int, error) {
+	// profits tracks the profit of every simulated amount in, so that each amount is only simulated once
+	profits := map[int64]osmomath.Int{1: minInProfit}
+	estimateProfit := func(amount int64) (osmomath.Int, error) {
+		if profit, ok := profits[amount]; ok {
+			return profit, nil
+		}
+
+		profit, err := simulateProfit(amount)
+		if err != nil {
+			return osmomath.ZeroInt(), err
+		}
+
+		profits[amount] = profit
+		return profit, nil
+	}
+
+	// Fit the closed form through the bounds of the range and refine it around every new estimate
+	prevAmount, curAmount := minAmount, maxAmount
+	for iteration := 0; iteration < types.MaxCFMMIterations; iteration++ {
+		prevProfit, err := estimateProfit(prevAmount)
+		if err != nil {
+			return 0, osmomath.ZeroInt(), err
+		}
+
+		curProfit, err := estimateProfit(curAmount)
+		if err != nil {
+			return 0, osmomath.ZeroInt(), err
+		}
+
+		nextAmount, ok := estimateOptimalAmountIn(prevAmount, prevProfit, curAmount, curProfit, stepSize, minAmount, maxAmount)
+		if !ok || nextAmount == curAmount {
+			break
+		}
+
+		prevAmount, curAmount = curAmount, nextAmount
+	}
+
+	// The closed form is only exact up to rounding, so move to the most profitable neighboring amount
+	for step := 0; ; step++ {
+		curProfit, err := estimateProfit(curAmount)
+		if err != nil {
+			return 0, osmomath.ZeroInt(), err
+		}
+
+		nextAmount := curAmount
+		if curAmount < maxAmount {
+			profitPlusOne, err := estimateProfit(curAmount + 1)
+			if err != nil {
+				return 0, osmomath.ZeroInt(), err
+			}
+
+			if profitPlusOne.GTE(curProfit) {
+				nextAmount = curAmount + 1
+			}
+		}
+
+		if nextAmount == curAmount && curAmount > minAmount {
+			profitMinusOne, err := estimateProfit(curAmount - 1)
+			if err != nil {
+				return 0, osmomath.ZeroInt(), err
+			}
+
+			if profitMinusOne.GT(curProfit) {
+				nextAmount = curAmount - 1
+			}
+		}
+
+		if nextAmount == curAmount {
+			break
+		}
+		if step == types.MaxCFMMHillClimbSteps {
+			return 0, osmomath.ZeroInt(), types.ErrCFMMHillClimbNotConverged
+		}
+		curAmount = nextAmount
+	}
+
+	// Select the most profitable simulated amount in, preferring larger amounts in like the binary search
+	maxProfitAmount := int64(0)
+	maxProfit := osmomath.ZeroInt()
+	for amount, profit := range profits {
+		if profit.GT(maxProfit) || (profit.Equal(maxProfit) && amount > maxProfitAmount) {
+			maxProfitAmount = amount
+			maxProfit = profit
+		}
+	}
+
+	return maxProfitAmount, maxProfit, nil
+}
+
+// estimateOptimalAmountIn fits amountOut = A * amountIn / (B + amountIn) through two simulated amounts in (normalized by the
+// step size) and returns the normalized amount in that maximizes amountOut - amountIn, bounded by [minAmount, maxAmount].
+// Since 1 / amountOut = (B / A) * (1 / amountIn) + 1 / A, the fit is a line through the reciprocals of the two simulations.
+func estimateOptimalAmountIn(amountA int64, profitA osmomath.Int, amountB int64, profitB osmomath.Int, stepSize osmomath.Int, minAmount, maxAmount int64) (int64, bool) {
+	if amountA == amountB {
+		return 0, false
+	}
+
+	stepSizeBigDec := osmomath.BigDecFromSDKInt(stepSize)
+	amountInA := osmomath.NewBigDec(amountA).Mul(stepSizeBigDec)
+	amountInB := osmomath.NewBigDec(amountB).Mul(stepSizeBigDec)
+	amountOutA := amountInA.Add(osmomath.BigDecFromSDKInt(profitA))
+	amountOutB := amountInB.Add(osmomath.BigDecFromSDKInt(profitB))
+	if !amountOutA.IsPositive() || !amountOutB.IsPositive() {
+		return 0, false
+	}
+
+	oneBigDec := osmomath.OneBigDec()
+	slope := oneBigDec.Quo(amountOutA).Sub(oneBigDec.Quo(amountOutB)).Quo(oneBigDec.Quo(amountInA).Sub(oneBigDec.Quo(amountInB)))
+	intercept := oneBigDec.Quo(amountOutA).Sub(slope.Quo(amountInA))
+	if !slope.IsPositive() || !intercept.IsPositive() {
+		return 0, false
+	}
+
+	// A route is only profitable if its marginal rate at an amount in of zero, A / B = 1 / slope, is above one
+	if slope.GTE(oneBigDec) {
+		return minAmount, true
+	}
+
+	// sqrt(A * B) - B = (sqrt(slope) - slope) / intercept
+	optimalAmount := osmomath.MustMonotonicSqrtBigDec(slope).Sub(slope).Quo(intercept).Quo(stepSizeBigDec)
+	if optimalAmount.LT(osmomath.NewBigDec(minAmount)) {
+		return minAmount, true
+	}
+	if optimalAmount.GT(osmomath.NewBigDec(maxAmount)) {
+		return maxAmount, true
+	}
+
+	return optimalAmount.RoundInt64(), true
+}
+
 // UpdateSearchRangeIfNeeded updates the search range for the binary search. First, we check if there are any
 // concentrated liquidity pools in the route. If there are, then we may need to reduce the upper bound of the
 // binary search since it is gas intensive to move across several ticks. Next, we determine if the current bound
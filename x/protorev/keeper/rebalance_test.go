@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -419,6 +421,218 @@ func (s *KeeperTestSuite) TestFindMaxProfitRoute() {
 	}
 }
 
+// TestFindMaxProfitForCFMMRoute checks that the closed form finds the same max profit as the binary search on routes
+// made only of balancer and stableswap pools, while simulating fewer amounts and therefore consuming less gas, and that
+// it only fails to simulate an amount where the binary search does as well.
+func (s *KeeperTestSuite) TestFindMaxProfitForCFMMRoute() {
+	tests := []struct {
+		name        string
+		route       func() poolmanagertypes.SwapAmountInRoutes
+		expectError bool
+	}{
+		{
+			name:  "Mainnet Arb Route - 2 Asset, Same Weights (Block: 5905150)",
+			route: func() poolmanagertypes.SwapAmountInRoutes { return routeTwoAssetSameWeight },
+		},
+		{
+			name:  "Mainnet Arb Route - Multi Asset, Same Weights (Block: 6906570)",
+			route: func() poolmanagertypes.SwapAmountInRoutes { return routeMultiAssetSameWeight },
+		},
+		{
+			name:  "Arb Route - Multi Asset, Same Weights - Pool 22 instead of 26 (Block: 6906570)",
+			route: func() poolmanagertypes.SwapAmountInRoutes { return routeMostProfitable },
+		},
+		{
+			name:  "Mainnet Arb Route - Multi Asset, Different Weights (Block: 6908256)",
+			route: func() poolmanagertypes.SwapAmountInRoutes { return routeDiffDenom },
+		},
+		{
+			name:  "StableSwap Test Route",
+			route: func() poolmanagertypes.SwapAmountInRoutes { return routeStableSwap },
+		},
+		{
+			name:  "Extended Range Test Route",
+			route: func() poolmanagertypes.SwapAmountInRoutes { return extendedRangeRoute },
+		},
+		{
+			name: "Route with pools shallower than the max input amount",
+			route: func() poolmanagertypes.SwapAmountInRoutes {
+				poolParams := stableswap.PoolParams{SwapFee: osmomath.NewDecWithPrec(1, 4), ExitFee: osmomath.ZeroDec()}
+				firstPoolId := s.createStableswapPool(sdk.NewCoins(sdk.NewCoin("usdx", osmomath.NewInt(10_000_000_000)), sdk.NewCoin("usdy", osmomath.NewInt(20_000_000_000))), poolParams, []uint64{1, 1})
+				secondPoolId := s.createStableswapPool(sdk.NewCoins(sdk.NewCoin("usdx", osmomath.NewInt(20_000_000_000)), sdk.NewCoin("usdy", osmomath.NewInt(10_000_000_000))), poolParams, []uint64{1, 1})
+				return poolmanagertypes.SwapAmountInRoutes{
+					{PoolId: firstPoolId, TokenOutDenom: "usdy"},
+					{PoolId: secondPoolId, TokenOutDenom: "usdx"},
+				}
+			},
+			// The max input amount is above the reserves of the first pool, so it fails to simulate
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupPoolsTest()
+			route := protorevtypes.RouteMetaData{
+				Route:    test.route(),
+				StepSize: osmomath.NewInt(1_000_000),
+			}
+			inputDenom := route.Route[route.Route.Length()-1].TokenOutDenom
+
+			isCFMMRoute, err := s.App.ProtoRevKeeper.IsCFMMRoute(s.Ctx, route.Route)
+			s.Require().NoError(err)
+			s.Require().True(isCFMMRoute)
+
+			_, minInProfit, err := s.App.ProtoRevKeeper.EstimateMultihopProfit(s.Ctx, inputDenom, route.StepSize, route.Route)
+			s.Require().NoError(err)
+			s.Require().True(minInProfit.IsPositive())
+
+			binarySearchCtx := s.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			expectedAmtIn, expectedProfit, binarySearchErr := s.App.ProtoRevKeeper.FindMaxProfitWithBinarySearch(binarySearchCtx, route, inputDenom)
+
+			closedFormCtx := s.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			amtIn, profit, err := s.App.ProtoRevKeeper.FindMaxProfitForCFMMRoute(closedFormCtx, route, inputDenom, minInProfit)
+
+			if test.expectError {
+				s.Require().Error(binarySearchErr)
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(binarySearchErr)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedAmtIn, amtIn)
+			s.Require().Equal(expectedProfit, profit)
+			s.Require().Less(closedFormCtx.GasMeter().GasConsumed(), binarySearchCtx.GasMeter().GasConsumed())
+		})
+	}
+}
+
+// TestFindMaxProfitInCFMMRangeSimulations checks that the closed form simulates each amount at most once and at most
+// types.MaxCFMMSimulations amounts, including for profit curves it doesn't fit, for which it gives up with
+// types.ErrCFMMHillClimbNotConverged once the hill climb exceeds types.MaxCFMMHillClimbSteps.
+func (s *KeeperTestSuite) TestFindMaxProfitInCFMMRangeSimulations() {
+	stepSize := osmomath.NewInt(1_000_000)
+	minAmount, maxAmount := int64(1), types.MaxInputAmount.Int64()
+
+	tests := []struct {
+		name                string
+		profit              func(amount int64) osmomath.Int
+		expectNotConverged  bool
+		expectedSimulations int
+	}{
+		{
+			name: "constant product route",
+			profit: func(amount int64) osmomath.Int {
+				// amountOut = A * amountIn / (B + amountIn), with A = 2e10 and B = 1e10
+				amountIn := osmomath.NewInt(amount).Mul(stepSize)
+				amountOut := osmomath.NewInt(20_000_000_000).Mul(amountIn).Quo(osmomath.NewInt(10_000_000_000).Add(amountIn))
+				return amountOut.Sub(amountIn)
+			},
+		},
+		{
+			name: "closed form fails to fit, most profitable amount far below the estimate",
+			profit: func(amount int64) osmomath.Int {
+				if amount == maxAmount {
+					return osmomath.NewInt(-amount).Mul(stepSize)
+				}
+				return osmomath.NewInt(100_000 - amount)
+			},
+			expectNotConverged: true,
+			// the max amount, then one amount below it for every step
+			expectedSimulations: 1 + 1 + types.MaxCFMMHillClimbSteps,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			simulated := map[int64]int{}
+			simulateProfit := func(amount int64) (osmomath.Int, error) {
+				simulated[amount]++
+				return test.profit(amount), nil
+			}
+
+			amount, profit, err := keeper.FindMaxProfitInCFMMRange(minAmount, maxAmount, stepSize, test.profit(1), simulateProfit)
+
+			simulations := 0
+			for _, count := range simulated {
+				s.Require().Equal(1, count)
+				simulations += count
+			}
+			s.Require().LessOrEqual(simulations, types.MaxCFMMSimulations)
+			if test.expectedSimulations != 0 {
+				s.Require().Equal(test.expectedSimulations, simulations)
+			}
+
+			if test.expectNotConverged {
+				s.Require().ErrorIs(err, types.ErrCFMMHillClimbNotConverged)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the result matches an exhaustive search, preferring larger amounts in
+			expectedAmount, expectedProfit := int64(0), osmomath.ZeroInt()
+			for amount := minAmount; amount <= maxAmount; amount++ {
+				if profit := test.profit(amount); profit.GTE(expectedProfit) {
+					expectedAmount, expectedProfit = amount, profit
+				}
+			}
+			s.Require().Equal(expectedAmount, amount)
+			s.Require().Equal(expectedProfit, profit)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestIsCFMMRoute() {
+	s.SetupPoolsTest()
+
+	tests := []struct {
+		name             string
+		route            poolmanagertypes.SwapAmountInRoutes
+		expectedCFMM     bool
+		expectedErrorMsg string
+	}{
+		{
+			name:         "Balancer Route",
+			route:        routeTwoAssetSameWeight,
+			expectedCFMM: true,
+		},
+		{
+			name:         "Balancer and StableSwap Route",
+			route:        routeStableSwap,
+			expectedCFMM: true,
+		},
+		{
+			name:         "CL Route",
+			route:        clPoolRoute,
+			expectedCFMM: false,
+		},
+		{
+			name:         "CW Pool Route",
+			route:        cwPoolRoute,
+			expectedCFMM: false,
+		},
+		{
+			name:             "Route with a pool that does not exist",
+			route:            poolmanagertypes.SwapAmountInRoutes{{PoolId: 4000, TokenOutDenom: "Atom"}},
+			expectedErrorMsg: "failed to find route for pool id (4000)",
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			isCFMMRoute, err := s.App.ProtoRevKeeper.IsCFMMRoute(s.Ctx, test.route)
+			if test.expectedErrorMsg != "" {
+				s.Require().ErrorContains(err, test.expectedErrorMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedCFMM, isCFMMRoute)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestExecuteTrade() {
 	s.SetupPoolsTest()
 	type param struct {
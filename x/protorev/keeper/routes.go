@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -34,6 +35,13 @@ func (k Keeper) BuildRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId ui
 		routes = append(routes, highestLiquidityRoutes...)
 	}
 
+	// Append routes found by searching the denom pair graph if the cyclic route search is enabled
+	if k.GetCyclicRouteSearchEnabled(ctx) {
+		if cyclicRoutes, err := k.BuildCyclicRoutes(ctx, tokenIn, tokenOut, poolId, routes); err == nil {
+			routes = append(routes, cyclicRoutes...)
+		}
+	}
+
 	return routes
 }
 
@@ -219,6 +227,151 @@ func (k Keeper) BuildTwoPoolRoute(
 	}, nil
 }
 
+// BuildCyclicRoutes builds cyclic arbitrage routes of 2 to MaxCyclicRouteLength pools by searching the denom pair graph for paths
+// from tokenIn back to tokenOut. Routes that were already built by the other methods are skipped, and routes are only kept while
+// they fit into the pool points that remain for the tx after the existing routes. Shorter routes have priority over longer ones.
+func (k Keeper) BuildCyclicRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64, existingRoutes []RouteMetaData) ([]RouteMetaData, error) {
+	routes := make([]RouteMetaData, 0)
+	baseDenoms, err := k.GetAllBaseDenoms(ctx)
+	if err != nil {
+		return routes, err
+	}
+
+	remainingPoolPoints, _, err := k.GetRemainingPoolPoints(ctx)
+	if err != nil {
+		return routes, err
+	}
+
+	seenRoutes := make(map[string]bool)
+	for _, route := range existingRoutes {
+		seenRoutes[string(types.CreateRouteKey(route.Route.PoolIds()))] = true
+
+		if route.PoolPoints >= remainingPoolPoints {
+			return routes, nil
+		}
+		remainingPoolPoints -= route.PoolPoints
+	}
+
+	// The cycle always swaps against the pool of the original swap in the opposite direction
+	swappedPoolHop := poolmanagertypes.SwapAmountInRoute{
+		PoolId:        poolId,
+		TokenOutDenom: tokenIn,
+	}
+
+	for _, path := range k.findCyclicRoutePaths(ctx, tokenIn, tokenOut, poolId) {
+		if len(routes) >= types.MaxCyclicRoutesPerSwap {
+			break
+		}
+
+		cycle := append(poolmanagertypes.SwapAmountInRoutes{swappedPoolHop}, path...)
+		newRoute, err := k.BuildCyclicRoute(ctx, cycle, tokenOut, baseDenoms)
+		if err != nil {
+			continue
+		}
+
+		routeKey := string(types.CreateRouteKey(newRoute.Route.PoolIds()))
+		if seenRoutes[routeKey] || newRoute.PoolPoints > remainingPoolPoints {
+			continue
+		}
+
+		seenRoutes[routeKey] = true
+		remainingPoolPoints -= newRoute.PoolPoints
+		routes = append(routes, newRoute)
+	}
+
+	return routes, nil
+}
+
+// BuildCyclicRoute rotates a cycle that starts by swapping in startDenom so that it starts and ends with the highest priority
+// base denom the cycle trades. An error is returned if the cycle does not trade any base denom.
+func (k Keeper) BuildCyclicRoute(ctx sdk.Context, cycle poolmanagertypes.SwapAmountInRoutes, startDenom string, baseDenoms []types.BaseDenom) (RouteMetaData, error) {
+	// tokenInDenoms[i] is the denom that is swapped in on the i-th hop of the cycle
+	tokenInDenoms := make([]string, len(cycle))
+	tokenInDenoms[0] = startDenom
+	for i := 1; i < len(cycle); i++ {
+		tokenInDenoms[i] = cycle[i-1].TokenOutDenom
+	}
+
+	for _, baseDenom := range baseDenoms {
+		for i, denom := range tokenInDenoms {
+			if denom != baseDenom.Denom {
+				continue
+			}
+
+			newRoute := make(poolmanagertypes.SwapAmountInRoutes, 0, len(cycle))
+			newRoute = append(newRoute, cycle[i:]...)
+			newRoute = append(newRoute, cycle[:i]...)
+
+			// Check that the route is valid and update the number of pool points that this route will consume when simulating and executing trades
+			routePoolPoints, err := k.CalculateRoutePoolPoints(ctx, newRoute)
+			if err != nil {
+				return RouteMetaData{}, err
+			}
+
+			return RouteMetaData{
+				Route:      newRoute,
+				PoolPoints: routePoolPoints,
+				StepSize:   baseDenom.StepSize,
+			}, nil
+		}
+	}
+
+	return RouteMetaData{}, fmt.Errorf("cycle %v does not trade any base denom", cycle.PoolIds())
+}
+
+// findCyclicRoutePaths returns every path of at most MaxCyclicRouteLength - 1 hops from tokenIn to tokenOut in the denom pair graph
+// that does not trade against the pool of the original swap and does not revisit a denom or pool. Shorter paths are returned first.
+func (k Keeper) findCyclicRoutePaths(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64) []poolmanagertypes.SwapAmountInRoutes {
+	paths := make([]poolmanagertypes.SwapAmountInRoutes, 0)
+	neighborsByDenom := make(map[string][]types.Trade)
+	visitedDenoms := map[string]bool{tokenIn: true}
+	visitedPools := map[uint64]bool{poolId: true}
+
+	var search func(denom string, path poolmanagertypes.SwapAmountInRoutes)
+	search = func(denom string, path poolmanagertypes.SwapAmountInRoutes) {
+		neighbors, ok := neighborsByDenom[denom]
+		if !ok {
+			neighbors = k.GetDenomPairGraphNeighbors(ctx, denom)
+			neighborsByDenom[denom] = neighbors
+		}
+
+		for _, edge := range neighbors {
+			if visitedPools[edge.Pool] || visitedDenoms[edge.TokenOut] {
+				continue
+			}
+
+			hop := poolmanagertypes.SwapAmountInRoute{
+				PoolId:        edge.Pool,
+				TokenOutDenom: edge.TokenOut,
+			}
+
+			if edge.TokenOut == tokenOut {
+				newPath := make(poolmanagertypes.SwapAmountInRoutes, 0, len(path)+1)
+				newPath = append(newPath, path...)
+				paths = append(paths, append(newPath, hop))
+				continue
+			}
+
+			if len(path)+1 >= types.MaxCyclicRouteLength-1 {
+				continue
+			}
+
+			visitedDenoms[edge.TokenOut] = true
+			visitedPools[edge.Pool] = true
+			search(edge.TokenOut, append(path, hop))
+			delete(visitedDenoms, edge.TokenOut)
+			delete(visitedPools, edge.Pool)
+		}
+	}
+	search(tokenIn, poolmanagertypes.SwapAmountInRoutes{})
+
+	sort.SliceStable(paths, func(i, j int) bool {
+		return len(paths[i]) < len(paths[j])
+	})
+
+	return paths
+}
+
 // CalculateRoutePoolPoints calculates the number of pool points that will be consumed by a route when simulating and executing trades. This
 // is only added to the global pool point counter if the route simulated is minimally profitable i.e. it will make a profit.
 func (k Keeper) CalculateRoutePoolPoints(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes) (uint64, error) {
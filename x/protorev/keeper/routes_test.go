@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v29/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v29/x/protorev/keeper"
 	"github.com/osmosis-labs/osmosis/v29/x/protorev/types"
 )
 
@@ -128,6 +129,122 @@ func (s *KeeperTestSuite) TestBuildRoutes() {
 	}
 }
 
+// TestBuildCyclicRoutes tests the BuildCyclicRoutes function
+func (s *KeeperTestSuite) TestBuildCyclicRoutes() {
+	cases := []struct {
+		description       string
+		inputDenom        string
+		outputDenom       string
+		poolID            uint64
+		maxPointsPerTx    uint64
+		useExistingRoutes bool
+		expectedRoutes    [][]TestRoute
+	}{
+		{
+			description:    "Routes are built for every cycle through a base denom with shorter routes first",
+			inputDenom:     "akash",
+			outputDenom:    "Atom",
+			poolID:         1,
+			maxPointsPerTx: 30,
+			expectedRoutes: [][]TestRoute{
+				{
+					{PoolId: 1, InputDenom: "Atom", OutputDenom: "akash"},
+					{PoolId: 14, InputDenom: "akash", OutputDenom: "bitcoin"},
+					{PoolId: 4, InputDenom: "bitcoin", OutputDenom: "Atom"},
+				},
+				{
+					{PoolId: 25, InputDenom: types.OsmosisDenomination, OutputDenom: "Atom"},
+					{PoolId: 1, InputDenom: "Atom", OutputDenom: "akash"},
+					{PoolId: 7, InputDenom: "akash", OutputDenom: types.OsmosisDenomination},
+				},
+				{
+					{PoolId: 1, InputDenom: "Atom", OutputDenom: "akash"},
+					{PoolId: 14, InputDenom: "akash", OutputDenom: "bitcoin"},
+					{PoolId: 19, InputDenom: "bitcoin", OutputDenom: "ethereum"},
+					{PoolId: 3, InputDenom: "ethereum", OutputDenom: "Atom"},
+				},
+			},
+		},
+		{
+			description:       "Routes that were already built by the other methods are skipped",
+			inputDenom:        "akash",
+			outputDenom:       "Atom",
+			poolID:            1,
+			maxPointsPerTx:    30,
+			useExistingRoutes: true,
+			expectedRoutes: [][]TestRoute{
+				{
+					{PoolId: 1, InputDenom: "Atom", OutputDenom: "akash"},
+					{PoolId: 14, InputDenom: "akash", OutputDenom: "bitcoin"},
+					{PoolId: 19, InputDenom: "bitcoin", OutputDenom: "ethereum"},
+					{PoolId: 3, InputDenom: "ethereum", OutputDenom: "Atom"},
+				},
+			},
+		},
+		{
+			description:    "Routes are bounded by the remaining pool points",
+			inputDenom:     "akash",
+			outputDenom:    "Atom",
+			poolID:         1,
+			maxPointsPerTx: 14,
+			expectedRoutes: [][]TestRoute{
+				{
+					{PoolId: 1, InputDenom: "Atom", OutputDenom: "akash"},
+					{PoolId: 14, InputDenom: "akash", OutputDenom: "bitcoin"},
+					{PoolId: 4, InputDenom: "bitcoin", OutputDenom: "Atom"},
+				},
+				{
+					{PoolId: 25, InputDenom: types.OsmosisDenomination, OutputDenom: "Atom"},
+					{PoolId: 1, InputDenom: "Atom", OutputDenom: "akash"},
+					{PoolId: 7, InputDenom: "akash", OutputDenom: types.OsmosisDenomination},
+				},
+			},
+		},
+		{
+			description:    "No routes are built for a denom that is not in the denom pair graph",
+			inputDenom:     "juno",
+			outputDenom:    "Atom",
+			poolID:         1,
+			maxPointsPerTx: 30,
+			expectedRoutes: [][]TestRoute{},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.description, func() {
+			s.SetupPoolsTest()
+			err := s.App.ProtoRevKeeper.SetMaxPointsPerTx(s.Ctx, tc.maxPointsPerTx)
+			s.Require().NoError(err)
+
+			// The edge on the swapped pool must never be used
+			s.App.ProtoRevKeeper.SetPoolForDenomPairGraphEdge(s.Ctx, "akash", "Atom", 1)
+			s.App.ProtoRevKeeper.SetPoolForDenomPairGraphEdge(s.Ctx, "akash", "bitcoin", 14)
+			s.App.ProtoRevKeeper.SetPoolForDenomPairGraphEdge(s.Ctx, "akash", types.OsmosisDenomination, 7)
+			s.App.ProtoRevKeeper.SetPoolForDenomPairGraphEdge(s.Ctx, "bitcoin", "Atom", 4)
+			s.App.ProtoRevKeeper.SetPoolForDenomPairGraphEdge(s.Ctx, "bitcoin", "ethereum", 19)
+			s.App.ProtoRevKeeper.SetPoolForDenomPairGraphEdge(s.Ctx, "ethereum", "Atom", 3)
+			s.App.ProtoRevKeeper.SetPoolForDenomPairGraphEdge(s.Ctx, types.OsmosisDenomination, "Atom", 25)
+
+			existingRoutes := []keeper.RouteMetaData{}
+			if tc.useExistingRoutes {
+				existingRoutes = s.App.ProtoRevKeeper.BuildRoutes(s.Ctx, tc.inputDenom, tc.outputDenom, tc.poolID)
+			}
+
+			routes, err := s.App.ProtoRevKeeper.BuildCyclicRoutes(s.Ctx, tc.inputDenom, tc.outputDenom, tc.poolID, existingRoutes)
+			s.Require().NoError(err)
+			s.Require().Equal(len(tc.expectedRoutes), len(routes))
+
+			for routeIndex, route := range routes {
+				s.Require().Equal(len(tc.expectedRoutes[routeIndex]), len(route.Route))
+				for tradeIndex, poolID := range route.Route.PoolIds() {
+					s.Require().Equal(tc.expectedRoutes[routeIndex][tradeIndex].PoolId, poolID)
+					s.Require().Equal(tc.expectedRoutes[routeIndex][tradeIndex].OutputDenom, route.Route[tradeIndex].TokenOutDenom)
+				}
+			}
+		})
+	}
+}
+
 // TestBuildHighestLiquidityRoute tests the BuildHighestLiquidityRoute function
 func (s *KeeperTestSuite) TestBuildHighestLiquidityRoute() {
 	s.SetupPoolsTest()
@@ -278,5 +278,192 @@ func (k Keeper) UpdateStatistics(ctx sdk.Context, route poolmanagertypes.SwapAmo
 		return err
 	}
 
+	// Update the statistics of the route and its pools in the current window
+	if err := k.UpdateStatisticsByWindow(ctx, route.PoolIds(), denom, profit); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetCurrentStatisticsWindow returns the window that the statistics of trades executed during the current day epoch
+// are recorded in, which is the number of days since module genesis
+func (k Keeper) GetCurrentStatisticsWindow(ctx sdk.Context) uint64 {
+	daysSinceGenesis, err := k.GetDaysSinceModuleGenesis(ctx)
+	if err != nil {
+		return 0
+	}
+
+	return daysSinceGenesis
+}
+
+// GetRouteStatisticsByWindow returns the statistics of the given route in the given window
+func (k Keeper) GetRouteStatisticsByWindow(ctx sdk.Context, window uint64, route []uint64) (types.RouteStatistics, error) {
+	statistics := types.RouteStatistics{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.GetKeyPrefixRouteStatisticsByWindow(window, route), &statistics)
+	if err != nil {
+		return types.RouteStatistics{}, err
+	}
+
+	if !found {
+		return types.RouteStatistics{
+			Profits:        []sdk.Coin{},
+			NumberOfTrades: osmomath.ZeroInt(),
+			Route:          route,
+		}, nil
+	}
+
+	return statistics, nil
+}
+
+// GetPoolStatisticsByWindow returns the statistics of the given pool in the given window
+func (k Keeper) GetPoolStatisticsByWindow(ctx sdk.Context, window, poolId uint64) (types.PoolStatistics, error) {
+	statistics := types.PoolStatistics{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.GetKeyPrefixPoolStatisticsByWindow(window, poolId), &statistics)
+	if err != nil {
+		return types.PoolStatistics{}, err
+	}
+
+	if !found {
+		return types.PoolStatistics{
+			PoolId:         poolId,
+			Profits:        []sdk.Coin{},
+			NumberOfTrades: osmomath.ZeroInt(),
+		}, nil
+	}
+
+	return statistics, nil
+}
+
+// UpdateStatisticsByWindow updates the statistics of the given route and of every pool in the route in the current window
+func (k Keeper) UpdateStatisticsByWindow(ctx sdk.Context, route []uint64, denom string, profit osmomath.Int) error {
+	window := k.GetCurrentStatisticsWindow(ctx)
+	store := ctx.KVStore(k.storeKey)
+
+	routeStatistics, err := k.GetRouteStatisticsByWindow(ctx, window, route)
+	if err != nil {
+		return err
+	}
+
+	routeStatistics.NumberOfTrades = routeStatistics.NumberOfTrades.Add(oneInt)
+	routeStatistics.Profits = sdk.Coins(routeStatistics.Profits).Add(sdk.NewCoin(denom, profit))
+	osmoutils.MustSet(store, types.GetKeyPrefixRouteStatisticsByWindow(window, route), &routeStatistics)
+
+	// A pool is only counted once per trade even if the route swaps against it several times
+	seenPools := make(map[uint64]bool)
+	for _, poolId := range route {
+		if seenPools[poolId] {
+			continue
+		}
+		seenPools[poolId] = true
+
+		poolStatistics, err := k.GetPoolStatisticsByWindow(ctx, window, poolId)
+		if err != nil {
+			return err
+		}
+
+		poolStatistics.NumberOfTrades = poolStatistics.NumberOfTrades.Add(oneInt)
+		poolStatistics.Profits = sdk.Coins(poolStatistics.Profits).Add(sdk.NewCoin(denom, profit))
+		osmoutils.MustSet(store, types.GetKeyPrefixPoolStatisticsByWindow(window, poolId), &poolStatistics)
+	}
+
+	// Mark the window as having statistics
+	store.Set(types.GetKeyPrefixStatisticsWindow(window), []byte{})
+
 	return nil
 }
+
+// SetStatisticsWindow sets the route and pool statistics of the given window
+func (k Keeper) SetStatisticsWindow(ctx sdk.Context, window types.StatisticsWindow) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, routeStatistics := range window.RouteStatistics {
+		osmoutils.MustSet(store, types.GetKeyPrefixRouteStatisticsByWindow(window.Window, routeStatistics.Route), &routeStatistics)
+	}
+
+	for _, poolStatistics := range window.PoolStatistics {
+		osmoutils.MustSet(store, types.GetKeyPrefixPoolStatisticsByWindow(window.Window, poolStatistics.PoolId), &poolStatistics)
+	}
+
+	store.Set(types.GetKeyPrefixStatisticsWindow(window.Window), []byte{})
+}
+
+// GetStatisticsWindow returns the route and pool statistics of the given window
+func (k Keeper) GetStatisticsWindow(ctx sdk.Context, window uint64) (types.StatisticsWindow, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	routeStatisticsPrefix := append(types.KeyPrefixRouteStatisticsByWindow, sdk.Uint64ToBigEndian(window)...)
+	routeStatistics, err := osmoutils.GatherValuesFromStorePrefix(store, routeStatisticsPrefix, func(bz []byte) (types.RouteStatistics, error) {
+		statistics := types.RouteStatistics{}
+		err := statistics.Unmarshal(bz)
+		return statistics, err
+	})
+	if err != nil {
+		return types.StatisticsWindow{}, err
+	}
+
+	poolStatisticsPrefix := append(types.KeyPrefixPoolStatisticsByWindow, sdk.Uint64ToBigEndian(window)...)
+	poolStatistics, err := osmoutils.GatherValuesFromStorePrefix(store, poolStatisticsPrefix, func(bz []byte) (types.PoolStatistics, error) {
+		statistics := types.PoolStatistics{}
+		err := statistics.Unmarshal(bz)
+		return statistics, err
+	})
+	if err != nil {
+		return types.StatisticsWindow{}, err
+	}
+
+	return types.StatisticsWindow{
+		Window:          window,
+		RouteStatistics: routeStatistics,
+		PoolStatistics:  poolStatistics,
+	}, nil
+}
+
+// GetStatisticsWindows returns the route and pool statistics of every window starting from the given window, ordered
+// from the oldest to the most recent window
+func (k Keeper) GetStatisticsWindows(ctx sdk.Context, fromWindow uint64) ([]types.StatisticsWindow, error) {
+	store := ctx.KVStore(k.storeKey)
+	windows := make([]types.StatisticsWindow, 0)
+
+	iterator := store.Iterator(types.GetKeyPrefixStatisticsWindow(fromWindow), storetypes.PrefixEndBytes(types.KeyPrefixStatisticsWindows))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		window, err := k.GetStatisticsWindow(ctx, sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixStatisticsWindows):]))
+		if err != nil {
+			return nil, err
+		}
+
+		windows = append(windows, window)
+	}
+
+	return windows, nil
+}
+
+// DeleteStatisticsWindowsBefore deletes the route and pool statistics of every window before the given window
+func (k Keeper) DeleteStatisticsWindowsBefore(ctx sdk.Context, window uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, keyPrefix := range [][]byte{types.KeyPrefixRouteStatisticsByWindow, types.KeyPrefixPoolStatisticsByWindow, types.KeyPrefixStatisticsWindows} {
+		iterator := store.Iterator(keyPrefix, append(keyPrefix, sdk.Uint64ToBigEndian(window)...))
+
+		keys := make([][]byte, 0)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+// PruneStatisticsWindows deletes the route and pool statistics of every window that is no longer retained
+func (k Keeper) PruneStatisticsWindows(ctx sdk.Context) {
+	currentWindow := k.GetCurrentStatisticsWindow(ctx)
+	if currentWindow < types.StatisticsWindowsRetained {
+		return
+	}
+
+	k.DeleteStatisticsWindowsBefore(ctx, currentWindow-types.StatisticsWindowsRetained+1)
+}
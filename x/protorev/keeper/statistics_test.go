@@ -194,6 +194,99 @@ func (s *KeeperTestSuite) TestUpdateStatistics() {
 	s.Require().Equal(2, len(routes))
 }
 
+// TestUpdateStatisticsByWindow tests that the route and pool statistics are recorded in the window of the current day epoch
+func (s *KeeperTestSuite) TestUpdateStatisticsByWindow() {
+	s.App.ProtoRevKeeper.SetDaysSinceModuleGenesis(s.Ctx, 3)
+
+	// Pseudo execute a trade through a route that uses the same pool twice
+	err := s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx,
+		poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 1}, {TokenOutDenom: "", PoolId: 2}, {TokenOutDenom: "", PoolId: 1}},
+		types.OsmosisDenomination, osmomath.NewInt(1000),
+	)
+	s.Require().NoError(err)
+
+	routeStatistics, err := s.App.ProtoRevKeeper.GetRouteStatisticsByWindow(s.Ctx, 3, []uint64{1, 2, 1})
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.OneInt(), routeStatistics.NumberOfTrades)
+	s.Require().Equal([]sdk.Coin{sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(1000))}, routeStatistics.Profits)
+
+	// The trade is only counted once for each pool in the route
+	poolStatistics, err := s.App.ProtoRevKeeper.GetPoolStatisticsByWindow(s.Ctx, 3, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.OneInt(), poolStatistics.NumberOfTrades)
+	s.Require().Equal([]sdk.Coin{sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(1000))}, poolStatistics.Profits)
+
+	// Nothing is recorded in any other window
+	poolStatistics, err = s.App.ProtoRevKeeper.GetPoolStatisticsByWindow(s.Ctx, 2, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.ZeroInt(), poolStatistics.NumberOfTrades)
+
+	// Pseudo execute a second trade in the next window
+	s.App.ProtoRevKeeper.SetDaysSinceModuleGenesis(s.Ctx, 4)
+	err = s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx,
+		poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 2}, {TokenOutDenom: "", PoolId: 3}},
+		"Atom", osmomath.NewInt(500),
+	)
+	s.Require().NoError(err)
+
+	windows, err := s.App.ProtoRevKeeper.GetStatisticsWindows(s.Ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Equal(2, len(windows))
+
+	s.Require().Equal(uint64(3), windows[0].Window)
+	s.Require().Equal(1, len(windows[0].RouteStatistics))
+	s.Require().Equal([]uint64{1, 2, 1}, windows[0].RouteStatistics[0].Route)
+	s.Require().Equal(2, len(windows[0].PoolStatistics))
+	s.Require().Equal(uint64(1), windows[0].PoolStatistics[0].PoolId)
+	s.Require().Equal(uint64(2), windows[0].PoolStatistics[1].PoolId)
+
+	s.Require().Equal(uint64(4), windows[1].Window)
+	s.Require().Equal(1, len(windows[1].RouteStatistics))
+	s.Require().Equal([]uint64{2, 3}, windows[1].RouteStatistics[0].Route)
+	s.Require().Equal(2, len(windows[1].PoolStatistics))
+	s.Require().Equal(uint64(2), windows[1].PoolStatistics[0].PoolId)
+	s.Require().Equal([]sdk.Coin{sdk.NewCoin("Atom", osmomath.NewInt(500))}, windows[1].PoolStatistics[0].Profits)
+
+	// Only the windows starting from the given window are returned
+	windows, err = s.App.ProtoRevKeeper.GetStatisticsWindows(s.Ctx, 4)
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(windows))
+	s.Require().Equal(uint64(4), windows[0].Window)
+}
+
+// TestPruneStatisticsWindows tests that only the most recent windows are retained
+func (s *KeeperTestSuite) TestPruneStatisticsWindows() {
+	route := poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 1}, {TokenOutDenom: "", PoolId: 2}}
+	for _, window := range []uint64{0, 1, 2} {
+		s.App.ProtoRevKeeper.SetDaysSinceModuleGenesis(s.Ctx, window)
+		err := s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx, route, types.OsmosisDenomination, osmomath.NewInt(1000))
+		s.Require().NoError(err)
+	}
+
+	// Nothing is pruned while fewer windows than are retained have elapsed
+	s.App.ProtoRevKeeper.PruneStatisticsWindows(s.Ctx)
+	windows, err := s.App.ProtoRevKeeper.GetStatisticsWindows(s.Ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Equal(3, len(windows))
+
+	// Windows 0 and 1 fall out of the retained windows
+	s.App.ProtoRevKeeper.SetDaysSinceModuleGenesis(s.Ctx, types.StatisticsWindowsRetained+1)
+	s.App.ProtoRevKeeper.PruneStatisticsWindows(s.Ctx)
+	windows, err = s.App.ProtoRevKeeper.GetStatisticsWindows(s.Ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(windows))
+	s.Require().Equal(uint64(2), windows[0].Window)
+
+	poolStatistics, err := s.App.ProtoRevKeeper.GetPoolStatisticsByWindow(s.Ctx, 1, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.ZeroInt(), poolStatistics.NumberOfTrades)
+
+	// The lifetime statistics are not affected
+	trades, err := s.App.ProtoRevKeeper.GetTradesByRoute(s.Ctx, []uint64{1, 2})
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(3), trades)
+}
+
 func (s *KeeperTestSuite) TestGetSetCyclicArbProfitTrackerValue() {
 	tests := map[string]struct {
 		firstCyclicArbValue  sdk.Coins
@@ -20,6 +20,17 @@ var ExtendedMaxInputAmount = osmomath.NewInt(131_072)
 // Max iterations for binary search (log2(131_072) = 17)
 const MaxIterations int = 17
 
+// Max iterations for fitting the closed-form optimal amount in of routes made only of balancer and stableswap pools
+const MaxCFMMIterations int = 8
+
+// Max number of steps from the closed-form optimal amount in to the most profitable amount in, beyond which the binary
+// search is run instead
+const MaxCFMMHillClimbSteps int = 3
+
+// Max number of amounts in simulated by the closed form: two for the first fit and one for every other fit, and at most
+// three for the first step to the most profitable amount in and one for every other step
+const MaxCFMMSimulations int = MaxCFMMIterations + 1 + MaxCFMMHillClimbSteps + 3
+
 // Max number of pool points that can be consumed per tx. This roughly corresponds
 // to the maximum execution time (in ms) of protorev per tx
 const MaxPoolPointsPerTx uint64 = 50
@@ -31,6 +42,19 @@ const MaxPoolPointsPerBlock uint64 = 200
 // Max number of ticks we can move in a concentrated pool swap.
 const MaxTicksCrossed uint64 = 10
 
+// Max number of pools in a route found by the cyclic route search (including the pool that was swapped against)
+const MaxCyclicRouteLength int = 4
+
+// Max number of neighbors (by highest liquidity) that are stored for each denom in the denom pair graph. This bounds
+// the number of store reads that the cyclic route search performs per swap
+const MaxDenomPairGraphNeighbors int = 10
+
+// Max number of routes that the cyclic route search will return per swap
+const MaxCyclicRoutesPerSwap int = 5
+
+// Number of day epochs for which route and pool statistics are retained in windows
+const StatisticsWindowsRetained uint64 = 30
+
 // ---------------- Module Profit Splitting Constants ---------------- //
 
 // Year 1 (20% of total profit)
@@ -15,3 +15,5 @@ func (e NoPoolForDenomPairError) Error() string {
 }
 
 var ErrRouteDoubleContainsPool = errors.New("cannot be trading on the same pool twice")
+
+var ErrCFMMHillClimbNotConverged = errors.New("closed-form optimal amount in is too far from the most profitable amount in")
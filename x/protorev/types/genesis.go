@@ -43,6 +43,7 @@ var (
 		CyclicArb:                  sdk.Coins(nil),
 		HeightAccountingStartsFrom: 0,
 	}
+	DefaultStatisticsWindows = []StatisticsWindow{}
 )
 
 // DefaultGenesis returns the default genesis state
@@ -61,6 +62,7 @@ func DefaultGenesis() *GenesisState {
 		PointCountForBlock:     DefaultPoolPointsConsumedInBlock,
 		Profits:                DefaultProfits,
 		CyclicArbTracker:       &DefaultCyclicArbTracker,
+		StatisticsWindows:      DefaultStatisticsWindows,
 	}
 }
 
@@ -103,6 +105,11 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// Validate the statistics windows
+	if err := ValidateStatisticsWindows(gs.StatisticsWindows); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
 
@@ -60,6 +60,8 @@ type GenesisState struct {
 	// consumption of a swap on a given pool type.
 	InfoByPoolType   InfoByPoolType    `protobuf:"bytes,13,opt,name=info_by_pool_type,json=infoByPoolType,proto3" json:"info_by_pool_type" yaml:"info_by_pool_type"`
 	CyclicArbTracker *CyclicArbTracker `protobuf:"bytes,14,opt,name=cyclic_arb_tracker,json=cyclicArbTracker,proto3" json:"cyclic_arb_tracker,omitempty" yaml:"cyclic_arb_tracker"`
+	// The route and pool statistics of the retained day epoch windows.
+	StatisticsWindows []StatisticsWindow `protobuf:"bytes,15,rep,name=statistics_windows,json=statisticsWindows,proto3" json:"statistics_windows" yaml:"statistics_windows"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -194,6 +196,13 @@ func (m *GenesisState) GetCyclicArbTracker() *CyclicArbTracker {
 	return nil
 }
 
+func (m *GenesisState) GetStatisticsWindows() []StatisticsWindow {
+	if m != nil {
+		return m.StatisticsWindows
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.protorev.v1beta1.GenesisState")
 }
@@ -203,57 +212,59 @@ func init() {
 }
 
 var fileDescriptor_3c77fc2da5752af2 = []byte{
-	// 789 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcf, 0x6e, 0xdc, 0x44,
-	0x18, 0x8f, 0x69, 0x48, 0xe9, 0x6c, 0xba, 0x6a, 0x06, 0x12, 0xcd, 0xae, 0x88, 0xd7, 0x0c, 0x0d,
-	0xac, 0x50, 0x6b, 0xab, 0x81, 0x0b, 0x3d, 0x20, 0xc5, 0x41, 0x05, 0x84, 0xa8, 0x22, 0x27, 0x08,
-	0x09, 0x24, 0x86, 0xb1, 0x3d, 0xbb, 0xb1, 0x62, 0x7b, 0xac, 0x99, 0xd9, 0x74, 0xfd, 0x00, 0xdc,
-	0x79, 0x18, 0x1e, 0xa2, 0xc7, 0x8a, 0x13, 0xa7, 0x15, 0x4a, 0x8e, 0xdc, 0xf6, 0x09, 0x90, 0x67,
-	0x66, 0x37, 0xed, 0x66, 0x4d, 0x6f, 0x99, 0xef, 0xfb, 0xfd, 0xf9, 0x7e, 0xe3, 0x6f, 0xb2, 0xe0,
-	0x13, 0x2e, 0x0b, 0x2e, 0x33, 0x19, 0x54, 0x82, 0x2b, 0x2e, 0xd8, 0x65, 0x70, 0xf9, 0x24, 0x66,
-	0x8a, 0x3e, 0x09, 0xc6, 0xac, 0x64, 0x32, 0x93, 0xbe, 0x6e, 0x40, 0x64, 0x71, 0xfe, 0x02, 0xe7,
-	0x5b, 0x5c, 0xff, 0x83, 0x31, 0x1f, 0x73, 0x5d, 0x0d, 0x9a, 0xbf, 0x0c, 0xa0, 0xff, 0x69, 0xab,
-	0xee, 0x52, 0xc0, 0x00, 0x0f, 0xda, 0x81, 0x54, 0xd0, 0xc2, 0x1a, 0xf6, 0x7b, 0x89, 0xc6, 0x11,
-	0x63, 0x64, 0x0e, 0xb6, 0xe5, 0x9a, 0x53, 0x10, 0x53, 0xc9, 0x96, 0xe4, 0x84, 0x67, 0xa5, 0xe9,
-	0xe3, 0x7f, 0x01, 0xd8, 0xfe, 0xc6, 0x84, 0x39, 0x55, 0x54, 0x31, 0xf8, 0x15, 0xd8, 0x32, 0xda,
-	0xc8, 0xf1, 0x9c, 0x61, 0xe7, 0xd0, 0xf3, 0xdb, 0xc2, 0xf9, 0x27, 0x1a, 0x17, 0x6e, 0xbe, 0x9c,
-	0x0d, 0x36, 0x22, 0xcb, 0x82, 0xbf, 0x3b, 0x60, 0x57, 0xf1, 0x0b, 0x56, 0x92, 0x8a, 0x66, 0x82,
-	0x50, 0x11, 0x13, 0xc1, 0x27, 0x8a, 0x49, 0xf4, 0x8e, 0x77, 0x67, 0xd8, 0x39, 0x7c, 0xd4, 0xae,
-	0x77, 0xd6, 0xd0, 0x4e, 0x68, 0x26, 0x8e, 0x44, 0x1c, 0x69, 0x4e, 0xf8, 0xb0, 0xd1, 0x9e, 0xcf,
-	0x06, 0x1f, 0xd6, 0xb4, 0xc8, 0x9f, 0xe2, 0xb5, 0xc2, 0x38, 0x82, 0xea, 0x16, 0x13, 0xfe, 0x06,
-	0x3a, 0x4d, 0x66, 0x92, 0xb2, 0x92, 0x17, 0x12, 0xdd, 0xd1, 0xe6, 0x1f, 0xb7, 0x9b, 0x87, 0x54,
-	0xb2, 0xaf, 0x1b, 0x6c, 0xd8, 0xb7, 0x9e, 0xd0, 0x78, 0xbe, 0xa6, 0x82, 0x23, 0x10, 0x2f, 0x60,
-	0x12, 0xd6, 0x60, 0xbb, 0xe2, 0x3c, 0x27, 0x2f, 0x58, 0x36, 0x3e, 0x57, 0x12, 0x6d, 0xea, 0xfb,
-	0x3a, 0xf8, 0x9f, 0xfb, 0xe2, 0x3c, 0xff, 0xc9, 0x80, 0xc3, 0xc0, 0x9a, 0x1c, 0x18, 0x93, 0xd7,
-	0x85, 0xf0, 0xa3, 0x94, 0x55, 0x82, 0x25, 0x54, 0xb1, 0xf4, 0x29, 0x56, 0x62, 0xc2, 0x30, 0x72,
-	0xa2, 0x4e, 0x75, 0xc3, 0x86, 0x04, 0xf4, 0x52, 0x5a, 0x4b, 0x22, 0xb3, 0x32, 0x61, 0xa4, 0xe0,
-	0xe9, 0x24, 0x67, 0xc4, 0xee, 0x24, 0x7a, 0xd7, 0x73, 0x86, 0x9b, 0xe1, 0xc3, 0xf9, 0x6c, 0xe0,
-	0x19, 0xf1, 0x56, 0x28, 0x8e, 0xf6, 0x9a, 0xde, 0x69, 0xd3, 0xfa, 0x41, 0x77, 0xec, 0x2a, 0x40,
-	0x02, 0xba, 0x29, 0xbb, 0x64, 0x39, 0xaf, 0x98, 0x20, 0x23, 0xc6, 0x24, 0xda, 0xd2, 0x17, 0xd8,
-	0xf3, 0xed, 0x76, 0x35, 0xf7, 0xb0, 0x0c, 0x76, 0xcc, 0xb3, 0x32, 0xdc, 0xb7, 0x89, 0x76, 0xad,
-	0xe9, 0x1b, 0x74, 0x1c, 0xdd, 0x5f, 0x16, 0x9e, 0x31, 0x26, 0xe1, 0x73, 0xf0, 0x7e, 0x4e, 0x15,
-	0x93, 0x8a, 0xc4, 0x39, 0x4f, 0x2e, 0xc8, 0xb9, 0x4e, 0x86, 0xee, 0xea, 0xd9, 0xdd, 0xf9, 0x6c,
-	0xd0, 0x37, 0x32, 0x6b, 0x40, 0x38, 0xda, 0x31, 0xd5, 0xb0, 0x29, 0x7e, 0xab, 0x6b, 0xf0, 0x17,
-	0xb0, 0x73, 0xe3, 0x48, 0xd3, 0x54, 0x30, 0x29, 0xd1, 0x7b, 0x9e, 0x33, 0xbc, 0x17, 0xfa, 0xf3,
-	0xd9, 0x00, 0xad, 0x0e, 0x65, 0x21, 0xf8, 0xaf, 0x3f, 0x1f, 0x77, 0x6d, 0xa4, 0x23, 0x53, 0x8a,
-	0x1e, 0x2c, 0x51, 0xb6, 0x02, 0x7f, 0x05, 0xbd, 0x82, 0x4e, 0x89, 0xfe, 0x48, 0x15, 0xcf, 0x4a,
-	0x25, 0x49, 0xa3, 0xa1, 0x87, 0x42, 0xf7, 0x56, 0xaf, 0xbb, 0x15, 0x8a, 0xa3, 0xdd, 0x82, 0x4e,
-	0x9b, 0x2d, 0x38, 0xd1, 0x9d, 0x13, 0x26, 0x74, 0x04, 0xf8, 0x23, 0xd8, 0x5b, 0x47, 0x52, 0x53,
-	0x04, 0xb4, 0xf8, 0x47, 0xf3, 0xd9, 0x60, 0xbf, 0x5d, 0x5c, 0x4d, 0x71, 0x04, 0x57, 0x95, 0xcf,
-	0xa6, 0xf0, 0x14, 0xec, 0x6a, 0x14, 0x49, 0xf8, 0xa4, 0x54, 0x64, 0xc4, 0x17, 0x23, 0x77, 0xb4,
-	0xaa, 0x77, 0xf3, 0xae, 0xd6, 0xc2, 0x70, 0x04, 0x75, 0xfd, 0xb8, 0x29, 0x3f, 0xe3, 0x76, 0xd6,
-	0xef, 0xc1, 0xdd, 0x4a, 0xf0, 0x51, 0xa6, 0x24, 0xda, 0x7e, 0xdb, 0x4a, 0xec, 0xd9, 0x95, 0xe8,
-	0x5a, 0x17, 0xc3, 0xc3, 0xd1, 0x42, 0x01, 0x4e, 0xc0, 0x4e, 0x56, 0x8e, 0x38, 0x89, 0x6b, 0x13,
-	0x4a, 0xd5, 0x15, 0x43, 0xf7, 0xf5, 0x3b, 0x1a, 0xb6, 0xbf, 0xa3, 0xef, 0xca, 0x11, 0x0f, 0xeb,
-	0x26, 0xed, 0x59, 0x5d, 0xb1, 0xd0, 0xb3, 0x2e, 0xf6, 0x1b, 0xdf, 0x12, 0xc4, 0x51, 0x37, 0x7b,
-	0x83, 0x01, 0x5f, 0x00, 0x98, 0xd4, 0x49, 0x9e, 0x25, 0xfa, 0xbf, 0x88, 0x12, 0x34, 0xb9, 0x60,
-	0x02, 0x75, 0xb5, 0xef, 0x67, 0xed, 0xbe, 0xc7, 0x9a, 0x73, 0x24, 0xe2, 0x33, 0xc3, 0x08, 0xf7,
-	0xe7, 0xb3, 0x41, 0xcf, 0xb8, 0xde, 0xd6, 0xc3, 0xd1, 0x83, 0x64, 0x95, 0xf0, 0xfc, 0xe5, 0x95,
-	0xeb, 0xbc, 0xba, 0x72, 0x9d, 0x7f, 0xae, 0x5c, 0xe7, 0x8f, 0x6b, 0x77, 0xe3, 0xd5, 0xb5, 0xbb,
-	0xf1, 0xf7, 0xb5, 0xbb, 0xf1, 0xf3, 0x17, 0xe3, 0x4c, 0x9d, 0x4f, 0x62, 0x3f, 0xe1, 0x45, 0x60,
-	0x07, 0x78, 0x9c, 0xd3, 0x58, 0x2e, 0x0e, 0xc1, 0xe5, 0xe1, 0x97, 0xc1, 0xf4, 0xe6, 0x77, 0xa0,
-	0x09, 0x26, 0xe3, 0x2d, 0x7d, 0xfe, 0xfc, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x28, 0xed,
-	0x61, 0xa9, 0x06, 0x00, 0x00,
+	// 827 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x92, 0x90, 0xd2, 0x71, 0x6a, 0x9a, 0x81, 0x44, 0x63, 0x8b, 0xd8, 0xdb, 0xa1, 0x01,
+	0x0b, 0xb5, 0x5e, 0x35, 0x70, 0xa1, 0x07, 0xa4, 0x6c, 0x50, 0x01, 0x21, 0xaa, 0x68, 0x13, 0x54,
+	0x09, 0x24, 0x86, 0xd9, 0xdd, 0xb1, 0x33, 0xca, 0x7a, 0x67, 0x35, 0x33, 0x4e, 0xbc, 0x12, 0x57,
+	0xee, 0x7c, 0x18, 0x3e, 0x44, 0x8f, 0x15, 0x27, 0x4e, 0x16, 0x4a, 0x4e, 0x5c, 0xfd, 0x09, 0xd0,
+	0xce, 0x8c, 0x9d, 0xd6, 0xf1, 0x96, 0x5b, 0xe6, 0xbd, 0xdf, 0x9f, 0xf9, 0xbd, 0x7d, 0x13, 0x83,
+	0x4f, 0x84, 0x1a, 0x09, 0xc5, 0x55, 0x50, 0x48, 0xa1, 0x85, 0x64, 0x17, 0xc1, 0xc5, 0x93, 0x98,
+	0x69, 0xfa, 0x24, 0x18, 0xb2, 0x9c, 0x29, 0xae, 0xfa, 0xa6, 0x01, 0x91, 0xc3, 0xf5, 0xe7, 0xb8,
+	0xbe, 0xc3, 0xb5, 0x3f, 0x1c, 0x8a, 0xa1, 0x30, 0xd5, 0xa0, 0xfa, 0xcb, 0x02, 0xda, 0x9f, 0xd6,
+	0xea, 0x2e, 0x04, 0x2c, 0x70, 0xbf, 0x1e, 0x48, 0x25, 0x1d, 0x39, 0xc3, 0x76, 0x2b, 0x31, 0x38,
+	0x62, 0x8d, 0xec, 0xc1, 0xb5, 0x3a, 0xf6, 0x14, 0xc4, 0x54, 0xb1, 0x05, 0x39, 0x11, 0x3c, 0xb7,
+	0x7d, 0xfc, 0x6f, 0x03, 0x6c, 0x7d, 0x63, 0xc3, 0x9c, 0x68, 0xaa, 0x19, 0xfc, 0x0a, 0x6c, 0x5a,
+	0x6d, 0xe4, 0xf9, 0x5e, 0xaf, 0x71, 0xe0, 0xf7, 0xeb, 0xc2, 0xf5, 0x8f, 0x0d, 0x2e, 0xdc, 0x78,
+	0x39, 0xed, 0xae, 0x45, 0x8e, 0x05, 0x7f, 0xf7, 0xc0, 0x8e, 0x16, 0xe7, 0x2c, 0x27, 0x05, 0xe5,
+	0x92, 0x50, 0x19, 0x13, 0x29, 0xc6, 0x9a, 0x29, 0xf4, 0x8e, 0xbf, 0xde, 0x6b, 0x1c, 0x3c, 0xaa,
+	0xd7, 0x3b, 0xad, 0x68, 0xc7, 0x94, 0xcb, 0x43, 0x19, 0x47, 0x86, 0x13, 0x3e, 0xac, 0xb4, 0x67,
+	0xd3, 0xee, 0x47, 0x25, 0x1d, 0x65, 0x4f, 0xf1, 0x4a, 0x61, 0x1c, 0x41, 0x7d, 0x8b, 0x09, 0x7f,
+	0x05, 0x8d, 0x2a, 0x33, 0x49, 0x59, 0x2e, 0x46, 0x0a, 0xad, 0x1b, 0xf3, 0x8f, 0xeb, 0xcd, 0x43,
+	0xaa, 0xd8, 0xd7, 0x15, 0x36, 0x6c, 0x3b, 0x4f, 0x68, 0x3d, 0x5f, 0x53, 0xc1, 0x11, 0x88, 0xe7,
+	0x30, 0x05, 0x4b, 0xb0, 0x55, 0x08, 0x91, 0x91, 0x4b, 0xc6, 0x87, 0x67, 0x5a, 0xa1, 0x0d, 0x33,
+	0xaf, 0xfd, 0xb7, 0xcc, 0x4b, 0x88, 0xec, 0x85, 0x05, 0x87, 0x81, 0x33, 0xd9, 0xb7, 0x26, 0xaf,
+	0x0b, 0xe1, 0x47, 0x29, 0x2b, 0x24, 0x4b, 0xa8, 0x66, 0xe9, 0x53, 0xac, 0xe5, 0x98, 0x61, 0xe4,
+	0x45, 0x8d, 0xe2, 0x86, 0x0d, 0x09, 0x68, 0xa5, 0xb4, 0x54, 0x44, 0xf1, 0x3c, 0x61, 0x64, 0x24,
+	0xd2, 0x71, 0xc6, 0x88, 0xdb, 0x49, 0xf4, 0xae, 0xef, 0xf5, 0x36, 0xc2, 0x87, 0xb3, 0x69, 0xd7,
+	0xb7, 0xe2, 0xb5, 0x50, 0x1c, 0xed, 0x56, 0xbd, 0x93, 0xaa, 0xf5, 0x83, 0xe9, 0xb8, 0x55, 0x80,
+	0x04, 0x34, 0x53, 0x76, 0xc1, 0x32, 0x51, 0x30, 0x49, 0x06, 0x8c, 0x29, 0xb4, 0x69, 0x06, 0xd8,
+	0xea, 0xbb, 0xed, 0xaa, 0xe6, 0xb0, 0x08, 0x76, 0x24, 0x78, 0x1e, 0xee, 0xb9, 0x44, 0x3b, 0xce,
+	0xf4, 0x0d, 0x3a, 0x8e, 0xee, 0x2d, 0x0a, 0xcf, 0x18, 0x53, 0xf0, 0x39, 0xf8, 0x20, 0xa3, 0x9a,
+	0x29, 0x4d, 0xe2, 0x4c, 0x24, 0xe7, 0xe4, 0xcc, 0x24, 0x43, 0x77, 0xcc, 0xdd, 0x3b, 0xb3, 0x69,
+	0xb7, 0x6d, 0x65, 0x56, 0x80, 0x70, 0xb4, 0x6d, 0xab, 0x61, 0x55, 0xfc, 0xd6, 0xd4, 0xe0, 0xcf,
+	0x60, 0xfb, 0xc6, 0x91, 0xa6, 0xa9, 0x64, 0x4a, 0xa1, 0xf7, 0x7c, 0xaf, 0x77, 0x37, 0xec, 0xcf,
+	0xa6, 0x5d, 0xb4, 0x7c, 0x29, 0x07, 0xc1, 0x7f, 0xfd, 0xf9, 0xb8, 0xe9, 0x22, 0x1d, 0xda, 0x52,
+	0x74, 0x7f, 0x81, 0x72, 0x15, 0xf8, 0x0b, 0x68, 0x8d, 0xe8, 0x84, 0x98, 0x8f, 0x54, 0x08, 0x9e,
+	0x6b, 0x45, 0x2a, 0x0d, 0x73, 0x29, 0x74, 0x77, 0x79, 0xdc, 0xb5, 0x50, 0x1c, 0xed, 0x8c, 0xe8,
+	0xa4, 0xda, 0x82, 0x63, 0xd3, 0x39, 0x66, 0xd2, 0x44, 0x80, 0x3f, 0x82, 0xdd, 0x55, 0x24, 0x3d,
+	0x41, 0xc0, 0x88, 0x3f, 0x98, 0x4d, 0xbb, 0x7b, 0xf5, 0xe2, 0x7a, 0x82, 0x23, 0xb8, 0xac, 0x7c,
+	0x3a, 0x81, 0x27, 0x60, 0xc7, 0xa0, 0x48, 0x22, 0xc6, 0xb9, 0x26, 0x03, 0x31, 0xbf, 0x72, 0xc3,
+	0xa8, 0xfa, 0x37, 0xef, 0x6a, 0x25, 0x0c, 0x47, 0xd0, 0xd4, 0x8f, 0xaa, 0xf2, 0x33, 0xe1, 0xee,
+	0xfa, 0x3d, 0xb8, 0x53, 0x48, 0x31, 0xe0, 0x5a, 0xa1, 0xad, 0xff, 0x5b, 0x89, 0x5d, 0xb7, 0x12,
+	0x4d, 0xe7, 0x62, 0x79, 0x38, 0x9a, 0x2b, 0xc0, 0x31, 0xd8, 0xe6, 0xf9, 0x40, 0x90, 0xb8, 0xb4,
+	0xa1, 0x74, 0x59, 0x30, 0x74, 0xcf, 0xbc, 0xa3, 0x5e, 0xfd, 0x3b, 0xfa, 0x2e, 0x1f, 0x88, 0xb0,
+	0xac, 0xd2, 0x9e, 0x96, 0x05, 0x0b, 0x7d, 0xe7, 0xe2, 0xbe, 0xf1, 0x2d, 0x41, 0x1c, 0x35, 0xf9,
+	0x1b, 0x0c, 0x78, 0x09, 0x60, 0x52, 0x26, 0x19, 0x4f, 0xcc, 0x7f, 0x11, 0x2d, 0x69, 0x72, 0xce,
+	0x24, 0x6a, 0x1a, 0xdf, 0xcf, 0xea, 0x7d, 0x8f, 0x0c, 0xe7, 0x50, 0xc6, 0xa7, 0x96, 0x11, 0xee,
+	0xcd, 0xa6, 0xdd, 0x96, 0x75, 0xbd, 0xad, 0x87, 0xa3, 0xfb, 0xc9, 0x12, 0x01, 0xfe, 0x06, 0xa0,
+	0xd2, 0x54, 0x73, 0xa5, 0x79, 0xa2, 0xc8, 0x25, 0xcf, 0x53, 0x71, 0xa9, 0xd0, 0xfb, 0xfe, 0xfa,
+	0xdb, 0x8d, 0x4f, 0x16, 0x9c, 0x17, 0x86, 0x12, 0x3e, 0x70, 0x91, 0x9d, 0xf9, 0x6d, 0x4d, 0x1c,
+	0x6d, 0xab, 0x25, 0x92, 0x0a, 0x9f, 0xbf, 0xbc, 0xea, 0x78, 0xaf, 0xae, 0x3a, 0xde, 0x3f, 0x57,
+	0x1d, 0xef, 0x8f, 0xeb, 0xce, 0xda, 0xab, 0xeb, 0xce, 0xda, 0xdf, 0xd7, 0x9d, 0xb5, 0x9f, 0xbe,
+	0x18, 0x72, 0x7d, 0x36, 0x8e, 0xfb, 0x89, 0x18, 0x05, 0xee, 0x16, 0x8f, 0x33, 0x1a, 0xab, 0xf9,
+	0x21, 0xb8, 0x38, 0xf8, 0x32, 0x98, 0xdc, 0xfc, 0x0a, 0x55, 0x63, 0x55, 0xf1, 0xa6, 0x39, 0x7f,
+	0xfe, 0xdf, 0x00, 0x77, 0x90, 0xdc, 0x88, 0x27, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -276,6 +287,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StatisticsWindows) > 0 {
+		for iNdEx := len(m.StatisticsWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StatisticsWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if m.CyclicArbTracker != nil {
 		{
 			size, err := m.CyclicArbTracker.MarshalToSizedBuffer(dAtA[:i])
@@ -479,6 +504,12 @@ func (m *GenesisState) Size() (n int) {
 		l = m.CyclicArbTracker.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.StatisticsWindows) > 0 {
+		for _, e := range m.StatisticsWindows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -915,6 +946,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatisticsWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatisticsWindows = append(m.StatisticsWindows, StatisticsWindow{})
+			if err := m.StatisticsWindows[len(m.StatisticsWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v29/x/protorev/types"
 )
 
@@ -20,6 +21,36 @@ func TestGenesisStateValidate(t *testing.T) {
 			genState:    types.DefaultGenesis(),
 			valid:       true,
 		},
+		{
+			description: "Valid statistics windows",
+			genState: withStatisticsWindows([]types.StatisticsWindow{
+				statisticsWindow(1, []uint64{1, 2}, 1, 2),
+				statisticsWindow(2, []uint64{1, 2}, 1, 2),
+			}),
+			valid: true,
+		},
+		{
+			description: "Duplicate statistics windows",
+			genState: withStatisticsWindows([]types.StatisticsWindow{
+				statisticsWindow(1, []uint64{1, 2}, 1, 2),
+				statisticsWindow(1, []uint64{2, 3}, 2, 3),
+			}),
+			valid: false,
+		},
+		{
+			description: "Duplicate pool statistics in a window",
+			genState: withStatisticsWindows([]types.StatisticsWindow{
+				statisticsWindow(1, []uint64{1, 2}, 1, 1),
+			}),
+			valid: false,
+		},
+		{
+			description: "Route statistics without a route",
+			genState: withStatisticsWindows([]types.StatisticsWindow{
+				statisticsWindow(1, []uint64{}, 1, 2),
+			}),
+			valid: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -40,3 +71,24 @@ func TestNullAccount(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, types.DefaultNullAddress.Equals(strAddress))
 }
+
+func withStatisticsWindows(windows []types.StatisticsWindow) *types.GenesisState {
+	genState := types.DefaultGenesis()
+	genState.StatisticsWindows = windows
+	return genState
+}
+
+// statisticsWindow returns a window with the statistics of a single trade on the given route, and of the given pools.
+func statisticsWindow(window uint64, route []uint64, poolIds ...uint64) types.StatisticsWindow {
+	profits := sdk.NewCoins(sdk.NewInt64Coin(types.OsmosisDenomination, 1000))
+	poolStatistics := []types.PoolStatistics{}
+	for _, poolId := range poolIds {
+		poolStatistics = append(poolStatistics, types.PoolStatistics{PoolId: poolId, Profits: profits, NumberOfTrades: osmomath.OneInt()})
+	}
+
+	return types.StatisticsWindow{
+		Window:          window,
+		RouteStatistics: []types.RouteStatistics{{Route: route, Profits: profits, NumberOfTrades: osmomath.OneInt()}},
+		PoolStatistics:  poolStatistics,
+	}
+}
@@ -41,6 +41,10 @@ const (
 	prefixcyclicArbTracker
 	prefixcyclicArbTrackerStartHeight
 	prefixBaseDenoms
+	prefixDenomPairGraph
+	prefixRouteStatisticsByWindow
+	prefixPoolStatisticsByWindow
+	prefixStatisticsWindows
 )
 
 var (
@@ -103,6 +107,19 @@ var (
 
 	// KeyPrefixBaseDenoms is the prefix that is used to store the base denoms that are used to create cyclic arbitrage routes
 	KeyPrefixBaseDenoms = []byte{prefixBaseDenoms}
+
+	// KeyPrefixDenomPairGraph is the prefix that is used to store the highest liquidity pool id for every denom pair (denom, neighborDenom)
+	// that is searched when cyclic route search is enabled
+	KeyPrefixDenomPairGraph = []byte{prefixDenomPairGraph}
+
+	// KeyPrefixRouteStatisticsByWindow is the prefix for the store that keeps track of the statistics of each route by day epoch
+	KeyPrefixRouteStatisticsByWindow = []byte{prefixRouteStatisticsByWindow}
+
+	// KeyPrefixPoolStatisticsByWindow is the prefix for the store that keeps track of the statistics of each pool by day epoch
+	KeyPrefixPoolStatisticsByWindow = []byte{prefixPoolStatisticsByWindow}
+
+	// KeyPrefixStatisticsWindows is the prefix for the store that keeps track of the windows that have statistics
+	KeyPrefixStatisticsWindows = []byte{prefixStatisticsWindows}
 )
 
 // Returns the key needed to fetch the pool id for a given denom
@@ -110,6 +127,11 @@ func GetKeyPrefixDenomPairToPool(baseDenom, matchDenom string) []byte {
 	return append(KeyPrefixDenomPairToPool, []byte(baseDenom+"|"+matchDenom)...)
 }
 
+// Returns the key needed to fetch the pool id for a given edge in the denom pair graph
+func GetKeyPrefixDenomPairGraph(denom, neighborDenom string) []byte {
+	return append(KeyPrefixDenomPairGraph, []byte(denom+"|"+neighborDenom)...)
+}
+
 // Returns the key needed to fetch info about base denoms
 func DeprecatedGetKeyPrefixBaseDenom(priority uint64) []byte {
 	return append(KeyPrefixDeprecatedBaseDenoms, sdk.Uint64ToBigEndian(priority)...)
@@ -135,6 +157,21 @@ func GetKeyPrefixProfitsByRoute(route []uint64, denom string) []byte {
 	return append(append(KeyPrefixProfitsByRoute, CreateRouteKey(route)...), []byte(denom)...)
 }
 
+// Returns the key needed to fetch the statistics of a route in the given window
+func GetKeyPrefixRouteStatisticsByWindow(window uint64, route []uint64) []byte {
+	return append(append(KeyPrefixRouteStatisticsByWindow, sdk.Uint64ToBigEndian(window)...), CreateRouteKey(route)...)
+}
+
+// Returns the key needed to fetch the statistics of a pool in the given window
+func GetKeyPrefixPoolStatisticsByWindow(window, poolId uint64) []byte {
+	return append(append(KeyPrefixPoolStatisticsByWindow, sdk.Uint64ToBigEndian(window)...), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// Returns the key needed to check whether the given window has statistics
+func GetKeyPrefixStatisticsWindow(window uint64) []byte {
+	return append(KeyPrefixStatisticsWindows, sdk.Uint64ToBigEndian(window)...)
+}
+
 // createRouteKey creates a key for the given route. converts a slice of uint64 to a string separated by a pipe
 // {1,2,3,4} -> []byte("1|2|3|4")
 func CreateRouteKey(route []uint64) []byte {
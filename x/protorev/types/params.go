@@ -15,12 +15,17 @@ var (
 	// All the settings manager's controls have limits, so it can't lead to a chain halt, excess processing time or prevention of swaps.
 	DefaultAdminAccount = "osmo17nv67dvc7f8yr00rhgxd688gcn9t9wvhn783z4"
 
+	// DefaultCyclicRouteSearchEnabled is the default value for whether routes are additionally discovered
+	// by searching the denom pair graph. It is disabled by default since it consumes more gas per swap.
+	DefaultCyclicRouteSearchEnabled = false
+
 	// DefaultNullAddress is the default value for the null account. ProtoRev arbitrage profits denominated in OSMO are sent to this account
 	// as of V24. This address is equivalent to osmo1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqmcn030.
 	DefaultNullAddress = sdk.AccAddress([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
 
-	ParamStoreKeyEnableModule = []byte("EnableProtoRevModule")
-	ParamStoreKeyAdminAccount = []byte("AdminAccount")
+	ParamStoreKeyEnableModule             = []byte("EnableProtoRevModule")
+	ParamStoreKeyAdminAccount             = []byte("AdminAccount")
+	ParamStoreKeyCyclicRouteSearchEnabled = []byte("CyclicRouteSearchEnabled")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -29,16 +34,17 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(enable bool, admin string) Params {
+func NewParams(enable bool, admin string, cyclicRouteSearchEnabled bool) Params {
 	return Params{
-		Enabled: enable,
-		Admin:   admin,
+		Enabled:                  enable,
+		Admin:                    admin,
+		CyclicRouteSearchEnabled: cyclicRouteSearchEnabled,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultEnableModule, DefaultAdminAccount)
+	return NewParams(DefaultEnableModule, DefaultAdminAccount, DefaultCyclicRouteSearchEnabled)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -46,6 +52,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableModule, &p.Enabled, ValidateBoolean),
 		paramtypes.NewParamSetPair(ParamStoreKeyAdminAccount, &p.Admin, ValidateAccount),
+		paramtypes.NewParamSetPair(ParamStoreKeyCyclicRouteSearchEnabled, &p.CyclicRouteSearchEnabled, ValidateBoolean),
 	}
 }
 
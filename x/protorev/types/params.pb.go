@@ -30,6 +30,9 @@ type Params struct {
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
 	// The admin account (settings manager) of the protorev module.
 	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// Boolean whether routes are additionally discovered by searching the
+	// denom pair graph for 2 to 4 pool cycles involving the swapped pair.
+	CyclicRouteSearchEnabled bool `protobuf:"varint,3,opt,name=cyclic_route_search_enabled,json=cyclicRouteSearchEnabled,proto3" json:"cyclic_route_search_enabled,omitempty" yaml:"cyclic_route_search_enabled"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +82,13 @@ func (m *Params) GetAdmin() string {
 	return ""
 }
 
+func (m *Params) GetCyclicRouteSearchEnabled() bool {
+	if m != nil {
+		return m.CyclicRouteSearchEnabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.protorev.v1beta1.Params")
 }
@@ -88,22 +98,25 @@ func init() {
 }
 
 var fileDescriptor_72168e5a5a65ae7e = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
+	// 283 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x2f, 0xce, 0xcd,
 	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x2f, 0x4a, 0x2d, 0xd3, 0x2f, 0x33, 0x4c,
 	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x03, 0x8b, 0x0b, 0x49,
 	0x40, 0x95, 0xe9, 0xc1, 0x94, 0xe9, 0x41, 0x95, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x45,
 	0xf5, 0x41, 0x2c, 0x88, 0x02, 0x29, 0xc9, 0x64, 0xb0, 0x86, 0x78, 0x88, 0x04, 0x84, 0x03, 0x91,
-	0x52, 0x8a, 0xe3, 0x62, 0x0b, 0x00, 0x1b, 0x2d, 0xa4, 0xc3, 0xc5, 0x9e, 0x9a, 0x97, 0x98, 0x94,
-	0x93, 0x9a, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe1, 0x24, 0xf4, 0xe9, 0x9e, 0x3c, 0x5f, 0x65,
-	0x62, 0x6e, 0x8e, 0x95, 0x12, 0x54, 0x42, 0x29, 0x08, 0xa6, 0x44, 0x48, 0x8d, 0x8b, 0x35, 0x31,
-	0x25, 0x37, 0x33, 0x4f, 0x82, 0x49, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xe0, 0xd3, 0x3d, 0x79, 0x1e,
-	0x88, 0x5a, 0xb0, 0xb0, 0x52, 0x10, 0x44, 0xda, 0xc9, 0xef, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0xa1, 0xfe, 0xd1, 0xcd, 0x49, 0x4c, 0x2a, 0x86, 0x71, 0xf4, 0xcb, 0x8c, 0x2c, 0xf5, 0x2b, 0x10,
-	0x21, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xe6, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x3e, 0xf6, 0x01, 0x16, 0x2a, 0x01, 0x00, 0x00,
+	0x52, 0x3a, 0xca, 0xc8, 0xc5, 0x16, 0x00, 0x36, 0x5b, 0x48, 0x87, 0x8b, 0x3d, 0x35, 0x2f, 0x31,
+	0x29, 0x27, 0x35, 0x45, 0x82, 0x51, 0x81, 0x51, 0x83, 0xc3, 0x49, 0xe8, 0xd3, 0x3d, 0x79, 0xbe,
+	0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0xa8, 0x84, 0x52, 0x10, 0x4c, 0x89, 0x90, 0x1a, 0x17, 0x6b,
+	0x62, 0x4a, 0x6e, 0x66, 0x9e, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc0, 0xa7, 0x7b, 0xf2,
+	0x3c, 0x10, 0xb5, 0x60, 0x61, 0xa5, 0x20, 0x88, 0xb4, 0x50, 0x2a, 0x97, 0x74, 0x72, 0x65, 0x72,
+	0x4e, 0x66, 0x72, 0x7c, 0x51, 0x7e, 0x69, 0x49, 0x6a, 0x7c, 0x71, 0x6a, 0x62, 0x51, 0x72, 0x46,
+	0x3c, 0xcc, 0x26, 0x66, 0xb0, 0x4d, 0x6a, 0x9f, 0xee, 0xc9, 0x2b, 0x41, 0x74, 0xe3, 0x51, 0xac,
+	0x14, 0x24, 0x01, 0x91, 0x0d, 0x02, 0x49, 0x06, 0x83, 0xe5, 0x5c, 0x21, 0x52, 0x4e, 0x7e, 0x27,
+	0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c,
+	0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x92, 0x9e, 0x59, 0x92, 0x51, 0x9a,
+	0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x0d, 0x37, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47, 0xbf,
+	0xcc, 0xc8, 0x52, 0xbf, 0x02, 0x11, 0xe2, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xbe,
+	0x31, 0x60, 0x00, 0xaf, 0xd7, 0xb5, 0x6a, 0x92, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -126,6 +139,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CyclicRouteSearchEnabled {
+		i--
+		if m.CyclicRouteSearchEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -170,6 +193,9 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.CyclicRouteSearchEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -260,6 +286,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CyclicRouteSearchEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CyclicRouteSearchEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
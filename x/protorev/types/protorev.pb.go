@@ -266,6 +266,134 @@ func (m *RouteStatistics) GetRoute() []uint64 {
 	return nil
 }
 
+// PoolStatistics contains the number of trades the module has executed on
+// routes that include a given pool and the profits from the trades
+type PoolStatistics struct {
+	// pool_id is the id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// profits is the total profit from all trades on routes that include this
+	// pool
+	Profits []types.Coin `protobuf:"bytes,2,rep,name=profits,proto3" json:"profits" yaml:"profits"`
+	// number_of_trades is the number of trades the module has executed on routes
+	// that include this pool
+	NumberOfTrades cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=number_of_trades,json=numberOfTrades,proto3,customtype=cosmossdk.io/math.Int" json:"number_of_trades" yaml:"number_of_trades"`
+}
+
+func (m *PoolStatistics) Reset()         { *m = PoolStatistics{} }
+func (m *PoolStatistics) String() string { return proto.CompactTextString(m) }
+func (*PoolStatistics) ProtoMessage()    {}
+func (*PoolStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{4}
+}
+func (m *PoolStatistics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolStatistics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolStatistics.Merge(m, src)
+}
+func (m *PoolStatistics) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolStatistics proto.InternalMessageInfo
+
+func (m *PoolStatistics) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolStatistics) GetProfits() []types.Coin {
+	if m != nil {
+		return m.Profits
+	}
+	return nil
+}
+
+// StatisticsWindow contains the route and pool statistics of the trades the
+// module has executed during a single day epoch
+type StatisticsWindow struct {
+	// window is the number of days since module genesis during which the trades
+	// were executed
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty" yaml:"window"`
+	// route_statistics contains the statistics of every route traded during the
+	// window
+	RouteStatistics []RouteStatistics `protobuf:"bytes,2,rep,name=route_statistics,json=routeStatistics,proto3" json:"route_statistics" yaml:"route_statistics"`
+	// pool_statistics contains the statistics of every pool traded during the
+	// window
+	PoolStatistics []PoolStatistics `protobuf:"bytes,3,rep,name=pool_statistics,json=poolStatistics,proto3" json:"pool_statistics" yaml:"pool_statistics"`
+}
+
+func (m *StatisticsWindow) Reset()         { *m = StatisticsWindow{} }
+func (m *StatisticsWindow) String() string { return proto.CompactTextString(m) }
+func (*StatisticsWindow) ProtoMessage()    {}
+func (*StatisticsWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{5}
+}
+func (m *StatisticsWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatisticsWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatisticsWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatisticsWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatisticsWindow.Merge(m, src)
+}
+func (m *StatisticsWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatisticsWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatisticsWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatisticsWindow proto.InternalMessageInfo
+
+func (m *StatisticsWindow) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *StatisticsWindow) GetRouteStatistics() []RouteStatistics {
+	if m != nil {
+		return m.RouteStatistics
+	}
+	return nil
+}
+
+func (m *StatisticsWindow) GetPoolStatistics() []PoolStatistics {
+	if m != nil {
+		return m.PoolStatistics
+	}
+	return nil
+}
+
 // PoolWeights contains the weights of all of the different pool types. This
 // distinction is made and necessary because the execution time ranges
 // significantly between the different pool types. Each weight roughly
@@ -289,7 +417,7 @@ func (m *PoolWeights) Reset()         { *m = PoolWeights{} }
 func (m *PoolWeights) String() string { return proto.CompactTextString(m) }
 func (*PoolWeights) ProtoMessage()    {}
 func (*PoolWeights) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{4}
+	return fileDescriptor_1e9f2391fd9fec01, []int{6}
 }
 func (m *PoolWeights) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -365,7 +493,7 @@ func (m *InfoByPoolType) Reset()         { *m = InfoByPoolType{} }
 func (m *InfoByPoolType) String() string { return proto.CompactTextString(m) }
 func (*InfoByPoolType) ProtoMessage()    {}
 func (*InfoByPoolType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{5}
+	return fileDescriptor_1e9f2391fd9fec01, []int{7}
 }
 func (m *InfoByPoolType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -432,7 +560,7 @@ func (m *StablePoolInfo) Reset()         { *m = StablePoolInfo{} }
 func (m *StablePoolInfo) String() string { return proto.CompactTextString(m) }
 func (*StablePoolInfo) ProtoMessage()    {}
 func (*StablePoolInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{6}
+	return fileDescriptor_1e9f2391fd9fec01, []int{8}
 }
 func (m *StablePoolInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,7 +606,7 @@ func (m *BalancerPoolInfo) Reset()         { *m = BalancerPoolInfo{} }
 func (m *BalancerPoolInfo) String() string { return proto.CompactTextString(m) }
 func (*BalancerPoolInfo) ProtoMessage()    {}
 func (*BalancerPoolInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{7}
+	return fileDescriptor_1e9f2391fd9fec01, []int{9}
 }
 func (m *BalancerPoolInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +655,7 @@ func (m *ConcentratedPoolInfo) Reset()         { *m = ConcentratedPoolInfo{} }
 func (m *ConcentratedPoolInfo) String() string { return proto.CompactTextString(m) }
 func (*ConcentratedPoolInfo) ProtoMessage()    {}
 func (*ConcentratedPoolInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{8}
+	return fileDescriptor_1e9f2391fd9fec01, []int{10}
 }
 func (m *ConcentratedPoolInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +708,7 @@ func (m *CosmwasmPoolInfo) Reset()         { *m = CosmwasmPoolInfo{} }
 func (m *CosmwasmPoolInfo) String() string { return proto.CompactTextString(m) }
 func (*CosmwasmPoolInfo) ProtoMessage()    {}
 func (*CosmwasmPoolInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{9}
+	return fileDescriptor_1e9f2391fd9fec01, []int{11}
 }
 func (m *CosmwasmPoolInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,7 +757,7 @@ func (m *WeightMap) Reset()         { *m = WeightMap{} }
 func (m *WeightMap) String() string { return proto.CompactTextString(m) }
 func (*WeightMap) ProtoMessage()    {}
 func (*WeightMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{10}
+	return fileDescriptor_1e9f2391fd9fec01, []int{12}
 }
 func (m *WeightMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +815,7 @@ func (m *BaseDenom) Reset()         { *m = BaseDenom{} }
 func (m *BaseDenom) String() string { return proto.CompactTextString(m) }
 func (*BaseDenom) ProtoMessage()    {}
 func (*BaseDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{11}
+	return fileDescriptor_1e9f2391fd9fec01, []int{13}
 }
 func (m *BaseDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +861,7 @@ func (m *BaseDenoms) Reset()         { *m = BaseDenoms{} }
 func (m *BaseDenoms) String() string { return proto.CompactTextString(m) }
 func (*BaseDenoms) ProtoMessage()    {}
 func (*BaseDenoms) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{12}
+	return fileDescriptor_1e9f2391fd9fec01, []int{14}
 }
 func (m *BaseDenoms) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,7 +908,7 @@ func (m *AllProtocolRevenue) Reset()         { *m = AllProtocolRevenue{} }
 func (m *AllProtocolRevenue) String() string { return proto.CompactTextString(m) }
 func (*AllProtocolRevenue) ProtoMessage()    {}
 func (*AllProtocolRevenue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{13}
+	return fileDescriptor_1e9f2391fd9fec01, []int{15}
 }
 func (m *AllProtocolRevenue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -840,7 +968,7 @@ func (m *CyclicArbTracker) Reset()         { *m = CyclicArbTracker{} }
 func (m *CyclicArbTracker) String() string { return proto.CompactTextString(m) }
 func (*CyclicArbTracker) ProtoMessage()    {}
 func (*CyclicArbTracker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{14}
+	return fileDescriptor_1e9f2391fd9fec01, []int{16}
 }
 func (m *CyclicArbTracker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -888,6 +1016,8 @@ func init() {
 	proto.RegisterType((*Route)(nil), "osmosis.protorev.v1beta1.Route")
 	proto.RegisterType((*Trade)(nil), "osmosis.protorev.v1beta1.Trade")
 	proto.RegisterType((*RouteStatistics)(nil), "osmosis.protorev.v1beta1.RouteStatistics")
+	proto.RegisterType((*PoolStatistics)(nil), "osmosis.protorev.v1beta1.PoolStatistics")
+	proto.RegisterType((*StatisticsWindow)(nil), "osmosis.protorev.v1beta1.StatisticsWindow")
 	proto.RegisterType((*PoolWeights)(nil), "osmosis.protorev.v1beta1.PoolWeights")
 	proto.RegisterType((*InfoByPoolType)(nil), "osmosis.protorev.v1beta1.InfoByPoolType")
 	proto.RegisterType((*StablePoolInfo)(nil), "osmosis.protorev.v1beta1.StablePoolInfo")
@@ -906,83 +1036,90 @@ func init() {
 }
 
 var fileDescriptor_1e9f2391fd9fec01 = []byte{
-	// 1218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0x5e, 0x37, 0xdb, 0x6d, 0x33, 0x69, 0x37, 0xe9, 0x74, 0xdb, 0x66, 0x53, 0x88, 0x97, 0x69,
-	0x81, 0x14, 0xd1, 0x44, 0x1b, 0x38, 0x40, 0x51, 0x91, 0xd6, 0x8b, 0x2a, 0x2a, 0x44, 0x5b, 0xcd,
-	0x46, 0xaa, 0xe0, 0x62, 0xc6, 0xce, 0x24, 0x6b, 0x25, 0xf6, 0x44, 0x9e, 0xc9, 0x36, 0x5b, 0xa4,
-	0x4a, 0x88, 0x23, 0x17, 0x2e, 0xbd, 0x71, 0xe0, 0xc6, 0x89, 0xbf, 0x81, 0x6b, 0x8f, 0x3d, 0x56,
-	0x1c, 0x2c, 0xd4, 0x5e, 0x10, 0xc7, 0xfc, 0x05, 0x68, 0x7e, 0xd8, 0x49, 0xbc, 0x4d, 0xdb, 0x95,
-	0x10, 0x37, 0xfb, 0xbd, 0xf7, 0x7d, 0xdf, 0x7b, 0xdf, 0x78, 0x26, 0x13, 0xf0, 0x3e, 0xe3, 0x21,
-	0xe3, 0x01, 0x6f, 0x8d, 0x62, 0x26, 0x58, 0x4c, 0x0f, 0x5a, 0x07, 0xdb, 0x1e, 0x15, 0x64, 0x3b,
-	0x0b, 0x34, 0xd5, 0x03, 0xac, 0x9a, 0xc2, 0x66, 0x16, 0x37, 0x85, 0xb5, 0x4d, 0x5f, 0xa5, 0x5c,
-	0x95, 0x68, 0xe9, 0x17, 0x5d, 0x55, 0xdb, 0xe8, 0xb3, 0x3e, 0xd3, 0x71, 0xf9, 0x64, 0xa2, 0x75,
-	0x5d, 0xd3, 0xf2, 0x08, 0xa7, 0x99, 0x9c, 0xcf, 0x82, 0xc8, 0xe4, 0xaf, 0x65, 0x3d, 0x31, 0x36,
-	0x0c, 0x49, 0x44, 0xfa, 0x34, 0xce, 0xea, 0xfa, 0x34, 0xa2, 0x59, 0x1b, 0xb5, 0xab, 0x69, 0xa9,
-	0x98, 0xf4, 0x28, 0xe5, 0x2f, 0xaf, 0x42, 0xcf, 0x2c, 0x00, 0x3b, 0x6c, 0x40, 0xa3, 0x7b, 0x24,
-	0x88, 0x77, 0x62, 0x0f, 0xb3, 0xb1, 0xa0, 0x1c, 0x7e, 0x03, 0x00, 0x89, 0x3d, 0x37, 0x56, 0x6f,
-	0x55, 0x6b, 0xab, 0xd0, 0x28, 0xb5, 0xed, 0xe6, 0xb2, 0x39, 0x9b, 0x0a, 0xe5, 0x6c, 0x3e, 0x49,
-	0xec, 0x95, 0x69, 0x62, 0x9f, 0x3b, 0x24, 0xe1, 0xf0, 0x06, 0x9a, 0x11, 0x20, 0x5c, 0x24, 0x19,
-	0x75, 0x13, 0x9c, 0x16, 0x52, 0xd0, 0x0d, 0xa2, 0xea, 0x89, 0x2d, 0xab, 0x51, 0x74, 0xce, 0x4f,
-	0x13, 0xbb, 0xac, 0x31, 0x69, 0x06, 0xe1, 0x53, 0xea, 0xf1, 0x76, 0x04, 0xb7, 0x41, 0x51, 0x47,
-	0xd9, 0x58, 0x54, 0x0b, 0x0a, 0xb0, 0x31, 0x4d, 0xec, 0xca, 0x3c, 0x80, 0x8d, 0x05, 0xc2, 0x9a,
-	0xf6, 0xee, 0x58, 0xdc, 0x58, 0xfd, 0xfb, 0x57, 0xdb, 0x42, 0xbf, 0x5b, 0xe0, 0xa4, 0xd2, 0x84,
-	0x77, 0xc0, 0x9a, 0x88, 0x49, 0xf7, 0x4d, 0x26, 0xe9, 0xc8, 0x3a, 0xe7, 0x82, 0x99, 0xe4, 0xac,
-	0x11, 0x51, 0x60, 0x84, 0x0d, 0x0b, 0xbc, 0x03, 0x8a, 0x5c, 0xd0, 0x91, 0xcb, 0x83, 0x87, 0xd4,
-	0xcc, 0xb0, 0x2d, 0x11, 0x7f, 0x26, 0xf6, 0x05, 0xbd, 0x80, 0xbc, 0x3b, 0x68, 0x06, 0xac, 0x15,
-	0x12, 0xb1, 0xdf, 0xbc, 0x1d, 0x89, 0x59, 0xbf, 0x19, 0x0e, 0xe1, 0xd3, 0xf2, 0x79, 0x2f, 0x78,
-	0x48, 0x4d, 0xbf, 0x8f, 0x2d, 0x70, 0x52, 0xc9, 0xc3, 0x2b, 0x60, 0x55, 0xae, 0x6f, 0xd5, 0xda,
-	0xb2, 0x1a, 0xab, 0x4e, 0x79, 0x9a, 0xd8, 0x25, 0x8d, 0x96, 0x51, 0x84, 0x55, 0xf2, 0xff, 0xf3,
-	0xf1, 0x1f, 0x0b, 0x94, 0x95, 0x8f, 0x7b, 0x82, 0x88, 0x80, 0x8b, 0xc0, 0xe7, 0xf0, 0x2b, 0x70,
-	0x6a, 0x14, 0xb3, 0x5e, 0x20, 0x52, 0x4b, 0x37, 0x9b, 0xe6, 0xeb, 0x96, 0x5f, 0x6e, 0xe6, 0xe6,
-	0x2e, 0x0b, 0x22, 0xe7, 0xa2, 0x31, 0x73, 0xdd, 0xcc, 0xa0, 0x71, 0x08, 0xa7, 0x0c, 0xd0, 0x03,
-	0x95, 0x68, 0x1c, 0x7a, 0x34, 0x76, 0x59, 0xcf, 0x35, 0x0b, 0xa5, 0x27, 0xfa, 0xe4, 0x75, 0xae,
-	0x5e, 0xd2, 0x9c, 0x79, 0x38, 0xc2, 0xeb, 0x3a, 0x74, 0xb7, 0xd7, 0xd1, 0x4b, 0xf6, 0x1e, 0x38,
-	0xa9, 0xbe, 0xc5, 0x6a, 0x61, 0xab, 0xd0, 0x58, 0x75, 0x2a, 0xd3, 0xc4, 0x3e, 0xa3, 0xb1, 0x2a,
-	0x8c, 0xb0, 0x4e, 0xa3, 0xdf, 0x4e, 0x80, 0xd2, 0x3d, 0xc6, 0x86, 0xf7, 0x69, 0xd0, 0xdf, 0x17,
-	0x1c, 0xde, 0x04, 0x67, 0xb9, 0x20, 0xde, 0x90, 0xba, 0x0f, 0x54, 0xc4, 0xac, 0x49, 0x75, 0x9a,
-	0xd8, 0x1b, 0xe9, 0x8a, 0xce, 0xa5, 0x11, 0x3e, 0xa3, 0xdf, 0x35, 0x1e, 0xee, 0x82, 0xb2, 0x47,
-	0x86, 0x24, 0xf2, 0x69, 0x9c, 0x12, 0x9c, 0x50, 0x04, 0xb5, 0x69, 0x62, 0x5f, 0xd4, 0x04, 0xb9,
-	0x02, 0x84, 0xd7, 0xd3, 0x88, 0x21, 0xb9, 0x0b, 0xce, 0xfb, 0x2c, 0xf2, 0x69, 0x24, 0x62, 0x22,
-	0x68, 0x37, 0x25, 0x2a, 0x28, 0xa2, 0xfa, 0x34, 0xb1, 0x6b, 0x9a, 0xe8, 0x25, 0x45, 0x08, 0xc3,
-	0xf9, 0xe8, 0xac, 0x2b, 0x69, 0xe8, 0x03, 0xc2, 0xc3, 0x94, 0x6c, 0x35, 0xdf, 0x55, 0xae, 0x00,
-	0xe1, 0xf5, 0x34, 0xa2, 0x49, 0xd0, 0x2f, 0x05, 0xb0, 0x7e, 0x3b, 0xea, 0x31, 0xe7, 0x50, 0xfa,
-	0xd5, 0x39, 0x1c, 0x51, 0x78, 0x1f, 0xac, 0xe9, 0xe9, 0x95, 0x4b, 0xa5, 0x76, 0x63, 0xf9, 0x3e,
-	0xdb, 0x53, 0x75, 0x12, 0xa9, 0x38, 0x72, 0x1b, 0x4e, 0xb3, 0x20, 0x6c, 0xe8, 0xa0, 0x0b, 0x4e,
-	0xa7, 0x9e, 0x28, 0xff, 0x4a, 0xed, 0x0f, 0x96, 0x53, 0x3b, 0xa6, 0x32, 0x23, 0xbf, 0x64, 0xc8,
-	0xcb, 0x8b, 0x7e, 0x23, 0x9c, 0x91, 0x42, 0x06, 0xce, 0xcc, 0xfb, 0xa4, 0xbc, 0x2d, 0xb5, 0x9b,
-	0xcb, 0x45, 0x76, 0xe7, 0xaa, 0x33, 0xa1, 0xcb, 0x46, 0xe8, 0xfc, 0xd1, 0xf5, 0x40, 0x78, 0x41,
-	0x40, 0x4e, 0x94, 0xfa, 0xa9, 0xbc, 0x7f, 0xe5, 0x44, 0xbb, 0xa6, 0x72, 0xd9, 0x44, 0x29, 0x13,
-	0xc2, 0x19, 0x29, 0xfa, 0x0c, 0xac, 0x2f, 0x7a, 0x0c, 0xaf, 0x81, 0xb5, 0x85, 0x6f, 0xf8, 0xdc,
-	0xcc, 0xef, 0x74, 0x8d, 0x4d, 0x01, 0xba, 0x09, 0x2a, 0x79, 0x17, 0x8f, 0x03, 0xff, 0xc9, 0x02,
-	0x1b, 0x2f, 0x33, 0xe8, 0x18, 0x1c, 0xf0, 0x4b, 0x70, 0x2e, 0x24, 0x13, 0x57, 0x04, 0xfe, 0x80,
-	0xbb, 0x7e, 0xcc, 0x38, 0xa7, 0x5d, 0xb3, 0x77, 0xde, 0x9a, 0x26, 0x76, 0x55, 0xa3, 0x8e, 0x94,
-	0x20, 0x5c, 0x0e, 0xc9, 0xa4, 0x23, 0x43, 0xbb, 0x26, 0x22, 0x40, 0x25, 0x6f, 0x20, 0xfc, 0x0e,
-	0x94, 0xb4, 0x8e, 0x1b, 0x92, 0x51, 0x7a, 0x86, 0x5d, 0x59, 0xbe, 0x02, 0xfa, 0x9b, 0xff, 0x9a,
-	0x8c, 0x9c, 0x9a, 0xb1, 0x1e, 0xce, 0xb7, 0xad, 0x58, 0x10, 0x06, 0x0f, 0xd2, 0x32, 0x8e, 0x1e,
-	0x81, 0x62, 0x06, 0x3a, 0xce, 0xdc, 0xb7, 0x40, 0xc5, 0x67, 0xd2, 0x37, 0x5f, 0xb8, 0xa4, 0xdb,
-	0x8d, 0x29, 0x4f, 0x0f, 0xc3, 0xcb, 0xb3, 0xf3, 0x2e, 0x5f, 0x81, 0x70, 0x39, 0x0d, 0xed, 0x98,
-	0xc8, 0x8f, 0x16, 0x28, 0x3a, 0x84, 0xd3, 0x2f, 0x68, 0xc4, 0x42, 0x79, 0xfc, 0x75, 0xe5, 0x83,
-	0xd2, 0x2f, 0xce, 0x1f, 0x7f, 0x2a, 0x8c, 0xb0, 0x4e, 0xff, 0xd7, 0xbf, 0x6c, 0x28, 0x02, 0x20,
-	0x6b, 0x82, 0x4b, 0xd7, 0xe5, 0xcf, 0x83, 0xab, 0xb4, 0xde, 0xc0, 0xf5, 0x0c, 0x9a, 0x77, 0x7d,
-	0x8e, 0x05, 0x61, 0xe0, 0x65, 0x0a, 0xe8, 0x71, 0x01, 0xc0, 0x9d, 0xe1, 0xf0, 0x9e, 0x64, 0xf2,
-	0xd9, 0x10, 0xd3, 0x03, 0x1a, 0x8d, 0x29, 0x7c, 0x04, 0xa0, 0x20, 0x03, 0x1a, 0xbb, 0xf2, 0x26,
-	0x24, 0x7f, 0x23, 0xfc, 0x01, 0x8d, 0xcd, 0x21, 0x75, 0x7d, 0xa6, 0x3f, 0xbb, 0x53, 0xcd, 0xee,
-	0x03, 0x12, 0x76, 0x8b, 0x52, 0xde, 0xd1, 0x20, 0xe7, 0x1d, 0xd3, 0xc9, 0xa6, 0xf9, 0xdd, 0x3c,
-	0x42, 0x8b, 0x70, 0x45, 0xe4, 0x40, 0xf0, 0x07, 0x0b, 0x94, 0xc5, 0x64, 0x51, 0x5d, 0x9f, 0x63,
-	0xef, 0x66, 0xea, 0xfa, 0x9a, 0x36, 0x13, 0x9e, 0xcc, 0xab, 0xb6, 0x8d, 0x6a, 0xc3, 0xa8, 0x2e,
-	0x72, 0xa1, 0x0f, 0xbb, 0x74, 0x14, 0x53, 0x5f, 0xee, 0x35, 0x79, 0x5b, 0x19, 0x53, 0x54, 0xb5,
-	0xf0, 0x59, 0x31, 0x4f, 0x01, 0xbf, 0x07, 0xd0, 0x3f, 0xf4, 0x87, 0x81, 0xef, 0xca, 0x8b, 0x59,
-	0xda, 0x45, 0xe1, 0xb5, 0x67, 0x8f, 0xc2, 0xec, 0xc4, 0xde, 0x12, 0x03, 0x8e, 0x72, 0x22, 0x5c,
-	0xf1, 0x73, 0x20, 0xf4, 0x87, 0x05, 0x2a, 0x79, 0x26, 0xf8, 0x39, 0x00, 0x33, 0xf4, 0xeb, 0xef,
-	0x11, 0xab, 0x52, 0x18, 0x17, 0x33, 0x6e, 0x38, 0x00, 0x6f, 0xef, 0xeb, 0xed, 0x47, 0x7c, 0x9f,
-	0x8d, 0x23, 0x11, 0x44, 0x7d, 0x97, 0x0b, 0x12, 0x0b, 0xee, 0xf6, 0x62, 0x16, 0x2a, 0x8b, 0x0b,
-	0x4e, 0x63, 0x9a, 0xd8, 0x57, 0x75, 0xb3, 0xaf, 0x2c, 0x47, 0xb8, 0xa6, 0xf3, 0x3b, 0x59, 0x7a,
-	0x4f, 0x65, 0x6f, 0xc5, 0x2c, 0x74, 0xee, 0x3c, 0x79, 0x5e, 0xb7, 0x9e, 0x3e, 0xaf, 0x5b, 0x7f,
-	0x3d, 0xaf, 0x5b, 0x3f, 0xbf, 0xa8, 0xaf, 0x3c, 0x7d, 0x51, 0x5f, 0x79, 0xf6, 0xa2, 0xbe, 0xf2,
-	0xed, 0xc7, 0xfd, 0x40, 0xec, 0x8f, 0xbd, 0xa6, 0xcf, 0xc2, 0x96, 0xb1, 0xf1, 0xfa, 0x90, 0x78,
-	0x3c, 0x7d, 0x69, 0x1d, 0xb4, 0x3f, 0x6d, 0x4d, 0x66, 0xff, 0x22, 0xc4, 0xe1, 0x88, 0x72, 0x6f,
-	0x4d, 0xbd, 0x7f, 0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x85, 0x27, 0x41, 0x42, 0x66, 0x0c,
+	// 1330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x3b, 0x6f, 0x1b, 0xc7,
+	0x16, 0xd6, 0x8a, 0xb4, 0x6c, 0x8e, 0x6c, 0x92, 0x1e, 0xcb, 0x36, 0x45, 0xdf, 0xcb, 0xd5, 0x1d,
+	0xfb, 0xde, 0x4b, 0x27, 0x31, 0x09, 0x29, 0x29, 0x12, 0x07, 0x0e, 0xa0, 0x55, 0x60, 0x44, 0x08,
+	0x62, 0x1b, 0x23, 0x01, 0x46, 0xd2, 0x6c, 0x66, 0x97, 0x23, 0x6a, 0x21, 0xee, 0x0e, 0xb3, 0x33,
+	0xd4, 0xc3, 0x01, 0x0c, 0x04, 0x29, 0xd3, 0xa4, 0x71, 0x97, 0x22, 0x5d, 0xaa, 0xfc, 0x86, 0xb4,
+	0x2e, 0x5d, 0x1a, 0x29, 0x88, 0xc0, 0x6e, 0x82, 0x14, 0x29, 0xf8, 0x0b, 0x82, 0x79, 0xec, 0x83,
+	0x2b, 0xd3, 0xb2, 0x01, 0x27, 0xdd, 0xf0, 0x3c, 0xbe, 0xef, 0x9c, 0xef, 0xcc, 0xec, 0x0c, 0xc1,
+	0xff, 0x19, 0x0f, 0x19, 0x0f, 0x78, 0x77, 0x18, 0x33, 0xc1, 0x62, 0xba, 0xdf, 0xdd, 0x5f, 0xf5,
+	0xa8, 0x20, 0xab, 0xa9, 0xa1, 0xa3, 0x16, 0xb0, 0x61, 0x02, 0x3b, 0xa9, 0xdd, 0x04, 0x36, 0x97,
+	0x7d, 0xe5, 0x72, 0x95, 0xa3, 0xab, 0x7f, 0xe8, 0xa8, 0xe6, 0x52, 0x9f, 0xf5, 0x99, 0xb6, 0xcb,
+	0x95, 0xb1, 0xb6, 0x74, 0x4c, 0xd7, 0x23, 0x9c, 0xa6, 0x74, 0x3e, 0x0b, 0x22, 0xe3, 0xbf, 0x9e,
+	0xd6, 0xc4, 0xd8, 0x20, 0x24, 0x11, 0xe9, 0xd3, 0x38, 0x8d, 0xeb, 0xd3, 0x88, 0xa6, 0x65, 0x34,
+	0xaf, 0x25, 0xa1, 0xe2, 0x70, 0x87, 0x52, 0xfe, 0xe2, 0x28, 0xf4, 0xd4, 0x02, 0x70, 0x9b, 0xed,
+	0xd1, 0xe8, 0x1e, 0x09, 0xe2, 0xf5, 0xd8, 0xc3, 0x6c, 0x24, 0x28, 0x87, 0x9f, 0x03, 0x40, 0x62,
+	0xcf, 0x8d, 0xd5, 0xaf, 0x86, 0xb5, 0x52, 0x6a, 0x2f, 0xae, 0xd9, 0x9d, 0x59, 0x7d, 0x76, 0x54,
+	0x96, 0xb3, 0xfc, 0x78, 0x6c, 0xcf, 0x4d, 0xc6, 0xf6, 0xf9, 0x23, 0x12, 0x0e, 0x6e, 0xa2, 0x0c,
+	0x00, 0xe1, 0x0a, 0x49, 0xa1, 0x3b, 0xe0, 0x8c, 0x90, 0x84, 0x6e, 0x10, 0x35, 0xe6, 0x57, 0xac,
+	0x76, 0xc5, 0xb9, 0x30, 0x19, 0xdb, 0x35, 0x9d, 0x93, 0x78, 0x10, 0x3e, 0xad, 0x96, 0x9b, 0x11,
+	0x5c, 0x05, 0x15, 0x6d, 0x65, 0x23, 0xd1, 0x28, 0xa9, 0x84, 0xa5, 0xc9, 0xd8, 0xae, 0xe7, 0x13,
+	0xd8, 0x48, 0x20, 0xac, 0x61, 0xef, 0x8e, 0xc4, 0xcd, 0xf2, 0xef, 0x3f, 0xda, 0x16, 0xfa, 0xd9,
+	0x02, 0xa7, 0x14, 0x27, 0xbc, 0x03, 0x16, 0x44, 0x4c, 0x7a, 0xaf, 0xd2, 0xc9, 0xb6, 0x8c, 0x73,
+	0x2e, 0x9a, 0x4e, 0xce, 0x19, 0x12, 0x95, 0x8c, 0xb0, 0x41, 0x81, 0x77, 0x40, 0x85, 0x0b, 0x3a,
+	0x74, 0x79, 0xf0, 0x80, 0x9a, 0x1e, 0x56, 0x65, 0xc6, 0xaf, 0x63, 0xfb, 0xa2, 0x1e, 0x20, 0xef,
+	0xed, 0x75, 0x02, 0xd6, 0x0d, 0x89, 0xd8, 0xed, 0x6c, 0x46, 0x22, 0xab, 0x37, 0xcd, 0x43, 0xf8,
+	0x8c, 0x5c, 0x6f, 0x05, 0x0f, 0xa8, 0xa9, 0xf7, 0x91, 0x05, 0x4e, 0x29, 0x7a, 0x78, 0x15, 0x94,
+	0xe5, 0x7c, 0x1b, 0xd6, 0x8a, 0xd5, 0x2e, 0x3b, 0xb5, 0xc9, 0xd8, 0x5e, 0xd4, 0xd9, 0xd2, 0x8a,
+	0xb0, 0x72, 0xfe, 0x73, 0x3a, 0xfe, 0x61, 0x81, 0x9a, 0xd2, 0x71, 0x4b, 0x10, 0x11, 0x70, 0x11,
+	0xf8, 0x1c, 0x7e, 0x0a, 0x4e, 0x0f, 0x63, 0xb6, 0x13, 0x88, 0x44, 0xd2, 0xe5, 0x8e, 0xd9, 0xdd,
+	0x72, 0xe7, 0xa6, 0x6a, 0x6e, 0xb0, 0x20, 0x72, 0x2e, 0x19, 0x31, 0xab, 0xa6, 0x07, 0x9d, 0x87,
+	0x70, 0x82, 0x00, 0x3d, 0x50, 0x8f, 0x46, 0xa1, 0x47, 0x63, 0x97, 0xed, 0xb8, 0x66, 0x50, 0xba,
+	0xa3, 0xf7, 0x4f, 0x52, 0xf5, 0xb2, 0xc6, 0x2c, 0xa6, 0x23, 0x5c, 0xd5, 0xa6, 0xbb, 0x3b, 0xdb,
+	0x7a, 0x64, 0xff, 0x03, 0xa7, 0xd4, 0x5e, 0x6c, 0x94, 0x56, 0x4a, 0xed, 0xb2, 0x53, 0x9f, 0x8c,
+	0xed, 0xb3, 0x3a, 0x57, 0x99, 0x11, 0xd6, 0x6e, 0xf4, 0xa7, 0x05, 0xaa, 0xf7, 0x18, 0x1b, 0xe4,
+	0x7a, 0x7d, 0x1b, 0x9c, 0x96, 0x82, 0xbb, 0x41, 0xcf, 0x0c, 0x04, 0xe6, 0x9a, 0xd1, 0x0e, 0x84,
+	0x17, 0xe4, 0x6a, 0xb3, 0x97, 0x17, 0x66, 0xfe, 0x6f, 0x11, 0xa6, 0xf4, 0x66, 0x85, 0x41, 0x3f,
+	0xcd, 0x83, 0x7a, 0xd6, 0xec, 0xfd, 0x20, 0xea, 0xb1, 0x03, 0x78, 0x1d, 0x2c, 0x1c, 0xa8, 0x95,
+	0xe9, 0xf8, 0x7c, 0x76, 0x16, 0xb4, 0x1d, 0x61, 0x13, 0x00, 0x47, 0xa0, 0xae, 0x94, 0x73, 0x79,
+	0x0a, 0x62, 0x3a, 0xbf, 0x7e, 0xc2, 0xf7, 0x22, 0x63, 0x75, 0x6c, 0xa3, 0xc4, 0xe5, 0xdc, 0x48,
+	0x72, 0x80, 0x08, 0xd7, 0xe2, 0xc2, 0x06, 0xfc, 0x0a, 0xd4, 0x94, 0xf6, 0x39, 0xd6, 0x92, 0x62,
+	0x6d, 0xcf, 0x66, 0x9d, 0x9e, 0xab, 0xd3, 0x32, 0xa4, 0x97, 0x72, 0xa3, 0xcc, 0x73, 0x56, 0x87,
+	0x53, 0xf1, 0x52, 0xa9, 0x45, 0x09, 0x71, 0x9f, 0x06, 0xfd, 0x5d, 0xc1, 0xe1, 0x2d, 0x70, 0x8e,
+	0x0b, 0xe2, 0x0d, 0xa8, 0x7b, 0xa0, 0x2c, 0x46, 0xab, 0xc6, 0x64, 0x6c, 0x2f, 0x25, 0x87, 0x3d,
+	0xe7, 0x46, 0xf8, 0xac, 0xfe, 0xad, 0xf3, 0xe1, 0x06, 0xa8, 0x79, 0x64, 0x40, 0x22, 0x9f, 0xc6,
+	0x09, 0xc0, 0xbc, 0x02, 0x68, 0x66, 0x35, 0x15, 0x02, 0x10, 0xae, 0x26, 0x16, 0x03, 0x72, 0x17,
+	0x5c, 0xf0, 0x59, 0xe4, 0xd3, 0x48, 0xc4, 0x44, 0xd0, 0x5e, 0x02, 0x54, 0x52, 0x40, 0xad, 0xc9,
+	0xd8, 0x6e, 0x6a, 0xa0, 0x17, 0x04, 0x21, 0x0c, 0xf3, 0xd6, 0xac, 0x2a, 0xb9, 0xa5, 0x0e, 0x08,
+	0x0f, 0x13, 0xb0, 0x72, 0xb1, 0xaa, 0x42, 0x00, 0xc2, 0xd5, 0xc4, 0xa2, 0x41, 0xd0, 0x0f, 0x25,
+	0x50, 0xdd, 0x8c, 0x76, 0x98, 0x73, 0x24, 0xf5, 0xda, 0x3e, 0x1a, 0x52, 0x78, 0x1f, 0x2c, 0xe8,
+	0xee, 0x95, 0x4a, 0x2f, 0x1d, 0xd3, 0x96, 0x8a, 0x93, 0x99, 0x0a, 0xa3, 0xf0, 0x2d, 0xd6, 0x28,
+	0x08, 0x1b, 0x38, 0xe8, 0x82, 0x33, 0x89, 0x26, 0x4a, 0xbf, 0xc5, 0xb5, 0xb7, 0x66, 0x43, 0x3b,
+	0x26, 0x32, 0x05, 0xbf, 0x6c, 0xc0, 0x6b, 0xd3, 0x7a, 0x23, 0x9c, 0x82, 0x42, 0x06, 0xce, 0xe6,
+	0x75, 0x52, 0xda, 0x2e, 0xae, 0x75, 0x66, 0x93, 0x6c, 0xe4, 0xa2, 0x53, 0xa2, 0x2b, 0x86, 0xe8,
+	0xc2, 0xf1, 0x79, 0x20, 0x3c, 0x45, 0x20, 0x3b, 0x4a, 0xf4, 0x6c, 0x94, 0x4f, 0xea, 0x68, 0xc3,
+	0x44, 0xce, 0xea, 0x28, 0x41, 0x42, 0x38, 0x05, 0x45, 0x1f, 0x82, 0xea, 0xb4, 0xc6, 0xea, 0xbc,
+	0xe7, 0xf7, 0x70, 0xfe, 0xbc, 0x9b, 0x19, 0x9b, 0x00, 0x74, 0x0b, 0xd4, 0x8b, 0x2a, 0xbe, 0x4e,
+	0xfa, 0x77, 0x16, 0x58, 0x7a, 0x91, 0x40, 0xaf, 0x81, 0x01, 0x3f, 0x01, 0xe7, 0x43, 0x72, 0xe8,
+	0x8a, 0xc0, 0xdf, 0xe3, 0xae, 0x1f, 0x33, 0xce, 0x69, 0xcf, 0x9c, 0x9d, 0x7f, 0x4d, 0xc6, 0x76,
+	0x43, 0x67, 0x1d, 0x0b, 0x41, 0xb8, 0x16, 0x92, 0xc3, 0x6d, 0x69, 0xda, 0x30, 0x16, 0x01, 0xea,
+	0x45, 0x01, 0xe1, 0x97, 0x60, 0x51, 0xf3, 0xb8, 0x21, 0x19, 0x26, 0xd7, 0xdb, 0xd5, 0xd9, 0x13,
+	0xd0, 0x7b, 0xfe, 0x33, 0x32, 0x74, 0x9a, 0x46, 0x7a, 0x98, 0x2f, 0x5b, 0xa1, 0x20, 0x0c, 0x0e,
+	0x92, 0x30, 0x8e, 0x1e, 0x82, 0x4a, 0x9a, 0xf4, 0x3a, 0x7d, 0xdf, 0x06, 0x75, 0x9f, 0x49, 0xdd,
+	0x7c, 0xe1, 0x92, 0x5e, 0x2f, 0xa6, 0x3c, 0xb9, 0x27, 0xaf, 0x64, 0xdf, 0xce, 0x62, 0x04, 0xc2,
+	0xb5, 0xc4, 0xb4, 0x6e, 0x2c, 0xdf, 0x5a, 0xa0, 0xe2, 0x10, 0x4e, 0x3f, 0xa6, 0x11, 0x0b, 0xe5,
+	0xcd, 0xd8, 0x93, 0x0b, 0xc5, 0x5f, 0xc9, 0xdf, 0x8c, 0xca, 0x8c, 0xb0, 0x76, 0xbf, 0xe9, 0x47,
+	0x0f, 0x8a, 0x00, 0x48, 0x8b, 0xe0, 0x52, 0x75, 0x79, 0x41, 0xba, 0x8a, 0xeb, 0x15, 0x54, 0x4f,
+	0x53, 0x8b, 0xaa, 0xe7, 0x50, 0x10, 0x06, 0x5e, 0xca, 0x80, 0x1e, 0x95, 0x00, 0x5c, 0x1f, 0x0c,
+	0xee, 0x49, 0x24, 0x9f, 0x0d, 0x30, 0xdd, 0xa7, 0xd1, 0x88, 0xc2, 0x87, 0x00, 0x0a, 0xb2, 0x47,
+	0x63, 0x57, 0x3e, 0x92, 0xe5, 0x2d, 0xe9, 0xef, 0xd1, 0xd8, 0x7c, 0xa4, 0x6e, 0x64, 0xfc, 0xd9,
+	0x73, 0x3b, 0x7b, 0x2a, 0xca, 0xb4, 0xdb, 0x94, 0xf2, 0x6d, 0x9d, 0xe4, 0xfc, 0xc7, 0x54, 0xb2,
+	0xac, 0x2b, 0x39, 0x0e, 0x8b, 0x70, 0x5d, 0x14, 0x92, 0xe0, 0x37, 0x16, 0xa8, 0x89, 0xc3, 0x69,
+	0x76, 0xfd, 0x1d, 0xfb, 0x6f, 0xca, 0xae, 0x5f, 0xf0, 0x19, 0xf1, 0x61, 0x9e, 0x75, 0xcd, 0xb0,
+	0xb6, 0x0d, 0xeb, 0x34, 0x16, 0x7a, 0xa7, 0x47, 0x87, 0x31, 0xf5, 0xe5, 0x59, 0x93, 0x0f, 0xd9,
+	0x11, 0x45, 0x0d, 0x0b, 0x9f, 0x13, 0x79, 0x08, 0xf8, 0x35, 0x80, 0xfe, 0x91, 0x3f, 0x08, 0x7c,
+	0x57, 0xbe, 0xd9, 0x93, 0x2a, 0x4a, 0x27, 0x7e, 0x7b, 0x54, 0xce, 0x7a, 0xec, 0xcd, 0x10, 0xe0,
+	0x38, 0x26, 0xc2, 0x75, 0xbf, 0x90, 0x84, 0x7e, 0xb1, 0x40, 0xbd, 0x88, 0x04, 0x3f, 0x02, 0x20,
+	0xcb, 0x3e, 0xf9, 0x89, 0x59, 0x96, 0xc4, 0xb8, 0x92, 0x62, 0xc3, 0x3d, 0xf0, 0xef, 0x5d, 0x7d,
+	0xfc, 0x88, 0xef, 0xb3, 0x51, 0x24, 0x82, 0xa8, 0x2f, 0x2f, 0xf7, 0x58, 0x70, 0x77, 0x27, 0x66,
+	0xa1, 0x92, 0xb8, 0xe4, 0xb4, 0x27, 0x63, 0xfb, 0x9a, 0x2e, 0xf6, 0xa5, 0xe1, 0x08, 0x37, 0xb5,
+	0x7f, 0x3d, 0x75, 0x6f, 0x29, 0xef, 0xed, 0x98, 0x85, 0xce, 0x9d, 0xc7, 0xcf, 0x5a, 0xd6, 0x93,
+	0x67, 0x2d, 0xeb, 0xb7, 0x67, 0x2d, 0xeb, 0xfb, 0xe7, 0xad, 0xb9, 0x27, 0xcf, 0x5b, 0x73, 0x4f,
+	0x9f, 0xb7, 0xe6, 0xbe, 0x78, 0xaf, 0x1f, 0x88, 0xdd, 0x91, 0xd7, 0xf1, 0x59, 0xd8, 0x35, 0x32,
+	0xde, 0x18, 0x10, 0x8f, 0x27, 0x3f, 0xba, 0xfb, 0x6b, 0x1f, 0x74, 0x0f, 0xb3, 0x3f, 0x98, 0xe2,
+	0x68, 0x48, 0xb9, 0xb7, 0xa0, 0x7e, 0xbf, 0xfb, 0xd7, 0x00, 0x42, 0xc9, 0x90, 0xb6, 0x81, 0x0e,
 	0x00, 0x00,
 }
 
@@ -1288,6 +1425,114 @@ func (m *RouteStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolStatistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolStatistics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NumberOfTrades.Size()
+		i -= size
+		if _, err := m.NumberOfTrades.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Profits) > 0 {
+		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatisticsWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatisticsWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatisticsWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolStatistics) > 0 {
+		for iNdEx := len(m.PoolStatistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolStatistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RouteStatistics) > 0 {
+		for iNdEx := len(m.RouteStatistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RouteStatistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Window != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolWeights) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1822,6 +2067,50 @@ func (m *RouteStatistics) Size() (n int) {
 	return n
 }
 
+func (m *PoolStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovProtorev(uint64(m.PoolId))
+	}
+	if len(m.Profits) > 0 {
+		for _, e := range m.Profits {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	l = m.NumberOfTrades.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	return n
+}
+
+func (m *StatisticsWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovProtorev(uint64(m.Window))
+	}
+	if len(m.RouteStatistics) > 0 {
+		for _, e := range m.RouteStatistics {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	if len(m.PoolStatistics) > 0 {
+		for _, e := range m.PoolStatistics {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *PoolWeights) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2592,6 +2881,280 @@ func (m *RouteStatistics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolStatistics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolStatistics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profits = append(m.Profits, types.Coin{})
+			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatisticsWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatisticsWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatisticsWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteStatistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RouteStatistics = append(m.RouteStatistics, RouteStatistics{})
+			if err := m.RouteStatistics[len(m.RouteStatistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolStatistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolStatistics = append(m.PoolStatistics, PoolStatistics{})
+			if err := m.PoolStatistics[len(m.PoolStatistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PoolWeights) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8,7 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -571,6 +571,127 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) GetStatistics() []RouteStat
 	return nil
 }
 
+// QueryGetProtoRevStatisticsWindowsRequest is request type for the
+// Query/GetProtoRevStatisticsWindows RPC method.
+type QueryGetProtoRevStatisticsWindowsRequest struct {
+	// num_windows is the number of most recent windows to query. All of the
+	// retained windows are returned if it is zero
+	NumWindows uint64 `protobuf:"varint,1,opt,name=num_windows,json=numWindows,proto3" json:"num_windows,omitempty" yaml:"num_windows"`
+	// pagination defines an optional pagination for the request, over the
+	// queried windows
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetProtoRevStatisticsWindowsRequest) Reset() {
+	*m = QueryGetProtoRevStatisticsWindowsRequest{}
+}
+func (m *QueryGetProtoRevStatisticsWindowsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevStatisticsWindowsRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevStatisticsWindowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{12}
+}
+func (m *QueryGetProtoRevStatisticsWindowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevStatisticsWindowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevStatisticsWindowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevStatisticsWindowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevStatisticsWindowsRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevStatisticsWindowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevStatisticsWindowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevStatisticsWindowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevStatisticsWindowsRequest proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevStatisticsWindowsRequest) GetNumWindows() uint64 {
+	if m != nil {
+		return m.NumWindows
+	}
+	return 0
+}
+
+func (m *QueryGetProtoRevStatisticsWindowsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetProtoRevStatisticsWindowsResponse is response type for the
+// Query/GetProtoRevStatisticsWindows RPC method.
+type QueryGetProtoRevStatisticsWindowsResponse struct {
+	// windows contains the route and pool statistics of every queried window
+	// ordered from the oldest to the most recent window
+	Windows []StatisticsWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows" yaml:"windows"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetProtoRevStatisticsWindowsResponse) Reset() {
+	*m = QueryGetProtoRevStatisticsWindowsResponse{}
+}
+func (m *QueryGetProtoRevStatisticsWindowsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevStatisticsWindowsResponse) ProtoMessage() {}
+func (*QueryGetProtoRevStatisticsWindowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{13}
+}
+func (m *QueryGetProtoRevStatisticsWindowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevStatisticsWindowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevStatisticsWindowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevStatisticsWindowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevStatisticsWindowsResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevStatisticsWindowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevStatisticsWindowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevStatisticsWindowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevStatisticsWindowsResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevStatisticsWindowsResponse) GetWindows() []StatisticsWindow {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+func (m *QueryGetProtoRevStatisticsWindowsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryGetProtoRevTokenPairArbRoutesRequest is request type for the
 // Query/GetProtoRevTokenPairArbRoutes RPC method.
 type QueryGetProtoRevTokenPairArbRoutesRequest struct {
@@ -584,7 +705,7 @@ func (m *QueryGetProtoRevTokenPairArbRoutesRequest) String() string {
 }
 func (*QueryGetProtoRevTokenPairArbRoutesRequest) ProtoMessage() {}
 func (*QueryGetProtoRevTokenPairArbRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{12}
+	return fileDescriptor_f5e7ac9973cce389, []int{14}
 }
 func (m *QueryGetProtoRevTokenPairArbRoutesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,7 +750,7 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) String() string {
 }
 func (*QueryGetProtoRevTokenPairArbRoutesResponse) ProtoMessage() {}
 func (*QueryGetProtoRevTokenPairArbRoutesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{13}
+	return fileDescriptor_f5e7ac9973cce389, []int{15}
 }
 func (m *QueryGetProtoRevTokenPairArbRoutesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +795,7 @@ func (m *QueryGetProtoRevAdminAccountRequest) Reset()         { *m = QueryGetPro
 func (m *QueryGetProtoRevAdminAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevAdminAccountRequest) ProtoMessage()    {}
 func (*QueryGetProtoRevAdminAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{14}
+	return fileDescriptor_f5e7ac9973cce389, []int{16}
 }
 func (m *QueryGetProtoRevAdminAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -714,7 +835,7 @@ func (m *QueryGetProtoRevAdminAccountResponse) Reset()         { *m = QueryGetPr
 func (m *QueryGetProtoRevAdminAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevAdminAccountResponse) ProtoMessage()    {}
 func (*QueryGetProtoRevAdminAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{15}
+	return fileDescriptor_f5e7ac9973cce389, []int{17}
 }
 func (m *QueryGetProtoRevAdminAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,7 +882,7 @@ func (m *QueryGetProtoRevDeveloperAccountRequest) Reset() {
 func (m *QueryGetProtoRevDeveloperAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevDeveloperAccountRequest) ProtoMessage()    {}
 func (*QueryGetProtoRevDeveloperAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{16}
+	return fileDescriptor_f5e7ac9973cce389, []int{18}
 }
 func (m *QueryGetProtoRevDeveloperAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +924,7 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Reset() {
 func (m *QueryGetProtoRevDeveloperAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevDeveloperAccountResponse) ProtoMessage()    {}
 func (*QueryGetProtoRevDeveloperAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{17}
+	return fileDescriptor_f5e7ac9973cce389, []int{19}
 }
 func (m *QueryGetProtoRevDeveloperAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -848,7 +969,7 @@ func (m *QueryGetProtoRevInfoByPoolTypeRequest) Reset()         { *m = QueryGetP
 func (m *QueryGetProtoRevInfoByPoolTypeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevInfoByPoolTypeRequest) ProtoMessage()    {}
 func (*QueryGetProtoRevInfoByPoolTypeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{18}
+	return fileDescriptor_f5e7ac9973cce389, []int{20}
 }
 func (m *QueryGetProtoRevInfoByPoolTypeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +1012,7 @@ func (m *QueryGetProtoRevInfoByPoolTypeResponse) Reset() {
 func (m *QueryGetProtoRevInfoByPoolTypeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevInfoByPoolTypeResponse) ProtoMessage()    {}
 func (*QueryGetProtoRevInfoByPoolTypeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{19}
+	return fileDescriptor_f5e7ac9973cce389, []int{21}
 }
 func (m *QueryGetProtoRevInfoByPoolTypeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +1061,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) String() string {
 }
 func (*QueryGetProtoRevMaxPoolPointsPerBlockRequest) ProtoMessage() {}
 func (*QueryGetProtoRevMaxPoolPointsPerBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{20}
+	return fileDescriptor_f5e7ac9973cce389, []int{22}
 }
 func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -985,7 +1106,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) String() string {
 }
 func (*QueryGetProtoRevMaxPoolPointsPerBlockResponse) ProtoMessage() {}
 func (*QueryGetProtoRevMaxPoolPointsPerBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{21}
+	return fileDescriptor_f5e7ac9973cce389, []int{23}
 }
 func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1034,7 +1155,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) String() string {
 }
 func (*QueryGetProtoRevMaxPoolPointsPerTxRequest) ProtoMessage() {}
 func (*QueryGetProtoRevMaxPoolPointsPerTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{22}
+	return fileDescriptor_f5e7ac9973cce389, []int{24}
 }
 func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1079,7 +1200,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) String() string {
 }
 func (*QueryGetProtoRevMaxPoolPointsPerTxResponse) ProtoMessage() {}
 func (*QueryGetProtoRevMaxPoolPointsPerTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{23}
+	return fileDescriptor_f5e7ac9973cce389, []int{25}
 }
 func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1124,7 +1245,7 @@ func (m *QueryGetProtoRevBaseDenomsRequest) Reset()         { *m = QueryGetProto
 func (m *QueryGetProtoRevBaseDenomsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevBaseDenomsRequest) ProtoMessage()    {}
 func (*QueryGetProtoRevBaseDenomsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{24}
+	return fileDescriptor_f5e7ac9973cce389, []int{26}
 }
 func (m *QueryGetProtoRevBaseDenomsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1285,7 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Reset()         { *m = QueryGetProt
 func (m *QueryGetProtoRevBaseDenomsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevBaseDenomsResponse) ProtoMessage()    {}
 func (*QueryGetProtoRevBaseDenomsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{25}
+	return fileDescriptor_f5e7ac9973cce389, []int{27}
 }
 func (m *QueryGetProtoRevBaseDenomsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,7 +1330,7 @@ func (m *QueryGetProtoRevEnabledRequest) Reset()         { *m = QueryGetProtoRev
 func (m *QueryGetProtoRevEnabledRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevEnabledRequest) ProtoMessage()    {}
 func (*QueryGetProtoRevEnabledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{26}
+	return fileDescriptor_f5e7ac9973cce389, []int{28}
 }
 func (m *QueryGetProtoRevEnabledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1249,7 +1370,7 @@ func (m *QueryGetProtoRevEnabledResponse) Reset()         { *m = QueryGetProtoRe
 func (m *QueryGetProtoRevEnabledResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevEnabledResponse) ProtoMessage()    {}
 func (*QueryGetProtoRevEnabledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{27}
+	return fileDescriptor_f5e7ac9973cce389, []int{29}
 }
 func (m *QueryGetProtoRevEnabledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1420,7 @@ func (m *QueryGetProtoRevPoolRequest) Reset()         { *m = QueryGetProtoRevPoo
 func (m *QueryGetProtoRevPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevPoolRequest) ProtoMessage()    {}
 func (*QueryGetProtoRevPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{28}
+	return fileDescriptor_f5e7ac9973cce389, []int{30}
 }
 func (m *QueryGetProtoRevPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1353,7 +1474,7 @@ func (m *QueryGetProtoRevPoolResponse) Reset()         { *m = QueryGetProtoRevPo
 func (m *QueryGetProtoRevPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevPoolResponse) ProtoMessage()    {}
 func (*QueryGetProtoRevPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{29}
+	return fileDescriptor_f5e7ac9973cce389, []int{31}
 }
 func (m *QueryGetProtoRevPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1396,7 +1517,7 @@ func (m *QueryGetAllProtocolRevenueRequest) Reset()         { *m = QueryGetAllPr
 func (m *QueryGetAllProtocolRevenueRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAllProtocolRevenueRequest) ProtoMessage()    {}
 func (*QueryGetAllProtocolRevenueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{30}
+	return fileDescriptor_f5e7ac9973cce389, []int{32}
 }
 func (m *QueryGetAllProtocolRevenueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1433,7 +1554,7 @@ func (m *QueryGetAllProtocolRevenueResponse) Reset()         { *m = QueryGetAllP
 func (m *QueryGetAllProtocolRevenueResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAllProtocolRevenueResponse) ProtoMessage()    {}
 func (*QueryGetAllProtocolRevenueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{31}
+	return fileDescriptor_f5e7ac9973cce389, []int{33}
 }
 func (m *QueryGetAllProtocolRevenueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1482,6 +1603,8 @@ func init() {
 	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteResponse")
 	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsRequest")
 	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsResponse")
+	proto.RegisterType((*QueryGetProtoRevStatisticsWindowsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsWindowsRequest")
+	proto.RegisterType((*QueryGetProtoRevStatisticsWindowsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsWindowsResponse")
 	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesRequest")
 	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesResponse")
 	proto.RegisterType((*QueryGetProtoRevAdminAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountRequest")
@@ -1509,108 +1632,115 @@ func init() {
 }
 
 var fileDescriptor_f5e7ac9973cce389 = []byte{
-	// 1608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4b, 0x6f, 0x1b, 0x55,
-	0x1b, 0xce, 0xf4, 0x92, 0x7c, 0x3d, 0xbd, 0x7c, 0xcd, 0xf9, 0x92, 0xd4, 0x99, 0xa4, 0xb6, 0x73,
-	0x92, 0x34, 0xb7, 0xc6, 0xfe, 0x7a, 0x01, 0x5a, 0xa0, 0x40, 0xa6, 0x81, 0x2a, 0xaa, 0x68, 0xcc,
-	0x10, 0x36, 0x20, 0x61, 0xc6, 0xf6, 0x24, 0x1d, 0x75, 0x3c, 0x67, 0x3a, 0x33, 0x8e, 0xe2, 0x2d,
-	0x95, 0x40, 0x48, 0x48, 0xdc, 0x7e, 0x00, 0xac, 0x11, 0x7f, 0x80, 0x25, 0xac, 0x2a, 0xd8, 0x14,
-	0x21, 0x21, 0x54, 0x90, 0x85, 0x5a, 0x16, 0xac, 0xfd, 0x03, 0x10, 0x9a, 0x73, 0xde, 0xb1, 0xc7,
-	0x73, 0x66, 0x1c, 0x4f, 0x22, 0xb1, 0xb3, 0xe7, 0xbc, 0xef, 0xf3, 0x3e, 0xcf, 0xb9, 0x3e, 0x2f,
-	0x9a, 0xa3, 0x6e, 0x9d, 0xba, 0x86, 0x5b, 0xb4, 0x1d, 0xea, 0x51, 0x47, 0xdf, 0x2d, 0xee, 0x5e,
-	0xaa, 0xe8, 0x9e, 0x76, 0xa9, 0x78, 0xbf, 0xa1, 0x3b, 0xcd, 0x02, 0xfb, 0x8c, 0x33, 0x10, 0x55,
-	0x08, 0xa2, 0x0a, 0x10, 0x25, 0x8f, 0xed, 0xd0, 0x1d, 0xca, 0xbe, 0x16, 0xfd, 0x5f, 0x3c, 0x40,
-	0x9e, 0xde, 0xa1, 0x74, 0xc7, 0xd4, 0x8b, 0x9a, 0x6d, 0x14, 0x35, 0xcb, 0xa2, 0x9e, 0xe6, 0x19,
-	0xd4, 0x82, 0x74, 0x79, 0xb9, 0xca, 0xe0, 0x8a, 0x15, 0xcd, 0xd5, 0x79, 0x99, 0x4e, 0x51, 0x5b,
-	0xdb, 0x31, 0x2c, 0x16, 0x0c, 0xb1, 0xf3, 0x89, 0xfc, 0x6c, 0xcd, 0xd1, 0xea, 0x01, 0xe4, 0x42,
-	0x72, 0x58, 0xc0, 0x98, 0x07, 0x66, 0xc3, 0xb5, 0x83, 0x98, 0x2a, 0x35, 0xa0, 0x1e, 0x19, 0x43,
-	0xf8, 0x0d, 0x9f, 0x51, 0x89, 0xa1, 0xab, 0xfa, 0xfd, 0x86, 0xee, 0x7a, 0x64, 0x1b, 0xfd, 0xaf,
-	0xe7, 0xab, 0x6b, 0x53, 0xcb, 0xd5, 0xf1, 0x26, 0x1a, 0xe6, 0x2c, 0x32, 0x52, 0x5e, 0x5a, 0x3c,
-	0x79, 0x39, 0x5f, 0x48, 0x9a, 0xa7, 0x02, 0xcf, 0x54, 0xc6, 0x1f, 0xb6, 0x72, 0x43, 0xed, 0x56,
-	0xee, 0x74, 0x53, 0xab, 0x9b, 0xcf, 0x13, 0x9e, 0x4d, 0x54, 0x80, 0x21, 0x0b, 0x68, 0x9e, 0xd5,
-	0xb9, 0xa5, 0x7b, 0x25, 0x1f, 0x41, 0xd5, 0x77, 0xef, 0x34, 0xea, 0x15, 0xdd, 0xd9, 0xdc, 0xde,
-	0x72, 0xb4, 0x9a, 0xde, 0x21, 0xf4, 0xb1, 0x84, 0x2e, 0xec, 0x17, 0x09, 0x24, 0x2b, 0xe8, 0xac,
-	0xc5, 0x46, 0xca, 0x74, 0xbb, 0xec, 0xb1, 0x31, 0x46, 0xf7, 0x84, 0x72, 0xcd, 0x27, 0xf3, 0xb8,
-	0x95, 0x1b, 0xe7, 0x73, 0xe2, 0xd6, 0xee, 0x15, 0x0c, 0x5a, 0xac, 0x6b, 0xde, 0xdd, 0xc2, 0x86,
-	0xe5, 0xb5, 0x5b, 0xb9, 0x73, 0x9c, 0x65, 0x34, 0x9d, 0xa8, 0x67, 0xac, 0x9e, 0x5a, 0x64, 0x53,
-	0xe4, 0x5d, 0x72, 0xe8, 0xb6, 0xe1, 0xb9, 0x4a, 0x73, 0x5d, 0xb7, 0x68, 0x1d, 0x78, 0xe3, 0x0b,
-	0xe8, 0x78, 0xcd, 0xff, 0x0f, 0x0c, 0xce, 0xb6, 0x5b, 0xb9, 0x53, 0xbc, 0x08, 0xfb, 0x4c, 0x54,
-	0x3e, 0x4c, 0x2c, 0x51, 0x5e, 0x14, 0x10, 0xe4, 0xad, 0xa3, 0x61, 0x9b, 0x8d, 0xc0, 0x1a, 0x4c,
-	0x16, 0xb8, 0x9a, 0x82, 0xbf, 0xc2, 0x9d, 0xe9, 0xbf, 0x49, 0x0d, 0x4b, 0x19, 0x0d, 0x4d, 0x3c,
-	0x4b, 0xf1, 0x27, 0x9e, 0xff, 0x98, 0x45, 0x33, 0xd1, 0x7a, 0x6b, 0xa6, 0x09, 0x25, 0x83, 0x49,
-	0xbf, 0x8f, 0x48, 0xbf, 0x20, 0x20, 0x74, 0x1b, 0x8d, 0x70, 0x50, 0x7f, 0x9a, 0x8f, 0xf6, 0x67,
-	0x34, 0x01, 0xdb, 0xe1, 0x4c, 0x98, 0x95, 0x4b, 0xd4, 0x91, 0xce, 0x2f, 0xb4, 0x18, 0x2d, 0xf9,
-	0xa6, 0x7f, 0x98, 0x5c, 0xcf, 0xa8, 0xba, 0x4a, 0x53, 0xa5, 0x0d, 0x4f, 0x0f, 0xcd, 0xad, 0xe3,
-	0xff, 0x67, 0x65, 0x8f, 0x85, 0xe7, 0x96, 0x7d, 0x26, 0x2a, 0x1f, 0x26, 0x9f, 0x49, 0x68, 0x69,
-	0x00, 0x50, 0x90, 0x53, 0x43, 0xc8, 0xed, 0x0c, 0xc2, 0x1c, 0x2f, 0x25, 0xef, 0x73, 0x96, 0x1c,
-	0x42, 0x9b, 0x04, 0x85, 0xa3, 0x9c, 0x49, 0x17, 0x8a, 0xa8, 0x21, 0x5c, 0xb2, 0x22, 0x52, 0x5a,
-	0x33, 0xcd, 0x08, 0x58, 0xb0, 0x0e, 0x9f, 0x4b, 0x68, 0x79, 0x90, 0xe8, 0x04, 0x05, 0x47, 0xff,
-	0x2d, 0x05, 0x5b, 0xf4, 0x9e, 0x6e, 0x95, 0x34, 0xc3, 0x59, 0x73, 0x2a, 0x0c, 0xb5, 0xa3, 0xe0,
-	0xa3, 0x18, 0x05, 0x71, 0xd1, 0xa0, 0xe0, 0x1d, 0x34, 0xcc, 0x96, 0x2e, 0x60, 0x7f, 0x31, 0x99,
-	0xbd, 0x88, 0x12, 0xbd, 0x73, 0x38, 0x12, 0x51, 0x01, 0x92, 0xcc, 0xa3, 0x59, 0x61, 0x32, 0x6b,
-	0x75, 0xc3, 0x5a, 0xab, 0x56, 0x69, 0xc3, 0xf2, 0x02, 0xca, 0x3a, 0x9a, 0xeb, 0x1f, 0x06, 0x5c,
-	0x6f, 0xa0, 0xd3, 0x9a, 0xff, 0xbd, 0xac, 0xf1, 0x01, 0x38, 0xe9, 0x99, 0x76, 0x2b, 0x37, 0xc6,
-	0x09, 0xf4, 0x0c, 0x13, 0xf5, 0x94, 0x16, 0x82, 0x21, 0x4b, 0x68, 0x21, 0x5a, 0x66, 0x5d, 0xdf,
-	0xd5, 0x4d, 0x6a, 0xeb, 0x4e, 0x84, 0x51, 0x43, 0x3c, 0x1b, 0x62, 0x28, 0xb0, 0xda, 0x40, 0xa3,
-	0xb5, 0x60, 0x2c, 0xc2, 0x6c, 0xba, 0xdd, 0xca, 0x65, 0x82, 0x3b, 0x28, 0x12, 0x42, 0xd4, 0xb3,
-	0xb5, 0x08, 0x64, 0xdc, 0x1d, 0xbd, 0x61, 0x6d, 0x53, 0xa5, 0x59, 0xa2, 0xd4, 0xdc, 0x6a, 0xda,
-	0xc1, 0x79, 0x24, 0x5f, 0xc6, 0xdc, 0xd1, 0xd1, 0x48, 0xa0, 0xd7, 0x40, 0xa3, 0x86, 0xb5, 0x4d,
-	0xcb, 0x95, 0x66, 0xd9, 0xa6, 0xd4, 0x2c, 0x7b, 0x4d, 0x5b, 0x87, 0xb3, 0xb6, 0x98, 0xbc, 0xd6,
-	0xbd, 0x60, 0x4a, 0x1e, 0xd6, 0x19, 0xc4, 0x08, 0x80, 0x44, 0x3d, 0x63, 0xf4, 0x64, 0x90, 0x02,
-	0xba, 0x18, 0x25, 0xf8, 0xba, 0xb6, 0xe7, 0x0f, 0x97, 0xa8, 0x61, 0x79, 0x6e, 0x49, 0x77, 0x14,
-	0x93, 0x56, 0xef, 0x05, 0x8a, 0x3e, 0x91, 0xd0, 0xea, 0x80, 0x09, 0x20, 0xec, 0x5d, 0x34, 0x59,
-	0xd7, 0xf6, 0x38, 0x07, 0x9b, 0x85, 0x94, 0xfd, 0xe9, 0xad, 0xf8, 0x41, 0x4c, 0xe0, 0x31, 0x65,
-	0xae, 0xdd, 0xca, 0xe5, 0x39, 0xe5, 0xc4, 0x50, 0xa2, 0x8e, 0xd7, 0xe3, 0xea, 0xc4, 0x9d, 0xba,
-	0x28, 0xa1, 0xad, 0xbd, 0x80, 0xfe, 0x83, 0x98, 0x53, 0x17, 0x17, 0x0d, 0xdc, 0xdf, 0x42, 0x13,
-	0x71, 0x84, 0xbc, 0x3d, 0x20, 0x3e, 0xd3, 0x6e, 0xe5, 0xce, 0x27, 0x13, 0xf7, 0xf6, 0x88, 0x8a,
-	0xeb, 0x02, 0x7c, 0xdc, 0x53, 0xa3, 0x68, 0xae, 0xce, 0x5e, 0xb5, 0xce, 0x05, 0xf1, 0x81, 0x24,
-	0xbe, 0x35, 0xe1, 0x28, 0xa0, 0xf8, 0x1e, 0x3a, 0xe9, 0x3f, 0x2a, 0x65, 0xf6, 0x68, 0x06, 0xb7,
-	0xc3, 0x6c, 0xf2, 0x8e, 0xe9, 0x40, 0x28, 0x32, 0x6c, 0x16, 0xcc, 0x05, 0x84, 0x50, 0x88, 0x8a,
-	0x2a, 0x9d, 0x4a, 0x24, 0x8f, 0xb2, 0x51, 0x1e, 0xaf, 0x5a, 0x5a, 0xc5, 0xd4, 0x6b, 0x01, 0xd5,
-	0x4d, 0x94, 0x4b, 0x8c, 0x00, 0x9a, 0x17, 0xd1, 0x88, 0xce, 0x3f, 0xb1, 0xa9, 0xfb, 0x8f, 0x82,
-	0xbb, 0x6f, 0x1e, 0x0c, 0x10, 0x35, 0x08, 0xf1, 0xbd, 0xcd, 0x94, 0xf0, 0xf8, 0x53, 0x6a, 0x06,
-	0xef, 0xdc, 0x55, 0x84, 0xba, 0x74, 0xe1, 0x10, 0x8f, 0x77, 0x2f, 0xe8, 0xee, 0x18, 0x51, 0x4f,
-	0x74, 0x94, 0xe0, 0xe7, 0xd0, 0x49, 0xea, 0xdd, 0xd5, 0x1d, 0x48, 0x3b, 0xc2, 0xd2, 0x26, 0xba,
-	0x33, 0x10, 0x1a, 0x24, 0x2a, 0x62, 0xff, 0x58, 0x22, 0xb9, 0x8d, 0xa6, 0xe3, 0xd9, 0x80, 0xb8,
-	0x15, 0x34, 0xc2, 0x96, 0xde, 0xa8, 0xc1, 0xbe, 0x08, 0x89, 0x83, 0x01, 0xdf, 0x67, 0x50, 0x6a,
-	0x6e, 0xd4, 0xc2, 0x8b, 0xcf, 0xad, 0x83, 0x47, 0xab, 0x3e, 0xd6, 0xae, 0x6e, 0x35, 0x3a, 0x17,
-	0xc7, 0xd7, 0xa1, 0xc5, 0x8f, 0x8b, 0x82, 0xc2, 0x0f, 0x24, 0x34, 0xa6, 0x99, 0x66, 0xd9, 0x86,
-	0xf1, 0xb2, 0xc3, 0x03, 0xe0, 0xe2, 0xe8, 0xf3, 0x48, 0x88, 0xa0, 0xca, 0x2c, 0xec, 0x87, 0x29,
-	0xb8, 0xa3, 0x63, 0x70, 0x89, 0x8a, 0x35, 0x21, 0xf1, 0xf2, 0xdf, 0x19, 0x74, 0x9c, 0x91, 0xc5,
-	0x1f, 0x4a, 0x68, 0x98, 0xdb, 0x5c, 0xdc, 0xa7, 0xb6, 0xe8, 0xae, 0xe5, 0xd5, 0x01, 0xa3, 0xb9,
-	0x6e, 0x92, 0x7f, 0xff, 0xe7, 0x3f, 0xbf, 0x38, 0x22, 0xe3, 0x4c, 0x51, 0x30, 0xfd, 0xdc, 0x46,
-	0xe3, 0x1f, 0x24, 0x34, 0x99, 0x68, 0x8c, 0xf1, 0xcb, 0xfb, 0x94, 0xdb, 0xcf, 0x7c, 0xcb, 0xaf,
-	0x1c, 0x1c, 0x00, 0x24, 0x2c, 0x33, 0x09, 0x73, 0x98, 0x88, 0x12, 0xa2, 0x66, 0x3b, 0x2a, 0xa6,
-	0xd7, 0x06, 0xa7, 0x11, 0x13, 0xeb, 0xc8, 0xd3, 0x88, 0x89, 0x77, 0xe0, 0xfd, 0xc4, 0x80, 0x8d,
-	0xf5, 0x9f, 0x21, 0x76, 0xb2, 0xf0, 0xb7, 0x12, 0x1a, 0x8f, 0xb5, 0xcf, 0xf8, 0x85, 0xc1, 0x79,
-	0x08, 0xce, 0x5c, 0x7e, 0xf1, 0x60, 0xc9, 0x20, 0x60, 0x9e, 0x09, 0xc8, 0xe1, 0xf3, 0xa2, 0x00,
-	0x38, 0x07, 0x8c, 0xe1, 0x2f, 0x12, 0x9a, 0xee, 0x67, 0x99, 0xb1, 0x32, 0x38, 0x8b, 0x24, 0x13,
-	0x2f, 0xdf, 0x3c, 0x14, 0x06, 0x08, 0x5a, 0x65, 0x82, 0x16, 0xf0, 0xbc, 0x28, 0xa8, 0xeb, 0x58,
-	0xfd, 0x45, 0x61, 0x16, 0x10, 0x3f, 0x96, 0xd0, 0xf9, 0xbe, 0x56, 0x1a, 0xdf, 0x4c, 0x35, 0xbf,
-	0xf1, 0xb6, 0x5d, 0x5e, 0x3f, 0x1c, 0x08, 0x68, 0x2b, 0x30, 0x6d, 0x8b, 0xf8, 0x42, 0xfc, 0x62,
-	0x31, 0x45, 0xe5, 0xae, 0x4a, 0xfc, 0x5b, 0xaf, 0x38, 0xd1, 0x1f, 0xa7, 0x11, 0x97, 0xe8, 0xe8,
-	0xd3, 0x88, 0x4b, 0x36, 0xfa, 0xa4, 0xc8, 0xc4, 0x2d, 0xe1, 0x05, 0x51, 0x9c, 0xe7, 0x67, 0x95,
-	0x6d, 0xcd, 0x70, 0xca, 0x9a, 0x53, 0xe1, 0x3a, 0x5d, 0xfc, 0x9d, 0x84, 0xce, 0x25, 0x38, 0x72,
-	0x7c, 0x23, 0xc5, 0x7c, 0x8b, 0x86, 0x5f, 0x7e, 0xe9, 0xa0, 0xe9, 0xa0, 0x65, 0x81, 0x69, 0x99,
-	0xc1, 0xb9, 0x98, 0x85, 0x0a, 0x77, 0x00, 0xf8, 0x27, 0x09, 0x4d, 0xf5, 0xf1, 0xf0, 0x78, 0x6d,
-	0x70, 0x22, 0x09, 0xad, 0x82, 0xac, 0x1c, 0x06, 0x02, 0xf4, 0xac, 0x30, 0x3d, 0xf3, 0x78, 0x56,
-	0xd4, 0x23, 0xf4, 0x0d, 0xf8, 0xc7, 0xde, 0x4b, 0xbb, 0xd7, 0xa9, 0xa7, 0xb9, 0xb4, 0x63, 0x5b,
-	0x8b, 0x34, 0x97, 0x76, 0x7c, 0xc7, 0xd1, 0x4f, 0x8d, 0xd0, 0x38, 0xe0, 0xdf, 0x7b, 0xcf, 0x90,
-	0xe8, 0x99, 0xd3, 0x9c, 0xa1, 0x44, 0x7f, 0x9e, 0xe6, 0x0c, 0x25, 0xdb, 0x76, 0xf2, 0x7f, 0xa6,
-	0x6c, 0x19, 0x2f, 0x8a, 0xca, 0xe2, 0x6d, 0x3a, 0xfe, 0x4b, 0x42, 0xf9, 0xfd, 0x3a, 0x1a, 0xfc,
-	0xda, 0xc1, 0xc9, 0x85, 0x7b, 0x28, 0xf9, 0xd6, 0xa1, 0x71, 0x40, 0xe7, 0x15, 0xa6, 0x73, 0x15,
-	0xaf, 0x0c, 0xa6, 0x93, 0xf5, 0x51, 0xd1, 0xf7, 0xb7, 0xdb, 0x52, 0xa4, 0x79, 0x7f, 0x85, 0x76,
-	0x25, 0xcd, 0xfb, 0x2b, 0x76, 0x31, 0xfd, 0xde, 0xdf, 0x50, 0x5f, 0x82, 0xbf, 0x91, 0x10, 0x16,
-	0x9b, 0x0c, 0x7c, 0x6d, 0xf0, 0xda, 0xbd, 0x9d, 0x8b, 0x7c, 0xfd, 0x00, 0x99, 0x40, 0x79, 0x86,
-	0x51, 0x9e, 0xc2, 0x93, 0x22, 0x65, 0x68, 0x63, 0xf0, 0x57, 0x12, 0xfa, 0x6f, 0xa4, 0x67, 0xc0,
-	0xcf, 0xa4, 0x30, 0x5b, 0xdd, 0x8e, 0x47, 0x7e, 0x36, 0x6d, 0x1a, 0xb0, 0xcc, 0x32, 0x96, 0x19,
-	0x3c, 0x11, 0xe3, 0xcc, 0x7c, 0x3a, 0xdf, 0xf3, 0xdd, 0x20, 0xb6, 0x03, 0x83, 0xec, 0x86, 0xc4,
-	0xfe, 0x65, 0x90, 0xdd, 0x90, 0xdc, 0xd6, 0xec, 0xf7, 0xc0, 0x47, 0xbb, 0x12, 0xe5, 0xce, 0xc3,
-	0x27, 0x59, 0xe9, 0xd1, 0x93, 0xac, 0xf4, 0xc7, 0x93, 0xac, 0xf4, 0xe9, 0xd3, 0xec, 0xd0, 0xa3,
-	0xa7, 0xd9, 0xa1, 0x5f, 0x9f, 0x66, 0x87, 0xde, 0xbe, 0xba, 0x63, 0x78, 0x77, 0x1b, 0x95, 0x42,
-	0x95, 0xd6, 0x03, 0xac, 0x55, 0x53, 0xab, 0xb8, 0x1d, 0xe0, 0xdd, 0xcb, 0xd7, 0x8b, 0x7b, 0xa1,
-	0x27, 0xb6, 0x69, 0xeb, 0x6e, 0x65, 0x98, 0xfd, 0xbf, 0xf2, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x00, 0xfe, 0x98, 0x0f, 0x1a, 0x19, 0x00, 0x00,
+	// 1727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5b, 0x6f, 0x1b, 0x45,
+	0x1b, 0xce, 0xf6, 0x90, 0x7c, 0x9d, 0x1e, 0xbe, 0x66, 0xbe, 0x24, 0x4d, 0x36, 0xa9, 0x9d, 0x4c,
+	0xce, 0x27, 0xfb, 0xeb, 0x01, 0x68, 0xa1, 0x85, 0x66, 0x13, 0x5a, 0x45, 0x15, 0x4d, 0x58, 0x82,
+	0x90, 0x00, 0x61, 0xd6, 0xf6, 0x26, 0x5d, 0x75, 0xbd, 0xe3, 0xee, 0xae, 0xd3, 0xf8, 0x96, 0x4a,
+	0x20, 0x24, 0x24, 0x4e, 0x3f, 0x00, 0xae, 0x81, 0x3f, 0xc0, 0x25, 0x5c, 0x55, 0x70, 0x53, 0x40,
+	0x54, 0xa8, 0x20, 0x0b, 0xb5, 0x5c, 0x70, 0xed, 0x5f, 0x80, 0x76, 0xe6, 0xdd, 0xf5, 0x1e, 0x7d,
+	0x14, 0x77, 0xf6, 0xce, 0xfb, 0x3e, 0xf3, 0x3c, 0xef, 0xbc, 0x73, 0x78, 0xd0, 0x0c, 0xb5, 0x4a,
+	0xd4, 0xd2, 0xac, 0x6c, 0xd9, 0xa4, 0x36, 0x35, 0xd5, 0xfd, 0xec, 0xfe, 0xb9, 0xbc, 0x6a, 0x2b,
+	0xe7, 0xb2, 0x77, 0x2b, 0xaa, 0x59, 0xcd, 0xb0, 0xcf, 0x78, 0x14, 0xa2, 0x32, 0x6e, 0x54, 0x06,
+	0xa2, 0xc4, 0xa1, 0x3d, 0xba, 0x47, 0xd9, 0xd7, 0xac, 0xf3, 0x8b, 0x07, 0x88, 0x13, 0x7b, 0x94,
+	0xee, 0xe9, 0x6a, 0x56, 0x29, 0x6b, 0x59, 0xc5, 0x30, 0xa8, 0xad, 0xd8, 0x1a, 0x35, 0x20, 0x5d,
+	0x5c, 0x2a, 0x30, 0xb8, 0x6c, 0x5e, 0xb1, 0x54, 0x3e, 0x8d, 0x37, 0x69, 0x59, 0xd9, 0xd3, 0x0c,
+	0x16, 0x0c, 0xb1, 0xb3, 0x89, 0xfc, 0xca, 0x8a, 0xa9, 0x94, 0x5c, 0xc8, 0xf9, 0xe4, 0x30, 0x97,
+	0x31, 0x0f, 0x4c, 0xf9, 0xe7, 0x76, 0x63, 0x0a, 0x54, 0x83, 0xf9, 0xc8, 0x10, 0xc2, 0xaf, 0x3a,
+	0x8c, 0xb6, 0x19, 0xba, 0xac, 0xde, 0xad, 0xa8, 0x96, 0x4d, 0x76, 0xd1, 0xff, 0x02, 0x5f, 0xad,
+	0x32, 0x35, 0x2c, 0x15, 0x6f, 0xa1, 0x7e, 0xce, 0x62, 0x54, 0x98, 0x14, 0x16, 0x8e, 0x9f, 0x9f,
+	0xcc, 0x24, 0xd5, 0x29, 0xc3, 0x33, 0xa5, 0xe1, 0x07, 0xb5, 0x74, 0x5f, 0xbd, 0x96, 0x3e, 0x59,
+	0x55, 0x4a, 0xfa, 0xf3, 0x84, 0x67, 0x13, 0x19, 0x60, 0xc8, 0x3c, 0x9a, 0x65, 0xf3, 0xdc, 0x50,
+	0xed, 0x6d, 0x07, 0x41, 0x56, 0xf7, 0x6f, 0x55, 0x4a, 0x79, 0xd5, 0xdc, 0xda, 0xdd, 0x31, 0x95,
+	0xa2, 0xea, 0x11, 0xfa, 0x48, 0x40, 0x73, 0xad, 0x22, 0x81, 0x64, 0x1e, 0x9d, 0x36, 0xd8, 0x48,
+	0x8e, 0xee, 0xe6, 0x6c, 0x36, 0xc6, 0xe8, 0x1e, 0x93, 0x2e, 0x39, 0x64, 0x1e, 0xd7, 0xd2, 0xc3,
+	0xbc, 0x26, 0x56, 0xf1, 0x4e, 0x46, 0xa3, 0xd9, 0x92, 0x62, 0xdf, 0xce, 0x6c, 0x1a, 0x76, 0xbd,
+	0x96, 0x3e, 0xc3, 0x59, 0x86, 0xd3, 0x89, 0x7c, 0xca, 0x08, 0xcc, 0x45, 0xb6, 0xa2, 0xbc, 0xb7,
+	0x4d, 0xba, 0xab, 0xd9, 0x96, 0x54, 0xdd, 0x50, 0x0d, 0x5a, 0x02, 0xde, 0x78, 0x0e, 0x1d, 0x2d,
+	0x3a, 0xff, 0x81, 0xc1, 0xe9, 0x7a, 0x2d, 0x7d, 0x82, 0x4f, 0xc2, 0x3e, 0x13, 0x99, 0x0f, 0x13,
+	0x03, 0xcd, 0xb5, 0x02, 0x04, 0x79, 0x1b, 0xa8, 0xbf, 0xcc, 0x46, 0x60, 0x0d, 0xc6, 0x32, 0x5c,
+	0x4d, 0xc6, 0x59, 0x61, 0xaf, 0xfc, 0xeb, 0x54, 0x33, 0xa4, 0x41, 0x5f, 0xe1, 0x59, 0x8a, 0x53,
+	0x78, 0xfe, 0x63, 0x1a, 0x4d, 0x85, 0xe7, 0x5b, 0xd3, 0x75, 0x98, 0xd2, 0x2d, 0xfa, 0x5d, 0x44,
+	0x9a, 0x05, 0x01, 0xa1, 0x9b, 0x68, 0x80, 0x83, 0x3a, 0x65, 0x3e, 0xdc, 0x9c, 0xd1, 0x08, 0xb4,
+	0xc3, 0x29, 0x3f, 0x2b, 0x8b, 0xc8, 0x03, 0xde, 0x2f, 0xb4, 0x10, 0x9e, 0xf2, 0x35, 0x67, 0x33,
+	0x59, 0xb6, 0x56, 0xb0, 0xa4, 0xaa, 0x4c, 0x2b, 0xb6, 0xea, 0xab, 0xad, 0xe9, 0xfc, 0x67, 0xd3,
+	0x1e, 0xf1, 0xd7, 0x96, 0x7d, 0x26, 0x32, 0x1f, 0x26, 0x9f, 0x0a, 0x68, 0xb1, 0x0d, 0x50, 0x90,
+	0x53, 0x44, 0xc8, 0xf2, 0x06, 0xa1, 0xc6, 0x8b, 0xc9, 0x7d, 0xce, 0x92, 0x7d, 0x68, 0x63, 0xa0,
+	0x70, 0x90, 0x33, 0x69, 0x40, 0x11, 0xd9, 0x87, 0x4b, 0x96, 0xa3, 0x94, 0xd6, 0x74, 0x3d, 0x04,
+	0xe6, 0xae, 0xc3, 0x67, 0x02, 0x5a, 0x6a, 0x27, 0x3a, 0x41, 0xc1, 0xe1, 0x7f, 0x45, 0xc1, 0xd7,
+	0x42, 0xb3, 0xa5, 0x7a, 0x43, 0x33, 0x8a, 0xf4, 0x9e, 0xab, 0x00, 0x3f, 0x87, 0x8e, 0x1b, 0x95,
+	0x52, 0xee, 0x1e, 0xff, 0xca, 0xaa, 0x7a, 0x44, 0x1a, 0xa9, 0xd7, 0xd2, 0xd8, 0xdb, 0x71, 0xee,
+	0x20, 0x91, 0x91, 0x51, 0x29, 0x41, 0x3e, 0xbe, 0x8e, 0x50, 0xe3, 0x88, 0x1c, 0x3d, 0xc4, 0x56,
+	0x63, 0x2e, 0xd0, 0x5f, 0xfc, 0xd8, 0x6e, 0x1c, 0x3b, 0x7b, 0x6e, 0x7f, 0xc8, 0xbe, 0x4c, 0xf2,
+	0x73, 0xd3, 0x1e, 0xf0, 0xd8, 0x42, 0x05, 0xdf, 0x46, 0x03, 0x0d, 0xaa, 0x4e, 0xf9, 0x96, 0x92,
+	0xcb, 0x17, 0x46, 0x09, 0xf7, 0xb8, 0x27, 0xcb, 0x85, 0xc4, 0x37, 0x62, 0x34, 0xcd, 0xb7, 0xd4,
+	0xc4, 0xa9, 0x05, 0x44, 0xc5, 0x34, 0xd1, 0x0e, 0xbd, 0xa3, 0x1a, 0xdb, 0x8a, 0x66, 0xae, 0x99,
+	0x79, 0xb6, 0xb0, 0x5e, 0x13, 0x7d, 0x18, 0xd3, 0x44, 0x71, 0xd1, 0x50, 0x82, 0xb7, 0x50, 0x3f,
+	0xdb, 0x3d, 0x6e, 0x05, 0x56, 0x92, 0x2b, 0x10, 0x45, 0x09, 0x1f, 0xfb, 0x1c, 0x89, 0xc8, 0x00,
+	0x49, 0x66, 0xd1, 0x74, 0xa4, 0x9f, 0x8b, 0x25, 0xcd, 0x58, 0x2b, 0x14, 0x68, 0xc5, 0xb0, 0x5d,
+	0xca, 0x2a, 0x9a, 0x69, 0x1e, 0x06, 0x5c, 0xaf, 0xa2, 0x93, 0x8a, 0xf3, 0x3d, 0xa7, 0xf0, 0x01,
+	0x38, 0x6c, 0x47, 0xeb, 0xb5, 0xf4, 0x10, 0x27, 0x10, 0x18, 0x26, 0xf2, 0x09, 0xc5, 0x07, 0x43,
+	0x16, 0xd1, 0x7c, 0x78, 0x9a, 0x0d, 0x75, 0x5f, 0xd5, 0x69, 0x59, 0x35, 0x43, 0x8c, 0x2a, 0x68,
+	0xa1, 0x75, 0x28, 0xb0, 0xda, 0x44, 0x83, 0x45, 0x77, 0x2c, 0xc4, 0x6c, 0xa2, 0x5e, 0x4b, 0x8f,
+	0xba, 0xd7, 0x40, 0x28, 0x84, 0xc8, 0xa7, 0x8b, 0x21, 0xc8, 0xb8, 0x6b, 0x72, 0xd3, 0xd8, 0xa5,
+	0x52, 0x75, 0x9b, 0x52, 0x7d, 0xa7, 0x5a, 0x76, 0x5b, 0x9e, 0x7c, 0x11, 0x73, 0x4d, 0x86, 0x23,
+	0x81, 0x5e, 0x05, 0x0d, 0x6a, 0xc6, 0x2e, 0xcd, 0xe5, 0xab, 0xb9, 0x32, 0xa5, 0x7a, 0xce, 0xae,
+	0x96, 0x55, 0x38, 0xee, 0x16, 0x92, 0xd7, 0x3a, 0x08, 0x26, 0x4d, 0xc2, 0x3a, 0x83, 0x98, 0x08,
+	0x20, 0x91, 0x4f, 0x69, 0x81, 0x0c, 0x92, 0x41, 0x2b, 0x61, 0x82, 0xaf, 0x28, 0x07, 0xce, 0xf0,
+	0x36, 0xd5, 0x0c, 0xdb, 0xda, 0x56, 0x4d, 0x49, 0xa7, 0x85, 0x3b, 0xae, 0xa2, 0x8f, 0x05, 0xb4,
+	0xda, 0x66, 0x02, 0x08, 0x7b, 0x07, 0x8d, 0x95, 0x94, 0x03, 0xce, 0xa1, 0xcc, 0x42, 0x72, 0x4e,
+	0x79, 0xf3, 0x4e, 0x10, 0x9c, 0x3c, 0x33, 0xf5, 0x5a, 0x7a, 0x92, 0x53, 0x4e, 0x0c, 0x25, 0xf2,
+	0x70, 0x29, 0x6e, 0x9e, 0xb8, 0x5d, 0x17, 0x26, 0xb4, 0x73, 0xe0, 0xd2, 0xbf, 0x1f, 0xb3, 0xeb,
+	0xe2, 0xa2, 0x81, 0xfb, 0xeb, 0x68, 0x24, 0x8e, 0x90, 0x7d, 0x00, 0xc4, 0xa7, 0xea, 0xb5, 0xf4,
+	0xd9, 0x64, 0xe2, 0xf6, 0x01, 0x91, 0x71, 0x29, 0x02, 0x1f, 0x77, 0xdb, 0x4b, 0x8a, 0xa5, 0xb2,
+	0x87, 0x85, 0x77, 0x40, 0xbc, 0x2f, 0x20, 0xd2, 0x2c, 0x0a, 0x28, 0xbe, 0x8b, 0x8e, 0x3b, 0x67,
+	0x54, 0x8e, 0xbd, 0x5b, 0xdc, 0xd3, 0x61, 0x3a, 0xb9, 0x63, 0x3c, 0x08, 0x49, 0x84, 0x66, 0x81,
+	0x33, 0xdf, 0x87, 0x42, 0x64, 0x94, 0xf7, 0x66, 0x22, 0x93, 0x28, 0x15, 0xe6, 0xf1, 0xb2, 0xa1,
+	0xe4, 0x75, 0xb5, 0xe8, 0x52, 0xdd, 0x42, 0xe9, 0xc4, 0x08, 0xa0, 0xb9, 0x82, 0x06, 0x54, 0xfe,
+	0x89, 0x95, 0xee, 0x3f, 0x12, 0x6e, 0x1c, 0xc9, 0x30, 0x40, 0x64, 0x37, 0xc4, 0x79, 0x5e, 0x8e,
+	0x47, 0xde, 0x5f, 0x94, 0xea, 0xee, 0xfd, 0x75, 0x11, 0xa1, 0x06, 0x5d, 0xd8, 0xc4, 0xc3, 0x8d,
+	0x3b, 0xb2, 0x31, 0x46, 0xe4, 0x63, 0x9e, 0x12, 0xe7, 0xd6, 0xa3, 0xf6, 0x6d, 0xd5, 0x84, 0xb4,
+	0x43, 0x2c, 0xcd, 0x77, 0xeb, 0xf9, 0x06, 0x89, 0x8c, 0xd8, 0x3f, 0x96, 0x48, 0x6e, 0xa2, 0x89,
+	0x78, 0x36, 0x20, 0x6e, 0x19, 0x0d, 0xb0, 0xa5, 0xd7, 0x8a, 0xd0, 0x17, 0x3e, 0x71, 0x30, 0xe0,
+	0x3c, 0xf5, 0x28, 0xd5, 0x37, 0x8b, 0xfe, 0xc5, 0xe7, 0xaf, 0x37, 0x9b, 0x16, 0x1c, 0xac, 0x7d,
+	0xd5, 0xa8, 0x78, 0x07, 0xc7, 0x57, 0xbe, 0xc5, 0x8f, 0x8b, 0x82, 0x89, 0xef, 0x0b, 0x68, 0x48,
+	0xd1, 0xf5, 0x5c, 0x19, 0xc6, 0x73, 0x26, 0x0f, 0x80, 0x83, 0xa3, 0xc9, 0x25, 0x11, 0x05, 0x95,
+	0xa6, 0xa1, 0x1f, 0xc6, 0xe1, 0x8c, 0x8e, 0xc1, 0x25, 0x32, 0x56, 0x22, 0x89, 0xe7, 0x1f, 0x89,
+	0xe8, 0x28, 0x23, 0x8b, 0x3f, 0x10, 0x50, 0x3f, 0x77, 0x1a, 0xb8, 0xc9, 0xdc, 0x51, 0x83, 0x23,
+	0xae, 0xb6, 0x19, 0xcd, 0x75, 0x93, 0xc9, 0xf7, 0x7e, 0xf9, 0xeb, 0xf3, 0x43, 0x22, 0x1e, 0xcd,
+	0x46, 0x7c, 0x17, 0x77, 0x32, 0xf8, 0x07, 0x01, 0x8d, 0x25, 0x7a, 0x13, 0xfc, 0x52, 0x8b, 0xe9,
+	0x5a, 0xf9, 0x1f, 0xf1, 0x5a, 0xf7, 0x00, 0x20, 0x61, 0x89, 0x49, 0x98, 0xc1, 0x24, 0x2a, 0x21,
+	0xec, 0x77, 0xc2, 0x62, 0x82, 0x4e, 0xa4, 0x13, 0x31, 0xb1, 0xa6, 0x48, 0xbc, 0xd6, 0x3d, 0x40,
+	0x6b, 0x31, 0xe0, 0x24, 0x9c, 0x6b, 0x88, 0xed, 0x2c, 0xfc, 0xad, 0x80, 0x86, 0x63, 0x1d, 0x0c,
+	0x7e, 0xa1, 0x7d, 0x1e, 0x11, 0x73, 0x24, 0x5e, 0xe9, 0x2e, 0x19, 0x04, 0xcc, 0x32, 0x01, 0x69,
+	0x7c, 0x36, 0x2a, 0x00, 0xf6, 0x01, 0x63, 0xf8, 0x48, 0x40, 0x13, 0xcd, 0x5c, 0x0b, 0x96, 0xda,
+	0x67, 0x91, 0xe4, 0xa3, 0xc4, 0xf5, 0x9e, 0x30, 0x40, 0xd0, 0x2a, 0x13, 0x34, 0x8f, 0x67, 0xa3,
+	0x82, 0x1a, 0xa6, 0xc1, 0x59, 0x14, 0xf6, 0x04, 0xc4, 0x8f, 0x05, 0x74, 0xb6, 0xa9, 0x9b, 0xc1,
+	0xeb, 0x1d, 0xd5, 0x37, 0xde, 0x39, 0x89, 0x1b, 0xbd, 0x81, 0x80, 0xb6, 0x0c, 0xd3, 0xb6, 0x80,
+	0xe7, 0xe2, 0x17, 0x8b, 0x29, 0xca, 0x35, 0x54, 0xe2, 0x5f, 0x93, 0x56, 0xcd, 0x75, 0x35, 0x5d,
+	0xad, 0x5a, 0xd0, 0x52, 0x89, 0xeb, 0x3d, 0x61, 0x80, 0xb2, 0x15, 0xa6, 0x6c, 0x0e, 0xcf, 0x34,
+	0x5d, 0x35, 0xd7, 0xb8, 0xfc, 0x1e, 0x5c, 0xb4, 0xe8, 0xbb, 0xbf, 0x93, 0x45, 0x4b, 0x74, 0x2a,
+	0xe2, 0x46, 0x6f, 0x20, 0x20, 0x2d, 0xcb, 0xa4, 0x2d, 0xe2, 0xf9, 0xa8, 0x34, 0xdb, 0xc9, 0xca,
+	0x95, 0x15, 0xcd, 0xcc, 0x29, 0x66, 0x9e, 0xaf, 0x9f, 0x85, 0xbf, 0x13, 0xd0, 0x99, 0x04, 0xa7,
+	0x81, 0xaf, 0x76, 0xd0, 0x47, 0x51, 0x23, 0x23, 0xbe, 0xd8, 0x6d, 0x3a, 0x68, 0x99, 0x67, 0x5a,
+	0xa6, 0x70, 0x3a, 0xa6, 0x01, 0xfd, 0xce, 0x06, 0xff, 0x24, 0xa0, 0xf1, 0x26, 0xde, 0x04, 0xaf,
+	0xb5, 0x4f, 0x24, 0xc1, 0x02, 0x89, 0x52, 0x2f, 0x10, 0xa0, 0x67, 0x99, 0xe9, 0x99, 0xc5, 0xd3,
+	0x51, 0x3d, 0x11, 0x3f, 0x84, 0x7f, 0x0c, 0x5e, 0x46, 0x41, 0x07, 0xd2, 0xc9, 0x65, 0x14, 0x6b,
+	0x99, 0xc4, 0x6b, 0xdd, 0x03, 0xb4, 0x56, 0x13, 0x31, 0x44, 0xf8, 0x8f, 0xe0, 0x1e, 0x8a, 0x7a,
+	0x81, 0x4e, 0xf6, 0x50, 0xa2, 0xef, 0x10, 0x37, 0x7a, 0x03, 0x01, 0x65, 0xff, 0x67, 0xca, 0x96,
+	0xf0, 0x42, 0x54, 0x59, 0xbc, 0xfd, 0xc0, 0x7f, 0x0b, 0x68, 0xb2, 0x95, 0x53, 0xc3, 0xd7, 0xbb,
+	0x27, 0xe7, 0xf7, 0x86, 0xe2, 0x8d, 0x9e, 0x71, 0x40, 0xe7, 0x05, 0xa6, 0x73, 0x15, 0x2f, 0xb7,
+	0xa7, 0x93, 0xf9, 0xc3, 0xf0, 0xbb, 0xa2, 0x61, 0x95, 0x3a, 0x79, 0x57, 0x44, 0x6c, 0x98, 0x78,
+	0xa5, 0xbb, 0xe4, 0xd6, 0xef, 0x0a, 0x9f, 0xdf, 0xc2, 0xdf, 0x08, 0x08, 0x47, 0xcd, 0x13, 0xbe,
+	0xd4, 0xfe, 0xdc, 0x41, 0x47, 0x26, 0x5e, 0xee, 0x22, 0x13, 0x28, 0x4f, 0x31, 0xca, 0xe3, 0x78,
+	0x2c, 0x4a, 0x19, 0xec, 0x19, 0xfe, 0x52, 0x40, 0xff, 0x0d, 0x79, 0x21, 0xfc, 0x4c, 0x07, 0x8f,
+	0xc8, 0x86, 0x93, 0x13, 0x9f, 0xed, 0x34, 0x0d, 0x58, 0xa6, 0x18, 0xcb, 0x51, 0x3c, 0x12, 0x65,
+	0xe9, 0xb4, 0x07, 0xfe, 0x9e, 0x77, 0x43, 0xd4, 0xe6, 0xb4, 0xd3, 0x0d, 0x89, 0xbe, 0x4c, 0xbc,
+	0xd2, 0x5d, 0x72, 0x7b, 0x0f, 0x97, 0xb0, 0xdb, 0x92, 0x6e, 0x3d, 0x78, 0x92, 0x12, 0x1e, 0x3e,
+	0x49, 0x09, 0x7f, 0x3e, 0x49, 0x09, 0x9f, 0x3c, 0x4d, 0xf5, 0x3d, 0x7c, 0x9a, 0xea, 0xfb, 0xed,
+	0x69, 0xaa, 0xef, 0xcd, 0x8b, 0x7b, 0x9a, 0x7d, 0xbb, 0x92, 0xcf, 0x14, 0x68, 0xc9, 0xc5, 0x5a,
+	0xd5, 0x95, 0xbc, 0xe5, 0x01, 0xef, 0x9f, 0xbf, 0x9c, 0x3d, 0x68, 0xc0, 0x3b, 0x67, 0x9d, 0x95,
+	0xef, 0x67, 0xff, 0x2f, 0xfc, 0x33, 0x00, 0x33, 0x6e, 0x8f, 0x28, 0x75, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1641,6 +1771,9 @@ type QueryClient interface {
 	// arbitraged against and the number of trades and profits that have been
 	// accumulated for each route
 	GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
+	// GetProtoRevStatisticsWindows queries the route and pool statistics of the
+	// trades the module has executed during each of the most recent day epochs
+	GetProtoRevStatisticsWindows(ctx context.Context, in *QueryGetProtoRevStatisticsWindowsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsWindowsResponse, error)
 	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
 	// is currently arbitraging
 	GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
@@ -1732,6 +1865,15 @@ func (c *queryClient) GetProtoRevAllRouteStatistics(ctx context.Context, in *Que
 	return out, nil
 }
 
+func (c *queryClient) GetProtoRevStatisticsWindows(ctx context.Context, in *QueryGetProtoRevStatisticsWindowsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsWindowsResponse, error) {
+	out := new(QueryGetProtoRevStatisticsWindowsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
 	out := new(QueryGetProtoRevTokenPairArbRoutesResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes", in, out, opts...)
@@ -1840,6 +1982,9 @@ type QueryServer interface {
 	// arbitraged against and the number of trades and profits that have been
 	// accumulated for each route
 	GetProtoRevAllRouteStatistics(context.Context, *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
+	// GetProtoRevStatisticsWindows queries the route and pool statistics of the
+	// trades the module has executed during each of the most recent day epochs
+	GetProtoRevStatisticsWindows(context.Context, *QueryGetProtoRevStatisticsWindowsRequest) (*QueryGetProtoRevStatisticsWindowsResponse, error)
 	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
 	// is currently arbitraging
 	GetProtoRevTokenPairArbRoutes(context.Context, *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
@@ -1891,6 +2036,9 @@ func (*UnimplementedQueryServer) GetProtoRevStatisticsByRoute(ctx context.Contex
 func (*UnimplementedQueryServer) GetProtoRevAllRouteStatistics(ctx context.Context, req *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllRouteStatistics not implemented")
 }
+func (*UnimplementedQueryServer) GetProtoRevStatisticsWindows(ctx context.Context, req *QueryGetProtoRevStatisticsWindowsRequest) (*QueryGetProtoRevStatisticsWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevStatisticsWindows not implemented")
+}
 func (*UnimplementedQueryServer) GetProtoRevTokenPairArbRoutes(ctx context.Context, req *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevTokenPairArbRoutes not implemented")
 }
@@ -2034,6 +2182,24 @@ func _Query_GetProtoRevAllRouteStatistics_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetProtoRevStatisticsWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevStatisticsWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevStatisticsWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsWindows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevStatisticsWindows(ctx, req.(*QueryGetProtoRevStatisticsWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetProtoRevTokenPairArbRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetProtoRevTokenPairArbRoutesRequest)
 	if err := dec(in); err != nil {
@@ -2242,6 +2408,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetProtoRevAllRouteStatistics",
 			Handler:    _Query_GetProtoRevAllRouteStatistics_Handler,
 		},
+		{
+			MethodName: "GetProtoRevStatisticsWindows",
+			Handler:    _Query_GetProtoRevStatisticsWindows_Handler,
+		},
 		{
 			MethodName: "GetProtoRevTokenPairArbRoutes",
 			Handler:    _Query_GetProtoRevTokenPairArbRoutes_Handler,
@@ -2658,6 +2828,95 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGetProtoRevStatisticsWindowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevStatisticsWindowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevStatisticsWindowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NumWindows != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumWindows))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevStatisticsWindowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevStatisticsWindowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevStatisticsWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3371,6 +3630,41 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetProtoRevStatisticsWindowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumWindows != 0 {
+		n += 1 + sovQuery(uint64(m.NumWindows))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevStatisticsWindowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4513,6 +4807,231 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
+func (m *QueryGetProtoRevStatisticsWindowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsWindowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsWindowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumWindows", wireType)
+			}
+			m.NumWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevStatisticsWindowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsWindowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, StatisticsWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
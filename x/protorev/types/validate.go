@@ -170,3 +170,60 @@ func ValidateMaxPoolPointsPerTx(points uint64) error {
 
 	return nil
 }
+
+// ---------------------- Statistics Validation ---------------------- //
+// ValidateStatisticsWindows does some basic validation on the statistics windows passed into the module genesis.
+func ValidateStatisticsWindows(windows []StatisticsWindow) error {
+	seenWindows := make(map[uint64]bool)
+	for _, window := range windows {
+		// Ensure that the window is unique
+		if seenWindows[window.Window] {
+			return fmt.Errorf("duplicate statistics window %d", window.Window)
+		}
+		seenWindows[window.Window] = true
+
+		seenRoutes := make(map[string]bool)
+		for _, statistics := range window.RouteStatistics {
+			if len(statistics.Route) == 0 {
+				return fmt.Errorf("route statistics of window %d must have a route", window.Window)
+			}
+
+			routeKey := string(CreateRouteKey(statistics.Route))
+			if seenRoutes[routeKey] {
+				return fmt.Errorf("duplicate route statistics %v in window %d", statistics.Route, window.Window)
+			}
+			seenRoutes[routeKey] = true
+
+			if err := validateStatisticsTotals(statistics.Profits, statistics.NumberOfTrades); err != nil {
+				return err
+			}
+		}
+
+		seenPools := make(map[uint64]bool)
+		for _, statistics := range window.PoolStatistics {
+			if seenPools[statistics.PoolId] {
+				return fmt.Errorf("duplicate pool statistics %d in window %d", statistics.PoolId, window.Window)
+			}
+			seenPools[statistics.PoolId] = true
+
+			if err := validateStatisticsTotals(statistics.Profits, statistics.NumberOfTrades); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateStatisticsTotals validates the profits and number of trades of route or pool statistics.
+func validateStatisticsTotals(profits []sdk.Coin, numberOfTrades osmomath.Int) error {
+	if err := sdk.Coins(profits).Validate(); err != nil {
+		return err
+	}
+
+	if numberOfTrades.IsNil() || numberOfTrades.IsNegative() {
+		return errors.New("number of trades cannot be negative")
+	}
+
+	return nil
+}
@@ -98,7 +98,10 @@ func (aoa AllOf) Authenticate(ctx sdk.Context, request AuthenticationRequest) er
 			request.Signature = signatures[i]
 		}
 		if err := auth.Authenticate(ctx, request); err != nil {
-			return err
+			return CompositeAuthenticationError{
+				Err:      err,
+				Failures: []SubAuthenticatorFailure{{AuthenticatorId: request.AuthenticatorId, AuthenticatorType: auth.Type(), Err: err}},
+			}
 		}
 	}
 	return nil
@@ -92,6 +92,7 @@ func (aoa AnyOf) Authenticate(ctx sdk.Context, request AuthenticationRequest) er
 	}
 
 	var subAuthErrors []string
+	var failures []SubAuthenticatorFailure
 	var err error
 
 	// If the signature assignment is partitioned, we need to split the signatures and pass them to the sub-authenticators
@@ -120,11 +121,15 @@ func (aoa AnyOf) Authenticate(ctx sdk.Context, request AuthenticationRequest) er
 		// If the sub-authenticator fails, we want to continue to the next one.
 		// We accumulate any errors so that they can all be surfaced to the user
 		subAuthErrors = append(subAuthErrors, fmt.Sprintf("[%s (id = %s)] %s; ", auth.Type(), request.AuthenticatorId, err))
+		failures = append(failures, SubAuthenticatorFailure{AuthenticatorId: request.AuthenticatorId, AuthenticatorType: auth.Type(), Err: err})
 	}
 
 	if err != nil {
 		// return all errors
-		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "all sub-authenticators failed to authenticate: %s", strings.Join(subAuthErrors, "; "))
+		return CompositeAuthenticationError{
+			Err:      errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "all sub-authenticators failed to authenticate: %s", strings.Join(subAuthErrors, "; ")),
+			Failures: failures,
+		}
 	}
 
 	return nil
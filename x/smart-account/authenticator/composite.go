@@ -14,6 +14,30 @@ type SubAuthenticatorInitData struct {
 	Config []byte `json:"config"`
 }
 
+// SubAuthenticatorFailure is the failure of a sub-authenticator of a composite authenticator.
+type SubAuthenticatorFailure struct {
+	// AuthenticatorId is the composite id of the sub-authenticator, e.g. "2.1".
+	AuthenticatorId string
+	// AuthenticatorType is the type of the sub-authenticator.
+	AuthenticatorType string
+	Err               error
+}
+
+// CompositeAuthenticationError is returned by AllOf and AnyOf when they fail to authenticate because of
+// their sub-authenticators. It wraps the returned error and records the failing sub-authenticators.
+type CompositeAuthenticationError struct {
+	Err      error
+	Failures []SubAuthenticatorFailure
+}
+
+func (e CompositeAuthenticationError) Error() string {
+	return e.Err.Error()
+}
+
+func (e CompositeAuthenticationError) Unwrap() error {
+	return e.Err
+}
+
 func subTrack(
 	ctx sdk.Context,
 	request AuthenticationRequest,
@@ -0,0 +1,242 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v29/x/smart-account/types"
+)
+
+var _ Authenticator = &Session{}
+
+// maxSessionEndTime is 9999-12-31T23:59:59Z, the latest time with a sortable store encoding.
+const maxSessionEndTime = 253402300799
+
+// Session wraps another authenticator and only lets it authenticate messages within a window of
+// block times and/or block heights.
+//
+// When a session is added as a top level authenticator, its expiration is indexed in the store and
+// the smart account keeper removes it once it expires. Sessions nested in composite authenticators
+// are not removed, they just stop authenticating.
+type Session struct {
+	am       *AuthenticatorManager
+	storeKey storetypes.StoreKey
+
+	SubAuthenticator Authenticator
+	config           SessionConfig
+}
+
+// SessionConfig is the configuration of a session authenticator. Times are unix timestamps in seconds.
+// Numbers are encoded as strings, as required for authenticator data. Zero values leave a bound unset,
+// but at least one of EndTime and EndHeight must be set.
+type SessionConfig struct {
+	// StartTime is the first block time at which the session can authenticate.
+	StartTime uint64 `json:"start_time,string,omitempty"`
+	// EndTime is the block time from which the session expires.
+	EndTime uint64 `json:"end_time,string,omitempty"`
+	// StartHeight is the first block height at which the session can authenticate.
+	StartHeight uint64 `json:"start_height,string,omitempty"`
+	// EndHeight is the block height from which the session expires.
+	EndHeight uint64 `json:"end_height,string,omitempty"`
+	// Authenticator is the wrapped authenticator.
+	Authenticator SubAuthenticatorInitData `json:"authenticator"`
+}
+
+// NewSession creates a new Session authenticator that indexes expirations under the given store key.
+func NewSession(am *AuthenticatorManager, storeKey storetypes.StoreKey) Session {
+	return Session{
+		am:       am,
+		storeKey: storeKey,
+	}
+}
+
+// Type returns the type of the authenticator.
+func (s Session) Type() string {
+	return "Session"
+}
+
+// StaticGas returns the static gas of the wrapped authenticator.
+func (s Session) StaticGas() uint64 {
+	if s.SubAuthenticator == nil {
+		return 0
+	}
+	return s.SubAuthenticator.StaticGas()
+}
+
+// Initialize parses the session configuration and initializes the wrapped authenticator.
+func (s Session) Initialize(config []byte) (Authenticator, error) {
+	sessionConfig, err := parseSessionConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticatorCode := s.am.GetAuthenticatorByType(sessionConfig.Authenticator.Type)
+	if authenticatorCode == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "authenticator type %s is not registered", sessionConfig.Authenticator.Type)
+	}
+	instance, err := authenticatorCode.Initialize(sessionConfig.Authenticator.Config)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to initialize sub-authenticator (type = %s)", sessionConfig.Authenticator.Type)
+	}
+
+	s.SubAuthenticator = instance
+	s.config = sessionConfig
+	return s, nil
+}
+
+// Authenticate fails outside of the session window and otherwise delegates to the wrapped authenticator.
+func (s Session) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	if err := s.config.checkActive(ctx); err != nil {
+		return err
+	}
+	request.AuthenticatorId = compositeId(request.AuthenticatorId, 0)
+	return s.SubAuthenticator.Authenticate(ctx, request)
+}
+
+// Track delegates to the wrapped authenticator.
+func (s Session) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	request.AuthenticatorId = compositeId(request.AuthenticatorId, 0)
+	return s.SubAuthenticator.Track(ctx, request)
+}
+
+// ConfirmExecution delegates to the wrapped authenticator.
+func (s Session) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	request.AuthenticatorId = compositeId(request.AuthenticatorId, 0)
+	return s.SubAuthenticator.ConfirmExecution(ctx, request)
+}
+
+// OnAuthenticatorAdded validates the session configuration, calls OnAuthenticatorAdded on the wrapped
+// authenticator and indexes the expiration of top level sessions.
+func (s Session) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	sessionConfig, err := parseSessionConfig(config)
+	if err != nil {
+		return err
+	}
+
+	authenticatorCode := s.am.GetAuthenticatorByType(sessionConfig.Authenticator.Type)
+	if authenticatorCode == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "authenticator type %s is not registered", sessionConfig.Authenticator.Type)
+	}
+	err = authenticatorCode.OnAuthenticatorAdded(ctx, account, sessionConfig.Authenticator.Config, compositeId(authenticatorId, 0))
+	if err != nil {
+		return errorsmod.Wrapf(err, "sub-authenticator `OnAuthenticatorAdded` failed (sub-authenticator id = %s)", compositeId(authenticatorId, 0))
+	}
+
+	id, isTopLevel := topLevelAuthenticatorId(authenticatorId)
+	if !isTopLevel {
+		return nil
+	}
+	store := ctx.KVStore(s.storeKey)
+	for _, key := range sessionConfig.expirationKeys(account, id) {
+		store.Set(key, []byte{})
+	}
+	return nil
+}
+
+// SetExpirationIndex indexes the expiration of the top level session with the given configuration.
+// It is used in genesis import, which only runs OnAuthenticatorAdded to validate the imported authenticators.
+func (s Session) SetExpirationIndex(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId uint64) error {
+	sessionConfig, err := parseSessionConfig(config)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(s.storeKey)
+	for _, key := range sessionConfig.expirationKeys(account, authenticatorId) {
+		store.Set(key, []byte{})
+	}
+	return nil
+}
+
+// OnAuthenticatorRemoved calls OnAuthenticatorRemoved on the wrapped authenticator and removes the
+// expiration index of top level sessions.
+func (s Session) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	sessionConfig, err := parseSessionConfig(config)
+	if err != nil {
+		return err
+	}
+
+	authenticatorCode := s.am.GetAuthenticatorByType(sessionConfig.Authenticator.Type)
+	if authenticatorCode == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "authenticator type %s is not registered", sessionConfig.Authenticator.Type)
+	}
+	err = authenticatorCode.OnAuthenticatorRemoved(ctx, account, sessionConfig.Authenticator.Config, compositeId(authenticatorId, 0))
+	if err != nil {
+		return errorsmod.Wrapf(err, "sub-authenticator `OnAuthenticatorRemoved` failed (sub-authenticator id = %s)", compositeId(authenticatorId, 0))
+	}
+
+	id, isTopLevel := topLevelAuthenticatorId(authenticatorId)
+	if !isTopLevel {
+		return nil
+	}
+	store := ctx.KVStore(s.storeKey)
+	for _, key := range sessionConfig.expirationKeys(account, id) {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// checkActive returns an error if the current block is outside of the session window.
+func (c SessionConfig) checkActive(ctx sdk.Context) error {
+	blockTime := uint64(ctx.BlockTime().Unix())
+	blockHeight := uint64(ctx.BlockHeight())
+
+	if blockTime < c.StartTime || blockHeight < c.StartHeight {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session has not started (start time = %d, start height = %d)", c.StartTime, c.StartHeight)
+	}
+	if (c.EndTime != 0 && blockTime >= c.EndTime) || (c.EndHeight != 0 && blockHeight >= c.EndHeight) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session expired (end time = %d, end height = %d)", c.EndTime, c.EndHeight)
+	}
+	return nil
+}
+
+// expirationKeys returns the keys indexing the expiration of the session.
+func (c SessionConfig) expirationKeys(account sdk.AccAddress, authenticatorId uint64) [][]byte {
+	var keys [][]byte
+	if c.EndTime != 0 {
+		keys = append(keys, types.KeySessionExpirationTime(time.Unix(int64(c.EndTime), 0), account, authenticatorId))
+	}
+	if c.EndHeight != 0 {
+		keys = append(keys, types.KeySessionExpirationHeight(c.EndHeight, account, authenticatorId))
+	}
+	return keys
+}
+
+// topLevelAuthenticatorId returns the id of the authenticator and whether it is a top level authenticator,
+// as opposed to a sub-authenticator identified by a composite id.
+func topLevelAuthenticatorId(authenticatorId string) (uint64, bool) {
+	id, err := strconv.ParseUint(authenticatorId, 10, 64)
+	return id, err == nil
+}
+
+func parseSessionConfig(config []byte) (SessionConfig, error) {
+	var sessionConfig SessionConfig
+	if err := json.Unmarshal(config, &sessionConfig); err != nil {
+		return SessionConfig{}, errorsmod.Wrap(err, "invalid session configuration")
+	}
+
+	if sessionConfig.EndTime == 0 && sessionConfig.EndHeight == 0 {
+		return SessionConfig{}, fmt.Errorf("session must have an end time or an end height")
+	}
+	if sessionConfig.EndTime > maxSessionEndTime {
+		return SessionConfig{}, fmt.Errorf("session end time %d is out of range", sessionConfig.EndTime)
+	}
+	if sessionConfig.EndTime != 0 && sessionConfig.EndTime <= sessionConfig.StartTime {
+		return SessionConfig{}, fmt.Errorf("session end time must be after its start time")
+	}
+	if sessionConfig.EndHeight != 0 && sessionConfig.EndHeight <= sessionConfig.StartHeight {
+		return SessionConfig{}, fmt.Errorf("session end height must be after its start height")
+	}
+	if sessionConfig.Authenticator.Type == "" {
+		return SessionConfig{}, fmt.Errorf("session must wrap an authenticator")
+	}
+	return sessionConfig, nil
+}
@@ -0,0 +1,177 @@
+package authenticator_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v29/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v29/x/smart-account/testutils"
+	smartaccounttypes "github.com/osmosis-labs/osmosis/v29/x/smart-account/types"
+)
+
+type SessionTest struct {
+	BaseAuthenticatorSuite
+
+	Session       authenticator.Session
+	alwaysApprove testutils.TestingAuthenticator
+	neverApprove  testutils.TestingAuthenticator
+}
+
+func TestSessionTest(t *testing.T) {
+	suite.Run(t, new(SessionTest))
+}
+
+func (s *SessionTest) SetupTest() {
+	s.SetupKeys()
+	am := authenticator.NewAuthenticatorManager()
+	s.alwaysApprove = testutils.TestingAuthenticator{Approve: testutils.Always, GasConsumption: 10, Confirm: testutils.Always}
+	s.neverApprove = testutils.TestingAuthenticator{Approve: testutils.Never, GasConsumption: 10, Confirm: testutils.Never}
+	am.RegisterAuthenticator(s.alwaysApprove)
+	am.RegisterAuthenticator(s.neverApprove)
+
+	s.Session = authenticator.NewSession(am, s.OsmosisApp.GetKey(smartaccounttypes.StoreKey))
+	am.RegisterAuthenticator(s.Session)
+
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(1_000, 0)).WithBlockHeight(100)
+}
+
+func (s *SessionTest) TearDownTest() {
+	os.RemoveAll(s.HomeDir)
+}
+
+func (s *SessionTest) sessionConfig(config authenticator.SessionConfig, sub testutils.TestingAuthenticator) []byte {
+	config.Authenticator = authenticator.SubAuthenticatorInitData{Type: sub.Type(), Config: []byte{}}
+	bz, err := json.Marshal(config)
+	s.Require().NoError(err)
+	return bz
+}
+
+func (s *SessionTest) TestSessionWindow() {
+	tests := map[string]struct {
+		config  authenticator.SessionConfig
+		sub     testutils.TestingAuthenticator
+		success bool
+	}{
+		"within time window": {
+			config:  authenticator.SessionConfig{StartTime: 900, EndTime: 1_100},
+			sub:     s.alwaysApprove,
+			success: true,
+		},
+		"within height window": {
+			config:  authenticator.SessionConfig{StartHeight: 100, EndHeight: 101},
+			sub:     s.alwaysApprove,
+			success: true,
+		},
+		"within window, sub-authenticator rejects": {
+			config: authenticator.SessionConfig{EndTime: 1_100},
+			sub:    s.neverApprove,
+		},
+		"before start time": {
+			config: authenticator.SessionConfig{StartTime: 1_001, EndTime: 1_100},
+			sub:    s.alwaysApprove,
+		},
+		"at end time": {
+			config: authenticator.SessionConfig{EndTime: 1_000},
+			sub:    s.alwaysApprove,
+		},
+		"before start height": {
+			config: authenticator.SessionConfig{StartHeight: 101, EndHeight: 200},
+			sub:    s.alwaysApprove,
+		},
+		"at end height": {
+			config: authenticator.SessionConfig{EndTime: 1_100, EndHeight: 100},
+			sub:    s.alwaysApprove,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			session, err := s.Session.Initialize(s.sessionConfig(tc.config, tc.sub))
+			s.Require().NoError(err)
+			s.Require().Equal(tc.sub.StaticGas(), session.StaticGas())
+
+			err = session.Authenticate(s.Ctx, authenticator.AuthenticationRequest{AuthenticatorId: "1"})
+			if tc.success {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *SessionTest) TestSessionConfigValidation() {
+	tests := map[string]struct {
+		config  []byte
+		success bool
+	}{
+		"valid": {
+			config:  s.sessionConfig(authenticator.SessionConfig{EndHeight: 200}, s.alwaysApprove),
+			success: true,
+		},
+		"no end": {
+			config: s.sessionConfig(authenticator.SessionConfig{StartTime: 10}, s.alwaysApprove),
+		},
+		"end time before start time": {
+			config: s.sessionConfig(authenticator.SessionConfig{StartTime: 10, EndTime: 10}, s.alwaysApprove),
+		},
+		"end height before start height": {
+			config: s.sessionConfig(authenticator.SessionConfig{StartHeight: 10, EndHeight: 5}, s.alwaysApprove),
+		},
+		"unregistered sub-authenticator": {
+			config: []byte(`{"end_height": "200", "authenticator": {"type": "Unknown", "config": ""}}`),
+		},
+		"no sub-authenticator": {
+			config: []byte(`{"end_height": "200"}`),
+		},
+		"invalid json": {
+			config: []byte(`{"end_height": 200}`),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			err := s.Session.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], tc.config, "1")
+			if tc.success {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *SessionTest) TestSessionExpirationIndex() {
+	account := s.TestAccAddress[0]
+	endTime := time.Unix(2_000, 0)
+	config := s.sessionConfig(authenticator.SessionConfig{EndTime: uint64(endTime.Unix()), EndHeight: 300}, s.alwaysApprove)
+	store := s.Ctx.KVStore(s.OsmosisApp.GetKey(smartaccounttypes.StoreKey))
+	timeKey := smartaccounttypes.KeySessionExpirationTime(endTime, account, 7)
+	heightKey := smartaccounttypes.KeySessionExpirationHeight(300, account, 7)
+
+	// Sessions nested in composite authenticators are not indexed.
+	s.Require().NoError(s.Session.OnAuthenticatorAdded(s.Ctx, account, config, "7.1"))
+	s.Require().False(store.Has(timeKey))
+	s.Require().False(store.Has(heightKey))
+
+	s.Require().NoError(s.Session.OnAuthenticatorAdded(s.Ctx, account, config, "7"))
+	s.Require().True(store.Has(timeKey))
+	s.Require().True(store.Has(heightKey))
+
+	parsedAccount, id, err := smartaccounttypes.ParseSessionExpirationTimeKey(timeKey)
+	s.Require().NoError(err)
+	s.Require().Equal(account, parsedAccount)
+	s.Require().Equal(uint64(7), id)
+	parsedAccount, id, err = smartaccounttypes.ParseSessionExpirationHeightKey(heightKey)
+	s.Require().NoError(err)
+	s.Require().Equal(account, parsedAccount)
+	s.Require().Equal(uint64(7), id)
+
+	s.Require().NoError(s.Session.OnAuthenticatorRemoved(s.Ctx, account, config, "7"))
+	s.Require().False(store.Has(timeKey))
+	s.Require().False(store.Has(heightKey))
+}
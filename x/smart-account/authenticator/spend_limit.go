@@ -0,0 +1,258 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/smart-account/types"
+)
+
+var _ Authenticator = &SpendLimit{}
+
+// SpendLimit caps how much of each tracked denom an account can spend within a period.
+// Spending is valued in a quote denom using the arithmetic TWAP of a configured pool.
+//
+// The balances of the tracked denoms are recorded in Track and compared to the balances
+// after execution in ConfirmExecution. Any decrease counts towards the limit of that denom,
+// and the transaction is rejected if the value spent in the current period exceeds it.
+//
+// SpendLimit does not verify signatures, so it is meant to be composed with other
+// authenticators, e.g. AllOf(SignatureVerification, SpendLimit).
+type SpendLimit struct {
+	storeKey   storetypes.StoreKey
+	bankKeeper types.BankKeeper
+	twapKeeper types.TwapKeeper
+
+	config SpendLimitConfig
+}
+
+// SpendLimitConfig is the configuration of a spend limit authenticator.
+// Numbers are encoded as strings, as required for authenticator data.
+type SpendLimitConfig struct {
+	// QuoteDenom is the denom every limit is expressed in.
+	QuoteDenom string `json:"quote_denom"`
+	// Period is the length of a spending period in seconds. A new period starts with the
+	// first spend made after the previous period elapsed.
+	Period uint64 `json:"period,string"`
+	// TwapDuration is the duration in seconds of the TWAP used to value spending.
+	TwapDuration uint64 `json:"twap_duration,string"`
+	// Limits are the per denom caps.
+	Limits []DenomSpendLimit `json:"limits"`
+}
+
+// DenomSpendLimit is the maximum value of Denom that can be spent in a period, in units of the quote denom.
+// PoolId is the pool used to price Denom against the quote denom and is ignored when Denom is the quote denom.
+type DenomSpendLimit struct {
+	Denom  string       `json:"denom"`
+	PoolId uint64       `json:"pool_id,string"`
+	Limit  osmomath.Int `json:"limit"`
+}
+
+// SpendLimitState is the spending of an account tracked by a spend limit authenticator.
+type SpendLimitState struct {
+	PeriodStart time.Time `json:"period_start"`
+	// Spent is the value spent per tracked denom in the current period, in units of the quote denom.
+	Spent sdk.Coins `json:"spent"`
+	// PreExecutionBalances are the balances of the tracked denoms recorded in Track.
+	PreExecutionBalances sdk.Coins `json:"pre_execution_balances,omitempty"`
+}
+
+// NewSpendLimit creates a new SpendLimit authenticator that stores its state under the given store key.
+func NewSpendLimit(storeKey storetypes.StoreKey, bankKeeper types.BankKeeper, twapKeeper types.TwapKeeper) SpendLimit {
+	return SpendLimit{
+		storeKey:   storeKey,
+		bankKeeper: bankKeeper,
+		twapKeeper: twapKeeper,
+	}
+}
+
+// Type returns the type of the authenticator.
+func (sl SpendLimit) Type() string {
+	return "SpendLimit"
+}
+
+// StaticGas returns the static gas amount for the authenticator. Currently, it's set to zero.
+func (sl SpendLimit) StaticGas() uint64 {
+	return 0
+}
+
+// Initialize sets up the authenticator with the given spend limit configuration.
+func (sl SpendLimit) Initialize(config []byte) (Authenticator, error) {
+	spendLimitConfig, err := parseSpendLimitConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	sl.config = spendLimitConfig
+	return sl, nil
+}
+
+// Authenticate is a no-op. Spending can only be known after execution, so limits are enforced in ConfirmExecution.
+func (sl SpendLimit) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+// Track records the balances of the tracked denoms before the messages are executed.
+func (sl SpendLimit) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	state, err := sl.getState(ctx, request.Account, request.AuthenticatorId)
+	if err != nil {
+		return err
+	}
+	state.PreExecutionBalances = sl.trackedBalances(ctx, request.Account)
+	return sl.setState(ctx, request.Account, request.AuthenticatorId, state)
+}
+
+// ConfirmExecution values the decrease of each tracked balance since Track in the quote denom, adds it
+// to the spending of the current period and fails if any limit is exceeded.
+func (sl SpendLimit) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	state, err := sl.getState(ctx, request.Account, request.AuthenticatorId)
+	if err != nil {
+		return err
+	}
+
+	// Track is called for every message of the transaction before execution, while ConfirmExecution is
+	// called for every message after all of them were executed. The balances recorded in Track are
+	// consumed by the first call so that spending is only accounted once per transaction.
+	if state.PreExecutionBalances == nil {
+		return nil
+	}
+
+	blockTime := ctx.BlockTime()
+	if blockTime.Sub(state.PeriodStart) >= time.Duration(sl.config.Period)*time.Second {
+		state.PeriodStart = blockTime
+		state.Spent = sdk.NewCoins()
+	}
+
+	for _, limit := range sl.config.Limits {
+		before := state.PreExecutionBalances.AmountOf(limit.Denom)
+		after := sl.bankKeeper.GetBalance(ctx, request.Account, limit.Denom).Amount
+		if !before.GT(after) {
+			continue
+		}
+
+		value, err := sl.valueInQuoteDenom(ctx, limit, before.Sub(after))
+		if err != nil {
+			return err
+		}
+
+		spent := state.Spent.AmountOf(limit.Denom).Add(value)
+		if spent.GT(limit.Limit) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+				"spend limit exceeded: spent %s%s worth of %s, limit is %s%s", spent, sl.config.QuoteDenom, limit.Denom, limit.Limit, sl.config.QuoteDenom)
+		}
+		state.Spent = state.Spent.Add(sdk.NewCoin(limit.Denom, value))
+	}
+
+	state.PreExecutionBalances = nil
+	return sl.setState(ctx, request.Account, request.AuthenticatorId, state)
+}
+
+// OnAuthenticatorAdded validates the spend limit configuration.
+func (sl SpendLimit) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	_, err := parseSpendLimitConfig(config)
+	return err
+}
+
+// OnAuthenticatorRemoved deletes the tracked spending of the account.
+func (sl SpendLimit) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	ctx.KVStore(sl.storeKey).Delete(types.KeySpendLimit(account, authenticatorId))
+	return nil
+}
+
+// GetSpendLimitState returns the spending tracked for the given account and authenticator id.
+func (sl SpendLimit) GetSpendLimitState(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) (SpendLimitState, error) {
+	return sl.getState(ctx, account, authenticatorId)
+}
+
+// valueInQuoteDenom converts amount of the limit's denom to units of the quote denom.
+func (sl SpendLimit) valueInQuoteDenom(ctx sdk.Context, limit DenomSpendLimit, amount osmomath.Int) (osmomath.Int, error) {
+	if limit.Denom == sl.config.QuoteDenom {
+		return amount, nil
+	}
+
+	startTime := ctx.BlockTime().Add(-time.Duration(sl.config.TwapDuration) * time.Second)
+	price, err := sl.twapKeeper.GetArithmeticTwapToNow(ctx, limit.PoolId, limit.Denom, sl.config.QuoteDenom, startTime)
+	if err != nil {
+		return osmomath.Int{}, errorsmod.Wrapf(err, "failed to price %s in %s", limit.Denom, sl.config.QuoteDenom)
+	}
+
+	// Round up so that spending is never undervalued.
+	return price.MulInt(amount).Ceil().TruncateInt(), nil
+}
+
+func (sl SpendLimit) trackedBalances(ctx sdk.Context, account sdk.AccAddress) sdk.Coins {
+	balances := sdk.NewCoins()
+	for _, limit := range sl.config.Limits {
+		balances = balances.Add(sl.bankKeeper.GetBalance(ctx, account, limit.Denom))
+	}
+	return balances
+}
+
+func (sl SpendLimit) getState(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) (SpendLimitState, error) {
+	state := SpendLimitState{Spent: sdk.NewCoins()}
+	bz := ctx.KVStore(sl.storeKey).Get(types.KeySpendLimit(account, authenticatorId))
+	if bz == nil {
+		return state, nil
+	}
+	if err := json.Unmarshal(bz, &state); err != nil {
+		return SpendLimitState{}, errorsmod.Wrap(err, "failed to unmarshal spend limit state")
+	}
+	return state, nil
+}
+
+func (sl SpendLimit) setState(ctx sdk.Context, account sdk.AccAddress, authenticatorId string, state SpendLimitState) error {
+	bz, err := json.Marshal(state)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to marshal spend limit state")
+	}
+	ctx.KVStore(sl.storeKey).Set(types.KeySpendLimit(account, authenticatorId), bz)
+	return nil
+}
+
+func parseSpendLimitConfig(config []byte) (SpendLimitConfig, error) {
+	var spendLimitConfig SpendLimitConfig
+	if err := json.Unmarshal(config, &spendLimitConfig); err != nil {
+		return SpendLimitConfig{}, errorsmod.Wrap(err, "invalid spend limit configuration")
+	}
+
+	if err := sdk.ValidateDenom(spendLimitConfig.QuoteDenom); err != nil {
+		return SpendLimitConfig{}, errorsmod.Wrap(err, "invalid quote denom")
+	}
+	if spendLimitConfig.Period == 0 {
+		return SpendLimitConfig{}, fmt.Errorf("spend limit period must be positive")
+	}
+	if len(spendLimitConfig.Limits) == 0 {
+		return SpendLimitConfig{}, fmt.Errorf("at least one spend limit must be set")
+	}
+
+	seenDenoms := make(map[string]bool, len(spendLimitConfig.Limits))
+	for _, limit := range spendLimitConfig.Limits {
+		if err := sdk.ValidateDenom(limit.Denom); err != nil {
+			return SpendLimitConfig{}, errorsmod.Wrap(err, "invalid spend limit denom")
+		}
+		if seenDenoms[limit.Denom] {
+			return SpendLimitConfig{}, fmt.Errorf("duplicate spend limit for denom %s", limit.Denom)
+		}
+		seenDenoms[limit.Denom] = true
+
+		if limit.Limit.IsNil() || limit.Limit.IsNegative() {
+			return SpendLimitConfig{}, fmt.Errorf("spend limit for denom %s must not be negative", limit.Denom)
+		}
+		if limit.Denom != spendLimitConfig.QuoteDenom {
+			if limit.PoolId == 0 {
+				return SpendLimitConfig{}, fmt.Errorf("a pool id is required to price %s in %s", limit.Denom, spendLimitConfig.QuoteDenom)
+			}
+			if spendLimitConfig.TwapDuration == 0 {
+				return SpendLimitConfig{}, fmt.Errorf("twap duration must be positive")
+			}
+		}
+	}
+	return spendLimitConfig, nil
+}
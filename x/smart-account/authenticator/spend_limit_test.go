@@ -0,0 +1,186 @@
+package authenticator_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/smart-account/authenticator"
+	smartaccounttypes "github.com/osmosis-labs/osmosis/v29/x/smart-account/types"
+)
+
+const (
+	spendLimitQuoteDenom = "uusdc"
+	spendLimitBaseDenom  = "uosmo"
+)
+
+// fixedPriceTwapKeeper prices every base asset at a fixed price in the quote asset.
+type fixedPriceTwapKeeper struct {
+	price osmomath.Dec
+}
+
+func (k fixedPriceTwapKeeper) GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (osmomath.Dec, error) {
+	if poolId != 1 {
+		return osmomath.Dec{}, fmt.Errorf("pool %d not found", poolId)
+	}
+	return k.price, nil
+}
+
+type SpendLimitTest struct {
+	BaseAuthenticatorSuite
+
+	SpendLimit authenticator.SpendLimit
+}
+
+func TestSpendLimitTest(t *testing.T) {
+	suite.Run(t, new(SpendLimitTest))
+}
+
+func (s *SpendLimitTest) SetupTest() {
+	s.SetupKeys()
+	s.SpendLimit = authenticator.NewSpendLimit(
+		s.OsmosisApp.GetKey(smartaccounttypes.StoreKey),
+		s.OsmosisApp.BankKeeper,
+		fixedPriceTwapKeeper{price: osmomath.NewDec(2)},
+	)
+}
+
+func (s *SpendLimitTest) TearDownTest() {
+	os.RemoveAll(s.HomeDir)
+}
+
+func spendLimitConfig(poolId uint64) []byte {
+	return []byte(fmt.Sprintf(`{
+		"quote_denom": "%s",
+		"period": "86400",
+		"twap_duration": "3600",
+		"limits": [
+			{"denom": "%s", "limit": "1000"},
+			{"denom": "%s", "pool_id": "%d", "limit": "1000"}
+		]
+	}`, spendLimitQuoteDenom, spendLimitQuoteDenom, spendLimitBaseDenom, poolId))
+}
+
+func (s *SpendLimitTest) TestOnAuthenticatorAdded() {
+	tests := map[string]struct {
+		config      string
+		expectedErr bool
+	}{
+		"valid": {
+			config: string(spendLimitConfig(1)),
+		},
+		"invalid json": {
+			config:      `{"quote_denom":`,
+			expectedErr: true,
+		},
+		"zero period": {
+			config:      `{"quote_denom": "uusdc", "period": "0", "limits": [{"denom": "uusdc", "limit": "1"}]}`,
+			expectedErr: true,
+		},
+		"no limits": {
+			config:      `{"quote_denom": "uusdc", "period": "10", "limits": []}`,
+			expectedErr: true,
+		},
+		"duplicate denom": {
+			config:      `{"quote_denom": "uusdc", "period": "10", "limits": [{"denom": "uusdc", "limit": "1"}, {"denom": "uusdc", "limit": "2"}]}`,
+			expectedErr: true,
+		},
+		"negative limit": {
+			config:      `{"quote_denom": "uusdc", "period": "10", "limits": [{"denom": "uusdc", "limit": "-1"}]}`,
+			expectedErr: true,
+		},
+		"missing pool id": {
+			config:      `{"quote_denom": "uusdc", "period": "10", "twap_duration": "10", "limits": [{"denom": "uosmo", "limit": "1"}]}`,
+			expectedErr: true,
+		},
+		"missing twap duration": {
+			config:      `{"quote_denom": "uusdc", "period": "10", "limits": [{"denom": "uosmo", "pool_id": "1", "limit": "1"}]}`,
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			err := s.SpendLimit.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], []byte(tc.config), "1")
+			if tc.expectedErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *SpendLimitTest) TestSpendLimit() {
+	account := s.TestAccAddress[0]
+	recipient := s.TestAccAddress[1]
+	s.FundAcc(account, sdk.NewCoins(sdk.NewInt64Coin(spendLimitQuoteDenom, 10_000), sdk.NewInt64Coin(spendLimitBaseDenom, 10_000)))
+
+	a11r, err := s.SpendLimit.Initialize(spendLimitConfig(1))
+	s.Require().NoError(err)
+	request := authenticator.AuthenticationRequest{Account: account, AuthenticatorId: "1"}
+
+	// execute tracks the tracked balances, spends the given coins and confirms the execution.
+	execute := func(spend sdk.Coins) error {
+		cacheCtx, write := s.Ctx.CacheContext()
+		s.Require().NoError(a11r.Track(cacheCtx, request))
+		s.Require().NoError(s.OsmosisApp.BankKeeper.SendCoins(cacheCtx, account, recipient, spend))
+		if err := a11r.ConfirmExecution(cacheCtx, request); err != nil {
+			return err
+		}
+		// A second message of the same transaction does not account the spending twice.
+		s.Require().NoError(a11r.ConfirmExecution(cacheCtx, request))
+		write()
+		return nil
+	}
+
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	s.Require().NoError(execute(sdk.NewCoins(sdk.NewInt64Coin(spendLimitQuoteDenom, 600))))
+	// 300 uosmo are worth 600 uusdc.
+	s.Require().NoError(execute(sdk.NewCoins(sdk.NewInt64Coin(spendLimitBaseDenom, 300))))
+
+	state, err := s.SpendLimit.GetSpendLimitState(s.Ctx, account, "1")
+	s.Require().NoError(err)
+	s.Require().Equal(s.Ctx.BlockTime().UTC(), state.PeriodStart.UTC())
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(spendLimitQuoteDenom, 600), sdk.NewInt64Coin(spendLimitBaseDenom, 600)), state.Spent)
+	s.Require().Nil(state.PreExecutionBalances)
+
+	// Limits are per denom.
+	err = execute(sdk.NewCoins(sdk.NewInt64Coin(spendLimitQuoteDenom, 401)))
+	s.Require().ErrorContains(err, "spend limit exceeded")
+	err = execute(sdk.NewCoins(sdk.NewInt64Coin(spendLimitBaseDenom, 201)))
+	s.Require().ErrorContains(err, "spend limit exceeded")
+	s.Require().NoError(execute(sdk.NewCoins(sdk.NewInt64Coin(spendLimitQuoteDenom, 400))))
+
+	// Untracked denoms are not limited.
+	s.FundAcc(account, sdk.NewCoins(sdk.NewInt64Coin("ufoo", 10_000)))
+	s.Require().NoError(execute(sdk.NewCoins(sdk.NewInt64Coin("ufoo", 10_000))))
+
+	// Spending is reset once the period elapsed.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(24 * time.Hour))
+	s.Require().NoError(execute(sdk.NewCoins(sdk.NewInt64Coin(spendLimitQuoteDenom, 1000))))
+
+	// Removing the authenticator deletes its state.
+	s.Require().NoError(a11r.OnAuthenticatorRemoved(s.Ctx, account, spendLimitConfig(1), "1"))
+	state, err = s.SpendLimit.GetSpendLimitState(s.Ctx, account, "1")
+	s.Require().NoError(err)
+	s.Require().True(state.Spent.IsZero())
+}
+
+func (s *SpendLimitTest) TestSpendLimitPricingError() {
+	account := s.TestAccAddress[0]
+	s.FundAcc(account, sdk.NewCoins(sdk.NewInt64Coin(spendLimitBaseDenom, 10_000)))
+
+	a11r, err := s.SpendLimit.Initialize(spendLimitConfig(2))
+	s.Require().NoError(err)
+	request := authenticator.AuthenticationRequest{Account: account, AuthenticatorId: "1"}
+
+	s.Require().NoError(a11r.Track(s.Ctx, request))
+	s.Require().NoError(s.OsmosisApp.BankKeeper.SendCoins(s.Ctx, account, s.TestAccAddress[1], sdk.NewCoins(sdk.NewInt64Coin(spendLimitBaseDenom, 1))))
+	s.Require().ErrorContains(a11r.ConfirmExecution(s.Ctx, request), "failed to price")
+}
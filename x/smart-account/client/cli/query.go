@@ -1,6 +1,14 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/spf13/cobra"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
@@ -12,6 +20,7 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdAuthenticators)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdAuthenticator)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdParams)
+	cmd.AddCommand(GetCmdSimulateAuthentication())
 
 	return cmd
 }
@@ -42,3 +51,56 @@ func GetCmdParams() (*osmocli.QueryDescriptor, *types.QueryParamsRequest) {
 {{.CommandPrefix}} params`,
 	}, &types.QueryParamsRequest{}
 }
+
+// GetCmdSimulateAuthentication returns the command simulating the authentication of a transaction.
+func GetCmdSimulateAuthentication() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-authentication <tx-file> <authenticatorID>",
+		Short: "Simulate the authentication of every message of a transaction with an authenticator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate the authentication of every message of a JSON encoded transaction, e.g. generated with --generate-only,
+with the selected authenticator. The transaction needs one signature entry per signer, signatures can be empty.
+Example:
+$ %s query smartaccount simulate-authentication tx.json 17
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			tx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			if err != nil {
+				return err
+			}
+
+			authenticatorId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SimulateAuthentication(cmd.Context(), &types.SimulateAuthenticationRequest{
+				TxBytes:         txBytes,
+				AuthenticatorId: authenticatorId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -38,6 +38,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			}
 		}
 	}
+
+	for i := range genState.SpendLimitData {
+		if err := k.SetSpendLimitData(ctx, genState.SpendLimitData[i]); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -52,5 +58,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 	genesis.AuthenticatorData = allAuthenticators
 
+	spendLimitData, err := k.GetAllSpendLimitData(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.SpendLimitData = spendLimitData
+
 	return genesis
 }
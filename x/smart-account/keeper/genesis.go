@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v29/x/smart-account/authenticator"
 	"github.com/osmosis-labs/osmosis/v29/x/smart-account/types"
 
 	storetypes "cosmossdk.io/store/types"
@@ -76,5 +78,54 @@ func (k Keeper) AddAuthenticatorWithId(ctx sdk.Context, account sdk.AccAddress,
 			Type:   authenticatorType,
 			Config: config,
 		})
+
+	// the changes of OnAuthenticatorAdded are discarded above, so the expiration of sessions is indexed here
+	// for them to be removed once they expire.
+	if session, ok := impl.(authenticator.Session); ok {
+		return session.SetExpirationIndex(ctx, account, config, id)
+	}
+	return nil
+}
+
+// GetAllSpendLimitData is used in genesis export to export the spending tracked by all spend limit authenticators
+func (k Keeper) GetAllSpendLimitData(ctx sdk.Context) ([]types.SpendLimitData, error) {
+	var spendLimitData []types.SpendLimitData
+
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeySpendLimitPrefixId())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// Extract account address and authenticator id from key
+		keyElements := strings.Split(string(iterator.Key()), types.KeySeparator)
+		if len(keyElements) != 4 {
+			return nil, fmt.Errorf("invalid spend limit key %s", iterator.Key())
+		}
+
+		spendLimitData = append(spendLimitData, types.SpendLimitData{
+			Address:         keyElements[1],
+			AuthenticatorId: keyElements[2],
+			State:           iterator.Value(),
+		})
+	}
+
+	return spendLimitData, nil
+}
+
+// SetSpendLimitData sets the spending tracked by a spend limit authenticator, this function is used in genesis import
+func (k Keeper) SetSpendLimitData(ctx sdk.Context, spendLimitData types.SpendLimitData) error {
+	account, err := sdk.AccAddressFromBech32(spendLimitData.Address)
+	if err != nil {
+		return err
+	}
+	if spendLimitData.AuthenticatorId == "" {
+		return fmt.Errorf("spend limit authenticator id of %s is empty", spendLimitData.Address)
+	}
+
+	var state authenticator.SpendLimitState
+	if err := json.Unmarshal(spendLimitData.State, &state); err != nil {
+		return fmt.Errorf("invalid spend limit state of authenticator %s of %s: %w", spendLimitData.AuthenticatorId, spendLimitData.Address, err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.KeySpendLimit(account, spendLimitData.AuthenticatorId), spendLimitData.State)
 	return nil
 }
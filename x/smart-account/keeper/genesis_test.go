@@ -2,10 +2,13 @@ package keeper_test
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	smartaccount "github.com/osmosis-labs/osmosis/v29/x/smart-account"
 	"github.com/osmosis-labs/osmosis/v29/x/smart-account/authenticator"
 )
 
@@ -93,3 +96,79 @@ func (s *KeeperTestSuite) TestKeeper_GetAllAuthenticatorDataGenesis() {
 	s.Require().Equal(5, len(authenticators[0].Authenticators), "Getting authenticators returning incorrect data")
 	s.Require().Equal(accAddress.String(), authenticators[0].Address, "Authenticator Address is incorrect")
 }
+
+func (s *KeeperTestSuite) TestKeeper_ImportedSessionExpires() {
+	accAddress := s.TestAccs[0]
+	endHeight := uint64(s.Ctx.BlockHeight() + 10)
+
+	config, err := json.Marshal(authenticator.SessionConfig{
+		EndHeight: endHeight,
+		Authenticator: authenticator.SubAuthenticatorInitData{
+			Type:   "MessageFilter",
+			Config: []byte(`{"@type":"/cosmos.bank.v1beta1.MsgSend"}`),
+		},
+	})
+	s.Require().NoError(err)
+	id, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, accAddress, "Session", config)
+	s.Require().NoError(err)
+
+	// Export the state and import it in a fresh chain.
+	genesis := smartaccount.ExportGenesis(s.Ctx, *s.App.SmartAccountKeeper)
+	s.SetupTest()
+	smartaccount.InitGenesis(s.Ctx, *s.App.SmartAccountKeeper, *genesis)
+
+	authenticators, err := s.App.SmartAccountKeeper.GetAuthenticatorDataForAccount(s.Ctx, accAddress)
+	s.Require().NoError(err)
+	s.Require().Len(authenticators, 1)
+	s.Require().Equal(id, authenticators[0].Id)
+
+	// The imported session is removed once it expires.
+	s.Ctx = s.Ctx.WithBlockHeight(int64(endHeight))
+	s.App.SmartAccountKeeper.RemoveExpiredSessions(s.Ctx, 100)
+	authenticators, err = s.App.SmartAccountKeeper.GetAuthenticatorDataForAccount(s.Ctx, accAddress)
+	s.Require().NoError(err)
+	s.Require().Empty(authenticators)
+}
+
+func (s *KeeperTestSuite) TestKeeper_ImportedSpendLimitState() {
+	accAddress := s.TestAccs[0]
+	s.FundAcc(accAddress, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10_000)))
+
+	config := []byte(`{"quote_denom": "uosmo", "period": "86400", "limits": [{"denom": "uosmo", "limit": "1000"}]}`)
+	id, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, accAddress, "SpendLimit", config)
+	s.Require().NoError(err)
+
+	// Spend within the current period.
+	spendLimit, ok := s.App.AuthenticatorManager.GetAuthenticatorByType("SpendLimit").(authenticator.SpendLimit)
+	s.Require().True(ok)
+	a11r, err := spendLimit.Initialize(config)
+	s.Require().NoError(err)
+	request := authenticator.AuthenticationRequest{Account: accAddress, AuthenticatorId: strconv.FormatUint(id, 10)}
+	s.Require().NoError(a11r.Track(s.Ctx, request))
+	s.Require().NoError(s.App.BankKeeper.SendCoins(s.Ctx, accAddress, s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin("uosmo", 600))))
+	s.Require().NoError(a11r.ConfirmExecution(s.Ctx, request))
+
+	expectedState, err := spendLimit.GetSpendLimitState(s.Ctx, accAddress, request.AuthenticatorId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 600)), expectedState.Spent)
+
+	// Export the state and import it in a fresh chain.
+	genesis := smartaccount.ExportGenesis(s.Ctx, *s.App.SmartAccountKeeper)
+	s.Require().Len(genesis.SpendLimitData, 1)
+	s.SetupTest()
+	smartaccount.InitGenesis(s.Ctx, *s.App.SmartAccountKeeper, *genesis)
+
+	spendLimit, ok = s.App.AuthenticatorManager.GetAuthenticatorByType("SpendLimit").(authenticator.SpendLimit)
+	s.Require().True(ok)
+	state, err := spendLimit.GetSpendLimitState(s.Ctx, accAddress, request.AuthenticatorId)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedState.Spent, state.Spent)
+	s.Require().True(expectedState.PeriodStart.Equal(state.PeriodStart))
+
+	// Malformed spending state fails the import.
+	s.SetupTest()
+	genesis.SpendLimitData[0].State = []byte("not json")
+	s.Require().Panics(func() {
+		smartaccount.InitGenesis(s.Ctx, *s.App.SmartAccountKeeper, *genesis)
+	})
+}
@@ -6,10 +6,12 @@ import (
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	gogotypes "github.com/cosmos/gogoproto/types"
 
@@ -28,7 +30,9 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 type Keeper struct {
 	storeKey                storetypes.StoreKey
-	cdc                     codec.BinaryCodec
+	cdc                     codec.Codec
+	accountKeeper           authante.AccountKeeper
+	txConfig                client.TxConfig
 	paramSpace              paramtypes.Subspace
 	CircuitBreakerGovernor  sdk.AccAddress
 	isSmartAccountActiveBz  []byte
@@ -38,11 +42,13 @@ type Keeper struct {
 }
 
 func NewKeeper(
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	StoreKey storetypes.StoreKey,
 	govModuleAddr sdk.AccAddress,
 	ps paramtypes.Subspace,
 	authenticatorManager *authenticator.AuthenticatorManager,
+	accountKeeper authante.AccountKeeper,
+	txConfig client.TxConfig,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -55,6 +61,8 @@ func NewKeeper(
 		CircuitBreakerGovernor: govModuleAddr,
 		paramSpace:             ps,
 		AuthenticatorManager:   authenticatorManager,
+		accountKeeper:          accountKeeper,
+		txConfig:               txConfig,
 	}
 }
 
@@ -233,6 +241,51 @@ func (k Keeper) RemoveAuthenticator(ctx sdk.Context, account sdk.AccAddress, aut
 	return nil
 }
 
+// RemoveExpiredSessions removes up to numToRemove session authenticators that expired at the current block time
+// or height, oldest first. The sessions left over are removed in the following blocks.
+func (k Keeper) RemoveExpiredSessions(ctx sdk.Context, numToRemove int) {
+	store := ctx.KVStore(k.storeKey)
+
+	type expiredSession struct {
+		key             []byte
+		account         sdk.AccAddress
+		authenticatorId uint64
+	}
+	var expired []expiredSession
+	// collect gathers the sessions indexed under prefix with an expiration up to and including expiration.
+	collect := func(prefix, expiration []byte, parse func([]byte) (sdk.AccAddress, uint64, error)) {
+		end := storetypes.PrefixEndBytes(append(append([]byte{}, prefix...), expiration...))
+		iterator := store.Iterator(prefix, end)
+		defer iterator.Close()
+		for ; iterator.Valid() && len(expired) < numToRemove; iterator.Next() {
+			account, authenticatorId, err := parse(iterator.Key())
+			if err != nil {
+				// This should never happen, as the keys are only written by the session authenticator
+				k.Logger(ctx).Error("invalid session expiration key", "key", iterator.Key(), "error", err)
+				continue
+			}
+			expired = append(expired, expiredSession{key: iterator.Key(), account: account, authenticatorId: authenticatorId})
+		}
+	}
+
+	collect(types.KeySessionExpirationTimePrefix, sdk.FormatTimeBytes(ctx.BlockTime()), types.ParseSessionExpirationTimeKey)
+	collect(types.KeySessionExpirationHeightPrefix, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())), types.ParseSessionExpirationHeightKey)
+
+	for _, session := range expired {
+		// A session with both an end time and an end height is indexed twice and may already be removed.
+		if !store.Has(session.key) {
+			continue
+		}
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.RemoveAuthenticator(cacheCtx, session.account, session.authenticatorId)
+		})
+		if err != nil {
+			k.Logger(ctx).Error("failed to remove expired session", "account", session.account, "authenticatorId", session.authenticatorId, "error", err)
+			store.Delete(session.key)
+		}
+	}
+}
+
 // GetAuthenticatorExtension unpacks the extension for the transaction, this is used with transactions specify
 // an authenticator to use
 func (k Keeper) GetAuthenticatorExtension(exts []*codectypes.Any) types.AuthenticatorTxOptions {
@@ -2,7 +2,9 @@ package keeper_test
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -209,3 +211,98 @@ func (s *KeeperTestSuite) TestKeeper_GetSelectedAuthenticatorForAccount() {
 	s.Require().Equal(selectedAuthenticator.Id, uint64(0), "Incorrect ID returned from store")
 	s.Require().Equal(selectedAuthenticator.Authenticator, nil, "Returned authenticator from store but nothing registered in manager")
 }
+
+func (s *KeeperTestSuite) TestKeeper_RemoveExpiredSessions() {
+	accAddress := s.TestAccs[0]
+	blockTime := s.Ctx.BlockTime()
+	blockHeight := uint64(s.Ctx.BlockHeight())
+
+	addSession := func(config authenticator.SessionConfig) uint64 {
+		config.Authenticator = authenticator.SubAuthenticatorInitData{
+			Type:   "MessageFilter",
+			Config: []byte(`{"@type":"/cosmos.bank.v1beta1.MsgSend"}`),
+		}
+		bz, err := json.Marshal(config)
+		s.Require().NoError(err)
+		id, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, accAddress, "Session", bz)
+		s.Require().NoError(err)
+		return id
+	}
+
+	timeBoundId := addSession(authenticator.SessionConfig{EndTime: uint64(blockTime.Add(time.Hour).Unix())})
+	heightBoundId := addSession(authenticator.SessionConfig{EndHeight: blockHeight + 10})
+	addSession(authenticator.SessionConfig{EndTime: uint64(blockTime.Add(2 * time.Hour).Unix()), EndHeight: blockHeight + 5})
+	_, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, accAddress, "MessageFilter", []byte(`{"@type":"/cosmos.bank.v1beta1.MsgSend"}`))
+	s.Require().NoError(err)
+
+	remainingIds := func() []uint64 {
+		authenticators, err := s.App.SmartAccountKeeper.GetAuthenticatorDataForAccount(s.Ctx, accAddress)
+		s.Require().NoError(err)
+		ids := []uint64{}
+		for _, a := range authenticators {
+			ids = append(ids, a.Id)
+		}
+		return ids
+	}
+
+	// Nothing expired yet.
+	s.App.SmartAccountKeeper.RemoveExpiredSessions(s.Ctx, 100)
+	s.Require().Equal([]uint64{1, 2, 3, 4}, remainingIds())
+
+	// The session with both bounds expires with its end height, and is only removed once.
+	s.Ctx = s.Ctx.WithBlockHeight(int64(blockHeight + 5))
+	s.App.SmartAccountKeeper.RemoveExpiredSessions(s.Ctx, 100)
+	s.Require().Equal([]uint64{timeBoundId, heightBoundId, 4}, remainingIds())
+
+	s.Ctx = s.Ctx.WithBlockTime(blockTime.Add(time.Hour))
+	s.App.SmartAccountKeeper.RemoveExpiredSessions(s.Ctx, 100)
+	s.Require().Equal([]uint64{heightBoundId, 4}, remainingIds())
+
+	s.Ctx = s.Ctx.WithBlockHeight(int64(blockHeight + 10))
+	s.App.SmartAccountKeeper.RemoveExpiredSessions(s.Ctx, 100)
+	s.Require().Equal([]uint64{4}, remainingIds())
+
+	// Removing a session before it expires removes its expiration index.
+	id := addSession(authenticator.SessionConfig{EndHeight: blockHeight + 20})
+	s.Require().NoError(s.App.SmartAccountKeeper.RemoveAuthenticator(s.Ctx, accAddress, id))
+	s.Ctx = s.Ctx.WithBlockHeight(int64(blockHeight + 20))
+	s.Require().NotPanics(func() { s.App.SmartAccountKeeper.RemoveExpiredSessions(s.Ctx, 100) })
+	s.Require().Equal([]uint64{4}, remainingIds())
+}
+
+func (s *KeeperTestSuite) TestKeeper_RemoveExpiredSessionsIsBounded() {
+	accAddress := s.TestAccs[0]
+	blockHeight := uint64(s.Ctx.BlockHeight())
+
+	bz, err := json.Marshal(authenticator.SessionConfig{
+		EndHeight: blockHeight + 1,
+		Authenticator: authenticator.SubAuthenticatorInitData{
+			Type:   "MessageFilter",
+			Config: []byte(`{"@type":"/cosmos.bank.v1beta1.MsgSend"}`),
+		},
+	})
+	s.Require().NoError(err)
+	for i := 0; i < 5; i++ {
+		_, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, accAddress, "Session", bz)
+		s.Require().NoError(err)
+	}
+
+	numRemaining := func() int {
+		authenticators, err := s.App.SmartAccountKeeper.GetAuthenticatorDataForAccount(s.Ctx, accAddress)
+		s.Require().NoError(err)
+		return len(authenticators)
+	}
+
+	// The expired sessions are removed in batches, carrying the rest over to the next blocks.
+	s.Ctx = s.Ctx.WithBlockHeight(int64(blockHeight + 1))
+	s.App.SmartAccountKeeper.RemoveExpiredSessions(s.Ctx, 2)
+	s.Require().Equal(3, numRemaining())
+
+	s.Ctx = s.Ctx.WithBlockHeight(int64(blockHeight + 2))
+	s.App.SmartAccountKeeper.RemoveExpiredSessions(s.Ctx, 2)
+	s.Require().Equal(1, numRemaining())
+
+	s.Ctx = s.Ctx.WithBlockHeight(int64(blockHeight + 3))
+	s.App.SmartAccountKeeper.RemoveExpiredSessions(s.Ctx, 2)
+	s.Require().Equal(0, numRemaining())
+}
@@ -2,11 +2,17 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"strconv"
 
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/osmosis-labs/osmosis/v29/x/smart-account/authenticator"
 	"github.com/osmosis-labs/osmosis/v29/x/smart-account/types"
 )
 
@@ -55,3 +61,151 @@ func (k Keeper) GetAuthenticator(
 
 	return &types.GetAuthenticatorResponse{AccountAuthenticator: authenticator}, nil
 }
+
+// SimulateAuthentication runs the Authenticate method of the selected authenticator for every message of the
+// given transaction and reports the outcome. State changes are discarded.
+func (k Keeper) SimulateAuthentication(
+	ctx context.Context,
+	request *types.SimulateAuthenticationRequest,
+) (*types.SimulateAuthenticationResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	tx, err := k.txConfig.TxDecoder()(request.TxBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no messages in transaction")
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "tx must be a FeeTx")
+	}
+
+	// The fee payer is the first signer of the transaction, as enforced by the ante handler.
+	signers, _, err := k.cdc.GetMsgV1Signers(msgs[0])
+	if err != nil || len(signers) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "failed to get signers")
+	}
+	feePayer := sdk.AccAddress(signers[0])
+
+	// Authentication is limited to the gas of the transaction, or to the unauthenticated gas if it is not set.
+	gasLimit := feeTx.GetGas()
+	if gasLimit == 0 {
+		gasLimit = k.GetParams(sdkCtx).MaximumUnauthenticatedGas
+	}
+
+	results := make([]types.AuthenticationResult, 0, len(msgs))
+	for msgIndex, msg := range msgs {
+		result := types.AuthenticationResult{
+			MsgIndex:        uint64(msgIndex),
+			MsgTypeUrl:      sdk.MsgTypeURL(msg),
+			AuthenticatorId: request.AuthenticatorId,
+		}
+
+		cacheCtx, _ := sdkCtx.CacheContext()
+		gasMeter := storetypes.NewGasMeter(gasLimit)
+		err := k.simulateMsgAuthentication(cacheCtx.WithGasMeter(gasMeter), tx, feeTx, feePayer, msgIndex, msg, request.AuthenticatorId, &result)
+		if err != nil {
+			result.Error = err.Error()
+			var compositeErr authenticator.CompositeAuthenticationError
+			if errors.As(err, &compositeErr) {
+				result.SubAuthenticatorFailures = subAuthenticatorFailures(compositeErr, result.AuthenticatorType)
+			}
+		}
+		result.Success = err == nil
+		result.GasUsed = gasMeter.GasConsumed()
+		results = append(results, result)
+	}
+
+	return &types.SimulateAuthenticationResponse{Results: results}, nil
+}
+
+// simulateMsgAuthentication authenticates a single message the same way the authenticator ante decorator does,
+// filling the account and authenticator type of the result along the way.
+func (k Keeper) simulateMsgAuthentication(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	feeTx sdk.FeeTx,
+	feePayer sdk.AccAddress,
+	msgIndex int,
+	msg sdk.Msg,
+	authenticatorId uint64,
+	result *types.AuthenticationResult,
+) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s", outOfGas.Descriptor)
+		}
+	}()
+
+	signers, _, err := k.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "failed to get signers")
+	}
+	if len(signers) != 1 {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "messages must have exactly one signer")
+	}
+	account := sdk.AccAddress(signers[0])
+	result.Account = account.String()
+
+	selectedAuthenticator, err := k.GetInitializedAuthenticatorForAccount(ctx, account, int(authenticatorId))
+	if err != nil {
+		return err
+	}
+	result.AuthenticatorType = selectedAuthenticator.Authenticator.Type()
+
+	authenticationRequest, err := authenticator.GenerateAuthenticationRequest(
+		ctx,
+		k.cdc,
+		k.accountKeeper,
+		k.txConfig.SignModeHandler(),
+		account,
+		feePayer,
+		feeTx.FeeGranter(),
+		feeTx.GetFee(),
+		msg,
+		tx,
+		msgIndex,
+		false,
+		authenticator.SequenceMatch,
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to generate authentication data")
+	}
+	authenticationRequest.AuthenticatorId = strconv.FormatUint(selectedAuthenticator.Id, 10)
+
+	ctx.GasMeter().ConsumeGas(selectedAuthenticator.Authenticator.StaticGas(), "authenticator static gas")
+	return selectedAuthenticator.Authenticator.Authenticate(ctx, authenticationRequest)
+}
+
+// subAuthenticatorFailures returns the innermost sub-authenticators that failed in a composite authentication,
+// along with the path of authenticator types leading to them from the given path.
+func subAuthenticatorFailures(compositeErr authenticator.CompositeAuthenticationError, path string) []types.SubAuthenticatorFailure {
+	failures := make([]types.SubAuthenticatorFailure, 0, len(compositeErr.Failures))
+	for _, failure := range compositeErr.Failures {
+		failurePath := path + "/" + failure.AuthenticatorType
+
+		var subCompositeErr authenticator.CompositeAuthenticationError
+		if errors.As(failure.Err, &subCompositeErr) {
+			failures = append(failures, subAuthenticatorFailures(subCompositeErr, failurePath)...)
+			continue
+		}
+
+		failures = append(failures, types.SubAuthenticatorFailure{
+			AuthenticatorId: failure.AuthenticatorId,
+			Path:            failurePath,
+			Error:           failure.Err.Error(),
+		})
+	}
+	return failures
+}
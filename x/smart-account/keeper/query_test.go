@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/v29/app"
+	"github.com/osmosis-labs/osmosis/v29/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v29/x/smart-account/types"
+)
+
+func (s *KeeperTestSuite) TestSimulateAuthentication() {
+	txConfig := app.MakeEncodingConfig().TxConfig
+	priv := secp256k1.GenPrivKey()
+	account := sdk.AccAddress(priv.PubKey().Address())
+	s.App.AccountKeeper.SetAccount(s.Ctx, s.App.AccountKeeper.NewAccountWithAddress(s.Ctx, account))
+	baseAccount := s.App.AccountKeeper.GetAccount(s.Ctx, account)
+
+	sigVerificationId, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, account, "SignatureVerification", priv.PubKey().Bytes())
+	s.Require().NoError(err)
+	messageFilterId, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, account, "MessageFilter", []byte(`{"@type":"/cosmos.bank.v1beta1.MsgMultiSend"}`))
+	s.Require().NoError(err)
+
+	// compositeConfig returns the config of a composite authenticator with the given sub-authenticators.
+	compositeConfig := func(subAuthenticators ...authenticator.SubAuthenticatorInitData) []byte {
+		bz, err := json.Marshal(subAuthenticators)
+		s.Require().NoError(err)
+		return bz
+	}
+	sigVerification := authenticator.SubAuthenticatorInitData{Type: "SignatureVerification", Config: priv.PubKey().Bytes()}
+	multiSendFilter := authenticator.SubAuthenticatorInitData{Type: "MessageFilter", Config: []byte(`{"@type":"/cosmos.bank.v1beta1.MsgMultiSend"}`)}
+	allOfId, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, account, "AllOf", compositeConfig(sigVerification, multiSendFilter))
+	s.Require().NoError(err)
+	anyOfId, err := s.App.SmartAccountKeeper.AddAuthenticator(s.Ctx, account, "AnyOf", compositeConfig(
+		multiSendFilter,
+		authenticator.SubAuthenticatorInitData{Type: "AllOf", Config: compositeConfig(sigVerification, multiSendFilter)},
+	))
+	s.Require().NoError(err)
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: account.String(),
+		ToAddress:   s.TestAccs[0].String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1)),
+	}
+
+	// buildTx returns the encoded transaction, signed if sign is true and with an empty signature otherwise.
+	buildTx := func(sign bool) []byte {
+		txBuilder := txConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(msgSend))
+		txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 2500)))
+		txBuilder.SetGasLimit(300_000)
+
+		signMode, err := authsigning.APISignModeToInternal(txConfig.SignModeHandler().DefaultMode())
+		s.Require().NoError(err)
+		s.Require().NoError(txBuilder.SetSignatures(signing.SignatureV2{
+			PubKey:   priv.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signMode},
+			Sequence: baseAccount.GetSequence(),
+		}))
+
+		if sign {
+			signerData := authsigning.SignerData{
+				ChainID:       s.Ctx.ChainID(),
+				AccountNumber: baseAccount.GetAccountNumber(),
+				Sequence:      baseAccount.GetSequence(),
+				PubKey:        priv.PubKey(),
+				Address:       account.String(),
+			}
+			sig, err := clienttx.SignWithPrivKey(s.Ctx, signMode, signerData, txBuilder, priv, txConfig, baseAccount.GetSequence())
+			s.Require().NoError(err)
+			s.Require().NoError(txBuilder.SetSignatures(sig))
+		}
+
+		bz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+		s.Require().NoError(err)
+		return bz
+	}
+
+	tests := map[string]struct {
+		txBytes           []byte
+		authenticatorId   uint64
+		expectedType      string
+		expectedSuccess   bool
+		expectedErrSubstr string
+		expectedFailures  []types.SubAuthenticatorFailure
+	}{
+		"signed tx passes signature verification": {
+			txBytes:         buildTx(true),
+			authenticatorId: sigVerificationId,
+			expectedType:    "SignatureVerification",
+			expectedSuccess: true,
+		},
+		"unsigned tx fails signature verification": {
+			txBytes:           buildTx(false),
+			authenticatorId:   sigVerificationId,
+			expectedType:      "SignatureVerification",
+			expectedErrSubstr: "signature verification failed",
+		},
+		"message does not match filter": {
+			txBytes:           buildTx(true),
+			authenticatorId:   messageFilterId,
+			expectedType:      "MessageFilter",
+			expectedErrSubstr: "message does not match pattern",
+		},
+		"all of reports the failing sub-authenticator": {
+			txBytes:           buildTx(true),
+			authenticatorId:   allOfId,
+			expectedType:      "AllOf",
+			expectedErrSubstr: "message does not match pattern",
+			expectedFailures: []types.SubAuthenticatorFailure{
+				{AuthenticatorId: fmt.Sprintf("%d.1", allOfId), Path: "AllOf/MessageFilter"},
+			},
+		},
+		"any of reports every failing nested sub-authenticator": {
+			txBytes:           buildTx(true),
+			authenticatorId:   anyOfId,
+			expectedType:      "AnyOf",
+			expectedErrSubstr: "all sub-authenticators failed to authenticate",
+			expectedFailures: []types.SubAuthenticatorFailure{
+				{AuthenticatorId: fmt.Sprintf("%d.0", anyOfId), Path: "AnyOf/MessageFilter"},
+				{AuthenticatorId: fmt.Sprintf("%d.1.1", anyOfId), Path: "AnyOf/AllOf/MessageFilter"},
+			},
+		},
+		"authenticator does not exist": {
+			txBytes:           buildTx(true),
+			authenticatorId:   100,
+			expectedErrSubstr: "authenticator",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			res, err := s.App.SmartAccountKeeper.SimulateAuthentication(s.Ctx, &types.SimulateAuthenticationRequest{
+				TxBytes:         tc.txBytes,
+				AuthenticatorId: tc.authenticatorId,
+			})
+			s.Require().NoError(err)
+			s.Require().Len(res.Results, 1)
+
+			result := res.Results[0]
+			s.Require().Equal(uint64(0), result.MsgIndex)
+			s.Require().Equal(sdk.MsgTypeURL(msgSend), result.MsgTypeUrl)
+			s.Require().Equal(account.String(), result.Account)
+			s.Require().Equal(tc.authenticatorId, result.AuthenticatorId)
+			s.Require().Equal(tc.expectedType, result.AuthenticatorType)
+			s.Require().Equal(tc.expectedSuccess, result.Success)
+			s.Require().Contains(result.Error, tc.expectedErrSubstr)
+			s.Require().NotZero(result.GasUsed)
+
+			s.Require().Len(result.SubAuthenticatorFailures, len(tc.expectedFailures))
+			for i, expectedFailure := range tc.expectedFailures {
+				s.Require().Equal(expectedFailure.AuthenticatorId, result.SubAuthenticatorFailures[i].AuthenticatorId)
+				s.Require().Equal(expectedFailure.Path, result.SubAuthenticatorFailures[i].Path)
+				s.Require().Contains(result.SubAuthenticatorFailures[i].Error, "message does not match pattern")
+			}
+		})
+	}
+
+	_, err = s.App.SmartAccountKeeper.SimulateAuthentication(s.Ctx, &types.SimulateAuthenticationRequest{TxBytes: []byte("invalid")})
+	s.Require().Error(err)
+}
@@ -9,6 +9,7 @@ func DefaultGenesis() *GenesisState {
 		Params:              DefaultParams(),
 		NextAuthenticatorId: DefaultIndex,
 		AuthenticatorData:   []AuthenticatorData{},
+		SpendLimitData:      []SpendLimitData{},
 	}
 }
 
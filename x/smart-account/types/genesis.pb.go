@@ -81,6 +81,72 @@ func (m *AuthenticatorData) GetAuthenticators() []AccountAuthenticator {
 	return nil
 }
 
+// SpendLimitData represents a genesis exported spending window tracked by a
+// SpendLimit authenticator.
+type SpendLimitData struct {
+	// address is the account the spending is tracked for
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// authenticator_id is the id of the spend limit authenticator, which includes
+	// the position of sub-authenticators, e.g. "5.1"
+	AuthenticatorId string `protobuf:"bytes,2,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty"`
+	// state is the JSON encoded spending window of the authenticator
+	State []byte `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (m *SpendLimitData) Reset()         { *m = SpendLimitData{} }
+func (m *SpendLimitData) String() string { return proto.CompactTextString(m) }
+func (*SpendLimitData) ProtoMessage()    {}
+func (*SpendLimitData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678d63c22c684b43, []int{1}
+}
+func (m *SpendLimitData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendLimitData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendLimitData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendLimitData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendLimitData.Merge(m, src)
+}
+func (m *SpendLimitData) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendLimitData) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendLimitData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendLimitData proto.InternalMessageInfo
+
+func (m *SpendLimitData) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SpendLimitData) GetAuthenticatorId() string {
+	if m != nil {
+		return m.AuthenticatorId
+	}
+	return ""
+}
+
+func (m *SpendLimitData) GetState() []byte {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
 // GenesisState defines the authenticator module's genesis state.
 type GenesisState struct {
 	// params define the parameters for the authenticator module.
@@ -90,13 +156,16 @@ type GenesisState struct {
 	// authenticator_data contains the data for multiple accounts, each with their
 	// authenticators.
 	AuthenticatorData []AuthenticatorData `protobuf:"bytes,3,rep,name=authenticator_data,json=authenticatorData,proto3" json:"authenticator_data"`
+	// spend_limit_data contains the spending windows tracked by spend limit
+	// authenticators.
+	SpendLimitData []SpendLimitData `protobuf:"bytes,4,rep,name=spend_limit_data,json=spendLimitData,proto3" json:"spend_limit_data"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678d63c22c684b43, []int{1}
+	return fileDescriptor_678d63c22c684b43, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,8 +215,16 @@ func (m *GenesisState) GetAuthenticatorData() []AuthenticatorData {
 	return nil
 }
 
+func (m *GenesisState) GetSpendLimitData() []SpendLimitData {
+	if m != nil {
+		return m.SpendLimitData
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AuthenticatorData)(nil), "osmosis.smartaccount.v1beta1.AuthenticatorData")
+	proto.RegisterType((*SpendLimitData)(nil), "osmosis.smartaccount.v1beta1.SpendLimitData")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.smartaccount.v1beta1.GenesisState")
 }
 
@@ -156,29 +233,32 @@ func init() {
 }
 
 var fileDescriptor_678d63c22c684b43 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x4b, 0x02, 0x41,
-	0x14, 0xc7, 0x77, 0x54, 0x8c, 0xc6, 0x08, 0xdc, 0x0a, 0x16, 0x89, 0x4d, 0xa4, 0x83, 0x05, 0xee,
-	0xe0, 0x76, 0xf2, 0xa8, 0x04, 0xd1, 0x2d, 0xf4, 0xd6, 0xc5, 0xde, 0xee, 0x0c, 0xeb, 0x82, 0xbb,
-	0x23, 0x3b, 0x4f, 0xb1, 0x4f, 0x51, 0x1f, 0xcb, 0xa3, 0xc7, 0x4e, 0x11, 0x7a, 0xed, 0x43, 0x84,
-	0xbb, 0x23, 0xb8, 0x06, 0xd6, 0x6d, 0x1e, 0xf3, 0x7b, 0xef, 0xff, 0xfe, 0xef, 0x4f, 0x6f, 0xa5,
-	0x8a, 0xa4, 0x0a, 0x15, 0x53, 0x11, 0x24, 0x08, 0xbe, 0x2f, 0xa7, 0x31, 0xb2, 0x59, 0xdb, 0x13,
-	0x08, 0x6d, 0x16, 0x88, 0x58, 0xa8, 0x50, 0x39, 0x93, 0x44, 0xa2, 0x34, 0x2f, 0x35, 0xeb, 0xec,
-	0xb2, 0x8e, 0x66, 0x6b, 0xe7, 0x81, 0x0c, 0x64, 0x0a, 0xb2, 0xcd, 0x2b, 0xeb, 0xa9, 0xdd, 0x1c,
-	0x9c, 0x3f, 0x81, 0x04, 0x22, 0xf5, 0x2f, 0x34, 0x92, 0x5c, 0x8c, 0x35, 0xda, 0x78, 0x23, 0xb4,
-	0xda, 0x9d, 0xe2, 0x48, 0xc4, 0x18, 0xfa, 0x80, 0x32, 0xb9, 0x07, 0x04, 0xd3, 0xa2, 0x47, 0xc0,
-	0x79, 0x22, 0x94, 0xb2, 0x48, 0x9d, 0x34, 0x8f, 0xfb, 0xdb, 0xd2, 0x7c, 0xa1, 0xa7, 0xb0, 0x8b,
-	0x2b, 0xab, 0x50, 0x2f, 0x36, 0x2b, 0xae, 0xeb, 0x1c, 0xb2, 0xe4, 0x74, 0xb3, 0x3a, 0xa7, 0xd4,
-	0x2b, 0x2d, 0x3e, 0xaf, 0x8c, 0xfe, 0xde, 0xbc, 0xc6, 0x37, 0xa1, 0x27, 0x0f, 0xd9, 0xb5, 0x06,
-	0x08, 0x28, 0xcc, 0x1e, 0x2d, 0x67, 0xee, 0xd2, 0x5d, 0x2a, 0xee, 0xf5, 0x61, 0xa9, 0xa7, 0x94,
-	0xd5, 0xc3, 0x75, 0xa7, 0xe9, 0xd2, 0x8b, 0x58, 0xcc, 0x71, 0x98, 0xd3, 0x1a, 0x86, 0xdc, 0x2a,
-	0xd4, 0x49, 0xb3, 0xd4, 0x3f, 0xdb, 0x7c, 0xe6, 0x96, 0x7b, 0xe4, 0x26, 0xa7, 0x66, 0x1e, 0xe7,
-	0x80, 0x60, 0x15, 0x53, 0xbb, 0xec, 0x0f, 0xbb, 0xfb, 0x17, 0xd5, 0xeb, 0x54, 0xe1, 0xd7, 0xc7,
-	0x60, 0xb1, 0xb2, 0xc9, 0x72, 0x65, 0x93, 0xaf, 0x95, 0x4d, 0xde, 0xd7, 0xb6, 0xb1, 0x5c, 0xdb,
-	0xc6, 0xc7, 0xda, 0x36, 0x9e, 0x3b, 0x41, 0x88, 0xa3, 0xa9, 0xe7, 0xf8, 0x32, 0x62, 0x5a, 0xad,
-	0x35, 0x06, 0x4f, 0x6d, 0x0b, 0x36, 0x73, 0x3b, 0x6c, 0x9e, 0x65, 0xdc, 0xda, 0x86, 0x8c, 0xaf,
-	0x13, 0xa1, 0xbc, 0x72, 0x1a, 0xee, 0xdd, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x46, 0x6a, 0x52,
-	0xa8, 0x94, 0x02, 0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x4e, 0xc2, 0x40,
+	0x10, 0xc6, 0x5b, 0x40, 0x8c, 0x0b, 0x41, 0xa8, 0x98, 0x34, 0xc4, 0x54, 0x42, 0x3c, 0x80, 0x91,
+	0x6e, 0xa8, 0x27, 0x8e, 0x10, 0x13, 0x63, 0xe2, 0xc1, 0x94, 0x9b, 0x31, 0xc1, 0x6d, 0x77, 0x53,
+	0x1a, 0x69, 0xb7, 0xe9, 0x2e, 0x04, 0x9f, 0x42, 0x1f, 0x8b, 0x78, 0xe2, 0xe8, 0xc9, 0x18, 0x78,
+	0x11, 0xd3, 0x7f, 0x09, 0x45, 0x53, 0xbc, 0x75, 0xa6, 0xbf, 0xd9, 0x6f, 0x66, 0xbe, 0x01, 0x97,
+	0x94, 0x39, 0x94, 0xd9, 0x0c, 0x32, 0x07, 0xf9, 0x1c, 0x99, 0x26, 0x9d, 0xb9, 0x1c, 0xce, 0x7b,
+	0x06, 0xe1, 0xa8, 0x07, 0x2d, 0xe2, 0x12, 0x66, 0x33, 0xd5, 0xf3, 0x29, 0xa7, 0xd2, 0x59, 0xcc,
+	0xaa, 0xdb, 0xac, 0x1a, 0xb3, 0x8d, 0xba, 0x45, 0x2d, 0x1a, 0x82, 0x30, 0xf8, 0x8a, 0x6a, 0x1a,
+	0x9d, 0xcc, 0xf7, 0x3d, 0xe4, 0x23, 0x87, 0xfd, 0x0b, 0x75, 0x28, 0x26, 0xd3, 0x18, 0x6d, 0xbd,
+	0x89, 0xa0, 0x36, 0x98, 0xf1, 0x09, 0x71, 0xb9, 0x6d, 0x22, 0x4e, 0xfd, 0x1b, 0xc4, 0x91, 0x24,
+	0x83, 0x43, 0x84, 0xb1, 0x4f, 0x18, 0x93, 0xc5, 0xa6, 0xd8, 0x3e, 0xd2, 0x93, 0x50, 0x7a, 0x06,
+	0x15, 0xb4, 0x8d, 0x33, 0x39, 0xd7, 0xcc, 0xb7, 0x4b, 0x9a, 0xa6, 0x66, 0x8d, 0xa4, 0x0e, 0xa2,
+	0x38, 0xa5, 0x34, 0x2c, 0x2c, 0xbf, 0xce, 0x05, 0x7d, 0xe7, 0xbd, 0xd6, 0x0b, 0xa8, 0x8c, 0x3c,
+	0xe2, 0xe2, 0x7b, 0xdb, 0xb1, 0xf9, 0x9e, 0x6e, 0x3a, 0xa0, 0x9a, 0xaa, 0x1e, 0xdb, 0x58, 0xce,
+	0x85, 0xc8, 0x71, 0x2a, 0x7f, 0x87, 0xa5, 0x3a, 0x38, 0x60, 0x1c, 0x71, 0x22, 0xe7, 0x9b, 0x62,
+	0xbb, 0xac, 0x47, 0x41, 0xeb, 0x23, 0x07, 0xca, 0xb7, 0x91, 0x35, 0xa3, 0x20, 0x21, 0x0d, 0x41,
+	0x31, 0x5a, 0x65, 0x28, 0x55, 0xd2, 0x2e, 0xb2, 0xe7, 0x7a, 0x08, 0xd9, 0x78, 0x92, 0xb8, 0x52,
+	0xd2, 0xc0, 0xa9, 0x4b, 0x16, 0x7c, 0xfc, 0x67, 0x6b, 0x05, 0xfd, 0x24, 0xf8, 0x39, 0xd8, 0x69,
+	0x0f, 0x03, 0x29, 0x8d, 0x63, 0xc4, 0x91, 0x9c, 0x0f, 0x77, 0x0b, 0xf7, 0xec, 0x76, 0xd7, 0xbe,
+	0xb8, 0x9d, 0x1a, 0xfa, 0xe5, 0xeb, 0x13, 0xa8, 0xb2, 0x60, 0xb7, 0xe3, 0x69, 0xb0, 0xdc, 0x48,
+	0xa3, 0x10, 0x6a, 0x5c, 0x65, 0x6b, 0xa4, 0x1d, 0x49, 0x9c, 0x63, 0xe9, 0xec, 0x68, 0xb9, 0x56,
+	0xc4, 0xd5, 0x5a, 0x11, 0xbf, 0xd7, 0x8a, 0xf8, 0xbe, 0x51, 0x84, 0xd5, 0x46, 0x11, 0x3e, 0x37,
+	0x8a, 0xf0, 0xd8, 0xb7, 0x6c, 0x3e, 0x99, 0x19, 0xaa, 0x49, 0x1d, 0x18, 0xeb, 0x74, 0xa7, 0xc8,
+	0x60, 0x49, 0x00, 0xe7, 0x5a, 0x1f, 0x2e, 0xa2, 0x73, 0xed, 0x26, 0xf7, 0xca, 0x5f, 0x3d, 0xc2,
+	0x8c, 0x62, 0x78, 0xa7, 0xd7, 0x3f, 0x03, 0x00, 0xaf, 0xde, 0x9a, 0x8f, 0x5f, 0x03, 0x00, 0x00,
 }
 
 func (m *AuthenticatorData) Marshal() (dAtA []byte, err error) {
@@ -225,6 +305,50 @@ func (m *AuthenticatorData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SpendLimitData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendLimitData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendLimitData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AuthenticatorId) > 0 {
+		i -= len(m.AuthenticatorId)
+		copy(dAtA[i:], m.AuthenticatorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AuthenticatorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -245,6 +369,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpendLimitData) > 0 {
+		for iNdEx := len(m.SpendLimitData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimitData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.AuthenticatorData) > 0 {
 		for iNdEx := len(m.AuthenticatorData) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -307,6 +445,27 @@ func (m *AuthenticatorData) Size() (n int) {
 	return n
 }
 
+func (m *SpendLimitData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.AuthenticatorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -324,6 +483,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SpendLimitData) > 0 {
+		for _, e := range m.SpendLimitData {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -449,6 +614,154 @@ func (m *AuthenticatorData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SpendLimitData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendLimitData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendLimitData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthenticatorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = append(m.State[:0], dAtA[iNdEx:postIndex]...)
+			if m.State == nil {
+				m.State = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -564,6 +877,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimitData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimitData = append(m.SpendLimitData, SpendLimitData{})
+			if err := m.SpendLimitData[len(m.SpendLimitData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
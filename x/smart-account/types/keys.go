@@ -3,8 +3,10 @@ package types
 import (
 	fmt "fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -33,6 +35,9 @@ var (
 	// Store prefix keys
 	KeyNextAccountAuthenticatorIdPrefix = []byte{0x01}
 	KeyAccountAuthenticatorsPrefix      = []byte{0x02}
+	KeySpendLimitPrefix                 = []byte{0x03}
+	KeySessionExpirationTimePrefix      = []byte{0x04}
+	KeySessionExpirationHeightPrefix    = []byte{0x05}
 
 	// Parameter keys
 	KeyMaximumUnauthenticatedGas = []byte("MaximumUnauthenticatedGas")
@@ -56,6 +61,56 @@ func KeyAccountAuthenticatorsPrefixId() []byte {
 	return BuildKey(KeyAccountAuthenticatorsPrefix)
 }
 
+// KeySpendLimit returns the key under which a spend limit authenticator stores the spending of an account.
+func KeySpendLimit(account sdk.AccAddress, authenticatorId string) []byte {
+	return BuildKey(KeySpendLimitPrefix, account.String(), authenticatorId)
+}
+
+// KeySpendLimitPrefixId returns the prefix of the spending tracked by spend limit authenticators.
+func KeySpendLimitPrefixId() []byte {
+	return BuildKey(KeySpendLimitPrefix)
+}
+
+// KeySessionExpirationTime returns the key indexing a session authenticator that expires at endTime.
+// Keys are ordered by expiration so that expired sessions can be iterated over.
+func KeySessionExpirationTime(endTime time.Time, account sdk.AccAddress, authenticatorId uint64) []byte {
+	return sessionExpirationKey(KeySessionExpirationTimePrefix, sdk.FormatTimeBytes(endTime), account, authenticatorId)
+}
+
+// KeySessionExpirationHeight returns the key indexing a session authenticator that expires at endHeight.
+func KeySessionExpirationHeight(endHeight uint64, account sdk.AccAddress, authenticatorId uint64) []byte {
+	return sessionExpirationKey(KeySessionExpirationHeightPrefix, sdk.Uint64ToBigEndian(endHeight), account, authenticatorId)
+}
+
+func sessionExpirationKey(prefix, expiration []byte, account sdk.AccAddress, authenticatorId uint64) []byte {
+	key := append([]byte{}, prefix...)
+	key = append(key, expiration...)
+	key = append(key, address.MustLengthPrefix(account)...)
+	return append(key, sdk.Uint64ToBigEndian(authenticatorId)...)
+}
+
+// ParseSessionExpirationTimeKey returns the account and authenticator id indexed by a KeySessionExpirationTime key.
+func ParseSessionExpirationTimeKey(key []byte) (sdk.AccAddress, uint64, error) {
+	return parseSessionExpirationKey(key, len(KeySessionExpirationTimePrefix)+len(sdk.FormatTimeBytes(time.Time{})))
+}
+
+// ParseSessionExpirationHeightKey returns the account and authenticator id indexed by a KeySessionExpirationHeight key.
+func ParseSessionExpirationHeightKey(key []byte) (sdk.AccAddress, uint64, error) {
+	return parseSessionExpirationKey(key, len(KeySessionExpirationHeightPrefix)+8)
+}
+
+func parseSessionExpirationKey(key []byte, suffixStart int) (sdk.AccAddress, uint64, error) {
+	if len(key) <= suffixStart {
+		return nil, 0, fmt.Errorf("invalid session expiration key %X", key)
+	}
+	suffix := key[suffixStart:]
+	addrLen := int(suffix[0])
+	if len(suffix) != 1+addrLen+8 {
+		return nil, 0, fmt.Errorf("invalid session expiration key %X", key)
+	}
+	return sdk.AccAddress(suffix[1 : 1+addrLen]), sdk.BigEndianToUint64(suffix[1+addrLen:]), nil
+}
+
 // BuildKey creates a key by concatenating the provided elements with the key separator.
 func BuildKey(elements ...interface{}) []byte {
 	strElements := make([]string, len(elements))
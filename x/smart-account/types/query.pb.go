@@ -301,6 +301,290 @@ func (m *GetAuthenticatorResponse) GetAccountAuthenticator() *AccountAuthenticat
 	return nil
 }
 
+// SimulateAuthenticationRequest defines the Query/SimulateAuthentication
+// request type.
+type SimulateAuthenticationRequest struct {
+	// tx_bytes is the proto encoded transaction. It must contain one signature
+	// entry per signer, but signatures can be empty.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// authenticator_id is the authenticator selected for every message.
+	AuthenticatorId uint64 `protobuf:"varint,2,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty"`
+}
+
+func (m *SimulateAuthenticationRequest) Reset()         { *m = SimulateAuthenticationRequest{} }
+func (m *SimulateAuthenticationRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateAuthenticationRequest) ProtoMessage()    {}
+func (*SimulateAuthenticationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{6}
+}
+func (m *SimulateAuthenticationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateAuthenticationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateAuthenticationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateAuthenticationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateAuthenticationRequest.Merge(m, src)
+}
+func (m *SimulateAuthenticationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateAuthenticationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateAuthenticationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateAuthenticationRequest proto.InternalMessageInfo
+
+func (m *SimulateAuthenticationRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *SimulateAuthenticationRequest) GetAuthenticatorId() uint64 {
+	if m != nil {
+		return m.AuthenticatorId
+	}
+	return 0
+}
+
+// AuthenticationResult is the outcome of authenticating a single message.
+type AuthenticationResult struct {
+	MsgIndex          uint64 `protobuf:"varint,1,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	MsgTypeUrl        string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Account           string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	AuthenticatorId   uint64 `protobuf:"varint,4,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty"`
+	AuthenticatorType string `protobuf:"bytes,5,opt,name=authenticator_type,json=authenticatorType,proto3" json:"authenticator_type,omitempty"`
+	Success           bool   `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
+	// error is the reason the authentication failed, empty on success.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// gas_used is the gas consumed by the authentication, including the
+	// static gas of the authenticator.
+	GasUsed uint64 `protobuf:"varint,8,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// sub_authenticator_failures lists the innermost sub-authenticators that
+	// failed to authenticate when the authenticator is an AllOf or AnyOf
+	// composition.
+	SubAuthenticatorFailures []SubAuthenticatorFailure `protobuf:"bytes,9,rep,name=sub_authenticator_failures,json=subAuthenticatorFailures,proto3" json:"sub_authenticator_failures"`
+}
+
+func (m *AuthenticationResult) Reset()         { *m = AuthenticationResult{} }
+func (m *AuthenticationResult) String() string { return proto.CompactTextString(m) }
+func (*AuthenticationResult) ProtoMessage()    {}
+func (*AuthenticationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{7}
+}
+func (m *AuthenticationResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthenticationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthenticationResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthenticationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticationResult.Merge(m, src)
+}
+func (m *AuthenticationResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthenticationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticationResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticationResult proto.InternalMessageInfo
+
+func (m *AuthenticationResult) GetMsgIndex() uint64 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *AuthenticationResult) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *AuthenticationResult) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AuthenticationResult) GetAuthenticatorId() uint64 {
+	if m != nil {
+		return m.AuthenticatorId
+	}
+	return 0
+}
+
+func (m *AuthenticationResult) GetAuthenticatorType() string {
+	if m != nil {
+		return m.AuthenticatorType
+	}
+	return ""
+}
+
+func (m *AuthenticationResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *AuthenticationResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AuthenticationResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *AuthenticationResult) GetSubAuthenticatorFailures() []SubAuthenticatorFailure {
+	if m != nil {
+		return m.SubAuthenticatorFailures
+	}
+	return nil
+}
+
+// SubAuthenticatorFailure is the failure of a sub-authenticator of a composite
+// authenticator.
+type SubAuthenticatorFailure struct {
+	// authenticator_id is the composite id of the sub-authenticator, e.g. "2.1".
+	AuthenticatorId string `protobuf:"bytes,1,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty"`
+	// path is the types of the authenticators from the selected authenticator to
+	// the sub-authenticator, e.g. "AllOf/AnyOf/SignatureVerification".
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// error is the reason the sub-authenticator failed.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *SubAuthenticatorFailure) Reset()         { *m = SubAuthenticatorFailure{} }
+func (m *SubAuthenticatorFailure) String() string { return proto.CompactTextString(m) }
+func (*SubAuthenticatorFailure) ProtoMessage()    {}
+func (*SubAuthenticatorFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{8}
+}
+func (m *SubAuthenticatorFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubAuthenticatorFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubAuthenticatorFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubAuthenticatorFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubAuthenticatorFailure.Merge(m, src)
+}
+func (m *SubAuthenticatorFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubAuthenticatorFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubAuthenticatorFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubAuthenticatorFailure proto.InternalMessageInfo
+
+func (m *SubAuthenticatorFailure) GetAuthenticatorId() string {
+	if m != nil {
+		return m.AuthenticatorId
+	}
+	return ""
+}
+
+func (m *SubAuthenticatorFailure) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SubAuthenticatorFailure) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// SimulateAuthenticationResponse defines the Query/SimulateAuthentication
+// response type.
+type SimulateAuthenticationResponse struct {
+	Results []AuthenticationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *SimulateAuthenticationResponse) Reset()         { *m = SimulateAuthenticationResponse{} }
+func (m *SimulateAuthenticationResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateAuthenticationResponse) ProtoMessage()    {}
+func (*SimulateAuthenticationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab2e1fc442f3cc3, []int{9}
+}
+func (m *SimulateAuthenticationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateAuthenticationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateAuthenticationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateAuthenticationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateAuthenticationResponse.Merge(m, src)
+}
+func (m *SimulateAuthenticationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateAuthenticationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateAuthenticationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateAuthenticationResponse proto.InternalMessageInfo
+
+func (m *SimulateAuthenticationResponse) GetResults() []AuthenticationResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.smartaccount.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.smartaccount.v1beta1.QueryParamsResponse")
@@ -308,6 +592,10 @@ func init() {
 	proto.RegisterType((*GetAuthenticatorsResponse)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorsResponse")
 	proto.RegisterType((*GetAuthenticatorRequest)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorRequest")
 	proto.RegisterType((*GetAuthenticatorResponse)(nil), "osmosis.smartaccount.v1beta1.GetAuthenticatorResponse")
+	proto.RegisterType((*SimulateAuthenticationRequest)(nil), "osmosis.smartaccount.v1beta1.SimulateAuthenticationRequest")
+	proto.RegisterType((*AuthenticationResult)(nil), "osmosis.smartaccount.v1beta1.AuthenticationResult")
+	proto.RegisterType((*SubAuthenticatorFailure)(nil), "osmosis.smartaccount.v1beta1.SubAuthenticatorFailure")
+	proto.RegisterType((*SimulateAuthenticationResponse)(nil), "osmosis.smartaccount.v1beta1.SimulateAuthenticationResponse")
 }
 
 func init() {
@@ -315,40 +603,58 @@ func init() {
 }
 
 var fileDescriptor_aab2e1fc442f3cc3 = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xae, 0x47, 0x29, 0xc2, 0x3b, 0x30, 0x4c, 0x07, 0xa1, 0xaa, 0x42, 0x15, 0xed, 0xd0, 0x21,
-	0x2d, 0x5e, 0x03, 0x0c, 0xed, 0xc6, 0x7a, 0x01, 0x6e, 0x10, 0x4e, 0x70, 0x60, 0x72, 0x52, 0x2b,
-	0xb3, 0xd4, 0xc4, 0x59, 0xec, 0x4c, 0x4c, 0xd3, 0x2e, 0x20, 0x71, 0x46, 0xda, 0xbf, 0xe0, 0x37,
-	0xf0, 0x03, 0x26, 0x71, 0x99, 0xc4, 0x85, 0x13, 0x42, 0x2d, 0x3f, 0x04, 0xcd, 0x76, 0x0b, 0x69,
-	0x43, 0x58, 0x77, 0xab, 0x5f, 0xbf, 0xef, 0x7d, 0xdf, 0xf7, 0xde, 0x53, 0x60, 0x97, 0x8b, 0x98,
-	0x0b, 0x26, 0xb0, 0x88, 0x49, 0x26, 0x49, 0x18, 0xf2, 0x3c, 0x91, 0xf8, 0xa0, 0x17, 0x50, 0x49,
-	0x7a, 0x78, 0x3f, 0xa7, 0xd9, 0xa1, 0x9b, 0x66, 0x5c, 0x72, 0xd4, 0x36, 0x48, 0xf7, 0x6f, 0xa4,
-	0x6b, 0x90, 0xad, 0x66, 0xc4, 0x23, 0xae, 0x80, 0xf8, 0xfc, 0x97, 0xe6, 0xb4, 0xda, 0x11, 0xe7,
-	0xd1, 0x90, 0x62, 0x92, 0x32, 0x4c, 0x92, 0x84, 0x4b, 0x22, 0x19, 0x4f, 0x84, 0xf9, 0xf7, 0x7e,
-	0xa8, 0x5a, 0xe2, 0x80, 0x08, 0xaa, 0xa5, 0xa6, 0xc2, 0x29, 0x89, 0x58, 0xa2, 0xc0, 0x06, 0xbb,
-	0x5e, 0xe9, 0x33, 0x25, 0x19, 0x89, 0xc5, 0x85, 0xa0, 0x31, 0x1f, 0xd0, 0xa1, 0x81, 0x3a, 0x4d,
-	0x88, 0x5e, 0x9e, 0xeb, 0xbe, 0x50, 0x7c, 0x9f, 0xee, 0xe7, 0x54, 0x48, 0xe7, 0x35, 0xbc, 0x55,
-	0xa8, 0x8a, 0x94, 0x27, 0x82, 0xa2, 0x3e, 0x6c, 0x68, 0x1d, 0x0b, 0x74, 0x40, 0x77, 0xd9, 0x5b,
-	0x73, 0xab, 0x26, 0xe2, 0x6a, 0x76, 0xbf, 0x7e, 0xfa, 0xe3, 0x5e, 0xcd, 0x37, 0x4c, 0xe7, 0x21,
-	0xb4, 0x9e, 0x52, 0xb9, 0x93, 0xcb, 0x3d, 0x9a, 0x48, 0x16, 0x12, 0xc9, 0xb3, 0x89, 0x2c, 0xb2,
-	0xe0, 0x35, 0xd3, 0x43, 0x09, 0x5c, 0xf7, 0x27, 0x4f, 0xe7, 0x23, 0x80, 0x77, 0x4b, 0x68, 0xc6,
-	0x17, 0x83, 0xb7, 0x0d, 0x70, 0x97, 0x14, 0x10, 0x16, 0xe8, 0x5c, 0xe9, 0x2e, 0x7b, 0x5e, 0xb5,
-	0xcf, 0x1d, 0xfd, 0x2e, 0x34, 0xf7, 0x57, 0x49, 0x49, 0x55, 0x38, 0x6f, 0xe1, 0x9d, 0x59, 0x1f,
-	0xff, 0x75, 0x8f, 0xd6, 0xe1, 0x4a, 0xc1, 0xd7, 0x2e, 0x1b, 0x58, 0x4b, 0x1d, 0xd0, 0xad, 0xfb,
-	0x37, 0x0a, 0xf5, 0xe7, 0x03, 0xe7, 0x03, 0x98, 0x9f, 0xcf, 0x34, 0x67, 0x04, 0x57, 0x4b, 0x73,
-	0x9a, 0x75, 0x5c, 0x26, 0x66, 0xb3, 0x2c, 0xa6, 0xf7, 0xb9, 0x0e, 0xaf, 0xaa, 0x03, 0x40, 0x27,
-	0x00, 0x36, 0xf4, 0x1e, 0xd1, 0x66, 0x75, 0xfb, 0xf9, 0x33, 0x6a, 0xf5, 0x16, 0x60, 0xe8, 0x88,
-	0xce, 0xda, 0xfb, 0x6f, 0xbf, 0x4e, 0x96, 0x6c, 0xd4, 0xc6, 0xa5, 0x37, 0xac, 0x8f, 0x08, 0x7d,
-	0x05, 0x70, 0x65, 0x76, 0x4a, 0xe8, 0x51, 0xb5, 0xda, 0x3f, 0xd6, 0xd6, 0xda, 0x5a, 0x94, 0x66,
-	0x9c, 0x3e, 0x53, 0x4e, 0xfb, 0xe8, 0x49, 0xb9, 0xd3, 0xc2, 0x82, 0xf0, 0x91, 0x29, 0x1f, 0xe3,
-	0xa3, 0xd9, 0x4b, 0x38, 0x46, 0x5f, 0x00, 0xbc, 0x39, 0x77, 0xdc, 0x68, 0x41, 0x5f, 0xd3, 0xa1,
-	0x3f, 0x5e, 0x98, 0x67, 0x02, 0x6d, 0xa9, 0x40, 0x9b, 0xc8, 0xbd, 0x40, 0x20, 0xf1, 0x27, 0x51,
-	0xff, 0xd5, 0xe9, 0xc8, 0x06, 0x67, 0x23, 0x1b, 0xfc, 0x1c, 0xd9, 0xe0, 0xd3, 0xd8, 0xae, 0x9d,
-	0x8d, 0xed, 0xda, 0xf7, 0xb1, 0x5d, 0x7b, 0xb3, 0x1d, 0x31, 0xb9, 0x97, 0x07, 0x6e, 0xc8, 0xe3,
-	0x49, 0xcf, 0x8d, 0x21, 0x09, 0xc4, 0x54, 0xe0, 0xc0, 0xdb, 0xc6, 0xef, 0xb4, 0xcc, 0xc6, 0x44,
-	0x47, 0x1e, 0xa6, 0x54, 0x04, 0x0d, 0xf5, 0x79, 0x7a, 0xf0, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x87,
-	0x99, 0x35, 0xb8, 0x9e, 0x05, 0x00, 0x00,
+	// 803 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x4f, 0xdb, 0x48,
+	0x14, 0x8f, 0xc9, 0xff, 0x01, 0x69, 0x61, 0x36, 0x80, 0xc9, 0xb2, 0xde, 0xc8, 0xe2, 0x10, 0x90,
+	0x12, 0x43, 0x76, 0x97, 0x5d, 0x76, 0xf7, 0xb0, 0xe4, 0xd0, 0x96, 0x5b, 0x6b, 0xca, 0xa1, 0x3d,
+	0x34, 0x1a, 0x27, 0x53, 0x63, 0xc9, 0xf6, 0x18, 0xcf, 0x18, 0x25, 0x42, 0x5c, 0x5a, 0xa9, 0xd7,
+	0x56, 0xe2, 0xeb, 0xf4, 0x03, 0x20, 0x55, 0x95, 0x90, 0x7a, 0xe9, 0xa9, 0xaa, 0xa0, 0x9f, 0xa2,
+	0xa7, 0xca, 0xe3, 0x49, 0x88, 0x83, 0x49, 0x08, 0xb7, 0xcc, 0xcc, 0x7b, 0xbf, 0x3f, 0xef, 0x3d,
+	0x3f, 0x05, 0x54, 0x09, 0x75, 0x08, 0xb5, 0xa8, 0x46, 0x1d, 0xe4, 0x33, 0xd4, 0x6e, 0x93, 0xc0,
+	0x65, 0xda, 0xf1, 0x96, 0x81, 0x19, 0xda, 0xd2, 0x8e, 0x02, 0xec, 0xf7, 0xea, 0x9e, 0x4f, 0x18,
+	0x81, 0xab, 0x22, 0xb2, 0x3e, 0x1c, 0x59, 0x17, 0x91, 0xe5, 0x92, 0x49, 0x4c, 0xc2, 0x03, 0xb5,
+	0xf0, 0x57, 0x94, 0x53, 0x5e, 0x35, 0x09, 0x31, 0x6d, 0xac, 0x21, 0xcf, 0xd2, 0x90, 0xeb, 0x12,
+	0x86, 0x98, 0x45, 0x5c, 0x2a, 0x5e, 0x37, 0xda, 0x1c, 0x52, 0x33, 0x10, 0xc5, 0x11, 0xd5, 0x80,
+	0xd8, 0x43, 0xa6, 0xe5, 0xf2, 0x60, 0x11, 0xbb, 0x3e, 0x56, 0xa7, 0x87, 0x7c, 0xe4, 0xd0, 0x3b,
+	0x85, 0x3a, 0xa4, 0x83, 0x6d, 0x11, 0xaa, 0x96, 0x00, 0x7c, 0x12, 0xf2, 0x3e, 0xe6, 0xf9, 0x3a,
+	0x3e, 0x0a, 0x30, 0x65, 0xea, 0x33, 0xf0, 0x73, 0xec, 0x96, 0x7a, 0xc4, 0xa5, 0x18, 0x36, 0x41,
+	0x2e, 0xe2, 0x91, 0xa5, 0x8a, 0x54, 0x9d, 0x6d, 0xac, 0xd5, 0xc7, 0x55, 0xa4, 0x1e, 0x65, 0x37,
+	0x33, 0xe7, 0x5f, 0x7e, 0x4b, 0xe9, 0x22, 0x53, 0xfd, 0x03, 0xc8, 0x0f, 0x31, 0xdb, 0x0d, 0xd8,
+	0x21, 0x76, 0x99, 0xd5, 0x46, 0x8c, 0xf8, 0x7d, 0x5a, 0x28, 0x83, 0xbc, 0xc0, 0xe0, 0x04, 0x45,
+	0xbd, 0x7f, 0x54, 0xdf, 0x48, 0x60, 0x25, 0x21, 0x4d, 0xe8, 0xb2, 0xc0, 0x92, 0x08, 0x6c, 0xa1,
+	0x58, 0x84, 0x2c, 0x55, 0xd2, 0xd5, 0xd9, 0x46, 0x63, 0xbc, 0xce, 0xdd, 0xe8, 0x1c, 0x03, 0xd7,
+	0x17, 0x51, 0xc2, 0x2d, 0x55, 0x5f, 0x80, 0xe5, 0x51, 0x1d, 0x13, 0xd5, 0xc3, 0x75, 0x30, 0x1f,
+	0xd3, 0xd5, 0xb2, 0x3a, 0xf2, 0x4c, 0x45, 0xaa, 0x66, 0xf4, 0x9f, 0x62, 0xf7, 0x7b, 0x1d, 0xf5,
+	0xb5, 0x74, 0xb3, 0x3e, 0x03, 0x9f, 0x26, 0x58, 0x4c, 0xf4, 0x29, 0xda, 0x71, 0x1f, 0x9b, 0xa5,
+	0x24, 0x9b, 0x2a, 0x06, 0xbf, 0xee, 0x5b, 0x4e, 0x60, 0x23, 0x86, 0x87, 0x1e, 0x2c, 0xe2, 0xf6,
+	0xbd, 0xae, 0x80, 0x02, 0xeb, 0xb6, 0x8c, 0x1e, 0xc3, 0xd1, 0x2c, 0xcc, 0xe9, 0x79, 0xd6, 0x6d,
+	0x86, 0xc7, 0x69, 0xcc, 0xbe, 0x4d, 0x83, 0xd2, 0x28, 0x3e, 0x0d, 0x6c, 0x06, 0x7f, 0x01, 0x45,
+	0x87, 0x9a, 0x2d, 0xcb, 0xed, 0xe0, 0x2e, 0xc7, 0xcf, 0xe8, 0x05, 0x87, 0x9a, 0x7b, 0xe1, 0x19,
+	0x56, 0xc0, 0x5c, 0xf8, 0xc8, 0x7a, 0x1e, 0x6e, 0x05, 0xbe, 0xcd, 0xc1, 0x8b, 0x3a, 0x70, 0xa8,
+	0xf9, 0xb4, 0xe7, 0xe1, 0x03, 0xdf, 0x1e, 0xee, 0x44, 0x7a, 0x72, 0x27, 0x32, 0x89, 0xe2, 0x60,
+	0x0d, 0xc0, 0x78, 0x68, 0x48, 0x28, 0x67, 0x39, 0xde, 0x42, 0xec, 0x25, 0xa4, 0x0d, 0x39, 0x69,
+	0xd0, 0x6e, 0x63, 0x4a, 0xe5, 0x5c, 0x45, 0xaa, 0x16, 0xf4, 0xfe, 0x11, 0x96, 0x40, 0x16, 0xfb,
+	0x3e, 0xf1, 0xe5, 0x3c, 0xcf, 0x8d, 0x0e, 0x61, 0x05, 0x4d, 0x44, 0x5b, 0x01, 0xc5, 0x1d, 0xb9,
+	0xc0, 0x15, 0xe4, 0x4d, 0x44, 0x0f, 0x28, 0xee, 0xc0, 0x1e, 0x28, 0xd3, 0xc0, 0x88, 0xb7, 0xb8,
+	0xf5, 0x12, 0x59, 0x76, 0xe0, 0x63, 0x2a, 0x17, 0xf9, 0x48, 0xff, 0x39, 0xbe, 0xd7, 0xfb, 0x81,
+	0x11, 0xeb, 0xe8, 0x83, 0x28, 0x5b, 0x7c, 0x8b, 0x32, 0x4d, 0x7e, 0xa6, 0xaa, 0x0b, 0x96, 0x6f,
+	0x49, 0x4d, 0x2c, 0x5d, 0x34, 0xe7, 0x37, 0x4a, 0x07, 0x41, 0xc6, 0x43, 0xec, 0x50, 0x74, 0x86,
+	0xff, 0xbe, 0xae, 0x42, 0x7a, 0xa8, 0x0a, 0x2a, 0x03, 0xca, 0x6d, 0x83, 0x26, 0x66, 0x5e, 0x07,
+	0x79, 0x9f, 0x0f, 0xc5, 0x5d, 0x3f, 0xe6, 0x84, 0x79, 0x12, 0xb6, 0xfb, 0x40, 0x8d, 0xef, 0x59,
+	0x90, 0xe5, 0xfb, 0x0d, 0x9e, 0x49, 0x20, 0x17, 0xad, 0x29, 0xb8, 0x39, 0x1e, 0xf7, 0xe6, 0x96,
+	0x2c, 0x6f, 0x4d, 0x91, 0x11, 0xb9, 0x51, 0xd7, 0x5e, 0x7d, 0xfa, 0x76, 0x36, 0xa3, 0xc0, 0x55,
+	0x2d, 0x71, 0x45, 0x47, 0x3b, 0x12, 0x7e, 0x90, 0xc0, 0xfc, 0xe8, 0x12, 0x80, 0x13, 0x3a, 0x7e,
+	0xcb, 0x56, 0x2a, 0x6f, 0x4f, 0x9b, 0x26, 0x94, 0x3e, 0xe2, 0x4a, 0x9b, 0xf0, 0xff, 0x64, 0xa5,
+	0xb1, 0x96, 0x6b, 0x27, 0xe2, 0xfa, 0x54, 0x3b, 0x19, 0x9d, 0x91, 0x53, 0xf8, 0x5e, 0x02, 0x0b,
+	0xa3, 0x34, 0x14, 0x4e, 0xa9, 0x6b, 0x50, 0xf4, 0xbf, 0xa6, 0xce, 0x13, 0x86, 0xb6, 0xb9, 0xa1,
+	0x4d, 0x58, 0xbf, 0x83, 0x21, 0x7a, 0xed, 0x08, 0x7e, 0x94, 0xc0, 0x52, 0xf2, 0x8c, 0xc2, 0x7f,
+	0x27, 0x7c, 0x84, 0xe3, 0x56, 0x68, 0xf9, 0xbf, 0xfb, 0x25, 0x0b, 0x37, 0x7f, 0x73, 0x37, 0x8d,
+	0x7f, 0xa4, 0x0d, 0xb5, 0x96, 0x6c, 0x88, 0x0a, 0x80, 0xe1, 0x3d, 0x62, 0x11, 0xb7, 0xb9, 0x7f,
+	0x7e, 0xa9, 0x48, 0x17, 0x97, 0x8a, 0xf4, 0xf5, 0x52, 0x91, 0xde, 0x5d, 0x29, 0xa9, 0x8b, 0x2b,
+	0x25, 0xf5, 0xf9, 0x4a, 0x49, 0x3d, 0xdf, 0x31, 0x2d, 0x76, 0x18, 0x18, 0xf5, 0x36, 0x71, 0xfa,
+	0x90, 0x35, 0x1b, 0x19, 0x74, 0x80, 0x7f, 0xdc, 0xd8, 0xd1, 0xba, 0x11, 0x4b, 0xad, 0x4f, 0x13,
+	0x6e, 0x45, 0x6a, 0xe4, 0xf8, 0xbf, 0x89, 0xdf, 0x7f, 0x0c, 0x00, 0x64, 0x4d, 0x69, 0x00, 0x4d,
+	0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -367,6 +673,9 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	GetAuthenticator(ctx context.Context, in *GetAuthenticatorRequest, opts ...grpc.CallOption) (*GetAuthenticatorResponse, error)
 	GetAuthenticators(ctx context.Context, in *GetAuthenticatorsRequest, opts ...grpc.CallOption) (*GetAuthenticatorsResponse, error)
+	// SimulateAuthentication runs the Authenticate method of the selected
+	// authenticator for every message of a transaction without side effects.
+	SimulateAuthentication(ctx context.Context, in *SimulateAuthenticationRequest, opts ...grpc.CallOption) (*SimulateAuthenticationResponse, error)
 }
 
 type queryClient struct {
@@ -404,12 +713,24 @@ func (c *queryClient) GetAuthenticators(ctx context.Context, in *GetAuthenticato
 	return out, nil
 }
 
+func (c *queryClient) SimulateAuthentication(ctx context.Context, in *SimulateAuthenticationRequest, opts ...grpc.CallOption) (*SimulateAuthenticationResponse, error) {
+	out := new(SimulateAuthenticationResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.smartaccount.v1beta1.Query/SimulateAuthentication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	GetAuthenticator(context.Context, *GetAuthenticatorRequest) (*GetAuthenticatorResponse, error)
 	GetAuthenticators(context.Context, *GetAuthenticatorsRequest) (*GetAuthenticatorsResponse, error)
+	// SimulateAuthentication runs the Authenticate method of the selected
+	// authenticator for every message of a transaction without side effects.
+	SimulateAuthentication(context.Context, *SimulateAuthenticationRequest) (*SimulateAuthenticationResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -425,6 +746,9 @@ func (*UnimplementedQueryServer) GetAuthenticator(ctx context.Context, req *GetA
 func (*UnimplementedQueryServer) GetAuthenticators(ctx context.Context, req *GetAuthenticatorsRequest) (*GetAuthenticatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuthenticators not implemented")
 }
+func (*UnimplementedQueryServer) SimulateAuthentication(ctx context.Context, req *SimulateAuthenticationRequest) (*SimulateAuthenticationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateAuthentication not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -484,6 +808,24 @@ func _Query_GetAuthenticators_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateAuthentication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateAuthenticationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateAuthentication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.smartaccount.v1beta1.Query/SimulateAuthentication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateAuthentication(ctx, req.(*SimulateAuthenticationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.smartaccount.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -500,6 +842,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAuthenticators",
 			Handler:    _Query_GetAuthenticators_Handler,
 		},
+		{
+			MethodName: "SimulateAuthentication",
+			Handler:    _Query_SimulateAuthentication_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/smartaccount/v1beta1/query.proto",
@@ -698,69 +1044,275 @@ func (m *GetAuthenticatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SimulateAuthenticationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *SimulateAuthenticationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetAuthenticatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SimulateAuthenticationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.AuthenticatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuthenticatorId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetAuthenticatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AccountAuthenticators) > 0 {
-		for _, e := range m.AccountAuthenticators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *AuthenticationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetAuthenticatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AuthenticationResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthenticationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubAuthenticatorFailures) > 0 {
+		for iNdEx := len(m.SubAuthenticatorFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubAuthenticatorFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AuthenticatorType) > 0 {
+		i -= len(m.AuthenticatorType)
+		copy(dAtA[i:], m.AuthenticatorType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AuthenticatorType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AuthenticatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuthenticatorId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubAuthenticatorFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubAuthenticatorFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubAuthenticatorFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuthenticatorId) > 0 {
+		i -= len(m.AuthenticatorId)
+		copy(dAtA[i:], m.AuthenticatorId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AuthenticatorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateAuthenticationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateAuthenticationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateAuthenticationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetAuthenticatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAuthenticatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccountAuthenticators) > 0 {
+		for _, e := range m.AccountAuthenticators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetAuthenticatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Account)
@@ -786,6 +1338,101 @@ func (m *GetAuthenticatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *SimulateAuthenticationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AuthenticatorId != 0 {
+		n += 1 + sovQuery(uint64(m.AuthenticatorId))
+	}
+	return n
+}
+
+func (m *AuthenticationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		n += 1 + sovQuery(uint64(m.MsgIndex))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AuthenticatorId != 0 {
+		n += 1 + sovQuery(uint64(m.AuthenticatorId))
+	}
+	l = len(m.AuthenticatorType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if len(m.SubAuthenticatorFailures) > 0 {
+		for _, e := range m.SubAuthenticatorFailures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubAuthenticatorFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuthenticatorId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateAuthenticationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1278,6 +1925,628 @@ func (m *GetAuthenticatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SimulateAuthenticationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateAuthenticationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateAuthenticationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			m.AuthenticatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthenticatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthenticationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthenticationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthenticationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			m.AuthenticatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthenticatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthenticatorType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubAuthenticatorFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubAuthenticatorFailures = append(m.SubAuthenticatorFailures, SubAuthenticatorFailure{})
+			if err := m.SubAuthenticatorFailures[len(m.SubAuthenticatorFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubAuthenticatorFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubAuthenticatorFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubAuthenticatorFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthenticatorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateAuthenticationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateAuthenticationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateAuthenticationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, AuthenticationResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -40,6 +40,7 @@ func GetTxCmd() *cobra.Command {
 		NewUnbondConvertAndStake(),
 	)
 	osmocli.AddTxCmd(cmd, NewCreateFullRangePositionAndSuperfluidDelegateCmd)
+	osmocli.AddTxCmd(cmd, NewCreatePositionAndSuperfluidDelegateCmd)
 	osmocli.AddTxCmd(cmd, NewAddToConcentratedLiquiditySuperfluidPositionCmd)
 
 	return cmd
@@ -353,6 +354,14 @@ func NewCreateFullRangePositionAndSuperfluidDelegateCmd() (*osmocli.TxCliDesc, *
 	}, &types.MsgCreateFullRangePositionAndSuperfluidDelegate{}
 }
 
+func NewCreatePositionAndSuperfluidDelegateCmd() (*osmocli.TxCliDesc, *types.MsgCreatePositionAndSuperfluidDelegate) {
+	return &osmocli.TxCliDesc{
+		Use:     "create-position-and-sf-delegate",
+		Short:   "creates a concentrated position in the given tick range and superfluid delegates it to the provided validator",
+		Example: "create-position-and-sf-delegate 100000000uosmo,10000udai osmovaloper1... 45 \"[-69082]\" 69082 --from val --chain-id osmosis-1",
+	}, &types.MsgCreatePositionAndSuperfluidDelegate{}
+}
+
 func parseUpdateUnpoolWhitelistArgsToContent(flags *flag.FlagSet) (govtypesv1beta1.Content, error) {
 	title, err := flags.GetString(govcli.FlagTitle)
 	if err != nil {
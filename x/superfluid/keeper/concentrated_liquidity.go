@@ -2,11 +2,18 @@ package keeper
 
 import (
 	"strconv"
+	"strings"
 
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity"
 	cltypes "github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v29/x/lockup/types"
 	"github.com/osmosis-labs/osmosis/v29/x/superfluid/types"
 )
 
@@ -141,3 +148,201 @@ func (k Keeper) addToConcentratedLiquiditySuperfluidPosition(ctx sdk.Context, se
 
 	return positionData, newLockId, nil
 }
+
+// SetBoundedConcentratedLockOsmoEquivalent sets the osmo equivalent, before the risk adjustment,
+// of a superfluid delegated bounded range concentrated liquidity lock, and indexes the lock by its intermediary account.
+func (k Keeper) SetBoundedConcentratedLockOsmoEquivalent(ctx sdk.Context, lockId uint64, intermediaryAcc sdk.AccAddress, osmoEquivalent osmomath.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixBoundedConcentratedLock)
+	record := types.BoundedConcentratedLockRecord{
+		LockId:         lockId,
+		OsmoEquivalent: osmoEquivalent,
+	}
+	bz, err := proto.Marshal(&record)
+	if err != nil {
+		panic(err)
+	}
+	prefixStore.Set(sdk.Uint64ToBigEndian(lockId), bz)
+	k.intermediaryAccountBoundedConcentratedLockStore(ctx, intermediaryAcc).Set(sdk.Uint64ToBigEndian(lockId), []byte{})
+}
+
+// GetBoundedConcentratedLockOsmoEquivalent returns the osmo equivalent, before the risk adjustment,
+// of the given lock, and false if the lock is not a superfluid delegated bounded range concentrated liquidity lock.
+func (k Keeper) GetBoundedConcentratedLockOsmoEquivalent(ctx sdk.Context, lockId uint64) (osmomath.Dec, bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixBoundedConcentratedLock)
+	bz := prefixStore.Get(sdk.Uint64ToBigEndian(lockId))
+	if bz == nil {
+		return osmomath.ZeroDec(), false
+	}
+	record := types.BoundedConcentratedLockRecord{}
+	err := proto.Unmarshal(bz, &record)
+	if err != nil {
+		panic(err)
+	}
+	return record.OsmoEquivalent, true
+}
+
+func (k Keeper) GetAllBoundedConcentratedLockRecords(ctx sdk.Context) []types.BoundedConcentratedLockRecord {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixBoundedConcentratedLock)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.BoundedConcentratedLockRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.BoundedConcentratedLockRecord{}
+		err := proto.Unmarshal(iterator.Value(), &record)
+		if err != nil {
+			panic(err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func (k Keeper) DeleteBoundedConcentratedLockOsmoEquivalent(ctx sdk.Context, lockId uint64, intermediaryAcc sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixBoundedConcentratedLock)
+	prefixStore.Delete(sdk.Uint64ToBigEndian(lockId))
+	k.intermediaryAccountBoundedConcentratedLockStore(ctx, intermediaryAcc).Delete(sdk.Uint64ToBigEndian(lockId))
+}
+
+// intermediaryAccountBoundedConcentratedLockStore returns the store of the ids of the superfluid delegated
+// bounded range concentrated liquidity locks of the given intermediary account.
+func (k Keeper) intermediaryAccountBoundedConcentratedLockStore(ctx sdk.Context, intermediaryAcc sdk.AccAddress) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, append(types.KeyPrefixIntermediaryAccountBoundedConcentratedLock, address.MustLengthPrefix(intermediaryAcc)...))
+}
+
+// calculateBoundedConcentratedLockOsmoEquivalent returns the osmo equivalent, before the risk adjustment, of the given lock
+// and true if the lock holds the shares of a bounded range concentrated liquidity position.
+// Full range positions are valued with the osmo equivalent multiplier of their pool, which only accounts for full range liquidity.
+// Bounded range positions are instead valued with the OSMO amount currently underlying the position, so that
+// a position that moved out of range into the other asset is not worth any OSMO. If the pool's shares are no longer
+// a superfluid asset, the position is not worth any OSMO either.
+func (k Keeper) calculateBoundedConcentratedLockOsmoEquivalent(ctx sdk.Context, lock *lockuptypes.PeriodLock) (osmomath.Dec, bool, error) {
+	if len(lock.Coins) != 1 || !strings.HasPrefix(lock.Coins[0].Denom, cltypes.ConcentratedLiquidityTokenPrefix) {
+		return osmomath.ZeroDec(), false, nil
+	}
+
+	positionId, err := k.clk.GetPositionIdToLockId(ctx, lock.ID)
+	if err != nil {
+		return osmomath.ZeroDec(), false, err
+	}
+	position, err := k.clk.GetPosition(ctx, positionId)
+	if err != nil {
+		return osmomath.ZeroDec(), false, err
+	}
+	if position.LowerTick == cltypes.MinInitializedTick && position.UpperTick == cltypes.MaxTick {
+		return osmomath.ZeroDec(), false, nil
+	}
+
+	if _, err := k.GetSuperfluidAsset(ctx, lock.Coins[0].Denom); err != nil {
+		return osmomath.ZeroDec(), true, nil
+	}
+
+	concentratedPool, err := k.clk.GetConcentratedPoolById(ctx, position.PoolId)
+	if err != nil {
+		return osmomath.ZeroDec(), true, err
+	}
+	asset0, asset1, err := cl.CalculateUnderlyingAssetsFromPosition(ctx, position, concentratedPool)
+	if err != nil {
+		return osmomath.ZeroDec(), true, err
+	}
+	bondDenom, err := k.sk.BondDenom(ctx)
+	if err != nil {
+		return osmomath.ZeroDec(), true, err
+	}
+
+	return sdk.NewCoins(asset0, asset1).AmountOf(bondDenom).ToLegacyDec(), true, nil
+}
+
+// getLockSuperfluidOSMOTokens returns the risk adjusted osmo equivalent of the given lock.
+// Superfluid delegated bounded range concentrated liquidity locks are valued with their osmo equivalent as of the last
+// delegation or refresh, which is also the amount that was delegated for them. Other bounded range concentrated
+// liquidity locks are valued from their position, and every other lock with the osmo equivalent multiplier of its denom.
+func (k Keeper) getLockSuperfluidOSMOTokens(ctx sdk.Context, lock *lockuptypes.PeriodLock) (osmomath.Int, error) {
+	if osmoEquivalent, found := k.GetBoundedConcentratedLockOsmoEquivalent(ctx, lock.ID); found {
+		return k.GetRiskAdjustedOsmoValue(ctx, osmoEquivalent.RoundInt()), nil
+	}
+
+	osmoEquivalent, isBounded, err := k.calculateBoundedConcentratedLockOsmoEquivalent(ctx, lock)
+	if err != nil {
+		return osmomath.ZeroInt(), err
+	}
+	if isBounded {
+		return k.GetRiskAdjustedOsmoValue(ctx, osmoEquivalent.RoundInt()), nil
+	}
+
+	coin, err := lock.SingleCoin()
+	if err != nil {
+		return osmomath.ZeroInt(), err
+	}
+	return k.GetSuperfluidOSMOTokens(ctx, coin.Denom, coin.Amount)
+}
+
+// boundedConcentratedLockTotals are the totals of the superfluid delegated bounded range
+// concentrated liquidity locks of an intermediary account.
+type boundedConcentratedLockTotals struct {
+	// shares is the amount of concentrated liquidity shares locked.
+	shares osmomath.Int
+	// osmoAmount is the sum of the locks' risk adjusted osmo equivalents.
+	osmoAmount osmomath.Int
+}
+
+func newBoundedConcentratedLockTotals() boundedConcentratedLockTotals {
+	return boundedConcentratedLockTotals{shares: osmomath.ZeroInt(), osmoAmount: osmomath.ZeroInt()}
+}
+
+// refreshBoundedConcentratedLocks recomputes the osmo equivalent of every superfluid delegated bounded range
+// concentrated liquidity lock from the current underlying amounts of its position.
+// It returns the totals of the refreshed locks for every intermediary account address.
+// Locks that fail to be valued keep their previous osmo equivalent.
+func (k Keeper) refreshBoundedConcentratedLocks(ctx sdk.Context) map[string]boundedConcentratedLockTotals {
+	totals := map[string]boundedConcentratedLockTotals{}
+	for _, record := range k.GetAllBoundedConcentratedLockRecords(ctx) {
+		lock, err := k.lk.GetLockByID(ctx, record.LockId)
+		if err != nil {
+			k.Logger(ctx).Error("bounded concentrated lock not found", "LockID", record.LockId, "Error", err)
+			continue
+		}
+
+		osmoEquivalent, _, err := k.calculateBoundedConcentratedLockOsmoEquivalent(ctx, lock)
+		if err != nil {
+			k.Logger(ctx).Error("failed to refresh osmo equivalent of bounded concentrated lock", "LockID", record.LockId, "Error", err)
+			osmoEquivalent = record.OsmoEquivalent
+		}
+		intermediaryAcc := k.GetLockIdIntermediaryAccountConnection(ctx, record.LockId)
+		k.SetBoundedConcentratedLockOsmoEquivalent(ctx, record.LockId, intermediaryAcc, osmoEquivalent)
+
+		accAddr := intermediaryAcc.String()
+		total, ok := totals[accAddr]
+		if !ok {
+			total = newBoundedConcentratedLockTotals()
+		}
+		total.shares = total.shares.Add(lock.Coins[0].Amount)
+		total.osmoAmount = total.osmoAmount.Add(k.GetRiskAdjustedOsmoValue(ctx, osmoEquivalent.RoundInt()))
+		totals[accAddr] = total
+	}
+	return totals
+}
+
+// getBoundedConcentratedLockTotals returns the totals of the superfluid delegated bounded range
+// concentrated liquidity locks of the given intermediary account, with their current osmo equivalents.
+func (k Keeper) getBoundedConcentratedLockTotals(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) (boundedConcentratedLockTotals, error) {
+	total := newBoundedConcentratedLockTotals()
+	iterator := k.intermediaryAccountBoundedConcentratedLockStore(ctx, acc.GetAccAddress()).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		lockId := sdk.BigEndianToUint64(iterator.Key())
+		lock, err := k.lk.GetLockByID(ctx, lockId)
+		if err != nil {
+			return boundedConcentratedLockTotals{}, err
+		}
+		osmoEquivalent, _ := k.GetBoundedConcentratedLockOsmoEquivalent(ctx, lockId)
+		total.shares = total.shares.Add(lock.Coins[0].Amount)
+		total.osmoAmount = total.osmoAmount.Add(k.GetRiskAdjustedOsmoValue(ctx, osmoEquivalent.RoundInt()))
+	}
+	return total, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
 	"github.com/osmosis-labs/osmosis/v29/app/apptesting"
+	cl "github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity"
 	cltypes "github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v29/x/superfluid/keeper"
 	"github.com/osmosis-labs/osmosis/v29/x/superfluid/types"
@@ -314,3 +315,126 @@ func (s *KeeperTestSuite) SetupSuperfluidConcentratedPosition(ctx sdk.Context, s
 
 	return positionId, lockId, amount0, amount1, valAddr, poolJoinAcc
 }
+
+func (s *KeeperTestSuite) TestBoundedConcentratedPositionSuperfluidDelegation() {
+	s.SetupTest()
+	superfluidKeeper := s.App.SuperfluidKeeper
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	// The pool holds foo and the bond denom at a price of one.
+	clPool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(defaultFunds[0].Denom, defaultFunds[1].Denom)
+	clPoolDenom := cltypes.GetConcentratedLockupDenomFromPoolId(clPool.GetId())
+	err := superfluidKeeper.AddNewSuperfluidAsset(s.Ctx, types.SuperfluidAsset{
+		Denom:     clPoolDenom,
+		AssetType: types.SuperfluidAssetTypeConcentratedShare,
+	})
+	s.Require().NoError(err)
+
+	valAddr := s.SetupValidator(stakingtypes.Bonded)
+	unbondingTime, err := s.App.StakingKeeper.UnbondingTime(s.Ctx)
+	s.Require().NoError(err)
+
+	positionCoins := sdk.NewCoins(sdk.NewCoin(defaultFunds[0].Denom, osmomath.NewInt(1_000_000)), sdk.NewCoin(defaultFunds[1].Denom, osmomath.NewInt(1_000_000)))
+	boundedOwner, fullRangeOwner, swapper := s.TestAccs[1], s.TestAccs[2], s.TestAccs[0]
+	s.FundAcc(boundedOwner, positionCoins)
+	s.FundAcc(fullRangeOwner, positionCoins)
+
+	// Superfluid delegate a bounded range and a full range position to the same validator.
+	boundedPosition, boundedLockId, err := clKeeper.CreatePositionLocked(s.Ctx, clPool.GetId(), boundedOwner, positionCoins, -1000, 1000, unbondingTime)
+	s.Require().NoError(err)
+	err = superfluidKeeper.SuperfluidDelegate(s.Ctx, boundedOwner.String(), boundedLockId, valAddr.String())
+	s.Require().NoError(err)
+	_, fullRangeLockId, err := clKeeper.CreateFullRangePositionLocked(s.Ctx, clPool.GetId(), fullRangeOwner, positionCoins, unbondingTime)
+	s.Require().NoError(err)
+	err = superfluidKeeper.SuperfluidDelegate(s.Ctx, fullRangeOwner.String(), fullRangeLockId, valAddr.String())
+	s.Require().NoError(err)
+
+	// Superfluid delegate another bounded range position to another validator, with another intermediary account.
+	otherValAddr := s.SetupValidator(stakingtypes.Bonded)
+	s.FundAcc(swapper, positionCoins)
+	_, otherBoundedLockId, err := clKeeper.CreatePositionLocked(s.Ctx, clPool.GetId(), swapper, positionCoins, -1000, 1000, unbondingTime)
+	s.Require().NoError(err)
+	err = superfluidKeeper.SuperfluidDelegate(s.Ctx, swapper.String(), otherBoundedLockId, otherValAddr.String())
+	s.Require().NoError(err)
+
+	// Only the bounded range locks are valued from their position.
+	_, found := superfluidKeeper.GetBoundedConcentratedLockOsmoEquivalent(s.Ctx, fullRangeLockId)
+	s.Require().False(found)
+
+	acc, found := superfluidKeeper.GetIntermediaryAccountFromLockId(s.Ctx, boundedLockId)
+	s.Require().True(found)
+
+	// checkDelegation asserts that the bounded range lock is worth the OSMO underlying its position,
+	// and that the intermediary account delegates the value of both locks.
+	checkDelegation := func() osmomath.Int {
+		position, err := clKeeper.GetPosition(s.Ctx, boundedPosition.ID)
+		s.Require().NoError(err)
+		pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, clPool.GetId())
+		s.Require().NoError(err)
+		asset0, asset1, err := cl.CalculateUnderlyingAssetsFromPosition(s.Ctx, position, pool)
+		s.Require().NoError(err)
+		expectedOsmoEquivalent := sdk.NewCoins(asset0, asset1).AmountOf(sdk.DefaultBondDenom)
+
+		osmoEquivalent, found := superfluidKeeper.GetBoundedConcentratedLockOsmoEquivalent(s.Ctx, boundedLockId)
+		s.Require().True(found)
+		s.Require().Equal(expectedOsmoEquivalent.ToLegacyDec(), osmoEquivalent)
+
+		fullRangeLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, fullRangeLockId)
+		s.Require().NoError(err)
+		fullRangeAmount, err := superfluidKeeper.GetSuperfluidOSMOTokens(s.Ctx, clPoolDenom, fullRangeLock.Coins[0].Amount)
+		s.Require().NoError(err)
+		expectedDelegation := superfluidKeeper.GetRiskAdjustedOsmoValue(s.Ctx, expectedOsmoEquivalent).Add(fullRangeAmount)
+
+		delegation, err := s.App.StakingKeeper.GetDelegation(s.Ctx, acc.GetAccAddress(), valAddr)
+		s.Require().NoError(err)
+		validator, err := s.App.StakingKeeper.GetValidator(s.Ctx, valAddr)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedDelegation, validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+		expectedDelegationAmount, err := superfluidKeeper.GetExpectedDelegationAmount(s.Ctx, acc)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedDelegation, expectedDelegationAmount)
+
+		reason, broken := keeper.AllInvariants(*superfluidKeeper)(s.Ctx)
+		s.Require().False(broken, reason)
+		return expectedOsmoEquivalent
+	}
+	osmoEquivalentBeforeSwap := checkDelegation()
+
+	// Swap the bond denom into the pool, moving the price above the bounded range.
+	// The bounded range position now only holds the bond denom, and is revalued on refresh.
+	tokenIn := sdk.NewCoin(defaultFunds[1].Denom, apptesting.DefaultCoinAmount.QuoRaw(100))
+	s.FundAcc(swapper, sdk.NewCoins(tokenIn))
+	_, _, err = s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, swapper, clPool.GetId(), tokenIn, defaultFunds[0].Denom, osmomath.OneInt())
+	s.Require().NoError(err)
+
+	superfluidKeeper.RefreshIntermediaryDelegationAmounts(s.Ctx, superfluidKeeper.GetAllIntermediaryAccounts(s.Ctx))
+	osmoEquivalentAfterSwap := checkDelegation()
+	s.Require().True(osmoEquivalentAfterSwap.GT(osmoEquivalentBeforeSwap))
+
+	// Undelegating the bounded range lock burns its recorded value and deletes the record.
+	err = superfluidKeeper.SuperfluidUndelegate(s.Ctx, boundedOwner.String(), boundedLockId)
+	s.Require().NoError(err)
+	_, found = superfluidKeeper.GetBoundedConcentratedLockOsmoEquivalent(s.Ctx, boundedLockId)
+	s.Require().False(found)
+
+	fullRangeLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, fullRangeLockId)
+	s.Require().NoError(err)
+	fullRangeAmount, err := superfluidKeeper.GetSuperfluidOSMOTokens(s.Ctx, clPoolDenom, fullRangeLock.Coins[0].Amount)
+	s.Require().NoError(err)
+	expectedDelegationAmount, err := superfluidKeeper.GetExpectedDelegationAmount(s.Ctx, acc)
+	s.Require().NoError(err)
+	s.Require().Equal(fullRangeAmount, expectedDelegationAmount)
+
+	// The other bounded range lock is only accounted to its own intermediary account.
+	otherAcc, found := superfluidKeeper.GetIntermediaryAccountFromLockId(s.Ctx, otherBoundedLockId)
+	s.Require().True(found)
+	otherOsmoEquivalent, found := superfluidKeeper.GetBoundedConcentratedLockOsmoEquivalent(s.Ctx, otherBoundedLockId)
+	s.Require().True(found)
+	expectedDelegationAmount, err = superfluidKeeper.GetExpectedDelegationAmount(s.Ctx, otherAcc)
+	s.Require().NoError(err)
+	s.Require().Equal(superfluidKeeper.GetRiskAdjustedOsmoValue(s.Ctx, otherOsmoEquivalent.RoundInt()), expectedDelegationAmount)
+
+	reason, broken := keeper.AllInvariants(*superfluidKeeper)(s.Ctx)
+	s.Require().False(broken, reason)
+}
@@ -37,6 +37,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 		k.SetLockIdIntermediaryAccountConnection(ctx, connection.LockId, intermediaryAcc)
 	}
+
+	// initialize osmo equivalents of bounded range concentrated liquidity locks
+	for _, record := range genState.BoundedConcentratedLocks {
+		k.SetBoundedConcentratedLockOsmoEquivalent(ctx, record.LockId, k.GetLockIdIntermediaryAccountConnection(ctx, record.LockId), record.OsmoEquivalent)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -47,5 +52,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		OsmoEquivalentMultipliers:     k.GetAllOsmoEquivalentMultipliers(ctx),
 		IntermediaryAccounts:          k.GetAllIntermediaryAccounts(ctx),
 		IntemediaryAccountConnections: k.GetAllLockIdIntermediaryAccountConnections(ctx),
+		BoundedConcentratedLocks:      k.GetAllBoundedConcentratedLockRecords(ctx),
 	}
 }
@@ -49,6 +49,12 @@ var testGenesis = types.GenesisState{
 			IntermediaryAccount: "osmo1hpgapnfl3thkevvl0jp3wqtk8jw7mpqumuuc2f",
 		},
 	},
+	BoundedConcentratedLocks: []types.BoundedConcentratedLockRecord{
+		{
+			LockId:         2,
+			OsmoEquivalent: osmomath.NewDec(500),
+		},
+	},
 }
 
 func TestMarshalUnmarshalGenesis(t *testing.T) {
@@ -99,6 +105,9 @@ func TestInitGenesis(t *testing.T) {
 
 	connections := app.SuperfluidKeeper.GetAllLockIdIntermediaryAccountConnections(ctx)
 	require.Equal(t, connections, genesis.IntemediaryAccountConnections)
+
+	boundedLocks := app.SuperfluidKeeper.GetAllBoundedConcentratedLockRecords(ctx)
+	require.Equal(t, boundedLocks, genesis.BoundedConcentratedLocks)
 	os.RemoveAll(dirName)
 }
 
@@ -126,6 +135,7 @@ func TestExportGenesis(t *testing.T) {
 	require.Equal(t, genesis.OsmoEquivalentMultipliers, genesis.OsmoEquivalentMultipliers)
 	require.Equal(t, genesis.IntermediaryAccounts, genesis.IntermediaryAccounts)
 	require.Equal(t, genesis.IntemediaryAccountConnections, genesis.IntemediaryAccountConnections)
+	require.Equal(t, genesisExported.BoundedConcentratedLocks, genesis.BoundedConcentratedLocks)
 
 	os.RemoveAll(dirName)
 }
@@ -261,7 +261,7 @@ func (q Querier) SuperfluidDelegationsByDelegator(goCtx context.Context, req *ty
 
 		// Find how many osmo tokens this delegation is worth at superfluids current risk adjustment
 		// and twap of the denom.
-		equivalentAmount, err := q.Keeper.GetSuperfluidOSMOTokens(ctx, baseDenom, lockedCoins.Amount)
+		equivalentAmount, err := q.Keeper.getLockSuperfluidOSMOTokens(ctx, periodLock)
 		if err != nil {
 			return nil, err
 		}
@@ -428,9 +428,8 @@ func (q Querier) SuperfluidDelegationsByValidatorDenom(goCtx context.Context, re
 
 	for _, lock := range periodLocks {
 		lockedCoins := sdk.NewCoin(req.Denom, lock.GetCoins().AmountOf(req.Denom))
-		baseDenom := lock.Coins.GetDenomByIndex(0)
 
-		equivalentAmount, err := q.Keeper.GetSuperfluidOSMOTokens(ctx, baseDenom, lockedCoins.Amount)
+		equivalentAmount, err := q.Keeper.getLockSuperfluidOSMOTokens(ctx, &lock)
 		if err != nil {
 			return nil, err
 		}
@@ -525,10 +524,16 @@ func (q Querier) TotalDelegationByValidatorForDenom(goCtx context.Context, req *
 			amount = amount.Add(record.DelegationAmount.Amount)
 		}
 
-		equivalentAmountOSMO, err := q.Keeper.GetSuperfluidOSMOTokens(ctx, req.Denom, amount)
+		// bounded range concentrated liquidity positions are valued from their underlying amounts instead of the multiplier.
+		boundedLocks, err := q.Keeper.getBoundedConcentratedLockTotals(ctx, intermediaryAccount)
+		if err != nil {
+			return nil, err
+		}
+		equivalentAmountOSMO, err := q.Keeper.GetSuperfluidOSMOTokens(ctx, req.Denom, amount.Sub(boundedLocks.shares))
 		if err != nil {
 			return nil, err
 		}
+		equivalentAmountOSMO = equivalentAmountOSMO.Add(boundedLocks.osmoAmount)
 
 		result := types.Delegations{
 			ValAddr:        valAddr.String(),
@@ -704,7 +709,7 @@ func (q Querier) filterConcentratedPositionLocks(ctx sdk.Context, positions []mo
 
 		baseDenom := lock.Coins.GetDenomByIndex(0)
 		lockedCoins := sdk.NewCoin(baseDenom, lock.GetCoins().AmountOf(baseDenom))
-		equivalentAmount, err := q.Keeper.GetSuperfluidOSMOTokens(ctx, baseDenom, lockedCoins.Amount)
+		equivalentAmount, err := q.Keeper.getLockSuperfluidOSMOTokens(ctx, lock)
 		if err != nil {
 			return nil, err
 		}
@@ -71,6 +71,9 @@ func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 func (h Hooks) OnLockupExtend(ctx sdk.Context, lockID uint64, oldDuration, newDuration time.Duration) {
 }
 
+func (h Hooks) OnLockTransferred(ctx sdk.Context, lockID uint64, prevOwner, newOwner sdk.AccAddress) {
+}
+
 // staking hooks.
 func (h Hooks) AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress) error {
 	return nil
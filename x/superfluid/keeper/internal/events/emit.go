@@ -2,6 +2,7 @@ package events
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -83,6 +84,27 @@ func newCreateFullRangePositionAndSuperfluidDelegateEvent(lockId, positionId uin
 	)
 }
 
+func EmitCreatePositionAndSuperfluidDelegateEvent(ctx sdk.Context, lockId, positionId uint64, lowerTick, upperTick int64, valAddress string) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newCreatePositionAndSuperfluidDelegateEvent(lockId, positionId, lowerTick, upperTick, valAddress),
+	})
+}
+
+func newCreatePositionAndSuperfluidDelegateEvent(lockId, positionId uint64, lowerTick, upperTick int64, valAddress string) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtCreatePositionAndSFDelegate,
+		sdk.NewAttribute(types.AttributeLockId, osmoutils.Uint64ToString(lockId)),
+		sdk.NewAttribute(types.AttributePositionId, osmoutils.Uint64ToString(positionId)),
+		sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(lowerTick, 10)),
+		sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(upperTick, 10)),
+		sdk.NewAttribute(types.AttributeValidator, valAddress),
+	)
+}
+
 func EmitSuperfluidIncreaseDelegationEvent(ctx sdk.Context, lockId uint64, amount sdk.Coins) {
 	if ctx.EventManager() == nil {
 		return
@@ -213,6 +213,41 @@ func (server msgServer) CreateFullRangePositionAndSuperfluidDelegate(goCtx conte
 	}, nil
 }
 
+func (server msgServer) CreatePositionAndSuperfluidDelegate(goCtx context.Context, msg *types.MsgCreatePositionAndSuperfluidDelegate) (*types.MsgCreatePositionAndSuperfluidDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	stakingParams, err := server.keeper.sk.GetParams(ctx)
+	if err != nil {
+		return &types.MsgCreatePositionAndSuperfluidDelegateResponse{}, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return &types.MsgCreatePositionAndSuperfluidDelegateResponse{}, err
+	}
+	positionData, lockId, err := server.keeper.clk.CreatePositionLocked(ctx, msg.PoolId, address, msg.Coins, msg.LowerTick, msg.UpperTick, stakingParams.UnbondingTime)
+	if err != nil {
+		return &types.MsgCreatePositionAndSuperfluidDelegateResponse{}, err
+	}
+
+	superfluidDelegateMsg := types.MsgSuperfluidDelegate{
+		Sender:  msg.Sender,
+		LockId:  lockId,
+		ValAddr: msg.ValAddr,
+	}
+
+	_, err = server.SuperfluidDelegate(goCtx, &superfluidDelegateMsg)
+	if err != nil {
+		return &types.MsgCreatePositionAndSuperfluidDelegateResponse{}, err
+	}
+
+	events.EmitCreatePositionAndSuperfluidDelegateEvent(ctx, lockId, positionData.ID, msg.LowerTick, msg.UpperTick, msg.ValAddr)
+
+	return &types.MsgCreatePositionAndSuperfluidDelegateResponse{
+		LockID:     lockId,
+		PositionID: positionData.ID,
+	}, nil
+}
+
 func (server msgServer) UnlockAndMigrateSharesToFullRangeConcentratedPosition(goCtx context.Context, msg *types.MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) (*types.MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse, error) {
 	return nil, errors.New("UnlockAndMigrateSharesToFullRangeConcentratedPosition is no longer supported")
 }
@@ -211,6 +211,85 @@ func (s *KeeperTestSuite) TestMsgCreateFullRangePositionAndSuperfluidDelegate()
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgCreatePositionAndSuperfluidDelegate() {
+	defaultSender := s.TestAccs[0]
+
+	tests := []struct {
+		name                   string
+		lowerTick              int64
+		upperTick              int64
+		poolId                 uint64
+		expectPass             bool
+		expectBoundedLockValue bool
+	}{
+		{
+			name:                   "bounded range",
+			lowerTick:              -1000,
+			upperTick:              1000,
+			expectPass:             true,
+			expectBoundedLockValue: true,
+		},
+		{
+			name:       "full range",
+			lowerTick:  cltypes.MinInitializedTick,
+			upperTick:  cltypes.MaxTick,
+			expectPass: true,
+		},
+		{
+			name:      "invalid tick range",
+			lowerTick: 1000,
+			upperTick: -1000,
+		},
+		{
+			name:      "invalid pool id",
+			lowerTick: -1000,
+			upperTick: 1000,
+			poolId:    3,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+
+			clPool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(defaultFunds[0].Denom, defaultFunds[1].Denom)
+			clLockupDenom := cltypes.GetConcentratedLockupDenomFromPoolId(clPool.GetId())
+			err := s.App.SuperfluidKeeper.AddNewSuperfluidAsset(s.Ctx, types.SuperfluidAsset{
+				Denom:     clLockupDenom,
+				AssetType: types.SuperfluidAssetTypeConcentratedShare,
+			})
+			s.Require().NoError(err)
+
+			if test.poolId == 0 {
+				test.poolId = clPool.GetId()
+			}
+
+			s.FundAcc(defaultSender, defaultFunds)
+
+			valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+
+			msgServer := keeper.NewMsgServerImpl(s.App.SuperfluidKeeper)
+			resp, err := msgServer.CreatePositionAndSuperfluidDelegate(s.Ctx, types.NewMsgCreatePositionAndSuperfluidDelegate(defaultSender, defaultFunds, valAddrs[0].String(), test.poolId, test.lowerTick, test.upperTick))
+			if !test.expectPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtCreatePositionAndSFDelegate, 1)
+
+			position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, resp.PositionID)
+			s.Require().NoError(err)
+			s.Require().Equal(test.lowerTick, position.LowerTick)
+			s.Require().Equal(test.upperTick, position.UpperTick)
+
+			_, found := s.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(s.Ctx, resp.LockID)
+			s.Require().True(found)
+			_, found = s.App.SuperfluidKeeper.GetBoundedConcentratedLockOsmoEquivalent(s.Ctx, resp.LockID)
+			s.Require().Equal(test.expectBoundedLockValue, found)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgSuperfluidUnbondLock() {
 	type param struct {
 		coinsToLock         sdk.Coins
@@ -38,18 +38,30 @@ func (k Keeper) GetTotalSyntheticAssetsLocked(ctx sdk.Context, denom string) (os
 // This is labeled as expected because the way it calculates the amount can
 // lead rounding errors from the true delegated amount.
 func (k Keeper) GetExpectedDelegationAmount(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) (osmomath.Int, error) {
+	boundedLocks, err := k.getBoundedConcentratedLockTotals(ctx, acc)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	return k.expectedDelegationAmount(ctx, acc, boundedLocks)
+}
+
+// expectedDelegationAmount returns the total number of osmo the intermediary account
+// has delegated, given the totals of its bounded range concentrated liquidity locks.
+func (k Keeper) expectedDelegationAmount(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount, boundedLocks boundedConcentratedLockTotals) (osmomath.Int, error) {
 	// (1) Find how many tokens total T are locked for (denom, validator) pair
 	totalSuperfluidDelegation, err := k.GetTotalSyntheticAssetsLocked(ctx, stakingSyntheticDenom(acc.Denom, acc.ValAddr))
 	if err != nil {
 		return osmomath.Int{}, err
 	}
-	// (2) Multiply the T tokens, by the number of superfluid osmo per token, to get the total amount
-	// of osmo we expect.
-	refreshedAmount, err := k.GetSuperfluidOSMOTokens(ctx, acc.Denom, totalSuperfluidDelegation)
+	// (2) Multiply the T tokens, excluding the shares of bounded range concentrated liquidity positions,
+	// by the number of superfluid osmo per token, to get the total amount of osmo we expect.
+	refreshedAmount, err := k.GetSuperfluidOSMOTokens(ctx, acc.Denom, totalSuperfluidDelegation.Sub(boundedLocks.shares))
 	if err != nil {
 		return osmomath.Int{}, err
 	}
-	return refreshedAmount, nil
+	// (3) Add the osmo equivalent of the bounded range concentrated liquidity positions,
+	// which are valued from their underlying amounts instead.
+	return refreshedAmount.Add(boundedLocks.osmoAmount), nil
 }
 
 // RefreshIntermediaryDelegationAmounts refreshes the amount of delegation for all intermediary accounts.
@@ -57,6 +69,9 @@ func (k Keeper) GetExpectedDelegationAmount(ctx sdk.Context, acc types.Superflui
 // instantly undelegating and burning if the refreshed delegation has decreased.
 func (k Keeper) RefreshIntermediaryDelegationAmounts(context context.Context, accs []types.SuperfluidIntermediaryAccount) {
 	ctx := sdk.UnwrapSDKContext(context)
+	// revalue bounded range concentrated liquidity locks from their positions' current underlying amounts.
+	boundedLocksByAcc := k.refreshBoundedConcentratedLocks(ctx)
+
 	// iterate over all intermedairy accounts - every (denom, validator) pair
 	for _, acc := range accs {
 		mAddr := acc.GetAccAddress()
@@ -83,7 +98,11 @@ func (k Keeper) RefreshIntermediaryDelegationAmounts(context context.Context, ac
 			currentAmount = validator.TokensFromShares(delegation.Shares).RoundInt()
 		}
 
-		refreshedAmount, err := k.GetExpectedDelegationAmount(ctx, acc)
+		boundedLocks, ok := boundedLocksByAcc[mAddr.String()]
+		if !ok {
+			boundedLocks = newBoundedConcentratedLockTotals()
+		}
+		refreshedAmount, err := k.expectedDelegationAmount(ctx, acc, boundedLocks)
 		if err != nil {
 			ctx.Logger().Error("Error in GetExpectedDelegationAmount (likely that underlying LP share is no longer superfluid capable), state update reverted", err)
 		}
@@ -216,6 +235,7 @@ func (k Keeper) validateValAddrForDelegate(ctx sdk.Context, valAddr string) (sta
 // and the intermediary account, as an intermediary account does not serve for delegations from a single delegator.
 // The actual amount of delegation is not equal to the equivalent amount of osmo the lock has. That is,
 // the actual amount of delegation is amount * osmo equivalent multiplier * (1 - k.RiskFactor(asset)).
+// For bounded range concentrated liquidity positions, it is instead the OSMO amount underlying the position * (1 - k.RiskFactor(asset)).
 func (k Keeper) SuperfluidDelegate(ctx sdk.Context, sender string, lockID uint64, valAddr string) error {
 	lock, err := k.lk.GetLockByID(ctx, lockID)
 	if err != nil {
@@ -247,9 +267,19 @@ func (k Keeper) SuperfluidDelegate(ctx sdk.Context, sender string, lockID uint64
 		return err
 	}
 
+	// Bounded range concentrated liquidity positions are valued from their underlying amounts
+	// rather than the twap of the denom. Record their value, to be refreshed every epoch.
+	osmoEquivalent, isBounded, err := k.calculateBoundedConcentratedLockOsmoEquivalent(ctx, lock)
+	if err != nil {
+		return err
+	}
+	if isBounded {
+		k.SetBoundedConcentratedLockOsmoEquivalent(ctx, lockID, acc.GetAccAddress(), osmoEquivalent)
+	}
+
 	// Find how many new osmo tokens this delegation is worth at superfluids current risk adjustment
 	// and twap of the denom.
-	amount, err := k.GetSuperfluidOSMOTokens(ctx, acc.Denom, lockedCoin.Amount)
+	amount, err := k.getLockSuperfluidOSMOTokens(ctx, lock)
 	if err != nil {
 		return err
 	}
@@ -267,6 +297,7 @@ func (k Keeper) SuperfluidDelegate(ctx sdk.Context, sender string, lockID uint64
 // - deletes the connection between the lock id and the intermediary account
 // - deletes the synthetic lockup associated with the lock id
 // - undelegates the superfluid staking position associated with the lock id and burns the underlying osmo tokens
+// - deletes the osmo equivalent record of the lock if it is a bounded range concentrated liquidity lock
 // - returns the intermediary account
 func (k Keeper) undelegateCommon(ctx sdk.Context, sender string, lockID uint64) (types.SuperfluidIntermediaryAccount, error) {
 	lock, err := k.lk.GetLockByID(ctx, lockID)
@@ -294,10 +325,11 @@ func (k Keeper) undelegateCommon(ctx sdk.Context, sender string, lockID uint64)
 	}
 
 	// undelegate this lock's delegation amount, and burn the minted osmo.
-	amount, err := k.GetSuperfluidOSMOTokens(ctx, intermediaryAcc.Denom, lockedCoin.Amount)
+	amount, err := k.getLockSuperfluidOSMOTokens(ctx, lock)
 	if err != nil {
 		return types.SuperfluidIntermediaryAccount{}, err
 	}
+	k.DeleteBoundedConcentratedLockOsmoEquivalent(ctx, lockID, intermediaryAcc.GetAccAddress())
 	err = k.forceUndelegateAndBurnOsmoTokens(ctx, amount, intermediaryAcc)
 	if err != nil {
 		return types.SuperfluidIntermediaryAccount{}, err
@@ -598,7 +630,7 @@ func (k Keeper) IterateDelegations(context context.Context, delegator sdk.AccAdd
 		}
 
 		// get osmo-equivalent token amount
-		amount, err := k.GetSuperfluidOSMOTokens(ctx, interim.Denom, coin.Amount)
+		amount, err := k.getLockSuperfluidOSMOTokens(ctx, lock)
 		if err != nil {
 			ctx.Logger().Error("failed to get osmo equivalent of token", "Denom", interim.Denom, "Amount", coin.Amount, "Error", err)
 			continue
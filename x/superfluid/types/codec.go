@@ -20,6 +20,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUnPoolWhitelistedPool{}, "osmosis/unpool-whitelisted-pool", nil)
 	cdc.RegisterConcrete(&MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition{}, "osmosis/unlock-and-migrate", nil)
 	cdc.RegisterConcrete(&MsgCreateFullRangePositionAndSuperfluidDelegate{}, "osmosis/full-range-and-sf-delegate", nil)
+	cdc.RegisterConcrete(&MsgCreatePositionAndSuperfluidDelegate{}, "osmosis/position-and-sf-delegate", nil)
 	cdc.RegisterConcrete(&MsgAddToConcentratedLiquiditySuperfluidPosition{}, "osmosis/add-to-cl-superfluid-position", nil)
 	cdc.RegisterConcrete(&MsgUnbondConvertAndStake{}, "osmosis/unbond-convert-and-stake", nil)
 }
@@ -35,6 +36,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUnPoolWhitelistedPool{},
 		&MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition{},
 		&MsgCreateFullRangePositionAndSuperfluidDelegate{},
+		&MsgCreatePositionAndSuperfluidDelegate{},
 		&MsgAddToConcentratedLiquiditySuperfluidPosition{},
 		&MsgUnbondConvertAndStake{},
 	)
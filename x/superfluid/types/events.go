@@ -16,6 +16,7 @@ const (
 
 	TypeEvtUnlockAndMigrateShares               = "unlock_and_migrate_shares"
 	TypeEvtCreateFullRangePositionAndSFDelegate = "full_range_position_and_delegate"
+	TypeEvtCreatePositionAndSFDelegate          = "position_and_delegate"
 	AttributeKeyPoolIdEntering                  = "pool_id_entering"
 	AttributeKeyPoolIdLeaving                   = "pool_id_leaving"
 	AttributeGammLockId                         = "gamm_lock_id"
@@ -26,6 +27,8 @@ const (
 	AttributeAmount0                            = "amount0"
 	AttributeAmount1                            = "amount1"
 	AttributeLiquidity                          = "liquidity"
+	AttributeLowerTick                          = "lower_tick"
+	AttributeUpperTick                          = "upper_tick"
 
 	AttributeDenom               = "denom"
 	AttributeSuperfluidAssetType = "superfluid_asset_type"
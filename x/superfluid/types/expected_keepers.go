@@ -10,6 +10,7 @@ import (
 	addresscodec "cosmossdk.io/core/address"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity"
 	"github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/model"
 	cltypes "github.com/osmosis-labs/osmosis/v29/x/concentrated-liquidity/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v29/x/gamm/types"
@@ -118,6 +119,7 @@ type ConcentratedKeeper interface {
 	GetConcentratedPoolById(ctx sdk.Context, poolId uint64) (cltypes.ConcentratedPoolExtension, error)
 	CreateFullRangePositionLocked(ctx sdk.Context, clPoolId uint64, owner sdk.AccAddress, coins sdk.Coins, remainingLockDuration time.Duration) (positionData cltypes.CreateFullRangePositionData, concentratedLockID uint64, err error)
 	CreateFullRangePositionUnlocking(ctx sdk.Context, clPoolId uint64, owner sdk.AccAddress, coins sdk.Coins, remainingLockDuration time.Duration) (positionData cltypes.CreateFullRangePositionData, concentratedLockID uint64, err error)
+	CreatePositionLocked(ctx sdk.Context, clPoolId uint64, owner sdk.AccAddress, coins sdk.Coins, lowerTick, upperTick int64, remainingLockDuration time.Duration) (positionData cl.CreatePositionData, concentratedLockID uint64, err error)
 	GetPositionIdToLockId(ctx sdk.Context, underlyingLockId uint64) (uint64, error)
 	GetFullRangeLiquidityInPool(ctx sdk.Context, poolId uint64) (osmomath.Dec, error)
 	PositionHasActiveUnderlyingLock(ctx sdk.Context, positionId uint64) (bool, uint64, error)
@@ -36,6 +36,9 @@ type GenesisState struct {
 	// plays an intermediary role between validators and the delegators.
 	IntermediaryAccounts          []SuperfluidIntermediaryAccount       `protobuf:"bytes,4,rep,name=intermediary_accounts,json=intermediaryAccounts,proto3" json:"intermediary_accounts"`
 	IntemediaryAccountConnections []LockIdIntermediaryAccountConnection `protobuf:"bytes,5,rep,name=intemediary_account_connections,json=intemediaryAccountConnections,proto3" json:"intemediary_account_connections"`
+	// bounded_concentrated_locks is the records of osmo equivalent amount of
+	// each superfluid delegated bounded range concentrated liquidity lock.
+	BoundedConcentratedLocks []BoundedConcentratedLockRecord `protobuf:"bytes,6,rep,name=bounded_concentrated_locks,json=boundedConcentratedLocks,proto3" json:"bounded_concentrated_locks"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +109,13 @@ func (m *GenesisState) GetIntemediaryAccountConnections() []LockIdIntermediaryAc
 	return nil
 }
 
+func (m *GenesisState) GetBoundedConcentratedLocks() []BoundedConcentratedLockRecord {
+	if m != nil {
+		return m.BoundedConcentratedLocks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.superfluid.GenesisState")
 }
@@ -113,31 +123,34 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/genesis.proto", fileDescriptor_d5256ebb7c83fff3) }
 
 var fileDescriptor_d5256ebb7c83fff3 = []byte{
-	// 384 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x4f, 0xf2, 0x40,
-	0x10, 0x87, 0xdb, 0x17, 0x5e, 0x0e, 0xc5, 0x83, 0x36, 0x98, 0x54, 0x8c, 0x85, 0xc8, 0x85, 0x8b,
-	0x6d, 0xac, 0x89, 0x7f, 0x8e, 0x60, 0x8c, 0x21, 0xd1, 0x48, 0x20, 0xf1, 0xe0, 0xa5, 0x59, 0xca,
-	0x5a, 0x37, 0xb6, 0xdd, 0xba, 0xb3, 0x25, 0xf0, 0x01, 0xbc, 0xf3, 0xb1, 0x38, 0x72, 0xf4, 0x64,
-	0x0c, 0x7c, 0x11, 0xd3, 0x76, 0x2d, 0x28, 0xd5, 0xdb, 0xb4, 0xf3, 0xfc, 0xe6, 0x99, 0x4d, 0x46,
-	0xa9, 0x53, 0xf0, 0x29, 0x10, 0x30, 0x21, 0x0a, 0x31, 0x7b, 0xf4, 0x22, 0x32, 0x34, 0x5d, 0x1c,
-	0x60, 0x20, 0x60, 0x84, 0x8c, 0x72, 0xaa, 0xaa, 0x82, 0x30, 0x56, 0x44, 0xb5, 0xe2, 0x52, 0x97,
-	0x26, 0x6d, 0x33, 0xae, 0x52, 0xb2, 0xda, 0xc8, 0x99, 0xb5, 0x2a, 0x05, 0x54, 0xcb, 0x81, 0x42,
-	0xc4, 0x90, 0x2f, 0x7c, 0x87, 0xd3, 0xa2, 0xb2, 0x75, 0x9d, 0x6e, 0xd0, 0xe7, 0x88, 0x63, 0xf5,
-	0x5c, 0x29, 0xa5, 0x80, 0x26, 0xd7, 0xe5, 0x66, 0xd9, 0xaa, 0x1a, 0x9b, 0x1b, 0x19, 0xdd, 0x84,
-	0x68, 0x17, 0x67, 0xef, 0x35, 0xa9, 0x27, 0x78, 0xf5, 0x5e, 0xd9, 0x59, 0x21, 0x36, 0x02, 0xc0,
-	0x1c, 0xb4, 0x7f, 0xf5, 0x42, 0xb3, 0x6c, 0x35, 0xf2, 0x86, 0xf4, 0xb3, 0xb2, 0x15, 0xb3, 0x62,
-	0xda, 0x36, 0x7c, 0xff, 0x0d, 0xea, 0x58, 0xd9, 0x8f, 0xd3, 0x36, 0x7e, 0x89, 0xc8, 0x08, 0x79,
-	0x38, 0xe0, 0xb6, 0x1f, 0x79, 0x9c, 0x84, 0x1e, 0xc1, 0x0c, 0xb4, 0x42, 0x62, 0xb0, 0xf2, 0x0c,
-	0x77, 0xe0, 0xd3, 0xab, 0x2c, 0x75, 0x9b, 0x85, 0x7a, 0xd8, 0xa1, 0x6c, 0x28, 0x84, 0x7b, 0xf4,
-	0x17, 0x0a, 0x54, 0x4f, 0xd9, 0x25, 0x01, 0xc7, 0xcc, 0xc7, 0x43, 0x82, 0xd8, 0xc4, 0x46, 0x8e,
-	0x43, 0xa3, 0x80, 0x83, 0x56, 0x4c, 0x9c, 0xc7, 0x7f, 0xbf, 0xaa, 0xb3, 0x16, 0x6d, 0xa5, 0x49,
-	0xa1, 0xac, 0x90, 0xcd, 0x16, 0xa8, 0xaf, 0xb2, 0x52, 0x8b, 0x1b, 0x3f, 0x6c, 0xb6, 0x43, 0x83,
-	0x00, 0x3b, 0x9c, 0xd0, 0x00, 0xb4, 0xff, 0x89, 0xf8, 0x2c, 0x4f, 0x7c, 0x43, 0x9d, 0xe7, 0x4e,
-	0x9e, 0xf4, 0x32, 0xcb, 0x0b, 0xfd, 0xc1, 0x9a, 0x65, 0x83, 0x81, 0x76, 0x77, 0xb6, 0xd0, 0xe5,
-	0xf9, 0x42, 0x97, 0x3f, 0x16, 0xba, 0x3c, 0x5d, 0xea, 0xd2, 0x7c, 0xa9, 0x4b, 0x6f, 0x4b, 0x5d,
-	0x7a, 0x38, 0x75, 0x09, 0x7f, 0x8a, 0x06, 0x86, 0x43, 0x7d, 0x53, 0x6c, 0x70, 0xe4, 0xa1, 0x01,
-	0x7c, 0x7d, 0x98, 0x23, 0xeb, 0xc2, 0x1c, 0xaf, 0xdf, 0x1a, 0x9f, 0x84, 0x18, 0x06, 0xa5, 0xe4,
-	0xd6, 0x4e, 0x3e, 0x03, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x05, 0x25, 0x2a, 0xff, 0x02, 0x00, 0x00,
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0x87, 0x63, 0xda, 0xe6, 0xb0, 0xe5, 0x00, 0xab, 0x22, 0x99, 0x20, 0x9c, 0x88, 0x5e, 0x7a,
+	0xc1, 0x16, 0x41, 0xe2, 0xcf, 0xb1, 0xa9, 0x10, 0xaa, 0x04, 0xa2, 0x6a, 0x25, 0x0e, 0x5c, 0xac,
+	0xf5, 0x7a, 0x08, 0xab, 0xda, 0x3b, 0x66, 0x67, 0xb7, 0x6a, 0x1f, 0x00, 0x71, 0xe5, 0xb1, 0x7a,
+	0xec, 0x91, 0x13, 0x42, 0xc9, 0x8b, 0x20, 0xdb, 0x4b, 0x92, 0x12, 0x87, 0xdb, 0xd8, 0xf3, 0xfd,
+	0xe6, 0x9b, 0x5d, 0x2d, 0x1b, 0x21, 0x95, 0x48, 0x8a, 0x12, 0x72, 0x15, 0x98, 0xcf, 0x85, 0x53,
+	0x79, 0x32, 0x05, 0x0d, 0xa4, 0x28, 0xae, 0x0c, 0x5a, 0xe4, 0xdc, 0x13, 0xf1, 0x92, 0x18, 0xec,
+	0x4d, 0x71, 0x8a, 0x4d, 0x3b, 0xa9, 0xab, 0x96, 0x1c, 0xec, 0x77, 0xcc, 0x5a, 0x96, 0x1e, 0x1a,
+	0x76, 0x40, 0x95, 0x30, 0xa2, 0xf4, 0xbe, 0x27, 0xdf, 0x77, 0xd8, 0xdd, 0xb7, 0xed, 0x06, 0x67,
+	0x56, 0x58, 0xe0, 0xaf, 0x58, 0xbf, 0x05, 0xc2, 0x60, 0x14, 0x1c, 0xec, 0x8e, 0x07, 0xf1, 0xfa,
+	0x46, 0xf1, 0x49, 0x43, 0x4c, 0xb6, 0xaf, 0x7f, 0x0d, 0x7b, 0xa7, 0x9e, 0xe7, 0x1f, 0xd9, 0xfd,
+	0x25, 0x92, 0x0a, 0x22, 0xb0, 0x14, 0xde, 0x19, 0x6d, 0x1d, 0xec, 0x8e, 0xf7, 0xbb, 0x86, 0x9c,
+	0x2d, 0xca, 0xc3, 0x9a, 0xf5, 0xd3, 0xee, 0xd1, 0xed, 0xdf, 0xc4, 0x2f, 0xd9, 0xa3, 0x3a, 0x9d,
+	0xc2, 0x57, 0xa7, 0x2e, 0x44, 0x01, 0xda, 0xa6, 0xa5, 0x2b, 0xac, 0xaa, 0x0a, 0x05, 0x86, 0xc2,
+	0xad, 0xc6, 0x30, 0xee, 0x32, 0x7c, 0xa0, 0x12, 0xdf, 0x2c, 0x52, 0xef, 0x17, 0xa1, 0x53, 0x90,
+	0x68, 0x72, 0x2f, 0x7c, 0x88, 0x1b, 0x28, 0xe2, 0x05, 0x7b, 0xa0, 0xb4, 0x05, 0x53, 0x42, 0xae,
+	0x84, 0xb9, 0x4a, 0x85, 0x94, 0xe8, 0xb4, 0xa5, 0x70, 0xbb, 0x71, 0x3e, 0xfb, 0xff, 0xa9, 0x8e,
+	0x57, 0xa2, 0x87, 0x6d, 0xd2, 0x2b, 0xf7, 0xd4, 0x7a, 0x8b, 0xf8, 0xb7, 0x80, 0x0d, 0xeb, 0xc6,
+	0x3f, 0xb6, 0x54, 0xa2, 0xd6, 0x20, 0xad, 0x42, 0x4d, 0xe1, 0x4e, 0x23, 0x7e, 0xd9, 0x25, 0x7e,
+	0x87, 0xf2, 0xfc, 0xb8, 0x4b, 0x7a, 0xb4, 0xc8, 0x7b, 0xfd, 0xe3, 0x15, 0xcb, 0x1a, 0x43, 0xdc,
+	0xb1, 0x41, 0x86, 0x4e, 0xe7, 0x90, 0xd7, 0x6a, 0x09, 0xda, 0x1a, 0x61, 0x21, 0x4f, 0x0b, 0x94,
+	0xe7, 0x14, 0xf6, 0x37, 0x1f, 0x7d, 0xd2, 0xa6, 0x8e, 0x56, 0x42, 0xf5, 0x52, 0xb7, 0x6e, 0x3b,
+	0xcc, 0xba, 0x21, 0x9a, 0x9c, 0x5c, 0xcf, 0xa2, 0xe0, 0x66, 0x16, 0x05, 0xbf, 0x67, 0x51, 0xf0,
+	0x63, 0x1e, 0xf5, 0x6e, 0xe6, 0x51, 0xef, 0xe7, 0x3c, 0xea, 0x7d, 0x7a, 0x31, 0x55, 0xf6, 0x8b,
+	0xcb, 0x62, 0x89, 0x65, 0xe2, 0xb5, 0x4f, 0x0b, 0x91, 0xd1, 0xdf, 0x8f, 0xe4, 0x62, 0xfc, 0x3a,
+	0xb9, 0x5c, 0x7d, 0xe2, 0xf6, 0xaa, 0x02, 0xca, 0xfa, 0xcd, 0x13, 0x7f, 0xfe, 0x67, 0x00, 0xc8,
+	0xa2, 0x2c, 0x37, 0x76, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -160,6 +173,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BoundedConcentratedLocks) > 0 {
+		for iNdEx := len(m.BoundedConcentratedLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BoundedConcentratedLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.IntemediaryAccountConnections) > 0 {
 		for iNdEx := len(m.IntemediaryAccountConnections) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -272,6 +299,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BoundedConcentratedLocks) > 0 {
+		for _, e := range m.BoundedConcentratedLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -479,6 +512,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundedConcentratedLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BoundedConcentratedLocks = append(m.BoundedConcentratedLocks, BoundedConcentratedLockRecord{})
+			if err := m.BoundedConcentratedLocks[len(m.BoundedConcentratedLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -27,4 +27,11 @@ var (
 
 	// KeyUnpoolAllowedPools defines key to unpool allowed pools.
 	KeyUnpoolAllowedPools = []byte{0x06}
+
+	// KeyPrefixBoundedConcentratedLock defines prefix to store the osmo equivalent of bounded range concentrated liquidity locks.
+	KeyPrefixBoundedConcentratedLock = []byte{0x07}
+
+	// KeyPrefixIntermediaryAccountBoundedConcentratedLock defines prefix to index the bounded range concentrated liquidity
+	// locks by intermediary account.
+	KeyPrefixIntermediaryAccountBoundedConcentratedLock = []byte{0x08}
 )
@@ -70,6 +70,17 @@ func TestAuthzMsg(t *testing.T) {
 				PoolId: 1,
 			},
 		},
+		{
+			name: "MsgCreatePositionAndSuperfluidDelegate",
+			msg: &types.MsgCreatePositionAndSuperfluidDelegate{
+				Sender:    addr1,
+				Coins:     sdk.NewCoins(coin),
+				ValAddr:   "valoper1xyz",
+				PoolId:    1,
+				LowerTick: -1000,
+				UpperTick: 1000,
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
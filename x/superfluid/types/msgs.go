@@ -23,6 +23,7 @@ const (
 	TypeMsgUnPoolWhitelistedPool                        = "unpool_whitelisted_pool"
 	TypeMsgUnlockAndMigrateShares                       = "unlock_and_migrate_shares"
 	TypeMsgCreateFullRangePositionAndSuperfluidDelegate = "create_full_range_position_and_delegate"
+	TypeMsgCreatePositionAndSuperfluidDelegate          = "create_position_and_delegate"
 	TypeMsgAddToConcentratedLiquiditySuperfluidPosition = "add_to_concentrated_liquidity_superfluid_position"
 	TypeMsgUnbondConvertAndStake                        = "unbond_convert_and_stake"
 )
@@ -322,6 +323,57 @@ func (msg MsgCreateFullRangePositionAndSuperfluidDelegate) GetSigners() []sdk.Ac
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgCreatePositionAndSuperfluidDelegate{}
+
+func NewMsgCreatePositionAndSuperfluidDelegate(sender sdk.AccAddress, coins sdk.Coins, valAddr string, poolId uint64, lowerTick, upperTick int64) *MsgCreatePositionAndSuperfluidDelegate {
+	return &MsgCreatePositionAndSuperfluidDelegate{
+		Sender:    sender.String(),
+		Coins:     coins,
+		ValAddr:   valAddr,
+		PoolId:    poolId,
+		LowerTick: lowerTick,
+		UpperTick: upperTick,
+	}
+}
+
+func (msg MsgCreatePositionAndSuperfluidDelegate) Route() string { return RouterKey }
+func (msg MsgCreatePositionAndSuperfluidDelegate) Type() string {
+	return TypeMsgCreatePositionAndSuperfluidDelegate
+}
+
+func (msg MsgCreatePositionAndSuperfluidDelegate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = msg.Coins.Validate()
+	if err != nil {
+		return err
+	}
+
+	if msg.ValAddr == "" {
+		return errors.New("ValAddr should not be empty")
+	}
+
+	if msg.PoolId < 1 {
+		return errors.New("pool id must be positive")
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return fmt.Errorf("lower tick (%d) must be less than upper tick (%d)", msg.LowerTick, msg.UpperTick)
+	}
+	return nil
+}
+
+func (msg MsgCreatePositionAndSuperfluidDelegate) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgAddToConcentratedLiquiditySuperfluidPosition{}
 
 func (msg MsgAddToConcentratedLiquiditySuperfluidPosition) Route() string { return RouterKey }
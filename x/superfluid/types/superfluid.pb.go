@@ -350,6 +350,56 @@ func (m *LockIdIntermediaryAccountConnection) GetIntermediaryAccount() string {
 	return ""
 }
 
+// BoundedConcentratedLockRecord is the OSMO equivalent, before the risk
+// adjustment, of a superfluid delegated bounded range concentrated liquidity
+// lock. Unlike full range positions, which are valued with the osmo equivalent
+// multiplier of their pool, it is computed from the position's underlying
+// amounts, and refreshed every epoch.
+type BoundedConcentratedLockRecord struct {
+	LockId         uint64                      `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	OsmoEquivalent cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=osmo_equivalent,json=osmoEquivalent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"osmo_equivalent" yaml:"osmo_equivalent"`
+}
+
+func (m *BoundedConcentratedLockRecord) Reset()         { *m = BoundedConcentratedLockRecord{} }
+func (m *BoundedConcentratedLockRecord) String() string { return proto.CompactTextString(m) }
+func (*BoundedConcentratedLockRecord) ProtoMessage()    {}
+func (*BoundedConcentratedLockRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{5}
+}
+func (m *BoundedConcentratedLockRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BoundedConcentratedLockRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BoundedConcentratedLockRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BoundedConcentratedLockRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoundedConcentratedLockRecord.Merge(m, src)
+}
+func (m *BoundedConcentratedLockRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BoundedConcentratedLockRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoundedConcentratedLockRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoundedConcentratedLockRecord proto.InternalMessageInfo
+
+func (m *BoundedConcentratedLockRecord) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
 type UnpoolWhitelistedPools struct {
 	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
@@ -358,7 +408,7 @@ func (m *UnpoolWhitelistedPools) Reset()         { *m = UnpoolWhitelistedPools{}
 func (m *UnpoolWhitelistedPools) String() string { return proto.CompactTextString(m) }
 func (*UnpoolWhitelistedPools) ProtoMessage()    {}
 func (*UnpoolWhitelistedPools) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{5}
+	return fileDescriptor_79d3c29d82dbb734, []int{6}
 }
 func (m *UnpoolWhitelistedPools) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +457,7 @@ func (m *ConcentratedPoolUserPositionRecord) Reset()         { *m = Concentrated
 func (m *ConcentratedPoolUserPositionRecord) String() string { return proto.CompactTextString(m) }
 func (*ConcentratedPoolUserPositionRecord) ProtoMessage()    {}
 func (*ConcentratedPoolUserPositionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{6}
+	return fileDescriptor_79d3c29d82dbb734, []int{7}
 }
 func (m *ConcentratedPoolUserPositionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,6 +535,7 @@ func init() {
 	proto.RegisterType((*OsmoEquivalentMultiplierRecord)(nil), "osmosis.superfluid.OsmoEquivalentMultiplierRecord")
 	proto.RegisterType((*SuperfluidDelegationRecord)(nil), "osmosis.superfluid.SuperfluidDelegationRecord")
 	proto.RegisterType((*LockIdIntermediaryAccountConnection)(nil), "osmosis.superfluid.LockIdIntermediaryAccountConnection")
+	proto.RegisterType((*BoundedConcentratedLockRecord)(nil), "osmosis.superfluid.BoundedConcentratedLockRecord")
 	proto.RegisterType((*UnpoolWhitelistedPools)(nil), "osmosis.superfluid.UnpoolWhitelistedPools")
 	proto.RegisterType((*ConcentratedPoolUserPositionRecord)(nil), "osmosis.superfluid.ConcentratedPoolUserPositionRecord")
 }
@@ -494,59 +545,62 @@ func init() {
 }
 
 var fileDescriptor_79d3c29d82dbb734 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xda, 0x6e, 0xd2, 0x4c, 0xa0, 0xb8, 0xdb, 0xa8, 0x24, 0x41, 0xd9, 0x0d, 0x5b, 0xa4,
-	0x5a, 0xad, 0xba, 0xab, 0x04, 0x09, 0x41, 0x6f, 0x4e, 0x0a, 0x92, 0x51, 0x28, 0xd6, 0x9a, 0x0a,
-	0xc4, 0x65, 0x35, 0xde, 0x79, 0x5d, 0x8f, 0x3c, 0xbb, 0xb3, 0xdd, 0x99, 0x35, 0xf8, 0xc6, 0x81,
-	0x43, 0x8f, 0xfc, 0x09, 0x95, 0xb8, 0x71, 0xe5, 0x9f, 0xe8, 0xb1, 0x12, 0x17, 0xc4, 0x21, 0xa0,
-	0xe4, 0xc2, 0xb9, 0x7f, 0x01, 0x9a, 0xd9, 0x1f, 0xde, 0x34, 0xae, 0x10, 0x17, 0x7a, 0xf2, 0xcc,
-	0xfb, 0xde, 0xbc, 0xf7, 0x7d, 0xf3, 0x3e, 0xcf, 0xa2, 0x5b, 0x5c, 0xc4, 0x5c, 0x50, 0xe1, 0x89,
-	0x3c, 0x85, 0xec, 0x31, 0xcb, 0x29, 0x69, 0x2c, 0xdd, 0x34, 0xe3, 0x92, 0x9b, 0x66, 0x99, 0xe4,
-	0x2e, 0x91, 0xdd, 0xad, 0x88, 0x47, 0x5c, 0xc3, 0x9e, 0x5a, 0x15, 0x99, 0xbb, 0x56, 0xc4, 0x79,
-	0xc4, 0xc0, 0xd3, 0xbb, 0x49, 0xfe, 0xd8, 0x23, 0x79, 0x86, 0x25, 0xe5, 0x49, 0x89, 0xdb, 0xaf,
-	0xe2, 0x92, 0xc6, 0x20, 0x24, 0x8e, 0xd3, 0xaa, 0x40, 0xa8, 0x7b, 0x79, 0x13, 0x2c, 0xc0, 0x9b,
-	0x1f, 0x4c, 0x40, 0xe2, 0x03, 0x2f, 0xe4, 0xb4, 0x2a, 0xb0, 0x53, 0xf1, 0x65, 0x3c, 0x9c, 0xe5,
-	0xa9, 0xfe, 0x29, 0x20, 0x67, 0x81, 0xde, 0x19, 0xd7, 0xfc, 0x06, 0x42, 0x80, 0x34, 0xb7, 0xd0,
-	0x15, 0x02, 0x09, 0x8f, 0xb7, 0x8d, 0x7d, 0xa3, 0xbf, 0xe1, 0x17, 0x1b, 0xf3, 0x33, 0x84, 0xb0,
-	0x82, 0x03, 0xb9, 0x48, 0x61, 0xbb, 0xbd, 0x6f, 0xf4, 0xaf, 0x1d, 0xde, 0x76, 0x2f, 0x6b, 0x74,
-	0x5f, 0x29, 0xf7, 0xd5, 0x22, 0x05, 0x7f, 0x03, 0x57, 0xcb, 0xfb, 0x57, 0x9f, 0x3e, 0xb3, 0x5b,
-	0x7f, 0x3f, 0xb3, 0x0d, 0x67, 0x86, 0xf6, 0x96, 0xb9, 0xc3, 0x44, 0x42, 0x16, 0x03, 0xa1, 0x38,
-	0x5b, 0x0c, 0xc2, 0x90, 0xe7, 0xc9, 0xeb, 0x88, 0xec, 0xa0, 0xab, 0x73, 0xcc, 0x02, 0x4c, 0x48,
-	0xa6, 0x69, 0x6c, 0xf8, 0xeb, 0x73, 0xcc, 0x06, 0x84, 0x64, 0x0a, 0x8a, 0x70, 0x1e, 0x41, 0x40,
-	0xc9, 0x76, 0x67, 0xdf, 0xe8, 0x77, 0xfd, 0x75, 0xbd, 0x1f, 0x12, 0xe7, 0x57, 0x03, 0x59, 0x5f,
-	0x8a, 0x98, 0x7f, 0xfa, 0x24, 0xa7, 0x73, 0xcc, 0x20, 0x91, 0x5f, 0xe4, 0x4c, 0xd2, 0x94, 0x51,
-	0xc8, 0x7c, 0x08, 0x79, 0x46, 0xcc, 0xf7, 0xd1, 0x5b, 0x90, 0xf2, 0x70, 0x1a, 0x24, 0x79, 0x3c,
-	0x81, 0x4c, 0x77, 0xed, 0xf8, 0x9b, 0x3a, 0xf6, 0x50, 0x87, 0x96, 0x8c, 0xda, 0x4d, 0x46, 0xdf,
-	0x20, 0x14, 0xd7, 0xc5, 0x74, 0xe3, 0x8d, 0xa3, 0x8f, 0x9f, 0x9f, 0xda, 0xad, 0x3f, 0x4e, 0xed,
-	0xf7, 0x8a, 0xd1, 0x08, 0x32, 0x73, 0x29, 0xf7, 0x62, 0x2c, 0xa7, 0xee, 0x09, 0x44, 0x38, 0x5c,
-	0x3c, 0x80, 0xf0, 0xe5, 0xa9, 0x7d, 0x7d, 0x81, 0x63, 0x76, 0xdf, 0x59, 0x1e, 0x77, 0xfc, 0x46,
-	0x2d, 0xe7, 0x65, 0x1b, 0xed, 0x2e, 0xef, 0xe8, 0x01, 0x30, 0x88, 0xb4, 0x31, 0x4a, 0xc6, 0x77,
-	0xd1, 0x75, 0x52, 0xc4, 0x78, 0xa6, 0x2f, 0x04, 0x84, 0x28, 0x2f, 0xab, 0x57, 0x03, 0x83, 0x22,
-	0xae, 0x92, 0xe7, 0x98, 0x51, 0x72, 0x21, 0xb9, 0xd0, 0xd1, 0xab, 0x81, 0x2a, 0xf9, 0xbb, 0xba,
-	0x32, 0xe5, 0x49, 0x80, 0x63, 0x35, 0x0f, 0xad, 0x6c, 0xf3, 0x70, 0xc7, 0x2d, 0x24, 0xb9, 0xca,
-	0x6d, 0x6e, 0xe9, 0x36, 0xf7, 0x98, 0xd3, 0xe4, 0xc8, 0x53, 0xa2, 0x7f, 0xf9, 0xd3, 0xbe, 0x1d,
-	0x51, 0x39, 0xcd, 0x27, 0x6e, 0xc8, 0x63, 0xaf, 0xb4, 0x66, 0xf1, 0x73, 0x4f, 0x90, 0x99, 0xa7,
-	0x0c, 0x24, 0xf4, 0x81, 0x9a, 0x25, 0xe5, 0xc9, 0x40, 0xf7, 0x30, 0x7f, 0x30, 0xd0, 0x36, 0xd4,
-	0x33, 0x0a, 0x84, 0xc4, 0x33, 0x20, 0x15, 0x81, 0xee, 0xbf, 0x11, 0xb8, 0xfb, 0x5f, 0x9a, 0xdf,
-	0x5c, 0xf6, 0x19, 0xeb, 0x36, 0x05, 0x05, 0xe7, 0x09, 0xba, 0x75, 0xc2, 0xc3, 0xd9, 0x70, 0x95,
-	0x27, 0x8f, 0x79, 0x92, 0x40, 0xa8, 0xf8, 0x9a, 0xef, 0xa2, 0x75, 0xf5, 0x3f, 0x52, 0x5e, 0x33,
-	0xb4, 0xd7, 0xd6, 0x98, 0x3e, 0x65, 0x1e, 0xa0, 0x2d, 0xda, 0x38, 0x19, 0xe0, 0xe2, 0x68, 0x79,
-	0xd7, 0x37, 0xe8, 0xe5, 0xaa, 0xce, 0x1d, 0x74, 0xf3, 0x51, 0x92, 0x72, 0xce, 0xbe, 0x9e, 0x52,
-	0x09, 0x8c, 0x0a, 0x09, 0x64, 0xc4, 0x39, 0x13, 0x66, 0x0f, 0x75, 0x28, 0x51, 0x43, 0xed, 0xf4,
-	0xbb, 0xbe, 0x5a, 0x3a, 0xbf, 0x75, 0x90, 0x73, 0xcc, 0x93, 0x10, 0x12, 0x99, 0xe1, 0x32, 0xef,
-	0x91, 0x80, 0x6c, 0xc4, 0x05, 0xbd, 0xe8, 0x8d, 0xcb, 0xe3, 0x36, 0x5e, 0x33, 0x6e, 0x1b, 0x6d,
-	0xa6, 0xe5, 0x71, 0xa5, 0xa7, 0xad, 0xf5, 0xa0, 0x2a, 0x34, 0x24, 0x4d, 0xb1, 0x9d, 0x0b, 0x62,
-	0x3f, 0x47, 0xd7, 0xc4, 0x22, 0x91, 0x53, 0x90, 0x34, 0x0c, 0x54, 0xac, 0x1c, 0xd2, 0x5e, 0xfd,
-	0x34, 0x14, 0x6f, 0x8e, 0x3b, 0xae, 0xb2, 0xd4, 0xdd, 0x1e, 0x75, 0x95, 0x53, 0xfc, 0xb7, 0x45,
-	0x33, 0xb8, 0xda, 0x74, 0x57, 0xde, 0xb4, 0xe9, 0xd6, 0xfe, 0x0f, 0xd3, 0xdd, 0xf9, 0xd1, 0x40,
-	0x37, 0x56, 0xbc, 0x9c, 0xe6, 0x1e, 0xda, 0x59, 0x11, 0x7e, 0x88, 0x25, 0x9d, 0x43, 0xaf, 0x65,
-	0x5a, 0xcd, 0xf7, 0xa1, 0x86, 0x4f, 0x46, 0xe3, 0x29, 0xce, 0xa0, 0x67, 0x98, 0x7d, 0xf4, 0xc1,
-	0x0a, 0xbc, 0x69, 0x9f, 0x22, 0xb3, 0xbd, 0xdb, 0x7d, 0xfa, 0xb3, 0xd5, 0x3a, 0x1a, 0x3d, 0x3f,
-	0xb3, 0x8c, 0x17, 0x67, 0x96, 0xf1, 0xd7, 0x99, 0x65, 0xfc, 0x74, 0x6e, 0xb5, 0x5e, 0x9c, 0x5b,
-	0xad, 0xdf, 0xcf, 0xad, 0xd6, 0xb7, 0x1f, 0x35, 0x14, 0x96, 0xa3, 0xbd, 0xc7, 0xf0, 0x44, 0x54,
-	0x1b, 0x6f, 0x7e, 0xf8, 0x89, 0xf7, 0x7d, 0xf3, 0x8b, 0xa8, 0x55, 0x4f, 0xd6, 0xf4, 0x77, 0xe6,
-	0xc3, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xa4, 0x54, 0xdc, 0x34, 0x07, 0x00, 0x00,
+	// 873 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x93, 0x6c, 0xbb, 0x9d, 0x42, 0x37, 0xeb, 0xad, 0x4a, 0x1b, 0x54, 0xa7, 0x78, 0x91,
+	0x36, 0xda, 0xd5, 0xda, 0x6a, 0x91, 0x10, 0xac, 0xc4, 0x21, 0xe9, 0x82, 0x54, 0x54, 0x96, 0xca,
+	0x65, 0x05, 0xe2, 0x62, 0x4d, 0x3c, 0xaf, 0xce, 0x28, 0xf6, 0x8c, 0xd7, 0x33, 0x0e, 0xe4, 0xc6,
+	0x81, 0xc3, 0x1e, 0xf9, 0x08, 0x95, 0xb8, 0x71, 0xe5, 0x4b, 0xec, 0x71, 0x25, 0x2e, 0x88, 0x43,
+	0x41, 0xed, 0x85, 0x73, 0x3f, 0x01, 0x9a, 0xb1, 0x9d, 0x38, 0x6d, 0x2a, 0xe0, 0x02, 0x27, 0xcf,
+	0xbc, 0xdf, 0xfb, 0xf3, 0x7b, 0x6f, 0x7e, 0x33, 0x46, 0xf7, 0xb9, 0x88, 0xb9, 0xa0, 0xc2, 0x15,
+	0x59, 0x02, 0xe9, 0x49, 0x94, 0x51, 0x52, 0x59, 0x3a, 0x49, 0xca, 0x25, 0x37, 0xcd, 0xc2, 0xc9,
+	0x99, 0x21, 0xed, 0xf5, 0x90, 0x87, 0x5c, 0xc3, 0xae, 0x5a, 0xe5, 0x9e, 0x6d, 0x2b, 0xe4, 0x3c,
+	0x8c, 0xc0, 0xd5, 0xbb, 0x41, 0x76, 0xe2, 0x92, 0x2c, 0xc5, 0x92, 0x72, 0x56, 0xe0, 0x9d, 0xab,
+	0xb8, 0xa4, 0x31, 0x08, 0x89, 0xe3, 0xa4, 0x4c, 0x10, 0xe8, 0x5a, 0xee, 0x00, 0x0b, 0x70, 0xc7,
+	0xbb, 0x03, 0x90, 0x78, 0xd7, 0x0d, 0x38, 0x2d, 0x13, 0x6c, 0x95, 0x7c, 0x23, 0x1e, 0x8c, 0xb2,
+	0x44, 0x7f, 0x72, 0xc8, 0x9e, 0xa0, 0x3b, 0xc7, 0x53, 0x7e, 0x3d, 0x21, 0x40, 0x9a, 0xeb, 0xe8,
+	0x16, 0x01, 0xc6, 0xe3, 0x4d, 0x63, 0xc7, 0xe8, 0xae, 0x78, 0xf9, 0xc6, 0xfc, 0x04, 0x21, 0xac,
+	0x60, 0x5f, 0x4e, 0x12, 0xd8, 0xac, 0xef, 0x18, 0xdd, 0xb5, 0xbd, 0x07, 0xce, 0xf5, 0x1e, 0x9d,
+	0x2b, 0xe9, 0xbe, 0x98, 0x24, 0xe0, 0xad, 0xe0, 0x72, 0xf9, 0xe4, 0xf6, 0xcb, 0xd3, 0x4e, 0xed,
+	0xcf, 0xd3, 0x8e, 0x61, 0x8f, 0xd0, 0xf6, 0xcc, 0xf7, 0x80, 0x49, 0x48, 0x63, 0x20, 0x14, 0xa7,
+	0x93, 0x5e, 0x10, 0xf0, 0x8c, 0xdd, 0x44, 0x64, 0x0b, 0xdd, 0x1e, 0xe3, 0xc8, 0xc7, 0x84, 0xa4,
+	0x9a, 0xc6, 0x8a, 0xb7, 0x3c, 0xc6, 0x51, 0x8f, 0x90, 0x54, 0x41, 0x21, 0xce, 0x42, 0xf0, 0x29,
+	0xd9, 0x6c, 0xec, 0x18, 0xdd, 0xa6, 0xb7, 0xac, 0xf7, 0x07, 0xc4, 0xfe, 0xd9, 0x40, 0xd6, 0xe7,
+	0x22, 0xe6, 0x1f, 0xbf, 0xc8, 0xe8, 0x18, 0x47, 0xc0, 0xe4, 0x67, 0x59, 0x24, 0x69, 0x12, 0x51,
+	0x48, 0x3d, 0x08, 0x78, 0x4a, 0xcc, 0x77, 0xd0, 0x1b, 0x90, 0xf0, 0x60, 0xe8, 0xb3, 0x2c, 0x1e,
+	0x40, 0xaa, 0xab, 0x36, 0xbc, 0x55, 0x6d, 0x7b, 0xa6, 0x4d, 0x33, 0x46, 0xf5, 0x2a, 0xa3, 0xaf,
+	0x10, 0x8a, 0xa7, 0xc9, 0x74, 0xe1, 0x95, 0xfe, 0x07, 0xaf, 0xce, 0x3a, 0xb5, 0xdf, 0xce, 0x3a,
+	0x6f, 0xe7, 0x47, 0x23, 0xc8, 0xc8, 0xa1, 0xdc, 0x8d, 0xb1, 0x1c, 0x3a, 0x87, 0x10, 0xe2, 0x60,
+	0xf2, 0x14, 0x82, 0xcb, 0xb3, 0xce, 0xdd, 0x09, 0x8e, 0xa3, 0x27, 0xf6, 0x2c, 0xdc, 0xf6, 0x2a,
+	0xb9, 0xec, 0xcb, 0x3a, 0x6a, 0xcf, 0x66, 0xf4, 0x14, 0x22, 0x08, 0xb5, 0x30, 0x0a, 0xc6, 0x8f,
+	0xd0, 0x5d, 0x92, 0xdb, 0x78, 0xaa, 0x07, 0x02, 0x42, 0x14, 0xc3, 0x6a, 0x4d, 0x81, 0x5e, 0x6e,
+	0x57, 0xce, 0x63, 0x1c, 0x51, 0x32, 0xe7, 0x9c, 0xf7, 0xd1, 0x9a, 0x02, 0xa5, 0xf3, 0x37, 0xd3,
+	0xcc, 0x94, 0x33, 0x1f, 0xc7, 0xea, 0x3c, 0x74, 0x67, 0xab, 0x7b, 0x5b, 0x4e, 0xde, 0x92, 0xa3,
+	0xd4, 0xe6, 0x14, 0x6a, 0x73, 0xf6, 0x39, 0x65, 0x7d, 0x57, 0x35, 0xfd, 0xd3, 0xef, 0x9d, 0x07,
+	0x21, 0x95, 0xc3, 0x6c, 0xe0, 0x04, 0x3c, 0x76, 0x0b, 0x69, 0xe6, 0x9f, 0xc7, 0x82, 0x8c, 0x5c,
+	0x25, 0x20, 0xa1, 0x03, 0xa6, 0x2c, 0x29, 0x67, 0x3d, 0x5d, 0xc3, 0xfc, 0xce, 0x40, 0x9b, 0x30,
+	0x3d, 0x23, 0x5f, 0x48, 0x3c, 0x02, 0x52, 0x12, 0x68, 0xfe, 0x1d, 0x81, 0x47, 0xff, 0xa6, 0xf8,
+	0xc6, 0xac, 0xce, 0xb1, 0x2e, 0x93, 0x53, 0xb0, 0x5f, 0xa0, 0xfb, 0x87, 0x3c, 0x18, 0x1d, 0x2c,
+	0xd2, 0xe4, 0x3e, 0x67, 0x0c, 0x02, 0xc5, 0xd7, 0x7c, 0x0b, 0x2d, 0xab, 0x7b, 0xa4, 0xb4, 0x66,
+	0x68, 0xad, 0x2d, 0x45, 0x3a, 0xca, 0xdc, 0x45, 0xeb, 0xb4, 0x12, 0xe9, 0xe3, 0x3c, 0xb4, 0x98,
+	0xf5, 0x3d, 0x7a, 0x3d, 0xab, 0x7d, 0x6a, 0xa0, 0xed, 0x3e, 0xcf, 0x18, 0x01, 0xb2, 0xcf, 0x59,
+	0x00, 0x4c, 0xa6, 0x58, 0x02, 0x51, 0x34, 0x8a, 0xa3, 0xbe, 0xb1, 0xda, 0x09, 0xba, 0xa3, 0xba,
+	0xf3, 0x67, 0xcd, 0xe4, 0x85, 0xfa, 0x1f, 0xfd, 0x33, 0x05, 0x6e, 0xe4, 0x0a, 0xbc, 0x92, 0xc3,
+	0xf6, 0xd6, 0xf8, 0xdc, 0x6d, 0xb1, 0x1f, 0xa2, 0x8d, 0xe7, 0x2c, 0xe1, 0x3c, 0xfa, 0x72, 0x48,
+	0x25, 0x44, 0x54, 0x48, 0x20, 0x47, 0x9c, 0x47, 0xc2, 0x6c, 0xa1, 0x06, 0x25, 0x4a, 0x77, 0x8d,
+	0x6e, 0xd3, 0x53, 0x4b, 0xfb, 0x97, 0x06, 0xb2, 0xab, 0x7d, 0x28, 0xbf, 0xe7, 0x02, 0xd2, 0x23,
+	0x2e, 0xe8, 0xbc, 0x7c, 0xaf, 0x2b, 0xd2, 0xb8, 0x41, 0x91, 0x1d, 0xb4, 0x9a, 0x14, 0xe1, 0x6a,
+	0x08, 0x75, 0x3d, 0x04, 0x54, 0x9a, 0x0e, 0xe6, 0x26, 0xd4, 0x98, 0x9b, 0xd0, 0xa7, 0x68, 0x4d,
+	0x4c, 0x98, 0x1c, 0x82, 0xa4, 0x81, 0xaf, 0x6c, 0x85, 0x8e, 0xb6, 0xa7, 0xaf, 0x57, 0xfe, 0x2c,
+	0x3a, 0xc7, 0xa5, 0x97, 0x9a, 0x7b, 0xbf, 0xa9, 0xe6, 0xe7, 0xbd, 0x29, 0xaa, 0xc6, 0xc5, 0xf7,
+	0xe2, 0xd6, 0xff, 0x7d, 0x2f, 0x96, 0xfe, 0x8b, 0x7b, 0xf1, 0xf0, 0x7b, 0x03, 0xdd, 0x5b, 0xf0,
+	0xb8, 0x9b, 0xdb, 0x68, 0x6b, 0x81, 0xf9, 0x19, 0x96, 0x74, 0x0c, 0xad, 0x9a, 0x69, 0xa1, 0xf6,
+	0x02, 0xf8, 0xf0, 0xe8, 0x78, 0x88, 0x53, 0x68, 0x19, 0x66, 0x17, 0xbd, 0xbb, 0x00, 0xaf, 0xca,
+	0x27, 0xf7, 0xac, 0xb7, 0x9b, 0x2f, 0x7f, 0xb4, 0x6a, 0xfd, 0xa3, 0x57, 0xe7, 0x96, 0xf1, 0xfa,
+	0xdc, 0x32, 0xfe, 0x38, 0xb7, 0x8c, 0x1f, 0x2e, 0xac, 0xda, 0xeb, 0x0b, 0xab, 0xf6, 0xeb, 0x85,
+	0x55, 0xfb, 0xfa, 0xfd, 0x4a, 0x87, 0xc5, 0xd1, 0x3e, 0x8e, 0xf0, 0x40, 0x94, 0x1b, 0x77, 0xbc,
+	0xf7, 0xa1, 0xfb, 0x6d, 0xf5, 0xa7, 0xad, 0xbb, 0x1e, 0x2c, 0xe9, 0x5f, 0xe1, 0x7b, 0x7f, 0x0d,
+	0x00, 0xa2, 0x3a, 0x89, 0xdb, 0xd7, 0x07, 0x00, 0x00,
 }
 
 func (this *SuperfluidAsset) Equal(that interface{}) bool {
@@ -792,6 +846,44 @@ func (m *LockIdIntermediaryAccountConnection) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *BoundedConcentratedLockRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BoundedConcentratedLockRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BoundedConcentratedLockRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OsmoEquivalent.Size()
+		i -= size
+		if _, err := m.OsmoEquivalent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.LockId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *UnpoolWhitelistedPools) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1009,6 +1101,20 @@ func (m *LockIdIntermediaryAccountConnection) Size() (n int) {
 	return n
 }
 
+func (m *BoundedConcentratedLockRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.LockId))
+	}
+	l = m.OsmoEquivalent.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	return n
+}
+
 func (m *UnpoolWhitelistedPools) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1711,6 +1817,109 @@ func (m *LockIdIntermediaryAccountConnection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BoundedConcentratedLockRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BoundedConcentratedLockRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BoundedConcentratedLockRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoEquivalent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OsmoEquivalent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *UnpoolWhitelistedPools) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -659,6 +659,152 @@ func (m *MsgCreateFullRangePositionAndSuperfluidDelegateResponse) GetPositionID(
 	return 0
 }
 
+// MsgCreatePositionAndSuperfluidDelegate creates a position in the given tick
+// range in a concentrated liquidity pool, then superfluid delegates. Bounded
+// range positions are valued from their underlying OSMO amount instead of the
+// pool's osmo equivalent multiplier.
+type MsgCreatePositionAndSuperfluidDelegate struct {
+	Sender    string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Coins     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	ValAddr   string                                   `protobuf:"bytes,3,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
+	PoolId    uint64                                   `protobuf:"varint,4,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	LowerTick int64                                    `protobuf:"varint,5,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64                                    `protobuf:"varint,6,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+}
+
+func (m *MsgCreatePositionAndSuperfluidDelegate) Reset() {
+	*m = MsgCreatePositionAndSuperfluidDelegate{}
+}
+func (m *MsgCreatePositionAndSuperfluidDelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePositionAndSuperfluidDelegate) ProtoMessage()    {}
+func (*MsgCreatePositionAndSuperfluidDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{12}
+}
+func (m *MsgCreatePositionAndSuperfluidDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePositionAndSuperfluidDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePositionAndSuperfluidDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePositionAndSuperfluidDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePositionAndSuperfluidDelegate.Merge(m, src)
+}
+func (m *MsgCreatePositionAndSuperfluidDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePositionAndSuperfluidDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePositionAndSuperfluidDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePositionAndSuperfluidDelegate proto.InternalMessageInfo
+
+func (m *MsgCreatePositionAndSuperfluidDelegate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCreatePositionAndSuperfluidDelegate) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *MsgCreatePositionAndSuperfluidDelegate) GetValAddr() string {
+	if m != nil {
+		return m.ValAddr
+	}
+	return ""
+}
+
+func (m *MsgCreatePositionAndSuperfluidDelegate) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgCreatePositionAndSuperfluidDelegate) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *MsgCreatePositionAndSuperfluidDelegate) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+type MsgCreatePositionAndSuperfluidDelegateResponse struct {
+	LockID     uint64 `protobuf:"varint,1,opt,name=lockID,proto3" json:"lockID,omitempty"`
+	PositionID uint64 `protobuf:"varint,2,opt,name=positionID,proto3" json:"positionID,omitempty"`
+}
+
+func (m *MsgCreatePositionAndSuperfluidDelegateResponse) Reset() {
+	*m = MsgCreatePositionAndSuperfluidDelegateResponse{}
+}
+func (m *MsgCreatePositionAndSuperfluidDelegateResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgCreatePositionAndSuperfluidDelegateResponse) ProtoMessage() {}
+func (*MsgCreatePositionAndSuperfluidDelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{13}
+}
+func (m *MsgCreatePositionAndSuperfluidDelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePositionAndSuperfluidDelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePositionAndSuperfluidDelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePositionAndSuperfluidDelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePositionAndSuperfluidDelegateResponse.Merge(m, src)
+}
+func (m *MsgCreatePositionAndSuperfluidDelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePositionAndSuperfluidDelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePositionAndSuperfluidDelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePositionAndSuperfluidDelegateResponse proto.InternalMessageInfo
+
+func (m *MsgCreatePositionAndSuperfluidDelegateResponse) GetLockID() uint64 {
+	if m != nil {
+		return m.LockID
+	}
+	return 0
+}
+
+func (m *MsgCreatePositionAndSuperfluidDelegateResponse) GetPositionID() uint64 {
+	if m != nil {
+		return m.PositionID
+	}
+	return 0
+}
+
 // MsgUnPoolWhitelistedPool Unpools every lock the sender has, that is
 // associated with pool pool_id. If pool_id is not approved for unpooling by
 // governance, this is a no-op. Unpooling takes the locked gamm shares, and runs
@@ -676,7 +822,7 @@ func (m *MsgUnPoolWhitelistedPool) Reset()         { *m = MsgUnPoolWhitelistedPo
 func (m *MsgUnPoolWhitelistedPool) String() string { return proto.CompactTextString(m) }
 func (*MsgUnPoolWhitelistedPool) ProtoMessage()    {}
 func (*MsgUnPoolWhitelistedPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{12}
+	return fileDescriptor_55b645f187d22814, []int{14}
 }
 func (m *MsgUnPoolWhitelistedPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -727,7 +873,7 @@ func (m *MsgUnPoolWhitelistedPoolResponse) Reset()         { *m = MsgUnPoolWhite
 func (m *MsgUnPoolWhitelistedPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnPoolWhitelistedPoolResponse) ProtoMessage()    {}
 func (*MsgUnPoolWhitelistedPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{13}
+	return fileDescriptor_55b645f187d22814, []int{15}
 }
 func (m *MsgUnPoolWhitelistedPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -781,7 +927,7 @@ func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) String() stri
 }
 func (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) ProtoMessage() {}
 func (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{14}
+	return fileDescriptor_55b645f187d22814, []int{16}
 }
 func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -853,7 +999,7 @@ func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Strin
 }
 func (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) ProtoMessage() {}
 func (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{15}
+	return fileDescriptor_55b645f187d22814, []int{17}
 }
 func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -905,7 +1051,7 @@ func (m *MsgAddToConcentratedLiquiditySuperfluidPosition) String() string {
 }
 func (*MsgAddToConcentratedLiquiditySuperfluidPosition) ProtoMessage() {}
 func (*MsgAddToConcentratedLiquiditySuperfluidPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{16}
+	return fileDescriptor_55b645f187d22814, []int{18}
 }
 func (m *MsgAddToConcentratedLiquiditySuperfluidPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -981,7 +1127,7 @@ func (m *MsgAddToConcentratedLiquiditySuperfluidPositionResponse) String() strin
 }
 func (*MsgAddToConcentratedLiquiditySuperfluidPositionResponse) ProtoMessage() {}
 func (*MsgAddToConcentratedLiquiditySuperfluidPositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{17}
+	return fileDescriptor_55b645f187d22814, []int{19}
 }
 func (m *MsgAddToConcentratedLiquiditySuperfluidPositionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1046,7 +1192,7 @@ func (m *MsgUnbondConvertAndStake) Reset()         { *m = MsgUnbondConvertAndSta
 func (m *MsgUnbondConvertAndStake) String() string { return proto.CompactTextString(m) }
 func (*MsgUnbondConvertAndStake) ProtoMessage()    {}
 func (*MsgUnbondConvertAndStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{18}
+	return fileDescriptor_55b645f187d22814, []int{20}
 }
 func (m *MsgUnbondConvertAndStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1111,7 +1257,7 @@ func (m *MsgUnbondConvertAndStakeResponse) Reset()         { *m = MsgUnbondConve
 func (m *MsgUnbondConvertAndStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnbondConvertAndStakeResponse) ProtoMessage()    {}
 func (*MsgUnbondConvertAndStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{19}
+	return fileDescriptor_55b645f187d22814, []int{21}
 }
 func (m *MsgUnbondConvertAndStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1153,6 +1299,8 @@ func init() {
 	proto.RegisterType((*MsgLockAndSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegateResponse")
 	proto.RegisterType((*MsgCreateFullRangePositionAndSuperfluidDelegate)(nil), "osmosis.superfluid.MsgCreateFullRangePositionAndSuperfluidDelegate")
 	proto.RegisterType((*MsgCreateFullRangePositionAndSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgCreateFullRangePositionAndSuperfluidDelegateResponse")
+	proto.RegisterType((*MsgCreatePositionAndSuperfluidDelegate)(nil), "osmosis.superfluid.MsgCreatePositionAndSuperfluidDelegate")
+	proto.RegisterType((*MsgCreatePositionAndSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgCreatePositionAndSuperfluidDelegateResponse")
 	proto.RegisterType((*MsgUnPoolWhitelistedPool)(nil), "osmosis.superfluid.MsgUnPoolWhitelistedPool")
 	proto.RegisterType((*MsgUnPoolWhitelistedPoolResponse)(nil), "osmosis.superfluid.MsgUnPoolWhitelistedPoolResponse")
 	proto.RegisterType((*MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition)(nil), "osmosis.superfluid.MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition")
@@ -1166,105 +1314,111 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/tx.proto", fileDescriptor_55b645f187d22814) }
 
 var fileDescriptor_55b645f187d22814 = []byte{
-	// 1557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x3d, 0x6c, 0xdb, 0xc6,
-	0x17, 0x37, 0x25, 0xc7, 0x4e, 0xce, 0xb1, 0x63, 0xf3, 0x1f, 0xc7, 0x8a, 0x92, 0x48, 0x0e, 0xff,
-	0x69, 0xeb, 0x7c, 0x88, 0xb4, 0x9c, 0x2f, 0x47, 0x1d, 0x1a, 0xcb, 0x42, 0x0b, 0x35, 0x36, 0x1a,
-	0x30, 0x0e, 0x0a, 0x74, 0x51, 0x29, 0xdd, 0x99, 0x66, 0x4d, 0xf2, 0x1c, 0xdd, 0xc9, 0xb1, 0xd1,
-	0xad, 0x43, 0x0b, 0x04, 0x6d, 0x11, 0x74, 0xe9, 0x54, 0x74, 0xe8, 0xd6, 0x29, 0x43, 0x81, 0x0e,
-	0x5d, 0x3a, 0x66, 0xcc, 0x58, 0xb4, 0x80, 0x53, 0x24, 0x43, 0xd0, 0xd5, 0x4b, 0xa7, 0x02, 0xc5,
-	0x91, 0x47, 0x8a, 0xa2, 0x49, 0x49, 0x54, 0xbc, 0x74, 0x49, 0xc4, 0xbb, 0x7b, 0x5f, 0xbf, 0xf7,
-	0x7e, 0xef, 0xdd, 0x19, 0x9c, 0xc1, 0xc4, 0xc2, 0xc4, 0x20, 0x0a, 0x69, 0x6d, 0xa1, 0xe6, 0xba,
-	0xd9, 0x32, 0xa0, 0x42, 0x77, 0xe4, 0xad, 0x26, 0xa6, 0x58, 0x14, 0xf9, 0xa6, 0xdc, 0xde, 0xcc,
-	0x9e, 0xd4, 0xb1, 0x8e, 0x9d, 0x6d, 0x85, 0xfd, 0x72, 0x4f, 0x66, 0xa7, 0x34, 0xcb, 0xb0, 0xb1,
-	0xe2, 0xfc, 0xcb, 0x97, 0x72, 0x3a, 0xc6, 0xba, 0x89, 0x14, 0xe7, 0xab, 0xde, 0x5a, 0x57, 0x60,
-	0xab, 0xa9, 0x51, 0x03, 0xdb, 0xde, 0x7e, 0xc3, 0xd1, 0xae, 0xd4, 0x35, 0x82, 0x94, 0xed, 0x62,
-	0x1d, 0x51, 0xad, 0xa8, 0x34, 0xb0, 0xe1, 0xed, 0xe7, 0xc3, 0xf2, 0xd4, 0xb0, 0x10, 0xa1, 0x9a,
-	0xb5, 0xc5, 0x0f, 0xfc, 0x3f, 0xc2, 0xf5, 0xf6, 0x4f, 0x7e, 0x68, 0x86, 0x5b, 0xb1, 0x88, 0xae,
-	0x6c, 0x17, 0xd9, 0x7f, 0xee, 0x86, 0xf4, 0x83, 0x00, 0xa6, 0x57, 0x89, 0x7e, 0xcf, 0x17, 0xa8,
-	0x20, 0x13, 0xe9, 0x1a, 0x45, 0xe2, 0x45, 0x30, 0x42, 0x90, 0x0d, 0x51, 0x33, 0x23, 0xcc, 0x0a,
-	0x73, 0xc7, 0xca, 0x53, 0xfb, 0x7b, 0xf9, 0xf1, 0x5d, 0xcd, 0x32, 0x4b, 0x92, 0xbb, 0x2e, 0xa9,
-	0xfc, 0x80, 0x38, 0x03, 0x46, 0x4d, 0xdc, 0xd8, 0xac, 0x19, 0x30, 0x93, 0x9a, 0x15, 0xe6, 0x86,
-	0xd5, 0x11, 0xf6, 0x59, 0x85, 0xe2, 0x69, 0x70, 0x74, 0x5b, 0x33, 0x6b, 0x1a, 0x84, 0xcd, 0x4c,
-	0x9a, 0x69, 0x51, 0x47, 0xb7, 0x35, 0x73, 0x09, 0xc2, 0x66, 0xe9, 0xf2, 0x67, 0xaf, 0x9e, 0x5c,
-	0xe2, 0x0a, 0x1e, 0xbd, 0x7a, 0x72, 0x29, 0x22, 0x03, 0x05, 0xc8, 0x7d, 0x91, 0xf2, 0xe0, 0x5c,
-	0xa4, 0x93, 0x2a, 0x22, 0x5b, 0xd8, 0x26, 0x48, 0xfa, 0x52, 0x00, 0x33, 0x1d, 0x27, 0xee, 0xdb,
-	0xf0, 0x10, 0x03, 0x29, 0x15, 0x42, 0xde, 0x9e, 0x8b, 0xf0, 0xb6, 0xe5, 0x9b, 0x94, 0xce, 0x83,
-	0x7c, 0x8c, 0x37, 0xbe, 0xc7, 0x5f, 0x1d, 0xf4, 0xb8, 0x8e, 0x6d, 0xb8, 0x82, 0x1b, 0x9b, 0x87,
-	0xe2, 0xb1, 0x1c, 0xf2, 0x38, 0x17, 0xe9, 0x31, 0x33, 0x59, 0x60, 0x12, 0x11, 0x2e, 0x7b, 0xee,
-	0xf8, 0x2e, 0xff, 0x25, 0x80, 0x0b, 0x31, 0x61, 0x2d, 0xd9, 0x87, 0xec, 0xbf, 0x58, 0x06, 0xc3,
-	0x8c, 0x05, 0x4e, 0xd9, 0x8c, 0x2d, 0x9c, 0x96, 0xdd, 0x02, 0x96, 0x19, 0x4d, 0x64, 0x4e, 0x13,
-	0x79, 0x19, 0x1b, 0x76, 0xf9, 0x7f, 0x4f, 0xf7, 0xf2, 0x43, 0xfb, 0x7b, 0xf9, 0x31, 0xd7, 0x00,
-	0x13, 0x92, 0x54, 0x47, 0xb6, 0x74, 0x2b, 0x84, 0xc1, 0xc5, 0xae, 0x59, 0xeb, 0x80, 0xe3, 0x3d,
-	0x70, 0xa5, 0x9f, 0x50, 0x3d, 0x6c, 0x82, 0x71, 0x08, 0xc1, 0x38, 0xa4, 0x7f, 0x04, 0x70, 0x76,
-	0x95, 0xe8, 0xec, 0xf0, 0x92, 0x0d, 0x5f, 0x8f, 0x67, 0x1a, 0x38, 0xc2, 0xe2, 0x22, 0x99, 0xd4,
-	0x6c, 0xba, 0x3b, 0x28, 0xf3, 0x0c, 0x94, 0x1f, 0x9f, 0xe7, 0xe7, 0x74, 0x83, 0x6e, 0xb4, 0xea,
-	0x72, 0x03, 0x5b, 0x0a, 0x6f, 0x01, 0xee, 0x7f, 0x05, 0x02, 0x37, 0x15, 0xba, 0xbb, 0x85, 0x88,
-	0x23, 0x40, 0x54, 0x57, 0x73, 0x37, 0xc6, 0x5e, 0x0b, 0xa1, 0x79, 0xc1, 0x43, 0x93, 0x45, 0x5a,
-	0xd0, 0x6c, 0x58, 0x88, 0xa2, 0xee, 0x0d, 0xa7, 0x66, 0x62, 0xc3, 0xf7, 0x01, 0x9c, 0x00, 0xa9,
-	0x6a, 0x85, 0x63, 0x97, 0xaa, 0x56, 0xa4, 0x5f, 0x52, 0x40, 0x59, 0x25, 0xfa, 0x72, 0x13, 0x69,
-	0x14, 0xbd, 0xdb, 0x32, 0x4d, 0x55, 0xb3, 0x75, 0x74, 0x17, 0x13, 0x83, 0x35, 0xcf, 0xff, 0x36,
-	0x94, 0xe2, 0x65, 0x30, 0xba, 0x85, 0xb1, 0xc9, 0xaa, 0x65, 0x98, 0x45, 0x5c, 0x16, 0xf7, 0xf7,
-	0xf2, 0x13, 0xae, 0xa7, 0x7c, 0x43, 0x52, 0x47, 0xd8, 0xaf, 0x2a, 0x2c, 0x2d, 0x84, 0x70, 0x97,
-	0x3c, 0xdc, 0xd7, 0x5b, 0xa6, 0x59, 0x68, 0x32, 0x58, 0x5c, 0xf4, 0xd7, 0xdb, 0xa8, 0x3f, 0x00,
-	0x37, 0x13, 0x82, 0xe7, 0x27, 0xe2, 0x14, 0x70, 0x4b, 0xb7, 0xd2, 0x51, 0xc8, 0x15, 0x31, 0x07,
-	0xc0, 0x16, 0x57, 0x50, 0xad, 0x70, 0xb2, 0x06, 0x56, 0xd8, 0x24, 0xc9, 0xac, 0x12, 0xfd, 0xbe,
-	0x7d, 0x17, 0x63, 0xf3, 0xc3, 0x0d, 0x83, 0x22, 0xd3, 0x20, 0x14, 0x41, 0xf6, 0x99, 0x24, 0x33,
-	0x01, 0x6c, 0x52, 0x3d, 0xb1, 0x51, 0x42, 0xd8, 0xe4, 0x3d, 0x6c, 0x5a, 0x36, 0x3b, 0x51, 0x78,
-	0xd8, 0xf6, 0xa3, 0xc0, 0x16, 0xa4, 0xf7, 0xc1, 0x6c, 0x9c, 0x93, 0x3e, 0x02, 0x6f, 0x82, 0x13,
-	0x68, 0xc7, 0xa0, 0x08, 0xd6, 0x38, 0xa5, 0x49, 0x46, 0x98, 0x4d, 0xcf, 0x0d, 0xab, 0xe3, 0xee,
-	0xf2, 0x8a, 0xc3, 0x6c, 0x22, 0xfd, 0x94, 0x06, 0x8b, 0x8e, 0x32, 0xd3, 0xad, 0xee, 0x55, 0x43,
-	0x6f, 0x6a, 0x14, 0xdd, 0xdb, 0xd0, 0x9a, 0x88, 0xac, 0x61, 0x1f, 0xf7, 0x65, 0x6c, 0x37, 0x90,
-	0x4d, 0xd9, 0x1e, 0xf4, 0x72, 0x90, 0x10, 0x91, 0x60, 0x8f, 0x4c, 0x07, 0x11, 0xe1, 0x1b, 0x92,
-	0xdf, 0x37, 0x75, 0x30, 0x45, 0x1c, 0x07, 0x6a, 0x14, 0xd7, 0x2c, 0xd7, 0xa3, 0xde, 0x4d, 0x74,
-	0x96, 0x37, 0xd1, 0x0c, 0xf7, 0x20, 0xac, 0x41, 0x52, 0x4f, 0x10, 0x1e, 0x16, 0x8f, 0x52, 0x7c,
-	0x24, 0x80, 0x09, 0x8a, 0x37, 0x91, 0x5d, 0xc3, 0x2d, 0x5a, 0xb3, 0x18, 0x97, 0x86, 0x7b, 0x71,
-	0xa9, 0xca, 0xcd, 0x4c, 0xbb, 0x66, 0x3a, 0xc5, 0xa5, 0x44, 0x24, 0x3b, 0xee, 0x08, 0x7f, 0xd0,
-	0xa2, 0xab, 0x86, 0x4d, 0x4a, 0x97, 0x42, 0x75, 0x90, 0x6d, 0xd7, 0x81, 0xdf, 0x9d, 0xbc, 0x50,
-	0xbe, 0x4b, 0x83, 0xdb, 0x83, 0xa6, 0xcd, 0xaf, 0x91, 0x2a, 0x18, 0xd5, 0x2c, 0xdc, 0xb2, 0xe9,
-	0x3c, 0xcf, 0x9f, 0xc2, 0x42, 0xfb, 0x7d, 0x2f, 0x3f, 0xed, 0xfa, 0x4b, 0xe0, 0xa6, 0x6c, 0x60,
-	0xc5, 0xd2, 0xe8, 0x86, 0x5c, 0xb5, 0x69, 0x3b, 0x61, 0x5c, 0x4a, 0x52, 0x3d, 0xf9, 0xb6, 0xaa,
-	0xa2, 0x93, 0xde, 0xa4, 0xaa, 0x8a, 0xbe, 0xaa, 0xa2, 0x68, 0x82, 0x29, 0xd3, 0x78, 0xd0, 0x32,
-	0xa0, 0x41, 0x77, 0x6b, 0x0d, 0x87, 0xfd, 0xd0, 0xed, 0x3d, 0xe5, 0x77, 0xb8, 0xd2, 0x33, 0x07,
-	0x95, 0xae, 0x20, 0x5d, 0x6b, 0xec, 0x56, 0x50, 0xa3, 0x5d, 0x00, 0x07, 0xb4, 0x48, 0xea, 0xa4,
-	0xbf, 0xe6, 0xb6, 0x15, 0x28, 0xde, 0x07, 0xc7, 0x3e, 0xc1, 0x86, 0x5d, 0x63, 0x37, 0x52, 0xa7,
-	0x8f, 0x8d, 0x2d, 0x64, 0x65, 0xf7, 0xba, 0x2a, 0x7b, 0xd7, 0x55, 0x79, 0xcd, 0xbb, 0xae, 0x96,
-	0xcf, 0xf2, 0xe4, 0x4f, 0xba, 0x26, 0x7c, 0x51, 0xe9, 0xf1, 0xf3, 0xbc, 0xa0, 0x1e, 0x65, 0xdf,
-	0xec, 0xb0, 0xf4, 0x75, 0xda, 0xe9, 0xfc, 0x4b, 0x10, 0xae, 0xe1, 0x60, 0x0e, 0x56, 0x3c, 0xfb,
-	0xed, 0xe6, 0xe5, 0xb3, 0xe9, 0x26, 0x18, 0xf3, 0x5a, 0x91, 0x3f, 0x82, 0xcb, 0xa7, 0xf6, 0xf7,
-	0xf2, 0xa2, 0xd7, 0x38, 0xfc, 0x4d, 0x29, 0xd0, 0xb5, 0x60, 0x80, 0x86, 0xa9, 0x5e, 0x34, 0xac,
-	0x79, 0xf5, 0x0e, 0x11, 0x31, 0x9a, 0x08, 0xce, 0xf7, 0xa6, 0xd5, 0xb9, 0xa8, 0x7a, 0xf7, 0xc4,
-	0x25, 0x75, 0xdc, 0x59, 0xa8, 0xf0, 0xef, 0x03, 0x06, 0x8a, 0x1c, 0xd4, 0x01, 0x0d, 0x14, 0x43,
-	0x06, 0x8a, 0xa5, 0xeb, 0x21, 0x96, 0xbc, 0xe1, 0xb1, 0x44, 0x83, 0xb0, 0x40, 0x71, 0xa1, 0x61,
-	0x06, 0x47, 0xb8, 0x87, 0x92, 0xf4, 0x6d, 0xda, 0x99, 0x26, 0x49, 0x12, 0xe2, 0xf3, 0x64, 0xe0,
-	0xc4, 0x04, 0x08, 0x96, 0x3a, 0x3c, 0x82, 0xa5, 0x5f, 0x93, 0x60, 0x1f, 0x83, 0x71, 0x1b, 0x3d,
-	0xac, 0xf9, 0x54, 0xc8, 0x1c, 0x71, 0x14, 0xbe, 0xdd, 0x1f, 0xb9, 0x4e, 0xba, 0x6a, 0x3b, 0x34,
-	0x48, 0xea, 0x71, 0x1b, 0x3d, 0xf4, 0xa1, 0x0c, 0x36, 0xfb, 0x03, 0x57, 0x83, 0x70, 0xb3, 0x97,
-	0x7e, 0x4e, 0xf3, 0x99, 0xcb, 0xee, 0xa3, 0xcb, 0xd8, 0xde, 0x46, 0x4d, 0xca, 0xa6, 0x3b, 0xd5,
-	0x36, 0x51, 0x50, 0x93, 0xd0, 0x4b, 0x53, 0x12, 0x1e, 0x74, 0xb9, 0xd7, 0x68, 0x60, 0xd2, 0x32,
-	0xec, 0x9a, 0x66, 0x51, 0x36, 0x3b, 0x08, 0x73, 0xc3, 0x89, 0xe2, 0x58, 0x79, 0xb1, 0x17, 0xe4,
-	0x33, 0xae, 0xb1, 0xb0, 0xb8, 0xa4, 0x8e, 0x5b, 0x86, 0xbd, 0x64, 0xd1, 0x35, 0xec, 0x46, 0xf5,
-	0x8d, 0x10, 0x1c, 0x70, 0x0d, 0x37, 0x66, 0x27, 0x0d, 0x5d, 0x89, 0x72, 0x27, 0x6e, 0xc0, 0x71,
-	0x0d, 0x6c, 0xf8, 0xbc, 0xd5, 0xe7, 0xf0, 0x69, 0xcf, 0x42, 0x0e, 0x79, 0x69, 0x3e, 0x44, 0xac,
-	0xd9, 0xf6, 0xf8, 0x71, 0x9e, 0x14, 0xdc, 0x88, 0x7b, 0x4d, 0x73, 0xc2, 0xfa, 0x5c, 0xe0, 0x17,
-	0x91, 0x88, 0xcc, 0xf9, 0xe4, 0xa9, 0x83, 0x49, 0x8a, 0x29, 0xc3, 0xda, 0xa2, 0x2e, 0x1c, 0x90,
-	0x4f, 0x9b, 0x7e, 0xe1, 0x0c, 0x8b, 0x4b, 0xea, 0x84, 0xb3, 0xb4, 0x64, 0x51, 0xc7, 0x14, 0x5c,
-	0xf8, 0x7b, 0x0c, 0xa4, 0x57, 0x89, 0x2e, 0x36, 0x81, 0x18, 0x75, 0xa3, 0x96, 0x0f, 0xfe, 0xed,
-	0x43, 0x8e, 0x7c, 0x8a, 0x67, 0x8b, 0x7d, 0x1f, 0xf5, 0xe3, 0xdb, 0x01, 0x27, 0x23, 0x5f, 0xec,
-	0x97, 0x7b, 0xaa, 0x6a, 0x1f, 0xce, 0x5e, 0x4d, 0x70, 0x38, 0xce, 0xb2, 0xff, 0x72, 0xed, 0xc7,
-	0xb2, 0x77, 0xb8, 0x2f, 0xcb, 0x07, 0x1e, 0x8a, 0xdf, 0x0b, 0xe0, 0x7c, 0xef, 0x17, 0xf4, 0x62,
-	0x82, 0xa0, 0x3a, 0x24, 0xb3, 0xb7, 0x07, 0x95, 0xf4, 0x3d, 0xfc, 0x42, 0x00, 0xa7, 0xe3, 0x9f,
-	0xab, 0xf3, 0x31, 0xfa, 0x63, 0x25, 0xb2, 0x8b, 0x49, 0x25, 0x7c, 0x4f, 0x7e, 0x15, 0xc0, 0x95,
-	0x44, 0x0f, 0xc0, 0xe5, 0x18, 0x53, 0x49, 0x94, 0x64, 0xef, 0x1c, 0x82, 0x12, 0x3f, 0x84, 0x4f,
-	0xc1, 0x74, 0xf4, 0x8b, 0xe8, 0x4a, 0x8c, 0x95, 0xc8, 0xd3, 0xd9, 0x6b, 0x49, 0x4e, 0xfb, 0xc6,
-	0xff, 0x10, 0xc0, 0xf5, 0xc1, 0x5e, 0x27, 0x2b, 0xb1, 0xf6, 0x06, 0xd0, 0x96, 0x5d, 0x3b, 0x4c,
-	0x6d, 0x1d, 0xd5, 0x91, 0xe8, 0x92, 0x18, 0x57, 0x1d, 0x49, 0x94, 0xc4, 0x56, 0xc7, 0x40, 0xb7,
-	0x23, 0xa7, 0x3a, 0xa2, 0x66, 0x77, 0x7c, 0x75, 0x44, 0x9c, 0xee, 0x52, 0x1d, 0x5d, 0xa6, 0x4b,
-	0xf9, 0xee, 0xd3, 0x17, 0x39, 0xe1, 0xd9, 0x8b, 0x9c, 0xf0, 0xe7, 0x8b, 0x9c, 0xf0, 0xf8, 0x65,
-	0x6e, 0xe8, 0xd9, 0xcb, 0xdc, 0xd0, 0x6f, 0x2f, 0x73, 0x43, 0x1f, 0xdd, 0x08, 0x0c, 0x42, 0xae,
-	0xb9, 0x60, 0x6a, 0x75, 0xe2, 0x7d, 0x28, 0xdb, 0x0b, 0xb7, 0x94, 0x9d, 0x8e, 0xbf, 0x95, 0xb3,
-	0xe1, 0x58, 0x1f, 0x71, 0x6e, 0xfd, 0x57, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x18, 0x2b, 0x26,
-	0x03, 0x4e, 0x17, 0x00, 0x00,
+	// 1653 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x3d, 0x6c, 0xdb, 0x56,
+	0x1e, 0x37, 0x25, 0xc7, 0x8e, 0x9f, 0x63, 0xc7, 0xe6, 0xc5, 0xb1, 0xa2, 0x24, 0x92, 0xc2, 0xe4,
+	0x72, 0xce, 0x87, 0x48, 0xcb, 0x71, 0x12, 0x47, 0x37, 0x5c, 0x2c, 0x0b, 0x77, 0xd0, 0xc5, 0xc6,
+	0x05, 0x8c, 0x83, 0x03, 0x6e, 0xd1, 0x51, 0x7a, 0xcf, 0x34, 0x4f, 0x24, 0x9f, 0xa2, 0xf7, 0xe4,
+	0x0f, 0xdc, 0xd6, 0xa1, 0x05, 0x82, 0xb6, 0x08, 0xba, 0x74, 0x69, 0x91, 0x21, 0xe8, 0xd2, 0x29,
+	0x43, 0x81, 0x0e, 0x5d, 0x3a, 0x66, 0xcc, 0x58, 0xb4, 0x80, 0x53, 0x24, 0x43, 0xd0, 0xd5, 0x7b,
+	0x81, 0xe2, 0x91, 0x8f, 0x14, 0x45, 0x53, 0x96, 0x69, 0x7b, 0x29, 0xba, 0xc4, 0x7a, 0xef, 0xfd,
+	0x3f, 0x7f, 0xff, 0xaf, 0xf7, 0x18, 0x70, 0x1e, 0x13, 0x0b, 0x13, 0x83, 0x28, 0xa4, 0xdd, 0x44,
+	0xad, 0x35, 0xb3, 0x6d, 0x40, 0x85, 0x6e, 0xc9, 0xcd, 0x16, 0xa6, 0x58, 0x14, 0xf9, 0xa1, 0xdc,
+	0x39, 0x4c, 0x9f, 0xd1, 0xb1, 0x8e, 0x9d, 0x63, 0x85, 0xfd, 0x72, 0x29, 0xd3, 0x93, 0x9a, 0x65,
+	0xd8, 0x58, 0x71, 0xfe, 0xe5, 0x5b, 0x19, 0x1d, 0x63, 0xdd, 0x44, 0x8a, 0xb3, 0xaa, 0xb5, 0xd7,
+	0x14, 0xd8, 0x6e, 0x69, 0xd4, 0xc0, 0xb6, 0x77, 0x5e, 0x77, 0xa4, 0x2b, 0x35, 0x8d, 0x20, 0x65,
+	0xa3, 0x50, 0x43, 0x54, 0x2b, 0x28, 0x75, 0x6c, 0x78, 0xe7, 0xd9, 0x30, 0x3f, 0x35, 0x2c, 0x44,
+	0xa8, 0x66, 0x35, 0x39, 0xc1, 0xe5, 0x08, 0xd3, 0x3b, 0x3f, 0x39, 0xd1, 0x34, 0xd7, 0x62, 0x11,
+	0x5d, 0xd9, 0x28, 0xb0, 0x3f, 0xee, 0x81, 0xf4, 0x42, 0x00, 0x53, 0x2b, 0x44, 0x7f, 0xe4, 0x33,
+	0x94, 0x91, 0x89, 0x74, 0x8d, 0x22, 0xf1, 0x1a, 0x18, 0x22, 0xc8, 0x86, 0xa8, 0x95, 0x12, 0x72,
+	0xc2, 0xcc, 0x48, 0x69, 0x72, 0x77, 0x27, 0x3b, 0xb6, 0xad, 0x59, 0x66, 0x51, 0x72, 0xf7, 0x25,
+	0x95, 0x13, 0x88, 0xd3, 0x60, 0xd8, 0xc4, 0xf5, 0x46, 0xd5, 0x80, 0xa9, 0x44, 0x4e, 0x98, 0x19,
+	0x54, 0x87, 0xd8, 0xb2, 0x02, 0xc5, 0x73, 0xe0, 0xe4, 0x86, 0x66, 0x56, 0x35, 0x08, 0x5b, 0xa9,
+	0x24, 0x93, 0xa2, 0x0e, 0x6f, 0x68, 0xe6, 0x22, 0x84, 0xad, 0xe2, 0x8d, 0x0f, 0xde, 0xbf, 0xbc,
+	0xce, 0x05, 0x3c, 0x7d, 0xff, 0xf2, 0x7a, 0x44, 0x04, 0xf2, 0x90, 0xdb, 0x22, 0x65, 0xc1, 0xc5,
+	0x48, 0x23, 0x55, 0x44, 0x9a, 0xd8, 0x26, 0x48, 0xfa, 0x58, 0x00, 0xd3, 0x5d, 0x14, 0x8f, 0x6d,
+	0x78, 0x8c, 0x8e, 0x14, 0xf3, 0x21, 0x6b, 0x2f, 0x46, 0x58, 0xdb, 0xf6, 0x55, 0x4a, 0x97, 0x40,
+	0xb6, 0x87, 0x35, 0xbe, 0xc5, 0x9f, 0xec, 0xb5, 0xb8, 0x86, 0x6d, 0xb8, 0x8c, 0xeb, 0x8d, 0x63,
+	0xb1, 0x58, 0x0e, 0x59, 0x9c, 0x89, 0xb4, 0x98, 0xa9, 0xcc, 0x33, 0x8e, 0x08, 0x93, 0x3d, 0x73,
+	0x7c, 0x93, 0x7f, 0x11, 0xc0, 0x95, 0x1e, 0x6e, 0x2d, 0xda, 0xc7, 0x6c, 0xbf, 0x58, 0x02, 0x83,
+	0xac, 0x0a, 0x9c, 0xb4, 0x19, 0x9d, 0x3b, 0x27, 0xbb, 0x09, 0x2c, 0xb3, 0x32, 0x91, 0x79, 0x99,
+	0xc8, 0x4b, 0xd8, 0xb0, 0x4b, 0x7f, 0x7a, 0xb5, 0x93, 0x1d, 0xd8, 0xdd, 0xc9, 0x8e, 0xba, 0x0a,
+	0x18, 0x93, 0xa4, 0x3a, 0xbc, 0xc5, 0x7b, 0x21, 0x0c, 0xae, 0xed, 0x1b, 0xb5, 0x2e, 0x38, 0xfe,
+	0x01, 0x6e, 0x1e, 0xc4, 0x55, 0x0f, 0x9b, 0xa0, 0x1f, 0x42, 0xd0, 0x0f, 0xe9, 0x57, 0x01, 0x5c,
+	0x58, 0x21, 0x3a, 0x23, 0x5e, 0xb4, 0xe1, 0xd1, 0xea, 0x4c, 0x03, 0x27, 0x98, 0x5f, 0x24, 0x95,
+	0xc8, 0x25, 0xf7, 0x07, 0x65, 0x96, 0x81, 0xf2, 0xf5, 0x9b, 0xec, 0x8c, 0x6e, 0xd0, 0xf5, 0x76,
+	0x4d, 0xae, 0x63, 0x4b, 0xe1, 0x2d, 0xc0, 0xfd, 0x93, 0x27, 0xb0, 0xa1, 0xd0, 0xed, 0x26, 0x22,
+	0x0e, 0x03, 0x51, 0x5d, 0xc9, 0xfb, 0x55, 0xec, 0x7c, 0x08, 0xcd, 0x2b, 0x1e, 0x9a, 0xcc, 0xd3,
+	0xbc, 0x66, 0xc3, 0x7c, 0x54, 0xe9, 0xde, 0x01, 0x57, 0xf6, 0x73, 0xdf, 0x07, 0x70, 0x1c, 0x24,
+	0x2a, 0x65, 0x8e, 0x5d, 0xa2, 0x52, 0x96, 0xbe, 0x4b, 0x00, 0x65, 0x85, 0xe8, 0x4b, 0x2d, 0xa4,
+	0x51, 0xf4, 0xf7, 0xb6, 0x69, 0xaa, 0x9a, 0xad, 0xa3, 0x87, 0x98, 0x18, 0xac, 0x79, 0xfe, 0xbe,
+	0xa1, 0x14, 0x6f, 0x80, 0xe1, 0x26, 0xc6, 0x26, 0xcb, 0x96, 0x41, 0xe6, 0x71, 0x49, 0xdc, 0xdd,
+	0xc9, 0x8e, 0xbb, 0x96, 0xf2, 0x03, 0x49, 0x1d, 0x62, 0xbf, 0x2a, 0xb0, 0x38, 0x17, 0xc2, 0x5d,
+	0xf2, 0x70, 0x5f, 0x6b, 0x9b, 0x66, 0xbe, 0xc5, 0x60, 0x71, 0xd1, 0x5f, 0xeb, 0xa0, 0xfe, 0x04,
+	0xdc, 0x8d, 0x09, 0x9e, 0x1f, 0x88, 0xb3, 0xc0, 0x4d, 0xdd, 0x72, 0x57, 0x22, 0x97, 0xc5, 0x0c,
+	0x00, 0x4d, 0x2e, 0xa0, 0x52, 0xe6, 0xc5, 0x1a, 0xd8, 0x91, 0xbe, 0x48, 0x82, 0xab, 0xbe, 0xce,
+	0x3f, 0x60, 0x9c, 0xc4, 0x79, 0x00, 0x4c, 0xbc, 0x89, 0x5a, 0x55, 0x6a, 0xd4, 0x1b, 0xa9, 0x13,
+	0x39, 0x61, 0x26, 0x59, 0x9a, 0xda, 0xdd, 0xc9, 0x4e, 0xba, 0xf4, 0x9d, 0x33, 0x49, 0x1d, 0x71,
+	0x16, 0xab, 0x46, 0xbd, 0xc1, 0xb8, 0xda, 0xcd, 0xa6, 0xc7, 0x35, 0x14, 0xe6, 0xea, 0x9c, 0x49,
+	0xea, 0x88, 0xb3, 0x60, 0x5c, 0xc5, 0xd9, 0x50, 0x4e, 0xe4, 0xbc, 0x9c, 0xf0, 0x02, 0xb2, 0x27,
+	0x23, 0xd6, 0x81, 0x7c, 0xb0, 0xe8, 0x1c, 0x39, 0x11, 0x5e, 0x08, 0x20, 0xb5, 0x42, 0xf4, 0xc7,
+	0xf6, 0x43, 0x8c, 0xcd, 0x7f, 0xaf, 0x1b, 0x14, 0x99, 0x06, 0xa1, 0x08, 0xb2, 0x65, 0x9c, 0xd0,
+	0x07, 0xc0, 0x4f, 0xf4, 0x2d, 0x12, 0x25, 0x04, 0x48, 0xd6, 0x03, 0xa4, 0x6d, 0x33, 0x8a, 0xfc,
+	0x66, 0xc7, 0x8e, 0x3c, 0xdb, 0x90, 0xfe, 0x09, 0x72, 0xbd, 0x8c, 0xf4, 0x11, 0xb8, 0x0a, 0x4e,
+	0xa3, 0x2d, 0x83, 0x22, 0x58, 0xe5, 0xbd, 0x9d, 0xa4, 0x84, 0x5c, 0x72, 0x66, 0x50, 0x1d, 0x73,
+	0xb7, 0x97, 0x9d, 0x16, 0x4f, 0xa4, 0x6f, 0x92, 0x60, 0xc1, 0x11, 0x66, 0xba, 0x6d, 0x6e, 0xc5,
+	0xd0, 0x5b, 0x1a, 0x45, 0x8f, 0xd6, 0xb5, 0x16, 0x22, 0xab, 0xd8, 0x2f, 0xc0, 0x25, 0x6c, 0xd7,
+	0x91, 0x4d, 0xd9, 0x19, 0xf4, 0x62, 0x10, 0x13, 0x91, 0xe0, 0xb0, 0x4c, 0x06, 0x11, 0xe1, 0x07,
+	0x92, 0x3f, 0x40, 0x75, 0x30, 0x49, 0x1c, 0x03, 0xaa, 0x14, 0x57, 0x2d, 0xd7, 0xa2, 0xfe, 0xd3,
+	0x34, 0xc7, 0xa7, 0x69, 0x8a, 0x5b, 0x10, 0x96, 0x20, 0xa9, 0xa7, 0x09, 0x77, 0x8b, 0x7b, 0x29,
+	0x3e, 0x15, 0xc0, 0x38, 0xc5, 0x0d, 0x64, 0x57, 0x71, 0x9b, 0x56, 0x2d, 0x56, 0xac, 0x83, 0xfd,
+	0x8a, 0xb5, 0xc2, 0xd5, 0x4c, 0xb9, 0x6a, 0xba, 0xd9, 0xa5, 0x58, 0x55, 0x7c, 0xca, 0x61, 0xfe,
+	0x57, 0x9b, 0xae, 0x18, 0x36, 0x29, 0x5e, 0x0f, 0xe5, 0x41, 0xba, 0x93, 0x07, 0xfe, 0x98, 0xf2,
+	0x5c, 0xf9, 0x32, 0x09, 0xee, 0x1f, 0x36, 0x6c, 0x7e, 0x8e, 0x54, 0xc0, 0xb0, 0x66, 0xe1, 0xb6,
+	0x4d, 0x67, 0x79, 0xfc, 0x14, 0xe6, 0xda, 0x8f, 0x3b, 0xd9, 0x29, 0xd7, 0x5e, 0x02, 0x1b, 0xb2,
+	0x81, 0x15, 0x4b, 0xa3, 0xeb, 0x72, 0xc5, 0xa6, 0x9d, 0x80, 0x71, 0x2e, 0x49, 0xf5, 0xf8, 0x3b,
+	0xa2, 0x0a, 0xa9, 0xc4, 0x21, 0x44, 0x15, 0x7c, 0x51, 0x05, 0xd1, 0x04, 0x93, 0xa6, 0xf1, 0xa4,
+	0x6d, 0x40, 0x83, 0x6e, 0x57, 0xeb, 0x4e, 0xd1, 0x43, 0xb7, 0xb9, 0x95, 0xfe, 0xc6, 0x85, 0x9e,
+	0xdf, 0x2b, 0x74, 0x19, 0xe9, 0x5a, 0x7d, 0xbb, 0x8c, 0xea, 0x9d, 0x04, 0xd8, 0x23, 0x45, 0x52,
+	0x27, 0xfc, 0x3d, 0xb7, 0x9b, 0x40, 0xf1, 0x31, 0x18, 0xf9, 0x1f, 0x36, 0xec, 0x2a, 0x7b, 0x9a,
+	0x38, 0x8d, 0x72, 0x74, 0x2e, 0x2d, 0xbb, 0xef, 0x16, 0xd9, 0x7b, 0xb7, 0xc8, 0xab, 0xde, 0xbb,
+	0xa5, 0x74, 0x81, 0x07, 0x7f, 0xc2, 0x55, 0xe1, 0xb3, 0x4a, 0xcf, 0xde, 0x64, 0x05, 0xf5, 0x24,
+	0x5b, 0x33, 0x62, 0xe9, 0xd3, 0xa4, 0x73, 0x05, 0x58, 0x84, 0x70, 0x15, 0x07, 0x63, 0xb0, 0xec,
+	0xe9, 0xef, 0x34, 0x2f, 0xbf, 0x9a, 0xee, 0x82, 0x51, 0xaf, 0x15, 0xf9, 0x77, 0xb1, 0xd2, 0xd9,
+	0xdd, 0x9d, 0xac, 0xe8, 0x35, 0x0e, 0xff, 0x50, 0x0a, 0x74, 0x2d, 0x18, 0x28, 0xc3, 0x44, 0xbf,
+	0x32, 0xac, 0x7a, 0xf9, 0x0e, 0x11, 0x31, 0x5a, 0x08, 0xce, 0xf6, 0x2f, 0xab, 0x8b, 0x51, 0xf9,
+	0xee, 0xb1, 0x4b, 0xea, 0x98, 0xb3, 0x51, 0xe6, 0xeb, 0x3d, 0x0a, 0x0a, 0xa9, 0xc1, 0xa3, 0x28,
+	0x28, 0x84, 0x14, 0x14, 0x8a, 0xb7, 0x43, 0x55, 0xf2, 0x67, 0xaf, 0x4a, 0x34, 0x08, 0xf3, 0x14,
+	0xe7, 0xeb, 0x66, 0xf0, 0x2e, 0xe7, 0xa1, 0x24, 0x7d, 0x9e, 0x04, 0x77, 0x63, 0x06, 0xc4, 0xaf,
+	0x93, 0x43, 0x07, 0x26, 0x50, 0x60, 0x89, 0xe3, 0x2b, 0xb0, 0xe4, 0x11, 0x0b, 0xec, 0xbf, 0x60,
+	0xcc, 0x46, 0x9b, 0x55, 0xbf, 0x14, 0x9c, 0x79, 0x3f, 0x52, 0xfa, 0xeb, 0xc1, 0x8a, 0xeb, 0x8c,
+	0x2b, 0xb6, 0x4b, 0x82, 0xa4, 0x9e, 0xb2, 0xd1, 0xa6, 0x0f, 0x65, 0xb0, 0xd9, 0xef, 0xb9, 0x7b,
+	0x84, 0x9b, 0xbd, 0xf4, 0x6d, 0x92, 0xcf, 0x5c, 0xf6, 0x30, 0x59, 0xc2, 0xf6, 0x06, 0x6a, 0x51,
+	0x36, 0xdd, 0xa9, 0xd6, 0x40, 0x41, 0x49, 0x42, 0x3f, 0x49, 0x71, 0xea, 0x60, 0x9f, 0x8b, 0x93,
+	0x06, 0x26, 0x2c, 0xc3, 0xae, 0x6a, 0x16, 0x65, 0xb3, 0x83, 0x30, 0x33, 0x1c, 0x2f, 0x46, 0x4a,
+	0x0b, 0xfd, 0x20, 0x9f, 0x76, 0x95, 0x85, 0xd9, 0x25, 0x75, 0xcc, 0x32, 0xec, 0x45, 0x8b, 0xae,
+	0x62, 0xd7, 0xab, 0xcf, 0x84, 0xe0, 0x80, 0xab, 0xbb, 0x3e, 0xa7, 0x4e, 0xf4, 0x2b, 0x94, 0x07,
+	0xbd, 0x06, 0x1c, 0x97, 0xc0, 0x86, 0xcf, 0x5f, 0x0e, 0x38, 0x7c, 0x3a, 0xb3, 0x90, 0x43, 0xde,
+	0xfb, 0x5e, 0xc6, 0xdf, 0x96, 0x5c, 0x89, 0x7b, 0x3b, 0x73, 0xdc, 0xfa, 0x50, 0xe0, 0x17, 0x91,
+	0x88, 0xc8, 0xf9, 0xc5, 0x53, 0x03, 0x13, 0x14, 0x53, 0x86, 0xb5, 0x45, 0x5d, 0x38, 0x60, 0x4a,
+	0x88, 0x05, 0x67, 0x98, 0x5d, 0x52, 0xc7, 0x9d, 0xad, 0x45, 0x8b, 0x3a, 0xaa, 0xe0, 0xdc, 0xf3,
+	0x31, 0x90, 0x5c, 0x21, 0xba, 0xd8, 0x02, 0x62, 0xd4, 0x95, 0x5d, 0xde, 0xfb, 0x11, 0x4c, 0x8e,
+	0xfc, 0x26, 0x93, 0x2e, 0x1c, 0x98, 0xd4, 0xf7, 0x6f, 0x0b, 0x9c, 0x89, 0xfc, 0x74, 0x73, 0xa3,
+	0xaf, 0xa8, 0x0e, 0x71, 0xfa, 0x56, 0x0c, 0xe2, 0x5e, 0x9a, 0xfd, 0x4f, 0x18, 0x07, 0xd1, 0xec,
+	0x11, 0xa7, 0x6f, 0xc5, 0x20, 0xf6, 0x35, 0x3f, 0x17, 0xc0, 0xa5, 0xfe, 0x9f, 0x52, 0x16, 0x62,
+	0x38, 0xd5, 0xc5, 0x99, 0xbe, 0x7f, 0x58, 0x4e, 0xdf, 0xc2, 0x8f, 0x04, 0x70, 0xae, 0xf7, 0x77,
+	0x8b, 0xd9, 0x1e, 0xf2, 0x7b, 0x72, 0xa4, 0x17, 0xe2, 0x72, 0xf8, 0x96, 0x7c, 0x2f, 0x80, 0x9b,
+	0xb1, 0xbe, 0x04, 0x2c, 0xf5, 0x50, 0x15, 0x47, 0x48, 0xfa, 0xc1, 0x31, 0x08, 0xf1, 0x5d, 0xf8,
+	0x4a, 0x00, 0x97, 0x0f, 0xf2, 0x36, 0x2e, 0xee, 0xab, 0x74, 0x7f, 0x83, 0x4b, 0x87, 0xe7, 0xf5,
+	0xed, 0xfc, 0x3f, 0x98, 0x8a, 0x7e, 0xb9, 0xdd, 0xec, 0x21, 0x3c, 0x92, 0x3a, 0x3d, 0x1f, 0x87,
+	0xda, 0x57, 0xfe, 0x93, 0x00, 0x6e, 0x1f, 0xee, 0x15, 0xb5, 0xdc, 0x53, 0xdf, 0x21, 0xa4, 0xa5,
+	0x57, 0x8f, 0x53, 0x5a, 0x57, 0x16, 0xc7, 0xba, 0xcc, 0xf6, 0xca, 0xe2, 0x38, 0x42, 0xd2, 0x0f,
+	0x8e, 0x41, 0x48, 0x77, 0x76, 0x44, 0xdd, 0x31, 0x7a, 0x67, 0x47, 0x04, 0x75, 0x7a, 0x3e, 0x0e,
+	0xb5, 0xa7, 0xbc, 0xf4, 0xf0, 0xd5, 0xdb, 0x8c, 0xf0, 0xfa, 0x6d, 0x46, 0xf8, 0xf9, 0x6d, 0x46,
+	0x78, 0xf6, 0x2e, 0x33, 0xf0, 0xfa, 0x5d, 0x66, 0xe0, 0x87, 0x77, 0x99, 0x81, 0xff, 0xdc, 0x09,
+	0x0c, 0x6c, 0x2e, 0x39, 0x6f, 0x6a, 0x35, 0xe2, 0x2d, 0x94, 0x8d, 0xb9, 0x7b, 0xca, 0x56, 0xd7,
+	0x7f, 0xee, 0xb0, 0x21, 0x5e, 0x1b, 0x72, 0x5e, 0x27, 0xb7, 0x7e, 0x1b, 0x00, 0x59, 0x94, 0xa5,
+	0x06, 0xff, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1291,6 +1445,9 @@ type MsgClient interface {
 	// Execute lockup lock and superfluid delegation in a single msg
 	LockAndSuperfluidDelegate(ctx context.Context, in *MsgLockAndSuperfluidDelegate, opts ...grpc.CallOption) (*MsgLockAndSuperfluidDelegateResponse, error)
 	CreateFullRangePositionAndSuperfluidDelegate(ctx context.Context, in *MsgCreateFullRangePositionAndSuperfluidDelegate, opts ...grpc.CallOption) (*MsgCreateFullRangePositionAndSuperfluidDelegateResponse, error)
+	// CreatePositionAndSuperfluidDelegate creates a concentrated liquidity
+	// position in the given tick range, locks it and superfluid delegates it.
+	CreatePositionAndSuperfluidDelegate(ctx context.Context, in *MsgCreatePositionAndSuperfluidDelegate, opts ...grpc.CallOption) (*MsgCreatePositionAndSuperfluidDelegateResponse, error)
 	UnPoolWhitelistedPool(ctx context.Context, in *MsgUnPoolWhitelistedPool, opts ...grpc.CallOption) (*MsgUnPoolWhitelistedPoolResponse, error)
 	UnlockAndMigrateSharesToFullRangeConcentratedPosition(ctx context.Context, in *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition, opts ...grpc.CallOption) (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse, error)
 	AddToConcentratedLiquiditySuperfluidPosition(ctx context.Context, in *MsgAddToConcentratedLiquiditySuperfluidPosition, opts ...grpc.CallOption) (*MsgAddToConcentratedLiquiditySuperfluidPositionResponse, error)
@@ -1361,6 +1518,15 @@ func (c *msgClient) CreateFullRangePositionAndSuperfluidDelegate(ctx context.Con
 	return out, nil
 }
 
+func (c *msgClient) CreatePositionAndSuperfluidDelegate(ctx context.Context, in *MsgCreatePositionAndSuperfluidDelegate, opts ...grpc.CallOption) (*MsgCreatePositionAndSuperfluidDelegateResponse, error) {
+	out := new(MsgCreatePositionAndSuperfluidDelegateResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/CreatePositionAndSuperfluidDelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UnPoolWhitelistedPool(ctx context.Context, in *MsgUnPoolWhitelistedPool, opts ...grpc.CallOption) (*MsgUnPoolWhitelistedPoolResponse, error) {
 	out := new(MsgUnPoolWhitelistedPoolResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/UnPoolWhitelistedPool", in, out, opts...)
@@ -1411,6 +1577,9 @@ type MsgServer interface {
 	// Execute lockup lock and superfluid delegation in a single msg
 	LockAndSuperfluidDelegate(context.Context, *MsgLockAndSuperfluidDelegate) (*MsgLockAndSuperfluidDelegateResponse, error)
 	CreateFullRangePositionAndSuperfluidDelegate(context.Context, *MsgCreateFullRangePositionAndSuperfluidDelegate) (*MsgCreateFullRangePositionAndSuperfluidDelegateResponse, error)
+	// CreatePositionAndSuperfluidDelegate creates a concentrated liquidity
+	// position in the given tick range, locks it and superfluid delegates it.
+	CreatePositionAndSuperfluidDelegate(context.Context, *MsgCreatePositionAndSuperfluidDelegate) (*MsgCreatePositionAndSuperfluidDelegateResponse, error)
 	UnPoolWhitelistedPool(context.Context, *MsgUnPoolWhitelistedPool) (*MsgUnPoolWhitelistedPoolResponse, error)
 	UnlockAndMigrateSharesToFullRangeConcentratedPosition(context.Context, *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse, error)
 	AddToConcentratedLiquiditySuperfluidPosition(context.Context, *MsgAddToConcentratedLiquiditySuperfluidPosition) (*MsgAddToConcentratedLiquiditySuperfluidPositionResponse, error)
@@ -1441,6 +1610,9 @@ func (*UnimplementedMsgServer) LockAndSuperfluidDelegate(ctx context.Context, re
 func (*UnimplementedMsgServer) CreateFullRangePositionAndSuperfluidDelegate(ctx context.Context, req *MsgCreateFullRangePositionAndSuperfluidDelegate) (*MsgCreateFullRangePositionAndSuperfluidDelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateFullRangePositionAndSuperfluidDelegate not implemented")
 }
+func (*UnimplementedMsgServer) CreatePositionAndSuperfluidDelegate(ctx context.Context, req *MsgCreatePositionAndSuperfluidDelegate) (*MsgCreatePositionAndSuperfluidDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePositionAndSuperfluidDelegate not implemented")
+}
 func (*UnimplementedMsgServer) UnPoolWhitelistedPool(ctx context.Context, req *MsgUnPoolWhitelistedPool) (*MsgUnPoolWhitelistedPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnPoolWhitelistedPool not implemented")
 }
@@ -1566,6 +1738,24 @@ func _Msg_CreateFullRangePositionAndSuperfluidDelegate_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreatePositionAndSuperfluidDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePositionAndSuperfluidDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePositionAndSuperfluidDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/CreatePositionAndSuperfluidDelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePositionAndSuperfluidDelegate(ctx, req.(*MsgCreatePositionAndSuperfluidDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UnPoolWhitelistedPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUnPoolWhitelistedPool)
 	if err := dec(in); err != nil {
@@ -1666,6 +1856,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateFullRangePositionAndSuperfluidDelegate",
 			Handler:    _Msg_CreateFullRangePositionAndSuperfluidDelegate_Handler,
 		},
+		{
+			MethodName: "CreatePositionAndSuperfluidDelegate",
+			Handler:    _Msg_CreatePositionAndSuperfluidDelegate_Handler,
+		},
 		{
 			MethodName: "UnPoolWhitelistedPool",
 			Handler:    _Msg_UnPoolWhitelistedPool_Handler,
@@ -2109,6 +2303,105 @@ func (m *MsgCreateFullRangePositionAndSuperfluidDelegateResponse) MarshalToSized
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCreatePositionAndSuperfluidDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePositionAndSuperfluidDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePositionAndSuperfluidDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpperTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePositionAndSuperfluidDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePositionAndSuperfluidDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePositionAndSuperfluidDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LockID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUnPoolWhitelistedPool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2714,7 +3007,7 @@ func (m *MsgCreateFullRangePositionAndSuperfluidDelegateResponse) Size() (n int)
 	return n
 }
 
-func (m *MsgUnPoolWhitelistedPool) Size() (n int) {
+func (m *MsgCreatePositionAndSuperfluidDelegate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2724,29 +3017,44 @@ func (m *MsgUnPoolWhitelistedPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	if m.PoolId != 0 {
 		n += 1 + sovTx(uint64(m.PoolId))
 	}
+	if m.LowerTick != 0 {
+		n += 1 + sovTx(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovTx(uint64(m.UpperTick))
+	}
 	return n
 }
 
-func (m *MsgUnPoolWhitelistedPoolResponse) Size() (n int) {
+func (m *MsgCreatePositionAndSuperfluidDelegateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ExitedLockIds) > 0 {
-		l = 0
-		for _, e := range m.ExitedLockIds {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	if m.LockID != 0 {
+		n += 1 + sovTx(uint64(m.LockID))
+	}
+	if m.PositionID != 0 {
+		n += 1 + sovTx(uint64(m.PositionID))
 	}
 	return n
 }
 
-func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) Size() (n int) {
+func (m *MsgUnPoolWhitelistedPool) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2756,21 +3064,53 @@ func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) Size() (n int
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.LockId != 0 {
-		n += 1 + sovTx(uint64(m.LockId))
-	}
-	l = m.SharesToMigrate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.TokenOutMins) > 0 {
-		for _, e := range m.TokenOutMins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
 	}
 	return n
 }
 
-func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Size() (n int) {
+func (m *MsgUnPoolWhitelistedPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExitedLockIds) > 0 {
+		l = 0
+		for _, e := range m.ExitedLockIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = m.SharesToMigrate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenOutMins) > 0 {
+		for _, e := range m.TokenOutMins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4029,6 +4369,299 @@ func (m *MsgCreateFullRangePositionAndSuperfluidDelegateResponse) Unmarshal(dAtA
 	}
 	return nil
 }
+func (m *MsgCreatePositionAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePositionAndSuperfluidDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePositionAndSuperfluidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePositionAndSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePositionAndSuperfluidDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePositionAndSuperfluidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockID", wireType)
+			}
+			m.LockID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionID", wireType)
+			}
+			m.PositionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUnPoolWhitelistedPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -22,6 +22,8 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdDenomAuthorityMetadata)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdDenomsFromCreator)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdAllBeforeSendHooks)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdDenomTransferPolicy)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdRemainingMintableSupply)
 
 	cmd.AddCommand(
 		osmocli.GetParams[*types.QueryParamsRequest](
@@ -55,6 +57,24 @@ func GetCmdAllBeforeSendHooks() (*osmocli.QueryDescriptor, *types.QueryAllBefore
 	}, &types.QueryAllBeforeSendHooksAddressesRequest{}
 }
 
+func GetCmdDenomTransferPolicy() (*osmocli.QueryDescriptor, *types.QueryDenomTransferPolicyRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "denom-transfer-policy",
+		Short: "Get the native transfer policy for a specific denom",
+		Long: `{{.Short}}{{.ExampleHeader}}
+		{{.CommandPrefix}} factory/<creator>/<subdenom>`,
+	}, &types.QueryDenomTransferPolicyRequest{}
+}
+
+func GetCmdRemainingMintableSupply() (*osmocli.QueryDescriptor, *types.QueryRemainingMintableSupplyRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "remaining-mintable-supply",
+		Short: "Get the supply cap for a specific denom and the amount that can still be minted",
+		Long: `{{.Short}}{{.ExampleHeader}}
+		{{.CommandPrefix}} factory/<creator>/<subdenom>`,
+	}, &types.QueryRemainingMintableSupplyRequest{}
+}
+
 // GetCmdDenomAuthorityMetadata returns the authority metadata for a queried denom
 func GetCmdDenomBeforeSendHook() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1,12 +1,15 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/spf13/cobra"
 
 	// "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v29/x/tokenfactory/types"
 )
@@ -22,6 +25,9 @@ func GetTxCmd() *cobra.Command {
 		NewChangeAdminCmd(),
 		NewSetBeforeSendHookCmd(),
 		NewMsgSetDenomMetadata(),
+		NewSetDenomTransferPolicyCmd(),
+		NewSetDenomSupplyCapCmd(),
+		NewRenounceMintCmd(),
 	)
 
 	return cmd
@@ -55,6 +61,13 @@ func NewBurnCmd() *cobra.Command {
 	})
 }
 
+func NewRenounceMintCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgRenounceMint](&osmocli.TxCliDesc{
+		Use:   "renounce-mint",
+		Short: "Permanently give up minting a factory-created denom, keeping the other admin rights. Must have admin authority to do so.",
+	})
+}
+
 func NewChangeAdminCmd() *cobra.Command {
 	return osmocli.BuildTxCli[*types.MsgChangeAdmin](&osmocli.TxCliDesc{
 		Use:   "change-admin",
@@ -93,3 +106,120 @@ func NewSetBeforeSendHookCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+const (
+	FlagFrozen     = "frozen"
+	FlagAllowlist  = "allowlist"
+	FlagDenylist   = "denylist"
+	FlagMaxBalance = "max-balance"
+	FlagImmutable  = "immutable"
+)
+
+// NewSetDenomTransferPolicyCmd broadcast MsgSetDenomTransferPolicy
+func NewSetDenomTransferPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-transfer-policy [denom] [flags]",
+		Short:   "Set the native transfer policy for a factory-created denom, replacing the previous one. Must have admin authority to do so.",
+		Example: "osmosisd tx tokenfactory set-transfer-policy factory/<creator>/<subdenom> --denylist osmo1...,osmo1... --max-balance 1000000",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			frozen, err := cmd.Flags().GetBool(FlagFrozen)
+			if err != nil {
+				return err
+			}
+			allowlist, err := cmd.Flags().GetStringSlice(FlagAllowlist)
+			if err != nil {
+				return err
+			}
+			denylist, err := cmd.Flags().GetStringSlice(FlagDenylist)
+			if err != nil {
+				return err
+			}
+			maxBalanceStr, err := cmd.Flags().GetString(FlagMaxBalance)
+			if err != nil {
+				return err
+			}
+			maxBalance, ok := osmomath.NewIntFromString(maxBalanceStr)
+			if !ok {
+				return fmt.Errorf("invalid max balance %s", maxBalanceStr)
+			}
+
+			msg := types.NewMsgSetDenomTransferPolicy(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				types.DenomTransferPolicy{
+					Frozen:     frozen,
+					Allowlist:  allowlist,
+					Denylist:   denylist,
+					MaxBalance: maxBalance,
+				},
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagFrozen, false, "Halt all transfers of the denom between accounts")
+	cmd.Flags().StringSlice(FlagAllowlist, nil, "Comma separated addresses that are the only ones allowed to send or receive the denom")
+	cmd.Flags().StringSlice(FlagDenylist, nil, "Comma separated addresses that may neither send nor receive the denom")
+	cmd.Flags().String(FlagMaxBalance, "0", "Maximum balance of the denom that any account may hold, 0 for no limit")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetDenomSupplyCapCmd broadcast MsgSetDenomSupplyCap
+func NewSetDenomSupplyCapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-supply-cap [denom] [max-supply] [flags]",
+		Short:   "Set the supply cap for a factory-created denom. A mutable supply cap can only be lowered afterwards. Must have admin authority to do so.",
+		Example: "osmosisd tx tokenfactory set-supply-cap factory/<creator>/<subdenom> 1000000000 --immutable",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			maxSupply, ok := osmomath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max supply %s", args[1])
+			}
+			immutable, err := cmd.Flags().GetBool(FlagImmutable)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomSupplyCap(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				types.DenomSupplyCap{
+					MaxSupply: maxSupply,
+					Immutable: immutable,
+				},
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagImmutable, false, "Make the supply cap immutable, so that it can never be changed again")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -107,8 +107,13 @@ func (h Hooks) TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amo
 	_ = h.k.callBeforeSendListener(ctx, from, to, amount, false)
 }
 
-// TrackBeforeSend calls the before send listener contract returns any errors
+// BlockBeforeSend enforces the native transfer policies of the sent denoms,
+// then calls the before send listener contract returns any errors
 func (h Hooks) BlockBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	err := h.k.checkTransferPolicies(sdk.UnwrapSDKContext(ctx), from, to, amount)
+	if err != nil {
+		return err
+	}
 	return h.k.callBeforeSendListener(ctx, from, to, amount, true)
 }
 
@@ -29,6 +29,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		err = k.setDenomTransferPolicy(ctx, genDenom.GetDenom(), genDenom.GetTransferPolicy())
+		if err != nil {
+			panic(err)
+		}
+		err = k.setDenomSupplyCap(ctx, genDenom.GetDenom(), genDenom.GetSupplyCap())
+		if err != nil {
+			panic(err)
+		}
 	}
 }
 
@@ -45,9 +53,21 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
+		transferPolicy, err := k.GetDenomTransferPolicy(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+
+		supplyCap, err := k.GetDenomSupplyCap(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+
 		genDenoms = append(genDenoms, types.GenesisDenom{
 			Denom:             denom,
 			AuthorityMetadata: authorityMetadata,
+			TransferPolicy:    transferPolicy,
+			SupplyCap:         supplyCap,
 		})
 	}
 
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	appparams "github.com/osmosis-labs/osmosis/v29/app/params"
 	"github.com/osmosis-labs/osmosis/v29/x/tokenfactory/types"
 )
@@ -20,7 +21,12 @@ func (s *KeeperTestSuite) TestGenesis() {
 			{
 				Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/diff-admin",
 				AuthorityMetadata: types.DenomAuthorityMetadata{
-					Admin: "osmo15czt5nhlnvayqq37xun9s9yus0d6y26dw9xnzn",
+					Admin:            "osmo15czt5nhlnvayqq37xun9s9yus0d6y26dw9xnzn",
+					MintingRenounced: true,
+				},
+				SupplyCap: types.DenomSupplyCap{
+					MaxSupply: osmomath.NewInt(1000000),
+					Immutable: true,
 				},
 			},
 			{
@@ -28,6 +34,11 @@ func (s *KeeperTestSuite) TestGenesis() {
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
 				},
+				TransferPolicy: types.DenomTransferPolicy{
+					Frozen:     true,
+					Denylist:   []string{"osmo15czt5nhlnvayqq37xun9s9yus0d6y26dw9xnzn"},
+					MaxBalance: osmomath.NewInt(1000),
+				},
 			},
 		},
 	}
@@ -57,3 +57,31 @@ func (k Keeper) AllBeforeSendHooksAddresses(ctx context.Context, req *types.Quer
 
 	return &types.QueryAllBeforeSendHooksAddressesResponse{Denoms: denoms, BeforeSendHookAddresses: beforesendHookAddresses}, nil
 }
+
+func (k Keeper) DenomTransferPolicy(ctx context.Context, req *types.QueryDenomTransferPolicyRequest) (*types.QueryDenomTransferPolicyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+	transferPolicy, err := k.GetDenomTransferPolicy(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomTransferPolicyResponse{TransferPolicy: transferPolicy}, nil
+}
+
+func (k Keeper) RemainingMintableSupply(ctx context.Context, req *types.QueryRemainingMintableSupplyRequest) (*types.QueryRemainingMintableSupplyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+	supplyCap, unlimited, remaining, err := k.GetRemainingMintableSupply(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRemainingMintableSupplyResponse{SupplyCap: supplyCap, Unlimited: unlimited, RemainingMintableSupply: remaining}, nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -231,3 +232,87 @@ func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgS
 
 	return &types.MsgSetBeforeSendHookResponse{}, nil
 }
+
+func (server msgServer) SetDenomTransferPolicy(goCtx context.Context, msg *types.MsgSetDenomTransferPolicy) (*types.MsgSetDenomTransferPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setDenomTransferPolicy(ctx, msg.Denom, msg.TransferPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetDenomTransferPolicy,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeTransferPolicy, msg.TransferPolicy.String()),
+		),
+	})
+
+	return &types.MsgSetDenomTransferPolicyResponse{}, nil
+}
+
+func (server msgServer) SetDenomSupplyCap(goCtx context.Context, msg *types.MsgSetDenomSupplyCap) (*types.MsgSetDenomSupplyCapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.updateDenomSupplyCap(ctx, msg.Denom, msg.SupplyCap)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetDenomSupplyCap,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMaxSupply, msg.SupplyCap.MaxSupply.String()),
+			sdk.NewAttribute(types.AttributeImmutable, strconv.FormatBool(msg.SupplyCap.Immutable)),
+		),
+	})
+
+	return &types.MsgSetDenomSupplyCapResponse{}, nil
+}
+
+func (server msgServer) RenounceMint(goCtx context.Context, msg *types.MsgRenounceMint) (*types.MsgRenounceMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.renounceMint(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRenounceMint,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+		),
+	})
+
+	return &types.MsgRenounceMintResponse{}, nil
+}
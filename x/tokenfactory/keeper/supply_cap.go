@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/tokenfactory/types"
+)
+
+// GetDenomSupplyCap returns the supply cap of a specific denom.
+// Denoms without a supply cap return an empty supply cap, which does not limit the supply.
+func (k Keeper) GetDenomSupplyCap(ctx sdk.Context, denom string) (types.DenomSupplyCap, error) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.SupplyCapKey))
+	if bz == nil {
+		return types.DenomSupplyCap{}, nil
+	}
+
+	supplyCap := types.DenomSupplyCap{}
+	err := proto.Unmarshal(bz, &supplyCap)
+	if err != nil {
+		return types.DenomSupplyCap{}, err
+	}
+	return supplyCap, nil
+}
+
+// setDenomSupplyCap stores the supply cap of a specific denom, without checking it against the previous one.
+func (k Keeper) setDenomSupplyCap(ctx sdk.Context, denom string, supplyCap types.DenomSupplyCap) error {
+	err := supplyCap.Validate()
+	if err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+
+	if !supplyCap.HasMaxSupply() {
+		store.Delete([]byte(types.SupplyCapKey))
+		return nil
+	}
+
+	bz, err := proto.Marshal(&supplyCap)
+	if err != nil {
+		return err
+	}
+
+	store.Set([]byte(types.SupplyCapKey), bz)
+	return nil
+}
+
+// updateDenomSupplyCap replaces the supply cap of a specific denom.
+// The new max supply may not be below the current supply of the denom.
+// If the denom already has a supply cap, it must be mutable and the new max supply may not be above the previous one.
+func (k Keeper) updateDenomSupplyCap(ctx sdk.Context, denom string, supplyCap types.DenomSupplyCap) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	if !supplyCap.HasMaxSupply() {
+		return errorsmod.Wrap(types.ErrInvalidSupplyCap, "max supply must be positive")
+	}
+
+	prevSupplyCap, err := k.GetDenomSupplyCap(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if prevSupplyCap.HasMaxSupply() {
+		if prevSupplyCap.Immutable {
+			return errorsmod.Wrapf(types.ErrInvalidSupplyCap, "supply cap of denom %s is immutable", denom)
+		}
+		if supplyCap.MaxSupply.GT(prevSupplyCap.MaxSupply) {
+			return errorsmod.Wrapf(types.ErrInvalidSupplyCap, "supply cap can only be lowered, from %s, got %s", prevSupplyCap.MaxSupply, supplyCap.MaxSupply)
+		}
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if supplyCap.MaxSupply.LT(supply) {
+		return errorsmod.Wrapf(types.ErrInvalidSupplyCap, "max supply %s is below the current supply %s", supplyCap.MaxSupply, supply)
+	}
+
+	return k.setDenomSupplyCap(ctx, denom, supplyCap)
+}
+
+// renounceMint permanently removes the permission to mint a specific denom.
+func (k Keeper) renounceMint(ctx sdk.Context, denom string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	metadata.MintingRenounced = true
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// GetRemainingMintableSupply returns the supply cap of a specific denom and the amount of it that can still be minted.
+// unlimited is true if the denom has no supply cap and minting was not renounced, in which case the remaining amount is zero.
+func (k Keeper) GetRemainingMintableSupply(ctx sdk.Context, denom string) (supplyCap types.DenomSupplyCap, unlimited bool, remaining osmomath.Int, err error) {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return types.DenomSupplyCap{}, false, osmomath.ZeroInt(), err
+	}
+	supplyCap, err = k.GetDenomSupplyCap(ctx, denom)
+	if err != nil {
+		return types.DenomSupplyCap{}, false, osmomath.ZeroInt(), err
+	}
+
+	if metadata.MintingRenounced {
+		return supplyCap, false, osmomath.ZeroInt(), nil
+	}
+	if !supplyCap.HasMaxSupply() {
+		return supplyCap, true, osmomath.ZeroInt(), nil
+	}
+
+	remaining = supplyCap.MaxSupply.Sub(k.bankKeeper.GetSupply(ctx, denom).Amount)
+	if remaining.IsNegative() {
+		remaining = osmomath.ZeroInt()
+	}
+	return supplyCap, false, remaining, nil
+}
+
+// checkMintable returns an error if minting the given amount is not allowed,
+// either because minting of the denom was renounced or because it would exceed the denom's supply cap.
+func (k Keeper) checkMintable(ctx sdk.Context, amount sdk.Coin) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+	if metadata.MintingRenounced {
+		return errorsmod.Wrapf(types.ErrMintingRenounced, "denom: %s", amount.Denom)
+	}
+
+	supplyCap, err := k.GetDenomSupplyCap(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+	if !supplyCap.HasMaxSupply() {
+		return nil
+	}
+
+	newSupply := k.bankKeeper.GetSupply(ctx, amount.Denom).Amount.Add(amount.Amount)
+	if newSupply.GT(supplyCap.MaxSupply) {
+		return errorsmod.Wrapf(types.ErrSupplyCapExceeded, "supply of %s would be %s, above the max supply of %s", amount.Denom, newSupply, supplyCap.MaxSupply)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestDenomSupplyCap() {
+	for _, tc := range []struct {
+		desc string
+		// initialCap is set before minting initialSupply, if it has a max supply.
+		initialCap    types.DenomSupplyCap
+		initialSupply int64
+		newCap        types.DenomSupplyCap
+		expectedErr   error
+	}{
+		{
+			desc:          "set supply cap on uncapped denom",
+			initialSupply: 100,
+			newCap:        types.DenomSupplyCap{MaxSupply: osmomath.NewInt(1000)},
+		},
+		{
+			desc:          "set supply cap equal to the supply",
+			initialSupply: 100,
+			newCap:        types.DenomSupplyCap{MaxSupply: osmomath.NewInt(100)},
+		},
+		{
+			desc:          "error: supply cap below the supply",
+			initialSupply: 100,
+			newCap:        types.DenomSupplyCap{MaxSupply: osmomath.NewInt(99)},
+			expectedErr:   types.ErrInvalidSupplyCap,
+		},
+		{
+			desc:          "error: zero supply cap",
+			initialSupply: 100,
+			newCap:        types.DenomSupplyCap{MaxSupply: osmomath.ZeroInt()},
+			expectedErr:   types.ErrInvalidSupplyCap,
+		},
+		{
+			desc:          "lower mutable supply cap",
+			initialCap:    types.DenomSupplyCap{MaxSupply: osmomath.NewInt(1000)},
+			initialSupply: 100,
+			newCap:        types.DenomSupplyCap{MaxSupply: osmomath.NewInt(500), Immutable: true},
+		},
+		{
+			desc:          "error: raise mutable supply cap",
+			initialCap:    types.DenomSupplyCap{MaxSupply: osmomath.NewInt(1000)},
+			initialSupply: 100,
+			newCap:        types.DenomSupplyCap{MaxSupply: osmomath.NewInt(1001)},
+			expectedErr:   types.ErrInvalidSupplyCap,
+		},
+		{
+			desc:          "error: lower immutable supply cap",
+			initialCap:    types.DenomSupplyCap{MaxSupply: osmomath.NewInt(1000), Immutable: true},
+			initialSupply: 100,
+			newCap:        types.DenomSupplyCap{MaxSupply: osmomath.NewInt(500)},
+			expectedErr:   types.ErrInvalidSupplyCap,
+		},
+	} {
+		s.Run(tc.desc, func() {
+			s.SetupTest()
+			s.CreateDefaultDenom()
+			admin := s.TestAccs[0]
+
+			if tc.initialCap.HasMaxSupply() {
+				_, err := s.msgServer.SetDenomSupplyCap(s.Ctx, types.NewMsgSetDenomSupplyCap(admin.String(), s.defaultDenom, tc.initialCap))
+				s.Require().NoError(err)
+			}
+			_, err := s.msgServer.Mint(s.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, tc.initialSupply)))
+			s.Require().NoError(err)
+
+			_, err = s.msgServer.SetDenomSupplyCap(s.Ctx, types.NewMsgSetDenomSupplyCap(admin.String(), s.defaultDenom, tc.newCap))
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			res, err := s.queryClient.RemainingMintableSupply(s.Ctx.Context(), &types.QueryRemainingMintableSupplyRequest{Denom: s.defaultDenom})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.newCap, res.SupplyCap)
+			s.Require().False(res.Unlimited)
+			remaining := tc.newCap.MaxSupply.SubRaw(tc.initialSupply)
+			s.Require().Equal(remaining.String(), res.RemainingMintableSupply.String())
+
+			// Minting above the remaining supply fails, minting all of it succeeds.
+			_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMint(admin.String(), sdk.NewCoin(s.defaultDenom, remaining.AddRaw(1))))
+			s.Require().ErrorIs(err, types.ErrSupplyCapExceeded)
+			if remaining.IsPositive() {
+				_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMint(admin.String(), sdk.NewCoin(s.defaultDenom, remaining)))
+				s.Require().NoError(err)
+			}
+			s.Require().Equal(tc.newCap.MaxSupply, s.App.BankKeeper.GetSupply(s.Ctx, s.defaultDenom).Amount)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRenounceMint() {
+	s.SetupTest()
+	s.CreateDefaultDenom()
+	admin, other := s.TestAccs[0], s.TestAccs[1]
+
+	res, err := s.queryClient.RemainingMintableSupply(s.Ctx.Context(), &types.QueryRemainingMintableSupplyRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().True(res.Unlimited)
+
+	_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100)))
+	s.Require().NoError(err)
+
+	// only the admin can renounce minting
+	_, err = s.msgServer.RenounceMint(s.Ctx, types.NewMsgRenounceMint(other.String(), s.defaultDenom))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.RenounceMint(s.Ctx, types.NewMsgRenounceMint(admin.String(), s.defaultDenom))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 1)))
+	s.Require().ErrorIs(err, types.ErrMintingRenounced)
+
+	res, err = s.queryClient.RemainingMintableSupply(s.Ctx.Context(), &types.QueryRemainingMintableSupplyRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().False(res.Unlimited)
+	s.Require().True(res.RemainingMintableSupply.IsZero())
+
+	// the admin keeps its other rights
+	_, err = s.msgServer.Burn(s.Ctx, types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().NoError(err)
+	_, err = s.msgServer.SetDenomMetadata(s.Ctx, types.NewMsgSetDenomMetadata(admin.String(), banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: s.defaultDenom, Exponent: 0}},
+		Base:       s.defaultDenom,
+		Display:    s.defaultDenom,
+		Name:       s.defaultDenom,
+		Symbol:     "BTC",
+	}))
+	s.Require().NoError(err)
+
+	// changing the admin does not restore minting
+	_, err = s.msgServer.ChangeAdmin(s.Ctx, types.NewMsgChangeAdmin(admin.String(), s.defaultDenom, other.String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMint(other.String(), sdk.NewInt64Coin(s.defaultDenom, 1)))
+	s.Require().ErrorIs(err, types.ErrMintingRenounced)
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/v29/x/tokenfactory/types"
+)
+
+// GetDenomTransferPolicy returns the transfer policy of a specific denom.
+// Denoms without a transfer policy return an empty policy, which does not restrict any transfer.
+func (k Keeper) GetDenomTransferPolicy(ctx sdk.Context, denom string) (types.DenomTransferPolicy, error) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.TransferPolicyKey))
+	if bz == nil {
+		return types.DenomTransferPolicy{}, nil
+	}
+
+	policy := types.DenomTransferPolicy{}
+	err := proto.Unmarshal(bz, &policy)
+	if err != nil {
+		return types.DenomTransferPolicy{}, err
+	}
+	return policy, nil
+}
+
+// setDenomTransferPolicy stores the transfer policy of a specific denom.
+// Setting an empty policy removes the denom's transfer policy.
+func (k Keeper) setDenomTransferPolicy(ctx sdk.Context, denom string, policy types.DenomTransferPolicy) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	err = policy.Validate()
+	if err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+
+	if policy.IsEmpty() {
+		store.Delete([]byte(types.TransferPolicyKey))
+		return nil
+	}
+
+	bz, err := proto.Marshal(&policy)
+	if err != nil {
+		return err
+	}
+
+	store.Set([]byte(types.TransferPolicyKey), bz)
+	return nil
+}
+
+// checkTransferPolicies returns an error if sending the given amount from one account to another
+// violates the transfer policy of any of the sent denoms.
+//
+// Sends to the tokenfactory module account are burns and are never restricted.
+// Sends from the tokenfactory module account are mints, for which only the recipient is checked.
+// Sends from any other module account, such as reward distributions and lockup unlocks, only skip the
+// sender checks: the recipient allowlist, denylist and max balance always apply, so that a restricted
+// account cannot receive the denom through a protocol flow either.
+// Note that force transfers are subject to the policy too, the admin can lift it for the duration of the transfer.
+func (k Keeper) checkTransferPolicies(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	moduleAddr := k.permAddrs[types.ModuleName].GetAddress()
+	if to.Equals(moduleAddr) {
+		return nil
+	}
+	checkSender := !from.Equals(moduleAddr) && !k.IsModuleAcc(ctx, from)
+
+	for _, coin := range amount {
+		// only token factory denoms can have a transfer policy, skip reading the store for the others
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			continue
+		}
+		policy, err := k.GetDenomTransferPolicy(ctx, coin.Denom)
+		if err != nil {
+			return err
+		}
+		if policy.IsEmpty() {
+			continue
+		}
+
+		if checkSender {
+			if policy.Frozen {
+				return errorsmod.Wrapf(types.ErrTransferRestricted, "denom %s is frozen", coin.Denom)
+			}
+			if !policy.IsAllowlisted(from.String()) {
+				return errorsmod.Wrapf(types.ErrTransferRestricted, "sender %s is not allowlisted for denom %s", from, coin.Denom)
+			}
+			if policy.IsDenylisted(from.String()) {
+				return errorsmod.Wrapf(types.ErrTransferRestricted, "sender %s is denylisted for denom %s", from, coin.Denom)
+			}
+		}
+		if !policy.IsAllowlisted(to.String()) {
+			return errorsmod.Wrapf(types.ErrTransferRestricted, "recipient %s is not allowlisted for denom %s", to, coin.Denom)
+		}
+		if policy.IsDenylisted(to.String()) {
+			return errorsmod.Wrapf(types.ErrTransferRestricted, "recipient %s is denylisted for denom %s", to, coin.Denom)
+		}
+		if policy.HasMaxBalance() {
+			newBalance := k.bankKeeper.GetBalance(ctx, to, coin.Denom).Amount.Add(coin.Amount)
+			if newBalance.GT(policy.MaxBalance) {
+				return errorsmod.Wrapf(types.ErrTransferRestricted, "recipient %s balance of denom %s would be %s, above the max balance of %s", to, coin.Denom, newBalance, policy.MaxBalance)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	minttypes "github.com/osmosis-labs/osmosis/v29/x/mint/types"
+	"github.com/osmosis-labs/osmosis/v29/x/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestDenomTransferPolicy() {
+	for _, tc := range []struct {
+		desc   string
+		policy func(admin, other sdk.AccAddress) types.DenomTransferPolicy
+		// sendAmount is sent from the admin to the other account.
+		sendAmount     int64
+		expectSendPass bool
+		mintAmount     int64
+		expectMintPass bool
+	}{
+		{
+			desc: "empty policy",
+			policy: func(admin, other sdk.AccAddress) types.DenomTransferPolicy {
+				return types.DenomTransferPolicy{}
+			},
+			sendAmount:     10,
+			expectSendPass: true,
+			mintAmount:     10,
+			expectMintPass: true,
+		},
+		{
+			desc: "frozen denom blocks sends but not mints",
+			policy: func(admin, other sdk.AccAddress) types.DenomTransferPolicy {
+				return types.DenomTransferPolicy{Frozen: true}
+			},
+			sendAmount:     10,
+			expectSendPass: false,
+			mintAmount:     10,
+			expectMintPass: true,
+		},
+		{
+			desc: "recipient not allowlisted",
+			policy: func(admin, other sdk.AccAddress) types.DenomTransferPolicy {
+				return types.DenomTransferPolicy{Allowlist: []string{admin.String()}}
+			},
+			sendAmount:     10,
+			expectSendPass: false,
+			mintAmount:     10,
+			expectMintPass: false,
+		},
+		{
+			desc: "sender and recipient allowlisted",
+			policy: func(admin, other sdk.AccAddress) types.DenomTransferPolicy {
+				return types.DenomTransferPolicy{Allowlist: []string{admin.String(), other.String()}}
+			},
+			sendAmount:     10,
+			expectSendPass: true,
+			mintAmount:     10,
+			expectMintPass: true,
+		},
+		{
+			desc: "recipient denylisted",
+			policy: func(admin, other sdk.AccAddress) types.DenomTransferPolicy {
+				return types.DenomTransferPolicy{Denylist: []string{other.String()}}
+			},
+			sendAmount:     10,
+			expectSendPass: false,
+			mintAmount:     10,
+			expectMintPass: false,
+		},
+		{
+			desc: "sender denylisted",
+			policy: func(admin, other sdk.AccAddress) types.DenomTransferPolicy {
+				return types.DenomTransferPolicy{Denylist: []string{admin.String()}}
+			},
+			sendAmount:     10,
+			expectSendPass: false,
+			mintAmount:     10,
+			expectMintPass: true,
+		},
+		{
+			desc: "max balance not exceeded",
+			policy: func(admin, other sdk.AccAddress) types.DenomTransferPolicy {
+				return types.DenomTransferPolicy{MaxBalance: osmomath.NewInt(20)}
+			},
+			sendAmount:     10,
+			expectSendPass: true,
+			mintAmount:     10,
+			expectMintPass: true,
+		},
+		{
+			desc: "max balance exceeded",
+			policy: func(admin, other sdk.AccAddress) types.DenomTransferPolicy {
+				return types.DenomTransferPolicy{MaxBalance: osmomath.NewInt(15)}
+			},
+			sendAmount:     10,
+			expectSendPass: true,
+			mintAmount:     10,
+			expectMintPass: false,
+		},
+	} {
+		s.Run(tc.desc, func() {
+			s.SetupTest()
+			s.CreateDefaultDenom()
+			admin, other := s.TestAccs[0], s.TestAccs[1]
+
+			// Mint to the admin before setting the policy.
+			_, err := s.msgServer.Mint(s.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100)))
+			s.Require().NoError(err)
+
+			_, err = s.msgServer.SetDenomTransferPolicy(s.Ctx, types.NewMsgSetDenomTransferPolicy(admin.String(), s.defaultDenom, tc.policy(admin, other)))
+			s.Require().NoError(err)
+
+			_, err = s.bankMsgServer.Send(s.Ctx, banktypes.NewMsgSend(admin, other, sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, tc.sendAmount))))
+			if tc.expectSendPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, types.ErrTransferRestricted)
+				// Fund the other account regardless, so that minting and burning are tested from the same balance.
+				s.Require().NoError(s.App.BankKeeper.SendCoinsWithoutBlockHook(s.Ctx, admin, other, sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, tc.sendAmount))))
+			}
+
+			_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, tc.mintAmount), other.String()))
+			if tc.expectMintPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, types.ErrTransferRestricted)
+			}
+
+			// Burns are never restricted.
+			_, err = s.msgServer.Burn(s.Ctx, types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 1), other.String()))
+			s.Require().NoError(err)
+
+			// Removing the policy lifts the restrictions.
+			_, err = s.msgServer.SetDenomTransferPolicy(s.Ctx, types.NewMsgSetDenomTransferPolicy(admin.String(), s.defaultDenom, types.DenomTransferPolicy{}))
+			s.Require().NoError(err)
+			_, err = s.bankMsgServer.Send(s.Ctx, banktypes.NewMsgSend(admin, other, sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 1))))
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestDenomTransferPolicyPoolSwap() {
+	s.SetupTest()
+	s.CreateDefaultDenom()
+	admin, other := s.TestAccs[0], s.TestAccs[1]
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(s.defaultDenom, 1_000_000), sdk.NewInt64Coin("uosmo", 1_000_000))
+
+	policy := types.DenomTransferPolicy{Denylist: []string{other.String()}, MaxBalance: osmomath.NewInt(1_000_000_000)}
+	_, err := s.msgServer.SetDenomTransferPolicy(s.Ctx, types.NewMsgSetDenomTransferPolicy(admin.String(), s.defaultDenom, policy))
+	s.Require().NoError(err)
+
+	tokenIn := sdk.NewInt64Coin("uosmo", 1_000)
+	s.FundAcc(other, sdk.NewCoins(tokenIn))
+	s.FundAcc(admin, sdk.NewCoins(tokenIn))
+
+	// The pool paying out the denom to a denylisted account is rejected.
+	_, _, err = s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, other, poolId, tokenIn, s.defaultDenom, osmomath.OneInt())
+	s.Require().ErrorIs(err, types.ErrTransferRestricted)
+
+	// So is a module account paying it out.
+	moduleCoins := sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 10))
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, moduleCoins))
+	err = s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, minttypes.ModuleName, other, moduleCoins)
+	s.Require().ErrorIs(err, types.ErrTransferRestricted)
+
+	// Other accounts can still swap into the denom.
+	_, _, err = s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, admin, poolId, tokenIn, s.defaultDenom, osmomath.OneInt())
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestSetDenomTransferPolicy() {
+	s.SetupTest()
+	s.CreateDefaultDenom()
+	admin, other := s.TestAccs[0], s.TestAccs[1]
+	policy := types.DenomTransferPolicy{
+		Frozen:     true,
+		Denylist:   []string{other.String()},
+		MaxBalance: osmomath.NewInt(100),
+	}
+
+	// only the admin can set the transfer policy
+	_, err := s.msgServer.SetDenomTransferPolicy(s.Ctx, types.NewMsgSetDenomTransferPolicy(other.String(), s.defaultDenom, policy))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// an address cannot be both allowlisted and denylisted
+	invalidPolicy := types.DenomTransferPolicy{Allowlist: []string{other.String()}, Denylist: []string{other.String()}}
+	_, err = s.msgServer.SetDenomTransferPolicy(s.Ctx, types.NewMsgSetDenomTransferPolicy(admin.String(), s.defaultDenom, invalidPolicy))
+	s.Require().ErrorIs(err, types.ErrInvalidTransferPolicy)
+
+	_, err = s.msgServer.SetDenomTransferPolicy(s.Ctx, types.NewMsgSetDenomTransferPolicy(admin.String(), s.defaultDenom, policy))
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.DenomTransferPolicy(s.Ctx.Context(), &types.QueryDenomTransferPolicyRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(policy, res.TransferPolicy)
+}
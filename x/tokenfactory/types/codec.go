@@ -18,6 +18,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSetDenomMetadata{}, "osmosis/tokenfactory/set-denom-metadata")
 	legacy.RegisterAminoMsg(cdc, &MsgSetBeforeSendHook{}, "osmosis/tokenfactory/set-bef-send-hook")
 	legacy.RegisterAminoMsg(cdc, &MsgForceTransfer{}, "osmosis/tokenfactory/force-transfer")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomTransferPolicy{}, "osmosis/tokenfactory/set-denom-policy")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomSupplyCap{}, "osmosis/tokenfactory/set-supply-cap")
+	legacy.RegisterAminoMsg(cdc, &MsgRenounceMint{}, "osmosis/tokenfactory/renounce-mint")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -30,6 +33,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSetDenomMetadata{},
 		&MsgSetBeforeSendHook{},
 		&MsgForceTransfer{},
+		&MsgSetDenomTransferPolicy{},
+		&MsgSetDenomSupplyCap{},
+		&MsgRenounceMint{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
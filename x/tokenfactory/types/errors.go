@@ -22,4 +22,9 @@ var (
 	ErrBurnFromModuleAccount    = errorsmod.Register(ModuleName, 11, "burning from Module Account is not allowed")
 	ErrBeforeSendHookOutOfGas   = errorsmod.Register(ModuleName, 12, "gas meter hit maximum limit")
 	ErrMintToModuleAccount      = errorsmod.Register(ModuleName, 13, "minting to Module Account is not allowed")
+	ErrInvalidTransferPolicy    = errorsmod.Register(ModuleName, 14, "invalid transfer policy")
+	ErrTransferRestricted       = errorsmod.Register(ModuleName, 15, "transfer restricted by the denom's transfer policy")
+	ErrInvalidSupplyCap         = errorsmod.Register(ModuleName, 16, "invalid supply cap")
+	ErrSupplyCapExceeded        = errorsmod.Register(ModuleName, 17, "minting would exceed the denom's supply cap")
+	ErrMintingRenounced         = errorsmod.Register(ModuleName, 18, "minting of the denom was renounced")
 )
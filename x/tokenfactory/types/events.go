@@ -14,4 +14,7 @@ const (
 	AttributeNewAdmin              = "new_admin"
 	AttributeDenomMetadata         = "denom_metadata"
 	AttributeBeforeSendHookAddress = "before_send_hook_address"
+	AttributeTransferPolicy        = "transfer_policy"
+	AttributeMaxSupply             = "max_supply"
+	AttributeImmutable             = "immutable"
 )
@@ -45,6 +45,16 @@ func (gs GenesisState) Validate() error {
 				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		err = denom.TransferPolicy.Validate()
+		if err != nil {
+			return err
+		}
+
+		err = denom.SupplyCap.Validate()
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
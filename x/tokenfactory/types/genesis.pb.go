@@ -79,10 +79,13 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 
 // GenesisDenom defines a tokenfactory denom that is defined within genesis
 // state. The structure contains DenomAuthorityMetadata which defines the
-// denom's admin.
+// denom's admin, DenomTransferPolicy which defines the denom's native transfer
+// restrictions, and DenomSupplyCap which defines the denom's maximum supply.
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	TransferPolicy    DenomTransferPolicy    `protobuf:"bytes,3,opt,name=transfer_policy,json=transferPolicy,proto3" json:"transfer_policy" yaml:"transfer_policy"`
+	SupplyCap         DenomSupplyCap         `protobuf:"bytes,4,opt,name=supply_cap,json=supplyCap,proto3" json:"supply_cap" yaml:"supply_cap"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +135,20 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetTransferPolicy() DenomTransferPolicy {
+	if m != nil {
+		return m.TransferPolicy
+	}
+	return DenomTransferPolicy{}
+}
+
+func (m *GenesisDenom) GetSupplyCap() DenomSupplyCap {
+	if m != nil {
+		return m.SupplyCap
+	}
+	return DenomSupplyCap{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "osmosis.tokenfactory.v1beta1.GenesisDenom")
@@ -142,30 +159,36 @@ func init() {
 }
 
 var fileDescriptor_5749c3f71850298b = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd5, 0x43, 0x56, 0xab, 0x07, 0x55,
-	0x2b, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0x99, 0xe0,
-	0x35, 0x3f, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0xd2, 0x37, 0xb5, 0x24, 0x31, 0x25,
-	0xb1, 0x24, 0x11, 0xaa, 0x4b, 0x13, 0xaf, 0xae, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xa3, 0x94,
-	0x8e, 0x30, 0x72, 0xf1, 0xb8, 0x43, 0x9c, 0x19, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xc4, 0xc5,
-	0x06, 0x51, 0x20, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa2, 0x87, 0xcf, 0xd9, 0x7a, 0x01,
-	0x60, 0xb5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x0a, 0x15, 0x70, 0xf1, 0x41,
-	0xd5, 0xc5, 0xa7, 0xa4, 0xe6, 0xe5, 0xe7, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x69,
-	0xe1, 0x37, 0x0b, 0xea, 0x0e, 0x17, 0x90, 0x16, 0x27, 0x59, 0x90, 0x89, 0x9f, 0xee, 0xc9, 0x8b,
-	0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0x9a, 0xa7, 0x14, 0xc4, 0x0b, 0x15, 0x70, 0x81, 0xf0,
-	0x8f, 0x22, 0xbc, 0x01, 0x16, 0x11, 0x52, 0xe3, 0x62, 0x05, 0x2b, 0x05, 0xfb, 0x82, 0xd3, 0x49,
-	0xe0, 0xd3, 0x3d, 0x79, 0x1e, 0x88, 0x49, 0x60, 0x61, 0xa5, 0x20, 0x88, 0xb4, 0x50, 0x1b, 0x23,
-	0x97, 0x10, 0x3c, 0x18, 0xe3, 0x73, 0xa1, 0xe1, 0x28, 0xc1, 0x04, 0xf6, 0xbb, 0x09, 0x7e, 0xf7,
-	0x82, 0x6d, 0x72, 0x44, 0x8f, 0x03, 0x27, 0x45, 0xa8, 0xcb, 0x25, 0x21, 0xf6, 0x61, 0x9a, 0xae,
-	0x14, 0x24, 0x88, 0x11, 0x73, 0x56, 0x2c, 0x2f, 0x16, 0xc8, 0x33, 0x3a, 0x05, 0x9d, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x45, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x55, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x30, 0x8e, 0x7e, 0x99, 0x91,
-	0xa5, 0x7e, 0x05, 0x6a, 0x8c, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x63, 0xda, 0x18,
-	0x10, 0x00, 0x00, 0xff, 0xff, 0x7c, 0xeb, 0xad, 0x05, 0xac, 0x02, 0x00, 0x00,
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc7, 0xe3, 0xb5, 0x4c, 0x9a, 0x37, 0x06, 0xb3, 0x00, 0x65, 0x15, 0x24, 0x23, 0x42, 0x68,
+	0x4c, 0x2c, 0x51, 0xc3, 0x0e, 0xb0, 0x1b, 0x66, 0x12, 0x27, 0xa4, 0x29, 0xe3, 0xc4, 0x25, 0x72,
+	0x32, 0x37, 0x8b, 0x48, 0x62, 0x2b, 0x76, 0x27, 0xc2, 0x03, 0x70, 0xe6, 0x11, 0xb8, 0xf2, 0x1e,
+	0x1c, 0x76, 0xdc, 0x91, 0x53, 0x85, 0xda, 0x0b, 0xe7, 0x3d, 0x01, 0xaa, 0xed, 0x6d, 0xb4, 0x95,
+	0xa2, 0xde, 0x1a, 0xf7, 0xf3, 0xfd, 0x63, 0xff, 0x7e, 0x70, 0x8f, 0x89, 0x92, 0x89, 0x5c, 0x04,
+	0x92, 0x7d, 0xa6, 0xd5, 0x80, 0xa4, 0x92, 0xd5, 0x4d, 0x70, 0xde, 0x4f, 0xa8, 0x24, 0xfd, 0x20,
+	0xa3, 0x15, 0x15, 0xb9, 0xf0, 0x79, 0xcd, 0x24, 0x43, 0x8f, 0x0d, 0xeb, 0xff, 0xcf, 0xfa, 0x86,
+	0xed, 0x3d, 0xc8, 0x58, 0xc6, 0x14, 0x18, 0x4c, 0x7f, 0x69, 0x4d, 0xef, 0xa0, 0xd5, 0x9f, 0x0c,
+	0xe5, 0x19, 0xab, 0x73, 0xd9, 0x7c, 0xa0, 0x92, 0x9c, 0x12, 0x49, 0x8c, 0xea, 0x45, 0xab, 0x8a,
+	0x93, 0x9a, 0x94, 0xa6, 0x54, 0x6f, 0xbf, 0x15, 0x15, 0x43, 0xce, 0x8b, 0x26, 0x4e, 0x09, 0x37,
+	0x78, 0xd8, 0x8a, 0xcb, 0x9a, 0x54, 0x62, 0x40, 0xeb, 0x98, 0xb3, 0x22, 0x4f, 0x1b, 0xad, 0xf1,
+	0x7e, 0x01, 0xb8, 0xf1, 0x5e, 0xbf, 0xc4, 0x89, 0x24, 0x92, 0x22, 0x0c, 0x57, 0x75, 0x07, 0x1b,
+	0xec, 0x80, 0xdd, 0xf5, 0xf0, 0x99, 0xdf, 0xf6, 0x32, 0xfe, 0xb1, 0x62, 0x71, 0xf7, 0x62, 0xe4,
+	0x5a, 0x91, 0x51, 0x22, 0x0e, 0x37, 0x0d, 0x17, 0x9f, 0xd2, 0x8a, 0x95, 0xc2, 0x5e, 0xd9, 0xe9,
+	0xec, 0xae, 0x87, 0x7b, 0xed, 0x5e, 0xa6, 0xc7, 0xd1, 0x54, 0x82, 0x9f, 0x4c, 0x1d, 0xaf, 0x46,
+	0xee, 0xc3, 0x86, 0x94, 0xc5, 0xa1, 0x37, 0xeb, 0xe7, 0x45, 0x77, 0xcd, 0xc1, 0x91, 0xfe, 0xfe,
+	0xd9, 0xb9, 0xb9, 0x86, 0x3a, 0x41, 0xcf, 0xe1, 0x1d, 0x85, 0xaa, 0x5b, 0xac, 0xe1, 0xfb, 0x57,
+	0x23, 0x77, 0x43, 0x3b, 0xa9, 0x63, 0x2f, 0xd2, 0x7f, 0xa3, 0x6f, 0x00, 0xa2, 0x9b, 0x49, 0xc5,
+	0xa5, 0x19, 0x95, 0xbd, 0xa2, 0xee, 0x7e, 0xd0, 0xde, 0x57, 0x25, 0xbd, 0x9d, 0x1f, 0x33, 0x7e,
+	0x6a, 0x9a, 0x6f, 0xeb, 0xbc, 0x45, 0x77, 0x2f, 0xda, 0x5a, 0x58, 0x0e, 0xf4, 0x15, 0xde, 0x9b,
+	0x9b, 0x90, 0xdd, 0x51, 0x25, 0xfa, 0x4b, 0x94, 0xf8, 0x68, 0x94, 0xc7, 0x4a, 0x88, 0x1d, 0xd3,
+	0xe0, 0x91, 0x6e, 0x30, 0xe7, 0xeb, 0x45, 0x9b, 0x72, 0x86, 0x47, 0x03, 0x08, 0x6f, 0x97, 0xc9,
+	0xee, 0xaa, 0xd8, 0x97, 0x4b, 0xc4, 0x9e, 0x28, 0xd1, 0x3b, 0xc2, 0xf1, 0xb6, 0x49, 0xdc, 0xd2,
+	0x89, 0xb7, 0x6e, 0x5e, 0xb4, 0x26, 0xae, 0xa9, 0xc3, 0xee, 0xdf, 0x1f, 0x2e, 0xc0, 0xd1, 0xc5,
+	0xd8, 0x01, 0x97, 0x63, 0x07, 0xfc, 0x19, 0x3b, 0xe0, 0xfb, 0xc4, 0xb1, 0x2e, 0x27, 0x8e, 0xf5,
+	0x7b, 0xe2, 0x58, 0x9f, 0x5e, 0x67, 0xb9, 0x3c, 0x1b, 0x26, 0x7e, 0xca, 0xca, 0xc0, 0xa4, 0xef,
+	0x17, 0x24, 0x11, 0xd7, 0x1f, 0xc1, 0x79, 0xf8, 0x26, 0xf8, 0x32, 0xbb, 0xde, 0xb2, 0xe1, 0x54,
+	0x24, 0xab, 0x6a, 0x9b, 0x5f, 0xfd, 0x1b, 0x00, 0x52, 0x09, 0xab, 0x7f, 0xf3, 0x03, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -193,6 +216,12 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if !this.TransferPolicy.Equal(&that1.TransferPolicy) {
+		return false
+	}
+	if !this.SupplyCap.Equal(&that1.SupplyCap) {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -262,6 +291,26 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.SupplyCap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TransferPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -322,6 +371,10 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TransferPolicy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SupplyCap.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -542,6 +595,72 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -30,6 +30,8 @@ var (
 	CreatorPrefixKey               = "creator"
 	AdminPrefixKey                 = "admin"
 	BeforeSendHookAddressPrefixKey = "beforesendhook"
+	TransferPolicyKey              = "transferpolicy"
+	SupplyCapKey                   = "supplycap"
 )
 
 // GetDenomPrefixStore returns the store prefix where all the data associated with a specific denom
@@ -11,13 +11,16 @@ import (
 
 // constants
 const (
-	TypeMsgCreateDenom       = "create_denom"
-	TypeMsgMint              = "tf_mint"
-	TypeMsgBurn              = "tf_burn"
-	TypeMsgForceTransfer     = "force_transfer"
-	TypeMsgChangeAdmin       = "change_admin"
-	TypeMsgSetDenomMetadata  = "set_denom_metadata"
-	TypeMsgSetBeforeSendHook = "set_before_send_hook"
+	TypeMsgCreateDenom            = "create_denom"
+	TypeMsgMint                   = "tf_mint"
+	TypeMsgBurn                   = "tf_burn"
+	TypeMsgForceTransfer          = "force_transfer"
+	TypeMsgChangeAdmin            = "change_admin"
+	TypeMsgSetDenomMetadata       = "set_denom_metadata"
+	TypeMsgSetBeforeSendHook      = "set_before_send_hook"
+	TypeMsgSetDenomTransferPolicy = "set_denom_transfer_policy"
+	TypeMsgSetDenomSupplyCap      = "set_denom_supply_cap"
+	TypeMsgRenounceMint           = "renounce_mint"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -280,3 +283,102 @@ func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetDenomTransferPolicy{}
+
+// NewMsgSetDenomTransferPolicy creates a message to set the transfer policy of a denom
+func NewMsgSetDenomTransferPolicy(sender, denom string, transferPolicy DenomTransferPolicy) *MsgSetDenomTransferPolicy {
+	return &MsgSetDenomTransferPolicy{
+		Sender:         sender,
+		Denom:          denom,
+		TransferPolicy: transferPolicy,
+	}
+}
+
+func (m MsgSetDenomTransferPolicy) Route() string { return RouterKey }
+func (m MsgSetDenomTransferPolicy) Type() string  { return TypeMsgSetDenomTransferPolicy }
+func (m MsgSetDenomTransferPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return m.TransferPolicy.Validate()
+}
+
+func (m MsgSetDenomTransferPolicy) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetDenomSupplyCap{}
+
+// NewMsgSetDenomSupplyCap creates a message to set the supply cap of a denom
+func NewMsgSetDenomSupplyCap(sender, denom string, supplyCap DenomSupplyCap) *MsgSetDenomSupplyCap {
+	return &MsgSetDenomSupplyCap{
+		Sender:    sender,
+		Denom:     denom,
+		SupplyCap: supplyCap,
+	}
+}
+
+func (m MsgSetDenomSupplyCap) Route() string { return RouterKey }
+func (m MsgSetDenomSupplyCap) Type() string  { return TypeMsgSetDenomSupplyCap }
+func (m MsgSetDenomSupplyCap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if !m.SupplyCap.HasMaxSupply() {
+		return errorsmod.Wrap(ErrInvalidSupplyCap, "max supply must be positive")
+	}
+
+	return m.SupplyCap.Validate()
+}
+
+func (m MsgSetDenomSupplyCap) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRenounceMint{}
+
+// NewMsgRenounceMint creates a message to renounce minting a denom
+func NewMsgRenounceMint(sender, denom string) *MsgRenounceMint {
+	return &MsgRenounceMint{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgRenounceMint) Route() string { return RouterKey }
+func (m MsgRenounceMint) Type() string  { return TypeMsgRenounceMint }
+func (m MsgRenounceMint) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgRenounceMint) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -55,6 +55,36 @@ func TestAuthzMsg(t *testing.T) {
 				NewAdmin: "osmo1q8tq5qhrhw6t970egemuuwywhlhpnmdmts6xnu",
 			},
 		},
+		{
+			name: "MsgSetDenomTransferPolicy",
+			msg: &types.MsgSetDenomTransferPolicy{
+				Sender: addr1,
+				Denom:  "denom",
+				TransferPolicy: types.DenomTransferPolicy{
+					Frozen:     true,
+					Denylist:   []string{"osmo1q8tq5qhrhw6t970egemuuwywhlhpnmdmts6xnu"},
+					MaxBalance: osmomath.NewInt(100),
+				},
+			},
+		},
+		{
+			name: "MsgSetDenomSupplyCap",
+			msg: &types.MsgSetDenomSupplyCap{
+				Sender: addr1,
+				Denom:  "denom",
+				SupplyCap: types.DenomSupplyCap{
+					MaxSupply: osmomath.NewInt(100),
+					Immutable: true,
+				},
+			},
+		},
+		{
+			name: "MsgRenounceMint",
+			msg: &types.MsgRenounceMint{
+				Sender: addr1,
+				Denom:  "denom",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -481,6 +482,203 @@ func (m *QueryAllBeforeSendHooksAddressesResponse) GetBeforeSendHookAddresses()
 	return nil
 }
 
+// QueryDenomTransferPolicyRequest defines the request structure for the
+// DenomTransferPolicy gRPC query.
+type QueryDenomTransferPolicyRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryDenomTransferPolicyRequest) Reset()         { *m = QueryDenomTransferPolicyRequest{} }
+func (m *QueryDenomTransferPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomTransferPolicyRequest) ProtoMessage()    {}
+func (*QueryDenomTransferPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{10}
+}
+func (m *QueryDenomTransferPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomTransferPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomTransferPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomTransferPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomTransferPolicyRequest.Merge(m, src)
+}
+func (m *QueryDenomTransferPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomTransferPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomTransferPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomTransferPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryDenomTransferPolicyRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomTransferPolicyResponse defines the response structure for the
+// DenomTransferPolicy gRPC query.
+type QueryDenomTransferPolicyResponse struct {
+	TransferPolicy DenomTransferPolicy `protobuf:"bytes,1,opt,name=transfer_policy,json=transferPolicy,proto3" json:"transfer_policy" yaml:"transfer_policy"`
+}
+
+func (m *QueryDenomTransferPolicyResponse) Reset()         { *m = QueryDenomTransferPolicyResponse{} }
+func (m *QueryDenomTransferPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomTransferPolicyResponse) ProtoMessage()    {}
+func (*QueryDenomTransferPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{11}
+}
+func (m *QueryDenomTransferPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomTransferPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomTransferPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomTransferPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomTransferPolicyResponse.Merge(m, src)
+}
+func (m *QueryDenomTransferPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomTransferPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomTransferPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomTransferPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryDenomTransferPolicyResponse) GetTransferPolicy() DenomTransferPolicy {
+	if m != nil {
+		return m.TransferPolicy
+	}
+	return DenomTransferPolicy{}
+}
+
+// QueryRemainingMintableSupplyRequest defines the request structure for the
+// RemainingMintableSupply gRPC query.
+type QueryRemainingMintableSupplyRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryRemainingMintableSupplyRequest) Reset()         { *m = QueryRemainingMintableSupplyRequest{} }
+func (m *QueryRemainingMintableSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingMintableSupplyRequest) ProtoMessage()    {}
+func (*QueryRemainingMintableSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{12}
+}
+func (m *QueryRemainingMintableSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainingMintableSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingMintableSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemainingMintableSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingMintableSupplyRequest.Merge(m, src)
+}
+func (m *QueryRemainingMintableSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingMintableSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingMintableSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRemainingMintableSupplyRequest proto.InternalMessageInfo
+
+func (m *QueryRemainingMintableSupplyRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryRemainingMintableSupplyResponse defines the response structure for the
+// RemainingMintableSupply gRPC query.
+type QueryRemainingMintableSupplyResponse struct {
+	SupplyCap DenomSupplyCap `protobuf:"bytes,1,opt,name=supply_cap,json=supplyCap,proto3" json:"supply_cap" yaml:"supply_cap"`
+	// unlimited is true if the denom can be minted without limit, that is when it
+	// has no supply cap and minting was not renounced.
+	Unlimited bool `protobuf:"varint,2,opt,name=unlimited,proto3" json:"unlimited,omitempty" yaml:"unlimited"`
+	// remaining_mintable_supply is the amount of the denom that can still be
+	// minted. It is zero when unlimited is true.
+	RemainingMintableSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remaining_mintable_supply,json=remainingMintableSupply,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_mintable_supply" yaml:"remaining_mintable_supply"`
+}
+
+func (m *QueryRemainingMintableSupplyResponse) Reset()         { *m = QueryRemainingMintableSupplyResponse{} }
+func (m *QueryRemainingMintableSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingMintableSupplyResponse) ProtoMessage()    {}
+func (*QueryRemainingMintableSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{13}
+}
+func (m *QueryRemainingMintableSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainingMintableSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingMintableSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemainingMintableSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingMintableSupplyResponse.Merge(m, src)
+}
+func (m *QueryRemainingMintableSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingMintableSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingMintableSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRemainingMintableSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryRemainingMintableSupplyResponse) GetSupplyCap() DenomSupplyCap {
+	if m != nil {
+		return m.SupplyCap
+	}
+	return DenomSupplyCap{}
+}
+
+func (m *QueryRemainingMintableSupplyResponse) GetUnlimited() bool {
+	if m != nil {
+		return m.Unlimited
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryParamsResponse")
@@ -492,6 +690,10 @@ func init() {
 	proto.RegisterType((*QueryBeforeSendHookAddressResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryBeforeSendHookAddressResponse")
 	proto.RegisterType((*QueryAllBeforeSendHooksAddressesRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryAllBeforeSendHooksAddressesRequest")
 	proto.RegisterType((*QueryAllBeforeSendHooksAddressesResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryAllBeforeSendHooksAddressesResponse")
+	proto.RegisterType((*QueryDenomTransferPolicyRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomTransferPolicyRequest")
+	proto.RegisterType((*QueryDenomTransferPolicyResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomTransferPolicyResponse")
+	proto.RegisterType((*QueryRemainingMintableSupplyRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryRemainingMintableSupplyRequest")
+	proto.RegisterType((*QueryRemainingMintableSupplyResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryRemainingMintableSupplyResponse")
 }
 
 func init() {
@@ -499,55 +701,73 @@ func init() {
 }
 
 var fileDescriptor_6f22013ad0f72e3f = []byte{
-	// 767 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x4f, 0x13, 0x4d,
-	0x18, 0xee, 0xf2, 0x7d, 0xf4, 0xfb, 0x18, 0x7f, 0xc1, 0x88, 0x82, 0x0b, 0x76, 0x61, 0x24, 0x58,
-	0x0c, 0x76, 0xe5, 0x87, 0x89, 0x8a, 0x04, 0xba, 0x08, 0x9a, 0x20, 0x89, 0xae, 0x27, 0x4d, 0xcc,
-	0x66, 0xb6, 0x1d, 0x4a, 0xc3, 0xee, 0x4e, 0xd9, 0x99, 0xa2, 0x0d, 0xe1, 0xe2, 0xc1, 0xb3, 0x89,
-	0x47, 0xff, 0x07, 0x4f, 0xfc, 0x11, 0x1c, 0x49, 0xb8, 0x78, 0xda, 0x28, 0x18, 0x13, 0xaf, 0xfd,
-	0x0b, 0x4c, 0x67, 0xa7, 0x15, 0xda, 0xb2, 0xb4, 0x70, 0xea, 0x66, 0xe6, 0x79, 0x9f, 0xf7, 0x79,
-	0xde, 0x77, 0xde, 0x37, 0x05, 0x49, 0xca, 0x5c, 0xca, 0xf2, 0x4c, 0xe7, 0x74, 0x9d, 0x78, 0xab,
-	0x38, 0xc3, 0xa9, 0x5f, 0xd2, 0x37, 0x27, 0x6c, 0xc2, 0xf1, 0x84, 0xbe, 0x51, 0x24, 0x7e, 0x29,
-	0x55, 0xf0, 0x29, 0xa7, 0x70, 0x50, 0x22, 0x53, 0x47, 0x91, 0x29, 0x89, 0x54, 0x7b, 0x73, 0x34,
-	0x47, 0x05, 0x50, 0xaf, 0x7c, 0x85, 0x31, 0xea, 0x60, 0x8e, 0xd2, 0x9c, 0x43, 0x74, 0x5c, 0xc8,
-	0xeb, 0xd8, 0xf3, 0x28, 0xc7, 0x3c, 0x4f, 0x3d, 0x26, 0x6f, 0xef, 0x64, 0x04, 0xa5, 0x6e, 0x63,
-	0x46, 0xc2, 0x54, 0xb5, 0xc4, 0x05, 0x9c, 0xcb, 0x7b, 0x02, 0x2c, 0xb1, 0xd3, 0x91, 0x3a, 0x71,
-	0x91, 0xaf, 0x51, 0x3f, 0xcf, 0x4b, 0x2b, 0x84, 0xe3, 0x2c, 0xe6, 0x58, 0x46, 0x8d, 0x45, 0x46,
-	0x15, 0xb0, 0x8f, 0x5d, 0x29, 0x06, 0xf5, 0x02, 0xf8, 0xb2, 0x22, 0xe1, 0x85, 0x38, 0x34, 0xc9,
-	0x46, 0x91, 0x30, 0x8e, 0x5e, 0x83, 0xab, 0xc7, 0x4e, 0x59, 0x81, 0x7a, 0x8c, 0x40, 0x03, 0xc4,
-	0xc3, 0xe0, 0x7e, 0x65, 0x48, 0x49, 0x5e, 0x98, 0x1c, 0x49, 0x45, 0x15, 0x27, 0x15, 0x46, 0x1b,
-	0xff, 0xee, 0x06, 0x5a, 0xcc, 0x94, 0x91, 0xe8, 0x39, 0x40, 0x82, 0xfa, 0x09, 0xf1, 0xa8, 0x9b,
-	0xae, 0x37, 0x20, 0x05, 0xc0, 0x51, 0xd0, 0x99, 0xad, 0x00, 0x44, 0xa2, 0x2e, 0xa3, 0xbb, 0x1c,
-	0x68, 0x17, 0x4b, 0xd8, 0x75, 0x1e, 0x21, 0x71, 0x8c, 0xcc, 0xf0, 0x1a, 0x7d, 0x55, 0xc0, 0xad,
-	0x48, 0x3a, 0xa9, 0xfc, 0xa3, 0x02, 0x60, 0xad, 0x5a, 0x96, 0x2b, 0xaf, 0xa5, 0x8d, 0xe9, 0x68,
-	0x1b, 0xcd, 0xa9, 0x8d, 0xe1, 0x8a, 0xad, 0x72, 0xa0, 0xdd, 0x08, 0x75, 0x35, 0xb2, 0x23, 0xb3,
-	0xa7, 0xa1, 0x41, 0x68, 0x05, 0xdc, 0xfc, 0xab, 0x97, 0x2d, 0xf9, 0xd4, 0x5d, 0xf0, 0x09, 0xe6,
-	0xd4, 0xaf, 0x3a, 0x1f, 0x07, 0xff, 0x65, 0xc2, 0x13, 0xe9, 0x1d, 0x96, 0x03, 0xed, 0x72, 0x98,
-	0x43, 0x5e, 0x20, 0xb3, 0x0a, 0x41, 0xcb, 0x20, 0x71, 0x12, 0x9d, 0x74, 0x3e, 0x06, 0xe2, 0xa2,
-	0x54, 0x95, 0x9e, 0xfd, 0x93, 0xec, 0x32, 0x7a, 0xca, 0x81, 0x76, 0xe9, 0x48, 0x29, 0x19, 0x32,
-	0x25, 0x00, 0x2d, 0x83, 0x61, 0x41, 0x66, 0x90, 0x55, 0xea, 0x93, 0x57, 0xc4, 0xcb, 0x3e, 0xa3,
-	0x74, 0x3d, 0x9d, 0xcd, 0xfa, 0x84, 0xb1, 0x76, 0x3b, 0xe3, 0xc8, 0x3e, 0x9f, 0x40, 0x26, 0xd5,
-	0x2d, 0x81, 0xee, 0xca, 0x34, 0xbc, 0xc3, 0xcc, 0xb5, 0x70, 0x78, 0x27, 0x89, 0x07, 0xca, 0x81,
-	0xd6, 0x27, 0x6d, 0xd7, 0x21, 0x90, 0x79, 0xa5, 0x7a, 0x24, 0xf9, 0xd0, 0x18, 0xb8, 0x2d, 0xb2,
-	0xa5, 0x1d, 0xe7, 0x78, 0x42, 0x26, 0x11, 0xa4, 0xf6, 0xb6, 0x77, 0x14, 0x90, 0x3c, 0x1d, 0xdb,
-	0x76, 0xf5, 0xe0, 0x5b, 0xa0, 0xda, 0x82, 0xce, 0x62, 0xc4, 0xcb, 0x5a, 0x6b, 0x94, 0xae, 0x57,
-	0x05, 0x13, 0xd6, 0xdf, 0x21, 0xc2, 0x87, 0xca, 0x81, 0x36, 0x18, 0x86, 0x1f, 0xc5, 0xd6, 0x60,
-	0xc8, 0xec, 0xb3, 0x9b, 0xd5, 0x8b, 0xb0, 0xc9, 0x9d, 0xff, 0x41, 0xa7, 0x90, 0x0d, 0xbf, 0x28,
-	0x20, 0x1e, 0x8e, 0x16, 0xbc, 0x17, 0xfd, 0x72, 0x1b, 0x27, 0x5b, 0x9d, 0x68, 0x23, 0x22, 0xac,
-	0x01, 0x1a, 0xff, 0xb0, 0xff, 0xf3, 0x73, 0xc7, 0x28, 0x1c, 0xd1, 0x5b, 0x58, 0x2b, 0xf0, 0x97,
-	0x02, 0xae, 0x37, 0x9f, 0x18, 0x38, 0xdf, 0x42, 0xee, 0xc8, 0xb5, 0xa0, 0xa6, 0xcf, 0xc1, 0x20,
-	0xdd, 0x3c, 0x15, 0x6e, 0xd2, 0x70, 0x2e, 0xda, 0x4d, 0xd8, 0x54, 0x7d, 0x4b, 0xfc, 0x6e, 0xeb,
-	0x8d, 0xd3, 0x0d, 0xf7, 0x15, 0xd0, 0xd3, 0x30, 0x76, 0x70, 0xa6, 0x55, 0x85, 0x4d, 0x66, 0x5f,
-	0x7d, 0x7c, 0xb6, 0x60, 0xe9, 0x6c, 0x41, 0x38, 0x9b, 0x85, 0x33, 0xad, 0x38, 0xb3, 0x56, 0x7d,
-	0xea, 0x5a, 0x72, 0x8d, 0xe8, 0x5b, 0xf2, 0x63, 0x1b, 0xfe, 0x50, 0xc0, 0xb5, 0xa6, 0x23, 0x0b,
-	0xe7, 0x5a, 0x10, 0x17, 0xb5, 0x39, 0xd4, 0xf9, 0xb3, 0x13, 0x48, 0x87, 0x8b, 0xc2, 0xe1, 0x1c,
-	0x9c, 0x6d, 0xab, 0x77, 0xf5, 0x53, 0x09, 0x7f, 0x2b, 0x60, 0x20, 0x62, 0xf8, 0xe1, 0x62, 0x0b,
-	0x42, 0x4f, 0x5f, 0x34, 0xea, 0xd2, 0x79, 0x69, 0xa4, 0xeb, 0x19, 0xe1, 0xfa, 0x3e, 0x9c, 0x8a,
-	0x76, 0x8d, 0x1d, 0xc7, 0xaa, 0xb7, 0xca, 0x0c, 0x73, 0xf7, 0x20, 0xa1, 0xec, 0x1d, 0x24, 0x94,
-	0xef, 0x07, 0x09, 0xe5, 0xd3, 0x61, 0x22, 0xb6, 0x77, 0x98, 0x88, 0x7d, 0x3b, 0x4c, 0xc4, 0xde,
-	0x3c, 0xc8, 0xe5, 0xf9, 0x5a, 0xd1, 0x4e, 0x65, 0xa8, 0x5b, 0x25, 0xbe, 0xeb, 0x60, 0x9b, 0xd5,
-	0xb2, 0x6c, 0x4e, 0x3e, 0xd4, 0xdf, 0x1f, 0xcf, 0xc5, 0x4b, 0x05, 0xc2, 0xec, 0xb8, 0xf8, 0xeb,
-	0x30, 0xf5, 0x27, 0x00, 0x00, 0xff, 0xff, 0x24, 0x1b, 0x76, 0x61, 0x45, 0x09, 0x00, 0x00,
+	// 1049 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x1c, 0xcd, 0xa6, 0x34, 0x90, 0xa1, 0xb4, 0xc9, 0x34, 0x6d, 0x92, 0x6d, 0xf0, 0xba, 0x43, 0x54,
+	0x1c, 0x94, 0x7a, 0x89, 0x5b, 0x24, 0x20, 0x4d, 0x53, 0x6f, 0xda, 0xd0, 0xaa, 0x04, 0x95, 0x2d,
+	0x17, 0x90, 0xd0, 0x6a, 0x6c, 0x8f, 0x9d, 0x55, 0x76, 0x77, 0xb6, 0x3b, 0xe3, 0x82, 0xa9, 0x72,
+	0x41, 0x88, 0x33, 0x12, 0x47, 0x24, 0x3e, 0x02, 0x27, 0xbe, 0x00, 0xb7, 0x1e, 0x2b, 0xf5, 0x82,
+	0x7a, 0x58, 0x41, 0x82, 0x90, 0xb8, 0x21, 0x7f, 0x82, 0xca, 0x33, 0x63, 0x3b, 0xfe, 0xb7, 0x5d,
+	0x27, 0xa7, 0x58, 0x33, 0xef, 0xf7, 0x7e, 0xef, 0xfd, 0x66, 0x67, 0x9e, 0x02, 0x72, 0x94, 0xf9,
+	0x94, 0xb9, 0xcc, 0xe4, 0x74, 0x8f, 0x04, 0x55, 0x5c, 0xe6, 0x34, 0x6a, 0x98, 0x8f, 0xd7, 0x4a,
+	0x84, 0xe3, 0x35, 0xf3, 0x51, 0x9d, 0x44, 0x8d, 0x7c, 0x18, 0x51, 0x4e, 0xe1, 0x92, 0x42, 0xe6,
+	0x8f, 0x22, 0xf3, 0x0a, 0xa9, 0xcf, 0xd5, 0x68, 0x8d, 0x0a, 0xa0, 0xd9, 0xfa, 0x25, 0x6b, 0xf4,
+	0xa5, 0x1a, 0xa5, 0x35, 0x8f, 0x98, 0x38, 0x74, 0x4d, 0x1c, 0x04, 0x94, 0x63, 0xee, 0xd2, 0x80,
+	0xa9, 0xdd, 0xf7, 0xca, 0x82, 0xd2, 0x2c, 0x61, 0x46, 0x64, 0xab, 0x4e, 0xe3, 0x10, 0xd7, 0xdc,
+	0x40, 0x80, 0x15, 0xf6, 0x7a, 0xa2, 0x4e, 0x5c, 0xe7, 0xbb, 0x34, 0x72, 0x79, 0x63, 0x87, 0x70,
+	0x5c, 0xc1, 0x1c, 0xab, 0xaa, 0x95, 0xc4, 0xaa, 0x10, 0x47, 0xd8, 0x6f, 0x8b, 0xb9, 0x9a, 0x08,
+	0x65, 0xf5, 0x30, 0xf4, 0x1a, 0x4e, 0x19, 0x87, 0x0a, 0x5e, 0x48, 0x84, 0xf3, 0x08, 0x07, 0xac,
+	0x4a, 0x22, 0x27, 0xa4, 0x9e, 0x5b, 0x56, 0x13, 0x44, 0x73, 0x00, 0x7e, 0xde, 0x72, 0xf9, 0x40,
+	0xf4, 0xb5, 0xc9, 0xa3, 0x3a, 0x61, 0x1c, 0x7d, 0x09, 0xce, 0xf7, 0xac, 0xb2, 0x90, 0x06, 0x8c,
+	0x40, 0x0b, 0x4c, 0x49, 0x7d, 0x0b, 0x5a, 0x56, 0xcb, 0xbd, 0x59, 0x58, 0xce, 0x27, 0xcd, 0x3f,
+	0x2f, 0xab, 0xad, 0xd7, 0x9e, 0xc6, 0xc6, 0x84, 0xad, 0x2a, 0xd1, 0xa7, 0x00, 0x09, 0xea, 0xdb,
+	0x24, 0xa0, 0x7e, 0xb1, 0x7f, 0x46, 0x4a, 0x00, 0xbc, 0x02, 0x4e, 0x57, 0x5a, 0x00, 0xd1, 0x68,
+	0xda, 0x9a, 0x69, 0xc6, 0xc6, 0x99, 0x06, 0xf6, 0xbd, 0x8f, 0x91, 0x58, 0x46, 0xb6, 0xdc, 0x46,
+	0xbf, 0x69, 0xe0, 0x9d, 0x44, 0x3a, 0xa5, 0xfc, 0x47, 0x0d, 0xc0, 0xce, 0x81, 0x38, 0xbe, 0xda,
+	0x56, 0x36, 0xae, 0x27, 0xdb, 0x18, 0x4e, 0x6d, 0x5d, 0x6e, 0xd9, 0x6a, 0xc6, 0xc6, 0xa2, 0xd4,
+	0x35, 0xc8, 0x8e, 0xec, 0xd9, 0x81, 0x6f, 0x00, 0xed, 0x80, 0xb7, 0xbb, 0x7a, 0xd9, 0x76, 0x44,
+	0xfd, 0xad, 0x88, 0x60, 0x4e, 0xa3, 0xb6, 0xf3, 0x55, 0xf0, 0x7a, 0x59, 0xae, 0x28, 0xef, 0xb0,
+	0x19, 0x1b, 0x67, 0x65, 0x0f, 0xb5, 0x81, 0xec, 0x36, 0x04, 0xdd, 0x07, 0x99, 0x51, 0x74, 0xca,
+	0xf9, 0x0a, 0x98, 0x12, 0xa3, 0x6a, 0x9d, 0xd9, 0xa9, 0xdc, 0xb4, 0x35, 0xdb, 0x8c, 0x8d, 0xb7,
+	0x8e, 0x8c, 0x92, 0x21, 0x5b, 0x01, 0xd0, 0x7d, 0x70, 0x59, 0x90, 0x59, 0xa4, 0x4a, 0x23, 0xf2,
+	0x90, 0x04, 0x95, 0xbb, 0x94, 0xee, 0x15, 0x2b, 0x95, 0x88, 0x30, 0x36, 0xee, 0xc9, 0x78, 0x00,
+	0x25, 0x91, 0x29, 0x75, 0xdb, 0x60, 0xa6, 0x75, 0xe1, 0xbe, 0xc1, 0xcc, 0x77, 0xb0, 0xdc, 0x53,
+	0xc4, 0x97, 0x9a, 0xb1, 0x31, 0xaf, 0x6c, 0xf7, 0x21, 0x90, 0x7d, 0xae, 0xbd, 0xa4, 0xf8, 0xd0,
+	0x0a, 0x78, 0x57, 0x74, 0x2b, 0x7a, 0x5e, 0x6f, 0x43, 0xa6, 0x10, 0xa4, 0xf3, 0x6d, 0xff, 0xae,
+	0x81, 0xdc, 0xab, 0xb1, 0x63, 0x4f, 0x0f, 0x7e, 0x0d, 0xf4, 0x92, 0xa0, 0x73, 0x18, 0x09, 0x2a,
+	0xce, 0x2e, 0xa5, 0x7b, 0x6d, 0xc1, 0x84, 0x2d, 0x4c, 0x8a, 0xf2, 0x6c, 0x33, 0x36, 0x96, 0x64,
+	0xf9, 0x51, 0x6c, 0x07, 0x86, 0xec, 0xf9, 0xd2, 0xb0, 0x79, 0x11, 0x86, 0xee, 0x01, 0xa3, 0x7b,
+	0xd2, 0x5f, 0xa8, 0xbb, 0xfc, 0x40, 0x5c, 0xe5, 0x71, 0x8f, 0xe6, 0x57, 0x0d, 0x64, 0x47, 0x73,
+	0x29, 0xe7, 0xdf, 0x81, 0x73, 0x7d, 0x2f, 0x86, 0xba, 0x2d, 0x6b, 0x29, 0x6e, 0x4b, 0x2f, 0xa7,
+	0x95, 0x51, 0x57, 0xe5, 0xa2, 0x54, 0xd3, 0xc7, 0x8b, 0xec, 0xb3, 0xbc, 0x07, 0x8f, 0x76, 0xd4,
+	0xa5, 0xb6, 0x89, 0x8f, 0xdd, 0xc0, 0x0d, 0x6a, 0x3b, 0x6e, 0xc0, 0x71, 0xc9, 0x23, 0x0f, 0xc5,
+	0x93, 0x37, 0xae, 0xdf, 0x3f, 0x26, 0xc1, 0x72, 0x32, 0x9f, 0xf2, 0x5c, 0x05, 0xa0, 0xfb, 0xa8,
+	0x2a, 0xbb, 0xab, 0x29, 0xec, 0x4a, 0x9a, 0x2d, 0x1c, 0x5a, 0x8b, 0xca, 0xe9, 0xac, 0xd4, 0xd1,
+	0x65, 0x43, 0xf6, 0x34, 0x6b, 0xa3, 0x60, 0x01, 0x4c, 0xd7, 0x03, 0xcf, 0xf5, 0x5d, 0x4e, 0x2a,
+	0x0b, 0x93, 0x59, 0x2d, 0xf7, 0x86, 0x35, 0xd7, 0x8c, 0x8d, 0x19, 0x59, 0xd4, 0xd9, 0x42, 0x76,
+	0x17, 0x06, 0xf7, 0xc1, 0x62, 0xd4, 0x96, 0xef, 0xf8, 0x4a, 0xbf, 0x23, 0x39, 0x17, 0x4e, 0x89,
+	0x01, 0x14, 0x5b, 0xcd, 0x5f, 0xc4, 0xc6, 0x05, 0x99, 0x61, 0xac, 0xb2, 0x97, 0x77, 0xa9, 0xe9,
+	0x63, 0xbe, 0x9b, 0xbf, 0x17, 0xf0, 0x66, 0x6c, 0x64, 0x65, 0x83, 0x91, 0x3c, 0xc8, 0x9e, 0x8f,
+	0x86, 0x8f, 0xa8, 0xf0, 0xc3, 0x19, 0x70, 0x5a, 0xcc, 0x10, 0xfe, 0xa2, 0x81, 0x29, 0xf9, 0xb2,
+	0xc3, 0xf7, 0x93, 0x67, 0x33, 0x18, 0x2c, 0xfa, 0xda, 0x18, 0x15, 0xf2, 0x50, 0xd0, 0xea, 0xf7,
+	0xcf, 0xff, 0xf9, 0x79, 0xf2, 0x0a, 0x5c, 0x36, 0x53, 0x04, 0x27, 0xfc, 0x57, 0x03, 0x17, 0x87,
+	0x3f, 0xd8, 0xf0, 0x56, 0x8a, 0xde, 0x89, 0xa9, 0xa4, 0x17, 0x4f, 0xc0, 0xa0, 0xdc, 0x7c, 0x22,
+	0xdc, 0x14, 0xe1, 0x66, 0xb2, 0x1b, 0xf9, 0xa6, 0x98, 0x4f, 0xc4, 0xdf, 0x7d, 0x73, 0x30, 0x5c,
+	0xe0, 0x73, 0x0d, 0xcc, 0x0e, 0xbc, 0xfa, 0x70, 0x3d, 0xad, 0xc2, 0x21, 0xd1, 0xa3, 0xdf, 0x38,
+	0x5e, 0xb1, 0x72, 0xb6, 0x25, 0x9c, 0x6d, 0xc0, 0xf5, 0x34, 0xce, 0x9c, 0x6a, 0x44, 0x7d, 0x47,
+	0xa5, 0x98, 0xf9, 0x44, 0xfd, 0xd8, 0x87, 0x7f, 0x6b, 0xe0, 0xc2, 0xd0, 0xc4, 0x80, 0x9b, 0x29,
+	0xc4, 0x25, 0x05, 0x97, 0x7e, 0xeb, 0xf8, 0x04, 0xca, 0xe1, 0x1d, 0xe1, 0x70, 0x13, 0x6e, 0x8c,
+	0x75, 0x76, 0xfd, 0xa1, 0x00, 0xff, 0xd3, 0xc0, 0xa5, 0x84, 0xec, 0x81, 0x77, 0x52, 0x08, 0x7d,
+	0x75, 0xce, 0xe9, 0xdb, 0x27, 0xa5, 0x51, 0xae, 0xd7, 0x85, 0xeb, 0x0f, 0xe0, 0xb5, 0x64, 0xd7,
+	0xd8, 0xf3, 0x9c, 0x7e, 0xab, 0x0c, 0xbe, 0xd0, 0xc0, 0xf9, 0x21, 0x89, 0x00, 0x37, 0xd2, 0x7e,
+	0x6a, 0x43, 0x93, 0x4e, 0xbf, 0x79, 0xdc, 0x72, 0xe5, 0xe9, 0xb6, 0xf0, 0x74, 0x13, 0xde, 0x18,
+	0xeb, 0x24, 0xfb, 0x72, 0x0b, 0xfe, 0xaf, 0x81, 0xf9, 0x11, 0x91, 0x02, 0xd3, 0x3c, 0x15, 0xc9,
+	0xf1, 0xa6, 0x5b, 0x27, 0xa1, 0x50, 0x46, 0x3f, 0x13, 0x46, 0xef, 0xc2, 0xed, 0xb1, 0x8c, 0x8e,
+	0x0c, 0x08, 0xcb, 0x7e, 0x7a, 0x90, 0xd1, 0x9e, 0x1d, 0x64, 0xb4, 0xbf, 0x0e, 0x32, 0xda, 0x4f,
+	0x87, 0x99, 0x89, 0x67, 0x87, 0x99, 0x89, 0x3f, 0x0f, 0x33, 0x13, 0x5f, 0x7d, 0x58, 0x73, 0xf9,
+	0x6e, 0xbd, 0x94, 0x2f, 0x53, 0xbf, 0xdd, 0xeb, 0xaa, 0x87, 0x4b, 0xac, 0xd3, 0xf8, 0x71, 0xe1,
+	0x23, 0xf3, 0xdb, 0xde, 0xf6, 0xbc, 0x11, 0x12, 0x56, 0x9a, 0x12, 0xff, 0x89, 0x5c, 0x7b, 0x39,
+	0x00, 0x9b, 0x33, 0x25, 0x5f, 0xf7, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -580,6 +800,12 @@ type QueryClient interface {
 	// of before send hook addresses. The idx of denom corresponds to before send
 	// hook addresse's idx.
 	AllBeforeSendHooksAddresses(ctx context.Context, in *QueryAllBeforeSendHooksAddressesRequest, opts ...grpc.CallOption) (*QueryAllBeforeSendHooksAddressesResponse, error)
+	// DenomTransferPolicy defines a gRPC query method for fetching the native
+	// transfer restrictions of a particular denom.
+	DenomTransferPolicy(ctx context.Context, in *QueryDenomTransferPolicyRequest, opts ...grpc.CallOption) (*QueryDenomTransferPolicyResponse, error)
+	// RemainingMintableSupply defines a gRPC query method for fetching the
+	// supply cap of a particular denom and the amount that can still be minted.
+	RemainingMintableSupply(ctx context.Context, in *QueryRemainingMintableSupplyRequest, opts ...grpc.CallOption) (*QueryRemainingMintableSupplyResponse, error)
 }
 
 type queryClient struct {
@@ -635,6 +861,24 @@ func (c *queryClient) AllBeforeSendHooksAddresses(ctx context.Context, in *Query
 	return out, nil
 }
 
+func (c *queryClient) DenomTransferPolicy(ctx context.Context, in *QueryDenomTransferPolicyRequest, opts ...grpc.CallOption) (*QueryDenomTransferPolicyResponse, error) {
+	out := new(QueryDenomTransferPolicyResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Query/DenomTransferPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemainingMintableSupply(ctx context.Context, in *QueryRemainingMintableSupplyRequest, opts ...grpc.CallOption) (*QueryRemainingMintableSupplyResponse, error) {
+	out := new(QueryRemainingMintableSupplyResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Query/RemainingMintableSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the tokenfactory module's
@@ -655,6 +899,12 @@ type QueryServer interface {
 	// of before send hook addresses. The idx of denom corresponds to before send
 	// hook addresse's idx.
 	AllBeforeSendHooksAddresses(context.Context, *QueryAllBeforeSendHooksAddressesRequest) (*QueryAllBeforeSendHooksAddressesResponse, error)
+	// DenomTransferPolicy defines a gRPC query method for fetching the native
+	// transfer restrictions of a particular denom.
+	DenomTransferPolicy(context.Context, *QueryDenomTransferPolicyRequest) (*QueryDenomTransferPolicyResponse, error)
+	// RemainingMintableSupply defines a gRPC query method for fetching the
+	// supply cap of a particular denom and the amount that can still be minted.
+	RemainingMintableSupply(context.Context, *QueryRemainingMintableSupplyRequest) (*QueryRemainingMintableSupplyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -676,6 +926,12 @@ func (*UnimplementedQueryServer) BeforeSendHookAddress(ctx context.Context, req
 func (*UnimplementedQueryServer) AllBeforeSendHooksAddresses(ctx context.Context, req *QueryAllBeforeSendHooksAddressesRequest) (*QueryAllBeforeSendHooksAddressesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllBeforeSendHooksAddresses not implemented")
 }
+func (*UnimplementedQueryServer) DenomTransferPolicy(ctx context.Context, req *QueryDenomTransferPolicyRequest) (*QueryDenomTransferPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomTransferPolicy not implemented")
+}
+func (*UnimplementedQueryServer) RemainingMintableSupply(ctx context.Context, req *QueryRemainingMintableSupplyRequest) (*QueryRemainingMintableSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemainingMintableSupply not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -771,6 +1027,42 @@ func _Query_AllBeforeSendHooksAddresses_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomTransferPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomTransferPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomTransferPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Query/DenomTransferPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomTransferPolicy(ctx, req.(*QueryDenomTransferPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemainingMintableSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemainingMintableSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemainingMintableSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Query/RemainingMintableSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemainingMintableSupply(ctx, req.(*QueryRemainingMintableSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.tokenfactory.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -795,6 +1087,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllBeforeSendHooksAddresses",
 			Handler:    _Query_AllBeforeSendHooksAddresses_Handler,
 		},
+		{
+			MethodName: "DenomTransferPolicy",
+			Handler:    _Query_DenomTransferPolicy_Handler,
+		},
+		{
+			MethodName: "RemainingMintableSupply",
+			Handler:    _Query_RemainingMintableSupply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/tokenfactory/v1beta1/query.proto",
@@ -1105,94 +1405,240 @@ func (m *QueryAllBeforeSendHooksAddressesResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomTransferPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryDenomTransferPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomAuthorityMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDenomTransferPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomAuthorityMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomTransferPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AuthorityMetadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDenomsFromCreatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryDenomTransferPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDenomTransferPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Denoms) > 0 {
-		for _, s := range m.Denoms {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.TransferPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBeforeSendHookAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *QueryRemainingMintableSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainingMintableSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainingMintableSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainingMintableSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainingMintableSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainingMintableSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingMintableSupply.Size()
+		i -= size
+		if _, err := m.RemainingMintableSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Unlimited {
+		i--
+		if m.Unlimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SupplyCap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomAuthorityMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomAuthorityMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AuthorityMetadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomsFromCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBeforeSendHookAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Denom)
 	if l > 0 {
@@ -1244,6 +1690,59 @@ func (m *QueryAllBeforeSendHooksAddressesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDenomTransferPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomTransferPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TransferPolicy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRemainingMintableSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRemainingMintableSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SupplyCap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Unlimited {
+		n += 2
+	}
+	l = m.RemainingMintableSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1269,16 +1768,264 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1300,7 +2047,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1323,17 +2070,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1343,24 +2090,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1383,7 +2129,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1406,15 +2152,15 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1442,7 +2188,7 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1465,7 +2211,7 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1488,17 +2234,17 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1508,24 +2254,23 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1548,7 +2293,7 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1571,15 +2316,15 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1607,7 +2352,7 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.CosmwasmAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1630,7 +2375,7 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBeforeSendHooksAddressesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1653,10 +2398,60 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBeforeSendHooksAddressesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBeforeSendHooksAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBeforeSendHooksAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBeforeSendHooksAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBeforeSendHooksAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1691,6 +2486,38 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHookAddresses = append(m.BeforeSendHookAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1712,7 +2539,7 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomTransferPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1735,10 +2562,10 @@ func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomTransferPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomTransferPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1794,7 +2621,7 @@ func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomTransferPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1817,17 +2644,17 @@ func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomTransferPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomTransferPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPolicy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1837,23 +2664,24 @@ func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmwasmAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.TransferPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1876,7 +2704,7 @@ func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBeforeSendHooksAddressesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRemainingMintableSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1899,12 +2727,44 @@ func (m *QueryAllBeforeSendHooksAddressesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBeforeSendHooksAddressesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemainingMintableSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBeforeSendHooksAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemainingMintableSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1926,7 +2786,7 @@ func (m *QueryAllBeforeSendHooksAddressesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBeforeSendHooksAddressesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRemainingMintableSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1949,17 +2809,17 @@ func (m *QueryAllBeforeSendHooksAddressesResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBeforeSendHooksAddressesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemainingMintableSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBeforeSendHooksAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemainingMintableSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1969,27 +2829,48 @@ func (m *QueryAllBeforeSendHooksAddressesResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unlimited = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingMintableSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2017,7 +2898,9 @@ func (m *QueryAllBeforeSendHooksAddressesResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BeforeSendHookAddresses = append(m.BeforeSendHookAddresses, string(dAtA[iNdEx:postIndex]))
+			if err := m.RemainingMintableSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
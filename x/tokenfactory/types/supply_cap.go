@@ -0,0 +1,23 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+func (supplyCap DenomSupplyCap) Validate() error {
+	if supplyCap.MaxSupply.IsNil() || supplyCap.MaxSupply.IsZero() {
+		if supplyCap.Immutable {
+			return errorsmod.Wrap(ErrInvalidSupplyCap, "an immutable supply cap must have a max supply")
+		}
+		return nil
+	}
+	if supplyCap.MaxSupply.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidSupplyCap, "max supply must not be negative, got %s", supplyCap.MaxSupply)
+	}
+	return nil
+}
+
+// HasMaxSupply returns true if the supply cap limits the supply of the denom.
+func (supplyCap DenomSupplyCap) HasMaxSupply() bool {
+	return !supplyCap.MaxSupply.IsNil() && supplyCap.MaxSupply.IsPositive()
+}
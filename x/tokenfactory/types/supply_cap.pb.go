@@ -0,0 +1,397 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/supply_cap.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomSupplyCap specifies the maximum supply of a token factory denom.
+// Once set, a supply cap cannot be removed nor raised. A mutable supply cap can
+// be lowered by the admin down to the current supply, while an immutable one
+// cannot be changed anymore.
+type DenomSupplyCap struct {
+	// max_supply is the maximum total supply of the denom. Zero means that the
+	// denom does not have a supply cap.
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply" yaml:"max_supply"`
+	// immutable is true if the supply cap can no longer be lowered.
+	Immutable bool `protobuf:"varint,2,opt,name=immutable,proto3" json:"immutable,omitempty" yaml:"immutable"`
+}
+
+func (m *DenomSupplyCap) Reset()         { *m = DenomSupplyCap{} }
+func (m *DenomSupplyCap) String() string { return proto.CompactTextString(m) }
+func (*DenomSupplyCap) ProtoMessage()    {}
+func (*DenomSupplyCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e2348cdc87ded3d, []int{0}
+}
+func (m *DenomSupplyCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomSupplyCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomSupplyCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomSupplyCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomSupplyCap.Merge(m, src)
+}
+func (m *DenomSupplyCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomSupplyCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomSupplyCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomSupplyCap proto.InternalMessageInfo
+
+func (m *DenomSupplyCap) GetImmutable() bool {
+	if m != nil {
+		return m.Immutable
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*DenomSupplyCap)(nil), "osmosis.tokenfactory.v1beta1.DenomSupplyCap")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/supply_cap.proto", fileDescriptor_7e2348cdc87ded3d)
+}
+
+var fileDescriptor_7e2348cdc87ded3d = []byte{
+	// 280 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcd, 0x2f, 0xce, 0xcd,
+	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
+	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x2e, 0x2d, 0x28, 0xc8, 0xa9, 0x8c,
+	0x4f, 0x4e, 0x2c, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0x2a, 0xd7, 0x43, 0x56,
+	0xae, 0x07, 0x55, 0x2e, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4,
+	0x28, 0xcd, 0x65, 0xe4, 0xe2, 0x73, 0x49, 0xcd, 0xcb, 0xcf, 0x0d, 0x06, 0x9b, 0xe6, 0x9c, 0x58,
+	0x20, 0x14, 0xc8, 0xc5, 0x95, 0x9b, 0x58, 0x11, 0x0f, 0x31, 0x5e, 0x82, 0x51, 0x81, 0x51, 0x83,
+	0xd3, 0xc9, 0xe8, 0xc4, 0x3d, 0x79, 0x86, 0x5b, 0xf7, 0xe4, 0x45, 0x93, 0xc1, 0x76, 0x14, 0xa7,
+	0x64, 0xeb, 0x65, 0xe6, 0xeb, 0xe7, 0x26, 0x96, 0x64, 0xe8, 0x79, 0xe6, 0x95, 0x7c, 0xba, 0x27,
+	0x2f, 0x58, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0xd0, 0xa8, 0x14, 0xc4, 0x99, 0x9b, 0x58, 0x01,
+	0x31, 0x55, 0xc8, 0x88, 0x8b, 0x33, 0x33, 0x37, 0xb7, 0xb4, 0x24, 0x31, 0x29, 0x27, 0x55, 0x82,
+	0x49, 0x81, 0x51, 0x83, 0xc3, 0x49, 0xe4, 0xd3, 0x3d, 0x79, 0x01, 0x88, 0x26, 0xb8, 0x94, 0x52,
+	0x10, 0x42, 0x99, 0x15, 0xcb, 0x8b, 0x05, 0xf2, 0x8c, 0x4e, 0x41, 0x27, 0x1e, 0xc9, 0x31, 0x5e,
+	0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31,
+	0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f,
+	0xab, 0x0f, 0xf5, 0xb8, 0x6e, 0x4e, 0x62, 0x52, 0x31, 0x8c, 0xa3, 0x5f, 0x66, 0x64, 0xa9, 0x5f,
+	0x81, 0x1a, 0x74, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xaf, 0x1b, 0x03, 0x06, 0x00,
+	0x24, 0xfc, 0x7e, 0x8d, 0x5f, 0x01, 0x00, 0x00,
+}
+
+func (this *DenomSupplyCap) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomSupplyCap)
+	if !ok {
+		that2, ok := that.(DenomSupplyCap)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if this.Immutable != that1.Immutable {
+		return false
+	}
+	return true
+}
+func (m *DenomSupplyCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomSupplyCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomSupplyCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Immutable {
+		i--
+		if m.Immutable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSupplyCap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSupplyCap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSupplyCap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomSupplyCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovSupplyCap(uint64(l))
+	if m.Immutable {
+		n += 2
+	}
+	return n
+}
+
+func sovSupplyCap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSupplyCap(x uint64) (n int) {
+	return sovSupplyCap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomSupplyCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSupplyCap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomSupplyCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomSupplyCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyCap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSupplyCap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyCap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Immutable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyCap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Immutable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSupplyCap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSupplyCap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSupplyCap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSupplyCap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSupplyCap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSupplyCap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSupplyCap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSupplyCap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSupplyCap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSupplyCap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSupplyCap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSupplyCap = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTransferPolicyAddresses is the maximum number of addresses that the allowlist
+// and the denylist of a transfer policy may each contain.
+// It bounds the cost of checking the policy on every send of the denom.
+const MaxTransferPolicyAddresses = 500
+
+func (policy DenomTransferPolicy) Validate() error {
+	if err := validateTransferPolicyAddresses(policy.Allowlist); err != nil {
+		return errorsmod.Wrapf(ErrInvalidTransferPolicy, "invalid allowlist: %s", err)
+	}
+	if err := validateTransferPolicyAddresses(policy.Denylist); err != nil {
+		return errorsmod.Wrapf(ErrInvalidTransferPolicy, "invalid denylist: %s", err)
+	}
+	for _, addr := range policy.Denylist {
+		if containsAddress(policy.Allowlist, addr) {
+			return errorsmod.Wrapf(ErrInvalidTransferPolicy, "address %s is both allowlisted and denylisted", addr)
+		}
+	}
+	if !policy.MaxBalance.IsNil() && policy.MaxBalance.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidTransferPolicy, "max balance must not be negative, got %s", policy.MaxBalance)
+	}
+	return nil
+}
+
+// IsEmpty returns true if the policy does not restrict any transfer.
+func (policy DenomTransferPolicy) IsEmpty() bool {
+	return !policy.Frozen && len(policy.Allowlist) == 0 && len(policy.Denylist) == 0 && !policy.HasMaxBalance()
+}
+
+// HasMaxBalance returns true if the policy limits the balance that an account may hold.
+func (policy DenomTransferPolicy) HasMaxBalance() bool {
+	return !policy.MaxBalance.IsNil() && policy.MaxBalance.IsPositive()
+}
+
+// IsAllowlisted returns true if the allowlist is empty or contains the given address.
+func (policy DenomTransferPolicy) IsAllowlisted(addr string) bool {
+	if len(policy.Allowlist) == 0 {
+		return true
+	}
+	return containsAddress(policy.Allowlist, addr)
+}
+
+// IsDenylisted returns true if the denylist contains the given address.
+func (policy DenomTransferPolicy) IsDenylisted(addr string) bool {
+	return containsAddress(policy.Denylist, addr)
+}
+
+func validateTransferPolicyAddresses(addrs []string) error {
+	if len(addrs) > MaxTransferPolicyAddresses {
+		return fmt.Errorf("too many addresses, max is %d, got %d", MaxTransferPolicyAddresses, len(addrs))
+	}
+	seen := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return err
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate address %s", addr)
+		}
+		seen[addr] = true
+	}
+	return nil
+}
+
+func containsAddress(addrs []string, addr string) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
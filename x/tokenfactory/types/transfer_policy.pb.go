@@ -0,0 +1,530 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/transfer_policy.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomTransferPolicy specifies the native transfer restrictions that the admin
+// of a token factory denom has set on it. The policy is enforced on every send
+// of the denom, in addition to the before send hook contract, if any.
+type DenomTransferPolicy struct {
+	// frozen halts all transfers of the denom between accounts.
+	Frozen bool `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty" yaml:"frozen"`
+	// allowlist, when not empty, is the only set of addresses that may send or
+	// receive the denom.
+	Allowlist []string `protobuf:"bytes,2,rep,name=allowlist,proto3" json:"allowlist,omitempty" yaml:"allowlist"`
+	// denylist is a set of addresses that may neither send nor receive the
+	// denom.
+	Denylist []string `protobuf:"bytes,3,rep,name=denylist,proto3" json:"denylist,omitempty" yaml:"denylist"`
+	// max_balance is the maximum balance of the denom that any account may hold
+	// after receiving it. Zero means that there is no limit.
+	MaxBalance cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_balance,json=maxBalance,proto3,customtype=cosmossdk.io/math.Int" json:"max_balance" yaml:"max_balance"`
+}
+
+func (m *DenomTransferPolicy) Reset()         { *m = DenomTransferPolicy{} }
+func (m *DenomTransferPolicy) String() string { return proto.CompactTextString(m) }
+func (*DenomTransferPolicy) ProtoMessage()    {}
+func (*DenomTransferPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdcfcdf2304241a6, []int{0}
+}
+func (m *DenomTransferPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTransferPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTransferPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTransferPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTransferPolicy.Merge(m, src)
+}
+func (m *DenomTransferPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTransferPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTransferPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTransferPolicy proto.InternalMessageInfo
+
+func (m *DenomTransferPolicy) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *DenomTransferPolicy) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func (m *DenomTransferPolicy) GetDenylist() []string {
+	if m != nil {
+		return m.Denylist
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DenomTransferPolicy)(nil), "osmosis.tokenfactory.v1beta1.DenomTransferPolicy")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/transfer_policy.proto", fileDescriptor_cdcfcdf2304241a6)
+}
+
+var fileDescriptor_cdcfcdf2304241a6 = []byte{
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xb1, 0x6e, 0xea, 0x30,
+	0x14, 0x86, 0x13, 0x40, 0x08, 0x72, 0x75, 0x75, 0x6f, 0x03, 0x95, 0x50, 0x55, 0xc5, 0x28, 0x13,
+	0x1d, 0x1a, 0x0b, 0x58, 0x5a, 0xc6, 0xa8, 0x4b, 0xb7, 0x2a, 0x62, 0xea, 0x82, 0x9c, 0x60, 0x20,
+	0xc2, 0xf6, 0x41, 0xb1, 0x4b, 0x49, 0xb7, 0xbe, 0x41, 0x1f, 0xa1, 0x8f, 0xc3, 0xc8, 0x58, 0x75,
+	0x88, 0x2a, 0x58, 0x3a, 0xe7, 0x09, 0xaa, 0x26, 0x81, 0xd2, 0xcd, 0xc7, 0xe7, 0xfb, 0x7e, 0xc9,
+	0xbf, 0x8d, 0x1e, 0x48, 0x0e, 0x32, 0x94, 0x58, 0xc1, 0x9c, 0x8a, 0x09, 0x09, 0x14, 0x44, 0x31,
+	0x5e, 0x76, 0x7d, 0xaa, 0x48, 0x17, 0xab, 0x88, 0x08, 0x39, 0xa1, 0xd1, 0x68, 0x01, 0x2c, 0x0c,
+	0x62, 0x67, 0x11, 0x81, 0x02, 0xf3, 0xbc, 0x70, 0x9c, 0x63, 0xc7, 0x29, 0x9c, 0xb3, 0xe6, 0x14,
+	0xa6, 0x90, 0x81, 0xf8, 0xfb, 0x94, 0x3b, 0xf6, 0x73, 0xc9, 0x68, 0xdc, 0x50, 0x01, 0x7c, 0x58,
+	0x44, 0xde, 0x65, 0x89, 0xe6, 0x85, 0x51, 0x9d, 0x44, 0xf0, 0x44, 0x45, 0x4b, 0x6f, 0xeb, 0x9d,
+	0x9a, 0x7b, 0x92, 0x26, 0xe8, 0x6f, 0x4c, 0x38, 0x1b, 0xd8, 0xf9, 0xbd, 0xed, 0x15, 0x80, 0xd9,
+	0x33, 0xea, 0x84, 0x31, 0x78, 0x64, 0xa1, 0x54, 0xad, 0x52, 0xbb, 0xdc, 0xa9, 0xbb, 0xcd, 0x34,
+	0x41, 0xff, 0x73, 0xfa, 0xb0, 0xb2, 0xbd, 0x1f, 0xcc, 0xc4, 0x46, 0x6d, 0x4c, 0x45, 0x9c, 0x29,
+	0xe5, 0x4c, 0x69, 0xa4, 0x09, 0xfa, 0x97, 0x2b, 0xfb, 0x8d, 0xed, 0x1d, 0x20, 0x73, 0x68, 0xfc,
+	0xe1, 0x64, 0x35, 0xf2, 0x09, 0x23, 0x22, 0xa0, 0xad, 0x4a, 0x5b, 0xef, 0xd4, 0xdd, 0xfe, 0x3a,
+	0x41, 0xda, 0x7b, 0x82, 0x4e, 0x83, 0xec, 0xe5, 0x72, 0x3c, 0x77, 0x42, 0xc0, 0x9c, 0xa8, 0x99,
+	0x73, 0x2b, 0x54, 0x9a, 0x20, 0x33, 0x0f, 0x3c, 0x32, 0x6d, 0xcf, 0xe0, 0x64, 0xe5, 0xe6, 0xc3,
+	0xa0, 0xf2, 0xf9, 0x8a, 0x74, 0xd7, 0x5b, 0x6f, 0x2d, 0x7d, 0xb3, 0xb5, 0xf4, 0x8f, 0xad, 0xa5,
+	0xbf, 0xec, 0x2c, 0x6d, 0xb3, 0xb3, 0xb4, 0xb7, 0x9d, 0xa5, 0xdd, 0x5f, 0x4d, 0x43, 0x35, 0x7b,
+	0xf0, 0x9d, 0x00, 0x38, 0x2e, 0xca, 0xbd, 0x64, 0xc4, 0x97, 0xfb, 0x01, 0x2f, 0x7b, 0xd7, 0x78,
+	0xf5, 0xfb, 0x8f, 0x54, 0xbc, 0xa0, 0xd2, 0xaf, 0x66, 0xf5, 0xf6, 0xbf, 0x06, 0x00, 0x44, 0x2f,
+	0x85, 0x4c, 0xc8, 0x01, 0x00, 0x00,
+}
+
+func (this *DenomTransferPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomTransferPolicy)
+	if !ok {
+		that2, ok := that.(DenomTransferPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Frozen != that1.Frozen {
+		return false
+	}
+	if len(this.Allowlist) != len(that1.Allowlist) {
+		return false
+	}
+	for i := range this.Allowlist {
+		if this.Allowlist[i] != that1.Allowlist[i] {
+			return false
+		}
+	}
+	if len(this.Denylist) != len(that1.Denylist) {
+		return false
+	}
+	for i := range this.Denylist {
+		if this.Denylist[i] != that1.Denylist[i] {
+			return false
+		}
+	}
+	if !this.MaxBalance.Equal(that1.MaxBalance) {
+		return false
+	}
+	return true
+}
+func (m *DenomTransferPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTransferPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTransferPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxBalance.Size()
+		i -= size
+		if _, err := m.MaxBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransferPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintTransferPolicy(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintTransferPolicy(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransferPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransferPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomTransferPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovTransferPolicy(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 1 + l + sovTransferPolicy(uint64(l))
+		}
+	}
+	l = m.MaxBalance.Size()
+	n += 1 + l + sovTransferPolicy(uint64(l))
+	return n
+}
+
+func sovTransferPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransferPolicy(x uint64) (n int) {
+	return sovTransferPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomTransferPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransferPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTransferPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTransferPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransferPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransferPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTransferPolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTransferPolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransferPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransferPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTransferPolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTransferPolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTransferPolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTransferPolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTransferPolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTransferPolicy = fmt.Errorf("proto: unexpected end of group")
+)
@@ -736,6 +736,302 @@ func (m *MsgForceTransferResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgForceTransferResponse proto.InternalMessageInfo
 
+// MsgSetDenomTransferPolicy is the sdk.Msg type for allowing an admin account
+// to set the native transfer restrictions of a denom. Setting an empty policy
+// removes all restrictions.
+type MsgSetDenomTransferPolicy struct {
+	Sender         string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom          string              `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	TransferPolicy DenomTransferPolicy `protobuf:"bytes,3,opt,name=transfer_policy,json=transferPolicy,proto3" json:"transfer_policy" yaml:"transfer_policy"`
+}
+
+func (m *MsgSetDenomTransferPolicy) Reset()         { *m = MsgSetDenomTransferPolicy{} }
+func (m *MsgSetDenomTransferPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomTransferPolicy) ProtoMessage()    {}
+func (*MsgSetDenomTransferPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{14}
+}
+func (m *MsgSetDenomTransferPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomTransferPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomTransferPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomTransferPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomTransferPolicy.Merge(m, src)
+}
+func (m *MsgSetDenomTransferPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomTransferPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomTransferPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomTransferPolicy proto.InternalMessageInfo
+
+func (m *MsgSetDenomTransferPolicy) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetDenomTransferPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetDenomTransferPolicy) GetTransferPolicy() DenomTransferPolicy {
+	if m != nil {
+		return m.TransferPolicy
+	}
+	return DenomTransferPolicy{}
+}
+
+// MsgSetDenomTransferPolicyResponse defines the response structure for an
+// executed MsgSetDenomTransferPolicy message.
+type MsgSetDenomTransferPolicyResponse struct {
+}
+
+func (m *MsgSetDenomTransferPolicyResponse) Reset()         { *m = MsgSetDenomTransferPolicyResponse{} }
+func (m *MsgSetDenomTransferPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomTransferPolicyResponse) ProtoMessage()    {}
+func (*MsgSetDenomTransferPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{15}
+}
+func (m *MsgSetDenomTransferPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomTransferPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomTransferPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomTransferPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomTransferPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetDenomTransferPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomTransferPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomTransferPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomTransferPolicyResponse proto.InternalMessageInfo
+
+// MsgSetDenomSupplyCap is the sdk.Msg type for allowing an admin account to
+// set the supply cap of a denom. A denom without a supply cap can be given any
+// supply cap that is not below its current supply. A mutable supply cap can
+// only be lowered, down to the current supply, or made immutable.
+type MsgSetDenomSupplyCap struct {
+	Sender    string         `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom     string         `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	SupplyCap DenomSupplyCap `protobuf:"bytes,3,opt,name=supply_cap,json=supplyCap,proto3" json:"supply_cap" yaml:"supply_cap"`
+}
+
+func (m *MsgSetDenomSupplyCap) Reset()         { *m = MsgSetDenomSupplyCap{} }
+func (m *MsgSetDenomSupplyCap) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomSupplyCap) ProtoMessage()    {}
+func (*MsgSetDenomSupplyCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{16}
+}
+func (m *MsgSetDenomSupplyCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomSupplyCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomSupplyCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomSupplyCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomSupplyCap.Merge(m, src)
+}
+func (m *MsgSetDenomSupplyCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomSupplyCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomSupplyCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomSupplyCap proto.InternalMessageInfo
+
+func (m *MsgSetDenomSupplyCap) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetDenomSupplyCap) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetDenomSupplyCap) GetSupplyCap() DenomSupplyCap {
+	if m != nil {
+		return m.SupplyCap
+	}
+	return DenomSupplyCap{}
+}
+
+// MsgSetDenomSupplyCapResponse defines the response structure for an executed
+// MsgSetDenomSupplyCap message.
+type MsgSetDenomSupplyCapResponse struct {
+}
+
+func (m *MsgSetDenomSupplyCapResponse) Reset()         { *m = MsgSetDenomSupplyCapResponse{} }
+func (m *MsgSetDenomSupplyCapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomSupplyCapResponse) ProtoMessage()    {}
+func (*MsgSetDenomSupplyCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{17}
+}
+func (m *MsgSetDenomSupplyCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomSupplyCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomSupplyCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomSupplyCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomSupplyCapResponse.Merge(m, src)
+}
+func (m *MsgSetDenomSupplyCapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomSupplyCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomSupplyCapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomSupplyCapResponse proto.InternalMessageInfo
+
+// MsgRenounceMint is the sdk.Msg type for allowing an admin account to
+// permanently give up the permission to mint a denom. The admin keeps all of
+// its other permissions, such as setting the denom metadata.
+type MsgRenounceMint struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *MsgRenounceMint) Reset()         { *m = MsgRenounceMint{} }
+func (m *MsgRenounceMint) String() string { return proto.CompactTextString(m) }
+func (*MsgRenounceMint) ProtoMessage()    {}
+func (*MsgRenounceMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{18}
+}
+func (m *MsgRenounceMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenounceMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenounceMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenounceMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenounceMint.Merge(m, src)
+}
+func (m *MsgRenounceMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenounceMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenounceMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenounceMint proto.InternalMessageInfo
+
+func (m *MsgRenounceMint) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRenounceMint) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgRenounceMintResponse defines the response structure for an executed
+// MsgRenounceMint message.
+type MsgRenounceMintResponse struct {
+}
+
+func (m *MsgRenounceMintResponse) Reset()         { *m = MsgRenounceMintResponse{} }
+func (m *MsgRenounceMintResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenounceMintResponse) ProtoMessage()    {}
+func (*MsgRenounceMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{19}
+}
+func (m *MsgRenounceMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenounceMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenounceMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenounceMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenounceMintResponse.Merge(m, src)
+}
+func (m *MsgRenounceMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenounceMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenounceMintResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenounceMintResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateDenom)(nil), "osmosis.tokenfactory.v1beta1.MsgCreateDenom")
 	proto.RegisterType((*MsgCreateDenomResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgCreateDenomResponse")
@@ -751,6 +1047,12 @@ func init() {
 	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgSetDenomMetadataResponse")
 	proto.RegisterType((*MsgForceTransfer)(nil), "osmosis.tokenfactory.v1beta1.MsgForceTransfer")
 	proto.RegisterType((*MsgForceTransferResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgForceTransferResponse")
+	proto.RegisterType((*MsgSetDenomTransferPolicy)(nil), "osmosis.tokenfactory.v1beta1.MsgSetDenomTransferPolicy")
+	proto.RegisterType((*MsgSetDenomTransferPolicyResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgSetDenomTransferPolicyResponse")
+	proto.RegisterType((*MsgSetDenomSupplyCap)(nil), "osmosis.tokenfactory.v1beta1.MsgSetDenomSupplyCap")
+	proto.RegisterType((*MsgSetDenomSupplyCapResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgSetDenomSupplyCapResponse")
+	proto.RegisterType((*MsgRenounceMint)(nil), "osmosis.tokenfactory.v1beta1.MsgRenounceMint")
+	proto.RegisterType((*MsgRenounceMintResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgRenounceMintResponse")
 }
 
 func init() {
@@ -758,66 +1060,80 @@ func init() {
 }
 
 var fileDescriptor_283b6c9a90a846b4 = []byte{
-	// 936 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xbf, 0x6f, 0xdb, 0x46,
-	0x14, 0x36, 0x93, 0xd4, 0x75, 0x2e, 0x75, 0x2d, 0xd1, 0x6e, 0xac, 0xb0, 0x0e, 0x99, 0xb0, 0x48,
-	0xda, 0x18, 0x25, 0x09, 0x39, 0x45, 0xda, 0x68, 0x8b, 0xd2, 0x06, 0x01, 0x52, 0x2d, 0x8c, 0xa7,
-	0x22, 0x80, 0x70, 0x92, 0x4e, 0x34, 0xa1, 0xf0, 0xce, 0xe5, 0x9d, 0xac, 0x78, 0x2b, 0xda, 0xad,
-	0x53, 0xff, 0x83, 0xae, 0xed, 0xe6, 0x3f, 0x23, 0x1d, 0x0a, 0x64, 0xec, 0x44, 0x14, 0xf6, 0xe0,
-	0xad, 0x28, 0x38, 0x74, 0x2e, 0xee, 0x07, 0x29, 0x91, 0x52, 0x2b, 0x69, 0x08, 0xbc, 0xd8, 0xe2,
-	0xbd, 0xef, 0xfb, 0xf8, 0xde, 0x77, 0xf7, 0xde, 0x11, 0xdc, 0x21, 0x34, 0x22, 0x34, 0xa4, 0x1e,
-	0x23, 0x03, 0x84, 0xfb, 0xb0, 0xcb, 0x48, 0x7c, 0xec, 0x1d, 0xd5, 0x3b, 0x88, 0xc1, 0xba, 0xc7,
-	0x5e, 0xb9, 0x87, 0x31, 0x61, 0x44, 0xdf, 0x51, 0x30, 0x77, 0x12, 0xe6, 0x2a, 0x98, 0xb1, 0x15,
-	0x90, 0x80, 0x08, 0xa0, 0xc7, 0x7f, 0x49, 0x8e, 0x51, 0x85, 0x51, 0x88, 0x89, 0x27, 0xfe, 0xaa,
-	0x25, 0xb3, 0x2b, 0x74, 0xbc, 0x0e, 0xa4, 0x28, 0x7f, 0x49, 0x97, 0x84, 0x78, 0x2a, 0x8e, 0x07,
-	0x79, 0x9c, 0x3f, 0xa8, 0xf8, 0xb6, 0x8a, 0x47, 0x34, 0xf0, 0x8e, 0xea, 0xfc, 0x9f, 0x0c, 0xd8,
-	0x3f, 0x6b, 0xe0, 0xfd, 0x16, 0x0d, 0x1e, 0xc7, 0x08, 0x32, 0xf4, 0x25, 0xc2, 0x24, 0xd2, 0xef,
-	0x81, 0x55, 0x8a, 0x70, 0x0f, 0xc5, 0x35, 0xed, 0x96, 0xf6, 0xc9, 0xd5, 0x66, 0x35, 0x4d, 0xac,
-	0xf5, 0x63, 0x18, 0xbd, 0x6c, 0xd8, 0x72, 0xdd, 0xf6, 0x15, 0x40, 0xf7, 0xc0, 0x1a, 0x1d, 0x76,
-	0x7a, 0x9c, 0x56, 0xbb, 0x24, 0xc0, 0x9b, 0x69, 0x62, 0x6d, 0x28, 0xb0, 0x8a, 0xd8, 0x7e, 0x0e,
-	0x6a, 0xd4, 0xbf, 0x3f, 0x3f, 0xd9, 0x55, 0xec, 0x1f, 0xcf, 0x4f, 0x76, 0x6f, 0xcf, 0x74, 0xb1,
-	0x2b, 0xb2, 0x71, 0x24, 0xfb, 0x05, 0xb8, 0x5e, 0x4c, 0xd0, 0x47, 0xf4, 0x90, 0x60, 0x8a, 0xf4,
-	0x26, 0xd8, 0xc0, 0x68, 0xd4, 0x16, 0xd4, 0xb6, 0x4c, 0x42, 0x66, 0x6c, 0xa4, 0x89, 0x75, 0x5d,
-	0x26, 0x51, 0x02, 0xd8, 0xfe, 0x3a, 0x46, 0xa3, 0x7d, 0xbe, 0x20, 0xb4, 0xec, 0xbf, 0x35, 0xf0,
-	0x6e, 0x8b, 0x06, 0xad, 0x10, 0xb3, 0x65, 0x0a, 0x7f, 0x0a, 0x56, 0x61, 0x44, 0x86, 0x98, 0x89,
-	0xb2, 0xaf, 0xed, 0xdd, 0x70, 0xa5, 0xc1, 0x2e, 0xdf, 0xa0, 0x6c, 0x7b, 0xdd, 0xc7, 0x24, 0xc4,
-	0xcd, 0x0f, 0x5e, 0x27, 0xd6, 0xca, 0x58, 0x49, 0xd2, 0x6c, 0x5f, 0xf1, 0xf5, 0xaf, 0xc0, 0x7a,
-	0x14, 0x62, 0xb6, 0x4f, 0x1e, 0xf5, 0x7a, 0x31, 0xa2, 0xb4, 0x76, 0x59, 0xbc, 0xdb, 0x1a, 0x97,
-	0xc0, 0xc3, 0x6d, 0x46, 0xda, 0x50, 0x02, 0xec, 0x5f, 0xce, 0x4f, 0x76, 0x35, 0xbf, 0xc8, 0x6a,
-	0xdc, 0x2b, 0x19, 0x7b, 0x63, 0xa6, 0xb1, 0x9c, 0x63, 0x57, 0xc1, 0x86, 0xaa, 0x38, 0x73, 0xd2,
-	0xfe, 0x47, 0xba, 0xd0, 0x1c, 0xc6, 0xf8, 0x62, 0x5c, 0x78, 0x06, 0x36, 0x3a, 0xc3, 0x18, 0x3f,
-	0x89, 0x49, 0x54, 0xf4, 0xe1, 0x76, 0x9a, 0x58, 0x35, 0xc9, 0xe1, 0x80, 0x76, 0x3f, 0x26, 0x51,
-	0xc9, 0x89, 0x32, 0x73, 0x41, 0x2f, 0x38, 0x4b, 0x79, 0xc1, 0xeb, 0xce, 0xbd, 0xf8, 0x4d, 0x75,
-	0xc4, 0x01, 0xc4, 0x01, 0x7a, 0xd4, 0x8b, 0xc2, 0xa5, 0x2c, 0xb9, 0x0b, 0xde, 0x99, 0x6c, 0x87,
-	0x4a, 0x9a, 0x58, 0xef, 0x49, 0xa4, 0x3a, 0x7f, 0x32, 0xac, 0xd7, 0xc1, 0x55, 0x7e, 0x34, 0x21,
-	0xd7, 0x57, 0xa5, 0x6e, 0xa5, 0x89, 0x55, 0x19, 0x9f, 0x5a, 0x11, 0xb2, 0xfd, 0x35, 0x8c, 0x46,
-	0x22, 0x8b, 0x45, 0x7b, 0x47, 0xe4, 0xed, 0x48, 0x76, 0x4d, 0xf6, 0xce, 0xb8, 0x94, 0xbc, 0xca,
-	0xbf, 0x34, 0xb0, 0xd5, 0xa2, 0xc1, 0x73, 0xc4, 0x9a, 0xa8, 0x4f, 0x62, 0xf4, 0x1c, 0xe1, 0xde,
-	0x53, 0x42, 0x06, 0x6f, 0xa3, 0xd6, 0x67, 0xa0, 0xc2, 0xcf, 0xc5, 0x08, 0xd2, 0x7c, 0xeb, 0x54,
-	0xc9, 0xb7, 0xd2, 0xc4, 0xda, 0x96, 0x94, 0x32, 0x22, 0xdb, 0xdc, 0x6c, 0x3d, 0xdb, 0xdc, 0x07,
-	0x25, 0x17, 0xee, 0xce, 0x74, 0x81, 0x22, 0xe6, 0x74, 0x50, 0xdf, 0xe1, 0x38, 0xe7, 0x80, 0x90,
-	0x81, 0x6d, 0x82, 0x9d, 0x59, 0xf5, 0xe6, 0x86, 0xfc, 0xae, 0x81, 0x4d, 0x09, 0x10, 0x83, 0xa1,
-	0x85, 0x18, 0xec, 0x41, 0x06, 0x97, 0xf1, 0xc3, 0x07, 0x6b, 0x91, 0xa2, 0xa9, 0x86, 0xb8, 0x39,
-	0x6e, 0x08, 0x3c, 0xc8, 0x1b, 0x22, 0xd3, 0x6e, 0x6e, 0xab, 0xa6, 0x50, 0x03, 0x33, 0x23, 0xdb,
-	0x7e, 0xae, 0xd3, 0xf8, 0xbc, 0x54, 0xee, 0xc7, 0xff, 0x59, 0xae, 0xf0, 0xda, 0xc9, 0x35, 0x6e,
-	0x82, 0x0f, 0x67, 0x94, 0x93, 0x97, 0x9b, 0x5c, 0x02, 0x95, 0x16, 0x0d, 0x9e, 0x90, 0xb8, 0x8b,
-	0xf6, 0x63, 0x88, 0x69, 0x1f, 0xc5, 0x17, 0xd3, 0xfa, 0x3e, 0xd8, 0x64, 0x2a, 0x81, 0xe9, 0xf6,
-	0xe7, 0x07, 0x64, 0x47, 0xf2, 0x32, 0x50, 0x71, 0x04, 0xf8, 0xb3, 0xc8, 0xfa, 0xd7, 0xa0, 0x9a,
-	0x2d, 0x8f, 0x07, 0xeb, 0x15, 0xa1, 0x68, 0xa6, 0x89, 0x65, 0x94, 0x14, 0x27, 0x86, 0xab, 0x3f,
-	0x4d, 0x6c, 0xdc, 0x2f, 0xed, 0xc1, 0x47, 0x33, 0xf7, 0xa0, 0xcf, 0xad, 0x74, 0x32, 0xb6, 0x6d,
-	0x80, 0x5a, 0xd9, 0xdf, 0xcc, 0xfc, 0xbd, 0x5f, 0x57, 0xc1, 0xe5, 0x16, 0x0d, 0xf4, 0x6f, 0xc1,
-	0xb5, 0xc9, 0x8b, 0xf7, 0x53, 0xf7, 0xff, 0x3e, 0x16, 0xdc, 0xe2, 0x2d, 0x68, 0x7c, 0xb6, 0x0c,
-	0x3a, 0xbf, 0x33, 0x5f, 0x80, 0x2b, 0xe2, 0xae, 0xbb, 0x33, 0x97, 0xcd, 0x61, 0x86, 0xb3, 0x10,
-	0x6c, 0x52, 0x5d, 0xdc, 0x21, 0xf3, 0xd5, 0x39, 0x6c, 0x01, 0xf5, 0xc9, 0xc9, 0x2c, 0xec, 0x9a,
-	0x98, 0xca, 0x0b, 0xd8, 0x35, 0x46, 0x2f, 0x62, 0xd7, 0xf4, 0x98, 0xd4, 0xbf, 0xd3, 0x40, 0x65,
-	0x6a, 0x24, 0xd4, 0xe7, 0x4a, 0x95, 0x29, 0xc6, 0xc3, 0xa5, 0x29, 0x79, 0x0a, 0x3f, 0x68, 0xa0,
-	0x3a, 0x3d, 0xa6, 0xf7, 0x16, 0x11, 0x2c, 0x72, 0x8c, 0xc6, 0xf2, 0x9c, 0x3c, 0x8b, 0x11, 0x58,
-	0x2f, 0xce, 0x0a, 0x77, 0xae, 0x58, 0x01, 0x6f, 0x3c, 0x58, 0x0e, 0x9f, 0xbd, 0xb8, 0xe9, 0xbf,
-	0x3e, 0x35, 0xb5, 0x37, 0xa7, 0xa6, 0xf6, 0xe7, 0xa9, 0xa9, 0xfd, 0x74, 0x66, 0xae, 0xbc, 0x39,
-	0x33, 0x57, 0xfe, 0x38, 0x33, 0x57, 0xbe, 0xf9, 0x22, 0x08, 0xd9, 0xc1, 0xb0, 0xe3, 0x76, 0x49,
-	0xe4, 0x29, 0x6d, 0xe7, 0x25, 0xec, 0xd0, 0xec, 0xc1, 0x3b, 0xda, 0x7b, 0xe8, 0xbd, 0x2a, 0x36,
-	0x29, 0x3b, 0x3e, 0x44, 0xb4, 0xb3, 0x2a, 0xbe, 0x7d, 0xef, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0xa7, 0xce, 0x83, 0x74, 0xc4, 0x0b, 0x00, 0x00,
+	// 1168 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x4f, 0x1b, 0xc7,
+	0x1b, 0x66, 0x49, 0x7e, 0xfc, 0x60, 0x08, 0x01, 0x1b, 0x0a, 0x66, 0x4b, 0xbc, 0x61, 0x23, 0xd2,
+	0x06, 0x75, 0x77, 0x65, 0xe7, 0xab, 0xf1, 0xa5, 0x8a, 0x69, 0xa3, 0x48, 0xa9, 0xa5, 0x6a, 0xe1,
+	0x54, 0x45, 0xb2, 0xd6, 0xf6, 0x78, 0xb1, 0x60, 0x67, 0xdc, 0x9d, 0x35, 0x84, 0x9e, 0xaa, 0x56,
+	0xea, 0xa1, 0xa7, 0xaa, 0xa7, 0xde, 0xaa, 0xde, 0x7a, 0xe4, 0xdc, 0xbf, 0x20, 0x3d, 0x54, 0xca,
+	0xb1, 0xa7, 0x55, 0x05, 0x07, 0x6e, 0x55, 0xe5, 0x43, 0xcf, 0xd5, 0x7c, 0xec, 0xd8, 0xbb, 0x18,
+	0xbc, 0x5b, 0x09, 0xe5, 0x02, 0xec, 0xcc, 0xf3, 0x3c, 0xf3, 0xbe, 0xcf, 0xcc, 0x3b, 0xf3, 0x02,
+	0x36, 0x30, 0xf1, 0x30, 0xe9, 0x10, 0x2b, 0xc0, 0x7b, 0x10, 0xb5, 0x9d, 0x66, 0x80, 0xfd, 0x23,
+	0xeb, 0xa0, 0xd4, 0x80, 0x81, 0x53, 0xb2, 0x82, 0x57, 0x66, 0xd7, 0xc7, 0x01, 0xce, 0xaf, 0x09,
+	0x98, 0x39, 0x0c, 0x33, 0x05, 0x4c, 0x5d, 0x72, 0xb1, 0x8b, 0x19, 0xd0, 0xa2, 0x7f, 0x71, 0x8e,
+	0x9a, 0x73, 0xbc, 0x0e, 0xc2, 0x16, 0xfb, 0x29, 0x86, 0x8a, 0x4d, 0xa6, 0x63, 0x35, 0x1c, 0x02,
+	0xe5, 0x22, 0x4d, 0xdc, 0x41, 0xe7, 0xe6, 0xd1, 0x9e, 0x9c, 0xa7, 0x1f, 0x62, 0x7e, 0x45, 0xcc,
+	0x7b, 0xc4, 0xb5, 0x0e, 0x4a, 0xf4, 0x97, 0x98, 0x30, 0x2e, 0x4d, 0x83, 0xf4, 0xba, 0xdd, 0xfd,
+	0xa3, 0x7a, 0xd3, 0xe9, 0x0a, 0x78, 0xf9, 0xf2, 0xac, 0x7d, 0x07, 0x91, 0x36, 0xf4, 0xeb, 0x5d,
+	0xbc, 0xdf, 0x69, 0x1e, 0x71, 0x8e, 0xfe, 0x93, 0x02, 0x6e, 0xd6, 0x88, 0xbb, 0xe5, 0x43, 0x27,
+	0x80, 0x1f, 0x43, 0x84, 0xbd, 0xfc, 0x3d, 0x30, 0x45, 0x20, 0x6a, 0x41, 0xbf, 0xa0, 0xdc, 0x56,
+	0xde, 0x9f, 0xa9, 0xe6, 0xfa, 0xa1, 0x36, 0x77, 0xe4, 0x78, 0xfb, 0x15, 0x9d, 0x8f, 0xeb, 0xb6,
+	0x00, 0xe4, 0x2d, 0x30, 0x4d, 0x7a, 0x8d, 0x16, 0xa5, 0x15, 0x26, 0x19, 0x78, 0xb1, 0x1f, 0x6a,
+	0xf3, 0x02, 0x2c, 0x66, 0x74, 0x5b, 0x82, 0x2a, 0xa5, 0xaf, 0xcf, 0x8e, 0x37, 0x05, 0xfb, 0xbb,
+	0xb3, 0xe3, 0xcd, 0xf5, 0x91, 0x21, 0x37, 0x59, 0x34, 0x06, 0x67, 0xbf, 0x04, 0xcb, 0xf1, 0x00,
+	0x6d, 0x48, 0xba, 0x18, 0x11, 0x98, 0xaf, 0x82, 0x79, 0x04, 0x0f, 0xeb, 0x8c, 0x5a, 0xe7, 0x41,
+	0xf0, 0x88, 0xd5, 0x7e, 0xa8, 0x2d, 0xf3, 0x20, 0x12, 0x00, 0xdd, 0x9e, 0x43, 0xf0, 0x70, 0x87,
+	0x0e, 0x30, 0x2d, 0xfd, 0x6f, 0x05, 0xfc, 0xbf, 0x46, 0xdc, 0x5a, 0x07, 0x05, 0x59, 0x12, 0x7f,
+	0x0e, 0xa6, 0x1c, 0x0f, 0xf7, 0x50, 0xc0, 0xd2, 0x9e, 0x2d, 0xaf, 0x9a, 0x7c, 0x0f, 0x4d, 0x7a,
+	0x06, 0xa2, 0x13, 0x64, 0x6e, 0xe1, 0x0e, 0xaa, 0xbe, 0xf3, 0x3a, 0xd4, 0x26, 0x06, 0x4a, 0x9c,
+	0xa6, 0xdb, 0x82, 0x9f, 0xff, 0x04, 0xcc, 0x79, 0x1d, 0x14, 0xec, 0xe0, 0xa7, 0xad, 0x96, 0x0f,
+	0x09, 0x29, 0x5c, 0x63, 0x6b, 0x6b, 0x83, 0x14, 0xe8, 0x74, 0x3d, 0xc0, 0x75, 0x87, 0x03, 0xf4,
+	0x5f, 0xce, 0x8e, 0x37, 0x15, 0x3b, 0xce, 0xaa, 0xdc, 0x4b, 0x18, 0xbb, 0x3a, 0xd2, 0x58, 0xca,
+	0xd1, 0x73, 0x60, 0x5e, 0x64, 0x1c, 0x39, 0xa9, 0xff, 0xc3, 0x5d, 0xa8, 0xf6, 0x7c, 0xf4, 0x76,
+	0x5c, 0x78, 0x01, 0xe6, 0x1b, 0x3d, 0x1f, 0x3d, 0xf3, 0xb1, 0x17, 0xf7, 0x61, 0xbd, 0x1f, 0x6a,
+	0x05, 0xce, 0xa1, 0x80, 0x7a, 0xdb, 0xc7, 0x5e, 0xc2, 0x89, 0x24, 0x33, 0xa5, 0x17, 0x94, 0x25,
+	0xbc, 0xa0, 0x79, 0x4b, 0x2f, 0x7e, 0x13, 0x15, 0xb1, 0xeb, 0x20, 0x17, 0x3e, 0x6d, 0x79, 0x9d,
+	0x4c, 0x96, 0xdc, 0x05, 0xff, 0x1b, 0x2e, 0x87, 0x85, 0x7e, 0xa8, 0xdd, 0xe0, 0x48, 0x71, 0xfe,
+	0xf8, 0x74, 0xbe, 0x04, 0x66, 0xe8, 0xd1, 0x74, 0xa8, 0xbe, 0x48, 0x75, 0xa9, 0x1f, 0x6a, 0x0b,
+	0x83, 0x53, 0xcb, 0xa6, 0x74, 0x7b, 0x1a, 0xc1, 0x43, 0x16, 0x45, 0xda, 0xda, 0x61, 0x71, 0x1b,
+	0x9c, 0x5d, 0xe0, 0xb5, 0x33, 0x48, 0x45, 0x66, 0xf9, 0x97, 0x02, 0x96, 0x6a, 0xc4, 0xdd, 0x86,
+	0x41, 0x15, 0xb6, 0xb1, 0x0f, 0xb7, 0x21, 0x6a, 0x3d, 0xc7, 0x78, 0xef, 0x2a, 0x72, 0x7d, 0x01,
+	0x16, 0xe8, 0xb9, 0x38, 0x74, 0x88, 0xdc, 0x3a, 0x91, 0xf2, 0xed, 0x7e, 0xa8, 0xad, 0x70, 0x4a,
+	0x12, 0x11, 0x6d, 0x6e, 0x34, 0x1e, 0x6d, 0xee, 0xa3, 0x84, 0x0b, 0x77, 0x47, 0xba, 0x40, 0x60,
+	0x60, 0x34, 0x60, 0xdb, 0xa0, 0x38, 0x63, 0x17, 0xe3, 0x3d, 0xbd, 0x08, 0xd6, 0x46, 0xe5, 0x2b,
+	0x0d, 0xf9, 0x5d, 0x01, 0x8b, 0x1c, 0xc0, 0x2e, 0x86, 0x1a, 0x0c, 0x9c, 0x96, 0x13, 0x38, 0x59,
+	0xfc, 0xb0, 0xc1, 0xb4, 0x27, 0x68, 0xa2, 0x20, 0x6e, 0x0d, 0x0a, 0x02, 0xed, 0xc9, 0x82, 0x88,
+	0xb4, 0xab, 0x2b, 0xa2, 0x28, 0xc4, 0x85, 0x19, 0x91, 0x75, 0x5b, 0xea, 0x54, 0x1e, 0x27, 0xd2,
+	0x7d, 0xef, 0xc2, 0x74, 0x99, 0xd7, 0x86, 0xd4, 0xb8, 0x05, 0xde, 0x1d, 0x91, 0x8e, 0x4c, 0x37,
+	0x9c, 0x04, 0x0b, 0x35, 0xe2, 0x3e, 0xc3, 0x7e, 0x13, 0xee, 0x88, 0x97, 0xe1, 0xed, 0x94, 0xbe,
+	0x0d, 0x16, 0xa3, 0xa7, 0xe9, 0x7c, 0xf9, 0xd3, 0x03, 0xb2, 0xc6, 0x79, 0xf2, 0xfd, 0x8a, 0x5d,
+	0x01, 0xf6, 0x28, 0x72, 0xfe, 0x53, 0x90, 0x8b, 0x86, 0x07, 0x17, 0xeb, 0x75, 0xa6, 0x58, 0xec,
+	0x87, 0x9a, 0x9a, 0x50, 0x1c, 0xba, 0x5c, 0xed, 0xf3, 0xc4, 0xca, 0xfd, 0xc4, 0x1e, 0xdc, 0x19,
+	0xb9, 0x07, 0x6d, 0x6a, 0xa5, 0x11, 0xb1, 0x75, 0x15, 0x14, 0x92, 0xfe, 0x4a, 0xf3, 0x7f, 0x9e,
+	0x04, 0xab, 0x43, 0x9b, 0x13, 0xcd, 0x7f, 0xc6, 0x1e, 0xe6, 0xab, 0xa8, 0xc0, 0x2f, 0xc1, 0x7c,
+	0xe2, 0xf9, 0x67, 0xfe, 0xce, 0x96, 0x4b, 0xe6, 0x65, 0x2d, 0x90, 0x39, 0x22, 0xbc, 0x6a, 0x51,
+	0x6c, 0xe7, 0x72, 0xc2, 0x44, 0xae, 0xab, 0xdb, 0x37, 0x83, 0x18, 0xbe, 0xf2, 0x30, 0xe1, 0xde,
+	0xc6, 0x98, 0x13, 0x2c, 0x74, 0xee, 0x80, 0xf5, 0x0b, 0x2d, 0x92, 0x46, 0x7e, 0x3b, 0x09, 0x96,
+	0x86, 0x50, 0xdb, 0xac, 0x23, 0xda, 0x72, 0xba, 0x57, 0xe1, 0x61, 0x1b, 0x80, 0x41, 0xc7, 0x25,
+	0xec, 0xfb, 0x20, 0x85, 0x7d, 0x32, 0xa8, 0xea, 0xaa, 0x70, 0x2e, 0x17, 0xf5, 0x47, 0x91, 0x9a,
+	0x6e, 0xcf, 0x90, 0x08, 0x95, 0xf2, 0xb4, 0x51, 0xbf, 0x38, 0xc7, 0xa0, 0x02, 0xf2, 0x76, 0x8b,
+	0x2f, 0x29, 0x8d, 0xfa, 0x51, 0x61, 0x0f, 0x9d, 0x0d, 0x11, 0xee, 0xa1, 0x26, 0xcc, 0xda, 0xee,
+	0xa4, 0xf4, 0xa8, 0x52, 0x4e, 0xc4, 0xae, 0x8f, 0x8c, 0xdd, 0x17, 0x51, 0x18, 0xac, 0x1d, 0x59,
+	0x05, 0x2b, 0x89, 0xc8, 0xa2, 0xa8, 0xcb, 0xbf, 0xce, 0x80, 0x6b, 0x35, 0xe2, 0xe6, 0xbf, 0x00,
+	0xb3, 0xc3, 0x0d, 0xea, 0x18, 0xd7, 0xe3, 0xdd, 0xa2, 0xfa, 0x20, 0x0b, 0x5a, 0xf6, 0x96, 0x2f,
+	0xc1, 0x75, 0x66, 0xd2, 0xc6, 0x58, 0x36, 0x85, 0xa9, 0x46, 0x2a, 0xd8, 0xb0, 0x3a, 0xeb, 0xb5,
+	0xc6, 0xab, 0x53, 0x98, 0x6a, 0xa4, 0x82, 0x49, 0x75, 0x6a, 0xd7, 0x50, 0xf7, 0x92, 0xc2, 0xae,
+	0x01, 0x5a, 0x7d, 0x90, 0x05, 0x2d, 0x97, 0xfc, 0x4a, 0x01, 0x0b, 0xe7, 0x9e, 0xce, 0xd2, 0x58,
+	0xa9, 0x24, 0x45, 0x7d, 0x92, 0x99, 0x22, 0x43, 0xf8, 0x46, 0x01, 0xb9, 0xf3, 0xed, 0x4c, 0x39,
+	0x8d, 0x60, 0x9c, 0xa3, 0x56, 0xb2, 0x73, 0x64, 0x14, 0x87, 0x60, 0x2e, 0xfe, 0xa6, 0x9a, 0x63,
+	0xc5, 0x62, 0x78, 0xf5, 0x51, 0x36, 0xbc, 0x5c, 0xf8, 0x07, 0x05, 0x2c, 0x5f, 0xf0, 0xa0, 0x3c,
+	0x4e, 0x6d, 0x6a, 0x9c, 0xa8, 0x7e, 0xf4, 0x1f, 0x89, 0xc9, 0x3d, 0x49, 0x5c, 0xce, 0xe5, 0xd4,
+	0xb2, 0x92, 0xa3, 0x56, 0xb2, 0x73, 0x64, 0x14, 0x01, 0xb8, 0x11, 0xbb, 0xf8, 0xc6, 0x97, 0xd3,
+	0x30, 0x5c, 0x7d, 0x98, 0x09, 0x1e, 0xad, 0x5a, 0xb5, 0x5f, 0x9f, 0x14, 0x95, 0x37, 0x27, 0x45,
+	0xe5, 0xcf, 0x93, 0xa2, 0xf2, 0xfd, 0x69, 0x71, 0xe2, 0xcd, 0x69, 0x71, 0xe2, 0x8f, 0xd3, 0xe2,
+	0xc4, 0xe7, 0x1f, 0xba, 0x9d, 0x60, 0xb7, 0xd7, 0x30, 0x9b, 0xd8, 0xb3, 0x84, 0xb4, 0xb1, 0xef,
+	0x34, 0x48, 0xf4, 0x61, 0x1d, 0x94, 0x9f, 0x58, 0xaf, 0xe2, 0x77, 0x66, 0x70, 0xd4, 0x85, 0xa4,
+	0x31, 0xc5, 0xfe, 0x69, 0xbf, 0xff, 0xef, 0x00, 0x2d, 0x0c, 0xe8, 0xbc, 0xe0, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -839,6 +1155,9 @@ type MsgClient interface {
 	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
 	SetBeforeSendHook(ctx context.Context, in *MsgSetBeforeSendHook, opts ...grpc.CallOption) (*MsgSetBeforeSendHookResponse, error)
 	ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error)
+	SetDenomTransferPolicy(ctx context.Context, in *MsgSetDenomTransferPolicy, opts ...grpc.CallOption) (*MsgSetDenomTransferPolicyResponse, error)
+	SetDenomSupplyCap(ctx context.Context, in *MsgSetDenomSupplyCap, opts ...grpc.CallOption) (*MsgSetDenomSupplyCapResponse, error)
+	RenounceMint(ctx context.Context, in *MsgRenounceMint, opts ...grpc.CallOption) (*MsgRenounceMintResponse, error)
 }
 
 type msgClient struct {
@@ -912,6 +1231,33 @@ func (c *msgClient) ForceTransfer(ctx context.Context, in *MsgForceTransfer, opt
 	return out, nil
 }
 
+func (c *msgClient) SetDenomTransferPolicy(ctx context.Context, in *MsgSetDenomTransferPolicy, opts ...grpc.CallOption) (*MsgSetDenomTransferPolicyResponse, error) {
+	out := new(MsgSetDenomTransferPolicyResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/SetDenomTransferPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomSupplyCap(ctx context.Context, in *MsgSetDenomSupplyCap, opts ...grpc.CallOption) (*MsgSetDenomSupplyCapResponse, error) {
+	out := new(MsgSetDenomSupplyCapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/SetDenomSupplyCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RenounceMint(ctx context.Context, in *MsgRenounceMint, opts ...grpc.CallOption) (*MsgRenounceMintResponse, error) {
+	out := new(MsgRenounceMintResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/RenounceMint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error)
@@ -921,6 +1267,9 @@ type MsgServer interface {
 	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
 	SetBeforeSendHook(context.Context, *MsgSetBeforeSendHook) (*MsgSetBeforeSendHookResponse, error)
 	ForceTransfer(context.Context, *MsgForceTransfer) (*MsgForceTransferResponse, error)
+	SetDenomTransferPolicy(context.Context, *MsgSetDenomTransferPolicy) (*MsgSetDenomTransferPolicyResponse, error)
+	SetDenomSupplyCap(context.Context, *MsgSetDenomSupplyCap) (*MsgSetDenomSupplyCapResponse, error)
+	RenounceMint(context.Context, *MsgRenounceMint) (*MsgRenounceMintResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -948,6 +1297,15 @@ func (*UnimplementedMsgServer) SetBeforeSendHook(ctx context.Context, req *MsgSe
 func (*UnimplementedMsgServer) ForceTransfer(ctx context.Context, req *MsgForceTransfer) (*MsgForceTransferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceTransfer not implemented")
 }
+func (*UnimplementedMsgServer) SetDenomTransferPolicy(ctx context.Context, req *MsgSetDenomTransferPolicy) (*MsgSetDenomTransferPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomTransferPolicy not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomSupplyCap(ctx context.Context, req *MsgSetDenomSupplyCap) (*MsgSetDenomSupplyCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomSupplyCap not implemented")
+}
+func (*UnimplementedMsgServer) RenounceMint(ctx context.Context, req *MsgRenounceMint) (*MsgRenounceMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenounceMint not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1079,6 +1437,60 @@ func _Msg_ForceTransfer_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetDenomTransferPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomTransferPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomTransferPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/SetDenomTransferPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomTransferPolicy(ctx, req.(*MsgSetDenomTransferPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomSupplyCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomSupplyCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomSupplyCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/SetDenomSupplyCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomSupplyCap(ctx, req.(*MsgSetDenomSupplyCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenounceMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenounceMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenounceMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/RenounceMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenounceMint(ctx, req.(*MsgRenounceMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.tokenfactory.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1111,6 +1523,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForceTransfer",
 			Handler:    _Msg_ForceTransfer_Handler,
 		},
+		{
+			MethodName: "SetDenomTransferPolicy",
+			Handler:    _Msg_SetDenomTransferPolicy_Handler,
+		},
+		{
+			MethodName: "SetDenomSupplyCap",
+			Handler:    _Msg_SetDenomSupplyCap_Handler,
+		},
+		{
+			MethodName: "RenounceMint",
+			Handler:    _Msg_RenounceMint_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/tokenfactory/v1beta1/tx.proto",
@@ -1597,24 +2021,224 @@ func (m *MsgForceTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetDenomTransferPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
+
+func (m *MsgSetDenomTransferPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomTransferPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TransferPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomTransferPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomTransferPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomTransferPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomSupplyCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomSupplyCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomSupplyCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SupplyCap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomSupplyCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomSupplyCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomSupplyCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenounceMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenounceMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenounceMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenounceMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenounceMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenounceMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -1798,25 +2422,500 @@ func (m *MsgForceTransfer) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgForceTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomTransferPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TransferPolicy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDenomTransferPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomSupplyCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SupplyCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDenomSupplyCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRenounceMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRenounceMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subdenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTokenDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewTokenDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgForceTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateDenom) Unmarshal(dAtA []byte) error {
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1839,10 +2938,10 @@ func (m *MsgCreateDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1879,7 +2978,40 @@ func (m *MsgCreateDenom) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1907,7 +3039,7 @@ func (m *MsgCreateDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subdenom = string(dAtA[iNdEx:postIndex])
+			m.BurnFromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1930,7 +3062,7 @@ func (m *MsgCreateDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1953,15 +3085,65 @@ func (m *MsgCreateDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewTokenDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1989,7 +3171,71 @@ func (m *MsgCreateDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewTokenDenom = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2012,7 +3258,7 @@ func (m *MsgCreateDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMint) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2035,10 +3281,60 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBeforeSendHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBeforeSendHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2075,9 +3371,9 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2087,28 +3383,27 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2136,7 +3431,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintToAddress = string(dAtA[iNdEx:postIndex])
+			m.CosmwasmAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2159,7 +3454,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBeforeSendHookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2182,10 +3477,10 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBeforeSendHookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBeforeSendHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2209,7 +3504,7 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2232,10 +3527,10 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2272,7 +3567,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2299,42 +3594,10 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnFromAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BurnFromAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2356,7 +3619,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2379,10 +3642,10 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2406,7 +3669,7 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2429,10 +3692,10 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2469,7 +3732,40 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2497,11 +3793,11 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.TransferFromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferToAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2529,7 +3825,7 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			m.TransferToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2552,7 +3848,7 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2575,10 +3871,10 @@ func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2602,7 +3898,7 @@ func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomTransferPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2625,10 +3921,10 @@ func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBeforeSendHook: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomTransferPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBeforeSendHook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomTransferPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2697,9 +3993,9 @@ func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPolicy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2709,23 +4005,24 @@ func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmwasmAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.TransferPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2748,7 +4045,7 @@ func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBeforeSendHookResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomTransferPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2771,10 +4068,10 @@ func (m *MsgSetBeforeSendHookResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBeforeSendHookResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomTransferPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBeforeSendHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomTransferPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2798,7 +4095,7 @@ func (m *MsgSetBeforeSendHookResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomSupplyCap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2821,10 +4118,10 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomSupplyCap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomSupplyCap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2861,7 +4158,39 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2888,7 +4217,7 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2913,7 +4242,7 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomSupplyCapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2936,10 +4265,10 @@ func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomSupplyCapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomSupplyCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2963,7 +4292,7 @@ func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgRenounceMint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2986,10 +4315,10 @@ func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenounceMint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenounceMint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3026,72 +4355,7 @@ func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferFromAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TransferFromAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3119,7 +4383,7 @@ func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferToAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3142,7 +4406,7 @@ func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRenounceMintResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3165,10 +4429,10 @@ func (m *MsgForceTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenounceMintResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenounceMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -79,6 +79,74 @@ func (k Keeper) GetGeometricTwapToNow(
 	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetGeometricStrategy())
 }
 
+// GetMedianTwap returns a time weighted median price.
+// The returned price is the median of the spot prices of the base asset, in units of the quote asset,
+// from (startTime, endTime), where each spot price is weighted by the time it was in effect.
+// Contrary to the arithmetic twap, a short lived price manipulation cannot move
+// the median unless it lasts for at least half of the window.
+//
+// The same time constraints and errors as GetArithmeticTwap apply.
+func (k Keeper) GetMedianTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	if startTime.After(endTime) {
+		return osmomath.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return osmomath.Dec{}, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+	return k.getMedianTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
+}
+
+// GetMedianTwapToNow returns the time weighted median price from start time until the current block time
+// for quote and base assets in a given pool.
+func (k Keeper) GetMedianTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (osmomath.Dec, error) {
+	if startTime.After(ctx.BlockTime()) {
+		return osmomath.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: ctx.BlockTime()}
+	}
+	return k.getMedianTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, ctx.BlockTime())
+}
+
+// GetVolatility returns the realized volatility of the pool's spot price over (startTime, endTime),
+// defined as the time weighted variance of the natural logarithm of the spot price.
+// The result does not depend on which asset is the quote asset.
+// Zero is returned for an empty time range.
+//
+// The same time constraints and errors as GetArithmeticTwap apply.
+func (k Keeper) GetVolatility(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetVolatilityStrategy())
+}
+
+// GetVolatilityToNow returns the realized volatility from start time until the current block time
+// for quote and base assets in a given pool.
+func (k Keeper) GetVolatilityToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (osmomath.Dec, error) {
+	return k.GetVolatility(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, ctx.BlockTime())
+}
+
 // getTwap computes and returns twap from the start time until the end time. The type
 // of twap returned depends on the strategy given and can be either arithmetic or geometric.
 func (k Keeper) getTwap(
@@ -136,6 +204,37 @@ func (k Keeper) getTwapToNow(
 	return computeTwap(startRecord, endRecord, quoteAssetDenom, strategy)
 }
 
+// getMedianTwap computes and returns the time weighted median price from the start time until the end time.
+// pre-condition: startTime <= endTime <= ctx.BlockTime()
+func (k Keeper) getMedianTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	startRecord, err := k.getInterpolatedRecord(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	var endRecord types.TwapRecord
+	if endTime.Equal(ctx.BlockTime()) {
+		endRecord, err = k.GetBeginBlockAccumulatorRecord(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+	} else {
+		endRecord, err = k.getInterpolatedRecord(ctx, poolId, endTime, baseAssetDenom, quoteAssetDenom)
+	}
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	records, err := k.getRecordsInTimeRange(ctx, poolId, startTime, endTime, baseAssetDenom, quoteAssetDenom, MaxMedianTwapRecords)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	return computeMedianTwap(startRecord, endRecord, records, quoteAssetDenom, k.GetMedianStrategy())
+}
+
 // GetBeginBlockAccumulatorRecord returns a TwapRecord struct corresponding to the state of pool `poolId`
 // as of the beginning of the block this is called on.
 func (k Keeper) GetBeginBlockAccumulatorRecord(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
 	sdkrand "github.com/osmosis-labs/osmosis/v29/simulation/simtypes/random"
 	"github.com/osmosis-labs/osmosis/v29/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v29/x/twap"
@@ -112,7 +113,7 @@ func (s *TestSuite) TestGetBeginBlockAccumulatorRecord() {
 		"idempotent overwrite2":                             {initStartRecord, recordWithUpdatedAccum(initStartRecord, OneSec, OneSec, osmomath.ZeroDec()), tPlusOne, 1, denomA, denomB, nil},
 		"diff spot price": {
 			zeroAccumTenPoint1Record,
-			recordWithUpdatedAccum(zeroAccumTenPoint1Record, OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum),
+			withGeomSquaredAccum(recordWithUpdatedAccum(zeroAccumTenPoint1Record, OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum), geometricSquaredTenSecAccum),
 			tPlusOne, 1, denomA, denomB, nil,
 		},
 	}
@@ -909,3 +910,131 @@ func (s *TestSuite) TestGeometricTwapToNow_BalancerPool_Randomized() {
 		})
 	}
 }
+
+// TestGetMedianTwapTooManyRecords tests that the median fails without reading the records
+// of a window with more than MaxMedianTwapRecords records.
+func (s *TestSuite) TestGetMedianTwapTooManyRecords() {
+	s.SetupTest()
+	records := []types.TwapRecord{}
+	for i := 0; i < 5; i++ {
+		records = append(records, newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(time.Duration(i)*time.Second), osmomath.NewDec(2), osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec()))
+	}
+	s.preSetRecords(records)
+	s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(10 * time.Second))
+	poolId := records[0].PoolId
+
+	defer func(maxRecords int) { twap.MaxMedianTwapRecords = maxRecords }(twap.MaxMedianTwapRecords)
+	twap.MaxMedianTwapRecords = 3
+
+	// The window (baseTime + 1s, baseTime + 10s] holds the 3 newest records.
+	_, err := s.twapkeeper.GetMedianTwapToNow(s.Ctx, poolId, denom1, denom0, baseTime.Add(time.Second))
+	s.Require().NoError(err)
+
+	// The window (baseTime, baseTime + 10s] holds 4 records.
+	_, err = s.twapkeeper.GetMedianTwapToNow(s.Ctx, poolId, denom1, denom0, baseTime)
+	s.Require().ErrorAs(err, &types.TooManyRecordsInRangeError{})
+}
+
+// TestGetMedianTwapAndVolatility tests the median and volatility APIs
+// on a pool with a spot price of 2 for 10 seconds, followed by a spot price of 8.
+func (s *TestSuite) TestGetMedianTwapAndVolatility() {
+	ln2Squared := osmomath.MustNewDecFromStr("0.480453013918201425")
+	baseRecord := newTwoAssetPoolTwapRecordWithDefaults(baseTime, osmomath.NewDec(2), osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec())
+	secondRecord := recordWithUpdatedSpotPrice(twap.RecordWithUpdatedAccumulators(baseRecord, baseTime.Add(10*time.Second)), osmomath.NewDec(8), osmomath.OneDec().QuoInt64(8))
+	blockTime := baseTime.Add(20 * time.Second)
+
+	tests := map[string]struct {
+		startTime     time.Time
+		endTime       time.Time
+		quoteAsset    string
+		expMedian     osmomath.Dec
+		expVolatility osmomath.Dec
+		expectError   error
+	}{
+		"whole window: equal weights, lower price is the median": {
+			startTime:     baseTime,
+			endTime:       blockTime,
+			quoteAsset:    denom0,
+			expMedian:     osmomath.NewDec(2),
+			expVolatility: ln2Squared,
+		},
+		"whole window, quote asset 1": {
+			startTime:     baseTime,
+			endTime:       blockTime,
+			quoteAsset:    denom1,
+			expMedian:     osmomath.OneDec().QuoInt64(8),
+			expVolatility: ln2Squared,
+		},
+		"window mostly after the price change": {
+			startTime:  baseTime.Add(5 * time.Second),
+			endTime:    blockTime,
+			quoteAsset: denom0,
+			expMedian:  osmomath.NewDec(8),
+			// log2 price of 1 for 5s and 3 for 10s: mean = 7/3, mean of squares = 19/3, variance = 8/9.
+			expVolatility: ln2Squared.MulInt64(8).QuoInt64(9),
+		},
+		"window before the price change": {
+			startTime:     baseTime.Add(time.Second),
+			endTime:       baseTime.Add(5 * time.Second),
+			quoteAsset:    denom0,
+			expMedian:     osmomath.NewDec(2),
+			expVolatility: osmomath.ZeroDec(),
+		},
+		"empty window": {
+			startTime:     baseTime.Add(15 * time.Second),
+			endTime:       baseTime.Add(15 * time.Second),
+			quoteAsset:    denom0,
+			expMedian:     osmomath.NewDec(8),
+			expVolatility: osmomath.ZeroDec(),
+		},
+		"end time in the future": {
+			startTime:   baseTime,
+			endTime:     blockTime.Add(time.Second),
+			quoteAsset:  denom0,
+			expectError: types.EndTimeInFutureError{EndTime: blockTime.Add(time.Second), BlockTime: blockTime},
+		},
+		"start time after end time": {
+			startTime:   baseTime.Add(time.Second),
+			endTime:     baseTime,
+			quoteAsset:  denom0,
+			expectError: types.StartTimeAfterEndTimeError{StartTime: baseTime.Add(time.Second), EndTime: baseTime},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords([]types.TwapRecord{baseRecord, secondRecord})
+			s.Ctx = s.Ctx.WithBlockTime(blockTime)
+			baseAsset := denom1
+			if test.quoteAsset == denom1 {
+				baseAsset = denom0
+			}
+
+			median, err := s.twapkeeper.GetMedianTwap(s.Ctx, baseRecord.PoolId, baseAsset, test.quoteAsset, test.startTime, test.endTime)
+			if test.expectError != nil {
+				s.Require().ErrorIs(err, test.expectError)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(test.expMedian, median)
+			}
+
+			volatility, err := s.twapkeeper.GetVolatility(s.Ctx, baseRecord.PoolId, baseAsset, test.quoteAsset, test.startTime, test.endTime)
+			if test.expectError != nil {
+				s.Require().ErrorIs(err, test.expectError)
+				return
+			}
+			s.Require().NoError(err)
+			osmoassert.DecApproxEq(s.T(), test.expVolatility, volatility, osmomath.MustNewDecFromStr("0.000000001"))
+
+			if test.endTime.Equal(blockTime) {
+				medianToNow, err := s.twapkeeper.GetMedianTwapToNow(s.Ctx, baseRecord.PoolId, baseAsset, test.quoteAsset, test.startTime)
+				s.Require().NoError(err)
+				s.Require().Equal(median, medianToNow)
+
+				volatilityToNow, err := s.twapkeeper.GetVolatilityToNow(s.Ctx, baseRecord.PoolId, baseAsset, test.quoteAsset, test.startTime)
+				s.Require().NoError(err)
+				s.Require().Equal(volatility, volatilityToNow)
+			}
+		})
+	}
+}
@@ -30,6 +30,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	cmd.AddCommand(GetQueryArithmeticCommand())
 	cmd.AddCommand(GetQueryGeometricCommand())
+	cmd.AddCommand(GetQueryMedianCommand())
+	cmd.AddCommand(GetQueryVolatilityCommand())
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -138,6 +140,102 @@ Example:
 	return cmd
 }
 
+// GetQueryMedianCommand returns a median twap query command.
+func GetQueryMedianCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "median [poolid] [base denom] [start time] [end time]",
+		Short: "Query time weighted median price",
+		Long: osmocli.FormatLongDescDirect(`Query time weighted median price for pool. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} median 1 uosmo 1667088000 24h
+{{.CommandPrefix}} median 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			twapArgs, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, twapArgs.PoolId, twapArgs.BaseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.MedianTwap(cmd.Context(), &queryproto.MedianTwapRequest{
+				PoolId:     twapArgs.PoolId,
+				BaseAsset:  twapArgs.BaseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  twapArgs.StartTime,
+				EndTime:    &twapArgs.EndTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryVolatilityCommand returns a realized volatility query command.
+func GetQueryVolatilityCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "volatility [poolid] [base denom] [start time] [end time]",
+		Short: "Query realized volatility",
+		Long: osmocli.FormatLongDescDirect(`Query realized volatility, the time weighted variance of the natural logarithm of the spot price, for pool. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} volatility 1 uosmo 1667088000 24h
+{{.CommandPrefix}} volatility 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			twapArgs, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, twapArgs.PoolId, twapArgs.BaseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.Volatility(cmd.Context(), &queryproto.VolatilityRequest{
+				PoolId:     twapArgs.PoolId,
+				BaseAsset:  twapArgs.BaseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  twapArgs.StartTime,
+				EndTime:    &twapArgs.EndTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // getQuoteDenomFromLiquidity gets the quote liquidity denom from the pool. In addition, validates that base denom
 // exists in the pool. Fails if not.
 func getQuoteDenomFromLiquidity(ctx context.Context, clientCtx client.Context, poolId uint64, baseDenom string) (string, error) {
@@ -21,6 +21,26 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) VolatilityToNow(grpcCtx context.Context,
+	req *queryproto.VolatilityToNowRequest,
+) (*queryproto.VolatilityToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.VolatilityToNow(ctx, *req)
+}
+
+func (q Querier) Volatility(grpcCtx context.Context,
+	req *queryproto.VolatilityRequest,
+) (*queryproto.VolatilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Volatility(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -31,6 +51,26 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) MedianTwapToNow(grpcCtx context.Context,
+	req *queryproto.MedianTwapToNowRequest,
+) (*queryproto.MedianTwapToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.MedianTwapToNow(ctx, *req)
+}
+
+func (q Querier) MedianTwap(grpcCtx context.Context,
+	req *queryproto.MedianTwapRequest,
+) (*queryproto.MedianTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.MedianTwap(ctx, *req)
+}
+
 func (q Querier) GeometricTwapToNow(grpcCtx context.Context,
 	req *queryproto.GeometricTwapToNowRequest,
 ) (*queryproto.GeometricTwapToNowResponse, error) {
@@ -61,6 +61,52 @@ func (q Querier) GeometricTwapToNow(ctx sdk.Context,
 	return &queryproto.GeometricTwapToNowResponse{GeometricTwap: twap}, err
 }
 
+func (q Querier) MedianTwap(ctx sdk.Context,
+	req queryproto.MedianTwapRequest,
+) (*queryproto.MedianTwapResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetMedianTwap(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.MedianTwapResponse{MedianTwap: twap}, err
+}
+
+func (q Querier) MedianTwapToNow(ctx sdk.Context,
+	req queryproto.MedianTwapToNowRequest,
+) (*queryproto.MedianTwapToNowResponse, error) {
+	twap, err := q.K.GetMedianTwapToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+
+	return &queryproto.MedianTwapToNowResponse{MedianTwap: twap}, err
+}
+
+func (q Querier) Volatility(ctx sdk.Context,
+	req queryproto.VolatilityRequest,
+) (*queryproto.VolatilityResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	volatility, err := q.K.GetVolatility(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.VolatilityResponse{Volatility: volatility}, err
+}
+
+func (q Querier) VolatilityToNow(ctx sdk.Context,
+	req queryproto.VolatilityToNowRequest,
+) (*queryproto.VolatilityToNowResponse, error) {
+	volatility, err := q.K.GetVolatilityToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+
+	return &queryproto.VolatilityToNowResponse{Volatility: volatility}, err
+}
+
 func (q Querier) Params(ctx sdk.Context,
 	req queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -14,6 +14,8 @@ type (
 	TwapStrategy           = twapStrategy
 	ArithmeticTwapStrategy = arithmetic
 	GeometricTwapStrategy  = geometric
+	VolatilityTwapStrategy = volatility
+	MedianTwapStrategy     = median
 )
 
 func (k Keeper) GetMostRecentRecordStoreRepresentation(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
@@ -60,6 +62,14 @@ func (s geometric) ComputeTwap(startRecord types.TwapRecord, endRecord types.Twa
 	return s.computeTwap(startRecord, endRecord, quoteAsset)
 }
 
+func (s volatility) ComputeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
+	return s.computeTwap(startRecord, endRecord, quoteAsset)
+}
+
+func (s median) ComputeMedian(startRecord types.TwapRecord, endRecord types.TwapRecord, records []types.TwapRecord, quoteAsset string) osmomath.Dec {
+	return s.computeMedian(startRecord, endRecord, records, quoteAsset)
+}
+
 func RecordWithUpdatedAccumulators(record types.TwapRecord, t time.Time) types.TwapRecord {
 	return recordWithUpdatedAccumulators(record, t)
 }
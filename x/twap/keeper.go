@@ -100,6 +100,16 @@ func (k Keeper) GetArithmeticStrategy() *arithmetic {
 	return &arithmetic{k}
 }
 
+// GetVolatilityStrategy gets volatility TWAP keeper.
+func (k Keeper) GetVolatilityStrategy() *volatility {
+	return &volatility{k}
+}
+
+// GetMedianStrategy gets median TWAP keeper.
+func (k Keeper) GetMedianStrategy() *median {
+	return &median{k}
+}
+
 // GetPruningState gets the current pruning state, which is used to determine
 // whether to prune historical records in the EndBlock. This allows us to spread
 // out the computational cost of pruning over time rather than all at once at epoch.
@@ -19,17 +19,18 @@ func newTwapRecord(k types.PoolManagerInterface, ctx sdk.Context, poolId uint64,
 	previousErrorTime := time.Time{} // no previous error
 	sp0, sp1, lastErrorTime := getSpotPrices(ctx, k, poolId, denom0, denom1, previousErrorTime)
 	return types.TwapRecord{
-		PoolId:                      poolId,
-		Asset0Denom:                 denom0,
-		Asset1Denom:                 denom1,
-		Height:                      ctx.BlockHeight(),
-		Time:                        ctx.BlockTime(),
-		P0LastSpotPrice:             sp0,
-		P1LastSpotPrice:             sp1,
-		P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
-		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
-		GeometricTwapAccumulator:    osmomath.ZeroDec(),
-		LastErrorTime:               lastErrorTime,
+		PoolId:                          poolId,
+		Asset0Denom:                     denom0,
+		Asset1Denom:                     denom1,
+		Height:                          ctx.BlockHeight(),
+		Time:                            ctx.BlockTime(),
+		P0LastSpotPrice:                 sp0,
+		P1LastSpotPrice:                 sp1,
+		P0ArithmeticTwapAccumulator:     osmomath.ZeroDec(),
+		P1ArithmeticTwapAccumulator:     osmomath.ZeroDec(),
+		GeometricTwapAccumulator:        osmomath.ZeroDec(),
+		GeometricSquaredTwapAccumulator: osmomath.ZeroDec(),
+		LastErrorTime:                   lastErrorTime,
 	}, nil
 }
 
@@ -247,6 +248,10 @@ func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) t
 	p0NewGeomAccum := types.SpotPriceMulDuration(logP0SpotPrice, timeDelta)
 	newRecord.GeometricTwapAccumulator = p0NewGeomAccum.AddMut(newRecord.GeometricTwapAccumulator)
 
+	// p0NewGeomSquaredAccum = (log_{2}{P_0})^2 * timeDelta
+	p0NewGeomSquaredAccum := types.SpotPriceMulDuration(logP0SpotPrice.Mul(logP0SpotPrice), timeDelta)
+	newRecord.GeometricSquaredTwapAccumulator = p0NewGeomSquaredAccum.AddMut(newRecord.GeometricSquaredTwapAccumulator)
+
 	return newRecord
 }
 
@@ -282,29 +287,46 @@ func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, asse
 // precondition: endRecord.Time >= startRecord.Time
 // if (endRecord.LastErrorTime >= startRecord.Time) returns an error at end + result
 // if (startRecord.LastErrorTime == startRecord.Time) returns an error at end + result
-// if (endRecord.Time == startRecord.Time) returns endRecord.LastSpotPrice
+// if (endRecord.Time == startRecord.Time) returns the strategy's result for the end record alone
 // else returns
 // (endRecord.Accumulator - startRecord.Accumulator) / (endRecord.Time - startRecord.Time)
 func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string, strategy twapStrategy) (osmomath.Dec, error) {
 	// see if we need to return an error, due to spot price issues
-	var err error = nil
-	if endRecord.LastErrorTime.After(startRecord.Time) ||
-		endRecord.LastErrorTime.Equal(startRecord.Time) ||
-		startRecord.LastErrorTime.Equal(startRecord.Time) {
-		err = errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
-	}
+	err := spotPriceErrorInRange(startRecord, endRecord)
 	timeDelta := endRecord.Time.Sub(startRecord.Time)
-	// if time difference is 0, then return the last spot price based off of start.
+	// if time difference is 0, then the strategy computes the result from the end record alone.
 	if timeDelta == time.Duration(0) {
-		if quoteAsset == startRecord.Asset0Denom {
-			return endRecord.P0LastSpotPrice, err
-		}
-		return endRecord.P1LastSpotPrice, err
+		return strategy.computeTwapOverEmptyRange(endRecord, quoteAsset), err
 	}
 
 	return strategy.computeTwap(startRecord, endRecord, quoteAsset), err
 }
 
+// computeMedianTwap computes and returns a time weighted median price
+// between two records given the quote asset and the historical records written in between.
+// precondition: endRecord.Time >= startRecord.Time
+// Errors are returned under the same conditions as computeTwap.
+// if (endRecord.Time == startRecord.Time) returns endRecord.LastSpotPrice
+func computeMedianTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, records []types.TwapRecord, quoteAsset string, strategy *median) (osmomath.Dec, error) {
+	err := spotPriceErrorInRange(startRecord, endRecord)
+	if endRecord.Time.Equal(startRecord.Time) {
+		return lastSpotPrice(endRecord, quoteAsset), err
+	}
+
+	return strategy.computeMedian(startRecord, endRecord, records, quoteAsset), err
+}
+
+// spotPriceErrorInRange returns an error if a spot price error occurred
+// between the start and end record, in which case the twap result may be faulty.
+func spotPriceErrorInRange(startRecord types.TwapRecord, endRecord types.TwapRecord) error {
+	if endRecord.LastErrorTime.After(startRecord.Time) ||
+		endRecord.LastErrorTime.Equal(startRecord.Time) ||
+		startRecord.LastErrorTime.Equal(startRecord.Time) {
+		return errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
+	}
+	return nil
+}
+
 // twapLog returns the logarithm of the given spot price, base 2.
 // Panics if zero is given.
 func twapLog(price osmomath.Dec) osmomath.Dec {
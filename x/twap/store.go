@@ -2,6 +2,7 @@ package twap
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v29/x/twap/types"
 )
@@ -28,6 +30,11 @@ var NumRecordsToPrunePerBlock uint16 = 200
 // After all these records are pruned, this logic can be removed for a future upgrade.
 var NumDeprecatedRecordsToPrunePerBlock uint16 = 200
 
+// MaxMedianTwapRecords is the maximum number of historical twap records read to compute a time weighted median.
+// A pool can write a record every block, so this bounds the cost of a median over a long window.
+// Medians over windows with more records fail, and must be computed over shorter windows.
+var MaxMedianTwapRecords = 5_000
+
 type timeTooOldError struct {
 	Time time.Time
 }
@@ -269,6 +276,35 @@ func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.T
 	return twap, nil
 }
 
+// getRecordsInTimeRange returns the historical records for (id, asset0, asset1)
+// with a time in (startTime, endTime], in ascending time order.
+// Returns error if there are more than maxRecords records in the range, without reading the others.
+func (k Keeper) getRecordsInTimeRange(ctx sdk.Context, poolId uint64, startTime, endTime time.Time, asset0Denom string, asset1Denom string, maxRecords int) ([]types.TwapRecord, error) {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
+	if err != nil {
+		return nil, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	// The time suffix sorts after the key of a record at exactly that time.
+	startKey := types.FormatHistoricalPoolIndexTimeSuffix(poolId, asset0Denom, asset1Denom, startTime)
+	endKey := types.FormatHistoricalPoolIndexTimeSuffix(poolId, asset0Denom, asset1Denom, endTime)
+	iterator := store.Iterator(startKey, endKey)
+	defer iterator.Close()
+
+	records := []types.TwapRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		if len(records) == maxRecords {
+			return nil, types.TooManyRecordsInRangeError{PoolId: poolId, StartTime: startTime, EndTime: endTime, MaxRecords: maxRecords}
+		}
+		record, err := types.ParseTwapFromBz(iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
 // DeleteHistoricalTimeIndexedTWAPs deletes every historical twap record indexed by time (now deprecated) up till the limit.
 // This is to be used in the upgrade handler, to clear out the now-obsolete historical twap records
 // that were indexed by time.
@@ -304,3 +340,80 @@ func (k Keeper) DeleteDeprecatedHistoricalTWAPsIsPruning(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.DeprecatedHistoricalTWAPsIsPruningKey)
 }
+
+// BackfillGeometricSquaredAccumulators sets the geometric squared accumulator of the records written before it existed.
+// The accumulator is replayed through the historical records of each (pool, asset0, asset1), starting at zero on the
+// oldest one, the same way it is updated when records are written. Only accumulator differences are used by queries, so
+// every range covered by the historical records can be queried as if the accumulator had always been tracked.
+// Pairs are backfilled one at a time while iterating over the store. A pair whose records are inconsistent is logged
+// and left untouched, so that it does not halt the upgrade.
+// This is to be used in the upgrade handler that introduces the accumulator.
+func (k Keeper) BackfillGeometricSquaredAccumulators(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	start := []byte(types.HistoricalTWAPPoolIndexPrefix)
+	end := storetypes.PrefixEndBytes(start)
+	for {
+		// Historical records are ordered by (pool, asset0, asset1) and then by time,
+		// so the first record after the previous pair is the oldest record of the next pair.
+		oldest, err := osmoutils.GetFirstValueInRange(store, start, end, false, types.ParseTwapFromBz)
+		if errors.Is(err, osmoutils.ErrNoValuesInRange) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		pairPrefix := types.FormatHistoricalPoolIndexTimePrefix(oldest.PoolId, oldest.Asset0Denom, oldest.Asset1Denom)
+		err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.backfillPairGeometricSquaredAccumulators(cacheCtx, pairPrefix)
+		})
+		if err != nil {
+			ctx.Logger().Error("skipped backfilling the geometric squared twap accumulator",
+				"pool_id", oldest.PoolId, "asset0", oldest.Asset0Denom, "asset1", oldest.Asset1Denom, "error", err)
+		}
+		start = storetypes.PrefixEndBytes(pairPrefix)
+	}
+}
+
+// backfillPairGeometricSquaredAccumulators replays the geometric squared accumulator through the historical records
+// with the given (pool, asset0, asset1) prefix, then sets it on the most recent record of the pair.
+func (k Keeper) backfillPairGeometricSquaredAccumulators(ctx sdk.Context, pairPrefix []byte) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, pairPrefix)
+	defer iterator.Close()
+
+	var previous types.TwapRecord
+	for isOldest := true; iterator.Valid(); iterator.Next() {
+		record, err := types.ParseTwapFromBz(iterator.Value())
+		if err != nil {
+			return err
+		}
+		if isOldest {
+			record.GeometricSquaredTwapAccumulator = osmomath.ZeroDec()
+			isOldest = false
+		} else {
+			record.GeometricSquaredTwapAccumulator = recordWithUpdatedAccumulators(previous, record.Time).GeometricSquaredTwapAccumulator
+		}
+		// Overwriting the record does not change the keys being iterated over.
+		k.StoreHistoricalTWAP(ctx, record)
+		previous = record
+	}
+	return k.backfillMostRecentGeometricSquaredAccumulator(ctx, previous)
+}
+
+// backfillMostRecentGeometricSquaredAccumulator sets the geometric squared accumulator of the most recent record of
+// the pair of the given record, which must be its newest backfilled historical record.
+func (k Keeper) backfillMostRecentGeometricSquaredAccumulator(ctx sdk.Context, newestHistorical types.TwapRecord) error {
+	mostRecent, err := k.getMostRecentRecordStoreRepresentation(ctx, newestHistorical.PoolId, newestHistorical.Asset0Denom, newestHistorical.Asset1Denom)
+	if err != nil {
+		return err
+	}
+	if mostRecent.Time.Before(newestHistorical.Time) {
+		return fmt.Errorf("most recent twap record of pool %d (%s, %s) is older than its newest historical record",
+			mostRecent.PoolId, mostRecent.Asset0Denom, mostRecent.Asset1Denom)
+	}
+	mostRecent.GeometricSquaredTwapAccumulator = recordWithUpdatedAccumulators(newestHistorical, mostRecent.Time).GeometricSquaredTwapAccumulator
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.FormatMostRecentTWAPKey(mostRecent.PoolId, mostRecent.Asset0Denom, mostRecent.Asset1Denom), &mostRecent)
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v29/x/twap"
 
 	storetypes "cosmossdk.io/store/types"
@@ -934,3 +935,74 @@ func (s *TestSuite) prepPoolsAndRemoveRecords(poolCoins []sdk.Coins) {
 		iter.Next()
 	}
 }
+
+// TestBackfillGeometricSquaredAccumulators tests that the geometric squared accumulator of records written before it
+// existed is replayed through the historical records of each pair, and set on the most recent records.
+func (s *TestSuite) TestBackfillGeometricSquaredAccumulators() {
+	s.SetupTest()
+	twoSeconds := baseTime.Add(2 * time.Second)
+	threeSeconds := baseTime.Add(3 * time.Second)
+
+	// Records of pool 1, with a spot price error between two and three seconds
+	poolOneRecords := []types.TwapRecord{
+		withPrice0Set(newEmptyPriceRecord(1, baseTime, denom0, denom1), osmomath.NewDec(2)),
+		withPrice0Set(newEmptyPriceRecord(1, tPlusOne, denom0, denom1), osmomath.NewDec(4)),
+		newEmptyPriceRecord(1, twoSeconds, denom0, denom1),
+		withPrice0Set(newEmptyPriceRecord(1, threeSeconds, denom0, denom1), osmomath.NewDec(8)),
+	}
+	// Records of pool 2, which start their own accumulation
+	poolTwoRecords := []types.TwapRecord{
+		withPrice0Set(newEmptyPriceRecord(2, tPlusOne, denom0, denom1), osmomath.NewDec(4)),
+		withPrice0Set(newEmptyPriceRecord(2, twoSeconds, denom0, denom1), osmomath.NewDec(2)),
+	}
+	// Records of pool 3, whose most recent record is older than its newest historical record
+	poolThreeRecords := []types.TwapRecord{
+		withPrice0Set(newEmptyPriceRecord(3, baseTime, denom0, denom1), osmomath.NewDec(2)),
+		withPrice0Set(newEmptyPriceRecord(3, tPlusOne, denom0, denom1), osmomath.NewDec(4)),
+	}
+	s.preSetRecords(poolOneRecords)
+	s.preSetRecords(poolTwoRecords)
+	s.preSetRecords(poolThreeRecords)
+	store := s.Ctx.KVStore(s.App.AppKeepers.GetKey(types.StoreKey))
+	osmoutils.MustSet(store, types.FormatMostRecentTWAPKey(3, denom0, denom1), &poolThreeRecords[0])
+	poolThreeHistoricalRecords := s.getAllHistoricalRecordsForPool(3)
+
+	squaredLogMs := func(price osmomath.Dec, ms int64) osmomath.Dec {
+		log := twap.TwapLog(price)
+		return log.Mul(log).MulInt64(ms)
+	}
+	twoSquared := squaredLogMs(osmomath.NewDec(2), 1000)
+	fourSquared := squaredLogMs(osmomath.NewDec(4), 1000)
+	expectedPoolOne := []osmomath.Dec{
+		osmomath.ZeroDec(),
+		twoSquared,
+		twoSquared.Add(fourSquared),
+		// The accumulator is not updated during a spot price error
+		twoSquared.Add(fourSquared),
+	}
+	expectedPoolTwo := []osmomath.Dec{
+		osmomath.ZeroDec(),
+		fourSquared,
+	}
+
+	err := s.twapkeeper.BackfillGeometricSquaredAccumulators(s.Ctx)
+	s.Require().NoError(err)
+
+	for poolId, expected := range map[uint64][]osmomath.Dec{1: expectedPoolOne, 2: expectedPoolTwo} {
+		records := s.getAllHistoricalRecordsForPool(poolId)
+		s.Require().Len(records, len(expected))
+		for i, record := range records {
+			s.Require().Equal(expected[i], record.GeometricSquaredTwapAccumulator, "pool %d record %d", poolId, i)
+		}
+
+		mostRecent, err := s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, poolId, denom0, denom1)
+		s.Require().NoError(err)
+		s.Require().Equal(expected[len(expected)-1], mostRecent.GeometricSquaredTwapAccumulator)
+	}
+
+	// The inconsistent pair is skipped and left untouched, without failing the backfill of the others.
+	s.Require().Equal(poolThreeHistoricalRecords, s.getAllHistoricalRecordsForPool(3))
+	mostRecent, err := s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, 3, denom0, denom1)
+	s.Require().NoError(err)
+	s.Require().Equal(poolThreeRecords[0], mostRecent)
+}
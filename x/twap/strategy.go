@@ -1,18 +1,27 @@
 package twap
 
 import (
+	"sort"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v29/x/twap/types"
 
 	gammtypes "github.com/osmosis-labs/osmosis/v29/x/gamm/types"
 )
 
+// ln2Squared is (ln 2)^2, used to convert the variance of base 2 logarithms
+// into the variance of natural logarithms.
+var ln2Squared = osmomath.MustNewDecFromStr("0.480453013918201425")
+
 // twapStrategy is an interface for computing TWAPs.
-// We have two strategies implementing the interface - arithmetic and geometric.
+// We have three strategies implementing the interface - arithmetic, geometric and volatility.
 // We expose a common TWAP API to reduce duplication and avoid complexity.
 type twapStrategy interface {
 	// computeTwap calculates the TWAP with specific startRecord and endRecord.
 	computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec
+	// computeTwapOverEmptyRange calculates the TWAP when the start and end times are equal,
+	// given the record at that time.
+	computeTwapOverEmptyRange(record types.TwapRecord, quoteAsset string) osmomath.Dec
 }
 
 type arithmetic struct {
@@ -23,6 +32,17 @@ type geometric struct {
 	TwapKeeper Keeper
 }
 
+type volatility struct {
+	TwapKeeper Keeper
+}
+
+// median does not implement twapStrategy as a time weighted median
+// cannot be derived from the difference of two accumulators. Instead, it is computed
+// from the spot prices of every historical record within the window.
+type median struct {
+	TwapKeeper Keeper
+}
+
 // computeTwap computes and returns an arithmetic TWAP between
 // two records given the quote asset.
 func (s *arithmetic) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
@@ -36,6 +56,11 @@ func (s *arithmetic) computeTwap(startRecord types.TwapRecord, endRecord types.T
 	return types.AccumDiffDivDuration(accumDiff, timeDelta)
 }
 
+// computeTwapOverEmptyRange returns the last spot price of the record given the quote asset.
+func (s *arithmetic) computeTwapOverEmptyRange(record types.TwapRecord, quoteAsset string) osmomath.Dec {
+	return lastSpotPrice(record, quoteAsset)
+}
+
 // computeTwap computes and returns a geometric TWAP between
 // two records given the quote asset.
 func (s *geometric) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
@@ -70,3 +95,100 @@ func (s *geometric) computeTwap(startRecord types.TwapRecord, endRecord types.Tw
 	// by the underlying spot price function.
 	return osmomath.SigFigRound(result.Dec(), gammtypes.SpotPriceSigFigs)
 }
+
+// computeTwapOverEmptyRange returns the last spot price of the record given the quote asset.
+func (s *geometric) computeTwapOverEmptyRange(record types.TwapRecord, quoteAsset string) osmomath.Dec {
+	return lastSpotPrice(record, quoteAsset)
+}
+
+// computeTwap computes and returns the time weighted variance of the natural logarithm
+// of the spot price between two records, i.e. the realized volatility over the window.
+// Since log(1/p) = -log(p), the variance is the same for either quote asset.
+//
+// variance = E[log(p)^2] - E[log(p)]^2
+func (s *volatility) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
+	timeDelta := types.CanonicalTimeMs(endRecord.Time) - types.CanonicalTimeMs(startRecord.Time)
+
+	accumDiff := endRecord.GeometricTwapAccumulator.Sub(startRecord.GeometricTwapAccumulator)
+	meanOfLogPrices := types.AccumDiffDivDuration(accumDiff, timeDelta)
+
+	squaredAccumDiff := endRecord.GeometricSquaredTwapAccumulator.Sub(startRecord.GeometricSquaredTwapAccumulator)
+	meanOfSquaredLogPrices := types.AccumDiffDivDuration(squaredAccumDiff, timeDelta)
+
+	variance := meanOfSquaredLogPrices.Sub(meanOfLogPrices.Mul(meanOfLogPrices))
+	// N.B. the variance may be slightly negative due to rounding when the price is constant.
+	if variance.IsNegative() {
+		return osmomath.ZeroDec()
+	}
+
+	return variance.Mul(ln2Squared)
+}
+
+// computeTwapOverEmptyRange returns zero, as the price does not vary over an empty range.
+func (s *volatility) computeTwapOverEmptyRange(record types.TwapRecord, quoteAsset string) osmomath.Dec {
+	return osmomath.ZeroDec()
+}
+
+// lastSpotPrice returns the last spot price of the record given the quote asset.
+func lastSpotPrice(record types.TwapRecord, quoteAsset string) osmomath.Dec {
+	if quoteAsset == record.Asset0Denom {
+		return record.P0LastSpotPrice
+	}
+	return record.P1LastSpotPrice
+}
+
+// computeMedian computes and returns the time weighted median of the spot price between
+// two records given the quote asset. records are the historical records written after
+// startRecord.Time and at or before endRecord.Time, in ascending time order.
+// Each spot price is weighted by the time it was in effect, starting from the start record.
+//
+// pre-condition: endRecord.Time > startRecord.Time
+func (s *median) computeMedian(startRecord types.TwapRecord, endRecord types.TwapRecord, records []types.TwapRecord, quoteAsset string) osmomath.Dec {
+	type weightedPrice struct {
+		price  osmomath.Dec
+		weight int64
+	}
+
+	spotPrice := func(record types.TwapRecord) osmomath.Dec {
+		if quoteAsset == startRecord.Asset0Denom {
+			return record.P0LastSpotPrice
+		}
+		return record.P1LastSpotPrice
+	}
+
+	records = append([]types.TwapRecord{startRecord}, records...)
+	endTimeMs := types.CanonicalTimeMs(endRecord.Time)
+
+	weightedPrices := make([]weightedPrice, 0, len(records))
+	totalWeight := int64(0)
+	for i, record := range records {
+		untilMs := endTimeMs
+		if i+1 < len(records) {
+			untilMs = types.CanonicalTimeMs(records[i+1].Time)
+		}
+		weight := untilMs - types.CanonicalTimeMs(record.Time)
+		if weight <= 0 {
+			continue
+		}
+		weightedPrices = append(weightedPrices, weightedPrice{price: spotPrice(record), weight: weight})
+		totalWeight += weight
+	}
+
+	if len(weightedPrices) == 0 {
+		return spotPrice(endRecord)
+	}
+
+	sort.SliceStable(weightedPrices, func(i, j int) bool {
+		return weightedPrices[i].price.LT(weightedPrices[j].price)
+	})
+
+	cumulativeWeight := int64(0)
+	for _, wp := range weightedPrices {
+		cumulativeWeight += wp.weight
+		if 2*cumulativeWeight >= totalWeight {
+			return wp.price
+		}
+	}
+
+	return weightedPrices[len(weightedPrices)-1].price
+}
@@ -33,6 +33,10 @@ func (s *TestSuite) TestComputeTwap() {
 		TwapKeeper: *s.App.TwapKeeper,
 	}
 
+	volatilityStrategy := &twap.VolatilityTwapStrategy{
+		TwapKeeper: *s.App.TwapKeeper,
+	}
+
 	tests := map[string]computeTwapTestCase{
 		"arithmetic only, basic: spot price = 1 for one second, 0 init accumulator": {
 			startRecord: newOneSidedRecord(baseTime, osmomath.ZeroDec(), true),
@@ -74,6 +78,15 @@ func (s *TestSuite) TestComputeTwap() {
 			},
 			expTwap: osmomath.OneDec(),
 		},
+		"volatility only, same record: end spot price = 1": {
+			startRecord: newOneSidedRecord(baseTime, osmomath.ZeroDec(), true),
+			endRecord:   newOneSidedRecord(baseTime, osmomath.ZeroDec(), true),
+			quoteAsset:  denom1,
+			twapStrategies: []twap.TwapStrategy{
+				volatilityStrategy,
+			},
+			expTwap: osmomath.ZeroDec(),
+		},
 		"arithmetic only: accumulator = 10*OneSec, t=5s. 0 base accum": testCaseFromDeltas(
 			s, osmomath.ZeroDec(), tenSecAccum, 5*time.Second, osmomath.NewDec(2)),
 		"arithmetic only: accumulator = 10*OneSec, t=100s. 0 base accum (asset 1)": testCaseFromDeltasAsset1(s, osmomath.ZeroDec(), OneSec.MulInt64(10), 100*time.Second, osmomath.NewDecWithPrec(1, 1)),
@@ -375,3 +388,127 @@ func (s *TestSuite) TestComputeGeometricStrategyTwap_ThreeAsset() {
 		})
 	}
 }
+
+// TestComputeVolatilityStrategyTwap tests volatility strategy's computeTwap.
+// The expected values are the variance of the base 2 logarithm of the price, converted to natural logarithm.
+func (s *TestSuite) TestComputeVolatilityStrategyTwap() {
+	ln2Squared := osmomath.MustNewDecFromStr("0.480453013918201425")
+	newVolatilityRecord := func(t time.Time, geomAccum, geomSquaredAccum osmomath.Dec) types.TwapRecord {
+		record := newOneSidedGeometricRecord(t, geomAccum)
+		record.GeometricSquaredTwapAccumulator = geomSquaredAccum
+		return record
+	}
+
+	tests := map[string]struct {
+		startRecord types.TwapRecord
+		endRecord   types.TwapRecord
+		expVariance osmomath.Dec
+	}{
+		"constant price: zero variance": {
+			startRecord: newVolatilityRecord(baseTime, osmomath.ZeroDec(), osmomath.ZeroDec()),
+			endRecord:   newVolatilityRecord(tPlusOne, geometricTenSecAccum, geometricSquaredTenSecAccum),
+			expVariance: osmomath.ZeroDec(),
+		},
+		"constant price, non-zero start accumulators: zero variance": {
+			startRecord: newVolatilityRecord(baseTime, geometricTenSecAccum, geometricSquaredTenSecAccum),
+			endRecord:   newVolatilityRecord(baseTime.Add(3*time.Second), geometricTenSecAccum.MulInt64(4), geometricSquaredTenSecAccum.MulInt64(4)),
+			expVariance: osmomath.ZeroDec(),
+		},
+		// log2(2) = 1 for 1s and log2(8) = 3 for 1s: mean = 2, mean of squares = 5, variance = 1.
+		"price of 2 then 8 for one second each: log2 variance of 1": {
+			startRecord: newVolatilityRecord(baseTime, osmomath.ZeroDec(), osmomath.ZeroDec()),
+			endRecord:   newVolatilityRecord(baseTime.Add(2*time.Second), OneSec.MulInt64(1+3), OneSec.MulInt64(1+9)),
+			expVariance: ln2Squared,
+		},
+		// log2(2) = 1 for 3s and log2(32) = 5 for 1s: mean = 2, mean of squares = 7, variance = 3.
+		"price of 2 for three seconds then 32 for one second: log2 variance of 3": {
+			startRecord: newVolatilityRecord(baseTime, osmomath.ZeroDec(), osmomath.ZeroDec()),
+			endRecord:   newVolatilityRecord(baseTime.Add(4*time.Second), OneSec.MulInt64(3+5), OneSec.MulInt64(3+25)),
+			expVariance: ln2Squared.MulInt64(3),
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			volatilityStrategy := &twap.VolatilityTwapStrategy{TwapKeeper: *s.App.TwapKeeper}
+			for _, quoteAsset := range []string{denom0, denom1} {
+				actualVariance := volatilityStrategy.ComputeTwap(test.startRecord, test.endRecord, quoteAsset)
+				s.Require().Equal(test.expVariance, actualVariance)
+			}
+		})
+	}
+}
+
+// TestComputeMedianStrategy tests median strategy's computeMedian.
+func (s *TestSuite) TestComputeMedianStrategy() {
+	withSpotPrices := func(t time.Time, sp0 int64) types.TwapRecord {
+		return recordWithUpdatedSpotPrice(newOneSidedRecord(t, osmomath.ZeroDec(), true), osmomath.NewDec(sp0), osmomath.OneDec().QuoInt64(sp0))
+	}
+
+	tests := map[string]struct {
+		startRecord types.TwapRecord
+		endRecord   types.TwapRecord
+		records     []types.TwapRecord
+		quoteAsset  string
+		expMedian   osmomath.Dec
+	}{
+		"no records in between: start record spot price": {
+			startRecord: withSpotPrices(baseTime, 4),
+			endRecord:   withSpotPrices(tPlusOne, 4),
+			quoteAsset:  denom0,
+			expMedian:   osmomath.NewDec(4),
+		},
+		"price held the longest is the median": {
+			startRecord: withSpotPrices(baseTime, 1),
+			endRecord:   withSpotPrices(baseTime.Add(10*time.Second), 3),
+			records: []types.TwapRecord{
+				withSpotPrices(baseTime.Add(time.Second), 5),
+				withSpotPrices(baseTime.Add(2*time.Second), 3),
+			},
+			quoteAsset: denom0,
+			expMedian:  osmomath.NewDec(3),
+		},
+		"short lived spike does not move the median": {
+			startRecord: withSpotPrices(baseTime, 2),
+			endRecord:   withSpotPrices(baseTime.Add(10*time.Second), 1000),
+			records: []types.TwapRecord{
+				withSpotPrices(baseTime.Add(8*time.Second), 1000),
+			},
+			quoteAsset: denom0,
+			expMedian:  osmomath.NewDec(2),
+		},
+		"denom1: inverse prices": {
+			startRecord: withSpotPrices(baseTime, 2),
+			endRecord:   withSpotPrices(baseTime.Add(10*time.Second), 1000),
+			records: []types.TwapRecord{
+				withSpotPrices(baseTime.Add(8*time.Second), 1000),
+			},
+			quoteAsset: denom1,
+			expMedian:  osmomath.OneDec().QuoInt64(2),
+		},
+		"record at end time has no weight": {
+			startRecord: withSpotPrices(baseTime, 2),
+			endRecord:   withSpotPrices(tPlusOne, 7),
+			records: []types.TwapRecord{
+				withSpotPrices(tPlusOne, 7),
+			},
+			quoteAsset: denom0,
+			expMedian:  osmomath.NewDec(2),
+		},
+		"exactly half of the window: lower price is the median": {
+			startRecord: withSpotPrices(baseTime, 6),
+			endRecord:   withSpotPrices(baseTime.Add(2*time.Second), 4),
+			records: []types.TwapRecord{
+				withSpotPrices(tPlusOne, 4),
+			},
+			quoteAsset: denom0,
+			expMedian:  osmomath.NewDec(4),
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			medianStrategy := &twap.MedianTwapStrategy{TwapKeeper: *s.App.TwapKeeper}
+			actualMedian := medianStrategy.ComputeMedian(test.startRecord, test.endRecord, test.records, test.quoteAsset)
+			s.Require().Equal(test.expMedian, actualMedian)
+		})
+	}
+}
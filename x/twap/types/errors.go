@@ -25,6 +25,18 @@ func (e StartTimeAfterEndTimeError) Error() string {
 		" (start time %s, end time %s)", e.StartTime, e.EndTime)
 }
 
+type TooManyRecordsInRangeError struct {
+	PoolId     uint64
+	StartTime  time.Time
+	EndTime    time.Time
+	MaxRecords int
+}
+
+func (e TooManyRecordsInRangeError) Error() string {
+	return fmt.Sprintf("pool %d has more than %d twap records between %s and %s, use a shorter time range",
+		e.PoolId, e.MaxRecords, e.StartTime, e.EndTime)
+}
+
 type KeySeparatorLengthError struct {
 	ExpectedLength int
 	ActualLength   int
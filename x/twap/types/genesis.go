@@ -84,5 +84,10 @@ func (t TwapRecord) validate() error {
 	if t.GeometricTwapAccumulator.IsNil() {
 		return fmt.Errorf("twap record geometric accumulator cannot be nil, was (%s)", t.GeometricTwapAccumulator)
 	}
+
+	// records exported before the geometric squared accumulator was introduced do not have it set.
+	if !t.GeometricSquaredTwapAccumulator.IsNil() && t.GeometricSquaredTwapAccumulator.IsNegative() {
+		return fmt.Errorf("twap record geometric squared accumulator cannot be negative, was (%s)", t.GeometricSquaredTwapAccumulator)
+	}
 	return nil
 }
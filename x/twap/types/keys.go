@@ -108,5 +108,8 @@ func ParseTwapFromBz(bz []byte) (twap TwapRecord, err error) {
 	if twap.GeometricTwapAccumulator.IsNil() {
 		twap.GeometricTwapAccumulator = osmomath.ZeroDec()
 	}
+	if twap.GeometricSquaredTwapAccumulator.IsNil() {
+		twap.GeometricSquaredTwapAccumulator = osmomath.ZeroDec()
+	}
 	return twap, err
 }
@@ -61,16 +61,17 @@ func TestFormatHistoricalTwapKeys(t *testing.T) {
 func TestParseTwapFromBz(t *testing.T) {
 	baseTime := time.Unix(1257894000, 0).UTC()
 	baseParseRecord := TwapRecord{
-		PoolId:                      123,
-		Asset0Denom:                 "B",
-		Asset1Denom:                 "A",
-		Height:                      1,
-		Time:                        baseTime,
-		P0LastSpotPrice:             osmomath.NewDecWithPrec(1, 5),
-		P1LastSpotPrice:             osmomath.NewDecWithPrec(2, 5), // inconsistent value
-		P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
-		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
-		GeometricTwapAccumulator:    osmomath.ZeroDec(),
+		PoolId:                          123,
+		Asset0Denom:                     "B",
+		Asset1Denom:                     "A",
+		Height:                          1,
+		Time:                            baseTime,
+		P0LastSpotPrice:                 osmomath.NewDecWithPrec(1, 5),
+		P1LastSpotPrice:                 osmomath.NewDecWithPrec(2, 5), // inconsistent value
+		P0ArithmeticTwapAccumulator:     osmomath.ZeroDec(),
+		P1ArithmeticTwapAccumulator:     osmomath.ZeroDec(),
+		GeometricTwapAccumulator:        osmomath.ZeroDec(),
+		GeometricSquaredTwapAccumulator: osmomath.ZeroDec(),
 	}
 
 	withGeomAcc := func(r TwapRecord, acc osmomath.Dec) TwapRecord {
@@ -57,6 +57,10 @@ type TwapRecord struct {
 	// It is used to alert the caller if they are getting a potentially erroneous
 	// TWAP, due to an unforeseen underlying error.
 	LastErrorTime time.Time `protobuf:"bytes,11,opt,name=last_error_time,json=lastErrorTime,proto3,stdtime" json:"last_error_time" yaml:"last_error_time"`
+	// Accumulates the square of the base 2 logarithm of the spot price over time.
+	// Together with the geometric accumulator, it is used to compute the
+	// variance of the log price over a window.
+	GeometricSquaredTwapAccumulator cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=geometric_squared_twap_accumulator,json=geometricSquaredTwapAccumulator,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"geometric_squared_twap_accumulator"`
 }
 
 func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
@@ -226,47 +230,49 @@ func init() {
 }
 
 var fileDescriptor_dbf5c78678e601aa = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0xdb, 0x4e,
-	0x10, 0x8d, 0x21, 0xbf, 0x00, 0x9b, 0xf0, 0x43, 0x58, 0xb4, 0x58, 0x41, 0xb5, 0x83, 0x2b, 0x55,
-	0xe1, 0x50, 0x3b, 0xa6, 0xa7, 0xd2, 0x13, 0x11, 0x3d, 0xb4, 0x45, 0x55, 0x64, 0x38, 0xf5, 0x62,
-	0x6d, 0xec, 0xc1, 0xb1, 0x88, 0xb3, 0xab, 0xdd, 0x0d, 0x34, 0xdf, 0x82, 0xaf, 0xd4, 0x1b, 0x47,
-	0x8e, 0x55, 0x0f, 0x69, 0x05, 0xb7, 0x1e, 0x39, 0xf6, 0x54, 0xed, 0xae, 0x93, 0x12, 0xfa, 0x07,
-	0xb8, 0x79, 0x66, 0xdf, 0xbc, 0xf7, 0xc6, 0x3b, 0x3b, 0xe8, 0x19, 0xe1, 0x39, 0xe1, 0x19, 0xf7,
-	0xc5, 0x29, 0xa6, 0xfe, 0x49, 0xd0, 0x05, 0x81, 0x03, 0x15, 0x44, 0x0c, 0x62, 0xc2, 0x12, 0x8f,
-	0x32, 0x22, 0x88, 0xb9, 0x56, 0xe0, 0x3c, 0x79, 0xe4, 0x15, 0xb8, 0xfa, 0x5a, 0x4a, 0x52, 0xa2,
-	0x00, 0xbe, 0xfc, 0xd2, 0xd8, 0xba, 0x93, 0x12, 0x92, 0xf6, 0xc1, 0x57, 0x51, 0x77, 0x78, 0xe4,
-	0x8b, 0x2c, 0x07, 0x2e, 0x70, 0x4e, 0x35, 0xc0, 0xfd, 0x54, 0x41, 0xe8, 0xf0, 0x14, 0xd3, 0x50,
-	0x29, 0x98, 0xeb, 0x68, 0x81, 0x12, 0xd2, 0x8f, 0xb2, 0xc4, 0x32, 0x1a, 0x46, 0xb3, 0x1c, 0x56,
-	0x64, 0xf8, 0x26, 0x31, 0x37, 0x51, 0x0d, 0x73, 0x0e, 0xa2, 0x15, 0x25, 0x30, 0x20, 0xb9, 0x35,
-	0xd7, 0x30, 0x9a, 0x4b, 0x61, 0x55, 0xe7, 0xf6, 0x64, 0x6a, 0x0a, 0x09, 0x0a, 0xc8, 0xfc, 0x0d,
-	0x48, 0xa0, 0x21, 0xbb, 0xa8, 0xd2, 0x83, 0x2c, 0xed, 0x09, 0xab, 0xdc, 0x30, 0x9a, 0xf3, 0xed,
-	0xad, 0xef, 0x63, 0x67, 0x59, 0x37, 0x17, 0xe9, 0x83, 0xeb, 0xb1, 0xb3, 0x36, 0xc2, 0x79, 0x7f,
-	0xc7, 0x9d, 0x49, 0xbb, 0x61, 0x51, 0x68, 0xbe, 0x47, 0x65, 0xd9, 0x83, 0xf5, 0x5f, 0xc3, 0x68,
-	0x56, 0xb7, 0xeb, 0x9e, 0x6e, 0xd0, 0x9b, 0x34, 0xe8, 0x1d, 0x4e, 0x1a, 0x6c, 0xdb, 0xe7, 0x63,
-	0xa7, 0x74, 0x3d, 0x76, 0xcc, 0x19, 0x3e, 0x59, 0xec, 0x9e, 0x7d, 0x75, 0x8c, 0x50, 0xf1, 0x98,
-	0x1d, 0x64, 0xd2, 0x56, 0xd4, 0xc7, 0x5c, 0x44, 0x9c, 0x12, 0x11, 0x51, 0x96, 0xc5, 0x60, 0x55,
-	0xa4, 0xf7, 0xf6, 0x53, 0xc9, 0xf0, 0x65, 0xec, 0x6c, 0xc4, 0xea, 0x97, 0xf3, 0xe4, 0xd8, 0xcb,
-	0x88, 0x9f, 0x63, 0xd1, 0xf3, 0xf6, 0x21, 0xc5, 0xf1, 0x68, 0x0f, 0xe2, 0x70, 0x85, 0xb6, 0xf6,
-	0x31, 0x17, 0x07, 0x94, 0x88, 0x8e, 0xac, 0x55, 0x8c, 0xc1, 0x6f, 0x8c, 0x0b, 0x0f, 0x61, 0x0c,
-	0x66, 0x19, 0x7b, 0xc8, 0xa6, 0xad, 0x08, 0xb3, 0x4c, 0xf4, 0x72, 0x10, 0x59, 0x1c, 0xa9, 0xa1,
-	0xc0, 0x71, 0x3c, 0xcc, 0x87, 0x7d, 0x2c, 0x08, 0xb3, 0x16, 0xef, 0xcf, 0xbe, 0x41, 0x5b, 0xbb,
-	0x53, 0x26, 0x79, 0xf5, 0xbb, 0xbf, 0x78, 0x94, 0x52, 0xf0, 0x4f, 0xa5, 0xa5, 0x87, 0x28, 0x05,
-	0x7f, 0x57, 0xc2, 0xa8, 0x9e, 0x02, 0xc9, 0x41, 0xb0, 0x3f, 0xa9, 0xa0, 0xfb, 0xab, 0x58, 0x53,
-	0x9a, 0xdb, 0x12, 0x47, 0x68, 0x45, 0xdd, 0x02, 0x30, 0x46, 0x98, 0xba, 0x78, 0xab, 0x7a, 0xe7,
-	0xd4, 0xb8, 0xc5, 0xd4, 0x3c, 0xd6, 0x53, 0x73, 0x8b, 0x40, 0x4f, 0xce, 0xb2, 0xcc, 0xbe, 0x96,
-	0x49, 0x59, 0xe7, 0xfe, 0x30, 0x50, 0xad, 0xc3, 0x86, 0x83, 0x6c, 0x90, 0x1e, 0x08, 0x2c, 0xc0,
-	0x7c, 0x82, 0x50, 0xc6, 0x23, 0xaa, 0x53, 0xea, 0x21, 0x2d, 0x86, 0x4b, 0x19, 0x2f, 0x30, 0x66,
-	0x8c, 0xfe, 0x57, 0xb4, 0xc7, 0x40, 0x85, 0xb6, 0x35, 0x77, 0xa7, 0xad, 0xcd, 0xc2, 0xd6, 0xa3,
-	0x1b, 0xb6, 0xa6, 0xf5, 0xda, 0x55, 0x4d, 0x26, 0xdf, 0x01, 0x15, 0xb2, 0xca, 0x7c, 0x85, 0x96,
-	0x0b, 0xd0, 0x28, 0xe2, 0x00, 0x03, 0xf5, 0x1c, 0x6b, 0xed, 0xf5, 0xeb, 0xb1, 0xb3, 0x9a, 0x00,
-	0x65, 0x10, 0x63, 0x01, 0xc9, 0x8e, 0x2b, 0xd8, 0x10, 0x5c, 0xcb, 0x08, 0xab, 0xba, 0x7a, 0x74,
-	0x00, 0x30, 0x30, 0xb7, 0xd0, 0xaa, 0x9e, 0x5f, 0x80, 0x41, 0x34, 0x59, 0x08, 0x65, 0xb5, 0x10,
-	0x94, 0x75, 0x09, 0xea, 0xa8, 0xc5, 0xd0, 0x7e, 0x7b, 0x7e, 0x69, 0x1b, 0x17, 0x97, 0xb6, 0xf1,
-	0xed, 0xd2, 0x36, 0xce, 0xae, 0xec, 0xd2, 0xc5, 0x95, 0x5d, 0xfa, 0x7c, 0x65, 0x97, 0x3e, 0xb4,
-	0xd2, 0x4c, 0xf4, 0x86, 0x5d, 0x2f, 0x26, 0xb9, 0x5f, 0xac, 0xac, 0xe7, 0x7d, 0xdc, 0xe5, 0x93,
-	0xc0, 0x3f, 0xd9, 0x7e, 0xe9, 0x7f, 0xd4, 0xdb, 0x4e, 0x8c, 0x28, 0xf0, 0x6e, 0x45, 0x35, 0xfe,
-	0xe2, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x85, 0xc2, 0xb3, 0x0a, 0x05, 0x00, 0x00,
+	// 657 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x53, 0xd3, 0x40,
+	0x14, 0x6f, 0xa0, 0x16, 0xd8, 0x16, 0x19, 0x32, 0x28, 0x99, 0x32, 0x26, 0x25, 0xce, 0x38, 0xe5,
+	0x60, 0xd2, 0xe0, 0x49, 0x3c, 0xd1, 0xc1, 0x83, 0xca, 0x38, 0x9d, 0x94, 0x93, 0x97, 0xcc, 0x36,
+	0x79, 0xa4, 0x19, 0x9a, 0xee, 0x9a, 0xdd, 0x80, 0xfd, 0x16, 0x7c, 0x17, 0xbf, 0x04, 0x47, 0x8e,
+	0x8e, 0x87, 0xea, 0xc0, 0xcd, 0x23, 0x47, 0x4f, 0xce, 0xee, 0xa6, 0xe5, 0x9f, 0x0a, 0xdc, 0xba,
+	0x2f, 0xbf, 0x3f, 0xfb, 0x7b, 0xfb, 0xfa, 0xd0, 0x0b, 0xc2, 0x52, 0xc2, 0x12, 0xe6, 0xf2, 0x23,
+	0x4c, 0xdd, 0x43, 0xaf, 0x07, 0x1c, 0x7b, 0xf2, 0x10, 0x64, 0x10, 0x92, 0x2c, 0x72, 0x68, 0x46,
+	0x38, 0xd1, 0x57, 0x0a, 0x9c, 0x23, 0x3e, 0x39, 0x05, 0xae, 0xbe, 0x12, 0x93, 0x98, 0x48, 0x80,
+	0x2b, 0x7e, 0x29, 0x6c, 0xdd, 0x8a, 0x09, 0x89, 0x07, 0xe0, 0xca, 0x53, 0x2f, 0xdf, 0x77, 0x79,
+	0x92, 0x02, 0xe3, 0x38, 0xa5, 0x0a, 0x60, 0x7f, 0x9d, 0x43, 0x68, 0xef, 0x08, 0x53, 0x5f, 0x3a,
+	0xe8, 0xab, 0x68, 0x8e, 0x12, 0x32, 0x08, 0x92, 0xc8, 0xd0, 0x1a, 0x5a, 0xb3, 0xec, 0x57, 0xc4,
+	0xf1, 0x5d, 0xa4, 0xaf, 0xa3, 0x1a, 0x66, 0x0c, 0x78, 0x2b, 0x88, 0x60, 0x48, 0x52, 0x63, 0xa6,
+	0xa1, 0x35, 0x17, 0xfc, 0xaa, 0xaa, 0xed, 0x88, 0xd2, 0x14, 0xe2, 0x15, 0x90, 0xd9, 0x2b, 0x10,
+	0x4f, 0x41, 0xb6, 0x51, 0xa5, 0x0f, 0x49, 0xdc, 0xe7, 0x46, 0xb9, 0xa1, 0x35, 0x67, 0xdb, 0x1b,
+	0xbf, 0xc6, 0xd6, 0xa2, 0x0a, 0x17, 0xa8, 0x0f, 0x17, 0x63, 0x6b, 0x65, 0x84, 0xd3, 0xc1, 0x96,
+	0x7d, 0xad, 0x6c, 0xfb, 0x05, 0x51, 0xff, 0x88, 0xca, 0x22, 0x83, 0xf1, 0xa8, 0xa1, 0x35, 0xab,
+	0x9b, 0x75, 0x47, 0x05, 0x74, 0x26, 0x01, 0x9d, 0xbd, 0x49, 0xc0, 0xb6, 0x79, 0x32, 0xb6, 0x4a,
+	0x17, 0x63, 0x4b, 0xbf, 0xa6, 0x27, 0xc8, 0xf6, 0xf1, 0x0f, 0x4b, 0xf3, 0xa5, 0x8e, 0xde, 0x41,
+	0x3a, 0x6d, 0x05, 0x03, 0xcc, 0x78, 0xc0, 0x28, 0xe1, 0x01, 0xcd, 0x92, 0x10, 0x8c, 0x8a, 0xb8,
+	0x7b, 0xfb, 0xb9, 0x50, 0xf8, 0x3e, 0xb6, 0xd6, 0x42, 0xd9, 0x72, 0x16, 0x1d, 0x38, 0x09, 0x71,
+	0x53, 0xcc, 0xfb, 0xce, 0x2e, 0xc4, 0x38, 0x1c, 0xed, 0x40, 0xe8, 0x2f, 0xd1, 0xd6, 0x2e, 0x66,
+	0xbc, 0x4b, 0x09, 0xef, 0x08, 0xae, 0x54, 0xf4, 0x6e, 0x29, 0xce, 0x3d, 0x44, 0xd1, 0xbb, 0xae,
+	0xd8, 0x47, 0x26, 0x6d, 0x05, 0x38, 0x4b, 0x78, 0x3f, 0x05, 0x9e, 0x84, 0x81, 0x1c, 0x0a, 0x1c,
+	0x86, 0x79, 0x9a, 0x0f, 0x30, 0x27, 0x99, 0x31, 0x7f, 0x7f, 0xf5, 0x35, 0xda, 0xda, 0x9e, 0x2a,
+	0x89, 0xa7, 0xdf, 0xbe, 0xd4, 0x91, 0x4e, 0xde, 0x7f, 0x9d, 0x16, 0x1e, 0xe2, 0xe4, 0xfd, 0xdb,
+	0x09, 0xa3, 0x7a, 0x0c, 0x24, 0x05, 0x9e, 0xfd, 0xcd, 0x05, 0xdd, 0xdf, 0xc5, 0x98, 0xca, 0xdc,
+	0xb4, 0xd8, 0x47, 0x4b, 0xf2, 0x15, 0x20, 0xcb, 0x48, 0x26, 0x1f, 0xde, 0xa8, 0xde, 0x39, 0x35,
+	0x76, 0x31, 0x35, 0x4f, 0xd5, 0xd4, 0xdc, 0x10, 0x50, 0x93, 0xb3, 0x28, 0xaa, 0x6f, 0x45, 0x51,
+	0xf0, 0x74, 0x8a, 0xec, 0xcb, 0x28, 0xec, 0x73, 0x8e, 0x33, 0x88, 0x6e, 0x47, 0xaa, 0xdd, 0x3f,
+	0x92, 0x35, 0x95, 0xeb, 0x2a, 0xb5, 0x1b, 0xc9, 0xec, 0xdf, 0x1a, 0xaa, 0x75, 0xb2, 0x7c, 0x98,
+	0x0c, 0xe3, 0x2e, 0xc7, 0x1c, 0xf4, 0x67, 0x08, 0x25, 0x2c, 0xa0, 0xaa, 0x24, 0xff, 0xba, 0xf3,
+	0xfe, 0x42, 0xc2, 0x0a, 0x8c, 0x1e, 0xa2, 0xc7, 0x32, 0xc8, 0x01, 0x50, 0xae, 0x1a, 0x31, 0x73,
+	0x67, 0x23, 0xd6, 0x8b, 0x46, 0x3c, 0xb9, 0xd2, 0x88, 0x29, 0x5f, 0xf5, 0xa1, 0x26, 0x8a, 0x1f,
+	0x80, 0x72, 0xd9, 0x86, 0x37, 0x68, 0xb1, 0x00, 0x8d, 0x02, 0x06, 0x30, 0x94, 0x0b, 0xa0, 0xd6,
+	0x5e, 0xbd, 0x18, 0x5b, 0xcb, 0x11, 0xd0, 0x0c, 0x42, 0xcc, 0x21, 0xda, 0xb2, 0x79, 0x96, 0x83,
+	0x6d, 0x68, 0x7e, 0x55, 0xb1, 0x47, 0x5d, 0x80, 0xa1, 0xbe, 0x81, 0x96, 0x25, 0x59, 0x10, 0x83,
+	0xc9, 0x0a, 0x2a, 0xcb, 0x15, 0x24, 0xaf, 0x2e, 0x40, 0x1d, 0xb9, 0x8a, 0xda, 0xef, 0x4f, 0xce,
+	0x4c, 0xed, 0xf4, 0xcc, 0xd4, 0x7e, 0x9e, 0x99, 0xda, 0xf1, 0xb9, 0x59, 0x3a, 0x3d, 0x37, 0x4b,
+	0xdf, 0xce, 0xcd, 0xd2, 0xa7, 0x56, 0x9c, 0xf0, 0x7e, 0xde, 0x73, 0x42, 0x92, 0xba, 0xc5, 0x92,
+	0x7c, 0x39, 0xc0, 0x3d, 0x36, 0x39, 0xb8, 0x87, 0x9b, 0xaf, 0xdd, 0x2f, 0x6a, 0xbf, 0xf2, 0x11,
+	0x05, 0xd6, 0xab, 0xc8, 0xe0, 0xaf, 0xfe, 0x0c, 0x00, 0x24, 0xbe, 0xdb, 0x24, 0x7c, 0x05, 0x00,
+	0x00,
 }
 
 func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
@@ -289,6 +295,16 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.GeometricSquaredTwapAccumulator.Size()
+		i -= size
+		if _, err := m.GeometricSquaredTwapAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastErrorTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastErrorTime):])
 	if err1 != nil {
 		return 0, err1
@@ -480,6 +496,8 @@ func (m *TwapRecord) Size() (n int) {
 	n += 1 + l + sovTwapRecord(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastErrorTime)
 	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.GeometricSquaredTwapAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
 	return n
 }
 
@@ -877,6 +895,40 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeometricSquaredTwapAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GeometricSquaredTwapAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTwapRecord(dAtA[iNdEx:])
@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"math"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v29/x/valset-pref/types"
+)
+
+// SetAutoRebalanceConfig opts the delegator in to having their delegations automatically rebalanced.
+func (k Keeper) SetAutoRebalanceConfig(ctx sdk.Context, delegator string, config types.AutoRebalanceConfig) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.GetKeyAutoRebalance(delegator), &config)
+}
+
+// GetAutoRebalanceConfig returns the auto rebalance config of the delegator, if they opted in.
+func (k Keeper) GetAutoRebalanceConfig(ctx sdk.Context, delegator string) (types.AutoRebalanceConfig, bool) {
+	var config types.AutoRebalanceConfig
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.GetKeyAutoRebalance(delegator), &config)
+	if err != nil || !found {
+		return types.AutoRebalanceConfig{}, false
+	}
+
+	return config, true
+}
+
+// DeleteAutoRebalanceConfig opts the delegator out of having their delegations automatically rebalanced.
+func (k Keeper) DeleteAutoRebalanceConfig(ctx sdk.Context, delegator string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetKeyAutoRebalance(delegator))
+}
+
+// RebalanceAllAutoRebalanceDelegators rebalances the delegations of up to maxDelegators delegators that opted in to
+// auto rebalancing. Delegators are rebalanced in address order, starting after the last delegator rebalanced in the
+// previous call, so that every delegator is rebalanced once every few epochs when there are more than maxDelegators.
+// A delegator whose delegations fail to be rebalanced is skipped without affecting the other delegators.
+func (k Keeper) RebalanceAllAutoRebalanceDelegators(ctx sdk.Context, maxDelegators int) {
+	store := ctx.KVStore(k.storeKey)
+
+	start := types.KeyPrefixAutoRebalance
+	if cursor := store.Get(types.KeyAutoRebalanceCursor); cursor != nil {
+		// the key right after the cursor
+		start = append(types.GetKeyAutoRebalance(string(cursor)), 0x00)
+	}
+	iterator := store.Iterator(start, storetypes.PrefixEndBytes(types.KeyPrefixAutoRebalance))
+	defer iterator.Close()
+
+	numRebalanced := 0
+	for ; iterator.Valid(); iterator.Next() {
+		delegator := string(iterator.Key()[len(types.KeyPrefixAutoRebalance):])
+		if numRebalanced >= maxDelegators {
+			// the remaining delegators are rebalanced in the next epochs
+			return
+		}
+		numRebalanced++
+		store.Set(types.KeyAutoRebalanceCursor, []byte(delegator))
+
+		var config types.AutoRebalanceConfig
+		if err := proto.Unmarshal(iterator.Value(), &config); err != nil {
+			k.Logger(ctx).Error("failed to unmarshal auto rebalance config", "delegator", delegator, "error", err)
+			continue
+		}
+
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.RebalanceDelegations(cacheCtx, delegator, config.DriftThreshold)
+		})
+	}
+
+	// every delegator was rebalanced, so the next epoch starts over from the first delegator
+	store.Delete(types.KeyAutoRebalanceCursor)
+}
+
+// RebalanceDelegations moves the delegator's delegations towards their validator set preference if the fraction of
+// their delegated tokens that is allocated away from the preference is greater than the drift threshold.
+// Rule based preferences are resolved from the current staking state, so delegations follow the validators the rule
+// selects. Validators the delegator delegates to that are not part of the preference are treated as having a weight
+// of zero.
+// Tokens are moved from the most over allocated validators to the most under allocated ones. Pairs of validators that
+// the staking module does not allow to redelegate between yet are skipped, and at most
+// MaxAutoRebalanceRedelegationsPerEpoch redelegations are performed, so delegations that drifted far from the
+// preference are rebalanced gradually over several epochs.
+func (k Keeper) RebalanceDelegations(ctx sdk.Context, delegatorAddr string, driftThreshold osmomath.Dec) error {
+	delegator, err := sdk.AccAddressFromBech32(delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	valSetPref, found := k.GetValidatorSetPreference(ctx, delegatorAddr)
+	if !found {
+		return types.ValidatorSetPreferenceNotFoundError{DelegatorAddr: delegatorAddr}
+	}
+
+	valSetPref, err = k.ResolveValidatorSetPreference(ctx, valSetPref)
+	if err != nil {
+		return err
+	}
+
+	delegations, err := k.stakingKeeper.GetDelegatorDelegations(ctx, delegator, math.MaxUint16)
+	if err != nil {
+		return err
+	}
+
+	// diffs holds the amount of tokens each validator is over (positive) or under (negative) allocated,
+	// in the order the validators were first seen so that the rebalancing is deterministic
+	var diffs []valSet
+	diffIndex := make(map[string]int)
+	totalTokens := osmomath.ZeroDec()
+	for _, delegation := range delegations {
+		_, validator, err := k.getValAddrAndVal(ctx, delegation.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		tokens := validator.TokensFromShares(delegation.Shares)
+		diffIndex[delegation.ValidatorAddress] = len(diffs)
+		diffs = append(diffs, valSet{ValAddr: delegation.ValidatorAddress, Amount: tokens})
+		totalTokens = totalTokens.Add(tokens)
+	}
+
+	if !totalTokens.IsPositive() {
+		return nil
+	}
+
+	for _, preference := range valSetPref.Preferences {
+		idx, ok := diffIndex[preference.ValOperAddress]
+		if !ok {
+			idx = len(diffs)
+			diffs = append(diffs, valSet{ValAddr: preference.ValOperAddress, Amount: osmomath.ZeroDec()})
+		}
+
+		diffs[idx].Amount = diffs[idx].Amount.Sub(preference.Weight.Mul(totalTokens))
+	}
+
+	// The drift is the fraction of the delegated tokens that has to be moved to match the preference
+	var overAllocated, underAllocated []valSet
+	totalOverAllocated := osmomath.ZeroDec()
+	for _, diff := range diffs {
+		if diff.Amount.IsPositive() {
+			overAllocated = append(overAllocated, diff)
+			totalOverAllocated = totalOverAllocated.Add(diff.Amount)
+		} else if diff.Amount.IsNegative() {
+			underAllocated = append(underAllocated, valSet{ValAddr: diff.ValAddr, Amount: diff.Amount.Neg()})
+		}
+	}
+
+	if totalOverAllocated.Quo(totalTokens).LTE(driftThreshold) {
+		return nil
+	}
+
+	sort.SliceStable(overAllocated, func(i, j int) bool {
+		return overAllocated[i].Amount.GT(overAllocated[j].Amount)
+	})
+	sort.SliceStable(underAllocated, func(i, j int) bool {
+		return underAllocated[i].Amount.GT(underAllocated[j].Amount)
+	})
+
+	redelegations := 0
+	for i := range overAllocated {
+		source := &overAllocated[i]
+		valSource, err := sdk.ValAddressFromBech32(source.ValAddr)
+		if err != nil {
+			return err
+		}
+
+		// tokens can't be redelegated away from a validator they were redelegated to until that redelegation matures
+		hasReceivingRedelegation, err := k.stakingKeeper.HasReceivingRedelegation(ctx, delegator, valSource)
+		if err != nil {
+			return err
+		}
+		if hasReceivingRedelegation {
+			continue
+		}
+
+		for j := range underAllocated {
+			if redelegations >= types.MaxAutoRebalanceRedelegationsPerEpoch {
+				return nil
+			}
+
+			target := &underAllocated[j]
+			transferAmount := osmomath.MinDec(source.Amount, target.Amount).TruncateInt()
+			if !transferAmount.IsPositive() {
+				continue
+			}
+
+			valTarget, err := sdk.ValAddressFromBech32(target.ValAddr)
+			if err != nil {
+				return err
+			}
+
+			hasMaxEntries, err := k.stakingKeeper.HasMaxRedelegationEntries(ctx, delegator, valSource, valTarget)
+			if err != nil {
+				return err
+			}
+			if hasMaxEntries {
+				continue
+			}
+
+			validator, err := k.stakingKeeper.GetValidator(ctx, valSource)
+			if err != nil {
+				return err
+			}
+
+			delegation, err := k.stakingKeeper.GetDelegation(ctx, delegator, valSource)
+			if err != nil {
+				return err
+			}
+
+			shares, err := validator.SharesFromTokens(transferAmount)
+			if err != nil {
+				return err
+			}
+
+			_, err = k.stakingKeeper.BeginRedelegation(ctx, delegator, valSource, valTarget, osmomath.MinDec(shares, delegation.Shares))
+			if err != nil {
+				return err
+			}
+
+			source.Amount = source.Amount.Sub(transferAmount.ToLegacyDec())
+			target.Amount = target.Amount.Sub(transferAmount.ToLegacyDec())
+			redelegations++
+
+			if !source.Amount.TruncateInt().IsPositive() {
+				break
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	valPref "github.com/osmosis-labs/osmosis/v29/x/valset-pref"
+	"github.com/osmosis-labs/osmosis/v29/x/valset-pref/types"
+)
+
+// delegatedTokens returns the tokens the delegator has delegated to each of the given validators.
+func (s *KeeperTestSuite) delegatedTokens(delegator sdk.AccAddress, valAddrs []string) []osmomath.Int {
+	tokens := make([]osmomath.Int, len(valAddrs))
+	for i, valAddrStr := range valAddrs {
+		valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+		s.Require().NoError(err)
+
+		tokens[i] = osmomath.ZeroInt()
+		delegation, err := s.App.StakingKeeper.GetDelegation(s.Ctx, delegator, valAddr)
+		if err != nil {
+			continue
+		}
+
+		validator, err := s.App.StakingKeeper.GetValidator(s.Ctx, valAddr)
+		s.Require().NoError(err)
+		tokens[i] = validator.TokensFromShares(delegation.Shares).TruncateInt()
+	}
+
+	return tokens
+}
+
+func (s *KeeperTestSuite) TestRebalanceDelegations() {
+	tests := []struct {
+		name                  string
+		preferenceWeights     []osmomath.Dec // weights of the first validators
+		delegations           []int64        // tokens delegated to each validator
+		driftThreshold        osmomath.Dec
+		rebalanceCount        int
+		expectedDelegations   []int64
+		expectedRedelegations int
+	}{
+		{
+			name:                "delegations match the preference",
+			preferenceWeights:   []osmomath.Dec{osmomath.NewDecWithPrec(5, 1), osmomath.NewDecWithPrec(5, 1)},
+			delegations:         []int64{10_000_000, 10_000_000, 0},
+			driftThreshold:      osmomath.ZeroDec(),
+			rebalanceCount:      1,
+			expectedDelegations: []int64{10_000_000, 10_000_000, 0},
+		},
+		{
+			name:                  "delegation outside of the preference is moved to the preference",
+			preferenceWeights:     []osmomath.Dec{osmomath.NewDecWithPrec(5, 1), osmomath.NewDecWithPrec(5, 1)},
+			delegations:           []int64{10_000_000, 10_000_000, 10_000_000},
+			driftThreshold:        osmomath.NewDecWithPrec(1, 1),
+			rebalanceCount:        1,
+			expectedDelegations:   []int64{15_000_000, 15_000_000, 0},
+			expectedRedelegations: 2,
+		},
+		{
+			name:                  "slashed delegation is topped up from the other preference validators",
+			preferenceWeights:     []osmomath.Dec{osmomath.NewDecWithPrec(6, 1), osmomath.NewDecWithPrec(2, 1), osmomath.NewDecWithPrec(2, 1)},
+			delegations:           []int64{6_000_000, 7_000_000, 7_000_000},
+			driftThreshold:        osmomath.NewDecWithPrec(1, 1),
+			rebalanceCount:        1,
+			expectedDelegations:   []int64{12_000_000, 4_000_000, 4_000_000},
+			expectedRedelegations: 2,
+		},
+		{
+			name:                "drift below the threshold is not rebalanced",
+			preferenceWeights:   []osmomath.Dec{osmomath.NewDecWithPrec(5, 1), osmomath.NewDecWithPrec(5, 1)},
+			delegations:         []int64{10_000_000, 10_000_000, 2_000_000},
+			driftThreshold:      osmomath.NewDecWithPrec(1, 1),
+			rebalanceCount:      1,
+			expectedDelegations: []int64{10_000_000, 10_000_000, 2_000_000},
+		},
+		{
+			name:                  "redelegations are capped per epoch",
+			preferenceWeights:     []osmomath.Dec{osmomath.NewDecWithPrec(25, 2), osmomath.NewDecWithPrec(25, 2), osmomath.NewDecWithPrec(25, 2), osmomath.NewDecWithPrec(25, 2)},
+			delegations:           []int64{0, 0, 0, 0, 40_000_000},
+			driftThreshold:        osmomath.ZeroDec(),
+			rebalanceCount:        1,
+			expectedDelegations:   []int64{10_000_000, 10_000_000, 10_000_000, 0, 10_000_000},
+			expectedRedelegations: types.MaxAutoRebalanceRedelegationsPerEpoch,
+		},
+		{
+			name:                  "capped redelegations are completed in the next epoch",
+			preferenceWeights:     []osmomath.Dec{osmomath.NewDecWithPrec(25, 2), osmomath.NewDecWithPrec(25, 2), osmomath.NewDecWithPrec(25, 2), osmomath.NewDecWithPrec(25, 2)},
+			delegations:           []int64{0, 0, 0, 0, 40_000_000},
+			driftThreshold:        osmomath.ZeroDec(),
+			rebalanceCount:        2,
+			expectedDelegations:   []int64{10_000_000, 10_000_000, 10_000_000, 10_000_000, 0},
+			expectedRedelegations: 4,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			delegator := sdk.AccAddress([]byte("addr1---------------"))
+			valAddrs := s.SetupMultipleValidators(len(test.delegations))
+
+			preferences := make([]types.ValidatorPreference, len(test.preferenceWeights))
+			for i, weight := range test.preferenceWeights {
+				preferences[i] = types.ValidatorPreference{ValOperAddress: valAddrs[i], Weight: weight}
+			}
+			s.App.ValidatorSetPreferenceKeeper.SetValidatorSetPreferences(s.Ctx, delegator.String(), types.ValidatorSetPreferences{Preferences: preferences})
+
+			for i, amount := range test.delegations {
+				if amount == 0 {
+					continue
+				}
+				s.FundAcc(delegator, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+				err := s.PrepareExistingDelegations(s.Ctx, valAddrs[i:i+1], delegator, osmomath.NewInt(amount))
+				s.Require().NoError(err)
+			}
+
+			for i := 0; i < test.rebalanceCount; i++ {
+				err := s.App.ValidatorSetPreferenceKeeper.RebalanceDelegations(s.Ctx, delegator.String(), test.driftThreshold)
+				s.Require().NoError(err)
+			}
+
+			expectedDelegations := make([]osmomath.Int, len(test.expectedDelegations))
+			for i, amount := range test.expectedDelegations {
+				expectedDelegations[i] = osmomath.NewInt(amount)
+			}
+			s.Require().Equal(expectedDelegations, s.delegatedTokens(delegator, valAddrs))
+
+			redelegations, err := s.App.StakingKeeper.GetRedelegations(s.Ctx, delegator, 100)
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedRedelegations, len(redelegations))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSetAutoRebalance() {
+	s.SetupTest()
+
+	msgServer := valPref.NewMsgServerImpl(s.App.ValidatorSetPreferenceKeeper)
+	delegator := sdk.AccAddress([]byte("addr1---------------"))
+	valAddrs := s.SetupMultipleValidators(3)
+
+	// opting in without a validator set preference fails
+	_, err := msgServer.SetAutoRebalance(s.Ctx, types.NewMsgSetAutoRebalance(delegator, true, osmomath.NewDecWithPrec(1, 1)))
+	s.Require().ErrorIs(err, types.ValidatorSetPreferenceNotFoundError{DelegatorAddr: delegator.String()})
+
+	preferences := []types.ValidatorPreference{
+		{ValOperAddress: valAddrs[0], Weight: osmomath.NewDecWithPrec(5, 1)},
+		{ValOperAddress: valAddrs[1], Weight: osmomath.NewDecWithPrec(5, 1)},
+	}
+	_, err = msgServer.SetValidatorSetPreference(s.Ctx, types.NewMsgSetValidatorSetPreference(delegator, preferences))
+	s.Require().NoError(err)
+
+	_, err = msgServer.SetAutoRebalance(s.Ctx, types.NewMsgSetAutoRebalance(delegator, true, osmomath.NewDecWithPrec(1, 1)))
+	s.Require().NoError(err)
+
+	config, found := s.App.ValidatorSetPreferenceKeeper.GetAutoRebalanceConfig(s.Ctx, delegator.String())
+	s.Require().True(found)
+	s.Require().Equal(osmomath.NewDecWithPrec(1, 1), config.DriftThreshold)
+
+	// the delegations drift away from the preference
+	s.FundAcc(delegator, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30_000_000)))
+	err = s.PrepareExistingDelegations(s.Ctx, valAddrs, delegator, osmomath.NewInt(10_000_000))
+	s.Require().NoError(err)
+
+	// epochs other than the auto rebalance epoch do not rebalance
+	err = s.App.ValidatorSetPreferenceKeeper.EpochHooks().AfterEpochEnd(s.Ctx, "week", 1)
+	s.Require().NoError(err)
+	s.Require().Equal([]osmomath.Int{osmomath.NewInt(10_000_000), osmomath.NewInt(10_000_000), osmomath.NewInt(10_000_000)}, s.delegatedTokens(delegator, valAddrs))
+
+	err = s.App.ValidatorSetPreferenceKeeper.EpochHooks().AfterEpochEnd(s.Ctx, types.AutoRebalanceEpochIdentifier, 1)
+	s.Require().NoError(err)
+	s.Require().Equal([]osmomath.Int{osmomath.NewInt(15_000_000), osmomath.NewInt(15_000_000), osmomath.ZeroInt()}, s.delegatedTokens(delegator, valAddrs))
+
+	// opting out removes the config
+	_, err = msgServer.SetAutoRebalance(s.Ctx, types.NewMsgSetAutoRebalance(delegator, false, osmomath.Dec{}))
+	s.Require().NoError(err)
+
+	_, found = s.App.ValidatorSetPreferenceKeeper.GetAutoRebalanceConfig(s.Ctx, delegator.String())
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestRebalanceAllAutoRebalanceDelegatorsIsBounded() {
+	s.SetupTest()
+
+	msgServer := valPref.NewMsgServerImpl(s.App.ValidatorSetPreferenceKeeper)
+	valAddrs := s.SetupMultipleValidators(3)
+	preferences := []types.ValidatorPreference{
+		{ValOperAddress: valAddrs[0], Weight: osmomath.NewDecWithPrec(5, 1)},
+		{ValOperAddress: valAddrs[1], Weight: osmomath.NewDecWithPrec(5, 1)},
+	}
+
+	// every delegator drifted away from the preference
+	delegators := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1---------------")),
+		sdk.AccAddress([]byte("addr2---------------")),
+		sdk.AccAddress([]byte("addr3---------------")),
+	}
+	for _, delegator := range delegators {
+		_, err := msgServer.SetValidatorSetPreference(s.Ctx, types.NewMsgSetValidatorSetPreference(delegator, preferences))
+		s.Require().NoError(err)
+		_, err = msgServer.SetAutoRebalance(s.Ctx, types.NewMsgSetAutoRebalance(delegator, true, osmomath.NewDecWithPrec(1, 1)))
+		s.Require().NoError(err)
+
+		s.FundAcc(delegator, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30_000_000)))
+		err = s.PrepareExistingDelegations(s.Ctx, valAddrs, delegator, osmomath.NewInt(10_000_000))
+		s.Require().NoError(err)
+	}
+
+	// a rebalanced delegator no longer delegates to the validator outside of the preference
+	numRebalanced := func() int {
+		count := 0
+		for _, delegator := range delegators {
+			if s.delegatedTokens(delegator, valAddrs)[2].IsZero() {
+				count++
+			}
+		}
+		return count
+	}
+
+	// the delegators past the budget are rebalanced in the next epoch
+	s.App.ValidatorSetPreferenceKeeper.RebalanceAllAutoRebalanceDelegators(s.Ctx, 2)
+	s.Require().Equal(2, numRebalanced())
+
+	s.App.ValidatorSetPreferenceKeeper.RebalanceAllAutoRebalanceDelegators(s.Ctx, 2)
+	s.Require().Equal(3, numRebalanced())
+}
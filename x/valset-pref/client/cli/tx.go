@@ -2,6 +2,8 @@ package valsetprefcli
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -10,6 +12,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v29/x/valset-pref/types"
@@ -25,6 +28,8 @@ func GetTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewUndelRebalancedValSetCmd)
 	osmocli.AddTxCmd(txCmd, NewReDelValSetCmd)
 	osmocli.AddTxCmd(txCmd, NewWithRewValSetCmd)
+	osmocli.AddTxCmd(txCmd, NewSetAutoRebalanceCmd)
+	osmocli.AddTxCmd(txCmd, NewSetValSetRuleCmd)
 	return txCmd
 }
 
@@ -87,6 +92,27 @@ func NewWithRewValSetCmd() (*osmocli.TxCliDesc, *types.MsgWithdrawDelegationRewa
 	}, &types.MsgWithdrawDelegationRewards{}
 }
 
+func NewSetAutoRebalanceCmd() (*osmocli.TxCliDesc, *types.MsgSetAutoRebalance) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-auto-rebalance",
+		Short:   "Opt in or out of automatically rebalancing delegations towards the validator set every day epoch.",
+		Long:    "Opts in or out of automatically rebalancing delegations towards the validator set once the fraction of delegated tokens allocated away from it exceeds the drift threshold.",
+		Example: "osmosisd tx valset-pref set-auto-rebalance osmo1... true 0.05",
+		NumArgs: 3,
+	}, &types.MsgSetAutoRebalance{}
+}
+
+func NewSetValSetRuleCmd() (*osmocli.TxCliDesc, *types.MsgSetValidatorSetRule) {
+	return &osmocli.TxCliDesc{
+		Use:              "set-valset-rule",
+		Short:            "Sets a validator set that follows the staking state using max validators, max commission rate and weighting",
+		Long:             "Sets a validator set that delegates to the top max validators by voting power among the bonded validators that are not jailed or tombstoned, excluding validators above the max commission rate (0 for no limit). Weighting is either EqualWeight or VotingPowerWeight.",
+		Example:          "osmosisd tx valset-pref set-valset-rule osmo1... 10 0.1 EqualWeight",
+		NumArgs:          4,
+		ParseAndBuildMsg: NewMsgSetValidatorSetRule,
+	}, &types.MsgSetValidatorSetRule{}
+}
+
 func NewMsgSetValidatorSetPreference(clientCtx client.Context, args []string, fs *pflag.FlagSet) (sdk.Msg, error) {
 	delAddr, err := sdk.AccAddressFromBech32(args[0])
 	if err != nil {
@@ -104,6 +130,37 @@ func NewMsgSetValidatorSetPreference(clientCtx client.Context, args []string, fs
 	), nil
 }
 
+func NewMsgSetValidatorSetRule(clientCtx client.Context, args []string, fs *pflag.FlagSet) (sdk.Msg, error) {
+	delAddr, err := sdk.AccAddressFromBech32(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	maxValidators, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	maxCommissionRate, err := osmomath.NewDecFromStr(args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	weighting, ok := types.ValidatorSetRuleWeighting_value[args[3]]
+	if !ok {
+		return nil, fmt.Errorf("invalid weighting %s, expected one of EqualWeight or VotingPowerWeight", args[3])
+	}
+
+	return types.NewMsgSetValidatorSetRule(
+		delAddr,
+		types.ValidatorSetRule{
+			MaxValidators:     maxValidators,
+			MaxCommissionRate: maxCommissionRate,
+			Weighting:         types.ValidatorSetRuleWeighting(weighting),
+		},
+	), nil
+}
+
 func NewMsgReDelValidatorSetPreference(clientCtx client.Context, args []string, fs *pflag.FlagSet) (sdk.Msg, error) {
 	delAddr, err := sdk.AccAddressFromBech32(args[0])
 	if err != nil {
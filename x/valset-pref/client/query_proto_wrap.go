@@ -23,7 +23,13 @@ func (q Querier) UserValidatorPreferences(ctx sdk.Context, req queryproto.UserVa
 		return nil, errors.New("Validator set not found")
 	}
 
+	validatorSet, err := q.K.ResolveValidatorSetPreference(ctx, validatorSet)
+	if err != nil {
+		return nil, err
+	}
+
 	return &queryproto.UserValidatorPreferencesResponse{
 		Preferences: validatorSet.Preferences,
+		Rule:        validatorSet.Rule,
 	}, nil
 }
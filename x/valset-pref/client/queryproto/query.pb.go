@@ -71,7 +71,11 @@ var xxx_messageInfo_UserValidatorPreferencesRequest proto.InternalMessageInfo
 
 // Response type the QueryUserValidatorPreferences query request
 type UserValidatorPreferencesResponse struct {
+	// preferences holds the validators and weights the user delegates to. For
+	// rule based preferences, they are resolved from the current staking state.
 	Preferences []types.ValidatorPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences"`
+	// rule is the rule the preferences are resolved from, if any.
+	Rule *types.ValidatorSetRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
 }
 
 func (m *UserValidatorPreferencesResponse) Reset()         { *m = UserValidatorPreferencesResponse{} }
@@ -117,29 +121,31 @@ func init() {
 }
 
 var fileDescriptor_6e2d5b0777f607c6 = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x4f, 0x4b, 0x3a, 0x41,
-	0x18, 0xde, 0xf9, 0xfd, 0xfa, 0x43, 0xeb, 0x6d, 0xe9, 0xb0, 0x2c, 0x31, 0x8a, 0x07, 0xf1, 0xe2,
-	0x0c, 0xda, 0x29, 0xec, 0x64, 0x5f, 0xa0, 0x84, 0x0a, 0xba, 0xcd, 0xea, 0xeb, 0xb6, 0xb0, 0xee,
-	0xbb, 0xce, 0x3b, 0x4a, 0x21, 0x5d, 0xfa, 0x04, 0x41, 0x9f, 0x29, 0xf0, 0x96, 0xd0, 0xa5, 0x53,
-	0x94, 0xf6, 0x41, 0x42, 0x77, 0xc5, 0x84, 0x34, 0xe8, 0xb4, 0x3b, 0x33, 0xcf, 0x9f, 0x79, 0xde,
-	0x67, 0xec, 0x12, 0x52, 0x17, 0x29, 0x24, 0x39, 0x50, 0x11, 0x81, 0x49, 0x34, 0x74, 0xe4, 0xa0,
-	0xea, 0x83, 0x51, 0x55, 0xd9, 0xeb, 0x83, 0xbe, 0x15, 0x89, 0x46, 0x83, 0x8e, 0x97, 0xe1, 0xc4,
-	0x12, 0x27, 0x32, 0x9c, 0xb7, 0x1f, 0x60, 0x80, 0x73, 0x98, 0x9c, 0xfd, 0xa5, 0x0c, 0xef, 0x20,
-	0x40, 0x0c, 0x22, 0x90, 0x2a, 0x09, 0xa5, 0x8a, 0x63, 0x34, 0xca, 0x84, 0x18, 0x53, 0x76, 0xba,
-	0xc9, 0x97, 0x8c, 0x32, 0x90, 0xe2, 0x8a, 0x75, 0x3b, 0x7f, 0x4e, 0xa0, 0x2f, 0x54, 0x14, 0xb6,
-	0x95, 0x41, 0x7d, 0xaa, 0xa1, 0x03, 0x1a, 0xe2, 0x16, 0x50, 0x13, 0x7a, 0x7d, 0x20, 0xe3, 0xb8,
-	0xf6, 0xae, 0x6a, 0xb7, 0x35, 0x10, 0xb9, 0xac, 0xc0, 0xca, 0x7b, 0xcd, 0xc5, 0xb2, 0x38, 0xb4,
-	0x0b, 0xeb, 0xc9, 0x94, 0x60, 0x4c, 0xe0, 0x5c, 0xda, 0xb9, 0x64, 0xb9, 0xed, 0xb2, 0xc2, 0xff,
-	0x72, 0xae, 0x26, 0xc5, 0xfa, 0xb8, 0xe2, 0x07, 0xb9, 0xc6, 0xd6, 0xe8, 0x2d, 0x6f, 0x35, 0xbf,
-	0x2b, 0xd5, 0x9e, 0x99, 0xbd, 0x7d, 0x36, 0x9b, 0xa0, 0xf3, 0xc4, 0x6c, 0x77, 0xdd, 0x3d, 0x9c,
-	0xfa, 0x26, 0xab, 0x5f, 0xa2, 0x7b, 0xc7, 0x7f, 0x23, 0xa7, 0xd1, 0x8b, 0xe2, 0xfe, 0xe5, 0xf3,
-	0xf1, 0x5f, 0xd9, 0x29, 0xc9, 0xd5, 0x32, 0x2a, 0x2b, 0x6d, 0x0c, 0xb3, 0x69, 0xde, 0x35, 0xd4,
-	0xe8, 0x83, 0x5b, 0xa3, 0x09, 0x67, 0xe3, 0x09, 0x67, 0xef, 0x13, 0xce, 0x1e, 0xa6, 0xdc, 0x1a,
-	0x4f, 0xb9, 0xf5, 0x3a, 0xe5, 0xd6, 0xd5, 0x49, 0x10, 0x9a, 0xeb, 0xbe, 0x2f, 0x5a, 0xd8, 0x5d,
-	0xe8, 0x55, 0x22, 0xe5, 0xd3, 0x52, 0xbc, 0x76, 0x24, 0x6f, 0x56, 0x2c, 0x5a, 0x51, 0x08, 0xb1,
-	0x49, 0xdf, 0xd9, 0xbc, 0x6e, 0x7f, 0x67, 0xfe, 0x39, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xfd,
-	0x10, 0xb5, 0xfd, 0x97, 0x02, 0x00, 0x00,
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x86, 0x33, 0xbd, 0xbd, 0xf7, 0x72, 0xa7, 0xbb, 0x70, 0x17, 0x21, 0x48, 0x1a, 0xba, 0x28,
+	0x59, 0xd8, 0x0c, 0x8d, 0x2b, 0xa9, 0x0b, 0xa9, 0x2f, 0xa0, 0x11, 0x15, 0xdc, 0x4d, 0xda, 0xd3,
+	0x18, 0x98, 0x66, 0xd2, 0x99, 0x49, 0x51, 0xc4, 0x8d, 0x4f, 0x20, 0xf8, 0x3c, 0x2e, 0x85, 0xee,
+	0x2c, 0xb8, 0x71, 0x25, 0xda, 0xfa, 0x20, 0xd2, 0x24, 0xa5, 0x16, 0x6c, 0x15, 0x57, 0xc9, 0xcc,
+	0xfc, 0xff, 0x77, 0x38, 0xe7, 0x3f, 0xb8, 0xce, 0x65, 0x9f, 0xcb, 0x48, 0x92, 0x21, 0x65, 0x12,
+	0x54, 0x22, 0xa0, 0x47, 0x86, 0xcd, 0x00, 0x14, 0x6d, 0x92, 0x41, 0x0a, 0xe2, 0xc2, 0x4d, 0x04,
+	0x57, 0x5c, 0x37, 0x0b, 0x9d, 0xbb, 0xd0, 0xb9, 0x85, 0xce, 0xfc, 0x1f, 0xf2, 0x90, 0x67, 0x32,
+	0x32, 0xfb, 0xcb, 0x1d, 0xe6, 0x46, 0xc8, 0x79, 0xc8, 0x80, 0xd0, 0x24, 0x22, 0x34, 0x8e, 0xb9,
+	0xa2, 0x2a, 0xe2, 0xb1, 0x2c, 0x5e, 0xd7, 0xd5, 0x95, 0x8a, 0x2a, 0xc8, 0x75, 0xb5, 0x16, 0xae,
+	0x1e, 0x49, 0x10, 0xc7, 0x94, 0x45, 0x5d, 0xaa, 0xb8, 0xd8, 0x17, 0xd0, 0x03, 0x01, 0x71, 0x07,
+	0xa4, 0x0f, 0x83, 0x14, 0xa4, 0xd2, 0x0d, 0xfc, 0x97, 0x76, 0xbb, 0x02, 0xa4, 0x34, 0x90, 0x8d,
+	0x9c, 0x7f, 0xfe, 0xfc, 0x58, 0xbb, 0x43, 0xd8, 0x5e, 0xed, 0x96, 0x09, 0x8f, 0x25, 0xe8, 0x27,
+	0xb8, 0x92, 0x2c, 0xae, 0x0d, 0x64, 0xff, 0x72, 0x2a, 0x1e, 0x71, 0x57, 0xf7, 0xeb, 0x7e, 0x82,
+	0x6b, 0x97, 0x47, 0xcf, 0x55, 0xcd, 0xff, 0x48, 0xd2, 0x77, 0x71, 0x59, 0xa4, 0x0c, 0x8c, 0x92,
+	0x8d, 0x9c, 0x8a, 0xb7, 0xf9, 0x2d, 0xe2, 0x21, 0x28, 0x3f, 0x65, 0xe0, 0x67, 0x4e, 0xef, 0x01,
+	0xe1, 0xdf, 0x07, 0xb3, 0x10, 0xf4, 0x7b, 0x84, 0x8d, 0x55, 0x9d, 0xe8, 0xad, 0x75, 0xe8, 0x2f,
+	0xa6, 0x67, 0xee, 0xfc, 0xcc, 0x9c, 0x0f, 0xaf, 0xe6, 0x5e, 0x3f, 0xbe, 0xdd, 0x96, 0x1c, 0xbd,
+	0x4e, 0x96, 0xf3, 0x6c, 0x2c, 0x05, 0x7a, 0x59, 0x04, 0x72, 0xd5, 0xa6, 0xa3, 0x57, 0x4b, 0x1b,
+	0x4d, 0x2c, 0x34, 0x9e, 0x58, 0xe8, 0x65, 0x62, 0xa1, 0x9b, 0xa9, 0xa5, 0x8d, 0xa7, 0x96, 0xf6,
+	0x34, 0xb5, 0xb4, 0xd3, 0xbd, 0x30, 0x52, 0x67, 0x69, 0xe0, 0x76, 0x78, 0x7f, 0xce, 0x6b, 0x30,
+	0x1a, 0xc8, 0x05, 0xdc, 0xdb, 0x26, 0xe7, 0x4b, 0x25, 0x3a, 0x2c, 0x82, 0x58, 0xe5, 0xab, 0x9a,
+	0x6d, 0x4c, 0xf0, 0x27, 0xfb, 0x6c, 0xbd, 0x0f, 0x00, 0xa8, 0x1e, 0xd0, 0x6f, 0xda, 0x02, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -274,6 +280,18 @@ func (m *UserValidatorPreferencesResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.Rule != nil {
+		{
+			size, err := m.Rule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Preferences) > 0 {
 		for iNdEx := len(m.Preferences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -327,6 +345,10 @@ func (m *UserValidatorPreferencesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Rule != nil {
+		l = m.Rule.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -481,6 +503,42 @@ func (m *UserValidatorPreferencesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rule == nil {
+				m.Rule = &types.ValidatorSetRule{}
+			}
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v29/x/valset-pref/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks wraps the valset-pref keeper to implement the epoch hooks.
+type EpochHooks struct {
+	k Keeper
+}
+
+// EpochHooks returns the wrapper struct.
+func (k Keeper) EpochHooks() epochstypes.EpochHooks {
+	return EpochHooks{k}
+}
+
+// GetModuleName implements types.EpochHooks.
+func (EpochHooks) GetModuleName() string {
+	return types.ModuleName
+}
+
+// BeforeEpochStart is the epoch start hook.
+func (h EpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// AfterEpochEnd rebalances the delegations of the delegators that opted in to auto rebalancing.
+func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier == types.AutoRebalanceEpochIdentifier {
+		h.k.RebalanceAllAutoRebalanceDelegators(ctx, types.MaxAutoRebalanceDelegatorsPerEpoch)
+	}
+	return nil
+}
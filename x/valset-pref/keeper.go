@@ -46,7 +46,8 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 }
 
 // GetDelegationPreferences checks if valset position exists, if it does return that
-// else return existing delegation that's not valset.
+// else return existing delegation that's not valset. Rule based valsets are resolved
+// from the current staking state.
 func (k Keeper) GetDelegationPreferences(ctx sdk.Context, delegator string) (types.ValidatorSetPreferences, error) {
 	valSet, exists := k.GetValidatorSetPreference(ctx, delegator)
 	if !exists {
@@ -69,7 +70,7 @@ func (k Keeper) GetDelegationPreferences(ctx sdk.Context, delegator string) (typ
 		return types.ValidatorSetPreferences{Preferences: preferences}, nil
 	}
 
-	return valSet, nil
+	return k.ResolveValidatorSetPreference(ctx, valSet)
 }
 
 // GetValSetPreferencesWithDelegations fetches the delegator's validator set preferences
@@ -98,7 +99,7 @@ func (k Keeper) GetValSetPreferencesWithDelegations(ctx sdk.Context, delegator s
 
 	// Returning existing valSet when there are no existing delegations
 	if exists && len(existingDelegations) == 0 {
-		return valSet, nil
+		return k.ResolveValidatorSetPreference(ctx, valSet)
 	}
 
 	// when existing delegation exists, have it based upon the existing delegation
@@ -145,3 +145,42 @@ func (server msgServer) DelegateBondedTokens(goCtx context.Context, msg *types.M
 
 	return &types.MsgDelegateBondedTokensResponse{}, nil
 }
+
+// SetAutoRebalance opts the delegator in or out of having their delegations gradually rebalanced towards their
+// validator set preference every day epoch.
+func (server msgServer) SetAutoRebalance(goCtx context.Context, msg *types.MsgSetAutoRebalance) (*types.MsgSetAutoRebalanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !msg.Enabled {
+		server.keeper.DeleteAutoRebalanceConfig(ctx, msg.Delegator)
+		return &types.MsgSetAutoRebalanceResponse{}, nil
+	}
+
+	// delegations can only be rebalanced towards an existing validator set preference
+	_, found := server.keeper.GetValidatorSetPreference(ctx, msg.Delegator)
+	if !found {
+		return nil, types.ValidatorSetPreferenceNotFoundError{DelegatorAddr: msg.Delegator}
+	}
+
+	server.keeper.SetAutoRebalanceConfig(ctx, msg.Delegator, types.AutoRebalanceConfig{DriftThreshold: msg.DriftThreshold})
+	return &types.MsgSetAutoRebalanceResponse{}, nil
+}
+
+// SetValidatorSetRule sets a validator set preference that is resolved from the staking state whenever it is used.
+func (server msgServer) SetValidatorSetRule(goCtx context.Context, msg *types.MsgSetValidatorSetRule) (*types.MsgSetValidatorSetRuleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	existingValSet, found := server.keeper.GetValidatorSetPreference(ctx, msg.Delegator)
+	if found && existingValSet.Rule != nil && existingValSet.Rule.Equal(msg.Rule) {
+		return nil, errors.New("The validator set rule is the same")
+	}
+
+	// the rule has to select at least one validator in the current staking state
+	if _, err := server.keeper.ResolveValidatorSetRule(ctx, msg.Rule); err != nil {
+		return nil, err
+	}
+
+	rule := msg.Rule
+	server.keeper.SetValidatorSetPreferences(ctx, msg.Delegator, types.ValidatorSetPreferences{Rule: &rule})
+	return &types.MsgSetValidatorSetRuleResponse{}, nil
+}
@@ -14,6 +14,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUndelegateFromRebalancedValidatorSet{}, "osmosis/MsgUndelegateFromRebalValset", nil)
 	cdc.RegisterConcrete(&MsgWithdrawDelegationRewards{}, "osmosis/MsgWithdrawDelegationRewards", nil)
 	cdc.RegisterConcrete(&MsgRedelegateValidatorSet{}, "osmosis/MsgRedelegateValidatorSet", nil)
+	cdc.RegisterConcrete(&MsgSetAutoRebalance{}, "osmosis/MsgSetAutoRebalance", nil)
+	cdc.RegisterConcrete(&MsgSetValidatorSetRule{}, "osmosis/MsgSetValidatorSetRule", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -25,6 +27,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUndelegateFromRebalancedValidatorSet{},
 		&MsgWithdrawDelegationRewards{},
 		&MsgRedelegateValidatorSet{},
+		&MsgSetAutoRebalance{},
+		&MsgSetValidatorSetRule{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,19 @@
+package types
+
+const (
+	// AutoRebalanceEpochIdentifier is the epoch at the end of which the delegations of
+	// every delegator that opted in to auto rebalancing are rebalanced.
+	AutoRebalanceEpochIdentifier = "day"
+
+	// MaxAutoRebalanceRedelegationsPerEpoch is the maximum number of redelegations performed
+	// for a single delegator each epoch. Delegations that drifted far from the preference are
+	// moved back gradually over several epochs.
+	MaxAutoRebalanceRedelegationsPerEpoch = 3
+
+	// MaxAutoRebalanceDelegatorsPerEpoch is the maximum number of delegators whose delegations are
+	// rebalanced each epoch. The following delegators are rebalanced in the next epochs.
+	MaxAutoRebalanceDelegatorsPerEpoch = 500
+
+	// MaxValidatorSetRuleValidators is the maximum number of validators a validator set rule can select.
+	MaxValidatorSetRuleValidators = 100
+)
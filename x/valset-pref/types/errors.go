@@ -9,6 +9,8 @@ import (
 
 var (
 	ErrNoDelegation = errors.New("No existing delegation")
+
+	ErrNoValidatorsMatchRule = errors.New("No validators match the validator set rule")
 )
 
 type UndelegateMoreThanDelegatedError struct {
@@ -43,3 +45,11 @@ type ValidatorNotFoundError struct {
 func (e ValidatorNotFoundError) Error() string {
 	return fmt.Sprintf("validator %s not found", e.ValidatorAddr)
 }
+
+type ValidatorSetPreferenceNotFoundError struct {
+	DelegatorAddr string
+}
+
+func (e ValidatorSetPreferenceNotFoundError) Error() string {
+	return fmt.Sprintf("user %s doesn't have a validator set preference", e.DelegatorAddr)
+}
@@ -13,6 +13,18 @@ var (
 	// KeyPrefixValidatorSet defines prefix key for validator set.
 	KeyPrefixValidatorSet = []byte{0x01}
 
+	// KeyPrefixAutoRebalance defines prefix key for the auto rebalance config of a delegator.
+	KeyPrefixAutoRebalance = []byte{0x02}
+
+	// KeyAutoRebalanceCursor defines key for the last delegator whose delegations were rebalanced, when the
+	// rebalancing of the previous epoch did not reach every delegator.
+	KeyAutoRebalanceCursor = []byte{0x03}
+
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
 )
+
+// GetKeyAutoRebalance returns the key of the auto rebalance config of the given delegator.
+func GetKeyAutoRebalance(delegator string) []byte {
+	return append(KeyPrefixAutoRebalance, []byte(delegator)...)
+}
@@ -302,3 +302,75 @@ func (m MsgDelegateBondedTokens) GetSigners() []sdk.AccAddress {
 	delegator, _ := sdk.AccAddressFromBech32(m.Delegator)
 	return []sdk.AccAddress{delegator}
 }
+
+// constants
+const (
+	TypeMsgSetAutoRebalance = "set_auto_rebalance"
+)
+
+var _ sdk.Msg = &MsgSetAutoRebalance{}
+
+// NewMsgSetAutoRebalance creates a msg to opt in or out of auto rebalancing.
+func NewMsgSetAutoRebalance(delegator sdk.AccAddress, enabled bool, driftThreshold osmomath.Dec) *MsgSetAutoRebalance {
+	return &MsgSetAutoRebalance{
+		Delegator:      delegator.String(),
+		Enabled:        enabled,
+		DriftThreshold: driftThreshold,
+	}
+}
+
+func (m MsgSetAutoRebalance) Route() string { return RouterKey }
+func (m MsgSetAutoRebalance) Type() string  { return TypeMsgSetAutoRebalance }
+func (m MsgSetAutoRebalance) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Delegator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid delegator address (%s)", err)
+	}
+
+	if !m.Enabled {
+		return nil
+	}
+
+	// the drift threshold is a fraction of the total delegated tokens
+	if m.DriftThreshold.IsNil() || m.DriftThreshold.IsNegative() || m.DriftThreshold.GTE(osmomath.OneDec()) {
+		return fmt.Errorf("Invalid drift threshold, needs to be in [0, 1), got %s", m.DriftThreshold)
+	}
+
+	return nil
+}
+
+func (m MsgSetAutoRebalance) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(m.Delegator)
+	return []sdk.AccAddress{delegator}
+}
+
+// constants
+const (
+	TypeMsgSetValidatorSetRule = "set_validator_set_rule"
+)
+
+var _ sdk.Msg = &MsgSetValidatorSetRule{}
+
+// NewMsgSetValidatorSetRule creates a msg to set a rule based validator-set preference.
+func NewMsgSetValidatorSetRule(delegator sdk.AccAddress, rule ValidatorSetRule) *MsgSetValidatorSetRule {
+	return &MsgSetValidatorSetRule{
+		Delegator: delegator.String(),
+		Rule:      rule,
+	}
+}
+
+func (m MsgSetValidatorSetRule) Route() string { return RouterKey }
+func (m MsgSetValidatorSetRule) Type() string  { return TypeMsgSetValidatorSetRule }
+func (m MsgSetValidatorSetRule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Delegator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid delegator address (%s)", err)
+	}
+
+	return m.Rule.Validate()
+}
+
+func (m MsgSetValidatorSetRule) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(m.Delegator)
+	return []sdk.AccAddress{delegator}
+}
@@ -154,3 +154,160 @@ func TestMsgSetValidatorSetPreference(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetAutoRebalance(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSetAutoRebalance
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgSetAutoRebalance{
+				Delegator:      addr1,
+				Enabled:        true,
+				DriftThreshold: osmomath.NewDecWithPrec(5, 2),
+			},
+			expectPass: true,
+		},
+		{
+			name: "zero drift threshold",
+			msg: types.MsgSetAutoRebalance{
+				Delegator:      addr1,
+				Enabled:        true,
+				DriftThreshold: osmomath.ZeroDec(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "opt out without drift threshold",
+			msg: types.MsgSetAutoRebalance{
+				Delegator: addr1,
+				Enabled:   false,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid delegator",
+			msg: types.MsgSetAutoRebalance{
+				Delegator:      invalidAddr,
+				Enabled:        true,
+				DriftThreshold: osmomath.NewDecWithPrec(5, 2),
+			},
+			expectPass: false,
+		},
+		{
+			name: "missing drift threshold",
+			msg: types.MsgSetAutoRebalance{
+				Delegator: addr1,
+				Enabled:   true,
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative drift threshold",
+			msg: types.MsgSetAutoRebalance{
+				Delegator:      addr1,
+				Enabled:        true,
+				DriftThreshold: osmomath.NewDecWithPrec(-5, 2),
+			},
+			expectPass: false,
+		},
+		{
+			name: "drift threshold of one",
+			msg: types.MsgSetAutoRebalance{
+				Delegator:      addr1,
+				Enabled:        true,
+				DriftThreshold: osmomath.OneDec(),
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expectPass {
+				require.NoError(t, err, "test: %v", test.name)
+			} else {
+				require.Error(t, err, "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgSetValidatorSetRule(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSetValidatorSetRule
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgSetValidatorSetRule{
+				Delegator: addr1,
+				Rule: types.ValidatorSetRule{
+					MaxValidators:     10,
+					MaxCommissionRate: osmomath.NewDecWithPrec(1, 1),
+					Weighting:         types.VotingPowerWeight,
+				},
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty rule",
+			msg: types.MsgSetValidatorSetRule{
+				Delegator: addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid delegator",
+			msg: types.MsgSetValidatorSetRule{
+				Delegator: invalidAddr,
+			},
+			expectPass: false,
+		},
+		{
+			name: "too many validators",
+			msg: types.MsgSetValidatorSetRule{
+				Delegator: addr1,
+				Rule:      types.ValidatorSetRule{MaxValidators: types.MaxValidatorSetRuleValidators + 1},
+			},
+			expectPass: false,
+		},
+		{
+			name: "max commission rate above one",
+			msg: types.MsgSetValidatorSetRule{
+				Delegator: addr1,
+				Rule:      types.ValidatorSetRule{MaxCommissionRate: osmomath.NewDecWithPrec(11, 1)},
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid weighting",
+			msg: types.MsgSetValidatorSetRule{
+				Delegator: addr1,
+				Rule:      types.ValidatorSetRule{Weighting: types.ValidatorSetRuleWeighting(2)},
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expectPass {
+				require.NoError(t, err, "test: %v", test.name)
+			} else {
+				require.Error(t, err, "test: %v", test.name)
+			}
+		})
+	}
+}
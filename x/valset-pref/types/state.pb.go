@@ -25,6 +25,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ValidatorSetRuleWeighting determines how the validators selected by a
+// ValidatorSetRule are weighted.
+type ValidatorSetRuleWeighting int32
+
+const (
+	// EqualWeight assigns the same weight to every selected validator.
+	EqualWeight ValidatorSetRuleWeighting = 0
+	// VotingPowerWeight assigns weights proportional to the tokens bonded to
+	// every selected validator.
+	VotingPowerWeight ValidatorSetRuleWeighting = 1
+)
+
+var ValidatorSetRuleWeighting_name = map[int32]string{
+	0: "EqualWeight",
+	1: "VotingPowerWeight",
+}
+
+var ValidatorSetRuleWeighting_value = map[string]int32{
+	"EqualWeight":       0,
+	"VotingPowerWeight": 1,
+}
+
+func (x ValidatorSetRuleWeighting) String() string {
+	return proto.EnumName(ValidatorSetRuleWeighting_name, int32(x))
+}
+
+func (ValidatorSetRuleWeighting) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f1c846861b49d50b, []int{0}
+}
+
 // ValidatorPreference defines the message structure for
 // CreateValidatorSetPreference. It allows a user to set {val_addr, weight} in
 // state. If a user does not have a validator set preference list set, and has
@@ -78,6 +108,9 @@ var xxx_messageInfo_ValidatorPreference proto.InternalMessageInfo
 type ValidatorSetPreferences struct {
 	// preference holds {valAddr, weight} for the user who created it.
 	Preferences []ValidatorPreference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences" yaml:"preferences"`
+	// rule, when set, selects the validators and weights from the staking state
+	// every time the preference is used instead of the fixed preferences.
+	Rule *ValidatorSetRule `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty" yaml:"rule"`
 }
 
 func (m *ValidatorSetPreferences) Reset()         { *m = ValidatorSetPreferences{} }
@@ -113,9 +146,101 @@ func (m *ValidatorSetPreferences) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorSetPreferences proto.InternalMessageInfo
 
+// ValidatorSetRule defines a validator set preference that follows the
+// staking state. Validators are ranked by voting power, the ones excluded by
+// the rule are skipped and the top max_validators of the rest are selected.
+type ValidatorSetRule struct {
+	// max_validators is the maximum number of validators to select. Zero
+	// selects up to MaxValidatorSetRuleValidators validators.
+	MaxValidators uint64 `protobuf:"varint,1,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty" yaml:"max_validators"`
+	// max_commission_rate excludes the validators with a higher commission
+	// rate. Zero does not exclude any validator by commission.
+	MaxCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_rate" yaml:"max_commission_rate"`
+	// weighting determines how the selected validators are weighted.
+	Weighting ValidatorSetRuleWeighting `protobuf:"varint,4,opt,name=weighting,proto3,enum=osmosis.valsetpref.v1beta1.ValidatorSetRuleWeighting" json:"weighting,omitempty" yaml:"weighting"`
+}
+
+func (m *ValidatorSetRule) Reset()         { *m = ValidatorSetRule{} }
+func (m *ValidatorSetRule) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetRule) ProtoMessage()    {}
+func (*ValidatorSetRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1c846861b49d50b, []int{2}
+}
+func (m *ValidatorSetRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetRule.Merge(m, src)
+}
+func (m *ValidatorSetRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetRule proto.InternalMessageInfo
+
+// AutoRebalanceConfig defines a delegator's opt-in to have their delegations
+// gradually moved back towards their validator set preference every day epoch.
+type AutoRebalanceConfig struct {
+	// drift_threshold is the fraction of the delegator's total delegated tokens
+	// that has to be allocated away from the validator set preference before
+	// the delegations are rebalanced.
+	DriftThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=drift_threshold,json=driftThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"drift_threshold" yaml:"drift_threshold"`
+}
+
+func (m *AutoRebalanceConfig) Reset()         { *m = AutoRebalanceConfig{} }
+func (m *AutoRebalanceConfig) String() string { return proto.CompactTextString(m) }
+func (*AutoRebalanceConfig) ProtoMessage()    {}
+func (*AutoRebalanceConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1c846861b49d50b, []int{3}
+}
+func (m *AutoRebalanceConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRebalanceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRebalanceConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoRebalanceConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRebalanceConfig.Merge(m, src)
+}
+func (m *AutoRebalanceConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRebalanceConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRebalanceConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRebalanceConfig proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("osmosis.valsetpref.v1beta1.ValidatorSetRuleWeighting", ValidatorSetRuleWeighting_name, ValidatorSetRuleWeighting_value)
 	proto.RegisterType((*ValidatorPreference)(nil), "osmosis.valsetpref.v1beta1.ValidatorPreference")
 	proto.RegisterType((*ValidatorSetPreferences)(nil), "osmosis.valsetpref.v1beta1.ValidatorSetPreferences")
+	proto.RegisterType((*ValidatorSetRule)(nil), "osmosis.valsetpref.v1beta1.ValidatorSetRule")
+	proto.RegisterType((*AutoRebalanceConfig)(nil), "osmosis.valsetpref.v1beta1.AutoRebalanceConfig")
 }
 
 func init() {
@@ -123,30 +248,45 @@ func init() {
 }
 
 var fileDescriptor_f1c846861b49d50b = []byte{
-	// 361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
-	0x14, 0xc6, 0x93, 0x5e, 0x28, 0xdc, 0x14, 0x2e, 0x97, 0xdc, 0x0b, 0x2d, 0xa9, 0x24, 0x25, 0x82,
-	0x74, 0xd3, 0x19, 0x5a, 0x17, 0xa2, 0xae, 0x2c, 0xba, 0x13, 0x94, 0x8a, 0x2e, 0xdc, 0x94, 0x93,
-	0xe4, 0x34, 0x0d, 0x26, 0x99, 0x30, 0x33, 0x46, 0xfb, 0x06, 0x2e, 0xdd, 0xfa, 0x46, 0x5d, 0x76,
-	0x29, 0x2e, 0x82, 0xb6, 0x6f, 0xd0, 0x27, 0x90, 0x26, 0xa1, 0x2d, 0xa2, 0xbb, 0xf9, 0xf3, 0xfb,
-	0x0e, 0xdf, 0x77, 0x3e, 0x6d, 0x8f, 0x89, 0x88, 0x89, 0x40, 0xd0, 0x14, 0x42, 0x81, 0x32, 0xe1,
-	0x38, 0xa2, 0x69, 0xd7, 0x41, 0x09, 0x5d, 0x2a, 0x24, 0x48, 0x24, 0x09, 0x67, 0x92, 0xe9, 0x46,
-	0xc9, 0x91, 0x0d, 0x47, 0x4a, 0xce, 0xf8, 0xef, 0x33, 0x9f, 0xe5, 0x18, 0x5d, 0x9d, 0x0a, 0x85,
-	0xb1, 0xe3, 0x33, 0xe6, 0x87, 0x48, 0x21, 0x09, 0x28, 0xc4, 0x31, 0x93, 0x20, 0x03, 0x16, 0x8b,
-	0xe2, 0xd7, 0x7e, 0x51, 0xb5, 0x7f, 0x37, 0x10, 0x06, 0x1e, 0x48, 0xc6, 0x2f, 0x39, 0x8e, 0x90,
-	0x63, 0xec, 0xa2, 0x7e, 0xa6, 0xfd, 0x4d, 0x21, 0x1c, 0xb2, 0x04, 0xf9, 0x10, 0x3c, 0x8f, 0xa3,
-	0x10, 0x0d, 0xb5, 0xa5, 0xb6, 0x7f, 0xf7, 0x9b, 0xcb, 0xcc, 0xaa, 0x4f, 0x20, 0x0a, 0x8f, 0xec,
-	0xaf, 0x84, 0x3d, 0xf8, 0x93, 0x42, 0x78, 0x91, 0x20, 0x3f, 0x29, 0x1e, 0xf4, 0x63, 0xad, 0xfa,
-	0x80, 0x81, 0x3f, 0x96, 0x8d, 0x4a, 0x2e, 0xde, 0x9d, 0x66, 0x96, 0xf2, 0x96, 0x59, 0x4d, 0x37,
-	0xcf, 0x21, 0xbc, 0x3b, 0x12, 0x30, 0x1a, 0x81, 0x1c, 0x93, 0x73, 0xf4, 0xc1, 0x9d, 0x9c, 0xa2,
-	0x3b, 0x28, 0x25, 0xf6, 0x93, 0xaa, 0xd5, 0xd7, 0xde, 0xae, 0x50, 0x6e, 0xec, 0x09, 0x3d, 0xd2,
-	0x6a, 0xc9, 0xe6, 0xda, 0xa8, 0xb4, 0x7e, 0xb5, 0x6b, 0x3d, 0x4a, 0x7e, 0xde, 0x0e, 0xf9, 0x26,
-	0x65, 0xdf, 0x58, 0xd9, 0x59, 0x66, 0x96, 0x5e, 0xe4, 0xd9, 0x9a, 0x68, 0x0f, 0xb6, 0xe7, 0xf7,
-	0xaf, 0xa7, 0x1f, 0xa6, 0x32, 0x9d, 0x9b, 0xea, 0x6c, 0x6e, 0xaa, 0xef, 0x73, 0x53, 0x7d, 0x5e,
-	0x98, 0xca, 0x6c, 0x61, 0x2a, 0xaf, 0x0b, 0x53, 0xb9, 0x3d, 0xf0, 0x03, 0x39, 0xbe, 0x77, 0x88,
-	0xcb, 0x22, 0x5a, 0x3a, 0xe8, 0x84, 0xe0, 0x08, 0xba, 0x2e, 0xb5, 0x77, 0x48, 0x1f, 0xcb, 0x6a,
-	0x3b, 0x79, 0xb7, 0x72, 0x92, 0xa0, 0x70, 0xaa, 0x79, 0x09, 0xfb, 0x9f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x5d, 0xa4, 0x40, 0xb0, 0xfe, 0x01, 0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xb5, 0xdb, 0xa8, 0xa2, 0x1b, 0x91, 0xa6, 0x6e, 0x4b, 0xd3, 0x14, 0xd9, 0xd1, 0x22, 0xa1,
+	0x08, 0x51, 0x5b, 0x0d, 0x42, 0x08, 0x10, 0x12, 0x4d, 0xe9, 0x01, 0x84, 0x44, 0x71, 0xa1, 0x48,
+	0x5c, 0xa2, 0x8d, 0x33, 0x71, 0x56, 0xac, 0xbd, 0xee, 0xee, 0x26, 0x4d, 0x0f, 0xdc, 0x39, 0x72,
+	0xe5, 0xcc, 0x4f, 0xf0, 0x09, 0x3d, 0xf6, 0x88, 0x40, 0xb2, 0xa0, 0xfd, 0x03, 0x7f, 0x01, 0x8a,
+	0xed, 0x26, 0x25, 0xa2, 0x52, 0xb9, 0x25, 0x6f, 0xde, 0xbc, 0x99, 0xf7, 0x6c, 0x0f, 0xba, 0xcd,
+	0x65, 0xc0, 0x25, 0x95, 0xce, 0x80, 0x30, 0x09, 0x2a, 0x12, 0xd0, 0x75, 0x06, 0x9b, 0x6d, 0x50,
+	0x64, 0xd3, 0x91, 0x8a, 0x28, 0xb0, 0x23, 0xc1, 0x15, 0x37, 0xaa, 0x39, 0xcf, 0x9e, 0xf0, 0xec,
+	0x9c, 0x57, 0x5d, 0xf6, 0xb9, 0xcf, 0x53, 0x9a, 0x33, 0xfa, 0x95, 0x75, 0x54, 0x6f, 0xfa, 0x9c,
+	0xfb, 0x0c, 0x1c, 0x12, 0x51, 0x87, 0x84, 0x21, 0x57, 0x44, 0x51, 0x1e, 0xca, 0xac, 0x8a, 0xbf,
+	0xe8, 0x68, 0x69, 0x9f, 0x30, 0xda, 0x21, 0x8a, 0x8b, 0x5d, 0x01, 0x5d, 0x10, 0x10, 0x7a, 0x60,
+	0xec, 0xa0, 0xf2, 0x80, 0xb0, 0x16, 0x8f, 0x40, 0xb4, 0x48, 0xa7, 0x23, 0x40, 0xca, 0x8a, 0x5e,
+	0xd3, 0xeb, 0xf3, 0xcd, 0xf5, 0x24, 0xb6, 0x56, 0x8f, 0x48, 0xc0, 0x1e, 0xe1, 0x69, 0x06, 0x76,
+	0x4b, 0x03, 0xc2, 0x5e, 0x45, 0x20, 0xb6, 0x32, 0xc0, 0x78, 0x8c, 0xe6, 0x0e, 0x81, 0xfa, 0x3d,
+	0x55, 0x99, 0x49, 0x9b, 0x6f, 0x1d, 0xc7, 0x96, 0xf6, 0x23, 0xb6, 0xd6, 0xbd, 0xd4, 0x87, 0xec,
+	0x7c, 0xb0, 0x29, 0x77, 0x02, 0xa2, 0x7a, 0xf6, 0x4b, 0xf0, 0x89, 0x77, 0xf4, 0x0c, 0x3c, 0x37,
+	0x6f, 0xc1, 0x3f, 0x75, 0xb4, 0x3a, 0xde, 0x6d, 0x0f, 0xd4, 0x64, 0x3d, 0x69, 0x04, 0xa8, 0x18,
+	0x4d, 0xfe, 0x56, 0x66, 0x6a, 0xb3, 0xf5, 0x62, 0xc3, 0xb1, 0x2f, 0x4f, 0xc7, 0xfe, 0x87, 0xcb,
+	0x66, 0x75, 0xb4, 0x4e, 0x12, 0x5b, 0x46, 0xe6, 0xe7, 0x82, 0x22, 0x76, 0x2f, 0xea, 0x1b, 0xaf,
+	0x51, 0x41, 0xf4, 0x19, 0x54, 0x66, 0x6b, 0x7a, 0xbd, 0xd8, 0xb8, 0x7b, 0xa5, 0x39, 0x7b, 0xa0,
+	0xdc, 0x3e, 0x83, 0xe6, 0x42, 0x12, 0x5b, 0xc5, 0x6c, 0xc0, 0x48, 0x03, 0xbb, 0xa9, 0x14, 0xfe,
+	0x36, 0x83, 0xca, 0xd3, 0x5c, 0xe3, 0x29, 0x2a, 0x05, 0x64, 0xd8, 0x1a, 0x9c, 0xe3, 0x59, 0xe8,
+	0x85, 0xe6, 0x5a, 0x12, 0x5b, 0x2b, 0x99, 0xc6, 0xdf, 0x75, 0xec, 0x5e, 0x0f, 0xc8, 0x70, 0xac,
+	0x23, 0x8d, 0x03, 0xb4, 0x34, 0x62, 0x78, 0x3c, 0x08, 0xa8, 0x94, 0x94, 0x87, 0x2d, 0x41, 0x14,
+	0xe4, 0xf1, 0x6f, 0x5d, 0x21, 0xfe, 0x24, 0xb6, 0xaa, 0x93, 0x49, 0x53, 0x3a, 0xd8, 0x5d, 0x0c,
+	0xc8, 0x70, 0x7b, 0x0c, 0xba, 0x44, 0x81, 0x41, 0xd1, 0x7c, 0xf6, 0xc4, 0x68, 0xe8, 0x57, 0x0a,
+	0x35, 0xbd, 0x5e, 0x6a, 0xdc, 0xff, 0x9f, 0x84, 0xde, 0x9d, 0x37, 0x37, 0x97, 0x93, 0xd8, 0x2a,
+	0x67, 0xc3, 0xc7, 0x8a, 0xd8, 0x9d, 0xa8, 0xbf, 0x28, 0x5c, 0x9b, 0x2d, 0x17, 0xf0, 0x47, 0xb4,
+	0xb4, 0xd5, 0x57, 0xdc, 0x85, 0x36, 0x61, 0x24, 0xf4, 0x60, 0x9b, 0x87, 0x5d, 0xea, 0x1b, 0x5d,
+	0xb4, 0xd0, 0x11, 0xb4, 0xab, 0x5a, 0xaa, 0x27, 0x40, 0xf6, 0x38, 0xeb, 0xe4, 0xaf, 0xec, 0x93,
+	0xab, 0xd9, 0xbe, 0x91, 0x4d, 0x9e, 0xd2, 0xc0, 0x6e, 0x29, 0x45, 0xde, 0x9c, 0x03, 0x77, 0x9e,
+	0xa3, 0xb5, 0x4b, 0x2d, 0x18, 0x0b, 0xa8, 0xb8, 0x73, 0xd0, 0x27, 0x2c, 0x43, 0xca, 0x9a, 0xb1,
+	0x82, 0x16, 0xf7, 0xf9, 0xa8, 0xb4, 0xcb, 0x0f, 0x41, 0xe4, 0xb0, 0x5e, 0x2d, 0x7c, 0xfa, 0x6a,
+	0x6a, 0xcd, 0xb7, 0xc7, 0xbf, 0x4d, 0xed, 0xf8, 0xd4, 0xd4, 0x4f, 0x4e, 0x4d, 0xfd, 0xd7, 0xa9,
+	0xa9, 0x7f, 0x3e, 0x33, 0xb5, 0x93, 0x33, 0x53, 0xfb, 0x7e, 0x66, 0x6a, 0xef, 0x1f, 0xf8, 0x54,
+	0xf5, 0xfa, 0x6d, 0xdb, 0xe3, 0x81, 0x93, 0xe7, 0xb9, 0xc1, 0x48, 0x5b, 0x3a, 0xe3, 0x63, 0xd1,
+	0x78, 0xe8, 0x0c, 0xf3, 0x93, 0xb1, 0x91, 0xde, 0x0c, 0x75, 0x14, 0x81, 0x6c, 0xcf, 0xa5, 0x1f,
+	0xf7, 0xbd, 0x3f, 0x03, 0x00, 0x02, 0x29, 0xa6, 0x10, 0x56, 0x04, 0x00, 0x00,
 }
 
 func (m *ValidatorPreference) Marshal() (dAtA []byte, err error) {
@@ -209,6 +349,18 @@ func (m *ValidatorSetPreferences) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Rule != nil {
+		{
+			size, err := m.Rule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Preferences) > 0 {
 		for iNdEx := len(m.Preferences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -226,6 +378,82 @@ func (m *ValidatorSetPreferences) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorSetRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weighting != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Weighting))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MaxCommissionRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MaxValidators != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoRebalanceConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRebalanceConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRebalanceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DriftThreshold.Size()
+		i -= size
+		if _, err := m.DriftThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintState(dAtA []byte, offset int, v uint64) int {
 	offset -= sovState(v)
 	base := offset
@@ -264,6 +492,38 @@ func (m *ValidatorSetPreferences) Size() (n int) {
 			n += 1 + l + sovState(uint64(l))
 		}
 	}
+	if m.Rule != nil {
+		l = m.Rule.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorSetRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxValidators != 0 {
+		n += 1 + sovState(uint64(m.MaxValidators))
+	}
+	l = m.MaxCommissionRate.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.Weighting != 0 {
+		n += 1 + sovState(uint64(m.Weighting))
+	}
+	return n
+}
+
+func (m *AutoRebalanceConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DriftThreshold.Size()
+	n += 1 + l + sovState(uint64(l))
 	return n
 }
 
@@ -452,6 +712,248 @@ func (m *ValidatorSetPreferences) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rule == nil {
+				m.Rule = &ValidatorSetRule{}
+			}
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weighting", wireType)
+			}
+			m.Weighting = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weighting |= ValidatorSetRuleWeighting(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoRebalanceConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoRebalanceConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoRebalanceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DriftThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DriftThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
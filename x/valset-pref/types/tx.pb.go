@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -680,6 +681,193 @@ func (m *MsgDelegateBondedTokensResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDelegateBondedTokensResponse proto.InternalMessageInfo
 
+// MsgSetAutoRebalance opts the delegator in or out of the automatic
+// rebalancing of their delegations towards their validator set preference.
+type MsgSetAutoRebalance struct {
+	// delegator is the user who is trying to opt in or out of auto rebalancing.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty" yaml:"delegator"`
+	// enabled is whether the delegations should be automatically rebalanced.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	// drift_threshold is the fraction of the total delegated tokens that has to
+	// be allocated away from the validator set preference before the
+	// delegations are rebalanced. It is ignored when opting out.
+	DriftThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=drift_threshold,json=driftThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"drift_threshold" yaml:"drift_threshold"`
+}
+
+func (m *MsgSetAutoRebalance) Reset()         { *m = MsgSetAutoRebalance{} }
+func (m *MsgSetAutoRebalance) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRebalance) ProtoMessage()    {}
+func (*MsgSetAutoRebalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff1326c2fd6b4c, []int{14}
+}
+func (m *MsgSetAutoRebalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRebalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRebalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoRebalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRebalance.Merge(m, src)
+}
+func (m *MsgSetAutoRebalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRebalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRebalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoRebalance proto.InternalMessageInfo
+
+func (m *MsgSetAutoRebalance) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgSetAutoRebalance) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type MsgSetAutoRebalanceResponse struct {
+}
+
+func (m *MsgSetAutoRebalanceResponse) Reset()         { *m = MsgSetAutoRebalanceResponse{} }
+func (m *MsgSetAutoRebalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRebalanceResponse) ProtoMessage()    {}
+func (*MsgSetAutoRebalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff1326c2fd6b4c, []int{15}
+}
+func (m *MsgSetAutoRebalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRebalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRebalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoRebalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRebalanceResponse.Merge(m, src)
+}
+func (m *MsgSetAutoRebalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRebalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRebalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoRebalanceResponse proto.InternalMessageInfo
+
+// MsgSetValidatorSetRule sets a rule based validator set preference.
+type MsgSetValidatorSetRule struct {
+	// delegator is the user who is trying to set the rule.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty" yaml:"delegator"`
+	// rule selects the validators and weights to delegate to.
+	Rule ValidatorSetRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule" yaml:"rule"`
+}
+
+func (m *MsgSetValidatorSetRule) Reset()         { *m = MsgSetValidatorSetRule{} }
+func (m *MsgSetValidatorSetRule) String() string { return proto.CompactTextString(m) }
+func (*MsgSetValidatorSetRule) ProtoMessage()    {}
+func (*MsgSetValidatorSetRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff1326c2fd6b4c, []int{16}
+}
+func (m *MsgSetValidatorSetRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetValidatorSetRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetValidatorSetRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetValidatorSetRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetValidatorSetRule.Merge(m, src)
+}
+func (m *MsgSetValidatorSetRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetValidatorSetRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetValidatorSetRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetValidatorSetRule proto.InternalMessageInfo
+
+func (m *MsgSetValidatorSetRule) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgSetValidatorSetRule) GetRule() ValidatorSetRule {
+	if m != nil {
+		return m.Rule
+	}
+	return ValidatorSetRule{}
+}
+
+type MsgSetValidatorSetRuleResponse struct {
+}
+
+func (m *MsgSetValidatorSetRuleResponse) Reset()         { *m = MsgSetValidatorSetRuleResponse{} }
+func (m *MsgSetValidatorSetRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetValidatorSetRuleResponse) ProtoMessage()    {}
+func (*MsgSetValidatorSetRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff1326c2fd6b4c, []int{17}
+}
+func (m *MsgSetValidatorSetRuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetValidatorSetRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetValidatorSetRuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetValidatorSetRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetValidatorSetRuleResponse.Merge(m, src)
+}
+func (m *MsgSetValidatorSetRuleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetValidatorSetRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetValidatorSetRuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetValidatorSetRuleResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetValidatorSetPreference)(nil), "osmosis.valsetpref.v1beta1.MsgSetValidatorSetPreference")
 	proto.RegisterType((*MsgSetValidatorSetPreferenceResponse)(nil), "osmosis.valsetpref.v1beta1.MsgSetValidatorSetPreferenceResponse")
@@ -695,6 +883,10 @@ func init() {
 	proto.RegisterType((*MsgWithdrawDelegationRewardsResponse)(nil), "osmosis.valsetpref.v1beta1.MsgWithdrawDelegationRewardsResponse")
 	proto.RegisterType((*MsgDelegateBondedTokens)(nil), "osmosis.valsetpref.v1beta1.MsgDelegateBondedTokens")
 	proto.RegisterType((*MsgDelegateBondedTokensResponse)(nil), "osmosis.valsetpref.v1beta1.MsgDelegateBondedTokensResponse")
+	proto.RegisterType((*MsgSetAutoRebalance)(nil), "osmosis.valsetpref.v1beta1.MsgSetAutoRebalance")
+	proto.RegisterType((*MsgSetAutoRebalanceResponse)(nil), "osmosis.valsetpref.v1beta1.MsgSetAutoRebalanceResponse")
+	proto.RegisterType((*MsgSetValidatorSetRule)(nil), "osmosis.valsetpref.v1beta1.MsgSetValidatorSetRule")
+	proto.RegisterType((*MsgSetValidatorSetRuleResponse)(nil), "osmosis.valsetpref.v1beta1.MsgSetValidatorSetRuleResponse")
 }
 
 func init() {
@@ -702,57 +894,70 @@ func init() {
 }
 
 var fileDescriptor_3fff1326c2fd6b4c = []byte{
-	// 786 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6b, 0xd4, 0x5a,
-	0x14, 0x9f, 0xdb, 0x96, 0xf2, 0x7a, 0xbb, 0x79, 0x2f, 0x94, 0x7e, 0x84, 0xf7, 0x66, 0xda, 0xf4,
-	0x93, 0x42, 0x73, 0x99, 0xe9, 0x93, 0xb6, 0x23, 0x82, 0x4e, 0x8b, 0x20, 0x32, 0xa0, 0x69, 0xad,
-	0xe0, 0x42, 0xb8, 0x33, 0xb9, 0x4d, 0x43, 0x93, 0xdc, 0x21, 0xf7, 0xf6, 0x6b, 0xeb, 0x4a, 0x14,
-	0xc4, 0x9d, 0xe8, 0x9f, 0xe0, 0xaa, 0x4b, 0x57, 0xae, 0xbb, 0xec, 0xd2, 0x55, 0x95, 0x16, 0xe9,
-	0xca, 0x4d, 0x11, 0x17, 0xae, 0x64, 0x92, 0x3b, 0x99, 0xc8, 0xe4, 0xa3, 0xc6, 0x2a, 0x6e, 0x26,
-	0x93, 0x9c, 0xf3, 0x3b, 0x1f, 0xbf, 0x73, 0xcf, 0x39, 0x17, 0x8e, 0x53, 0x66, 0x53, 0x66, 0x32,
-	0xb4, 0x83, 0x2d, 0x46, 0x78, 0xc3, 0x25, 0x1b, 0x68, 0xa7, 0x58, 0x23, 0x1c, 0x17, 0x11, 0xdf,
-	0x53, 0x1b, 0x2e, 0xe5, 0x54, 0x92, 0x85, 0x92, 0xda, 0x56, 0x52, 0x85, 0x92, 0x3c, 0x60, 0x50,
-	0x83, 0x7a, 0x6a, 0xa8, 0xf9, 0xcf, 0x47, 0xc8, 0xff, 0x60, 0xdb, 0x74, 0x28, 0xf2, 0x7e, 0xc5,
-	0xa7, 0x82, 0x41, 0xa9, 0x61, 0x11, 0xe4, 0xbd, 0xd5, 0xb6, 0x37, 0x10, 0x37, 0x6d, 0xc2, 0x38,
-	0xb6, 0x1b, 0x42, 0x21, 0x5f, 0xf7, 0xdc, 0xa0, 0x1a, 0x66, 0x24, 0x88, 0xa1, 0x4e, 0x4d, 0x47,
-	0xc8, 0xa7, 0x12, 0x42, 0x65, 0x1c, 0x73, 0x22, 0xf4, 0x86, 0x84, 0x1d, 0x9b, 0x19, 0x68, 0xa7,
-	0xd8, 0x7c, 0xf8, 0x02, 0xe5, 0x2b, 0x80, 0xff, 0x56, 0x99, 0xb1, 0x4a, 0xf8, 0x3a, 0xb6, 0x4c,
-	0x1d, 0x73, 0xea, 0xae, 0x12, 0x7e, 0xc7, 0x25, 0x1b, 0xc4, 0x25, 0x4e, 0x9d, 0x48, 0x25, 0xd8,
-	0xa7, 0x13, 0x8b, 0x18, 0x4d, 0xc9, 0x30, 0x18, 0x05, 0x33, 0x7d, 0x95, 0x81, 0xf3, 0xe3, 0xc2,
-	0xdf, 0xfb, 0xd8, 0xb6, 0xca, 0x4a, 0x20, 0x52, 0xb4, 0xb6, 0x9a, 0x64, 0xc3, 0xfe, 0x46, 0x60,
-	0x81, 0x0d, 0x77, 0x8d, 0x76, 0xcf, 0xf4, 0x97, 0x90, 0x1a, 0xcf, 0x98, 0x1a, 0x38, 0x6f, 0x7b,
-	0xae, 0xc8, 0x87, 0xc7, 0x85, 0xdc, 0xf9, 0x71, 0x41, 0xf2, 0x5d, 0x85, 0x2c, 0x2a, 0x5a, 0xd8,
-	0x7e, 0x79, 0xe1, 0xd1, 0xd9, 0xc1, 0x6c, 0xdb, 0xfd, 0x93, 0xb3, 0x83, 0xd9, 0x89, 0x16, 0x2f,
-	0x49, 0xb9, 0x29, 0x53, 0x70, 0x22, 0x49, 0xae, 0x11, 0xd6, 0xa0, 0x0e, 0x23, 0xca, 0x47, 0x00,
-	0x47, 0xaa, 0xcc, 0x58, 0xf1, 0x3d, 0x90, 0x35, 0x1a, 0xd6, 0xcf, 0xc4, 0xd0, 0x43, 0xd8, 0xd3,
-	0xac, 0xe2, 0x70, 0xd7, 0x28, 0x98, 0xe9, 0x2f, 0x8d, 0xa8, 0x7e, 0x79, 0xd4, 0x66, 0x99, 0x03,
-	0x4e, 0x96, 0xa9, 0xe9, 0x54, 0x50, 0x93, 0x84, 0xd7, 0xef, 0x0b, 0xd3, 0x86, 0xc9, 0x37, 0xb7,
-	0x6b, 0x6a, 0x9d, 0xda, 0x48, 0xd4, 0xd2, 0x7f, 0xcc, 0x31, 0x7d, 0x0b, 0xf1, 0xfd, 0x06, 0x61,
-	0x1e, 0x40, 0xf3, 0xec, 0x96, 0xff, 0xef, 0xa4, 0x64, 0x2c, 0x44, 0x49, 0x74, 0x26, 0xca, 0x38,
-	0x1c, 0x8b, 0x15, 0x06, 0x64, 0x7c, 0x02, 0xf0, 0xbf, 0x2a, 0x33, 0xee, 0x39, 0xc2, 0x3a, 0xb9,
-	0xe9, 0x52, 0xfb, 0xd2, 0x08, 0xe9, 0xfe, 0x45, 0x84, 0x2c, 0x76, 0x12, 0x32, 0x19, 0x22, 0x24,
-	0x3e, 0x1b, 0x65, 0x1a, 0x4e, 0x26, 0x2a, 0x04, 0xc4, 0x7c, 0x01, 0x70, 0xba, 0x43, 0x53, 0x23,
-	0x35, 0x6c, 0x61, 0xa7, 0x4e, 0xf4, 0x3f, 0xfe, 0xcc, 0xa4, 0xb4, 0x51, 0x44, 0x5e, 0xeb, 0x5e,
-	0x23, 0x2b, 0x45, 0x88, 0x2e, 0x98, 0x77, 0xc0, 0xd5, 0x67, 0xbf, 0xa3, 0x34, 0xd2, 0xc2, 0xfc,
-	0x34, 0x3b, 0xbf, 0x79, 0xe6, 0xa4, 0x34, 0x58, 0x74, 0x62, 0xa2, 0xc1, 0xa2, 0x85, 0x01, 0x37,
-	0x4f, 0xfd, 0x91, 0x7c, 0xdf, 0xe4, 0x9b, 0xba, 0x8b, 0x77, 0x45, 0x3b, 0x9a, 0xd4, 0xd1, 0xc8,
-	0x2e, 0x76, 0x75, 0x96, 0x85, 0x9e, 0xb4, 0xe2, 0xc6, 0x3a, 0x13, 0x33, 0x32, 0x56, 0x1e, 0x44,
-	0xfd, 0x12, 0xc0, 0xa1, 0xd0, 0xf0, 0xa8, 0x50, 0x47, 0x27, 0xfa, 0x1a, 0xdd, 0x22, 0x4e, 0xa6,
-	0x80, 0xa5, 0x41, 0xd8, 0x6b, 0xd1, 0xfa, 0xd6, 0xad, 0x15, 0xef, 0xbc, 0xf7, 0x68, 0xe2, 0xad,
-	0x5c, 0xea, 0x4c, 0xa4, 0x10, 0x31, 0xd9, 0xc2, 0xfe, 0x95, 0x31, 0x58, 0x88, 0x11, 0xb5, 0xc2,
-	0x2f, 0xbd, 0xfd, 0x0b, 0x76, 0x57, 0x99, 0x21, 0xbd, 0x00, 0x70, 0x24, 0x7e, 0x19, 0x2e, 0x26,
-	0x9d, 0xa7, 0xa4, 0x55, 0x22, 0x5f, 0xcf, 0x8a, 0x6c, 0x45, 0x28, 0x3d, 0x03, 0x70, 0x30, 0x66,
-	0x03, 0x5d, 0x49, 0x31, 0x1e, 0x0d, 0x93, 0xaf, 0x65, 0x82, 0x05, 0x01, 0xbd, 0x02, 0x50, 0x4e,
-	0xd8, 0x02, 0x4b, 0x29, 0xd6, 0xe3, 0xa1, 0xf2, 0x8d, 0xcc, 0xd0, 0x20, 0xb8, 0x37, 0x00, 0x4e,
-	0x5c, 0x68, 0x12, 0x2f, 0xff, 0x90, 0xaf, 0x68, 0x23, 0xf2, 0xed, 0x4b, 0x30, 0xf2, 0x5d, 0xa1,
-	0x63, 0x06, 0x63, 0x5a, 0xa1, 0xa3, 0x61, 0xa9, 0x85, 0x4e, 0x1e, 0x48, 0x5e, 0x4f, 0xc4, 0x4f,
-	0xa3, 0xb4, 0x9e, 0x88, 0x45, 0xa6, 0xf6, 0x44, 0xea, 0xd0, 0x91, 0x1e, 0x03, 0x38, 0x10, 0x39,
-	0x71, 0xe6, 0x2f, 0x78, 0xb4, 0xc3, 0x20, 0xf9, 0x6a, 0x06, 0x50, 0x2b, 0x94, 0xca, 0xdd, 0xc3,
-	0x93, 0x3c, 0x38, 0x3a, 0xc9, 0x83, 0x0f, 0x27, 0x79, 0xf0, 0xfc, 0x34, 0x9f, 0x3b, 0x3a, 0xcd,
-	0xe7, 0xde, 0x9d, 0xe6, 0x73, 0x0f, 0x16, 0x42, 0x6b, 0x58, 0x38, 0x98, 0xb3, 0x70, 0x8d, 0xa1,
-	0xe0, 0xee, 0x5e, 0x5a, 0x42, 0x7b, 0xe2, 0x06, 0x3f, 0xe7, 0x5d, 0xe1, 0xbd, 0xdd, 0x5c, 0xeb,
-	0xf5, 0xae, 0xe8, 0xf3, 0xdf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf4, 0xae, 0xcb, 0x96, 0x90, 0x0c,
-	0x00, 0x00,
+	// 999 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0xcf, 0x24, 0x51, 0x21, 0x13, 0xa9, 0x14, 0x27, 0x4a, 0x13, 0x87, 0xda, 0x89, 0x9b, 0x36,
+	0x51, 0xd5, 0x78, 0xb4, 0x5b, 0x50, 0xda, 0x45, 0x91, 0xda, 0x6d, 0x84, 0x84, 0x20, 0x12, 0xb8,
+	0x69, 0x91, 0x38, 0x80, 0x66, 0xd7, 0xb3, 0x5e, 0x2b, 0xb6, 0x67, 0xe5, 0x99, 0x4d, 0x1b, 0x89,
+	0x13, 0x12, 0x12, 0x02, 0x09, 0xb8, 0x21, 0xf8, 0x08, 0x9c, 0x7a, 0xe4, 0x03, 0x70, 0xe8, 0xb1,
+	0x27, 0x84, 0x38, 0x2c, 0x28, 0x11, 0xea, 0x89, 0x4b, 0x84, 0x38, 0x70, 0x42, 0xb6, 0x67, 0x27,
+	0x5e, 0xad, 0xbd, 0xce, 0x9a, 0x82, 0xb8, 0x64, 0xe3, 0x79, 0xef, 0xf7, 0xfe, 0xfc, 0x66, 0xde,
+	0x7b, 0x33, 0xf0, 0x32, 0x65, 0x3e, 0x65, 0x2e, 0x43, 0x07, 0xd8, 0x63, 0x84, 0x77, 0x42, 0xd2,
+	0x42, 0x07, 0x95, 0x06, 0xe1, 0xb8, 0x82, 0xf8, 0x23, 0xb3, 0x13, 0x52, 0x4e, 0x15, 0x55, 0x28,
+	0x99, 0xa7, 0x4a, 0xa6, 0x50, 0x52, 0xe7, 0x1d, 0xea, 0xd0, 0x58, 0x0d, 0x45, 0xff, 0x25, 0x08,
+	0xf5, 0x65, 0xec, 0xbb, 0x01, 0x45, 0xf1, 0x5f, 0xb1, 0xa4, 0x3b, 0x94, 0x3a, 0x1e, 0x41, 0xf1,
+	0x57, 0xa3, 0xdb, 0x42, 0xdc, 0xf5, 0x09, 0xe3, 0xd8, 0xef, 0x08, 0x05, 0xad, 0x19, 0xbb, 0x41,
+	0x0d, 0xcc, 0x88, 0x8c, 0xa1, 0x49, 0xdd, 0x40, 0xc8, 0xaf, 0x8e, 0x08, 0x95, 0x71, 0xcc, 0x89,
+	0xd0, 0xbb, 0x28, 0xec, 0xf8, 0xcc, 0x41, 0x07, 0x95, 0xe8, 0x27, 0x11, 0x18, 0x7f, 0x01, 0xf8,
+	0xca, 0x2e, 0x73, 0xee, 0x11, 0xfe, 0x00, 0x7b, 0xae, 0x8d, 0x39, 0x0d, 0xef, 0x11, 0xfe, 0x4e,
+	0x48, 0x5a, 0x24, 0x24, 0x41, 0x93, 0x28, 0x55, 0x38, 0x63, 0x13, 0x8f, 0x38, 0x91, 0x64, 0x11,
+	0xac, 0x80, 0x8d, 0x99, 0xfa, 0xfc, 0x49, 0x4f, 0xbf, 0x70, 0x88, 0x7d, 0xaf, 0x66, 0x48, 0x91,
+	0x61, 0x9d, 0xaa, 0x29, 0x3e, 0x9c, 0xed, 0x48, 0x0b, 0x6c, 0x71, 0x72, 0x65, 0x6a, 0x63, 0xb6,
+	0x8a, 0xcc, 0x7c, 0xc6, 0x4c, 0xe9, 0xfc, 0xd4, 0x73, 0x5d, 0x7d, 0xd2, 0xd3, 0x27, 0x4e, 0x7a,
+	0xba, 0x92, 0xb8, 0x4a, 0x59, 0x34, 0xac, 0xb4, 0xfd, 0xda, 0xd6, 0xc7, 0xcf, 0x1e, 0x5f, 0x3b,
+	0x75, 0xff, 0xd9, 0xb3, 0xc7, 0xd7, 0xd6, 0xfa, 0xbc, 0x8c, 0xca, 0xcd, 0xb8, 0x0a, 0xd7, 0x46,
+	0xc9, 0x2d, 0xc2, 0x3a, 0x34, 0x60, 0xc4, 0xf8, 0x0d, 0xc0, 0xa5, 0x5d, 0xe6, 0xec, 0x24, 0x1e,
+	0xc8, 0x1e, 0x4d, 0xeb, 0x97, 0x62, 0xe8, 0x03, 0x38, 0x1d, 0xed, 0xe2, 0xe2, 0xe4, 0x0a, 0xd8,
+	0x98, 0xad, 0x2e, 0x99, 0xc9, 0xf6, 0x98, 0xd1, 0x36, 0x4b, 0x4e, 0xee, 0x52, 0x37, 0xa8, 0xa3,
+	0x88, 0x84, 0xef, 0x7e, 0xd1, 0xd7, 0x1d, 0x97, 0xb7, 0xbb, 0x0d, 0xb3, 0x49, 0x7d, 0x24, 0xf6,
+	0x32, 0xf9, 0xd9, 0x64, 0xf6, 0x3e, 0xe2, 0x87, 0x1d, 0xc2, 0x62, 0x80, 0x15, 0xdb, 0xad, 0xbd,
+	0x3a, 0x4c, 0xc9, 0x6a, 0x8a, 0x92, 0xec, 0x4c, 0x8c, 0xcb, 0x70, 0x35, 0x57, 0x28, 0xc9, 0xf8,
+	0x1d, 0xc0, 0x4b, 0xbb, 0xcc, 0xb9, 0x1f, 0x08, 0xeb, 0xe4, 0x8d, 0x90, 0xfa, 0xcf, 0x8d, 0x90,
+	0xa9, 0x7f, 0x89, 0x90, 0x9b, 0xc3, 0x84, 0x5c, 0x49, 0x11, 0x92, 0x9f, 0x8d, 0xb1, 0x0e, 0xaf,
+	0x8c, 0x54, 0x90, 0xc4, 0xfc, 0x09, 0xe0, 0xfa, 0x90, 0xa6, 0x45, 0x1a, 0xd8, 0xc3, 0x41, 0x93,
+	0xd8, 0xff, 0xfb, 0x33, 0x53, 0x50, 0x46, 0x19, 0x79, 0x3d, 0x88, 0x0b, 0xd9, 0xa8, 0x40, 0x74,
+	0xc6, 0xbc, 0x25, 0x57, 0x7f, 0x24, 0x15, 0x65, 0x91, 0x3e, 0xe6, 0x1f, 0xb3, 0xf3, 0x1f, 0xf7,
+	0x9c, 0x82, 0x02, 0xcb, 0x4e, 0x4c, 0x14, 0x58, 0xb6, 0x50, 0x72, 0xf3, 0x79, 0xd2, 0x92, 0xdf,
+	0x73, 0x79, 0xdb, 0x0e, 0xf1, 0x43, 0x51, 0x8e, 0x2e, 0x0d, 0x2c, 0xf2, 0x10, 0x87, 0x36, 0x2b,
+	0x43, 0x4f, 0xd1, 0xe6, 0xe6, 0x3a, 0x13, 0x3d, 0x32, 0x57, 0x2e, 0xa3, 0xfe, 0x06, 0xc0, 0x8b,
+	0xa9, 0xe6, 0x51, 0xa7, 0x81, 0x4d, 0xec, 0x3d, 0xba, 0x4f, 0x82, 0x52, 0x01, 0x2b, 0x0b, 0xf0,
+	0x9c, 0x47, 0x9b, 0xfb, 0x6f, 0xee, 0xc4, 0xe7, 0x7d, 0xda, 0x12, 0x5f, 0xb5, 0xea, 0x70, 0x22,
+	0x7a, 0x46, 0x67, 0x4b, 0xfb, 0x37, 0x56, 0xa1, 0x9e, 0x23, 0x92, 0xe1, 0x7f, 0x39, 0x09, 0xe7,
+	0x92, 0x59, 0x70, 0xa7, 0xcb, 0xa9, 0x3c, 0xbd, 0xa5, 0x42, 0xbf, 0x0e, 0x5f, 0x20, 0x01, 0x6e,
+	0x78, 0xc4, 0x8e, 0x63, 0x7f, 0xb1, 0xae, 0x9c, 0xf4, 0xf4, 0xf3, 0x09, 0x42, 0x08, 0x0c, 0xab,
+	0xaf, 0xa2, 0xb4, 0xe0, 0x4b, 0x76, 0xe8, 0xb6, 0xf8, 0x87, 0xbc, 0x1d, 0x12, 0xd6, 0xa6, 0x9e,
+	0x1d, 0x37, 0xc1, 0x99, 0xfa, 0x76, 0x74, 0x16, 0x7f, 0xee, 0xe9, 0xcb, 0x49, 0xd1, 0x32, 0x7b,
+	0xdf, 0x74, 0x29, 0xf2, 0x31, 0x6f, 0x9b, 0x6f, 0x13, 0x07, 0x37, 0x0f, 0x77, 0x48, 0xf3, 0xa4,
+	0xa7, 0x2f, 0x88, 0x50, 0x06, 0x6d, 0x18, 0xd6, 0xf9, 0x78, 0x65, 0xaf, 0xbf, 0x50, 0x33, 0x87,
+	0x89, 0x5b, 0x1e, 0x9c, 0x92, 0x03, 0x99, 0x1b, 0x97, 0xe0, 0x72, 0xc6, 0xb2, 0x24, 0xec, 0x47,
+	0x00, 0x17, 0x86, 0x87, 0xa7, 0xd5, 0xf5, 0xca, 0x71, 0x76, 0x1f, 0x4e, 0x87, 0x5d, 0x8f, 0x88,
+	0xe6, 0x76, 0xfd, 0x4c, 0x75, 0x2b, 0xfc, 0xd5, 0xe7, 0x44, 0xd1, 0xce, 0x26, 0x0e, 0x22, 0x3b,
+	0x86, 0x15, 0x9b, 0xab, 0x55, 0x86, 0x93, 0xd6, 0xf2, 0xaf, 0x06, 0x91, 0x35, 0x63, 0x05, 0x6a,
+	0xd9, 0x92, 0x7e, 0xea, 0xd5, 0x1f, 0x20, 0x9c, 0xda, 0x65, 0x8e, 0xf2, 0x35, 0x80, 0x4b, 0xf9,
+	0x17, 0xa7, 0x9b, 0xa3, 0x72, 0x18, 0x75, 0xed, 0x50, 0x6f, 0x97, 0x45, 0xf6, 0x23, 0x54, 0xbe,
+	0x00, 0x70, 0x21, 0xe7, 0xb6, 0xf2, 0x5a, 0x81, 0xf1, 0x6c, 0x98, 0xba, 0x5d, 0x0a, 0x26, 0x03,
+	0xfa, 0x16, 0x40, 0x75, 0xc4, 0x8d, 0xe1, 0x56, 0x81, 0xf5, 0x7c, 0xa8, 0x7a, 0xa7, 0x34, 0x54,
+	0x06, 0xf7, 0x3d, 0x80, 0x6b, 0x67, 0x9a, 0xda, 0x77, 0xc7, 0xf2, 0x95, 0x6d, 0x44, 0x7d, 0xeb,
+	0x39, 0x18, 0x19, 0xd8, 0xe8, 0x9c, 0x21, 0x5a, 0xb4, 0xd1, 0xd9, 0x30, 0x75, 0xbb, 0x14, 0x4c,
+	0x06, 0x14, 0xd5, 0x44, 0xfe, 0xe4, 0x2a, 0xaa, 0x89, 0x5c, 0xa4, 0x7a, 0xbb, 0x2c, 0x52, 0x46,
+	0xf6, 0x29, 0x80, 0xf3, 0x99, 0xd3, 0xe9, 0xc6, 0x19, 0x8f, 0x76, 0x1a, 0xa4, 0xbe, 0x5e, 0x02,
+	0x24, 0x43, 0xf9, 0x08, 0x5e, 0x18, 0x1a, 0x34, 0xa8, 0xb8, 0xe8, 0x07, 0x00, 0xea, 0xd6, 0x98,
+	0x00, 0xe9, 0xfd, 0x13, 0x00, 0xe7, 0x32, 0xdb, 0xf6, 0x78, 0x6d, 0x27, 0xc2, 0xa8, 0xb5, 0xf1,
+	0x31, 0xfd, 0x38, 0xea, 0xef, 0x3e, 0x39, 0xd2, 0xc0, 0xd3, 0x23, 0x0d, 0xfc, 0x7a, 0xa4, 0x81,
+	0xaf, 0x8e, 0xb5, 0x89, 0xa7, 0xc7, 0xda, 0xc4, 0x4f, 0xc7, 0xda, 0xc4, 0xfb, 0x5b, 0xa9, 0x8b,
+	0xab, 0xb0, 0xbf, 0xe9, 0xe1, 0x06, 0x43, 0xf2, 0xb5, 0x5b, 0xbd, 0x85, 0x1e, 0x89, 0x37, 0xef,
+	0x66, 0xfc, 0xe8, 0x8d, 0x6f, 0xb3, 0x8d, 0x73, 0xf1, 0xa3, 0xf6, 0xc6, 0xdf, 0x03, 0x00, 0xa6,
+	0xb1, 0x0c, 0x9d, 0xc2, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -790,6 +995,13 @@ type MsgClient interface {
 	// DelegateBondedTokens allows users to break the lockup bond and delegate
 	// osmo tokens to a predefined validator-set.
 	DelegateBondedTokens(ctx context.Context, in *MsgDelegateBondedTokens, opts ...grpc.CallOption) (*MsgDelegateBondedTokensResponse, error)
+	// SetAutoRebalance allows users to opt in or out of having their
+	// delegations gradually rebalanced towards their validator set preference
+	// every day epoch.
+	SetAutoRebalance(ctx context.Context, in *MsgSetAutoRebalance, opts ...grpc.CallOption) (*MsgSetAutoRebalanceResponse, error)
+	// SetValidatorSetRule sets a validator set preference that is resolved from
+	// the staking state whenever it is used, replacing any existing preference.
+	SetValidatorSetRule(ctx context.Context, in *MsgSetValidatorSetRule, opts ...grpc.CallOption) (*MsgSetValidatorSetRuleResponse, error)
 }
 
 type msgClient struct {
@@ -863,6 +1075,24 @@ func (c *msgClient) DelegateBondedTokens(ctx context.Context, in *MsgDelegateBon
 	return out, nil
 }
 
+func (c *msgClient) SetAutoRebalance(ctx context.Context, in *MsgSetAutoRebalance, opts ...grpc.CallOption) (*MsgSetAutoRebalanceResponse, error) {
+	out := new(MsgSetAutoRebalanceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.valsetpref.v1beta1.Msg/SetAutoRebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorSetRule(ctx context.Context, in *MsgSetValidatorSetRule, opts ...grpc.CallOption) (*MsgSetValidatorSetRuleResponse, error) {
+	out := new(MsgSetValidatorSetRuleResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.valsetpref.v1beta1.Msg/SetValidatorSetRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetValidatorSetPreference creates a set of validator preference.
@@ -888,6 +1118,13 @@ type MsgServer interface {
 	// DelegateBondedTokens allows users to break the lockup bond and delegate
 	// osmo tokens to a predefined validator-set.
 	DelegateBondedTokens(context.Context, *MsgDelegateBondedTokens) (*MsgDelegateBondedTokensResponse, error)
+	// SetAutoRebalance allows users to opt in or out of having their
+	// delegations gradually rebalanced towards their validator set preference
+	// every day epoch.
+	SetAutoRebalance(context.Context, *MsgSetAutoRebalance) (*MsgSetAutoRebalanceResponse, error)
+	// SetValidatorSetRule sets a validator set preference that is resolved from
+	// the staking state whenever it is used, replacing any existing preference.
+	SetValidatorSetRule(context.Context, *MsgSetValidatorSetRule) (*MsgSetValidatorSetRuleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -915,6 +1152,12 @@ func (*UnimplementedMsgServer) WithdrawDelegationRewards(ctx context.Context, re
 func (*UnimplementedMsgServer) DelegateBondedTokens(ctx context.Context, req *MsgDelegateBondedTokens) (*MsgDelegateBondedTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegateBondedTokens not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoRebalance(ctx context.Context, req *MsgSetAutoRebalance) (*MsgSetAutoRebalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoRebalance not implemented")
+}
+func (*UnimplementedMsgServer) SetValidatorSetRule(ctx context.Context, req *MsgSetValidatorSetRule) (*MsgSetValidatorSetRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorSetRule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1046,6 +1289,42 @@ func _Msg_DelegateBondedTokens_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoRebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoRebalance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoRebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.valsetpref.v1beta1.Msg/SetAutoRebalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoRebalance(ctx, req.(*MsgSetAutoRebalance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorSetRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorSetRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorSetRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.valsetpref.v1beta1.Msg/SetValidatorSetRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorSetRule(ctx, req.(*MsgSetValidatorSetRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.valsetpref.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1078,6 +1357,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegateBondedTokens",
 			Handler:    _Msg_DelegateBondedTokens_Handler,
 		},
+		{
+			MethodName: "SetAutoRebalance",
+			Handler:    _Msg_SetAutoRebalance_Handler,
+		},
+		{
+			MethodName: "SetValidatorSetRule",
+			Handler:    _Msg_SetValidatorSetRule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/valsetpref/v1beta1/tx.proto",
@@ -1517,85 +1804,221 @@ func (m *MsgDelegateBondedTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetAutoRebalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSetValidatorSetPreference) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetAutoRebalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoRebalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.DriftThreshold.Size()
+		i -= size
+		if _, err := m.DriftThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Preferences) > 0 {
-		for _, e := range m.Preferences {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetValidatorSetPreferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAutoRebalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDelegateToValidatorSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgSetAutoRebalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegateToValidatorSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetAutoRebalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUndelegateFromValidatorSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetValidatorSetRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUndelegateFromValidatorSetResponse) Size() (n int) {
+func (m *MsgSetValidatorSetRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetValidatorSetRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetValidatorSetRuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetValidatorSetRuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetValidatorSetRuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetValidatorSetPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Preferences) > 0 {
+		for _, e := range m.Preferences {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetValidatorSetPreferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateToValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDelegateToValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegateFromValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUndelegateFromValidatorSetResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1691,25 +2114,407 @@ func (m *MsgDelegateBondedTokens) Size() (n int) {
 	if m.LockID != 0 {
 		n += 1 + sovTx(uint64(m.LockID))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgDelegateBondedTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoRebalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = m.DriftThreshold.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAutoRebalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetValidatorSetRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetValidatorSetRuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetValidatorSetPreference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetValidatorSetPreference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetValidatorSetPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Preferences = append(m.Preferences, ValidatorPreference{})
+			if err := m.Preferences[len(m.Preferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetValidatorSetPreferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetValidatorSetPreferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetValidatorSetPreferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateToValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateToValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateToValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateToValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateToValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateToValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgDelegateBondedTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSetValidatorSetPreference) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateFromValidatorSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1732,10 +2537,10 @@ func (m *MsgSetValidatorSetPreference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetValidatorSetPreference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateFromValidatorSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetValidatorSetPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateFromValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1770,9 +2575,9 @@ func (m *MsgSetValidatorSetPreference) Unmarshal(dAtA []byte) error {
 			}
 			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Preferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1799,8 +2604,7 @@ func (m *MsgSetValidatorSetPreference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Preferences = append(m.Preferences, ValidatorPreference{})
-			if err := m.Preferences[len(m.Preferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1825,7 +2629,7 @@ func (m *MsgSetValidatorSetPreference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetValidatorSetPreferenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateFromValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1848,10 +2652,10 @@ func (m *MsgSetValidatorSetPreferenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetValidatorSetPreferenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateFromValidatorSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetValidatorSetPreferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateFromValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1875,7 +2679,7 @@ func (m *MsgSetValidatorSetPreferenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateToValidatorSet) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateFromRebalancedValidatorSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1898,10 +2702,10 @@ func (m *MsgDelegateToValidatorSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateToValidatorSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateFromRebalancedValidatorSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateToValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateFromRebalancedValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1990,7 +2794,7 @@ func (m *MsgDelegateToValidatorSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateToValidatorSetResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateFromRebalancedValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2013,10 +2817,10 @@ func (m *MsgDelegateToValidatorSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateToValidatorSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateFromRebalancedValidatorSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateToValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateFromRebalancedValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2040,7 +2844,7 @@ func (m *MsgDelegateToValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegateFromValidatorSet) Unmarshal(dAtA []byte) error {
+func (m *MsgRedelegateValidatorSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2063,10 +2867,10 @@ func (m *MsgUndelegateFromValidatorSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateFromValidatorSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedelegateValidatorSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateFromValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedelegateValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2101,9 +2905,9 @@ func (m *MsgUndelegateFromValidatorSet) Unmarshal(dAtA []byte) error {
 			}
 			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2130,7 +2934,8 @@ func (m *MsgUndelegateFromValidatorSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Preferences = append(m.Preferences, ValidatorPreference{})
+			if err := m.Preferences[len(m.Preferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2155,7 +2960,7 @@ func (m *MsgUndelegateFromValidatorSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegateFromValidatorSetResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedelegateValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2178,10 +2983,10 @@ func (m *MsgUndelegateFromValidatorSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateFromValidatorSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedelegateValidatorSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateFromValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedelegateValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2205,7 +3010,7 @@ func (m *MsgUndelegateFromValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegateFromRebalancedValidatorSet) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawDelegationRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2228,10 +3033,10 @@ func (m *MsgUndelegateFromRebalancedValidatorSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateFromRebalancedValidatorSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawDelegationRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateFromRebalancedValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawDelegationRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2266,39 +3071,6 @@ func (m *MsgUndelegateFromRebalancedValidatorSet) Unmarshal(dAtA []byte) error {
 			}
 			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2320,7 +3092,7 @@ func (m *MsgUndelegateFromRebalancedValidatorSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegateFromRebalancedValidatorSetResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2343,10 +3115,10 @@ func (m *MsgUndelegateFromRebalancedValidatorSetResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateFromRebalancedValidatorSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawDelegationRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateFromRebalancedValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2370,7 +3142,7 @@ func (m *MsgUndelegateFromRebalancedValidatorSetResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *MsgRedelegateValidatorSet) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateBondedTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2393,10 +3165,10 @@ func (m *MsgRedelegateValidatorSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedelegateValidatorSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateBondedTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedelegateValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateBondedTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2432,10 +3204,10 @@ func (m *MsgRedelegateValidatorSet) Unmarshal(dAtA []byte) error {
 			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Preferences", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockID", wireType)
 			}
-			var msglen int
+			m.LockID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2445,26 +3217,11 @@ func (m *MsgRedelegateValidatorSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LockID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Preferences = append(m.Preferences, ValidatorPreference{})
-			if err := m.Preferences[len(m.Preferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2486,7 +3243,7 @@ func (m *MsgRedelegateValidatorSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedelegateValidatorSetResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateBondedTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2509,10 +3266,10 @@ func (m *MsgRedelegateValidatorSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedelegateValidatorSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateBondedTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedelegateValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateBondedTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2536,7 +3293,7 @@ func (m *MsgRedelegateValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawDelegationRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoRebalance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2559,10 +3316,10 @@ func (m *MsgWithdrawDelegationRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawDelegationRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoRebalance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawDelegationRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoRebalance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2597,6 +3354,60 @@ func (m *MsgWithdrawDelegationRewards) Unmarshal(dAtA []byte) error {
 			}
 			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DriftThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DriftThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2618,7 +3429,7 @@ func (m *MsgWithdrawDelegationRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoRebalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2641,10 +3452,10 @@ func (m *MsgWithdrawDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawDelegationRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoRebalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoRebalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2668,7 +3479,7 @@ func (m *MsgWithdrawDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateBondedTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgSetValidatorSetRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2691,10 +3502,10 @@ func (m *MsgDelegateBondedTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateBondedTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetValidatorSetRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateBondedTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetValidatorSetRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2730,10 +3541,10 @@ func (m *MsgDelegateBondedTokens) Unmarshal(dAtA []byte) error {
 			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
 			}
-			m.LockID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2743,11 +3554,25 @@ func (m *MsgDelegateBondedTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LockID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2769,7 +3594,7 @@ func (m *MsgDelegateBondedTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateBondedTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetValidatorSetRuleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2792,10 +3617,10 @@ func (m *MsgDelegateBondedTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateBondedTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetValidatorSetRuleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateBondedTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetValidatorSetRuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
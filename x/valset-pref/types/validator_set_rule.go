@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// Validate performs stateless validation of the validator set rule.
+func (r ValidatorSetRule) Validate() error {
+	if r.MaxValidators > MaxValidatorSetRuleValidators {
+		return fmt.Errorf("Invalid max validators, needs to be at most %d, got %d", MaxValidatorSetRuleValidators, r.MaxValidators)
+	}
+
+	if !r.MaxCommissionRate.IsNil() && (r.MaxCommissionRate.IsNegative() || r.MaxCommissionRate.GT(osmomath.OneDec())) {
+		return fmt.Errorf("Invalid max commission rate, needs to be in [0, 1], got %s", r.MaxCommissionRate)
+	}
+
+	if _, ok := ValidatorSetRuleWeighting_name[int32(r.Weighting)]; !ok {
+		return fmt.Errorf("Invalid weighting %d", r.Weighting)
+	}
+
+	return nil
+}
+
+// GetMaxValidators returns the maximum number of validators the rule selects.
+func (r ValidatorSetRule) GetMaxValidators() uint64 {
+	if r.MaxValidators == 0 {
+		return MaxValidatorSetRuleValidators
+	}
+
+	return r.MaxValidators
+}
+
+// Equal returns true if both rules select the same validators with the same weights.
+func (r ValidatorSetRule) Equal(other ValidatorSetRule) bool {
+	return r.GetMaxValidators() == other.GetMaxValidators() &&
+		r.getMaxCommissionRate().Equal(other.getMaxCommissionRate()) &&
+		r.Weighting == other.Weighting
+}
+
+// getMaxCommissionRate returns the max commission rate of the rule, where zero does not exclude any validator.
+func (r ValidatorSetRule) getMaxCommissionRate() osmomath.Dec {
+	if r.MaxCommissionRate.IsNil() {
+		return osmomath.ZeroDec()
+	}
+
+	return r.MaxCommissionRate
+}
+
+// IsCommissionRateAllowed returns true if the rule does not exclude validators with the given commission rate.
+func (r ValidatorSetRule) IsCommissionRateAllowed(commissionRate osmomath.Dec) bool {
+	maxCommissionRate := r.getMaxCommissionRate()
+	if maxCommissionRate.IsZero() {
+		return true
+	}
+
+	return commissionRate.LTE(maxCommissionRate)
+}
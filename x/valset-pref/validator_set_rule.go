@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v29/x/valset-pref/types"
+)
+
+// ResolveValidatorSetPreference returns the given validator set preference with the validators and weights to delegate
+// to. Fixed preferences are returned as is, while rule based preferences are resolved from the current staking state.
+func (k Keeper) ResolveValidatorSetPreference(ctx sdk.Context, valSetPref types.ValidatorSetPreferences) (types.ValidatorSetPreferences, error) {
+	if valSetPref.Rule == nil {
+		return valSetPref, nil
+	}
+
+	preferences, err := k.ResolveValidatorSetRule(ctx, *valSetPref.Rule)
+	if err != nil {
+		return types.ValidatorSetPreferences{}, err
+	}
+
+	return types.ValidatorSetPreferences{Preferences: preferences, Rule: valSetPref.Rule}, nil
+}
+
+// ResolveValidatorSetRule selects the validators and weights of the given rule from the current staking state.
+// The bonded validators are iterated in the order of the staking power index, by decreasing consensus power with ties
+// broken by operator address, and the top rule.MaxValidators of the ones not excluded by the rule are selected.
+// Jailed validators, which includes tombstoned ones, are removed from the power index. The weights of the selected
+// validators add up to exactly one, with any rounding remainder assigned to the last validator.
+func (k Keeper) ResolveValidatorSetRule(ctx sdk.Context, rule types.ValidatorSetRule) ([]types.ValidatorPreference, error) {
+	var selected []stakingtypes.ValidatorI
+	totalTokens := osmomath.ZeroInt()
+	err := k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		if !rule.IsCommissionRateAllowed(validator.GetCommission()) {
+			return false
+		}
+
+		// validators without any tokens can't be weighted by voting power
+		if rule.Weighting == types.VotingPowerWeight && !validator.GetTokens().IsPositive() {
+			return false
+		}
+
+		selected = append(selected, validator)
+		totalTokens = totalTokens.Add(validator.GetTokens())
+		return uint64(len(selected)) >= rule.GetMaxValidators()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(selected) == 0 {
+		return nil, types.ErrNoValidatorsMatchRule
+	}
+
+	preferences := make([]types.ValidatorPreference, len(selected))
+	totalWeight := osmomath.ZeroDec()
+	for i, validator := range selected {
+		var weight osmomath.Dec
+		if i == len(selected)-1 {
+			weight = osmomath.OneDec().Sub(totalWeight)
+		} else if rule.Weighting == types.VotingPowerWeight {
+			weight = validator.GetTokens().ToLegacyDec().QuoInt(totalTokens)
+		} else {
+			weight = osmomath.OneDec().QuoInt64(int64(len(selected)))
+		}
+
+		totalWeight = totalWeight.Add(weight)
+		preferences[i] = types.ValidatorPreference{
+			ValOperAddress: validator.GetOperator(),
+			Weight:         weight,
+		}
+	}
+
+	return preferences, nil
+}
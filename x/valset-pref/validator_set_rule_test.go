@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	valPref "github.com/osmosis-labs/osmosis/v29/x/valset-pref"
+	"github.com/osmosis-labs/osmosis/v29/x/valset-pref/types"
+)
+
+// SetupRuleValidators creates a validator for each of the given delegations, commission rates and jailed statuses.
+// The delegations are added on top of the validators' self bond.
+func (s *KeeperTestSuite) SetupRuleValidators(delegations []int64, commissionRates []osmomath.Dec, jailed []bool) []string {
+	valAddrs := s.SetupMultipleValidators(len(delegations))
+	whale := sdk.AccAddress([]byte("whale---------------"))
+
+	for i, valAddrStr := range valAddrs {
+		s.FundAcc(whale, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, delegations[i])))
+		err := s.PrepareExistingDelegations(s.Ctx, []string{valAddrStr}, whale, osmomath.NewInt(delegations[i]))
+		s.Require().NoError(err)
+
+		valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+		s.Require().NoError(err)
+		validator, err := s.App.StakingKeeper.GetValidator(s.Ctx, valAddr)
+		s.Require().NoError(err)
+
+		validator.Commission.Rate = commissionRates[i]
+		err = s.App.StakingKeeper.SetValidator(s.Ctx, validator)
+		s.Require().NoError(err)
+
+		if jailed[i] {
+			consAddr, err := validator.GetConsAddr()
+			s.Require().NoError(err)
+			err = s.App.StakingKeeper.Jail(s.Ctx, consAddr)
+			s.Require().NoError(err)
+		}
+	}
+
+	return valAddrs
+}
+
+func (s *KeeperTestSuite) TestResolveValidatorSetRule() {
+	tests := []struct {
+		name               string
+		rule               types.ValidatorSetRule
+		expectedValidators []int // indexes of the expected validators
+		expectedWeights    []osmomath.Dec
+		expectedErr        error
+	}{
+		{
+			name:               "top validators by voting power with equal weights",
+			rule:               types.ValidatorSetRule{MaxValidators: 2},
+			expectedValidators: []int{0, 1},
+			expectedWeights:    []osmomath.Dec{osmomath.NewDecWithPrec(5, 1), osmomath.NewDecWithPrec(5, 1)},
+		},
+		{
+			name:               "validators above the max commission rate are excluded",
+			rule:               types.ValidatorSetRule{MaxValidators: 2, MaxCommissionRate: osmomath.NewDecWithPrec(1, 1)},
+			expectedValidators: []int{0, 3},
+			expectedWeights:    []osmomath.Dec{osmomath.NewDecWithPrec(5, 1), osmomath.NewDecWithPrec(5, 1)},
+		},
+		{
+			name:               "jailed validators are excluded and equal weights add up to one",
+			rule:               types.ValidatorSetRule{MaxValidators: 3},
+			expectedValidators: []int{0, 1, 3},
+			expectedWeights: []osmomath.Dec{
+				osmomath.MustNewDecFromStr("0.333333333333333333"),
+				osmomath.MustNewDecFromStr("0.333333333333333333"),
+				osmomath.MustNewDecFromStr("0.333333333333333334"),
+			},
+		},
+		{
+			name:               "voting power weights",
+			rule:               types.ValidatorSetRule{MaxValidators: 3, Weighting: types.VotingPowerWeight},
+			expectedValidators: []int{0, 1, 3},
+			expectedWeights: []osmomath.Dec{
+				osmomath.NewDecWithPrec(5, 1),
+				osmomath.NewDecWithPrec(375, 3),
+				osmomath.NewDecWithPrec(125, 3),
+			},
+		},
+		{
+			name:        "no validator matches the rule",
+			rule:        types.ValidatorSetRule{MaxCommissionRate: osmomath.NewDecWithPrec(1, 3)},
+			expectedErr: types.ErrNoValidatorsMatchRule,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+
+			// every validator, including the genesis one, has a commission rate of at least 1%
+			validators, err := s.App.StakingKeeper.GetAllValidators(s.Ctx)
+			s.Require().NoError(err)
+			for _, validator := range validators {
+				validator.Commission.Rate = osmomath.NewDecWithPrec(1, 2)
+				s.Require().NoError(s.App.StakingKeeper.SetValidator(s.Ctx, validator))
+			}
+
+			valAddrs := s.SetupRuleValidators(
+				[]int64{399_000_000, 299_000_000, 199_000_000, 99_000_000},
+				[]osmomath.Dec{osmomath.NewDecWithPrec(5, 2), osmomath.NewDecWithPrec(2, 1), osmomath.NewDecWithPrec(5, 2), osmomath.NewDecWithPrec(5, 2)},
+				[]bool{false, false, true, false},
+			)
+
+			preferences, err := s.App.ValidatorSetPreferenceKeeper.ResolveValidatorSetRule(s.Ctx, test.rule)
+			if test.expectedErr != nil {
+				s.Require().ErrorIs(err, test.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(len(test.expectedValidators), len(preferences))
+			totalWeight := osmomath.ZeroDec()
+			for i, preference := range preferences {
+				s.Require().Equal(valAddrs[test.expectedValidators[i]], preference.ValOperAddress)
+				s.Require().Equal(test.expectedWeights[i], preference.Weight)
+				totalWeight = totalWeight.Add(preference.Weight)
+			}
+			s.Require().Equal(osmomath.OneDec(), totalWeight)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestResolveValidatorSetRuleExcludesInactiveValidators() {
+	s.SetupTest()
+
+	valAddrs := s.SetupRuleValidators(
+		[]int64{399_000_000, 299_000_000, 199_000_000},
+		[]osmomath.Dec{osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec()},
+		[]bool{false, false, false},
+	)
+
+	// the first validator is jailed and tombstoned, as for a double sign, and is still bonded until the end of the block
+	valAddr, err := sdk.ValAddressFromBech32(valAddrs[0])
+	s.Require().NoError(err)
+	validator, err := s.App.StakingKeeper.GetValidator(s.Ctx, valAddr)
+	s.Require().NoError(err)
+	consAddr, err := validator.GetConsAddr()
+	s.Require().NoError(err)
+	s.Require().NoError(s.App.StakingKeeper.Jail(s.Ctx, consAddr))
+	s.Require().NoError(s.App.SlashingKeeper.Tombstone(s.Ctx, consAddr))
+
+	// the second validator is unbonding
+	valAddr, err = sdk.ValAddressFromBech32(valAddrs[1])
+	s.Require().NoError(err)
+	validator, err = s.App.StakingKeeper.GetValidator(s.Ctx, valAddr)
+	s.Require().NoError(err)
+	s.Require().NoError(s.App.StakingKeeper.SetValidator(s.Ctx, validator.UpdateStatus(stakingtypes.Unbonding)))
+
+	preferences, err := s.App.ValidatorSetPreferenceKeeper.ResolveValidatorSetRule(s.Ctx, types.ValidatorSetRule{MaxValidators: 1})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ValidatorPreference{{ValOperAddress: valAddrs[2], Weight: osmomath.OneDec()}}, preferences)
+}
+
+func (s *KeeperTestSuite) TestSetValidatorSetRule() {
+	s.SetupTest()
+
+	msgServer := valPref.NewMsgServerImpl(s.App.ValidatorSetPreferenceKeeper)
+	delegator := sdk.AccAddress([]byte("addr1---------------"))
+	valAddrs := s.SetupRuleValidators(
+		[]int64{30_000_000, 20_000_000, 10_000_000},
+		[]osmomath.Dec{osmomath.ZeroDec(), osmomath.NewDecWithPrec(2, 1), osmomath.ZeroDec()},
+		[]bool{false, false, false},
+	)
+	rule := types.ValidatorSetRule{MaxValidators: 2, MaxCommissionRate: osmomath.NewDecWithPrec(1, 1)}
+
+	_, err := msgServer.SetValidatorSetRule(s.Ctx, types.NewMsgSetValidatorSetRule(delegator, rule))
+	s.Require().NoError(err)
+
+	// setting the same rule again fails
+	_, err = msgServer.SetValidatorSetRule(s.Ctx, types.NewMsgSetValidatorSetRule(delegator, rule))
+	s.Require().Error(err)
+
+	// the rule is resolved when delegating
+	s.FundAcc(delegator, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000)))
+	_, err = msgServer.DelegateToValidatorSet(s.Ctx, types.NewMsgDelegateToValidatorSet(delegator, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000)))
+	s.Require().NoError(err)
+	s.Require().Equal([]osmomath.Int{osmomath.NewInt(5_000_000), osmomath.ZeroInt(), osmomath.NewInt(5_000_000)}, s.delegatedTokens(delegator, valAddrs))
+
+	// once the commission of the second validator is lowered, it replaces the third one at the next rebalance
+	valAddr, err := sdk.ValAddressFromBech32(valAddrs[1])
+	s.Require().NoError(err)
+	validator, err := s.App.StakingKeeper.GetValidator(s.Ctx, valAddr)
+	s.Require().NoError(err)
+	validator.Commission.Rate = osmomath.NewDecWithPrec(5, 2)
+	s.Require().NoError(s.App.StakingKeeper.SetValidator(s.Ctx, validator))
+
+	err = s.App.ValidatorSetPreferenceKeeper.RebalanceDelegations(s.Ctx, delegator.String(), osmomath.ZeroDec())
+	s.Require().NoError(err)
+	s.Require().Equal([]osmomath.Int{osmomath.NewInt(5_000_000), osmomath.NewInt(5_000_000), osmomath.ZeroInt()}, s.delegatedTokens(delegator, valAddrs))
+
+	// setting fixed preferences replaces the rule
+	preferences := []types.ValidatorPreference{{ValOperAddress: valAddrs[2], Weight: osmomath.OneDec()}}
+	_, err = msgServer.SetValidatorSetPreference(s.Ctx, types.NewMsgSetValidatorSetPreference(delegator, preferences))
+	s.Require().NoError(err)
+
+	valSetPref, found := s.App.ValidatorSetPreferenceKeeper.GetValidatorSetPreference(s.Ctx, delegator.String())
+	s.Require().True(found)
+	s.Require().Nil(valSetPref.Rule)
+}